@@ -5,11 +5,28 @@
 
 package acl
 
+import (
+	"net"
+	"time"
+)
+
 // AuthorizerContext contains extra information that can be
 // used in the determination of an ACL enforcement decision.
 type AuthorizerContext struct {
 	// Peer is the name of the peer that the resource was imported from.
 	Peer string
+
+	// SourceIP is the address of the client making the request, if known.
+	// It is used to evaluate the source_cidrs of conditional rules.
+	SourceIP net.IP
+
+	// NodeMeta is the metadata of the node that the request applies to, if
+	// any. It is used to evaluate the node_meta of conditional rules.
+	NodeMeta map[string]string
+
+	// Now overrides the time used to evaluate the time_window of conditional
+	// rules. The current time is used when it is zero.
+	Now time.Time
 }
 
 func (c *AuthorizerContext) PeerOrEmpty() string {
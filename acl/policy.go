@@ -115,6 +115,9 @@ type KeyRule struct {
 	Prefix string `hcl:",key"`
 	Policy string
 
+	// Condition optionally restricts the requests this rule grants access to.
+	Condition *RuleCondition `hcl:"condition"`
+
 	EnterpriseRule `hcl:",squash"`
 }
 
@@ -123,6 +126,9 @@ type NodeRule struct {
 	Name   string `hcl:",key"`
 	Policy string
 
+	// Condition optionally restricts the requests this rule grants access to.
+	Condition *RuleCondition `hcl:"condition"`
+
 	EnterpriseRule `hcl:",squash"`
 }
 
@@ -136,6 +142,9 @@ type ServiceRule struct {
 	// the intentions policy.
 	Intentions string
 
+	// Condition optionally restricts the requests this rule grants access to.
+	Condition *RuleCondition `hcl:"condition"`
+
 	EnterpriseRule `hcl:",squash"`
 }
 
@@ -200,6 +209,9 @@ func (pr *PolicyRules) Validate(conf *Config) error {
 		if err := kp.EnterpriseRule.Validate(kp.Policy, conf); err != nil {
 			return fmt.Errorf("Invalid key enterprise policy: %#v, got error: %v", kp, err)
 		}
+		if err := ValidateRuleCondition(kp.Condition, kp.Policy); err != nil {
+			return fmt.Errorf("Invalid key condition: %#v, got error: %v", kp, err)
+		}
 	}
 	for _, kp := range pr.KeyPrefixes {
 		if !isPolicyValid(kp.Policy, true) {
@@ -208,6 +220,9 @@ func (pr *PolicyRules) Validate(conf *Config) error {
 		if err := kp.EnterpriseRule.Validate(kp.Policy, conf); err != nil {
 			return fmt.Errorf("Invalid key_prefix enterprise policy: %#v, got error: %v", kp, err)
 		}
+		if err := ValidateRuleCondition(kp.Condition, kp.Policy); err != nil {
+			return fmt.Errorf("Invalid key_prefix condition: %#v, got error: %v", kp, err)
+		}
 	}
 
 	// Validate the node policies
@@ -218,6 +233,9 @@ func (pr *PolicyRules) Validate(conf *Config) error {
 		if err := np.EnterpriseRule.Validate(np.Policy, conf); err != nil {
 			return fmt.Errorf("Invalid node enterprise policy: %#v, got error: %v", np, err)
 		}
+		if err := ValidateRuleCondition(np.Condition, np.Policy); err != nil {
+			return fmt.Errorf("Invalid node condition: %#v, got error: %v", np, err)
+		}
 	}
 	for _, np := range pr.NodePrefixes {
 		if !isPolicyValid(np.Policy, false) {
@@ -226,6 +244,9 @@ func (pr *PolicyRules) Validate(conf *Config) error {
 		if err := np.EnterpriseRule.Validate(np.Policy, conf); err != nil {
 			return fmt.Errorf("Invalid node_prefix enterprise policy: %#v, got error: %v", np, err)
 		}
+		if err := ValidateRuleCondition(np.Condition, np.Policy); err != nil {
+			return fmt.Errorf("Invalid node_prefix condition: %#v, got error: %v", np, err)
+		}
 	}
 
 	// Validate the service policies
@@ -239,6 +260,9 @@ func (pr *PolicyRules) Validate(conf *Config) error {
 		if err := sp.EnterpriseRule.Validate(sp.Policy, conf); err != nil {
 			return fmt.Errorf("Invalid service enterprise policy: %#v, got error: %v", sp, err)
 		}
+		if err := ValidateRuleCondition(sp.Condition, sp.Policy); err != nil {
+			return fmt.Errorf("Invalid service condition: %#v, got error: %v", sp, err)
+		}
 	}
 	for _, sp := range pr.ServicePrefixes {
 		if !isPolicyValid(sp.Policy, false) {
@@ -250,6 +274,9 @@ func (pr *PolicyRules) Validate(conf *Config) error {
 		if err := sp.EnterpriseRule.Validate(sp.Policy, conf); err != nil {
 			return fmt.Errorf("Invalid service_prefix enterprise policy: %#v, got error: %v", sp, err)
 		}
+		if err := ValidateRuleCondition(sp.Condition, sp.Policy); err != nil {
+			return fmt.Errorf("Invalid service_prefix condition: %#v, got error: %v", sp, err)
+		}
 	}
 
 	// Validate the session policies
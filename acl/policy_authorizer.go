@@ -62,10 +62,46 @@ type policyAuthorizerRule struct {
 	// decision is the enforcement decision for this rule
 	access AccessLevel
 
+	// conditional holds additional access granted by this rule only to
+	// requests that satisfy a condition.
+	conditional []conditionalAccess
+
 	// Embedded Consul Enterprise specific policy
 	EnterpriseRule
 }
 
+// conditionalAccess is an access level granted only when its condition is
+// satisfied by the request.
+type conditionalAccess struct {
+	access    AccessLevel
+	condition *ruleCondition
+}
+
+// resolve returns the rule with the access that applies to the request
+// described by ctx, or nil if the rule grants the request nothing.
+func (r *policyAuthorizerRule) resolve(ctx *AuthorizerContext) *policyAuthorizerRule {
+	if r == nil || len(r.conditional) == 0 {
+		return r
+	}
+
+	access := r.access
+	for _, c := range r.conditional {
+		// Conditions cannot be attached to a deny so an explicit denial
+		// always wins.
+		if access != AccessDeny && c.access > access && c.condition.matches(ctx) {
+			access = c.access
+		}
+	}
+	if access == AccessUnknown {
+		return nil
+	}
+
+	return &policyAuthorizerRule{
+		access:         access,
+		EnterpriseRule: r.EnterpriseRule,
+	}
+}
+
 // policyAuthorizerRadixLeaf is used as the main
 // structure for storing in the radix.Tree's within the
 // PolicyAuthorizer
@@ -74,13 +110,27 @@ type policyAuthorizerRadixLeaf struct {
 	prefix *policyAuthorizerRule
 }
 
+// resolve returns the exact and prefix rules of the leaf with the access that
+// applies to the request described by ctx. Rules that grant the request
+// nothing are returned as nil.
+func (l *policyAuthorizerRadixLeaf) resolve(ctx *AuthorizerContext) *policyAuthorizerRadixLeaf {
+	if (l.exact == nil || len(l.exact.conditional) == 0) &&
+		(l.prefix == nil || len(l.prefix.conditional) == 0) {
+		return l
+	}
+	return &policyAuthorizerRadixLeaf{
+		exact:  l.exact.resolve(ctx),
+		prefix: l.prefix.resolve(ctx),
+	}
+}
+
 // getPolicy first attempts to get an exact match for the segment from the "exact" tree and then falls
 // back to getting the policy for the longest prefix from the "prefix" tree
-func getPolicy(segment string, tree *radix.Tree) (policy *policyAuthorizerRule, found bool) {
+func getPolicy(segment string, tree *radix.Tree, ctx *AuthorizerContext) (policy *policyAuthorizerRule, found bool) {
 	found = false
 
 	tree.WalkPath(segment, func(path string, leaf interface{}) bool {
-		policies := leaf.(*policyAuthorizerRadixLeaf)
+		policies := leaf.(*policyAuthorizerRadixLeaf).resolve(ctx)
 		if policies.exact != nil && path == segment {
 			found = true
 			policy = policies.exact
@@ -98,19 +148,13 @@ func getPolicy(segment string, tree *radix.Tree) (policy *policyAuthorizerRule,
 
 // insertPolicyIntoRadix will insert or update part of the leaf node within the radix tree corresponding to the
 // given segment. To update only one of the exact match or prefix match policy, set the value you want to leave alone
-// to nil when calling the function.
-func insertPolicyIntoRadix(segment string, policy string, ent *EnterpriseRule, tree *radix.Tree, prefix bool) error {
+// to nil when calling the function. When cond is non-nil the access is only granted to requests satisfying it, and
+// is added alongside any unconditional access for the segment rather than replacing it.
+func insertPolicyIntoRadix(segment string, policy string, ent *EnterpriseRule, cond *RuleCondition, tree *radix.Tree, prefix bool) error {
 	al, err := AccessLevelFromString(policy)
 	if err != nil {
 		return err
 	}
-	policyRule := policyAuthorizerRule{
-		access: al,
-	}
-
-	if ent != nil {
-		policyRule.EnterpriseRule = *ent
-	}
 
 	var policyLeaf *policyAuthorizerRadixLeaf
 	leaf, found := tree.Get(segment)
@@ -121,11 +165,41 @@ func insertPolicyIntoRadix(segment string, policy string, ent *EnterpriseRule, t
 		tree.Insert(segment, policyLeaf)
 	}
 
+	target := &policyLeaf.exact
 	if prefix {
-		policyLeaf.prefix = &policyRule
-	} else {
-		policyLeaf.exact = &policyRule
+		target = &policyLeaf.prefix
+	}
+
+	if cond != nil {
+		compiled, err := compileRuleCondition(cond)
+		if err != nil {
+			return err
+		}
+		if *target == nil {
+			*target = &policyAuthorizerRule{access: AccessUnknown}
+			if ent != nil {
+				(*target).EnterpriseRule = *ent
+			}
+		}
+		(*target).conditional = append((*target).conditional, conditionalAccess{
+			access:    al,
+			condition: compiled,
+		})
+		return nil
+	}
+
+	policyRule := policyAuthorizerRule{
+		access: al,
+	}
+
+	if ent != nil {
+		policyRule.EnterpriseRule = *ent
+	}
+
+	if *target != nil {
+		policyRule.conditional = (*target).conditional
 	}
+	*target = &policyRule
 
 	return nil
 }
@@ -171,49 +245,49 @@ func defaultIsAllow(decision EnforcementDecision) EnforcementDecision {
 func (p *policyAuthorizer) loadRules(policy *PolicyRules) error {
 	// Load the agent policy (exact matches)
 	for _, ap := range policy.Agents {
-		if err := insertPolicyIntoRadix(ap.Node, ap.Policy, nil, p.agentRules, false); err != nil {
+		if err := insertPolicyIntoRadix(ap.Node, ap.Policy, nil, nil, p.agentRules, false); err != nil {
 			return err
 		}
 	}
 
 	// Load the agent policy (prefix matches)
 	for _, ap := range policy.AgentPrefixes {
-		if err := insertPolicyIntoRadix(ap.Node, ap.Policy, nil, p.agentRules, true); err != nil {
+		if err := insertPolicyIntoRadix(ap.Node, ap.Policy, nil, nil, p.agentRules, true); err != nil {
 			return err
 		}
 	}
 
 	// Load the key policy (exact matches)
 	for _, kp := range policy.Keys {
-		if err := insertPolicyIntoRadix(kp.Prefix, kp.Policy, &kp.EnterpriseRule, p.keyRules, false); err != nil {
+		if err := insertPolicyIntoRadix(kp.Prefix, kp.Policy, &kp.EnterpriseRule, kp.Condition, p.keyRules, false); err != nil {
 			return err
 		}
 	}
 
 	// Load the key policy (prefix matches)
 	for _, kp := range policy.KeyPrefixes {
-		if err := insertPolicyIntoRadix(kp.Prefix, kp.Policy, &kp.EnterpriseRule, p.keyRules, true); err != nil {
+		if err := insertPolicyIntoRadix(kp.Prefix, kp.Policy, &kp.EnterpriseRule, kp.Condition, p.keyRules, true); err != nil {
 			return err
 		}
 	}
 
 	// Load the node policy (exact matches)
 	for _, np := range policy.Nodes {
-		if err := insertPolicyIntoRadix(np.Name, np.Policy, &np.EnterpriseRule, p.nodeRules, false); err != nil {
+		if err := insertPolicyIntoRadix(np.Name, np.Policy, &np.EnterpriseRule, np.Condition, p.nodeRules, false); err != nil {
 			return err
 		}
 	}
 
 	// Load the node policy (prefix matches)
 	for _, np := range policy.NodePrefixes {
-		if err := insertPolicyIntoRadix(np.Name, np.Policy, &np.EnterpriseRule, p.nodeRules, true); err != nil {
+		if err := insertPolicyIntoRadix(np.Name, np.Policy, &np.EnterpriseRule, np.Condition, p.nodeRules, true); err != nil {
 			return err
 		}
 	}
 
 	// Load the service policy (exact matches)
 	for _, sp := range policy.Services {
-		if err := insertPolicyIntoRadix(sp.Name, sp.Policy, &sp.EnterpriseRule, p.serviceRules, false); err != nil {
+		if err := insertPolicyIntoRadix(sp.Name, sp.Policy, &sp.EnterpriseRule, sp.Condition, p.serviceRules, false); err != nil {
 			return err
 		}
 
@@ -227,14 +301,14 @@ func (p *policyAuthorizer) loadRules(policy *PolicyRules) error {
 			}
 		}
 
-		if err := insertPolicyIntoRadix(sp.Name, intention, &sp.EnterpriseRule, p.intentionRules, false); err != nil {
+		if err := insertPolicyIntoRadix(sp.Name, intention, &sp.EnterpriseRule, sp.Condition, p.intentionRules, false); err != nil {
 			return err
 		}
 	}
 
 	// Load the service policy (prefix matches)
 	for _, sp := range policy.ServicePrefixes {
-		if err := insertPolicyIntoRadix(sp.Name, sp.Policy, &sp.EnterpriseRule, p.serviceRules, true); err != nil {
+		if err := insertPolicyIntoRadix(sp.Name, sp.Policy, &sp.EnterpriseRule, sp.Condition, p.serviceRules, true); err != nil {
 			return err
 		}
 
@@ -248,49 +322,49 @@ func (p *policyAuthorizer) loadRules(policy *PolicyRules) error {
 			}
 		}
 
-		if err := insertPolicyIntoRadix(sp.Name, intention, &sp.EnterpriseRule, p.intentionRules, true); err != nil {
+		if err := insertPolicyIntoRadix(sp.Name, intention, &sp.EnterpriseRule, sp.Condition, p.intentionRules, true); err != nil {
 			return err
 		}
 	}
 
 	// Load the session policy (exact matches)
 	for _, sp := range policy.Sessions {
-		if err := insertPolicyIntoRadix(sp.Node, sp.Policy, nil, p.sessionRules, false); err != nil {
+		if err := insertPolicyIntoRadix(sp.Node, sp.Policy, nil, nil, p.sessionRules, false); err != nil {
 			return err
 		}
 	}
 
 	// Load the session policy (prefix matches)
 	for _, sp := range policy.SessionPrefixes {
-		if err := insertPolicyIntoRadix(sp.Node, sp.Policy, nil, p.sessionRules, true); err != nil {
+		if err := insertPolicyIntoRadix(sp.Node, sp.Policy, nil, nil, p.sessionRules, true); err != nil {
 			return err
 		}
 	}
 
 	// Load the event policy (exact matches)
 	for _, ep := range policy.Events {
-		if err := insertPolicyIntoRadix(ep.Event, ep.Policy, nil, p.eventRules, false); err != nil {
+		if err := insertPolicyIntoRadix(ep.Event, ep.Policy, nil, nil, p.eventRules, false); err != nil {
 			return err
 		}
 	}
 
 	// Load the event policy (prefix matches)
 	for _, ep := range policy.EventPrefixes {
-		if err := insertPolicyIntoRadix(ep.Event, ep.Policy, nil, p.eventRules, true); err != nil {
+		if err := insertPolicyIntoRadix(ep.Event, ep.Policy, nil, nil, p.eventRules, true); err != nil {
 			return err
 		}
 	}
 
 	// Load the prepared query policy (exact matches)
 	for _, qp := range policy.PreparedQueries {
-		if err := insertPolicyIntoRadix(qp.Prefix, qp.Policy, nil, p.preparedQueryRules, false); err != nil {
+		if err := insertPolicyIntoRadix(qp.Prefix, qp.Policy, nil, nil, p.preparedQueryRules, false); err != nil {
 			return err
 		}
 	}
 
 	// Load the prepared query policy (prefix matches)
 	for _, qp := range policy.PreparedQueryPrefixes {
-		if err := insertPolicyIntoRadix(qp.Prefix, qp.Policy, nil, p.preparedQueryRules, true); err != nil {
+		if err := insertPolicyIntoRadix(qp.Prefix, qp.Policy, nil, nil, p.preparedQueryRules, true); err != nil {
 			return err
 		}
 	}
@@ -430,9 +504,9 @@ func allAllowed(tree *radix.Tree, enforceFn enforceCallback) EnforcementDecision
 	return decision
 }
 
-func (authz *policyAuthorizer) anyAllowed(tree *radix.Tree, requiredPermission AccessLevel) EnforcementDecision {
+func (authz *policyAuthorizer) anyAllowed(tree *radix.Tree, requiredPermission AccessLevel, ctx *AuthorizerContext) EnforcementDecision {
 	return anyAllowed(tree, func(raw interface{}, prefixOnly bool) EnforcementDecision {
-		leaf := raw.(*policyAuthorizerRadixLeaf)
+		leaf := raw.(*policyAuthorizerRadixLeaf).resolve(ctx)
 		decision := Default
 
 		if leaf.prefix != nil {
@@ -447,9 +521,9 @@ func (authz *policyAuthorizer) anyAllowed(tree *radix.Tree, requiredPermission A
 	})
 }
 
-func (authz *policyAuthorizer) allAllowed(tree *radix.Tree, requiredPermission AccessLevel) EnforcementDecision {
+func (authz *policyAuthorizer) allAllowed(tree *radix.Tree, requiredPermission AccessLevel, ctx *AuthorizerContext) EnforcementDecision {
 	return allAllowed(tree, func(raw interface{}, prefixOnly bool) EnforcementDecision {
-		leaf := raw.(*policyAuthorizerRadixLeaf)
+		leaf := raw.(*policyAuthorizerRadixLeaf).resolve(ctx)
 		prefixDecision := Default
 
 		if leaf.prefix != nil {
@@ -490,8 +564,8 @@ func (p *policyAuthorizer) ACLWrite(*AuthorizerContext) EnforcementDecision {
 
 // AgentRead checks for permission to read from agent endpoints for a given
 // node.
-func (p *policyAuthorizer) AgentRead(node string, _ *AuthorizerContext) EnforcementDecision {
-	if rule, ok := getPolicy(node, p.agentRules); ok {
+func (p *policyAuthorizer) AgentRead(node string, ctx *AuthorizerContext) EnforcementDecision {
+	if rule, ok := getPolicy(node, p.agentRules, ctx); ok {
 		return enforce(rule.access, AccessRead)
 	}
 	return Default
@@ -499,8 +573,8 @@ func (p *policyAuthorizer) AgentRead(node string, _ *AuthorizerContext) Enforcem
 
 // AgentWrite checks for permission to make changes via agent endpoints for a
 // given node.
-func (p *policyAuthorizer) AgentWrite(node string, _ *AuthorizerContext) EnforcementDecision {
-	if rule, ok := getPolicy(node, p.agentRules); ok {
+func (p *policyAuthorizer) AgentWrite(node string, ctx *AuthorizerContext) EnforcementDecision {
+	if rule, ok := getPolicy(node, p.agentRules, ctx); ok {
 		return enforce(rule.access, AccessWrite)
 	}
 	return Default
@@ -516,8 +590,8 @@ func (p *policyAuthorizer) Snapshot(_ *AuthorizerContext) EnforcementDecision {
 
 // EventRead is used to determine if the policy allows for a
 // specific user event to be read.
-func (p *policyAuthorizer) EventRead(name string, _ *AuthorizerContext) EnforcementDecision {
-	if rule, ok := getPolicy(name, p.eventRules); ok {
+func (p *policyAuthorizer) EventRead(name string, ctx *AuthorizerContext) EnforcementDecision {
+	if rule, ok := getPolicy(name, p.eventRules, ctx); ok {
 		return enforce(rule.access, AccessRead)
 	}
 	return Default
@@ -525,8 +599,8 @@ func (p *policyAuthorizer) EventRead(name string, _ *AuthorizerContext) Enforcem
 
 // EventWrite is used to determine if new events can be created
 // (fired) by the policy.
-func (p *policyAuthorizer) EventWrite(name string, _ *AuthorizerContext) EnforcementDecision {
-	if rule, ok := getPolicy(name, p.eventRules); ok {
+func (p *policyAuthorizer) EventWrite(name string, ctx *AuthorizerContext) EnforcementDecision {
+	if rule, ok := getPolicy(name, p.eventRules, ctx); ok {
 		return enforce(rule.access, AccessWrite)
 	}
 	return Default
@@ -540,12 +614,12 @@ func (p *policyAuthorizer) IntentionDefaultAllow(_ *AuthorizerContext) Enforceme
 }
 
 // IntentionRead checks if reading an intention is allowed.
-func (p *policyAuthorizer) IntentionRead(prefix string, _ *AuthorizerContext) EnforcementDecision {
+func (p *policyAuthorizer) IntentionRead(prefix string, ctx *AuthorizerContext) EnforcementDecision {
 	if prefix == "*" {
-		return p.anyAllowed(p.intentionRules, AccessRead)
+		return p.anyAllowed(p.intentionRules, AccessRead, ctx)
 	}
 
-	if rule, ok := getPolicy(prefix, p.intentionRules); ok {
+	if rule, ok := getPolicy(prefix, p.intentionRules, ctx); ok {
 		return enforce(rule.access, AccessRead)
 	}
 	return Default
@@ -553,24 +627,24 @@ func (p *policyAuthorizer) IntentionRead(prefix string, _ *AuthorizerContext) En
 
 // IntentionWrite checks if writing (creating, updating, or deleting) of an
 // intention is allowed.
-func (p *policyAuthorizer) IntentionWrite(prefix string, _ *AuthorizerContext) EnforcementDecision {
+func (p *policyAuthorizer) IntentionWrite(prefix string, ctx *AuthorizerContext) EnforcementDecision {
 	if prefix == "*" {
-		return p.allAllowed(p.intentionRules, AccessWrite)
+		return p.allAllowed(p.intentionRules, AccessWrite, ctx)
 	}
 
-	if rule, ok := getPolicy(prefix, p.intentionRules); ok {
+	if rule, ok := getPolicy(prefix, p.intentionRules, ctx); ok {
 		return enforce(rule.access, AccessWrite)
 	}
 	return Default
 }
 
 // TrafficPermissionsRead checks if reading of traffic permissions is allowed.
-func (p *policyAuthorizer) TrafficPermissionsRead(prefix string, _ *AuthorizerContext) EnforcementDecision {
+func (p *policyAuthorizer) TrafficPermissionsRead(prefix string, ctx *AuthorizerContext) EnforcementDecision {
 	if prefix == "*" {
-		return p.anyAllowed(p.trafficPermissionsRules, AccessRead)
+		return p.anyAllowed(p.trafficPermissionsRules, AccessRead, ctx)
 	}
 
-	if rule, ok := getPolicy(prefix, p.trafficPermissionsRules); ok {
+	if rule, ok := getPolicy(prefix, p.trafficPermissionsRules, ctx); ok {
 		return enforce(rule.access, AccessRead)
 	}
 	return Default
@@ -578,39 +652,39 @@ func (p *policyAuthorizer) TrafficPermissionsRead(prefix string, _ *AuthorizerCo
 
 // TrafficPermissionsWrite checks if writing (creating, updating, or deleting) of traffic
 // permissions is allowed.
-func (p *policyAuthorizer) TrafficPermissionsWrite(prefix string, _ *AuthorizerContext) EnforcementDecision {
+func (p *policyAuthorizer) TrafficPermissionsWrite(prefix string, ctx *AuthorizerContext) EnforcementDecision {
 	if prefix == "*" {
-		return p.allAllowed(p.trafficPermissionsRules, AccessWrite)
+		return p.allAllowed(p.trafficPermissionsRules, AccessWrite, ctx)
 	}
 
-	if rule, ok := getPolicy(prefix, p.trafficPermissionsRules); ok {
+	if rule, ok := getPolicy(prefix, p.trafficPermissionsRules, ctx); ok {
 		return enforce(rule.access, AccessWrite)
 	}
 	return Default
 }
 
 // KeyRead returns if a key is allowed to be read
-func (p *policyAuthorizer) KeyRead(key string, _ *AuthorizerContext) EnforcementDecision {
-	if rule, ok := getPolicy(key, p.keyRules); ok {
+func (p *policyAuthorizer) KeyRead(key string, ctx *AuthorizerContext) EnforcementDecision {
+	if rule, ok := getPolicy(key, p.keyRules, ctx); ok {
 		return enforce(rule.access, AccessRead)
 	}
 	return Default
 }
 
 // KeyList returns if a key is allowed to be listed
-func (p *policyAuthorizer) KeyList(key string, _ *AuthorizerContext) EnforcementDecision {
-	if rule, ok := getPolicy(key, p.keyRules); ok {
+func (p *policyAuthorizer) KeyList(key string, ctx *AuthorizerContext) EnforcementDecision {
+	if rule, ok := getPolicy(key, p.keyRules, ctx); ok {
 		return enforce(rule.access, AccessList)
 	}
 	return Default
 }
 
 // KeyWrite returns if a key is allowed to be written
-func (p *policyAuthorizer) KeyWrite(key string, entCtx *AuthorizerContext) EnforcementDecision {
-	if rule, ok := getPolicy(key, p.keyRules); ok {
+func (p *policyAuthorizer) KeyWrite(key string, ctx *AuthorizerContext) EnforcementDecision {
+	if rule, ok := getPolicy(key, p.keyRules, ctx); ok {
 		decision := enforce(rule.access, AccessWrite)
 		if decision == Allow {
-			return defaultIsAllow(p.enterprisePolicyAuthorizer.enforce(&rule.EnterpriseRule, entCtx))
+			return defaultIsAllow(p.enterprisePolicyAuthorizer.enforce(&rule.EnterpriseRule, ctx))
 		}
 		return decision
 	}
@@ -623,7 +697,7 @@ func (p *policyAuthorizer) KeyWrite(key string, entCtx *AuthorizerContext) Enfor
 // the KV can be removed. For that reason we must be able to
 // delete everything under the prefix. First we must have "write"
 // on the prefix itself
-func (p *policyAuthorizer) KeyWritePrefix(prefix string, _ *AuthorizerContext) EnforcementDecision {
+func (p *policyAuthorizer) KeyWritePrefix(prefix string, ctx *AuthorizerContext) EnforcementDecision {
 	// Conditions for Allow:
 	//   * The longest prefix match rule that would apply to the given prefix
 	//     grants AccessWrite
@@ -650,7 +724,7 @@ func (p *policyAuthorizer) KeyWritePrefix(prefix string, _ *AuthorizerContext) E
 	// WalkPath starts at the root and walks down to the given prefix.
 	// Therefore the last prefix rule we see is the one that matters
 	p.keyRules.WalkPath(prefix, func(path string, leaf interface{}) bool {
-		rule := leaf.(*policyAuthorizerRadixLeaf)
+		rule := leaf.(*policyAuthorizerRadixLeaf).resolve(ctx)
 
 		if rule.prefix != nil {
 			if rule.prefix.access != AccessWrite {
@@ -673,7 +747,7 @@ func (p *policyAuthorizer) KeyWritePrefix(prefix string, _ *AuthorizerContext) E
 	// into account both prefix and exact match rules.
 	withinPrefixAccess := Default
 	p.keyRules.WalkPrefix(prefix, func(path string, leaf interface{}) bool {
-		rule := leaf.(*policyAuthorizerRadixLeaf)
+		rule := leaf.(*policyAuthorizerRadixLeaf).resolve(ctx)
 
 		if rule.prefix != nil && rule.prefix.access != AccessWrite {
 			withinPrefixAccess = Deny
@@ -784,19 +858,19 @@ func (p *policyAuthorizer) NodeRead(name string, ctx *AuthorizerContext) Enforce
 		}
 		return p.NodeReadAll(nil)
 	}
-	if rule, ok := getPolicy(name, p.nodeRules); ok {
+	if rule, ok := getPolicy(name, p.nodeRules, ctx); ok {
 		return enforce(rule.access, AccessRead)
 	}
 	return Default
 }
 
-func (p *policyAuthorizer) NodeReadAll(_ *AuthorizerContext) EnforcementDecision {
-	return p.allAllowed(p.nodeRules, AccessRead)
+func (p *policyAuthorizer) NodeReadAll(ctx *AuthorizerContext) EnforcementDecision {
+	return p.allAllowed(p.nodeRules, AccessRead, ctx)
 }
 
 // NodeWrite checks if writing (registering) a node is allowed
-func (p *policyAuthorizer) NodeWrite(name string, _ *AuthorizerContext) EnforcementDecision {
-	if rule, ok := getPolicy(name, p.nodeRules); ok {
+func (p *policyAuthorizer) NodeWrite(name string, ctx *AuthorizerContext) EnforcementDecision {
+	if rule, ok := getPolicy(name, p.nodeRules, ctx); ok {
 		return enforce(rule.access, AccessWrite)
 	}
 	return Default
@@ -804,8 +878,8 @@ func (p *policyAuthorizer) NodeWrite(name string, _ *AuthorizerContext) Enforcem
 
 // PreparedQueryRead checks if reading (listing) of a prepared query is
 // allowed - this isn't execution, just listing its contents.
-func (p *policyAuthorizer) PreparedQueryRead(prefix string, _ *AuthorizerContext) EnforcementDecision {
-	if rule, ok := getPolicy(prefix, p.preparedQueryRules); ok {
+func (p *policyAuthorizer) PreparedQueryRead(prefix string, ctx *AuthorizerContext) EnforcementDecision {
+	if rule, ok := getPolicy(prefix, p.preparedQueryRules, ctx); ok {
 		return enforce(rule.access, AccessRead)
 	}
 	return Default
@@ -813,8 +887,8 @@ func (p *policyAuthorizer) PreparedQueryRead(prefix string, _ *AuthorizerContext
 
 // PreparedQueryWrite checks if writing (creating, updating, or deleting) of a
 // prepared query is allowed.
-func (p *policyAuthorizer) PreparedQueryWrite(prefix string, _ *AuthorizerContext) EnforcementDecision {
-	if rule, ok := getPolicy(prefix, p.preparedQueryRules); ok {
+func (p *policyAuthorizer) PreparedQueryWrite(prefix string, ctx *AuthorizerContext) EnforcementDecision {
+	if rule, ok := getPolicy(prefix, p.preparedQueryRules, ctx); ok {
 		return enforce(rule.access, AccessWrite)
 	}
 	return Default
@@ -833,14 +907,14 @@ func (p *policyAuthorizer) ServiceRead(name string, ctx *AuthorizerContext) Enfo
 		}
 		return p.ServiceReadAll(nil)
 	}
-	if rule, ok := getPolicy(name, p.serviceRules); ok {
+	if rule, ok := getPolicy(name, p.serviceRules, ctx); ok {
 		return enforce(rule.access, AccessRead)
 	}
 	return Default
 }
 
-func (p *policyAuthorizer) ServiceReadAll(_ *AuthorizerContext) EnforcementDecision {
-	return p.allAllowed(p.serviceRules, AccessRead)
+func (p *policyAuthorizer) ServiceReadAll(ctx *AuthorizerContext) EnforcementDecision {
+	return p.allAllowed(p.serviceRules, AccessRead, ctx)
 }
 
 // ServiceReadPrefix determines whether service read is allowed within the given prefix.
@@ -849,14 +923,14 @@ func (p *policyAuthorizer) ServiceReadAll(_ *AuthorizerContext) EnforcementDecis
 // - There's a read policy for the longest prefix that's shorter or equal to the provided prefix.
 // - There's no deny policy for any prefix that's longer than the given prefix.
 // - There's no deny policy for any exact match that's within the given prefix.
-func (p *policyAuthorizer) ServiceReadPrefix(prefix string, _ *AuthorizerContext) EnforcementDecision {
+func (p *policyAuthorizer) ServiceReadPrefix(prefix string, ctx *AuthorizerContext) EnforcementDecision {
 	access := Default
 
 	// 1. Walk the prefix tree from root to the given prefix. Find the longest prefix matching ours,
 	//    and use that policy to determine our access as that is the most specific prefix, and it
 	//    should take precedence.
 	p.serviceRules.WalkPath(prefix, func(path string, leaf interface{}) bool {
-		rule := leaf.(*policyAuthorizerRadixLeaf)
+		rule := leaf.(*policyAuthorizerRadixLeaf).resolve(ctx)
 
 		if rule.prefix != nil {
 			switch rule.prefix.access {
@@ -875,7 +949,7 @@ func (p *policyAuthorizer) ServiceReadPrefix(prefix string, _ *AuthorizerContext
 	// 2. Check rules "below" the given prefix. Access is allowed if there's no deny policy
 	//    for any prefix longer than ours or for any exact match that's within the prefix.
 	p.serviceRules.WalkPrefix(prefix, func(path string, leaf interface{}) bool {
-		rule := leaf.(*policyAuthorizerRadixLeaf)
+		rule := leaf.(*policyAuthorizerRadixLeaf).resolve(ctx)
 
 		if rule.prefix != nil && (rule.prefix.access != AccessRead && rule.prefix.access != AccessWrite) {
 			// If any prefix longer than the provided prefix has "deny" policy, then access is denied.
@@ -900,29 +974,29 @@ func (p *policyAuthorizer) ServiceReadPrefix(prefix string, _ *AuthorizerContext
 }
 
 // ServiceWrite checks if writing (registering) a service is allowed
-func (p *policyAuthorizer) ServiceWrite(name string, _ *AuthorizerContext) EnforcementDecision {
-	if rule, ok := getPolicy(name, p.serviceRules); ok {
+func (p *policyAuthorizer) ServiceWrite(name string, ctx *AuthorizerContext) EnforcementDecision {
+	if rule, ok := getPolicy(name, p.serviceRules, ctx); ok {
 		return enforce(rule.access, AccessWrite)
 	}
 	return Default
 }
 
-func (p *policyAuthorizer) ServiceWriteAny(_ *AuthorizerContext) EnforcementDecision {
-	return p.anyAllowed(p.serviceRules, AccessWrite)
+func (p *policyAuthorizer) ServiceWriteAny(ctx *AuthorizerContext) EnforcementDecision {
+	return p.anyAllowed(p.serviceRules, AccessWrite, ctx)
 }
 
 // SessionRead checks for permission to read sessions for a given node.
-func (p *policyAuthorizer) SessionRead(node string, _ *AuthorizerContext) EnforcementDecision {
-	if rule, ok := getPolicy(node, p.sessionRules); ok {
+func (p *policyAuthorizer) SessionRead(node string, ctx *AuthorizerContext) EnforcementDecision {
+	if rule, ok := getPolicy(node, p.sessionRules, ctx); ok {
 		return enforce(rule.access, AccessRead)
 	}
 	return Default
 }
 
 // SessionWrite checks for permission to create sessions for a given node.
-func (p *policyAuthorizer) SessionWrite(node string, _ *AuthorizerContext) EnforcementDecision {
+func (p *policyAuthorizer) SessionWrite(node string, ctx *AuthorizerContext) EnforcementDecision {
 	// Check for an exact rule or catch-all
-	if rule, ok := getPolicy(node, p.sessionRules); ok {
+	if rule, ok := getPolicy(node, p.sessionRules, ctx); ok {
 		return enforce(rule.access, AccessWrite)
 	}
 	return Default
@@ -5,7 +5,9 @@ package acl
 
 import (
 	"fmt"
+	"net"
 	"testing"
+	"time"
 
 	"github.com/armon/go-radix"
 	"github.com/stretchr/testify/require"
@@ -739,6 +741,182 @@ func TestPolicyAuthorizer(t *testing.T) {
 				{name: "ServiceReadPrefixDenied", prefix: "foo", check: checkDenyServiceReadPrefix},
 			},
 		},
+		"ConditionsMatched": {
+			policy: &Policy{PolicyRules: PolicyRules{
+				Services: []*ServiceRule{
+					{
+						Name:   "web",
+						Policy: PolicyRead,
+					},
+					{
+						Name:      "web",
+						Policy:    PolicyWrite,
+						Condition: &RuleCondition{NodeMeta: map[string]string{"env": "dev"}},
+					},
+				},
+				KeyPrefixes: []*KeyRule{
+					{
+						Prefix: "app/",
+						Policy: PolicyWrite,
+						Condition: &RuleCondition{
+							SourceCIDRs: []string{"10.0.0.0/8"},
+							TimeWindow: &TimeWindow{
+								Start: "09:00",
+								End:   "17:00",
+								Days:  []string{"mon", "tue", "wed", "thu", "fri"},
+							},
+						},
+					},
+				},
+				Nodes: []*NodeRule{
+					{
+						Name:      "db",
+						Policy:    PolicyWrite,
+						Condition: &RuleCondition{TimeWindow: &TimeWindow{Start: "22:00", End: "02:00"}},
+					},
+				},
+			}},
+			authzContext: &AuthorizerContext{
+				SourceIP: net.ParseIP("10.1.2.3"),
+				NodeMeta: map[string]string{"env": "dev", "rack": "a"},
+				Now:      time.Date(2023, time.January, 2, 10, 0, 0, 0, time.UTC),
+			},
+			checks: []aclCheck{
+				{name: "ServiceWriteAllowed", prefix: "web", check: checkAllowServiceWrite},
+				{name: "KeyWriteAllowed", prefix: "app/foo", check: checkAllowKeyWrite},
+				{name: "KeyWriteOutsidePrefix", prefix: "other", check: checkDefaultKeyWrite},
+				{name: "NodeWriteOutsideWindow", prefix: "db", check: checkDefaultNodeWrite},
+			},
+		},
+		"ConditionsNotMatched": {
+			policy: &Policy{PolicyRules: PolicyRules{
+				Services: []*ServiceRule{
+					{
+						Name:   "web",
+						Policy: PolicyRead,
+					},
+					{
+						Name:      "web",
+						Policy:    PolicyWrite,
+						Condition: &RuleCondition{NodeMeta: map[string]string{"env": "dev"}},
+					},
+				},
+				KeyPrefixes: []*KeyRule{
+					{
+						Prefix: "app/",
+						Policy: PolicyWrite,
+						Condition: &RuleCondition{
+							SourceCIDRs: []string{"10.0.0.0/8"},
+							TimeWindow: &TimeWindow{
+								Start: "09:00",
+								End:   "17:00",
+								Days:  []string{"mon", "tue", "wed", "thu", "fri"},
+							},
+						},
+					},
+				},
+				Nodes: []*NodeRule{
+					{
+						Name:      "db",
+						Policy:    PolicyWrite,
+						Condition: &RuleCondition{TimeWindow: &TimeWindow{Start: "22:00", End: "02:00"}},
+					},
+				},
+			}},
+			authzContext: &AuthorizerContext{
+				SourceIP: net.ParseIP("192.168.1.1"),
+				NodeMeta: map[string]string{"env": "prod"},
+				Now:      time.Date(2023, time.January, 7, 10, 0, 0, 0, time.UTC),
+			},
+			checks: []aclCheck{
+				{name: "ServiceReadAllowed", prefix: "web", check: checkAllowServiceRead},
+				{name: "ServiceWriteDenied", prefix: "web", check: checkDenyServiceWrite},
+				{name: "KeyWriteDefault", prefix: "app/foo", check: checkDefaultKeyWrite},
+			},
+		},
+		"ConditionsUnknownAttributes": {
+			policy: &Policy{PolicyRules: PolicyRules{
+				Services: []*ServiceRule{
+					{
+						Name:   "web",
+						Policy: PolicyRead,
+					},
+					{
+						Name:      "web",
+						Policy:    PolicyWrite,
+						Condition: &RuleCondition{NodeMeta: map[string]string{"env": "dev"}},
+					},
+				},
+				KeyPrefixes: []*KeyRule{
+					{
+						Prefix: "app/",
+						Policy: PolicyWrite,
+						Condition: &RuleCondition{
+							SourceCIDRs: []string{"10.0.0.0/8"},
+							TimeWindow: &TimeWindow{
+								Start: "09:00",
+								End:   "17:00",
+								Days:  []string{"mon", "tue", "wed", "thu", "fri"},
+							},
+						},
+					},
+				},
+				Nodes: []*NodeRule{
+					{
+						Name:      "db",
+						Policy:    PolicyWrite,
+						Condition: &RuleCondition{TimeWindow: &TimeWindow{Start: "22:00", End: "02:00"}},
+					},
+				},
+			}},
+			checks: []aclCheck{
+				{name: "ServiceReadAllowed", prefix: "web", check: checkAllowServiceRead},
+				{name: "ServiceWriteDenied", prefix: "web", check: checkDenyServiceWrite},
+				{name: "KeyWriteDefault", prefix: "app/foo", check: checkDefaultKeyWrite},
+			},
+		},
+		"ConditionsTimeWindowSpansMidnight": {
+			policy: &Policy{PolicyRules: PolicyRules{
+				Services: []*ServiceRule{
+					{
+						Name:   "web",
+						Policy: PolicyRead,
+					},
+					{
+						Name:      "web",
+						Policy:    PolicyWrite,
+						Condition: &RuleCondition{NodeMeta: map[string]string{"env": "dev"}},
+					},
+				},
+				KeyPrefixes: []*KeyRule{
+					{
+						Prefix: "app/",
+						Policy: PolicyWrite,
+						Condition: &RuleCondition{
+							SourceCIDRs: []string{"10.0.0.0/8"},
+							TimeWindow: &TimeWindow{
+								Start: "09:00",
+								End:   "17:00",
+								Days:  []string{"mon", "tue", "wed", "thu", "fri"},
+							},
+						},
+					},
+				},
+				Nodes: []*NodeRule{
+					{
+						Name:      "db",
+						Policy:    PolicyWrite,
+						Condition: &RuleCondition{TimeWindow: &TimeWindow{Start: "22:00", End: "02:00"}},
+					},
+				},
+			}},
+			authzContext: &AuthorizerContext{
+				Now: time.Date(2023, time.January, 2, 23, 30, 0, 0, time.UTC),
+			},
+			checks: []aclCheck{
+				{name: "NodeWriteAllowed", prefix: "db", check: checkAllowNodeWrite},
+			},
+		},
 	}
 
 	for name, tcase := range cases {
@@ -1005,9 +1183,9 @@ func TestAnyAllowed(t *testing.T) {
 			}
 
 			var authz policyAuthorizer
-			require.Equal(t, tcase.readEnforcement, authz.anyAllowed(tree, AccessRead))
-			require.Equal(t, tcase.listEnforcement, authz.anyAllowed(tree, AccessList))
-			require.Equal(t, tcase.writeEnforcement, authz.anyAllowed(tree, AccessWrite))
+			require.Equal(t, tcase.readEnforcement, authz.anyAllowed(tree, AccessRead, nil))
+			require.Equal(t, tcase.listEnforcement, authz.anyAllowed(tree, AccessList, nil))
+			require.Equal(t, tcase.writeEnforcement, authz.anyAllowed(tree, AccessWrite, nil))
 		})
 	}
 }
@@ -1247,9 +1425,9 @@ func TestAllAllowed(t *testing.T) {
 			}
 
 			var authz policyAuthorizer
-			require.Equal(t, tcase.readEnforcement, authz.allAllowed(tree, AccessRead))
-			require.Equal(t, tcase.listEnforcement, authz.allAllowed(tree, AccessList))
-			require.Equal(t, tcase.writeEnforcement, authz.allAllowed(tree, AccessWrite))
+			require.Equal(t, tcase.readEnforcement, authz.allAllowed(tree, AccessRead, nil))
+			require.Equal(t, tcase.listEnforcement, authz.allAllowed(tree, AccessList, nil))
+			require.Equal(t, tcase.writeEnforcement, authz.allAllowed(tree, AccessWrite, nil))
 		})
 	}
 }
@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package acl
+
+import (
+	"net"
+	"strings"
+	"time"
+)
+
+// RuleCondition restricts a rule so that it only grants access to requests
+// whose attributes match. Every attribute that is set must match for the
+// condition to be satisfied.
+type RuleCondition struct {
+	// SourceCIDRs is a list of networks, one of which must contain the
+	// address of the client making the request.
+	SourceCIDRs []string `hcl:"source_cidrs"`
+
+	// NodeMeta is a set of key/value pairs that must all be present in the
+	// metadata of the node the request applies to.
+	NodeMeta map[string]string `hcl:"node_meta"`
+
+	// TimeWindow restricts the rule to a time of day and days of the week.
+	TimeWindow *TimeWindow `hcl:"time_window"`
+}
+
+// TimeWindow is a recurring window of time during which a rule applies.
+type TimeWindow struct {
+	// Start and End are times of day in 24 hour "15:04" format. When End is
+	// before Start the window spans midnight.
+	Start string `hcl:"start"`
+	End   string `hcl:"end"`
+
+	// Days optionally restricts the window to the given days of the week
+	// using their three letter abbreviations, e.g. "mon".
+	Days []string `hcl:"days"`
+
+	// Timezone is the IANA name of the location Start and End are expressed
+	// in. Defaults to UTC.
+	Timezone string `hcl:"timezone"`
+}
+
+const timeOfDayFormat = "15:04"
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// SourceIPOrNil returns the address of the client making the request, or nil
+// if it is not known.
+func (c *AuthorizerContext) SourceIPOrNil() net.IP {
+	if c == nil {
+		return nil
+	}
+	return c.SourceIP
+}
+
+// NodeMetaOrNil returns the metadata of the node the request applies to, or
+// nil if it is not known.
+func (c *AuthorizerContext) NodeMetaOrNil() map[string]string {
+	if c == nil {
+		return nil
+	}
+	return c.NodeMeta
+}
+
+// RequestTime returns the time the request is being authorized at.
+func (c *AuthorizerContext) RequestTime() time.Time {
+	if c == nil || c.Now.IsZero() {
+		return time.Now()
+	}
+	return c.Now
+}
+
+// ruleCondition is the compiled form of a RuleCondition.
+type ruleCondition struct {
+	sourceCIDRs []*net.IPNet
+	nodeMeta    map[string]string
+	timeWindow  *timeWindow
+}
+
+type timeWindow struct {
+	start    time.Duration
+	end      time.Duration
+	days     map[time.Weekday]struct{}
+	location *time.Location
+}
+
+// compileRuleCondition parses the attributes of a RuleCondition that has
+// already been validated by ValidateRuleCondition.
+func compileRuleCondition(cond *RuleCondition) (*ruleCondition, error) {
+	compiled := &ruleCondition{
+		nodeMeta: cond.NodeMeta,
+	}
+
+	for _, cidr := range cond.SourceCIDRs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, err
+		}
+		compiled.sourceCIDRs = append(compiled.sourceCIDRs, network)
+	}
+
+	if w := cond.TimeWindow; w != nil {
+		start, err := parseTimeOfDay(w.Start)
+		if err != nil {
+			return nil, err
+		}
+		end, err := parseTimeOfDay(w.End)
+		if err != nil {
+			return nil, err
+		}
+		location := time.UTC
+		if w.Timezone != "" {
+			if location, err = time.LoadLocation(w.Timezone); err != nil {
+				return nil, err
+			}
+		}
+		compiled.timeWindow = &timeWindow{
+			start:    start,
+			end:      end,
+			location: location,
+		}
+		if len(w.Days) > 0 {
+			compiled.timeWindow.days = make(map[time.Weekday]struct{})
+			for _, day := range w.Days {
+				compiled.timeWindow.days[weekdays[strings.ToLower(day)]] = struct{}{}
+			}
+		}
+	}
+
+	return compiled, nil
+}
+
+func parseTimeOfDay(value string) (time.Duration, error) {
+	t, err := time.Parse(timeOfDayFormat, value)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// matches returns true if the attributes of the request satisfy the condition.
+// Attributes that are not known never satisfy a condition that requires them.
+func (c *ruleCondition) matches(ctx *AuthorizerContext) bool {
+	if len(c.sourceCIDRs) > 0 {
+		ip := ctx.SourceIPOrNil()
+		if ip == nil {
+			return false
+		}
+		found := false
+		for _, network := range c.sourceCIDRs {
+			if network.Contains(ip) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if len(c.nodeMeta) > 0 {
+		meta := ctx.NodeMetaOrNil()
+		for k, v := range c.nodeMeta {
+			if actual, ok := meta[k]; !ok || actual != v {
+				return false
+			}
+		}
+	}
+
+	if c.timeWindow != nil && !c.timeWindow.contains(ctx.RequestTime()) {
+		return false
+	}
+
+	return true
+}
+
+func (w *timeWindow) contains(t time.Time) bool {
+	t = t.In(w.location)
+	if w.days != nil {
+		if _, ok := w.days[t.Weekday()]; !ok {
+			return false
+		}
+	}
+
+	offset := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+	if w.start <= w.end {
+		return offset >= w.start && offset < w.end
+	}
+	// the window spans midnight
+	return offset >= w.start || offset < w.end
+}
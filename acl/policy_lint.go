@@ -0,0 +1,336 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package acl
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// NamedPolicy is a parsed policy along with the name used to refer to it in
+// lint findings.
+type NamedPolicy struct {
+	Name   string
+	Policy *Policy
+}
+
+// PolicyLintCatalog holds the names of the services and nodes that are
+// registered in the catalog. It is used to find rules that refer to services
+// or nodes that do not exist.
+type PolicyLintCatalog struct {
+	Services []string
+	Nodes    []string
+}
+
+// PolicyLintFinding describes a rule that is likely to be a mistake.
+type PolicyLintFinding struct {
+	// Policy is the name of the policy the rule is defined in.
+	Policy string
+
+	// Resource is the type of rule, e.g. "service_prefix".
+	Resource string
+
+	// Segment is the name or prefix the rule applies to.
+	Segment string
+
+	// Message describes the problem with the rule.
+	Message string
+}
+
+func (f PolicyLintFinding) String() string {
+	return fmt.Sprintf("%s %q: %s", f.Resource, f.Segment, f.Message)
+}
+
+// lintRule is a rule of any resource type flattened into a common form.
+type lintRule struct {
+	resource    string
+	segment     string
+	access      string
+	intentions  string
+	conditional bool
+	source      string
+}
+
+type lintKey struct {
+	resource string
+	segment  string
+}
+
+func (r lintRule) key() lintKey {
+	return lintKey{resource: r.resource, segment: r.segment}
+}
+
+func (r lintRule) sameAccess(o lintRule) bool {
+	return r.access == o.access && r.intentions == o.intentions
+}
+
+// lintResourceNouns maps the prefix form of each resource to the noun used to
+// describe the things it applies to.
+var lintResourceNouns = map[string]string{
+	"agent_prefix":   "agent",
+	"event_prefix":   "event",
+	"key_prefix":     "key",
+	"node_prefix":    "node",
+	"query_prefix":   "prepared query",
+	"service_prefix": "service",
+	"session_prefix": "session",
+}
+
+func prefixResource(resource string) string {
+	if strings.HasSuffix(resource, "_prefix") {
+		return resource
+	}
+	return resource + "_prefix"
+}
+
+func flattenPolicyRules(p *PolicyRules, source string) []lintRule {
+	var rules []lintRule
+	add := func(resource, segment, access, intentions string, conditional bool) {
+		rules = append(rules, lintRule{
+			resource:    resource,
+			segment:     segment,
+			access:      access,
+			intentions:  intentions,
+			conditional: conditional,
+			source:      source,
+		})
+	}
+
+	for _, r := range p.Agents {
+		add("agent", r.Node, r.Policy, "", false)
+	}
+	for _, r := range p.AgentPrefixes {
+		add("agent_prefix", r.Node, r.Policy, "", false)
+	}
+	for _, r := range p.Events {
+		add("event", r.Event, r.Policy, "", false)
+	}
+	for _, r := range p.EventPrefixes {
+		add("event_prefix", r.Event, r.Policy, "", false)
+	}
+	for _, r := range p.Keys {
+		add("key", r.Prefix, r.Policy, "", r.Condition != nil)
+	}
+	for _, r := range p.KeyPrefixes {
+		add("key_prefix", r.Prefix, r.Policy, "", r.Condition != nil)
+	}
+	for _, r := range p.Nodes {
+		add("node", r.Name, r.Policy, "", r.Condition != nil)
+	}
+	for _, r := range p.NodePrefixes {
+		add("node_prefix", r.Name, r.Policy, "", r.Condition != nil)
+	}
+	for _, r := range p.PreparedQueries {
+		add("query", r.Prefix, r.Policy, "", false)
+	}
+	for _, r := range p.PreparedQueryPrefixes {
+		add("query_prefix", r.Prefix, r.Policy, "", false)
+	}
+	for _, r := range p.Services {
+		add("service", r.Name, r.Policy, r.Intentions, r.Condition != nil)
+	}
+	for _, r := range p.ServicePrefixes {
+		add("service_prefix", r.Name, r.Policy, r.Intentions, r.Condition != nil)
+	}
+	for _, r := range p.Sessions {
+		add("session", r.Node, r.Policy, "", false)
+	}
+	for _, r := range p.SessionPrefixes {
+		add("session_prefix", r.Node, r.Policy, "", false)
+	}
+	return rules
+}
+
+// LintPolicies reports rules in the given policies that are likely to be
+// mistakes: rules that are overridden by or duplicate other rules for the
+// same resource, rules that have no effect because an enclosing prefix rule
+// grants the same access, prefix rules that grant write access to everything
+// and, when catalog is not nil, rules for services and nodes that are not
+// registered.
+//
+// The policies are analyzed as a set, as they would be when linked to a
+// single token.
+func LintPolicies(policies []NamedPolicy, catalog *PolicyLintCatalog) []PolicyLintFinding {
+	var raw []lintRule
+	parsed := make([]*Policy, 0, len(policies))
+	for _, p := range policies {
+		raw = append(raw, flattenPolicyRules(&p.Policy.PolicyRules, p.Name)...)
+		parsed = append(parsed, p.Policy)
+	}
+
+	// The rules were flattened above as merging modifies some of them in
+	// place.
+	merged := MergePolicies(parsed)
+
+	effective := make(map[lintKey]lintRule)
+	var conditional []lintRule
+	for _, r := range flattenPolicyRules(&merged.PolicyRules, "") {
+		if r.conditional {
+			conditional = append(conditional, r)
+			continue
+		}
+		effective[r.key()] = r
+	}
+
+	var findings []PolicyLintFinding
+	report := func(r lintRule, format string, args ...interface{}) {
+		findings = append(findings, PolicyLintFinding{
+			Policy:   r.source,
+			Resource: r.resource,
+			Segment:  r.segment,
+			Message:  fmt.Sprintf(format, args...),
+		})
+	}
+
+	// contributors holds the rules that the effective rule for each
+	// resource was merged from.
+	contributors := make(map[lintKey][]lintRule)
+	for _, r := range raw {
+		if r.conditional {
+			continue
+		}
+		e := effective[r.key()]
+		contributes := r.access == e.access || (r.intentions != "" && r.intentions == e.intentions)
+		if !contributes {
+			report(r, "is overridden by a %q rule for the same resource", e.access)
+			continue
+		}
+
+		duplicate := false
+		for _, c := range contributors[r.key()] {
+			if c.sameAccess(r) {
+				duplicate = true
+				break
+			}
+		}
+		if duplicate {
+			report(r, "duplicates another rule for the same resource")
+			continue
+		}
+		contributors[r.key()] = append(contributors[r.key()], r)
+	}
+
+	for key, e := range effective {
+		enclosing, ok := enclosingPrefixRule(key, effective, conditional)
+		if !ok || !enclosing.sameAccess(e) {
+			continue
+		}
+		for _, r := range contributors[key] {
+			report(r, "grants the same access as the enclosing %s %q rule and has no effect",
+				enclosing.resource, enclosing.segment)
+		}
+	}
+
+	for _, r := range raw {
+		if r.conditional || r.segment != "" || !strings.HasSuffix(r.resource, "_prefix") {
+			continue
+		}
+		if r.access == PolicyWrite {
+			report(r, "grants write access to every %s", lintResourceNouns[r.resource])
+		}
+	}
+
+	if catalog != nil {
+		findings = append(findings, lintCatalogNames(raw, "service", catalog.Services)...)
+		findings = append(findings, lintCatalogNames(raw, "node", catalog.Nodes)...)
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		a, b := findings[i], findings[j]
+		if a.Policy != b.Policy {
+			return a.Policy < b.Policy
+		}
+		if a.Resource != b.Resource {
+			return a.Resource < b.Resource
+		}
+		return a.Segment < b.Segment
+	})
+	return findings
+}
+
+// enclosingPrefixRule returns the prefix rule that would determine access to
+// the resource identified by key if its own rule was removed. It returns false
+// if there is no such rule, or if a conditional rule sits in between and could
+// make the outcome depend on the request.
+func enclosingPrefixRule(key lintKey, effective map[lintKey]lintRule, conditional []lintRule) (lintRule, bool) {
+	prefixRes := prefixResource(key.resource)
+	isPrefix := prefixRes == key.resource
+
+	var found lintRule
+	ok := false
+	for k, r := range effective {
+		if k.resource != prefixRes || !strings.HasPrefix(key.segment, k.segment) {
+			continue
+		}
+		// A prefix rule does not enclose itself, but it does enclose an exact
+		// rule for the same name.
+		if isPrefix && k.segment == key.segment {
+			continue
+		}
+		if !ok || len(k.segment) > len(found.segment) {
+			found = r
+			ok = true
+		}
+	}
+	if !ok {
+		return lintRule{}, false
+	}
+
+	for _, c := range conditional {
+		if c.resource != prefixRes && c.resource != key.resource {
+			continue
+		}
+		if c.resource != prefixRes && c.segment != key.segment {
+			// exact rules only apply to the name they are defined for
+			continue
+		}
+		if strings.HasPrefix(key.segment, c.segment) && strings.HasPrefix(c.segment, found.segment) {
+			return lintRule{}, false
+		}
+	}
+	return found, true
+}
+
+func lintCatalogNames(rules []lintRule, resource string, names []string) []PolicyLintFinding {
+	known := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		known[name] = struct{}{}
+	}
+
+	var findings []PolicyLintFinding
+	for _, r := range rules {
+		switch r.resource {
+		case resource:
+			if _, ok := known[r.segment]; !ok {
+				findings = append(findings, PolicyLintFinding{
+					Policy:   r.source,
+					Resource: r.resource,
+					Segment:  r.segment,
+					Message:  fmt.Sprintf("no %s with this name is registered in the catalog", resource),
+				})
+			}
+		case resource + "_prefix":
+			if r.segment == "" {
+				continue
+			}
+			matched := false
+			for name := range known {
+				if strings.HasPrefix(name, r.segment) {
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				findings = append(findings, PolicyLintFinding{
+					Policy:   r.source,
+					Resource: r.resource,
+					Segment:  r.segment,
+					Message:  fmt.Sprintf("matches no %ss registered in the catalog", resource),
+				})
+			}
+		}
+	}
+	return findings
+}
@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package acl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLintPolicies(t *testing.T) {
+	type testPolicy struct {
+		name  string
+		rules string
+	}
+
+	cases := map[string]struct {
+		policies []testPolicy
+		catalog  *PolicyLintCatalog
+		expected []PolicyLintFinding
+	}{
+		"no findings": {
+			policies: []testPolicy{{
+				name: "a",
+				rules: `
+					service_prefix "" { policy = "read" }
+					service "web" { policy = "write" }
+					key_prefix "app/" { policy = "write" }
+					key_prefix "app/secret/" { policy = "deny" }`,
+			}},
+		},
+		"overridden and duplicate rules": {
+			policies: []testPolicy{
+				{
+					name: "a",
+					rules: `
+						service "web" { policy = "read" }
+						key "foo" { policy = "write" }`,
+				},
+				{
+					name: "b",
+					rules: `
+						service "web" { policy = "write" }
+						key "foo" { policy = "write" }`,
+				},
+			},
+			expected: []PolicyLintFinding{
+				{Policy: "a", Resource: "service", Segment: "web", Message: `is overridden by a "write" rule for the same resource`},
+				{Policy: "b", Resource: "key", Segment: "foo", Message: "duplicates another rule for the same resource"},
+			},
+		},
+		"redundant rules": {
+			policies: []testPolicy{{
+				name: "a",
+				rules: `
+					node_prefix "" { policy = "read" }
+					node_prefix "web-" { policy = "read" }
+					node "db" { policy = "read" }
+					node "api" { policy = "write" }`,
+			}},
+			expected: []PolicyLintFinding{
+				{Policy: "a", Resource: "node", Segment: "db", Message: `grants the same access as the enclosing node_prefix "" rule and has no effect`},
+				{Policy: "a", Resource: "node_prefix", Segment: "web-", Message: `grants the same access as the enclosing node_prefix "" rule and has no effect`},
+			},
+		},
+		"conditional rules in between": {
+			policies: []testPolicy{{
+				name: "a",
+				rules: `
+					key_prefix "" { policy = "read" }
+					key_prefix "app/" {
+						policy = "write"
+						condition { source_cidrs = ["10.0.0.0/8"] }
+					}
+					key_prefix "app/config/" { policy = "read" }`,
+			}},
+		},
+		"broad prefixes": {
+			policies: []testPolicy{{
+				name: "a",
+				rules: `
+					key_prefix "" { policy = "write" }
+					service_prefix "" { policy = "write" }
+					node_prefix "" {
+						policy = "write"
+						condition { node_meta { env = "dev" } }
+					}`,
+			}},
+			expected: []PolicyLintFinding{
+				{Policy: "a", Resource: "key_prefix", Segment: "", Message: "grants write access to every key"},
+				{Policy: "a", Resource: "service_prefix", Segment: "", Message: "grants write access to every service"},
+			},
+		},
+		"unknown catalog names": {
+			policies: []testPolicy{{
+				name: "a",
+				rules: `
+					service "web" { policy = "write" }
+					service "wbe" { policy = "write" }
+					service_prefix "api-" { policy = "read" }
+					service_prefix "db-" { policy = "read" }
+					node "node1" { policy = "write" }
+					node_prefix "edge" { policy = "read" }`,
+			}},
+			catalog: &PolicyLintCatalog{
+				Services: []string{"web", "db-primary"},
+				Nodes:    []string{"node1"},
+			},
+			expected: []PolicyLintFinding{
+				{Policy: "a", Resource: "node_prefix", Segment: "edge", Message: "matches no nodes registered in the catalog"},
+				{Policy: "a", Resource: "service", Segment: "wbe", Message: "no service with this name is registered in the catalog"},
+				{Policy: "a", Resource: "service_prefix", Segment: "api-", Message: "matches no services registered in the catalog"},
+			},
+		},
+	}
+
+	for name, tcase := range cases {
+		t.Run(name, func(t *testing.T) {
+			var policies []NamedPolicy
+			for _, p := range tcase.policies {
+				policy, err := NewPolicyFromSource(p.rules, nil, nil)
+				require.NoError(t, err)
+				policies = append(policies, NamedPolicy{Name: p.name, Policy: policy})
+			}
+
+			require.Equal(t, tcase.expected, LintPolicies(policies, tcase.catalog))
+		})
+	}
+}
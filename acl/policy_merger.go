@@ -25,6 +25,10 @@ type policyRulesMergeContext struct {
 	servicePrefixRules       map[string]*ServiceRule
 	sessionRules             map[string]*SessionRule
 	sessionPrefixRules       map[string]*SessionRule
+	// conditionalRules holds the rules that have a condition attached. These
+	// cannot be merged with other rules for the same resource as they only
+	// apply to some requests, so they are all kept.
+	conditionalRules PolicyRules
 	// namespaceRule is an enterprise-only field
 	namespaceRule string
 }
@@ -51,6 +55,7 @@ func (p *policyRulesMergeContext) init() {
 	p.servicePrefixRules = make(map[string]*ServiceRule)
 	p.sessionRules = make(map[string]*SessionRule)
 	p.sessionPrefixRules = make(map[string]*SessionRule)
+	p.conditionalRules = PolicyRules{}
 }
 
 func (p *policyRulesMergeContext) merge(policy *PolicyRules) {
@@ -143,6 +148,11 @@ func (p *policyRulesMergeContext) merge(policy *PolicyRules) {
 	}
 
 	for _, kp := range policy.Keys {
+		if kp.Condition != nil {
+			p.conditionalRules.Keys = append(p.conditionalRules.Keys, kp)
+			continue
+		}
+
 		update := true
 		if permission, found := p.keyRules[kp.Prefix]; found {
 			update = takesPrecedenceOver(kp.Policy, permission.Policy)
@@ -154,6 +164,11 @@ func (p *policyRulesMergeContext) merge(policy *PolicyRules) {
 	}
 
 	for _, kp := range policy.KeyPrefixes {
+		if kp.Condition != nil {
+			p.conditionalRules.KeyPrefixes = append(p.conditionalRules.KeyPrefixes, kp)
+			continue
+		}
+
 		update := true
 		if permission, found := p.keyPrefixRules[kp.Prefix]; found {
 			update = takesPrecedenceOver(kp.Policy, permission.Policy)
@@ -165,6 +180,11 @@ func (p *policyRulesMergeContext) merge(policy *PolicyRules) {
 	}
 
 	for _, np := range policy.Nodes {
+		if np.Condition != nil {
+			p.conditionalRules.Nodes = append(p.conditionalRules.Nodes, np)
+			continue
+		}
+
 		update := true
 		if permission, found := p.nodeRules[np.Name]; found {
 			update = takesPrecedenceOver(np.Policy, permission.Policy)
@@ -176,6 +196,11 @@ func (p *policyRulesMergeContext) merge(policy *PolicyRules) {
 	}
 
 	for _, np := range policy.NodePrefixes {
+		if np.Condition != nil {
+			p.conditionalRules.NodePrefixes = append(p.conditionalRules.NodePrefixes, np)
+			continue
+		}
+
 		update := true
 		if permission, found := p.nodePrefixRules[np.Name]; found {
 			update = takesPrecedenceOver(np.Policy, permission.Policy)
@@ -221,6 +246,11 @@ func (p *policyRulesMergeContext) merge(policy *PolicyRules) {
 	}
 
 	for _, sp := range policy.Services {
+		if sp.Condition != nil {
+			p.conditionalRules.Services = append(p.conditionalRules.Services, sp)
+			continue
+		}
+
 		existing, found := p.serviceRules[sp.Name]
 
 		if !found {
@@ -239,6 +269,11 @@ func (p *policyRulesMergeContext) merge(policy *PolicyRules) {
 	}
 
 	for _, sp := range policy.ServicePrefixes {
+		if sp.Condition != nil {
+			p.conditionalRules.ServicePrefixes = append(p.conditionalRules.ServicePrefixes, sp)
+			continue
+		}
+
 		existing, found := p.servicePrefixRules[sp.Name]
 
 		if !found {
@@ -323,21 +358,25 @@ func (p *policyRulesMergeContext) fill(merged *PolicyRules) {
 	for _, policy := range p.keyRules {
 		merged.Keys = append(merged.Keys, policy)
 	}
+	merged.Keys = append(merged.Keys, p.conditionalRules.Keys...)
 
 	merged.KeyPrefixes = []*KeyRule{}
 	for _, policy := range p.keyPrefixRules {
 		merged.KeyPrefixes = append(merged.KeyPrefixes, policy)
 	}
+	merged.KeyPrefixes = append(merged.KeyPrefixes, p.conditionalRules.KeyPrefixes...)
 
 	merged.Nodes = []*NodeRule{}
 	for _, policy := range p.nodeRules {
 		merged.Nodes = append(merged.Nodes, policy)
 	}
+	merged.Nodes = append(merged.Nodes, p.conditionalRules.Nodes...)
 
 	merged.NodePrefixes = []*NodeRule{}
 	for _, policy := range p.nodePrefixRules {
 		merged.NodePrefixes = append(merged.NodePrefixes, policy)
 	}
+	merged.NodePrefixes = append(merged.NodePrefixes, p.conditionalRules.NodePrefixes...)
 
 	merged.PreparedQueries = []*PreparedQueryRule{}
 	for _, policy := range p.preparedQueryRules {
@@ -353,11 +392,13 @@ func (p *policyRulesMergeContext) fill(merged *PolicyRules) {
 	for _, policy := range p.serviceRules {
 		merged.Services = append(merged.Services, policy)
 	}
+	merged.Services = append(merged.Services, p.conditionalRules.Services...)
 
 	merged.ServicePrefixes = []*ServiceRule{}
 	for _, policy := range p.servicePrefixRules {
 		merged.ServicePrefixes = append(merged.ServicePrefixes, policy)
 	}
+	merged.ServicePrefixes = append(merged.ServicePrefixes, p.conditionalRules.ServicePrefixes...)
 
 	merged.Sessions = []*SessionRule{}
 	for _, policy := range p.sessionRules {
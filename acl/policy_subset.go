@@ -0,0 +1,347 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package acl
+
+import (
+	"sort"
+	"strings"
+)
+
+// subsetProbeSuffix is appended to a segment to probe the access granted to
+// names under a prefix that are not matched by any exact or longer prefix
+// rule. It cannot appear in a valid resource name.
+const subsetProbeSuffix = "\x00"
+
+// subsetLevels orders the access levels checked so that errors are stable.
+var subsetLevels = []AccessLevel{AccessRead, AccessList, AccessWrite}
+
+type namedAccessCheck func(authz Authorizer, name string, ctx *AuthorizerContext) EnforcementDecision
+
+type globalAccessCheck func(authz Authorizer, ctx *AuthorizerContext) EnforcementDecision
+
+// subsetResource describes how to compare access to one type of named
+// resource.
+type subsetResource struct {
+	resource Resource
+	segments func(p *PolicyRules) []string
+	checks   map[AccessLevel]namedAccessCheck
+}
+
+var subsetResources = []subsetResource{
+	{
+		resource: ResourceAgent,
+		segments: func(p *PolicyRules) (s []string) {
+			for _, r := range p.Agents {
+				s = append(s, r.Node)
+			}
+			for _, r := range p.AgentPrefixes {
+				s = append(s, r.Node)
+			}
+			return s
+		},
+		checks: map[AccessLevel]namedAccessCheck{
+			AccessRead:  func(a Authorizer, n string, c *AuthorizerContext) EnforcementDecision { return a.AgentRead(n, c) },
+			AccessWrite: func(a Authorizer, n string, c *AuthorizerContext) EnforcementDecision { return a.AgentWrite(n, c) },
+		},
+	},
+	{
+		resource: ResourceEvent,
+		segments: func(p *PolicyRules) (s []string) {
+			for _, r := range p.Events {
+				s = append(s, r.Event)
+			}
+			for _, r := range p.EventPrefixes {
+				s = append(s, r.Event)
+			}
+			return s
+		},
+		checks: map[AccessLevel]namedAccessCheck{
+			AccessRead:  func(a Authorizer, n string, c *AuthorizerContext) EnforcementDecision { return a.EventRead(n, c) },
+			AccessWrite: func(a Authorizer, n string, c *AuthorizerContext) EnforcementDecision { return a.EventWrite(n, c) },
+		},
+	},
+	{
+		resource: ResourceKey,
+		segments: func(p *PolicyRules) (s []string) {
+			for _, r := range p.Keys {
+				s = append(s, r.Prefix)
+			}
+			for _, r := range p.KeyPrefixes {
+				s = append(s, r.Prefix)
+			}
+			return s
+		},
+		checks: map[AccessLevel]namedAccessCheck{
+			AccessRead:  func(a Authorizer, n string, c *AuthorizerContext) EnforcementDecision { return a.KeyRead(n, c) },
+			AccessList:  func(a Authorizer, n string, c *AuthorizerContext) EnforcementDecision { return a.KeyList(n, c) },
+			AccessWrite: func(a Authorizer, n string, c *AuthorizerContext) EnforcementDecision { return a.KeyWrite(n, c) },
+		},
+	},
+	{
+		resource: ResourceNode,
+		segments: func(p *PolicyRules) (s []string) {
+			for _, r := range p.Nodes {
+				s = append(s, r.Name)
+			}
+			for _, r := range p.NodePrefixes {
+				s = append(s, r.Name)
+			}
+			return s
+		},
+		checks: map[AccessLevel]namedAccessCheck{
+			AccessRead:  func(a Authorizer, n string, c *AuthorizerContext) EnforcementDecision { return a.NodeRead(n, c) },
+			AccessWrite: func(a Authorizer, n string, c *AuthorizerContext) EnforcementDecision { return a.NodeWrite(n, c) },
+		},
+	},
+	{
+		resource: ResourceQuery,
+		segments: func(p *PolicyRules) (s []string) {
+			for _, r := range p.PreparedQueries {
+				s = append(s, r.Prefix)
+			}
+			for _, r := range p.PreparedQueryPrefixes {
+				s = append(s, r.Prefix)
+			}
+			return s
+		},
+		checks: map[AccessLevel]namedAccessCheck{
+			AccessRead: func(a Authorizer, n string, c *AuthorizerContext) EnforcementDecision {
+				return a.PreparedQueryRead(n, c)
+			},
+			AccessWrite: func(a Authorizer, n string, c *AuthorizerContext) EnforcementDecision {
+				return a.PreparedQueryWrite(n, c)
+			},
+		},
+	},
+	{
+		resource: ResourceService,
+		segments: serviceSegments,
+		checks: map[AccessLevel]namedAccessCheck{
+			AccessRead:  func(a Authorizer, n string, c *AuthorizerContext) EnforcementDecision { return a.ServiceRead(n, c) },
+			AccessWrite: func(a Authorizer, n string, c *AuthorizerContext) EnforcementDecision { return a.ServiceWrite(n, c) },
+		},
+	},
+	{
+		resource: ResourceIntention,
+		segments: serviceSegments,
+		checks: map[AccessLevel]namedAccessCheck{
+			AccessRead:  func(a Authorizer, n string, c *AuthorizerContext) EnforcementDecision { return a.IntentionRead(n, c) },
+			AccessWrite: func(a Authorizer, n string, c *AuthorizerContext) EnforcementDecision { return a.IntentionWrite(n, c) },
+		},
+	},
+	{
+		resource: ResourceSession,
+		segments: func(p *PolicyRules) (s []string) {
+			for _, r := range p.Sessions {
+				s = append(s, r.Node)
+			}
+			for _, r := range p.SessionPrefixes {
+				s = append(s, r.Node)
+			}
+			return s
+		},
+		checks: map[AccessLevel]namedAccessCheck{
+			AccessRead:  func(a Authorizer, n string, c *AuthorizerContext) EnforcementDecision { return a.SessionRead(n, c) },
+			AccessWrite: func(a Authorizer, n string, c *AuthorizerContext) EnforcementDecision { return a.SessionWrite(n, c) },
+		},
+	},
+}
+
+var subsetGlobalResources = []struct {
+	resource Resource
+	checks   map[AccessLevel]globalAccessCheck
+}{
+	{ResourceACL, map[AccessLevel]globalAccessCheck{
+		AccessRead:  func(a Authorizer, c *AuthorizerContext) EnforcementDecision { return a.ACLRead(c) },
+		AccessWrite: func(a Authorizer, c *AuthorizerContext) EnforcementDecision { return a.ACLWrite(c) },
+	}},
+	{ResourceKeyring, map[AccessLevel]globalAccessCheck{
+		AccessRead:  func(a Authorizer, c *AuthorizerContext) EnforcementDecision { return a.KeyringRead(c) },
+		AccessWrite: func(a Authorizer, c *AuthorizerContext) EnforcementDecision { return a.KeyringWrite(c) },
+	}},
+	{ResourceMesh, map[AccessLevel]globalAccessCheck{
+		AccessRead:  func(a Authorizer, c *AuthorizerContext) EnforcementDecision { return a.MeshRead(c) },
+		AccessWrite: func(a Authorizer, c *AuthorizerContext) EnforcementDecision { return a.MeshWrite(c) },
+	}},
+	{ResourceOperator, map[AccessLevel]globalAccessCheck{
+		AccessRead:  func(a Authorizer, c *AuthorizerContext) EnforcementDecision { return a.OperatorRead(c) },
+		AccessWrite: func(a Authorizer, c *AuthorizerContext) EnforcementDecision { return a.OperatorWrite(c) },
+	}},
+	{ResourcePeering, map[AccessLevel]globalAccessCheck{
+		AccessRead:  func(a Authorizer, c *AuthorizerContext) EnforcementDecision { return a.PeeringRead(c) },
+		AccessWrite: func(a Authorizer, c *AuthorizerContext) EnforcementDecision { return a.PeeringWrite(c) },
+	}},
+}
+
+func serviceSegments(p *PolicyRules) (s []string) {
+	for _, r := range p.Services {
+		s = append(s, r.Name)
+	}
+	for _, r := range p.ServicePrefixes {
+		s = append(s, r.Name)
+	}
+	return s
+}
+
+// CheckPolicySubset returns a permission denied error if the child policies
+// grant any access that the parent authorizer does not.
+//
+// The authorizer compiled from the child policies is compared with parent at
+// every name where the rules of either set of policies could change the
+// outcome: each rule segment and the names directly beneath each prefix.
+// Conditions on child rules are ignored so that they are checked as if they
+// always matched, while access the parent holds only through a conditional
+// rule is treated as not granted: the child would otherwise keep it outside
+// of the source addresses and times the condition allows. Access granted to
+// the child only by a default policy is not compared.
+func CheckPolicySubset(child []*Policy, parentPolicies []*Policy, parent Authorizer, conf *Config, ctx *AuthorizerContext) error {
+	unconditional := make([]*Policy, 0, len(child))
+	for _, p := range child {
+		unconditional = append(unconditional, withoutConditions(p))
+	}
+	childAuthz, err := NewPolicyAuthorizer(unconditional, conf)
+	if err != nil {
+		return err
+	}
+
+	// A decision the parent's policies only allow through a conditional rule
+	// doesn't count, even when ctx satisfies the condition.
+	parentConditional, err := NewPolicyAuthorizer(parentPolicies, conf)
+	if err != nil {
+		return err
+	}
+	parentStripped := make([]*Policy, 0, len(parentPolicies))
+	for _, p := range parentPolicies {
+		parentStripped = append(parentStripped, withoutConditionalRules(p))
+	}
+	parentUnconditional, err := NewPolicyAuthorizer(parentStripped, conf)
+	if err != nil {
+		return err
+	}
+	parentAllows := func(check func(Authorizer) EnforcementDecision) bool {
+		if check(parentConditional) == Allow && check(parentUnconditional) != Allow {
+			return false
+		}
+		return check(parent) == Allow
+	}
+
+	for _, res := range subsetGlobalResources {
+		for _, level := range subsetLevels {
+			check, ok := res.checks[level]
+			if !ok || check(childAuthz, ctx) != Allow {
+				continue
+			}
+			if !parentAllows(func(a Authorizer) EnforcementDecision { return check(a, ctx) }) {
+				return PermissionDenied("requested token would be granted %s:%s", res.resource, level)
+			}
+		}
+	}
+
+	for _, res := range subsetResources {
+		seen := make(map[string]struct{})
+		var probes []string
+		for _, p := range append(unconditional, parentPolicies...) {
+			for _, segment := range res.segments(&p.PolicyRules) {
+				for _, probe := range []string{segment, segment + subsetProbeSuffix} {
+					if _, ok := seen[probe]; !ok {
+						seen[probe] = struct{}{}
+						probes = append(probes, probe)
+					}
+				}
+			}
+		}
+		sort.Strings(probes)
+
+		for _, name := range probes {
+			for _, level := range subsetLevels {
+				check, ok := res.checks[level]
+				if !ok || check(childAuthz, name, ctx) != Allow {
+					continue
+				}
+				if !parentAllows(func(a Authorizer) EnforcementDecision { return check(a, name, ctx) }) {
+					return PermissionDenied("requested token would be granted %s:%s on %q",
+						res.resource, level, displayProbe(name))
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// displayProbe renders a probe name for use in an error message, showing
+// names beneath a prefix with a trailing wildcard.
+func displayProbe(name string) string {
+	if strings.HasSuffix(name, subsetProbeSuffix) {
+		return strings.TrimSuffix(name, subsetProbeSuffix) + "*"
+	}
+	return name
+}
+
+// withoutConditions returns a copy of the policy with the conditions removed
+// from all rules.
+func withoutConditions(p *Policy) *Policy {
+	return copyRules(p, func(c *RuleCondition) (*RuleCondition, bool) { return nil, true })
+}
+
+// withoutConditionalRules returns a copy of the policy without the rules that
+// have a condition.
+func withoutConditionalRules(p *Policy) *Policy {
+	return copyRules(p, func(c *RuleCondition) (*RuleCondition, bool) { return c, c == nil })
+}
+
+// copyRules returns a copy of the policy with the condition of each rule
+// replaced by the one returned by fn, dropping the rules that fn does not
+// keep.
+func copyRules(p *Policy, fn func(*RuleCondition) (*RuleCondition, bool)) *Policy {
+	out := *p
+	out.Keys = make([]*KeyRule, 0, len(p.Keys))
+	for _, r := range p.Keys {
+		c := *r
+		if cond, keep := fn(r.Condition); keep {
+			c.Condition = cond
+			out.Keys = append(out.Keys, &c)
+		}
+	}
+	out.KeyPrefixes = make([]*KeyRule, 0, len(p.KeyPrefixes))
+	for _, r := range p.KeyPrefixes {
+		c := *r
+		if cond, keep := fn(r.Condition); keep {
+			c.Condition = cond
+			out.KeyPrefixes = append(out.KeyPrefixes, &c)
+		}
+	}
+	out.Nodes = make([]*NodeRule, 0, len(p.Nodes))
+	for _, r := range p.Nodes {
+		c := *r
+		if cond, keep := fn(r.Condition); keep {
+			c.Condition = cond
+			out.Nodes = append(out.Nodes, &c)
+		}
+	}
+	out.NodePrefixes = make([]*NodeRule, 0, len(p.NodePrefixes))
+	for _, r := range p.NodePrefixes {
+		c := *r
+		if cond, keep := fn(r.Condition); keep {
+			c.Condition = cond
+			out.NodePrefixes = append(out.NodePrefixes, &c)
+		}
+	}
+	out.Services = make([]*ServiceRule, 0, len(p.Services))
+	for _, r := range p.Services {
+		c := *r
+		if cond, keep := fn(r.Condition); keep {
+			c.Condition = cond
+			out.Services = append(out.Services, &c)
+		}
+	}
+	out.ServicePrefixes = make([]*ServiceRule, 0, len(p.ServicePrefixes))
+	for _, r := range p.ServicePrefixes {
+		c := *r
+		if cond, keep := fn(r.Condition); keep {
+			c.Condition = cond
+			out.ServicePrefixes = append(out.ServicePrefixes, &c)
+		}
+	}
+	return &out
+}
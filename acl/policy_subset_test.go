@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package acl
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckPolicySubset(t *testing.T) {
+	cases := map[string]struct {
+		parent string
+		child  string
+		err    string
+	}{
+		"identical": {
+			parent: `service_prefix "" { policy = "write" }`,
+			child:  `service_prefix "" { policy = "write" }`,
+		},
+		"narrower": {
+			parent: `
+				service_prefix "" { policy = "write" }
+				key_prefix "app/" { policy = "write" }`,
+			child: `
+				service "web" { policy = "read" }
+				key_prefix "app/web/" { policy = "list" }`,
+		},
+		"higher level": {
+			parent: `service "web" { policy = "read" }`,
+			child:  `service "web" { policy = "write" }`,
+			err:    `requested token would be granted service:write on "web"`,
+		},
+		"broader prefix": {
+			parent: `key_prefix "app/" { policy = "read" }`,
+			child:  `key_prefix "" { policy = "read" }`,
+			err:    `key:read on ""`,
+		},
+		"prefix over exact": {
+			parent: `node "web" { policy = "write" }`,
+			child:  `node_prefix "web" { policy = "write" }`,
+			err:    `on "web*"`,
+		},
+		"denied beneath parent prefix": {
+			parent: `
+				key_prefix "app/" { policy = "write" }
+				key_prefix "app/secret/" { policy = "deny" }`,
+			child: `key_prefix "app/" { policy = "read" }`,
+			err:   `on "app/secret/"`,
+		},
+		"global resource": {
+			parent: `keyring = "read"`,
+			child:  `keyring = "write"`,
+			err:    "requested token would be granted keyring:write",
+		},
+		"condition ignored": {
+			parent: `service "db" { policy = "read" }`,
+			child: `
+				service "db" {
+					policy = "write"
+					condition { node_meta { env = "dev" } }
+				}`,
+			err: `service:write on "db"`,
+		},
+		"parent source_cidrs condition": {
+			parent: `
+				service_prefix "" {
+					policy = "write"
+					condition { source_cidrs = ["10.0.0.0/8"] }
+				}`,
+			child: `service_prefix "" { policy = "write" }`,
+			err:   `service:read on ""`,
+		},
+		"parent time_window condition": {
+			parent: `
+				key_prefix "app/" {
+					policy = "read"
+					condition {
+						time_window {
+							start = "09:00"
+							end   = "17:00"
+						}
+					}
+				}`,
+			child: `key "app/config" { policy = "read" }`,
+			err:   `key:read on "app/config"`,
+		},
+		"parent condition on higher level": {
+			parent: `
+				service "db" { policy = "read" }
+				service "web" {
+					policy = "write"
+					condition { node_meta { env = "dev" } }
+				}`,
+			child: `service "db" { policy = "read" }`,
+		},
+		"parent condition beneath unconditional rule": {
+			parent: `
+				node_prefix "" { policy = "write" }
+				node "db" {
+					policy = "write"
+					condition { source_cidrs = ["10.0.0.0/8"] }
+				}`,
+			child: `node "db" { policy = "write" }`,
+		},
+		"intentions": {
+			parent: `service "web" { policy = "write" }`,
+			child:  `service "web" { policy = "read" intentions = "write" }`,
+			err:    `intention:write on "web"`,
+		},
+	}
+
+	for name, tcase := range cases {
+		t.Run(name, func(t *testing.T) {
+			parent, err := NewPolicyFromSource(tcase.parent, nil, nil)
+			require.NoError(t, err)
+			child, err := NewPolicyFromSource(tcase.child, nil, nil)
+			require.NoError(t, err)
+
+			// The exchange is requested from where and when every condition
+			// in the parent policy is satisfied.
+			ctx := &AuthorizerContext{
+				SourceIP: net.ParseIP("10.1.2.3"),
+				NodeMeta: map[string]string{"env": "dev"},
+				Now:      time.Date(2024, time.March, 4, 12, 0, 0, 0, time.UTC),
+			}
+			parentAuthz, err := NewPolicyAuthorizerWithDefaults(DenyAll(), []*Policy{parent}, nil)
+			require.NoError(t, err)
+
+			err = CheckPolicySubset([]*Policy{child}, []*Policy{parent}, parentAuthz, nil, ctx)
+			if tcase.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.True(t, IsErrPermissionDenied(err))
+				require.Contains(t, err.Error(), tcase.err)
+			}
+		})
+	}
+}
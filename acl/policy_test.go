@@ -480,6 +480,95 @@ func TestPolicySourceParse(t *testing.T) {
 			RulesJSON: `{ "peering": "" }`,
 			Expected:  &Policy{PolicyRules: PolicyRules{Peering: ""}},
 		},
+		{
+			Name: "Conditions",
+			Rules: `
+				service "web" {
+					policy = "write"
+					condition {
+						node_meta {
+							env = "dev"
+						}
+					}
+				}
+				key_prefix "app/" {
+					policy = "write"
+					condition {
+						source_cidrs = ["10.0.0.0/8"]
+						time_window {
+							start = "09:00"
+							end = "17:00"
+							days = ["mon", "fri"]
+							timezone = "UTC"
+						}
+					}
+				}`,
+			RulesJSON: `
+				{
+					"service": {
+						"web": {
+							"policy": "write",
+							"condition": {
+								"node_meta": {
+									"env": "dev"
+								}
+							}
+						}
+					},
+					"key_prefix": {
+						"app/": {
+							"policy": "write",
+							"condition": {
+								"source_cidrs": ["10.0.0.0/8"],
+								"time_window": {
+									"start": "09:00",
+									"end": "17:00",
+									"days": ["mon", "fri"],
+									"timezone": "UTC"
+								}
+							}
+						}
+					}
+				}`,
+			Expected: &Policy{PolicyRules: PolicyRules{
+				Services: []*ServiceRule{
+					{
+						Name:   "web",
+						Policy: PolicyWrite,
+						Condition: &RuleCondition{
+							NodeMeta: map[string]string{"env": "dev"},
+						},
+					},
+				},
+				KeyPrefixes: []*KeyRule{
+					{
+						Prefix: "app/",
+						Policy: PolicyWrite,
+						Condition: &RuleCondition{
+							SourceCIDRs: []string{"10.0.0.0/8"},
+							TimeWindow: &TimeWindow{
+								Start:    "09:00",
+								End:      "17:00",
+								Days:     []string{"mon", "fri"},
+								Timezone: "UTC",
+							},
+						},
+					},
+				},
+			}},
+		},
+		{
+			Name:      "Bad Condition - Deny",
+			Rules:     `node "foo" { policy = "deny" condition { node_meta { env = "dev" } } }`,
+			RulesJSON: `{ "node": { "foo": { "policy": "deny", "condition": { "node_meta": { "env": "dev" } } } } }`,
+			Err:       "Invalid node condition",
+		},
+		{
+			Name:      "Bad Condition - CIDR",
+			Rules:     `key "foo" { policy = "write" condition { source_cidrs = ["10.0.0.0"] } }`,
+			RulesJSON: `{ "key": { "foo": { "policy": "write", "condition": { "source_cidrs": ["10.0.0.0"] } } } }`,
+			Err:       "Invalid key condition",
+		},
 	}
 
 	for _, tc := range cases {
@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package acl
+
+import "net"
+
+// sourceIPAuthorizer supplies the address of the client making a request to
+// every enforcement decision of the Authorizer it wraps, so that conditional
+// rules with source_cidrs can be evaluated by callers that don't build an
+// AuthorizerContext themselves.
+type sourceIPAuthorizer struct {
+	authz    Authorizer
+	sourceIP net.IP
+}
+
+// WithSourceIP returns an Authorizer that evaluates every enforcement decision
+// as being made for a request from ip. An address already set on the
+// AuthorizerContext of a decision takes precedence. authz is returned as is
+// when ip is nil.
+func WithSourceIP(authz Authorizer, ip net.IP) Authorizer {
+	if ip == nil || authz == nil {
+		return authz
+	}
+	return &sourceIPAuthorizer{authz: authz, sourceIP: ip}
+}
+
+var _ Authorizer = (*sourceIPAuthorizer)(nil)
+
+func (s *sourceIPAuthorizer) context(entCtx *AuthorizerContext) *AuthorizerContext {
+	var ctx AuthorizerContext
+	if entCtx != nil {
+		ctx = *entCtx
+	}
+	if ctx.SourceIP == nil {
+		ctx.SourceIP = s.sourceIP
+	}
+	return &ctx
+}
+
+func (s *sourceIPAuthorizer) ACLRead(entCtx *AuthorizerContext) EnforcementDecision {
+	return s.authz.ACLRead(s.context(entCtx))
+}
+
+func (s *sourceIPAuthorizer) ACLWrite(entCtx *AuthorizerContext) EnforcementDecision {
+	return s.authz.ACLWrite(s.context(entCtx))
+}
+
+func (s *sourceIPAuthorizer) AgentRead(node string, entCtx *AuthorizerContext) EnforcementDecision {
+	return s.authz.AgentRead(node, s.context(entCtx))
+}
+
+func (s *sourceIPAuthorizer) AgentWrite(node string, entCtx *AuthorizerContext) EnforcementDecision {
+	return s.authz.AgentWrite(node, s.context(entCtx))
+}
+
+func (s *sourceIPAuthorizer) EventRead(name string, entCtx *AuthorizerContext) EnforcementDecision {
+	return s.authz.EventRead(name, s.context(entCtx))
+}
+
+func (s *sourceIPAuthorizer) EventWrite(name string, entCtx *AuthorizerContext) EnforcementDecision {
+	return s.authz.EventWrite(name, s.context(entCtx))
+}
+
+func (s *sourceIPAuthorizer) IntentionDefaultAllow(entCtx *AuthorizerContext) EnforcementDecision {
+	return s.authz.IntentionDefaultAllow(s.context(entCtx))
+}
+
+func (s *sourceIPAuthorizer) IntentionRead(prefix string, entCtx *AuthorizerContext) EnforcementDecision {
+	return s.authz.IntentionRead(prefix, s.context(entCtx))
+}
+
+func (s *sourceIPAuthorizer) IntentionWrite(prefix string, entCtx *AuthorizerContext) EnforcementDecision {
+	return s.authz.IntentionWrite(prefix, s.context(entCtx))
+}
+
+func (s *sourceIPAuthorizer) KeyList(keyPrefix string, entCtx *AuthorizerContext) EnforcementDecision {
+	return s.authz.KeyList(keyPrefix, s.context(entCtx))
+}
+
+func (s *sourceIPAuthorizer) KeyRead(key string, entCtx *AuthorizerContext) EnforcementDecision {
+	return s.authz.KeyRead(key, s.context(entCtx))
+}
+
+func (s *sourceIPAuthorizer) KeyWrite(key string, entCtx *AuthorizerContext) EnforcementDecision {
+	return s.authz.KeyWrite(key, s.context(entCtx))
+}
+
+func (s *sourceIPAuthorizer) KeyWritePrefix(keyPrefix string, entCtx *AuthorizerContext) EnforcementDecision {
+	return s.authz.KeyWritePrefix(keyPrefix, s.context(entCtx))
+}
+
+func (s *sourceIPAuthorizer) KeyringRead(entCtx *AuthorizerContext) EnforcementDecision {
+	return s.authz.KeyringRead(s.context(entCtx))
+}
+
+func (s *sourceIPAuthorizer) KeyringWrite(entCtx *AuthorizerContext) EnforcementDecision {
+	return s.authz.KeyringWrite(s.context(entCtx))
+}
+
+func (s *sourceIPAuthorizer) MeshRead(entCtx *AuthorizerContext) EnforcementDecision {
+	return s.authz.MeshRead(s.context(entCtx))
+}
+
+func (s *sourceIPAuthorizer) MeshWrite(entCtx *AuthorizerContext) EnforcementDecision {
+	return s.authz.MeshWrite(s.context(entCtx))
+}
+
+func (s *sourceIPAuthorizer) PeeringRead(entCtx *AuthorizerContext) EnforcementDecision {
+	return s.authz.PeeringRead(s.context(entCtx))
+}
+
+func (s *sourceIPAuthorizer) PeeringWrite(entCtx *AuthorizerContext) EnforcementDecision {
+	return s.authz.PeeringWrite(s.context(entCtx))
+}
+
+func (s *sourceIPAuthorizer) NodeRead(node string, entCtx *AuthorizerContext) EnforcementDecision {
+	return s.authz.NodeRead(node, s.context(entCtx))
+}
+
+func (s *sourceIPAuthorizer) NodeReadAll(entCtx *AuthorizerContext) EnforcementDecision {
+	return s.authz.NodeReadAll(s.context(entCtx))
+}
+
+func (s *sourceIPAuthorizer) NodeWrite(node string, entCtx *AuthorizerContext) EnforcementDecision {
+	return s.authz.NodeWrite(node, s.context(entCtx))
+}
+
+func (s *sourceIPAuthorizer) OperatorRead(entCtx *AuthorizerContext) EnforcementDecision {
+	return s.authz.OperatorRead(s.context(entCtx))
+}
+
+func (s *sourceIPAuthorizer) OperatorWrite(entCtx *AuthorizerContext) EnforcementDecision {
+	return s.authz.OperatorWrite(s.context(entCtx))
+}
+
+func (s *sourceIPAuthorizer) PreparedQueryRead(query string, entCtx *AuthorizerContext) EnforcementDecision {
+	return s.authz.PreparedQueryRead(query, s.context(entCtx))
+}
+
+func (s *sourceIPAuthorizer) PreparedQueryWrite(query string, entCtx *AuthorizerContext) EnforcementDecision {
+	return s.authz.PreparedQueryWrite(query, s.context(entCtx))
+}
+
+func (s *sourceIPAuthorizer) ServiceRead(name string, entCtx *AuthorizerContext) EnforcementDecision {
+	return s.authz.ServiceRead(name, s.context(entCtx))
+}
+
+func (s *sourceIPAuthorizer) ServiceReadAll(entCtx *AuthorizerContext) EnforcementDecision {
+	return s.authz.ServiceReadAll(s.context(entCtx))
+}
+
+func (s *sourceIPAuthorizer) ServiceReadPrefix(prefix string, entCtx *AuthorizerContext) EnforcementDecision {
+	return s.authz.ServiceReadPrefix(prefix, s.context(entCtx))
+}
+
+func (s *sourceIPAuthorizer) ServiceWrite(name string, entCtx *AuthorizerContext) EnforcementDecision {
+	return s.authz.ServiceWrite(name, s.context(entCtx))
+}
+
+func (s *sourceIPAuthorizer) ServiceWriteAny(entCtx *AuthorizerContext) EnforcementDecision {
+	return s.authz.ServiceWriteAny(s.context(entCtx))
+}
+
+func (s *sourceIPAuthorizer) SessionRead(node string, entCtx *AuthorizerContext) EnforcementDecision {
+	return s.authz.SessionRead(node, s.context(entCtx))
+}
+
+func (s *sourceIPAuthorizer) SessionWrite(node string, entCtx *AuthorizerContext) EnforcementDecision {
+	return s.authz.SessionWrite(node, s.context(entCtx))
+}
+
+func (s *sourceIPAuthorizer) Snapshot(entCtx *AuthorizerContext) EnforcementDecision {
+	return s.authz.Snapshot(s.context(entCtx))
+}
+
+func (s *sourceIPAuthorizer) TrafficPermissionsRead(prefix string, entCtx *AuthorizerContext) EnforcementDecision {
+	return s.authz.TrafficPermissionsRead(prefix, s.context(entCtx))
+}
+
+func (s *sourceIPAuthorizer) TrafficPermissionsWrite(prefix string, entCtx *AuthorizerContext) EnforcementDecision {
+	return s.authz.TrafficPermissionsWrite(prefix, s.context(entCtx))
+}
+
+func (s *sourceIPAuthorizer) ToAllowAuthorizer() AllowAuthorizer {
+	return AllowAuthorizer{Authorizer: s}
+}
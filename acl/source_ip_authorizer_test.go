@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package acl
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithSourceIP(t *testing.T) {
+	policy := &Policy{PolicyRules: PolicyRules{
+		Keys: []*KeyRule{
+			{
+				Prefix:    "app",
+				Policy:    PolicyWrite,
+				Condition: &RuleCondition{SourceCIDRs: []string{"10.0.0.0/8"}},
+			},
+		},
+	}}
+	authz, err := NewPolicyAuthorizerWithDefaults(DenyAll(), []*Policy{policy}, nil)
+	require.NoError(t, err)
+
+	require.Equal(t, Deny, authz.KeyWrite("app", nil))
+	require.Same(t, authz, WithSourceIP(authz, nil))
+
+	inside := WithSourceIP(authz, net.ParseIP("10.1.2.3"))
+	require.Equal(t, Allow, inside.KeyWrite("app", nil))
+	require.Equal(t, Allow, inside.KeyWrite("app", &AuthorizerContext{}))
+	require.NoError(t, inside.ToAllowAuthorizer().KeyWriteAllowed("app", nil))
+
+	// An address set on the context takes precedence.
+	ctx := &AuthorizerContext{SourceIP: net.ParseIP("192.168.0.1")}
+	require.Equal(t, Deny, inside.KeyWrite("app", ctx))
+	require.Equal(t, "192.168.0.1", ctx.SourceIP.String())
+
+	outside := WithSourceIP(authz, net.ParseIP("192.168.0.1"))
+	require.Equal(t, Deny, outside.KeyWrite("app", nil))
+}
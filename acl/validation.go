@@ -5,8 +5,10 @@ package acl
 
 import (
 	"fmt"
+	"net"
 	"regexp"
 	"strings"
+	"time"
 )
 
 const (
@@ -77,3 +79,58 @@ func IsValidPolicyName(name string) bool {
 func IsValidAuthMethodName(name string) bool {
 	return validAuthMethodName.MatchString(name)
 }
+
+// ValidateRuleCondition returns nil if the provided condition can be attached
+// to a rule with the given policy, otherwise a useful error is returned.
+func ValidateRuleCondition(cond *RuleCondition, policy string) error {
+	if cond == nil {
+		return nil
+	}
+
+	// Conditions narrow the requests that a rule grants access to. An
+	// unmatched deny would silently grant access instead, so it is not
+	// allowed.
+	if policy == PolicyDeny {
+		return fmt.Errorf("conditions cannot be used with a %q policy", PolicyDeny)
+	}
+
+	if len(cond.SourceCIDRs) == 0 && len(cond.NodeMeta) == 0 && cond.TimeWindow == nil {
+		return fmt.Errorf("condition must set at least one of source_cidrs, node_meta or time_window")
+	}
+
+	for _, cidr := range cond.SourceCIDRs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return fmt.Errorf("invalid source_cidrs entry %q: %v", cidr, err)
+		}
+	}
+
+	for k := range cond.NodeMeta {
+		if k == "" {
+			return fmt.Errorf("node_meta keys cannot be empty")
+		}
+	}
+
+	if w := cond.TimeWindow; w != nil {
+		if _, err := parseTimeOfDay(w.Start); err != nil {
+			return fmt.Errorf("invalid time_window start %q: must be in 24 hour HH:MM format", w.Start)
+		}
+		if _, err := parseTimeOfDay(w.End); err != nil {
+			return fmt.Errorf("invalid time_window end %q: must be in 24 hour HH:MM format", w.End)
+		}
+		if w.Start == w.End {
+			return fmt.Errorf("time_window start and end cannot be the same")
+		}
+		for _, day := range w.Days {
+			if _, ok := weekdays[strings.ToLower(day)]; !ok {
+				return fmt.Errorf("invalid time_window day %q: must be one of sun, mon, tue, wed, thu, fri or sat", day)
+			}
+		}
+		if w.Timezone != "" {
+			if _, err := time.LoadLocation(w.Timezone); err != nil {
+				return fmt.Errorf("invalid time_window timezone %q: %v", w.Timezone, err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -76,3 +76,94 @@ func Test_ValidatePolicyName(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateRuleCondition(t *testing.T) {
+	for _, tc := range []struct {
+		description string
+		cond        *RuleCondition
+		policy      string
+		err         string
+	}{
+		{
+			description: "no condition",
+			policy:      PolicyDeny,
+		},
+		{
+			description: "valid",
+			cond: &RuleCondition{
+				SourceCIDRs: []string{"10.0.0.0/8", "fd00::/8"},
+				NodeMeta:    map[string]string{"env": "dev"},
+				TimeWindow: &TimeWindow{
+					Start:    "22:00",
+					End:      "06:00",
+					Days:     []string{"Sat", "sun"},
+					Timezone: "UTC",
+				},
+			},
+			policy: PolicyWrite,
+		},
+		{
+			description: "deny",
+			cond:        &RuleCondition{NodeMeta: map[string]string{"env": "dev"}},
+			policy:      PolicyDeny,
+			err:         `conditions cannot be used with a "deny" policy`,
+		},
+		{
+			description: "empty",
+			cond:        &RuleCondition{},
+			policy:      PolicyRead,
+			err:         "condition must set at least one of",
+		},
+		{
+			description: "bad cidr",
+			cond:        &RuleCondition{SourceCIDRs: []string{"10.0.0.1"}},
+			policy:      PolicyRead,
+			err:         `invalid source_cidrs entry "10.0.0.1"`,
+		},
+		{
+			description: "empty node meta key",
+			cond:        &RuleCondition{NodeMeta: map[string]string{"": "dev"}},
+			policy:      PolicyRead,
+			err:         "node_meta keys cannot be empty",
+		},
+		{
+			description: "bad start",
+			cond:        &RuleCondition{TimeWindow: &TimeWindow{Start: "9am", End: "17:00"}},
+			policy:      PolicyRead,
+			err:         `invalid time_window start "9am"`,
+		},
+		{
+			description: "bad end",
+			cond:        &RuleCondition{TimeWindow: &TimeWindow{Start: "09:00", End: "25:00"}},
+			policy:      PolicyRead,
+			err:         `invalid time_window end "25:00"`,
+		},
+		{
+			description: "empty window",
+			cond:        &RuleCondition{TimeWindow: &TimeWindow{Start: "09:00", End: "09:00"}},
+			policy:      PolicyRead,
+			err:         "time_window start and end cannot be the same",
+		},
+		{
+			description: "bad day",
+			cond:        &RuleCondition{TimeWindow: &TimeWindow{Start: "09:00", End: "17:00", Days: []string{"monday"}}},
+			policy:      PolicyRead,
+			err:         `invalid time_window day "monday"`,
+		},
+		{
+			description: "bad timezone",
+			cond:        &RuleCondition{TimeWindow: &TimeWindow{Start: "09:00", End: "17:00", Timezone: "Nowhere/Special"}},
+			policy:      PolicyRead,
+			err:         `invalid time_window timezone "Nowhere/Special"`,
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			err := ValidateRuleCondition(tc.cond, tc.policy)
+			if tc.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.err)
+			}
+		})
+	}
+}
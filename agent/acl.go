@@ -42,8 +42,15 @@ func (a *Agent) vetServiceRegister(token string, service *structs.NodeService) e
 	return a.vetServiceRegisterWithAuthorizer(authz, service)
 }
 
+// fillLocalNodeAuthzContext sets the attributes of the local node that rule
+// conditions can be evaluated against.
+func (a *Agent) fillLocalNodeAuthzContext(ctx *acl.AuthorizerContext) {
+	ctx.NodeMeta = a.State.Metadata()
+}
+
 func (a *Agent) vetServiceRegisterWithAuthorizer(authz acl.Authorizer, service *structs.NodeService) error {
 	var authzContext acl.AuthorizerContext
+	a.fillLocalNodeAuthzContext(&authzContext)
 
 	// Vet the service itself.
 	service.FillAuthzContext(&authzContext)
@@ -73,6 +80,7 @@ func (a *Agent) vetServiceRegisterWithAuthorizer(authz acl.Authorizer, service *
 
 func (a *Agent) vetServiceUpdateWithAuthorizer(authz acl.Authorizer, serviceID structs.ServiceID) error {
 	var authzContext acl.AuthorizerContext
+	a.fillLocalNodeAuthzContext(&authzContext)
 
 	// Vet any changes based on the existing services's info.
 	if existing := a.State.Service(serviceID); existing != nil {
@@ -97,6 +105,7 @@ func (a *Agent) vetServiceUpdateWithAuthorizer(authz acl.Authorizer, serviceID s
 
 func (a *Agent) vetCheckRegisterWithAuthorizer(authz acl.Authorizer, check *structs.HealthCheck) error {
 	var authzContext acl.AuthorizerContext
+	a.fillLocalNodeAuthzContext(&authzContext)
 	check.FillAuthzContext(&authzContext)
 
 	// Vet the check itself.
@@ -131,6 +140,7 @@ func (a *Agent) vetCheckRegisterWithAuthorizer(authz acl.Authorizer, check *stru
 
 func (a *Agent) vetCheckUpdateWithAuthorizer(authz acl.Authorizer, checkID structs.CheckID) error {
 	var authzContext acl.AuthorizerContext
+	a.fillLocalNodeAuthzContext(&authzContext)
 	checkID.FillAuthzContext(&authzContext)
 
 	// Vet any changes based on the existing check's info.
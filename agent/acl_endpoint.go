@@ -377,6 +377,32 @@ func (s *HTTPHandlers) ACLTokenSelf(resp http.ResponseWriter, req *http.Request)
 	return out.Token, nil
 }
 
+func (s *HTTPHandlers) ACLTokenExchange(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	if s.checkACLDisabled() {
+		return nil, aclDisabled
+	}
+
+	args := structs.ACLTokenSetRequest{
+		Datacenter: s.agent.config.Datacenter,
+		Create:     true,
+	}
+	s.parseToken(req, &args.Token)
+	if err := s.parseEntMeta(req, &args.ACLToken.EnterpriseMeta); err != nil {
+		return nil, err
+	}
+
+	if err := s.rewordUnknownEnterpriseFieldError(lib.DecodeJSON(req.Body, &args.ACLToken)); err != nil {
+		return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: fmt.Sprintf("Token decoding failed: %v", err)}
+	}
+
+	var out structs.ACLToken
+	if err := s.agent.RPC(req.Context(), "ACL.TokenExchange", args, &out); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
+
 func (s *HTTPHandlers) ACLTokenCreate(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
 	if s.checkACLDisabled() {
 		return nil, aclDisabled
@@ -1116,7 +1142,7 @@ func (s *HTTPHandlers) ACLAuthorize(resp http.ResponseWriter, req *http.Request)
 			return nil, err
 		}
 	} else {
-		authz, err := s.agent.delegate.ResolveTokenAndDefaultMeta(request.Token, nil, nil)
+		authz, err := s.resolveTokenAndDefaultMeta(req, request.Token, nil, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -1149,7 +1175,7 @@ func (s *HTTPHandlers) ACLTemplatedPoliciesList(resp http.ResponseWriter, req *h
 
 	s.defaultMetaPartitionToAgent(&entMeta)
 	var authzContext acl.AuthorizerContext
-	authz, err := s.agent.delegate.ResolveTokenAndDefaultMeta(token, &entMeta, &authzContext)
+	authz, err := s.resolveTokenAndDefaultMeta(req, token, &entMeta, &authzContext)
 	if err != nil {
 		return nil, err
 	}
@@ -1193,7 +1219,7 @@ func (s *HTTPHandlers) ACLTemplatedPolicyRead(resp http.ResponseWriter, req *htt
 
 	s.defaultMetaPartitionToAgent(&entMeta)
 	var authzContext acl.AuthorizerContext
-	authz, err := s.agent.delegate.ResolveTokenAndDefaultMeta(token, &entMeta, &authzContext)
+	authz, err := s.resolveTokenAndDefaultMeta(req, token, &entMeta, &authzContext)
 	if err != nil {
 		return nil, err
 	}
@@ -1236,7 +1262,7 @@ func (s *HTTPHandlers) ACLTemplatedPolicyPreview(resp http.ResponseWriter, req *
 
 	s.defaultMetaPartitionToAgent(&entMeta)
 	var authzContext acl.AuthorizerContext
-	authz, err := s.agent.delegate.ResolveTokenAndDefaultMeta(token, &entMeta, &authzContext)
+	authz, err := s.resolveTokenAndDefaultMeta(req, token, &entMeta, &authzContext)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package agent
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/agent/token"
+	"github.com/hashicorp/consul/lib"
+)
+
+// tokenRotationMinter implements token.Minter using the ACL RPC endpoints.
+// Tokens are either obtained by logging in with an auth method, or created
+// with a bootstrap token as copies of the token being replaced.
+type tokenRotationMinter struct {
+	agent *Agent
+	cfg   token.RotationConfig
+}
+
+var _ token.Minter = (*tokenRotationMinter)(nil)
+
+// Mint implements token.Minter.
+func (m *tokenRotationMinter) Mint(ctx context.Context, kind token.TokenKind, current string, ttl time.Duration) (string, error) {
+	if m.cfg.AuthMethod != "" {
+		return m.login(ctx, kind)
+	}
+
+	existing, err := m.readToken(ctx, current)
+	if err != nil {
+		return "", err
+	}
+
+	args := structs.ACLTokenSetRequest{
+		Datacenter: m.agent.config.Datacenter,
+		Create:     true,
+		ACLToken: structs.ACLToken{
+			Description:       fmt.Sprintf("Rotated %s token for agent %s", token.KindName(kind), m.agent.config.NodeName),
+			Policies:          existing.Policies,
+			Roles:             existing.Roles,
+			ServiceIdentities: existing.ServiceIdentities,
+			NodeIdentities:    existing.NodeIdentities,
+			TemplatedPolicies: existing.TemplatedPolicies,
+			Local:             existing.Local,
+			ExpirationTTL:     ttl,
+			EnterpriseMeta:    existing.EnterpriseMeta,
+		},
+		WriteRequest: structs.WriteRequest{Token: m.cfg.BootstrapToken},
+	}
+	var out structs.ACLToken
+	if err := m.agent.RPC(ctx, "ACL.TokenSet", &args, &out); err != nil {
+		return "", err
+	}
+	return out.SecretID, nil
+}
+
+// Revoke implements token.Minter.
+func (m *tokenRotationMinter) Revoke(ctx context.Context, secretID string) error {
+	if m.cfg.AuthMethod != "" {
+		args := structs.ACLLogoutRequest{
+			Datacenter:   m.agent.config.Datacenter,
+			WriteRequest: structs.WriteRequest{Token: secretID},
+		}
+		var ignored bool
+		return m.agent.RPC(ctx, "ACL.Logout", &args, &ignored)
+	}
+
+	existing, err := m.readToken(ctx, secretID)
+	if err != nil {
+		return err
+	}
+
+	args := structs.ACLTokenDeleteRequest{
+		Datacenter:     m.agent.config.Datacenter,
+		TokenID:        existing.AccessorID,
+		EnterpriseMeta: existing.EnterpriseMeta,
+		WriteRequest:   structs.WriteRequest{Token: m.cfg.BootstrapToken},
+	}
+	var ignored string
+	return m.agent.RPC(ctx, "ACL.TokenDelete", &args, &ignored)
+}
+
+func (m *tokenRotationMinter) login(ctx context.Context, kind token.TokenKind) (string, error) {
+	bearer, err := os.ReadFile(m.cfg.BearerTokenFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to read bearer token file: %w", err)
+	}
+
+	args := structs.ACLLoginRequest{
+		Datacenter: m.agent.config.Datacenter,
+		Auth: &structs.ACLLoginParams{
+			AuthMethod:  m.cfg.AuthMethod,
+			BearerToken: strings.TrimSpace(string(bearer)),
+			Meta: map[string]string{
+				"consul.agent": m.agent.config.NodeName,
+				"consul.token": token.KindName(kind),
+			},
+			EnterpriseMeta: *m.agent.AgentEnterpriseMeta(),
+		},
+	}
+	var out structs.ACLToken
+	if err := m.agent.RPC(ctx, "ACL.Login", &args, &out); err != nil {
+		return "", err
+	}
+	return out.SecretID, nil
+}
+
+func (m *tokenRotationMinter) readToken(ctx context.Context, secretID string) (*structs.ACLToken, error) {
+	args := structs.ACLTokenGetRequest{
+		Datacenter:  m.agent.config.Datacenter,
+		TokenID:     secretID,
+		TokenIDType: structs.ACLTokenSecret,
+		QueryOptions: structs.QueryOptions{
+			Token: m.cfg.BootstrapToken,
+		},
+	}
+	var out structs.ACLTokenResponse
+	if err := m.agent.RPC(ctx, "ACL.TokenRead", &args, &out); err != nil {
+		return nil, err
+	}
+	if out.Token == nil {
+		return nil, fmt.Errorf("token not found")
+	}
+	return out.Token, nil
+}
+
+// startTokenRotation starts rotating the tokens configured in
+// acl.tokens.rotation until the agent is shut down.
+func (a *Agent) startTokenRotation() error {
+	cfg := a.config.ACLTokens.Rotation
+	if !a.config.ACLsEnabled || !cfg.Enabled {
+		return nil
+	}
+
+	minter := &tokenRotationMinter{agent: a, cfg: cfg}
+	rotator, err := token.NewRotator(a.tokens, minter, cfg, a.logger.Named("token-rotation"))
+	if err != nil {
+		return err
+	}
+
+	rotator.Rotated = func(kind token.TokenKind) {
+		// Anti-entropy must pick up the new token right away, the token it
+		// was using is about to be revoked.
+		if kind == token.TokenKindUser || kind == token.TokenKindAgent {
+			a.sync.SyncFull.Trigger()
+		}
+	}
+
+	go rotator.Run(&lib.StopChannelContext{StopCh: a.shutdownCh})
+	return nil
+}
@@ -889,6 +889,10 @@ func (a *Agent) Start(ctx context.Context) error {
 		return err
 	}
 
+	if err := a.startTokenRotation(); err != nil {
+		return err
+	}
+
 	// start retry join
 	go a.retryJoinLAN()
 	if a.config.ServerMode {
@@ -29,6 +29,7 @@ import (
 	"github.com/hashicorp/consul/agent/leafcert"
 	"github.com/hashicorp/consul/agent/structs"
 	token_store "github.com/hashicorp/consul/agent/token"
+	"github.com/hashicorp/consul/agent/xds"
 	"github.com/hashicorp/consul/api"
 	"github.com/hashicorp/consul/envoyextensions/xdscommon"
 	"github.com/hashicorp/consul/internal/gossip/librtt"
@@ -70,7 +71,7 @@ func (s *HTTPHandlers) AgentSelf(resp http.ResponseWriter, req *http.Request) (i
 	// Fetch the ACL token, if any, and enforce agent policy.
 	var token string
 	s.parseToken(req, &token)
-	authz, err := s.agent.delegate.ResolveTokenAndDefaultMeta(token, nil, nil)
+	authz, err := s.resolveTokenAndDefaultMeta(req, token, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -175,7 +176,7 @@ func (s *HTTPHandlers) AgentMetrics(resp http.ResponseWriter, req *http.Request)
 	// Fetch the ACL token, if any, and enforce agent policy.
 	var token string
 	s.parseToken(req, &token)
-	authz, err := s.agent.delegate.ResolveTokenAndDefaultMeta(token, nil, nil)
+	authz, err := s.resolveTokenAndDefaultMeta(req, token, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -212,7 +213,7 @@ func (s *HTTPHandlers) AgentMetricsStream(resp http.ResponseWriter, req *http.Re
 	// Fetch the ACL token, if any, and enforce agent policy.
 	var token string
 	s.parseToken(req, &token)
-	authz, err := s.agent.delegate.ResolveTokenAndDefaultMeta(token, nil, nil)
+	authz, err := s.resolveTokenAndDefaultMeta(req, token, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -265,7 +266,7 @@ func (s *HTTPHandlers) AgentReload(resp http.ResponseWriter, req *http.Request)
 	// Fetch the ACL token, if any, and enforce agent policy.
 	var token string
 	s.parseToken(req, &token)
-	authz, err := s.agent.delegate.ResolveTokenAndDefaultMeta(token, nil, nil)
+	authz, err := s.resolveTokenAndDefaultMeta(req, token, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -351,7 +352,7 @@ func (s *HTTPHandlers) AgentServices(resp http.ResponseWriter, req *http.Request
 	s.parseFilter(req, &filterExpression)
 
 	s.defaultMetaPartitionToAgent(&entMeta)
-	authz, err := s.agent.delegate.ResolveTokenAndDefaultMeta(token, &entMeta, nil)
+	authz, err := s.resolveTokenAndDefaultMeta(req, token, &entMeta, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -440,7 +441,7 @@ func (s *HTTPHandlers) AgentService(resp http.ResponseWriter, req *http.Request)
 
 	// need to resolve to default the meta
 	s.defaultMetaPartitionToAgent(&entMeta)
-	_, err := s.agent.delegate.ResolveTokenAndDefaultMeta(token, &entMeta, nil)
+	_, err := s.resolveTokenAndDefaultMeta(req, token, &entMeta, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -471,7 +472,7 @@ func (s *HTTPHandlers) AgentService(resp http.ResponseWriter, req *http.Request)
 			ws.Add(svcState.WatchCh)
 
 			// Check ACLs.
-			authz, err := s.agent.delegate.ResolveTokenAndDefaultMeta(token, nil, nil)
+			authz, err := s.resolveTokenAndDefaultMeta(req, token, nil, nil)
 			if err != nil {
 				return "", nil, err
 			}
@@ -514,7 +515,7 @@ func (s *HTTPHandlers) AgentChecks(resp http.ResponseWriter, req *http.Request)
 	}
 
 	s.defaultMetaPartitionToAgent(&entMeta)
-	authz, err := s.agent.delegate.ResolveTokenAndDefaultMeta(token, &entMeta, nil)
+	authz, err := s.resolveTokenAndDefaultMeta(req, token, &entMeta, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -678,7 +679,7 @@ func (s *HTTPHandlers) AgentJoin(resp http.ResponseWriter, req *http.Request) (i
 	// Fetch the ACL token, if any, and enforce agent policy.
 	var token string
 	s.parseToken(req, &token)
-	authz, err := s.agent.delegate.ResolveTokenAndDefaultMeta(token, nil, nil)
+	authz, err := s.resolveTokenAndDefaultMeta(req, token, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -721,7 +722,7 @@ func (s *HTTPHandlers) AgentLeave(resp http.ResponseWriter, req *http.Request) (
 	// Fetch the ACL token, if any, and enforce agent policy.
 	var token string
 	s.parseToken(req, &token)
-	authz, err := s.agent.delegate.ResolveTokenAndDefaultMeta(token, nil, nil)
+	authz, err := s.resolveTokenAndDefaultMeta(req, token, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -743,7 +744,7 @@ func (s *HTTPHandlers) AgentForceLeave(resp http.ResponseWriter, req *http.Reque
 	// Fetch the ACL token, if any, and enforce agent policy.
 	var token string
 	s.parseToken(req, &token)
-	authz, err := s.agent.delegate.ResolveTokenAndDefaultMeta(token, nil, nil)
+	authz, err := s.resolveTokenAndDefaultMeta(req, token, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -804,7 +805,7 @@ func (s *HTTPHandlers) AgentRegisterCheck(resp http.ResponseWriter, req *http.Re
 	}
 
 	s.defaultMetaPartitionToAgent(&args.EnterpriseMeta)
-	authz, err := s.agent.delegate.ResolveTokenAndDefaultMeta(token, &args.EnterpriseMeta, nil)
+	authz, err := s.resolveTokenAndDefaultMeta(req, token, &args.EnterpriseMeta, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -864,7 +865,7 @@ func (s *HTTPHandlers) AgentDeregisterCheck(resp http.ResponseWriter, req *http.
 		return nil, err
 	}
 
-	authz, err := s.agent.delegate.ResolveTokenAndDefaultMeta(token, &checkID.EnterpriseMeta, nil)
+	authz, err := s.resolveTokenAndDefaultMeta(req, token, &checkID.EnterpriseMeta, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -957,7 +958,7 @@ func (s *HTTPHandlers) agentCheckUpdate(resp http.ResponseWriter, req *http.Requ
 		return nil, err
 	}
 
-	authz, err := s.agent.delegate.ResolveTokenAndDefaultMeta(token, &cid.EnterpriseMeta, nil)
+	authz, err := s.resolveTokenAndDefaultMeta(req, token, &cid.EnterpriseMeta, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1039,7 +1040,7 @@ func (s *HTTPHandlers) AgentHealthServiceByID(resp http.ResponseWriter, req *htt
 	// need to resolve to default the meta
 	s.defaultMetaPartitionToAgent(&entMeta)
 	var authzContext acl.AuthorizerContext
-	authz, err := s.agent.delegate.ResolveTokenAndDefaultMeta(token, &entMeta, &authzContext)
+	authz, err := s.resolveTokenAndDefaultMeta(req, token, &entMeta, &authzContext)
 	if err != nil {
 		return nil, err
 	}
@@ -1098,7 +1099,7 @@ func (s *HTTPHandlers) AgentHealthServiceByName(resp http.ResponseWriter, req *h
 	s.defaultMetaPartitionToAgent(&entMeta)
 	// need to resolve to default the meta
 	var authzContext acl.AuthorizerContext
-	authz, err := s.agent.delegate.ResolveTokenAndDefaultMeta(token, &entMeta, &authzContext)
+	authz, err := s.resolveTokenAndDefaultMeta(req, token, &entMeta, &authzContext)
 	if err != nil {
 		return nil, err
 	}
@@ -1174,7 +1175,7 @@ func (s *HTTPHandlers) AgentRegisterService(resp http.ResponseWriter, req *http.
 	s.parseToken(req, &token)
 
 	s.defaultMetaPartitionToAgent(&args.EnterpriseMeta)
-	authz, err := s.agent.delegate.ResolveTokenAndDefaultMeta(token, &args.EnterpriseMeta, nil)
+	authz, err := s.resolveTokenAndDefaultMeta(req, token, &args.EnterpriseMeta, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1308,7 +1309,7 @@ func (s *HTTPHandlers) AgentDeregisterService(resp http.ResponseWriter, req *htt
 		return nil, err
 	}
 
-	authz, err := s.agent.delegate.ResolveTokenAndDefaultMeta(token, &sid.EnterpriseMeta, nil)
+	authz, err := s.resolveTokenAndDefaultMeta(req, token, &sid.EnterpriseMeta, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1361,7 +1362,7 @@ func (s *HTTPHandlers) AgentServiceMaintenance(resp http.ResponseWriter, req *ht
 		return nil, err
 	}
 
-	authz, err := s.agent.delegate.ResolveTokenAndDefaultMeta(token, &sid.EnterpriseMeta, nil)
+	authz, err := s.resolveTokenAndDefaultMeta(req, token, &sid.EnterpriseMeta, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1407,7 +1408,7 @@ func (s *HTTPHandlers) AgentNodeMaintenance(resp http.ResponseWriter, req *http.
 	var token string
 	s.parseToken(req, &token)
 
-	authz, err := s.agent.delegate.ResolveTokenAndDefaultMeta(token, nil, nil)
+	authz, err := s.resolveTokenAndDefaultMeta(req, token, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1431,7 +1432,7 @@ func (s *HTTPHandlers) AgentMonitor(resp http.ResponseWriter, req *http.Request)
 	// Fetch the ACL token, if any, and enforce agent policy.
 	var token string
 	s.parseToken(req, &token)
-	authz, err := s.agent.delegate.ResolveTokenAndDefaultMeta(token, nil, nil)
+	authz, err := s.resolveTokenAndDefaultMeta(req, token, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1512,7 +1513,7 @@ func (s *HTTPHandlers) AgentToken(resp http.ResponseWriter, req *http.Request) (
 	// Fetch the ACL token, if any, and enforce agent policy.
 	var token string
 	s.parseToken(req, &token)
-	authz, err := s.agent.delegate.ResolveTokenAndDefaultMeta(token, nil, nil)
+	authz, err := s.resolveTokenAndDefaultMeta(req, token, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1703,7 +1704,7 @@ func (s *HTTPHandlers) AgentConnectAuthorize(resp http.ResponseWriter, req *http
 	// We do this manually here since the RPC request below only verifies
 	// service:read.
 	var authzContext acl.AuthorizerContext
-	authz, err := s.agent.delegate.ResolveTokenAndDefaultMeta(token, &authReq.EnterpriseMeta, &authzContext)
+	authz, err := s.resolveTokenAndDefaultMeta(req, token, &authReq.EnterpriseMeta, &authzContext)
 	if err != nil {
 		return nil, fmt.Errorf("Could not resolve token to authorizer: %w", err)
 	}
@@ -1811,7 +1812,7 @@ func (s *HTTPHandlers) AgentHost(resp http.ResponseWriter, req *http.Request) (i
 	// Fetch the ACL token, if any, and enforce agent policy.
 	var token string
 	s.parseToken(req, &token)
-	authz, err := s.agent.delegate.ResolveTokenAndDefaultMeta(token, nil, nil)
+	authz, err := s.resolveTokenAndDefaultMeta(req, token, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1832,3 +1833,83 @@ func (s *HTTPHandlers) AgentHost(resp http.ResponseWriter, req *http.Request) (i
 func (s *HTTPHandlers) AgentVersion(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
 	return version.GetBuildInfo(), nil
 }
+
+// AgentXDSHistory
+//
+// GET /v1/agent/xds/history/:proxy_id
+//
+// Returns the most recent xDS responses the agent sent to a proxy over
+// incremental xDS, with their ACK status and the changes made to each
+// resource. Requires service:write on the proxy service, as for the xDS stream
+// itself, or agent:read when the proxy is unknown to the agent.
+func (s *HTTPHandlers) AgentXDSHistory(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	id := strings.TrimPrefix(req.URL.Path, "/v1/agent/xds/history/")
+	if id == "" {
+		return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: "Missing proxy ID"}
+	}
+
+	var token string
+	s.parseToken(req, &token)
+
+	var entMeta acl.EnterpriseMeta
+	if err := s.parseEntMetaNoWildcard(req, &entMeta); err != nil {
+		return nil, err
+	}
+	authz, err := s.resolveTokenAndDefaultMeta(req, token, &entMeta, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.validateRequestPartition(resp, &entMeta) {
+		return nil, nil
+	}
+
+	var limit int
+	if raw := req.URL.Query().Get("limit"); raw != "" {
+		limit, err = strconv.Atoi(raw)
+		if err != nil || limit < 0 {
+			return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: fmt.Sprintf("Invalid limit: %q", raw)}
+		}
+	}
+
+	sid := structs.NewServiceID(id, &entMeta)
+
+	var (
+		service string
+		history []xds.PushEntry
+		ok      bool
+	)
+	if s.agent.xdsServer != nil {
+		service, history, ok, err = s.agent.xdsServer.PushHistory.Get(sid, limit)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if service == "" {
+		if svc := s.agent.State.Service(sid); svc != nil {
+			service = svc.Service
+		}
+	}
+
+	// Authorize before revealing whether there is a history for the proxy.
+	// Proxies that are unknown to the agent require agent:read instead.
+	var authzContext acl.AuthorizerContext
+	entMeta.FillAuthzContext(&authzContext)
+	if service != "" {
+		err = authz.ToAllowAuthorizer().ServiceWriteAllowed(service, &authzContext)
+	} else {
+		err = authz.ToAllowAuthorizer().AgentReadAllowed(s.agent.config.NodeName, &authzContext)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if s.agent.xdsServer == nil {
+		return nil, HTTPError{StatusCode: http.StatusNotFound, Reason: "xDS server is not enabled on this agent"}
+	}
+	if !ok {
+		return nil, HTTPError{StatusCode: http.StatusNotFound, Reason: fmt.Sprintf("no xDS history for proxy ID: %s", sid.String())}
+	}
+
+	return history, nil
+}
@@ -4263,6 +4263,37 @@ func TestAgent_RegisterService_ACLDeny(t *testing.T) {
 	})
 }
 
+func TestAgent_RegisterService_ACLSourceCIDRs(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := NewTestAgent(t, TestACLConfig())
+	defer a.Shutdown()
+	testrpc.WaitForLeader(t, a.RPC, "dc1")
+
+	token := testCreateToken(t, a, `
+	service "test" {
+	  policy = "write"
+	  condition {
+	    source_cidrs = ["192.0.2.0/24"]
+	  }
+	}`)
+
+	register := func(remoteAddr string) int {
+		args := &structs.ServiceDefinition{Name: "test", Port: 8000}
+		req, _ := http.NewRequest("PUT", "/v1/agent/service/register", jsonReader(args))
+		req.RemoteAddr = remoteAddr
+		req.Header.Add("X-Consul-Token", token)
+		resp := httptest.NewRecorder()
+		a.srv.h.ServeHTTP(resp, req)
+		return resp.Code
+	}
+	require.Equal(t, http.StatusForbidden, register("198.51.100.1:1234"))
+	require.Equal(t, http.StatusOK, register("192.0.2.1:1234"))
+}
+
 func testAgent_RegisterService_ACLDeny(t *testing.T, extraHCL string) {
 	t.Helper()
 
@@ -8439,3 +8470,85 @@ func TestAgent_Self_Reload(t *testing.T) {
 	require.Equal(t, float64(200), val.DebugConfig["RaftSnapshotThreshold"].(float64))
 
 }
+
+func TestAgent_XDSHistory(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+
+	t.Run("invalid limit", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/v1/agent/xds/history/web-sidecar-proxy?limit=-1", nil)
+		resp := httptest.NewRecorder()
+		a.srv.h.ServeHTTP(resp, req)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+
+	t.Run("unknown proxy", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/v1/agent/xds/history/web-sidecar-proxy", nil)
+		resp := httptest.NewRecorder()
+		a.srv.h.ServeHTTP(resp, req)
+		require.Equal(t, http.StatusNotFound, resp.Code)
+		require.Contains(t, resp.Body.String(), "no xDS history for proxy ID")
+	})
+}
+
+func TestAgent_XDSHistory_ACLDeny(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	a := NewTestAgent(t, TestACLConfig())
+	defer a.Shutdown()
+	testrpc.WaitForLeader(t, a.RPC, "dc1")
+
+	proxy := &structs.NodeService{
+		Kind:    structs.ServiceKindConnectProxy,
+		ID:      "web-sidecar-proxy",
+		Service: "web-sidecar-proxy",
+		Port:    20000,
+		Proxy: structs.ConnectProxyConfig{
+			DestinationServiceName: "web",
+		},
+	}
+	require.NoError(t, a.addServiceFromSource(proxy, nil, false, "", ConfigSourceLocal))
+
+	history := func(id, token string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest("GET", "/v1/agent/xds/history/"+id, nil)
+		req.Header.Add("X-Consul-Token", token)
+		resp := httptest.NewRecorder()
+		a.srv.h.ServeHTTP(resp, req)
+		return resp
+	}
+
+	serviceWrite := testCreateToken(t, a, `
+	service "web-sidecar-proxy" {
+	  policy = "write"
+	}`)
+	agentRead := testCreateToken(t, a, `
+	agent_prefix "" {
+	  policy = "read"
+	}`)
+
+	t.Run("no token", func(t *testing.T) {
+		require.Equal(t, http.StatusForbidden, history("web-sidecar-proxy", "").Code)
+		require.Equal(t, http.StatusForbidden, history("unknown", "").Code)
+	})
+
+	t.Run("service write", func(t *testing.T) {
+		require.Equal(t, http.StatusNotFound, history("web-sidecar-proxy", serviceWrite).Code)
+		require.Equal(t, http.StatusForbidden, history("unknown", serviceWrite).Code)
+	})
+
+	t.Run("agent read", func(t *testing.T) {
+		require.Equal(t, http.StatusForbidden, history("web-sidecar-proxy", agentRead).Code)
+		require.Equal(t, http.StatusNotFound, history("unknown", agentRead).Code)
+	})
+}
@@ -115,4 +115,7 @@ type Cache interface {
 	Notify(ctx context.Context, t string, r Request, correlationID string, ch chan<- UpdateEvent) error
 }
 
-const ConnectCARootName = "connect-ca-root"
+const (
+	ConnectCARootName = "connect-ca-root"
+	ConfigEntryName   = "config-entry"
+)
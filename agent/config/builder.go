@@ -742,6 +742,15 @@ func (b *builder) build() (rt RuntimeConfig, err error) {
 			"existing_arn":   "ExistingARN",
 			"delete_on_exit": "DeleteOnExit",
 
+			// External signer CA config
+			"cross_signed_root_cert": "CrossSignedRootCert",
+			"signer":                 "Signer",
+			"root_key_file":          "RootKeyFile",
+			"pkcs11_module":          "PKCS11Module",
+			"pkcs11_token_label":     "PKCS11TokenLabel",
+			"pkcs11_pin_file":        "PKCS11PINFile",
+			"pkcs11_key_label":       "PKCS11KeyLabel",
+
 			// Common CA config
 			"leaf_cert_ttl":      "LeafCertTTL",
 			"csr_max_per_second": "CSRMaxPerSecond",
@@ -888,6 +897,7 @@ func (b *builder) build() (rt RuntimeConfig, err error) {
 			ACLReplicationToken:            stringVal(c.ACL.Tokens.Replication),
 			ACLConfigFileRegistrationToken: stringVal(c.ACL.Tokens.ConfigFileRegistration),
 			ACLDNSToken:                    stringVal(c.ACL.Tokens.DNS),
+			Rotation:                       b.tokenRotationVal(c.ACL.Tokens.Rotation),
 		},
 
 		// Autopilot
@@ -1470,10 +1480,11 @@ func (b *builder) validate(rt RuntimeConfig) error {
 
 	// Validate the given Connect CA provider config
 	validCAProviders := map[string]bool{
-		"":                       true,
-		structs.ConsulCAProvider: true,
-		structs.VaultCAProvider:  true,
-		structs.AWSCAProvider:    true,
+		"":                               true,
+		structs.ConsulCAProvider:         true,
+		structs.VaultCAProvider:          true,
+		structs.AWSCAProvider:            true,
+		structs.ExternalSignerCAProvider: true,
 	}
 	if _, ok := validCAProviders[rt.ConnectCAProvider]; !ok {
 		return fmt.Errorf("%s is not a valid CA provider", rt.ConnectCAProvider)
@@ -1491,6 +1502,10 @@ func (b *builder) validate(rt RuntimeConfig) error {
 			if _, err := ca.ParseAWSCAConfig(rt.ConnectCAConfig); err != nil {
 				return err
 			}
+		case structs.ExternalSignerCAProvider:
+			if _, err := ca.ParseExternalSignerCAConfig(rt.ConnectCAConfig, rt.PrimaryDatacenter == rt.Datacenter); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -1549,6 +1564,10 @@ func (b *builder) validate(rt RuntimeConfig) error {
 		return err
 	}
 
+	if err := validateTokenRotation(rt); err != nil {
+		return err
+	}
+
 	if err := validateRemoteScriptsChecks(rt); err != nil {
 		// TODO: make this an error in a future version
 		b.warn(err.Error())
@@ -2509,6 +2528,67 @@ func (b *builder) validateAutoConfig(rt RuntimeConfig) error {
 	return nil
 }
 
+func (b *builder) tokenRotationVal(raw TokenRotation) token.RotationConfig {
+	cfg := token.RotationConfig{
+		Enabled:         boolVal(raw.Enabled),
+		Tokens:          raw.Tokens,
+		Interval:        b.durationVal("acl.tokens.rotation.interval", raw.Interval),
+		TTL:             b.durationVal("acl.tokens.rotation.ttl", raw.TTL),
+		AuthMethod:      stringVal(raw.AuthMethod),
+		BearerTokenFile: stringVal(raw.BearerTokenFile),
+		BootstrapToken:  stringVal(raw.BootstrapToken),
+	}
+	if cfg.Interval == 0 {
+		// Rotate halfway through the lifetime of each token so there is
+		// plenty of room to retry before it expires.
+		cfg.Interval = cfg.TTL / 2
+	}
+	return cfg
+}
+
+func validateTokenRotation(rt RuntimeConfig) error {
+	rotation := rt.ACLTokens.Rotation
+
+	if !rotation.Enabled {
+		return nil
+	}
+
+	if !rt.ACLsEnabled {
+		return fmt.Errorf("acl.tokens.rotation.enabled cannot be set without enabling ACLs")
+	}
+
+	// Without persistence a restarted agent would fall back to the
+	// configured tokens, which may have been replaced and revoked.
+	if !rt.ACLTokens.EnablePersistence {
+		return fmt.Errorf("acl.tokens.rotation.enabled requires acl.enable_token_persistence to be set")
+	}
+
+	if len(rotation.Tokens) == 0 {
+		return fmt.Errorf("acl.tokens.rotation.tokens must list at least one token to rotate")
+	}
+	for _, name := range rotation.Tokens {
+		if _, ok := token.RotatableKind(name); !ok {
+			return fmt.Errorf("acl.tokens.rotation.tokens contains %q which cannot be rotated", name)
+		}
+	}
+
+	if rotation.TTL <= 0 {
+		return fmt.Errorf("acl.tokens.rotation.ttl must be set")
+	}
+	if rotation.Interval >= rotation.TTL {
+		return fmt.Errorf("acl.tokens.rotation.interval (%s) must be less than acl.tokens.rotation.ttl (%s)", rotation.Interval, rotation.TTL)
+	}
+
+	switch {
+	case rotation.AuthMethod != "" && rotation.BearerTokenFile == "":
+		return fmt.Errorf("acl.tokens.rotation.bearer_token_file must be set when using acl.tokens.rotation.auth_method")
+	case rotation.AuthMethod == "" && rotation.BootstrapToken == "":
+		return fmt.Errorf("one of acl.tokens.rotation.auth_method or acl.tokens.rotation.bootstrap_token must be set")
+	}
+
+	return nil
+}
+
 func validateAutoConfigAuthorizer(rt RuntimeConfig) error {
 	authz := rt.AutoConfig.Authorizer
 
@@ -20,6 +20,10 @@ import (
 // DeepCopy generates a deep copy of *RuntimeConfig
 func (o *RuntimeConfig) DeepCopy() *RuntimeConfig {
 	var cp RuntimeConfig = *o
+	if o.ACLTokens.Rotation.Tokens != nil {
+		cp.ACLTokens.Rotation.Tokens = make([]string, len(o.ACLTokens.Rotation.Tokens))
+		copy(cp.ACLTokens.Rotation.Tokens, o.ACLTokens.Rotation.Tokens)
+	}
 	if o.Cloud.TLSConfig != nil {
 		cp.Cloud.TLSConfig = new(tls.Config)
 		*cp.Cloud.TLSConfig = *o.Cloud.TLSConfig
@@ -787,12 +787,24 @@ type Tokens struct {
 	ConfigFileRegistration *string `mapstructure:"config_file_service_registration"`
 	DNS                    *string `mapstructure:"dns"`
 
+	Rotation TokenRotation `mapstructure:"rotation"`
+
 	// Enterprise Only
 	ManagedServiceProvider []ServiceProviderToken `mapstructure:"managed_service_provider"`
 
 	DeprecatedTokens `mapstructure:",squash"`
 }
 
+type TokenRotation struct {
+	Enabled         *bool    `mapstructure:"enabled"`
+	Tokens          []string `mapstructure:"tokens"`
+	Interval        *string  `mapstructure:"interval"`
+	TTL             *string  `mapstructure:"ttl"`
+	AuthMethod      *string  `mapstructure:"auth_method"`
+	BearerTokenFile *string  `mapstructure:"bearer_token_file"`
+	BootstrapToken  *string  `mapstructure:"bootstrap_token"`
+}
+
 type DeprecatedTokens struct {
 	// DEPRECATED (ACL) - renamed to "initial_management"
 	Master *string `mapstructure:"master"`
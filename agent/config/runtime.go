@@ -1798,8 +1798,8 @@ func (c *RuntimeConfig) IsCloudEnabled() bool {
 // isSecret determines whether a field name represents a field which
 // may contain a secret.
 func isSecret(name string) bool {
-	// special cases for AuthMethod locality and intro token file
-	if name == "TokenLocality" || name == "IntroTokenFile" {
+	// special cases for AuthMethod locality and token file paths
+	if name == "TokenLocality" || name == "IntroTokenFile" || name == "BearerTokenFile" {
 		return false
 	}
 	name = strings.ToLower(name)
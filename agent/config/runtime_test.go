@@ -1729,6 +1729,82 @@ func TestLoad_IntegrationWithFlags(t *testing.T) {
 			rt.DataDir = dataDir
 		},
 	})
+	run(t, testCase{
+		desc: "acl.tokens.rotation defaults interval to half the ttl",
+		args: []string{`-data-dir=` + dataDir},
+		json: []string{`{
+			"acl": {
+				"enabled": true,
+				"enable_token_persistence": true,
+				"tokens": {
+					"rotation": {
+						"enabled": true,
+						"tokens": ["agent", "replication"],
+						"ttl": "2h",
+						"auth_method": "minikube",
+						"bearer_token_file": "/var/run/token"
+					}
+				}
+			}
+		}`},
+		hcl: []string{`
+			acl {
+				enabled = true
+				enable_token_persistence = true
+				tokens {
+					rotation {
+						enabled = true
+						tokens = ["agent", "replication"]
+						ttl = "2h"
+						auth_method = "minikube"
+						bearer_token_file = "/var/run/token"
+					}
+				}
+			}
+		`},
+		expected: func(rt *RuntimeConfig) {
+			rt.DataDir = dataDir
+			rt.ACLsEnabled = true
+			rt.ACLResolverSettings.ACLsEnabled = true
+			rt.ACLTokens.EnablePersistence = true
+			rt.ACLTokens.Rotation = token.RotationConfig{
+				Enabled:         true,
+				Tokens:          []string{"agent", "replication"},
+				Interval:        time.Hour,
+				TTL:             2 * time.Hour,
+				AuthMethod:      "minikube",
+				BearerTokenFile: "/var/run/token",
+			}
+		},
+	})
+	run(t, testCase{
+		desc:        "acl.tokens.rotation requires persistence",
+		args:        []string{`-data-dir=` + dataDir},
+		json:        []string{`{ "acl": { "enabled": true, "tokens": { "rotation": { "enabled": true, "tokens": ["agent"], "ttl": "1h", "bootstrap_token": "a" }}}}`},
+		hcl:         []string{`acl { enabled = true tokens { rotation { enabled = true tokens = ["agent"] ttl = "1h" bootstrap_token = "a" }}}`},
+		expectedErr: "acl.tokens.rotation.enabled requires acl.enable_token_persistence to be set",
+	})
+	run(t, testCase{
+		desc:        "acl.tokens.rotation rejects unknown tokens",
+		args:        []string{`-data-dir=` + dataDir},
+		json:        []string{`{ "acl": { "enabled": true, "enable_token_persistence": true, "tokens": { "rotation": { "enabled": true, "tokens": ["agent_recovery"], "ttl": "1h", "bootstrap_token": "a" }}}}`},
+		hcl:         []string{`acl { enabled = true enable_token_persistence = true tokens { rotation { enabled = true tokens = ["agent_recovery"] ttl = "1h" bootstrap_token = "a" }}}`},
+		expectedErr: `acl.tokens.rotation.tokens contains "agent_recovery" which cannot be rotated`,
+	})
+	run(t, testCase{
+		desc:        "acl.tokens.rotation interval must be less than ttl",
+		args:        []string{`-data-dir=` + dataDir},
+		json:        []string{`{ "acl": { "enabled": true, "enable_token_persistence": true, "tokens": { "rotation": { "enabled": true, "tokens": ["agent"], "interval": "2h", "ttl": "1h", "bootstrap_token": "a" }}}}`},
+		hcl:         []string{`acl { enabled = true enable_token_persistence = true tokens { rotation { enabled = true tokens = ["agent"] interval = "2h" ttl = "1h" bootstrap_token = "a" }}}`},
+		expectedErr: "acl.tokens.rotation.interval (2h0m0s) must be less than acl.tokens.rotation.ttl (1h0m0s)",
+	})
+	run(t, testCase{
+		desc:        "acl.tokens.rotation requires a credential",
+		args:        []string{`-data-dir=` + dataDir},
+		json:        []string{`{ "acl": { "enabled": true, "enable_token_persistence": true, "tokens": { "rotation": { "enabled": true, "tokens": ["agent"], "ttl": "1h" }}}}`},
+		hcl:         []string{`acl { enabled = true enable_token_persistence = true tokens { rotation { enabled = true tokens = ["agent"] ttl = "1h" }}}`},
+		expectedErr: "one of acl.tokens.rotation.auth_method or acl.tokens.rotation.bootstrap_token must be set",
+	})
 	run(t, testCase{
 		desc: "acl_enforce_version_8 is deprecated",
 		args: []string{`-data-dir=` + dataDir},
@@ -6291,6 +6367,13 @@ func TestLoad_FullConfig(t *testing.T) {
 			ACLAgentToken:         "bed2377c",
 			ACLAgentRecoveryToken: "1dba6aba",
 			ACLReplicationToken:   "5795983a",
+			Rotation: token.RotationConfig{
+				Enabled:        true,
+				Tokens:         []string{"agent", "replication"},
+				Interval:       6 * time.Minute,
+				TTL:            15 * time.Minute,
+				BootstrapToken: "a6c5b4f2",
+			},
 		},
 
 		ACLsEnabled:       true,
@@ -30,6 +30,13 @@ acl = {
         replication = "5795983a",
         agent = "bed2377c",
         default = "418fdff1",
+        rotation = {
+            enabled = true
+            tokens = ["agent", "replication"]
+            interval = "6m"
+            ttl = "15m"
+            bootstrap_token = "a6c5b4f2"
+        },
         managed_service_provider = [
             {
                 accessor_id = "first",
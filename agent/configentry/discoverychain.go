@@ -21,6 +21,18 @@ type DiscoveryChainSet struct {
 	DefaultSamenessGroup *structs.SamenessGroupConfigEntry
 	SamenessGroups       map[string]*structs.SamenessGroupConfigEntry
 	ProxyDefaults        map[string]*structs.ProxyConfigEntry
+
+	// ImportedEntries holds the config entries exported to this cluster by
+	// each peer, keyed by peer name.
+	ImportedEntries map[string]*ImportedChainSet
+}
+
+// ImportedChainSet is the subset of discovery chain config entries that a
+// cluster peer may export alongside its services.
+type ImportedChainSet struct {
+	Routers   map[structs.ServiceID]*structs.ServiceRouterConfigEntry
+	Resolvers map[structs.ServiceID]*structs.ServiceResolverConfigEntry
+	Services  map[structs.ServiceID]*structs.ServiceConfigEntry
 }
 
 func NewDiscoveryChainSet() *DiscoveryChainSet {
@@ -32,6 +44,8 @@ func NewDiscoveryChainSet() *DiscoveryChainSet {
 		Peers:          make(map[string]*pbpeering.Peering),
 		ProxyDefaults:  make(map[string]*structs.ProxyConfigEntry),
 		SamenessGroups: make(map[string]*structs.SamenessGroupConfigEntry),
+
+		ImportedEntries: make(map[string]*ImportedChainSet),
 	}
 }
 
@@ -81,6 +95,33 @@ func (e *DiscoveryChainSet) GetProxyDefaults(partition string) *structs.ProxyCon
 	return nil
 }
 
+// GetImportedRouter returns the service-router exported by the given peer for
+// the service, if any.
+func (e *DiscoveryChainSet) GetImportedRouter(peer string, sid structs.ServiceID) *structs.ServiceRouterConfigEntry {
+	if imported := e.ImportedEntries[peer]; imported != nil {
+		return imported.Routers[sid]
+	}
+	return nil
+}
+
+// GetImportedResolver returns the service-resolver exported by the given peer
+// for the service, if any.
+func (e *DiscoveryChainSet) GetImportedResolver(peer string, sid structs.ServiceID) *structs.ServiceResolverConfigEntry {
+	if imported := e.ImportedEntries[peer]; imported != nil {
+		return imported.Resolvers[sid]
+	}
+	return nil
+}
+
+// GetImportedService returns the service-defaults exported by the given peer
+// for the service, if any.
+func (e *DiscoveryChainSet) GetImportedService(peer string, sid structs.ServiceID) *structs.ServiceConfigEntry {
+	if imported := e.ImportedEntries[peer]; imported != nil {
+		return imported.Services[sid]
+	}
+	return nil
+}
+
 // AddRouters adds router configs. Convenience function for testing.
 func (e *DiscoveryChainSet) AddRouters(entries ...*structs.ServiceRouterConfigEntry) {
 	if e.Routers == nil {
@@ -165,6 +206,34 @@ func (e *DiscoveryChainSet) AddPeers(entries ...*pbpeering.Peering) {
 	}
 }
 
+// AddImportedEntries adds config entries exported by the given peer. Entries
+// of kinds that cannot be exported are ignored.
+func (e *DiscoveryChainSet) AddImportedEntries(peer string, entries ...structs.ConfigEntry) {
+	if e.ImportedEntries == nil {
+		e.ImportedEntries = make(map[string]*ImportedChainSet)
+	}
+	imported, ok := e.ImportedEntries[peer]
+	if !ok {
+		imported = &ImportedChainSet{
+			Routers:   make(map[structs.ServiceID]*structs.ServiceRouterConfigEntry),
+			Resolvers: make(map[structs.ServiceID]*structs.ServiceResolverConfigEntry),
+			Services:  make(map[structs.ServiceID]*structs.ServiceConfigEntry),
+		}
+		e.ImportedEntries[peer] = imported
+	}
+	for _, rawEntry := range entries {
+		sid := structs.NewServiceID(rawEntry.GetName(), rawEntry.GetEnterpriseMeta())
+		switch entry := rawEntry.(type) {
+		case *structs.ServiceRouterConfigEntry:
+			imported.Routers[sid] = entry
+		case *structs.ServiceResolverConfigEntry:
+			imported.Resolvers[sid] = entry
+		case *structs.ServiceConfigEntry:
+			imported.Services[sid] = entry
+		}
+	}
+}
+
 // AddEntries adds generic configs. Convenience function for testing. Panics on
 // operator error.
 func (e *DiscoveryChainSet) AddEntries(entries ...structs.ConfigEntry) {
@@ -6,6 +6,9 @@ package ca
 import (
 	"crypto/x509"
 	"errors"
+	"time"
+
+	"github.com/hashicorp/consul/agent/connect"
 )
 
 //go:generate mockery --name Provider --inpackage
@@ -29,6 +32,59 @@ type PrimaryUsesIntermediate interface {
 	GenerateLeafSigningCert() (string, error)
 }
 
+// RevocationListSigner is an optional interface that CA providers may
+// implement to support revoking leaf certificates. Providers that don't
+// implement it can't publish certificate revocation lists.
+type RevocationListSigner interface {
+	// SignRevocationList signs the given revocation list template with the
+	// key of the active leaf signing cert and returns the PEM encoded list.
+	// The issuer of the list is the active leaf signing cert.
+	SignRevocationList(*x509.RevocationList) (string, error)
+}
+
+// OperatorCrossSigned is an optional interface that CA providers may implement
+// when their root key is kept offline. Such providers can't have the previous
+// provider cross-sign a new root during rotation without the operator, so the
+// operator supplies the cross-signed root instead.
+type OperatorCrossSigned interface {
+	// CrossSignedRootCert returns the provider's root cert signed by the
+	// previous root, or an empty string if the operator didn't supply one.
+	CrossSignedRootCert() (string, error)
+}
+
+// LeafTTLSigner is an optional interface that CA providers may implement to
+// issue leaf certs with a TTL other than the configured LeafCertTTL, such as
+// the one a service overrides in its service-defaults config entry. Providers
+// that don't implement it always use LeafCertTTL.
+type LeafTTLSigner interface {
+	// SignWithTTL is like Sign but the returned leaf cert is valid for the
+	// given TTL.
+	SignWithTTL(csr *x509.CertificateRequest, ttl time.Duration) (string, error)
+}
+
+// LeafKeyTypeChecker is an optional interface that CA providers may implement
+// to declare the key types they can issue leaf certs for. Providers that
+// don't implement it are assumed to only support EC and RSA keys.
+type LeafKeyTypeChecker interface {
+	// SupportsLeafKeyType returns whether leaf certs can be issued for keys of
+	// the given type, one of "ec", "rsa" or "ed25519".
+	SupportsLeafKeyType(keyType string) bool
+}
+
+// SupportsLeafKeyType returns whether the given provider can issue leaf certs
+// for keys of the given type.
+func SupportsLeafKeyType(provider Provider, keyType string) bool {
+	if p, ok := provider.(LeafKeyTypeChecker); ok {
+		return p.SupportsLeafKeyType(keyType)
+	}
+	switch keyType {
+	case connect.DefaultPrivateKeyType, connect.PrivateKeyTypeRSA:
+		return true
+	default:
+		return false
+	}
+}
+
 // ProviderConfig encapsulates all the data Consul passes to `Configure` on a
 // new provider instance. The provider must treat this as read-only and make
 // copies of any map or slice if it might modify them internally.
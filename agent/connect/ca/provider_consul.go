@@ -52,7 +52,11 @@ type ConsulProvider struct {
 	sync.RWMutex
 }
 
-var _ Provider = (*ConsulProvider)(nil)
+var (
+	_ Provider           = (*ConsulProvider)(nil)
+	_ LeafTTLSigner      = (*ConsulProvider)(nil)
+	_ LeafKeyTypeChecker = (*ConsulProvider)(nil)
+)
 
 // NewConsulProvider returns a new ConsulProvider that is ready to be used.
 func NewConsulProvider(delegate ConsulProviderStateDelegate, logger hclog.Logger) *ConsulProvider {
@@ -312,6 +316,11 @@ func (c *ConsulProvider) Cleanup(_ bool, _ map[string]interface{}) error {
 // Sign returns a new certificate valid for the given SpiffeIDService
 // using the current CA.
 func (c *ConsulProvider) Sign(csr *x509.CertificateRequest) (string, error) {
+	return c.SignWithTTL(csr, c.config.LeafCertTTL)
+}
+
+// SignWithTTL implements LeafTTLSigner
+func (c *ConsulProvider) SignWithTTL(csr *x509.CertificateRequest, ttl time.Duration) (string, error) {
 	connect.HackSANExtensionForCSR(csr)
 
 	// Lock during the signing so we don't use the same index twice
@@ -388,7 +397,7 @@ func (c *ConsulProvider) Sign(csr *x509.CertificateRequest) (string, error) {
 			x509.ExtKeyUsageClientAuth,
 			x509.ExtKeyUsageServerAuth,
 		},
-		NotAfter:       effectiveNow.Add(c.config.LeafCertTTL),
+		NotAfter:       effectiveNow.Add(ttl),
 		NotBefore:      effectiveNow,
 		AuthorityKeyId: keyId,
 		SubjectKeyId:   subjectKeyID,
@@ -412,6 +421,43 @@ func (c *ConsulProvider) Sign(csr *x509.CertificateRequest) (string, error) {
 	return buf.String(), nil
 }
 
+// SignRevocationList implements RevocationListSigner by signing the list
+// with the private key of the active leaf signing cert.
+func (c *ConsulProvider) SignRevocationList(template *x509.RevocationList) (string, error) {
+	providerState, err := c.getState()
+	if err != nil {
+		return "", err
+	}
+	if providerState.PrivateKey == "" {
+		return "", ErrNotInitialized
+	}
+
+	signer, err := connect.ParseSigner(providerState.PrivateKey)
+	if err != nil {
+		return "", err
+	}
+
+	certPEM, err := c.ActiveLeafSigningCert()
+	if err != nil {
+		return "", err
+	}
+	caCert, err := connect.ParseCert(certPEM)
+	if err != nil {
+		return "", fmt.Errorf("error parsing CA cert: %s", err)
+	}
+
+	bs, err := x509.CreateRevocationList(rand.Reader, template, caCert, signer)
+	if err != nil {
+		return "", fmt.Errorf("error generating revocation list: %s", err)
+	}
+
+	var buf bytes.Buffer
+	if err := pem.Encode(&buf, &pem.Block{Type: "X509 CRL", Bytes: bs}); err != nil {
+		return "", fmt.Errorf("error encoding revocation list: %s", err)
+	}
+	return buf.String(), nil
+}
+
 // SignIntermediate will validate the CSR to ensure the trust domain in the
 // URI SAN matches the local one and that basic constraints for a CA certificate
 // are met. It should return a signed CA certificate with a path length constraint
@@ -568,6 +614,17 @@ func (c *ConsulProvider) SupportsCrossSigning() (bool, error) {
 	return !c.config.DisableCrossSigning, nil
 }
 
+// SupportsLeafKeyType implements LeafKeyTypeChecker. Leaf certs are signed
+// locally so any key type Go can encode is supported.
+func (c *ConsulProvider) SupportsLeafKeyType(keyType string) bool {
+	switch keyType {
+	case connect.DefaultPrivateKeyType, connect.PrivateKeyTypeRSA, connect.PrivateKeyTypeEd25519:
+		return true
+	default:
+		return false
+	}
+}
+
 // getState returns the current provider state from the state delegate, and returns
 // ErrNotInitialized if no entry is found.
 func (c *ConsulProvider) getState() (*structs.CAConsulProviderState, error) {
@@ -255,6 +255,41 @@ func TestConsulCAProvider_SignLeaf(t *testing.T) {
 	}
 }
 
+func TestConsulCAProvider_SignLeafWithTTL_Ed25519(t *testing.T) {
+	t.Parallel()
+
+	conf := testConsulCAConfig()
+	conf.Config["LeafCertTTL"] = "72h"
+	delegate := newMockDelegate(t, conf)
+
+	provider := TestConsulProvider(t, delegate)
+	require.NoError(t, provider.Configure(testProviderConfig(conf)))
+	rootPEM, err := provider.GenerateCAChain()
+	require.NoError(t, err)
+	require.True(t, SupportsLeafKeyType(provider, connect.PrivateKeyTypeEd25519))
+
+	spiffeService := &connect.SpiffeIDService{
+		Host:       connect.TestClusterID + ".consul",
+		Namespace:  "default",
+		Datacenter: "dc1",
+		Service:    "foo",
+	}
+	pk, _, err := connect.GeneratePrivateKeyWithConfig(connect.PrivateKeyTypeEd25519, 0)
+	require.NoError(t, err)
+	raw, err := connect.CreateCSR(spiffeService, pk, nil, nil)
+	require.NoError(t, err)
+	csr, err := connect.ParseCSR(raw)
+	require.NoError(t, err)
+
+	leafPEM, err := provider.SignWithTTL(csr, 2*time.Hour)
+	require.NoError(t, err)
+	leaf, err := connect.ParseCert(leafPEM)
+	require.NoError(t, err)
+	require.Equal(t, x509.Ed25519, leaf.PublicKeyAlgorithm)
+	require.WithinDuration(t, time.Now().Add(2*time.Hour), leaf.NotAfter, 2*time.Minute)
+	require.NoError(t, connect.ValidateLeaf(rootPEM, leafPEM, nil))
+}
+
 func TestConsulCAProvider_CrossSignCA(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
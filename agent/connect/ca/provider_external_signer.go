@@ -0,0 +1,619 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package ca
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/mitchellh/mapstructure"
+
+	"github.com/hashicorp/consul/agent/connect"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/lib"
+)
+
+// RootSigner signs certificates with the private key of an offline root CA.
+// Implementations only expose signatures so the key itself can stay in a file
+// on the servers or on a hardware token and is never written to Raft.
+type RootSigner interface {
+	crypto.Signer
+
+	// Close releases the resources held by the signer, such as a PKCS#11
+	// session.
+	Close() error
+}
+
+// ExternalSignerProvider implements Provider for a root CA whose private key
+// is kept outside of Consul. Leaf certs are signed by an intermediate that the
+// provider generates and has signed by a RootSigner, so the state store only
+// ever holds intermediate keys. Rotating to a new root requires the operator
+// to supply the new root cross-signed by the previous one.
+type ExternalSignerProvider struct {
+	Delegate ConsulProviderStateDelegate
+
+	config    *structs.ExternalSignerCAProviderConfig
+	id        string
+	clusterID string
+	isPrimary bool
+	spiffeID  *connect.SpiffeIDSigning
+	logger    hclog.Logger
+
+	// newSigner opens the RootSigner described by the config. It is only
+	// replaced in tests.
+	newSigner func(*structs.ExternalSignerCAProviderConfig) (RootSigner, error)
+
+	sync.Mutex
+}
+
+var (
+	_ Provider                = (*ExternalSignerProvider)(nil)
+	_ PrimaryUsesIntermediate = (*ExternalSignerProvider)(nil)
+	_ RevocationListSigner    = (*ExternalSignerProvider)(nil)
+	_ OperatorCrossSigned     = (*ExternalSignerProvider)(nil)
+	_ LeafTTLSigner           = (*ExternalSignerProvider)(nil)
+	_ LeafKeyTypeChecker      = (*ExternalSignerProvider)(nil)
+)
+
+// NewExternalSignerProvider returns a new ExternalSignerProvider that is ready
+// to be used.
+func NewExternalSignerProvider(delegate ConsulProviderStateDelegate, logger hclog.Logger) *ExternalSignerProvider {
+	return &ExternalSignerProvider{Delegate: delegate, logger: logger, newSigner: newRootSigner}
+}
+
+// Configure implements Provider
+func (e *ExternalSignerProvider) Configure(cfg ProviderConfig) error {
+	config, err := ParseExternalSignerCAConfig(cfg.RawConfig, cfg.IsPrimary)
+	if err != nil {
+		return err
+	}
+	e.config = config
+	e.id = hexStringHash(fmt.Sprintf("%s,%s,%s,%d,%v", structs.ExternalSignerCAProvider,
+		config.RootCert, config.PrivateKeyType, config.PrivateKeyBits, cfg.IsPrimary))
+	e.clusterID = cfg.ClusterID
+	e.isPrimary = cfg.IsPrimary
+	e.spiffeID = connect.SpiffeIDSigningForCluster(e.clusterID)
+
+	// The intermediate key and cert are persisted in the same table as the
+	// built-in provider's state, keyed by the root so that a new root starts
+	// with a new intermediate.
+	providerState, err := e.Delegate.ProviderState(e.id)
+	if err != nil {
+		return err
+	}
+	if providerState != nil {
+		return nil
+	}
+
+	args := &structs.CARequest{
+		Op:            structs.CAOpSetProviderState,
+		ProviderState: &structs.CAConsulProviderState{ID: e.id},
+	}
+	if _, err := e.Delegate.ApplyCARequest(args); err != nil {
+		return err
+	}
+
+	e.logger.Debug("external signer CA provider configured", "id", e.id, "is_primary", e.isPrimary)
+	return nil
+}
+
+// State implements Provider. The intermediate is stored in its own table since
+// it contains a private key, so there is no state to return.
+func (e *ExternalSignerProvider) State() (map[string]string, error) {
+	return nil, nil
+}
+
+// GenerateCAChain implements Provider by returning the configured root cert.
+// The provider never generates a root since it can't hold its key.
+func (e *ExternalSignerProvider) GenerateCAChain() (string, error) {
+	if !e.isPrimary {
+		return "", fmt.Errorf("provider is not the root certificate authority")
+	}
+	return lib.EnsureTrailingNewline(e.config.RootCert), nil
+}
+
+// CrossSignedRootCert implements OperatorCrossSigned.
+func (e *ExternalSignerProvider) CrossSignedRootCert() (string, error) {
+	if e.config.CrossSignedRootCert == "" {
+		return "", nil
+	}
+	return lib.EnsureTrailingNewline(e.config.CrossSignedRootCert), nil
+}
+
+// GenerateLeafSigningCert implements PrimaryUsesIntermediate by generating a
+// new intermediate key and having the root signer sign its cert.
+func (e *ExternalSignerProvider) GenerateLeafSigningCert() (string, error) {
+	if !e.isPrimary {
+		return "", fmt.Errorf("provider is not the root certificate authority")
+	}
+
+	providerState, err := e.getState()
+	if err != nil {
+		return "", err
+	}
+
+	signer, pk, err := connect.GeneratePrivateKeyWithConfig(e.config.PrivateKeyType, e.config.PrivateKeyBits)
+	if err != nil {
+		return "", err
+	}
+
+	uid, err := connect.CompactUID()
+	if err != nil {
+		return "", err
+	}
+	subject := pkix.Name{CommonName: connect.CACN("external", uid, e.clusterID, true)}
+
+	intermediatePEM, err := e.signIntermediate(subject, []*url.URL{e.spiffeID.URI()}, signer.Public())
+	if err != nil {
+		return "", err
+	}
+
+	newState := *providerState
+	newState.PrivateKey = pk
+	newState.IntermediateCert = intermediatePEM
+	newState.RootCert = lib.EnsureTrailingNewline(e.config.RootCert)
+	args := &structs.CARequest{
+		Op:            structs.CAOpSetProviderState,
+		ProviderState: &newState,
+	}
+	if _, err := e.Delegate.ApplyCARequest(args); err != nil {
+		return "", err
+	}
+
+	e.logger.Info("generated new intermediate signed by the external root signer")
+	return intermediatePEM, nil
+}
+
+// GenerateIntermediateCSR implements Provider
+func (e *ExternalSignerProvider) GenerateIntermediateCSR() (string, string, error) {
+	if e.isPrimary {
+		return "", "", fmt.Errorf("provider is the root certificate authority, " +
+			"cannot generate an intermediate CSR")
+	}
+
+	providerState, err := e.getState()
+	if err != nil {
+		return "", "", err
+	}
+
+	signer, pk, err := connect.GeneratePrivateKeyWithConfig(e.config.PrivateKeyType, e.config.PrivateKeyBits)
+	if err != nil {
+		return "", "", err
+	}
+	csr, err := connect.CreateCACSR(e.spiffeID, signer)
+	if err != nil {
+		return "", "", err
+	}
+
+	newState := *providerState
+	newState.PrivateKey = pk
+	args := &structs.CARequest{
+		Op:            structs.CAOpSetProviderState,
+		ProviderState: &newState,
+	}
+	if _, err := e.Delegate.ApplyCARequest(args); err != nil {
+		return "", "", err
+	}
+
+	return csr, "", nil
+}
+
+// SetIntermediate implements Provider
+func (e *ExternalSignerProvider) SetIntermediate(intermediatePEM, rootPEM, _ string) error {
+	if e.isPrimary {
+		return fmt.Errorf("cannot set an intermediate using another root in the primary datacenter")
+	}
+
+	providerState, err := e.getState()
+	if err != nil {
+		return err
+	}
+
+	if err := validateSetIntermediate(intermediatePEM, rootPEM, e.spiffeID); err != nil {
+		return err
+	}
+	if err := validateIntermediateSignedByPrivateKey(intermediatePEM, providerState.PrivateKey); err != nil {
+		return err
+	}
+
+	newState := *providerState
+	newState.IntermediateCert = intermediatePEM
+	newState.RootCert = rootPEM
+	args := &structs.CARequest{
+		Op:            structs.CAOpSetProviderState,
+		ProviderState: &newState,
+	}
+	if _, err := e.Delegate.ApplyCARequest(args); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ActiveLeafSigningCert implements Provider. It returns an empty string in
+// the primary datacenter until GenerateLeafSigningCert is called.
+func (e *ExternalSignerProvider) ActiveLeafSigningCert() (string, error) {
+	providerState, err := e.getState()
+	if err != nil {
+		return "", err
+	}
+	return providerState.IntermediateCert, nil
+}
+
+// Sign implements Provider by signing the leaf cert with the intermediate.
+func (e *ExternalSignerProvider) Sign(csr *x509.CertificateRequest) (string, error) {
+	return e.SignWithTTL(csr, e.config.LeafCertTTL)
+}
+
+// SignWithTTL implements LeafTTLSigner
+func (e *ExternalSignerProvider) SignWithTTL(csr *x509.CertificateRequest, ttl time.Duration) (string, error) {
+	connect.HackSANExtensionForCSR(csr)
+
+	// Lock during the signing so we don't use the same index twice
+	// for different cert serial numbers.
+	e.Lock()
+	defer e.Unlock()
+
+	signer, caCert, err := e.intermediateSigner()
+	if err != nil {
+		return "", err
+	}
+	subjectKeyID, err := connect.KeyId(csr.PublicKey)
+	if err != nil {
+		return "", err
+	}
+
+	nextSerial, err := e.incrementAndGetNextSerialNumber()
+	if err != nil {
+		return "", fmt.Errorf("error computing next serial number: %v", err)
+	}
+
+	sn := &big.Int{}
+	sn.SetUint64(nextSerial)
+	effectiveNow := time.Now().Add(-1 * CertificateTimeDriftBuffer)
+	template := x509.Certificate{
+		SerialNumber:          sn,
+		URIs:                  csr.URIs,
+		Signature:             csr.Signature,
+		SignatureAlgorithm:    connect.SigAlgoForKey(signer),
+		PublicKeyAlgorithm:    csr.PublicKeyAlgorithm,
+		PublicKey:             csr.PublicKey,
+		BasicConstraintsValid: true,
+		KeyUsage: x509.KeyUsageDataEncipherment |
+			x509.KeyUsageKeyAgreement |
+			x509.KeyUsageDigitalSignature |
+			x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage: []x509.ExtKeyUsage{
+			x509.ExtKeyUsageClientAuth,
+			x509.ExtKeyUsageServerAuth,
+		},
+		NotAfter:       effectiveNow.Add(ttl),
+		NotBefore:      effectiveNow,
+		AuthorityKeyId: caCert.SubjectKeyId,
+		SubjectKeyId:   subjectKeyID,
+		DNSNames:       csr.DNSNames,
+		IPAddresses:    csr.IPAddresses,
+	}
+
+	bs, err := x509.CreateCertificate(rand.Reader, &template, caCert, csr.PublicKey, signer)
+	if err != nil {
+		return "", fmt.Errorf("error generating certificate: %s", err)
+	}
+	return encodeCertificate(bs)
+}
+
+// SignRevocationList implements RevocationListSigner by signing the list
+// with the intermediate.
+func (e *ExternalSignerProvider) SignRevocationList(template *x509.RevocationList) (string, error) {
+	signer, caCert, err := e.intermediateSigner()
+	if err != nil {
+		return "", err
+	}
+
+	bs, err := x509.CreateRevocationList(rand.Reader, template, caCert, signer)
+	if err != nil {
+		return "", fmt.Errorf("error generating revocation list: %s", err)
+	}
+
+	var buf bytes.Buffer
+	if err := pem.Encode(&buf, &pem.Block{Type: "X509 CRL", Bytes: bs}); err != nil {
+		return "", fmt.Errorf("error encoding revocation list: %s", err)
+	}
+	return buf.String(), nil
+}
+
+// SignIntermediate implements Provider by having the root signer sign the
+// intermediate of a secondary datacenter.
+func (e *ExternalSignerProvider) SignIntermediate(csr *x509.CertificateRequest) (string, error) {
+	if !e.isPrimary {
+		return "", fmt.Errorf("provider is not the root certificate authority")
+	}
+	if err := validateSignIntermediate(csr, e.spiffeID); err != nil {
+		return "", err
+	}
+	return e.signIntermediate(csr.Subject, csr.URIs, csr.PublicKey)
+}
+
+// CrossSignCA implements Provider. The root key is offline, so cross-signing
+// another CA requires an operator to sign it outside of Consul.
+func (e *ExternalSignerProvider) CrossSignCA(*x509.Certificate) (string, error) {
+	return "", fmt.Errorf("the external signer CA provider can't cross-sign other CAs: " +
+		"sign the new root with the offline root key and set it as CrossSignedRootCert instead")
+}
+
+// SupportsCrossSigning implements Provider
+func (e *ExternalSignerProvider) SupportsCrossSigning() (bool, error) {
+	return false, nil
+}
+
+// SupportsLeafKeyType implements LeafKeyTypeChecker. Leaf certs are signed
+// with the intermediate key held by Consul so any key type is supported.
+func (e *ExternalSignerProvider) SupportsLeafKeyType(keyType string) bool {
+	switch keyType {
+	case connect.DefaultPrivateKeyType, connect.PrivateKeyTypeRSA, connect.PrivateKeyTypeEd25519:
+		return true
+	default:
+		return false
+	}
+}
+
+// Cleanup implements Provider by removing the intermediate from the state
+// store.
+func (e *ExternalSignerProvider) Cleanup(_ bool, _ map[string]interface{}) error {
+	args := &structs.CARequest{
+		Op:            structs.CAOpDeleteProviderState,
+		ProviderState: &structs.CAConsulProviderState{ID: e.id},
+	}
+	if _, err := e.Delegate.ApplyCARequest(args); err != nil {
+		return err
+	}
+	return nil
+}
+
+// sigAlgoForPublicKey is like connect.SigAlgoForKey but works for signers that
+// don't expose the private key, such as a key on a PKCS#11 token.
+func sigAlgoForPublicKey(pub crypto.PublicKey) x509.SignatureAlgorithm {
+	switch pub.(type) {
+	case *rsa.PublicKey:
+		return x509.SHA256WithRSA
+	case ed25519.PublicKey:
+		return x509.PureEd25519
+	}
+	return x509.ECDSAWithSHA256
+}
+
+// signIntermediate signs a CA cert for the given public key with the root
+// signer. The cert can only sign leaf certs and never outlives the root.
+func (e *ExternalSignerProvider) signIntermediate(subject pkix.Name, uris []*url.URL, pub crypto.PublicKey) (string, error) {
+	rootCert, err := connect.ParseCert(e.config.RootCert)
+	if err != nil {
+		return "", fmt.Errorf("error parsing root cert: %s", err)
+	}
+	subjectKeyID, err := connect.KeyId(pub)
+	if err != nil {
+		return "", err
+	}
+
+	nextSerial, err := e.incrementAndGetNextSerialNumber()
+	if err != nil {
+		return "", fmt.Errorf("error computing next serial number: %v", err)
+	}
+
+	signer, err := e.newSigner(e.config)
+	if err != nil {
+		return "", fmt.Errorf("error opening root signer: %w", err)
+	}
+	defer func() {
+		if err := signer.Close(); err != nil {
+			e.logger.Warn("failed to close root signer", "error", err)
+		}
+	}()
+	if err := validateSignerMatchesCert(signer, rootCert); err != nil {
+		return "", err
+	}
+
+	sn := &big.Int{}
+	sn.SetUint64(nextSerial)
+	effectiveNow := time.Now().Add(-1 * CertificateTimeDriftBuffer)
+	notAfter := effectiveNow.Add(e.config.IntermediateCertTTL)
+	if notAfter.After(rootCert.NotAfter) {
+		notAfter = rootCert.NotAfter
+	}
+	template := x509.Certificate{
+		SerialNumber:          sn,
+		Subject:               subject,
+		URIs:                  uris,
+		SignatureAlgorithm:    sigAlgoForPublicKey(signer.Public()),
+		PublicKey:             pub,
+		BasicConstraintsValid: true,
+		KeyUsage: x509.KeyUsageCertSign |
+			x509.KeyUsageCRLSign |
+			x509.KeyUsageDigitalSignature,
+		IsCA:           true,
+		MaxPathLenZero: true,
+		NotAfter:       notAfter,
+		NotBefore:      effectiveNow,
+		SubjectKeyId:   subjectKeyID,
+	}
+
+	bs, err := x509.CreateCertificate(rand.Reader, &template, rootCert, pub, signer)
+	if err != nil {
+		return "", fmt.Errorf("error generating intermediate certificate: %s", err)
+	}
+	return encodeCertificate(bs)
+}
+
+// intermediateSigner returns the intermediate key and cert used to sign leaf
+// certs.
+func (e *ExternalSignerProvider) intermediateSigner() (crypto.Signer, *x509.Certificate, error) {
+	providerState, err := e.getState()
+	if err != nil {
+		return nil, nil, err
+	}
+	if providerState.PrivateKey == "" || providerState.IntermediateCert == "" {
+		return nil, nil, ErrNotInitialized
+	}
+
+	signer, err := connect.ParseSigner(providerState.PrivateKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	caCert, err := connect.ParseCert(providerState.IntermediateCert)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error parsing intermediate cert: %s", err)
+	}
+	return signer, caCert, nil
+}
+
+// getState returns the current provider state from the state delegate, and
+// returns ErrNotInitialized if no entry is found.
+func (e *ExternalSignerProvider) getState() (*structs.CAConsulProviderState, error) {
+	providerState, err := e.Delegate.ProviderState(e.id)
+	if err != nil {
+		return nil, err
+	}
+	if providerState == nil {
+		return nil, ErrNotInitialized
+	}
+	return providerState, nil
+}
+
+func (e *ExternalSignerProvider) incrementAndGetNextSerialNumber() (uint64, error) {
+	args := &structs.CARequest{
+		Op: structs.CAOpIncrementProviderSerialNumber,
+	}
+
+	raw, err := e.Delegate.ApplyCARequest(args)
+	if err != nil {
+		return 0, err
+	}
+
+	return raw.(uint64), nil
+}
+
+func encodeCertificate(der []byte) (string, error) {
+	var buf bytes.Buffer
+	if err := pem.Encode(&buf, &pem.Block{Type: "CERTIFICATE", Bytes: der}); err != nil {
+		return "", fmt.Errorf("error encoding certificate: %s", err)
+	}
+	return buf.String(), nil
+}
+
+// validateSignerMatchesCert returns an error if the signer's key isn't the
+// key of the given cert, which would make every cert it signs untrusted.
+func validateSignerMatchesCert(signer crypto.Signer, cert *x509.Certificate) error {
+	b1, err := x509.MarshalPKIXPublicKey(signer.Public())
+	if err != nil {
+		return err
+	}
+	b2, err := x509.MarshalPKIXPublicKey(cert.PublicKey)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(b1, b2) {
+		return fmt.Errorf("root signer key does not match the root certificate")
+	}
+	return nil
+}
+
+// newRootSigner opens the RootSigner selected by the config.
+func newRootSigner(config *structs.ExternalSignerCAProviderConfig) (RootSigner, error) {
+	switch config.Signer {
+	case "file":
+		return newFileRootSigner(config.RootKeyFile)
+	case "pkcs11":
+		rootCert, err := connect.ParseCert(config.RootCert)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing root cert: %s", err)
+		}
+		return newPKCS11RootSigner(config, rootCert.PublicKey)
+	default:
+		return nil, fmt.Errorf("unknown root signer %q", config.Signer)
+	}
+}
+
+// fileRootSigner is a RootSigner backed by a PEM encoded private key file.
+// The file is read every time the signer is opened so it only needs to be
+// present on the servers while intermediates are signed.
+type fileRootSigner struct {
+	crypto.Signer
+}
+
+func newFileRootSigner(path string) (*fileRootSigner, error) {
+	keyPEM, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading root key file: %w", err)
+	}
+	signer, err := connect.ParseSigner(string(keyPEM))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing root key file %q: %w", path, err)
+	}
+	return &fileRootSigner{Signer: signer}, nil
+}
+
+// Close implements RootSigner
+func (s *fileRootSigner) Close() error {
+	return nil
+}
+
+// ParseExternalSignerCAConfig parses and validates the external signer CA
+// provider configuration.
+func ParseExternalSignerCAConfig(raw map[string]interface{}, isPrimary bool) (*structs.ExternalSignerCAProviderConfig, error) {
+	config := structs.ExternalSignerCAProviderConfig{
+		CommonCAProviderConfig: defaultCommonConfig(),
+		Signer:                 "file",
+	}
+
+	decodeConf := &mapstructure.DecoderConfig{
+		DecodeHook:       structs.ParseDurationFunc(),
+		Result:           &config,
+		WeaklyTypedInput: true,
+	}
+
+	decoder, err := mapstructure.NewDecoder(decodeConf)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := decoder.Decode(raw); err != nil {
+		return nil, fmt.Errorf("error decoding config: %s", err)
+	}
+
+	if err := config.CommonCAProviderConfig.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := config.Validate(isPrimary); err != nil {
+		return nil, err
+	}
+
+	if config.RootCert != "" {
+		rootCert, err := connect.ParseCert(config.RootCert)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing root cert: %s", err)
+		}
+		if !rootCert.IsCA {
+			return nil, fmt.Errorf("root cert is not a CA certificate")
+		}
+	}
+	if config.CrossSignedRootCert != "" {
+		if _, err := connect.ParseCert(config.CrossSignedRootCert); err != nil {
+			return nil, fmt.Errorf("error parsing cross-signed root cert: %s", err)
+		}
+	}
+
+	return &config, nil
+}
@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build cgo
+
+package ca
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/miekg/pkcs11"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// pkcs1DigestInfoPrefixes are the DER encoded DigestInfo prefixes the PKCS#1
+// v1.5 signature of a digest must start with. CKM_RSA_PKCS only pads the
+// input so the prefix is added before signing.
+var pkcs1DigestInfoPrefixes = map[crypto.Hash][]byte{
+	crypto.SHA256: {0x30, 0x31, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x01, 0x05, 0x00, 0x04, 0x20},
+	crypto.SHA384: {0x30, 0x41, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x02, 0x05, 0x00, 0x04, 0x30},
+	crypto.SHA512: {0x30, 0x51, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x03, 0x05, 0x00, 0x04, 0x40},
+}
+
+// ckmEDDSA is the PKCS#11 v3.0 CKM_EDDSA mechanism, which the PKCS#11 package
+// doesn't define since it only covers v2.40.
+const ckmEDDSA = 0x1057
+
+// pkcs11RootSigner is a RootSigner backed by a private key on a PKCS#11
+// token, such as an HSM or SoftHSM. The key never leaves the token.
+type pkcs11RootSigner struct {
+	ctx     *pkcs11.Ctx
+	session pkcs11.SessionHandle
+	key     pkcs11.ObjectHandle
+	public  crypto.PublicKey
+}
+
+// newPKCS11RootSigner opens a session on the configured token and looks up
+// the root private key. The public key of the root cert is used as the
+// signer's public key since tokens don't always store one alongside the
+// private key.
+func newPKCS11RootSigner(config *structs.ExternalSignerCAProviderConfig, public crypto.PublicKey) (_ *pkcs11RootSigner, err error) {
+	ctx := pkcs11.New(config.PKCS11Module)
+	if ctx == nil {
+		return nil, fmt.Errorf("error loading PKCS#11 module %q", config.PKCS11Module)
+	}
+	if err := ctx.Initialize(); err != nil && !errors.Is(err, pkcs11.Error(pkcs11.CKR_CRYPTOKI_ALREADY_INITIALIZED)) {
+		ctx.Destroy()
+		return nil, fmt.Errorf("error initializing PKCS#11 module: %w", err)
+	}
+
+	s := &pkcs11RootSigner{ctx: ctx, public: public}
+	defer func() {
+		if err != nil {
+			s.Close()
+		}
+	}()
+
+	slot, err := findPKCS11Slot(ctx, config.PKCS11TokenLabel)
+	if err != nil {
+		return nil, err
+	}
+	s.session, err = ctx.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION)
+	if err != nil {
+		return nil, fmt.Errorf("error opening PKCS#11 session: %w", err)
+	}
+	pin, err := readPKCS11PIN(config.PKCS11PINFile)
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.Login(s.session, pkcs11.CKU_USER, pin); err != nil &&
+		!errors.Is(err, pkcs11.Error(pkcs11.CKR_USER_ALREADY_LOGGED_IN)) {
+		return nil, fmt.Errorf("error logging in to PKCS#11 token: %w", err)
+	}
+
+	template := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PRIVATE_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, config.PKCS11KeyLabel),
+	}
+	if err := ctx.FindObjectsInit(s.session, template); err != nil {
+		return nil, fmt.Errorf("error finding PKCS#11 key: %w", err)
+	}
+	keys, _, err := ctx.FindObjects(s.session, 2)
+	if finalErr := ctx.FindObjectsFinal(s.session); err == nil {
+		err = finalErr
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error finding PKCS#11 key: %w", err)
+	}
+	switch len(keys) {
+	case 0:
+		return nil, fmt.Errorf("no private key labeled %q found on PKCS#11 token", config.PKCS11KeyLabel)
+	case 1:
+		s.key = keys[0]
+	default:
+		return nil, fmt.Errorf("multiple private keys labeled %q found on PKCS#11 token", config.PKCS11KeyLabel)
+	}
+
+	return s, nil
+}
+
+// readPKCS11PIN reads the user PIN of the token from path. Tokens that don't
+// require a login have no PIN file.
+func readPKCS11PIN(path string) (string, error) {
+	if path == "" {
+		return "", nil
+	}
+	pin, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("error reading PKCS#11 PIN file: %w", err)
+	}
+	return strings.TrimSpace(string(pin)), nil
+}
+
+func findPKCS11Slot(ctx *pkcs11.Ctx, tokenLabel string) (uint, error) {
+	slots, err := ctx.GetSlotList(true)
+	if err != nil {
+		return 0, fmt.Errorf("error listing PKCS#11 slots: %w", err)
+	}
+	for _, slot := range slots {
+		info, err := ctx.GetTokenInfo(slot)
+		if err != nil {
+			return 0, fmt.Errorf("error reading PKCS#11 token info: %w", err)
+		}
+		if info.Label == tokenLabel {
+			return slot, nil
+		}
+	}
+	return 0, fmt.Errorf("no PKCS#11 token labeled %q found", tokenLabel)
+}
+
+// Public implements crypto.Signer
+func (s *pkcs11RootSigner) Public() crypto.PublicKey {
+	return s.public
+}
+
+// Sign implements crypto.Signer. Only ECDSA, Ed25519 and RSA PKCS#1 v1.5
+// signatures are supported, which are the ones Consul uses for CA certs.
+func (s *pkcs11RootSigner) Sign(_ io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	switch s.public.(type) {
+	case *ecdsa.PublicKey:
+		mech := []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_ECDSA, nil)}
+		if err := s.ctx.SignInit(s.session, mech, s.key); err != nil {
+			return nil, fmt.Errorf("error signing with PKCS#11 key: %w", err)
+		}
+		sig, err := s.ctx.Sign(s.session, digest)
+		if err != nil {
+			return nil, fmt.Errorf("error signing with PKCS#11 key: %w", err)
+		}
+		// PKCS#11 returns r and s concatenated while x509 expects the ASN.1
+		// encoding used by crypto/ecdsa.
+		if len(sig)%2 != 0 {
+			return nil, fmt.Errorf("invalid ECDSA signature length %d from PKCS#11 token", len(sig))
+		}
+		half := len(sig) / 2
+		return asn1.Marshal(struct{ R, S *big.Int }{
+			R: new(big.Int).SetBytes(sig[:half]),
+			S: new(big.Int).SetBytes(sig[half:]),
+		})
+
+	case ed25519.PublicKey:
+		// Ed25519 signs the message itself rather than a digest of it.
+		if opts.HashFunc() != crypto.Hash(0) {
+			return nil, fmt.Errorf("Ed25519 signatures of a digest are not supported by the PKCS#11 signer")
+		}
+		mech := []*pkcs11.Mechanism{pkcs11.NewMechanism(ckmEDDSA, nil)}
+		if err := s.ctx.SignInit(s.session, mech, s.key); err != nil {
+			return nil, fmt.Errorf("error signing with PKCS#11 key: %w", err)
+		}
+		sig, err := s.ctx.Sign(s.session, digest)
+		if err != nil {
+			return nil, fmt.Errorf("error signing with PKCS#11 key: %w", err)
+		}
+		return sig, nil
+
+	case *rsa.PublicKey:
+		if _, ok := opts.(*rsa.PSSOptions); ok {
+			return nil, fmt.Errorf("RSA-PSS signatures are not supported by the PKCS#11 signer")
+		}
+		prefix, ok := pkcs1DigestInfoPrefixes[opts.HashFunc()]
+		if !ok {
+			return nil, fmt.Errorf("unsupported hash function %s for the PKCS#11 signer", opts.HashFunc())
+		}
+		mech := []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_RSA_PKCS, nil)}
+		if err := s.ctx.SignInit(s.session, mech, s.key); err != nil {
+			return nil, fmt.Errorf("error signing with PKCS#11 key: %w", err)
+		}
+		sig, err := s.ctx.Sign(s.session, append(append([]byte{}, prefix...), digest...))
+		if err != nil {
+			return nil, fmt.Errorf("error signing with PKCS#11 key: %w", err)
+		}
+		return sig, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported root key type %T for the PKCS#11 signer", s.public)
+	}
+}
+
+// Close implements RootSigner by closing the session and unloading the
+// module.
+func (s *pkcs11RootSigner) Close() error {
+	var err error
+	if s.session != 0 {
+		// Logging out may fail if the login failed, closing the session is
+		// what matters.
+		_ = s.ctx.Logout(s.session)
+		err = s.ctx.CloseSession(s.session)
+		s.session = 0
+	}
+	if finalizeErr := s.ctx.Finalize(); err == nil {
+		err = finalizeErr
+	}
+	s.ctx.Destroy()
+	return err
+}
@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build !cgo
+
+package ca
+
+import (
+	"crypto"
+	"fmt"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// newPKCS11RootSigner is unavailable since loading a PKCS#11 module requires
+// cgo.
+func newPKCS11RootSigner(*structs.ExternalSignerCAProviderConfig, crypto.PublicKey) (RootSigner, error) {
+	return nil, fmt.Errorf("the pkcs11 signer requires a Consul binary built with cgo, such as the release builds with the +pkcs11 version metadata")
+}
@@ -0,0 +1,317 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package ca
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/connect"
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+func testExternalSignerCAConfig(t *testing.T, root *structs.CARoot) *structs.CAConfiguration {
+	keyFile := filepath.Join(t.TempDir(), "root.key")
+	require.NoError(t, os.WriteFile(keyFile, []byte(root.SigningKey), 0600))
+
+	return &structs.CAConfiguration{
+		ClusterID: connect.TestClusterID,
+		Provider:  structs.ExternalSignerCAProvider,
+		Config: map[string]interface{}{
+			"LeafCertTTL":         "1h",
+			"IntermediateCertTTL": "24h",
+			"RootCert":            root.RootCert,
+			"Signer":              "file",
+			"RootKeyFile":         keyFile,
+		},
+	}
+}
+
+func TestExternalSignerCAProvider_SignLeaf(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range KeyTestCases {
+		tc := tc
+		t.Run(tc.Desc, func(t *testing.T) {
+			root := connect.TestCAWithKeyType(t, nil, tc.KeyType, tc.KeyBits)
+			conf := testExternalSignerCAConfig(t, root)
+			conf.Config["PrivateKeyType"] = tc.KeyType
+			conf.Config["PrivateKeyBits"] = tc.KeyBits
+			delegate := newMockDelegate(t, conf)
+
+			provider := NewExternalSignerProvider(delegate, hclog.New(nil))
+			require.NoError(t, provider.Configure(testProviderConfig(conf)))
+
+			rootPEM, err := provider.GenerateCAChain()
+			require.NoError(t, err)
+			require.Equal(t, root.RootCert, rootPEM)
+
+			// There is no intermediate until the leader asks for one.
+			active, err := provider.ActiveLeafSigningCert()
+			require.NoError(t, err)
+			require.Empty(t, active)
+
+			intermediatePEM, err := provider.GenerateLeafSigningCert()
+			require.NoError(t, err)
+			active, err = provider.ActiveLeafSigningCert()
+			require.NoError(t, err)
+			require.Equal(t, intermediatePEM, active)
+
+			intermediate, err := connect.ParseCert(intermediatePEM)
+			require.NoError(t, err)
+			require.True(t, intermediate.IsCA)
+			require.True(t, intermediate.MaxPathLenZero)
+			require.Equal(t, connect.SpiffeIDSigningForCluster(conf.ClusterID).URI(), intermediate.URIs[0])
+
+			// Only the intermediate key is persisted.
+			_, providerState, err := delegate.state.CAProviderState(provider.id)
+			require.NoError(t, err)
+			require.NotEmpty(t, providerState.PrivateKey)
+			require.NotEqual(t, root.SigningKey, providerState.PrivateKey)
+			require.NoError(t, validateIntermediateSignedByPrivateKey(intermediatePEM, providerState.PrivateKey))
+
+			spiffeService := &connect.SpiffeIDService{
+				Host:       connect.TestClusterID + ".consul",
+				Namespace:  "default",
+				Datacenter: "dc1",
+				Service:    "foo",
+			}
+			raw, _ := connect.TestCSR(t, spiffeService)
+			csr, err := connect.ParseCSR(raw)
+			require.NoError(t, err)
+
+			leafPEM, err := provider.Sign(csr)
+			require.NoError(t, err)
+			requireTrailingNewline(t, leafPEM)
+			leaf, err := connect.ParseCert(leafPEM)
+			require.NoError(t, err)
+			require.Equal(t, spiffeService.URI(), leaf.URIs[0])
+			require.Equal(t, intermediate.SubjectKeyId, leaf.AuthorityKeyId)
+			require.True(t, leaf.NotAfter.Sub(time.Now()) < time.Hour)
+
+			require.NoError(t, connect.ValidateLeaf(rootPEM, leafPEM, []string{intermediatePEM}))
+		})
+	}
+}
+
+func TestExternalSignerCAProvider_Ed25519Root(t *testing.T) {
+	t.Parallel()
+
+	root := connect.TestCAWithKeyType(t, nil, connect.PrivateKeyTypeEd25519, 0)
+	conf := testExternalSignerCAConfig(t, root)
+	delegate := newMockDelegate(t, conf)
+	provider := NewExternalSignerProvider(delegate, hclog.New(nil))
+	require.NoError(t, provider.Configure(testProviderConfig(conf)))
+
+	intermediatePEM, err := provider.GenerateLeafSigningCert()
+	require.NoError(t, err)
+	intermediate, err := connect.ParseCert(intermediatePEM)
+	require.NoError(t, err)
+	require.Equal(t, x509.PureEd25519, intermediate.SignatureAlgorithm)
+
+	rootCert, err := connect.ParseCert(root.RootCert)
+	require.NoError(t, err)
+	require.NoError(t, intermediate.CheckSignatureFrom(rootCert))
+}
+
+func TestExternalSignerCAProvider_SignIntermediate(t *testing.T) {
+	t.Parallel()
+
+	root := connect.TestCA(t, nil)
+	conf1 := testExternalSignerCAConfig(t, root)
+	delegate1 := newMockDelegate(t, conf1)
+	provider1 := NewExternalSignerProvider(delegate1, hclog.New(nil))
+	require.NoError(t, provider1.Configure(testProviderConfig(conf1)))
+
+	// Secondaries don't need the root or a signer.
+	conf2 := &structs.CAConfiguration{
+		ClusterID: connect.TestClusterID,
+		Provider:  structs.ExternalSignerCAProvider,
+		Config:    map[string]interface{}{},
+		RaftIndex: structs.RaftIndex{CreateIndex: 10},
+	}
+	delegate2 := newMockDelegate(t, conf2)
+	provider2 := NewExternalSignerProvider(delegate2, hclog.New(nil))
+	cfg := testProviderConfig(conf2)
+	cfg.IsPrimary = false
+	cfg.Datacenter = "dc2"
+	require.NoError(t, provider2.Configure(cfg))
+
+	testSignIntermediateCrossDC(t, provider1, provider2)
+}
+
+func TestExternalSignerCAProvider_SignerMismatch(t *testing.T) {
+	t.Parallel()
+
+	root := connect.TestCA(t, nil)
+	conf := testExternalSignerCAConfig(t, root)
+
+	// Point the signer to the key of another root.
+	other := testExternalSignerCAConfig(t, connect.TestCA(t, nil))
+	conf.Config["RootKeyFile"] = other.Config["RootKeyFile"]
+
+	delegate := newMockDelegate(t, conf)
+	provider := NewExternalSignerProvider(delegate, hclog.New(nil))
+	require.NoError(t, provider.Configure(testProviderConfig(conf)))
+
+	_, err := provider.GenerateLeafSigningCert()
+	require.ErrorContains(t, err, "root signer key does not match the root certificate")
+
+	// Cross-signing other CAs is never done online.
+	ok, err := provider.SupportsCrossSigning()
+	require.NoError(t, err)
+	require.False(t, ok)
+	_, err = provider.CrossSignCA(&x509.Certificate{})
+	require.ErrorContains(t, err, "CrossSignedRootCert")
+}
+
+func TestParseExternalSignerCAConfig(t *testing.T) {
+	root := connect.TestCA(t, nil)
+	leafPEM, _ := connect.TestLeaf(t, "web", root)
+
+	cases := map[string]struct {
+		raw       map[string]interface{}
+		isPrimary bool
+		wantErr   string
+	}{
+		"file signer": {
+			raw:       map[string]interface{}{"RootCert": root.RootCert, "Signer": "file", "RootKeyFile": "/etc/consul/root.key"},
+			isPrimary: true,
+		},
+		"pkcs11 signer": {
+			raw: map[string]interface{}{
+				"RootCert":         root.RootCert,
+				"Signer":           "pkcs11",
+				"PKCS11Module":     "/usr/lib/softhsm/libsofthsm2.so",
+				"PKCS11TokenLabel": "consul",
+				"PKCS11KeyLabel":   "root",
+			},
+			isPrimary: true,
+		},
+		"secondary without root": {
+			raw: map[string]interface{}{},
+		},
+		"missing root": {
+			raw:       map[string]interface{}{"Signer": "file", "RootKeyFile": "/etc/consul/root.key"},
+			isPrimary: true,
+			wantErr:   "must provide the root certificate",
+		},
+		"root is not a CA": {
+			raw:       map[string]interface{}{"RootCert": leafPEM, "Signer": "file", "RootKeyFile": "/etc/consul/root.key"},
+			isPrimary: true,
+			wantErr:   "root cert is not a CA certificate",
+		},
+		"unknown signer": {
+			raw:       map[string]interface{}{"RootCert": root.RootCert, "Signer": "vault"},
+			isPrimary: true,
+			wantErr:   "signer must be either 'file' or 'pkcs11'",
+		},
+		"file signer without key file": {
+			raw:       map[string]interface{}{"RootCert": root.RootCert, "Signer": "file"},
+			isPrimary: true,
+			wantErr:   "must provide RootKeyFile",
+		},
+		"pkcs11 signer without key label": {
+			raw: map[string]interface{}{
+				"RootCert":         root.RootCert,
+				"Signer":           "pkcs11",
+				"PKCS11Module":     "/usr/lib/softhsm/libsofthsm2.so",
+				"PKCS11TokenLabel": "consul",
+			},
+			isPrimary: true,
+			wantErr:   "must provide PKCS11KeyLabel",
+		},
+		"invalid cross-signed root": {
+			raw:     map[string]interface{}{"CrossSignedRootCert": "not a cert"},
+			wantErr: "error parsing cross-signed root cert",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseExternalSignerCAConfig(tc.raw, tc.isPrimary)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestExternalSignerCAProvider_PKCS11(t *testing.T) {
+	for _, tc := range KeyTestCases {
+		t.Run(tc.Desc, func(t *testing.T) {
+			root := connect.TestCAWithKeyType(t, nil, tc.KeyType, tc.KeyBits)
+			conf := testExternalSignerCAConfig(t, root)
+			conf.Config["Signer"] = "pkcs11"
+			conf.Config["PKCS11Module"] = testSoftHSMImportKey(t, root.SigningKey)
+			conf.Config["PKCS11TokenLabel"] = "consul"
+			pinFile := filepath.Join(t.TempDir(), "pin")
+			require.NoError(t, os.WriteFile(pinFile, []byte("1234\n"), 0600))
+			conf.Config["PKCS11PINFile"] = pinFile
+			conf.Config["PKCS11KeyLabel"] = "root"
+
+			delegate := newMockDelegate(t, conf)
+			provider := NewExternalSignerProvider(delegate, hclog.New(nil))
+			require.NoError(t, provider.Configure(testProviderConfig(conf)))
+
+			intermediatePEM, err := provider.GenerateLeafSigningCert()
+			require.NoError(t, err)
+
+			intermediate, err := connect.ParseCert(intermediatePEM)
+			require.NoError(t, err)
+			rootCert, err := connect.ParseCert(root.RootCert)
+			require.NoError(t, err)
+			require.NoError(t, intermediate.CheckSignatureFrom(rootCert))
+		})
+	}
+}
+
+// testSoftHSMImportKey imports the given root key into a new SoftHSM token
+// labeled "consul" and returns the path to the SoftHSM PKCS#11 module. The test
+// is skipped if SoftHSM isn't installed.
+func testSoftHSMImportKey(t *testing.T, keyPEM string) string {
+	module := os.Getenv("SOFTHSM2_MODULE")
+	if module == "" {
+		module = "/usr/lib/softhsm/libsofthsm2.so"
+	}
+	if _, err := os.Stat(module); err != nil {
+		t.Skipf("SoftHSM module %q not found - install SoftHSM or set SOFTHSM2_MODULE to run this test", module)
+	}
+	if _, err := exec.LookPath("softhsm2-util"); err != nil {
+		t.Skip("softhsm2-util not found on $PATH - install SoftHSM to run this test")
+	}
+
+	dir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "tokens"), 0700))
+	conf := filepath.Join(dir, "softhsm2.conf")
+	require.NoError(t, os.WriteFile(conf, []byte("directories.tokendir = "+filepath.Join(dir, "tokens")+"\n"), 0600))
+	t.Setenv("SOFTHSM2_CONF", conf)
+
+	// softhsm2-util only imports PKCS#8 encoded keys.
+	signer, err := connect.ParseSigner(keyPEM)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(signer)
+	require.NoError(t, err)
+	keyFile := filepath.Join(dir, "root.pem")
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0600))
+
+	for _, args := range [][]string{
+		{"--init-token", "--free", "--label", "consul", "--pin", "1234", "--so-pin", "5678"},
+		{"--import", keyFile, "--token", "consul", "--label", "root", "--id", "01", "--pin", "1234"},
+	} {
+		out, err := exec.Command("softhsm2-util", args...).CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+	return module
+}
@@ -76,7 +76,11 @@ type VaultProvider struct {
 	isConsulMountedIntermediate bool
 }
 
-var _ Provider = (*VaultProvider)(nil)
+var (
+	_ Provider           = (*VaultProvider)(nil)
+	_ LeafTTLSigner      = (*VaultProvider)(nil)
+	_ LeafKeyTypeChecker = (*VaultProvider)(nil)
+)
 
 func NewVaultProvider(logger hclog.Logger) *VaultProvider {
 	return &VaultProvider{
@@ -733,6 +737,12 @@ func (v *VaultProvider) setDefaultIntermediateIssuer(vaultResp *vaultapi.Secret,
 // a new leaf certificate based on the provided CSR, with the issuing
 // intermediate CA cert attached.
 func (v *VaultProvider) Sign(csr *x509.CertificateRequest) (string, error) {
+	return v.SignWithTTL(csr, v.config.LeafCertTTL)
+}
+
+// SignWithTTL implements LeafTTLSigner. Vault caps the TTL to the max_ttl of
+// the leaf cert role, which is LeafCertTTL, so only shorter TTLs are honoured.
+func (v *VaultProvider) SignWithTTL(csr *x509.CertificateRequest, ttl time.Duration) (string, error) {
 	connect.HackSANExtensionForCSR(csr)
 
 	var pemBuf bytes.Buffer
@@ -743,7 +753,7 @@ func (v *VaultProvider) Sign(csr *x509.CertificateRequest) (string, error) {
 	// Use the leaf cert role to sign a new cert for this CSR.
 	response, err := v.writeNamespaced(v.config.IntermediatePKINamespace, v.config.IntermediatePKIPath+"sign/"+VaultCALeafCertRole, map[string]interface{}{
 		"csr": pemBuf.String(),
-		"ttl": v.config.LeafCertTTL.String(),
+		"ttl": ttl.String(),
 	})
 	if err != nil {
 		return "", fmt.Errorf("error issuing cert: %v", err)
@@ -841,6 +851,17 @@ func (v *VaultProvider) SupportsCrossSigning() (bool, error) {
 	return true, nil
 }
 
+// SupportsLeafKeyType implements LeafKeyTypeChecker. The leaf cert role
+// accepts CSRs for any key type.
+func (v *VaultProvider) SupportsLeafKeyType(keyType string) bool {
+	switch keyType {
+	case connect.DefaultPrivateKeyType, connect.PrivateKeyTypeRSA, connect.PrivateKeyTypeEd25519:
+		return true
+	default:
+		return false
+	}
+}
+
 // Cleanup unmounts the configured intermediate PKI backend. It's fine to tear
 // this down and recreate it on small config changes because the intermediate
 // certs get bundled with the leaf certs, so there's no cost to the CA changing.
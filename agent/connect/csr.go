@@ -6,6 +6,7 @@ package connect
 import (
 	"bytes"
 	"crypto"
+	"crypto/ed25519"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
@@ -23,8 +24,11 @@ import (
 // ECDSAWithSHA256 on the basis that it will fail anyway and we've already type
 // checked keys by the time we call this in general.
 func SigAlgoForKey(key crypto.Signer) x509.SignatureAlgorithm {
-	if _, ok := key.(*rsa.PrivateKey); ok {
+	switch key.(type) {
+	case *rsa.PrivateKey:
 		return x509.SHA256WithRSA
+	case ed25519.PrivateKey:
+		return x509.PureEd25519
 	}
 	// We default to ECDSA but don't bother detecting invalid key types as we do
 	// that in lots of other places and it will fail anyway if we try to sign with
@@ -40,6 +44,8 @@ func SigAlgoForKeyType(keyType string) x509.SignatureAlgorithm {
 	switch keyType {
 	case "rsa":
 		return x509.SHA256WithRSA
+	case "ed25519":
+		return x509.PureEd25519
 	case "ec":
 		fallthrough
 	default:
@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
@@ -26,6 +27,10 @@ const (
 	PrivateKeyTypeRSA        = "rsa"
 	MinPrivateKeyBitsRSA     = 2048
 	DefaultPrivateKeyBitsRSA = 4096
+
+	// Ed25519 keys have a fixed size so the key bits are ignored. They are
+	// only supported for leaf certs.
+	PrivateKeyTypeEd25519 = "ed25519"
 )
 
 func pemEncode(value []byte, blockType string) (string, error) {
@@ -94,6 +99,26 @@ func generateECDSAKey(keyBits int) (crypto.Signer, string, error) {
 	return pk, pemBlock, nil
 }
 
+func generateEd25519Key() (crypto.Signer, string, error) {
+	_, pk, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, "", fmt.Errorf("error generating Ed25519 private key: %s", err)
+	}
+
+	// Ed25519 keys have no dedicated encoding so they are stored as PKCS#8.
+	bs, err := x509.MarshalPKCS8PrivateKey(pk)
+	if err != nil {
+		return nil, "", fmt.Errorf("error marshaling Ed25519 private key: %s", err)
+	}
+
+	pemBlock, err := pemEncode(bs, "PRIVATE KEY")
+	if err != nil {
+		return nil, "", err
+	}
+
+	return pk, pemBlock, nil
+}
+
 // GeneratePrivateKey generates a new Private key
 func GeneratePrivateKeyWithConfig(keyType string, keyBits int) (crypto.Signer, string, error) {
 	switch strings.ToLower(keyType) {
@@ -101,6 +126,8 @@ func GeneratePrivateKeyWithConfig(keyType string, keyBits int) (crypto.Signer, s
 		return generateRSAKey(keyBits)
 	case DefaultPrivateKeyType:
 		return generateECDSAKey(keyBits)
+	case PrivateKeyTypeEd25519:
+		return generateEd25519Key()
 	default:
 		return nil, "", fmt.Errorf("unknown private key type requested: %s", keyType)
 	}
@@ -6,6 +6,7 @@ package connect
 import (
 	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/rsa"
 	"crypto/sha1"
 	"crypto/sha256"
@@ -164,11 +165,12 @@ func ParseCSR(pemValue string) (*x509.CertificateRequest, error) {
 }
 
 // KeyId returns a x509 KeyId from the given signing key. The key must be
-// an *ecdsa.PublicKey currently, but may support more types in the future.
+// an *ecdsa.PublicKey, *rsa.PublicKey or ed25519.PublicKey.
 func KeyId(raw interface{}) ([]byte, error) {
 	switch raw.(type) {
 	case *ecdsa.PublicKey:
 	case *rsa.PublicKey:
+	case ed25519.PublicKey:
 	default:
 		return nil, fmt.Errorf("invalid key type: %T", raw)
 	}
@@ -191,6 +193,20 @@ func EncodeSerialNumber(serial *big.Int) string {
 	return HexString(serial.Bytes())
 }
 
+// ParseSerialNumber parses a serial number encoded as a hex string, with or
+// without the colons added by EncodeSerialNumber.
+func ParseSerialNumber(s string) (*big.Int, error) {
+	raw := strings.ReplaceAll(s, ":", "")
+	if len(raw)%2 == 1 {
+		raw = "0" + raw
+	}
+	bs, err := hex.DecodeString(raw)
+	if err != nil || len(bs) == 0 {
+		return nil, fmt.Errorf("invalid serial number %q", s)
+	}
+	return new(big.Int).SetBytes(bs), nil
+}
+
 // EncodeSigningKeyID encodes the given AuthorityKeyId or SubjectKeyId into a
 // colon-hex encoded string suitable for using as a SigningKeyID value.
 func EncodeSigningKeyID(keyID []byte) string { return HexString(keyID) }
@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package connect
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSerialNumber(t *testing.T) {
+	cases := map[string]struct {
+		input   string
+		want    int64
+		wantErr bool
+	}{
+		"colon-hex":     {input: "01:0a:ff", want: 0x010aff},
+		"plain hex":     {input: "010aff", want: 0x010aff},
+		"odd length":    {input: "10aff", want: 0x010aff},
+		"upper case":    {input: "0A:FF", want: 0x0aff},
+		"empty":         {input: "", wantErr: true},
+		"not hex":       {input: "zz", wantErr: true},
+		"only colons":   {input: "::", wantErr: true},
+		"round tripped": {input: EncodeSerialNumber(big.NewInt(123456789)), want: 123456789},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			sn, err := ParseSerialNumber(tc.input)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, big.NewInt(tc.want), sn)
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/hashicorp/consul/agent/consul"
 	"github.com/hashicorp/consul/agent/structs"
@@ -54,6 +55,34 @@ func (s *HTTPHandlers) ConnectCARoots(resp http.ResponseWriter, req *http.Reques
 	return nil, nil
 }
 
+// GET /v1/connect/ca/certs
+func (s *HTTPHandlers) ConnectCACerts(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	var args structs.CACertsRequest
+	if done := s.parse(resp, req, &args.Datacenter, &args.QueryOptions); done {
+		return nil, nil
+	}
+
+	if within := req.URL.Query().Get("expiring-within"); within != "" {
+		dur, err := time.ParseDuration(within)
+		if err != nil || dur < 0 {
+			return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: "The 'expiring-within' query parameter must be a positive duration"}
+		}
+		args.ExpiringWithin = dur
+	}
+
+	var reply structs.IndexedIssuedCerts
+	defer setMeta(resp, &reply.QueryMeta)
+	if err := s.agent.RPC(req.Context(), "ConnectCA.Certs", &args, &reply); err != nil {
+		return nil, err
+	}
+
+	// Use empty list instead of nil
+	if reply.Certs == nil {
+		reply.Certs = make([]*structs.IssuedCert, 0)
+	}
+	return reply.Certs, nil
+}
+
 // /v1/connect/ca/configuration
 func (s *HTTPHandlers) ConnectCAConfiguration(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
 	switch req.Method {
@@ -107,3 +136,22 @@ func (s *HTTPHandlers) ConnectCAConfigurationSet(req *http.Request) (interface{}
 	}
 	return nil, err
 }
+
+// PUT /v1/connect/ca/revoke
+func (s *HTTPHandlers) ConnectCARevoke(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	var args structs.CARevokeRequest
+	s.parseDC(req, &args.Datacenter)
+	s.parseToken(req, &args.Token)
+	if err := decodeBody(req.Body, &args); err != nil {
+		return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: fmt.Sprintf("Request decode failed: %v", err)}
+	}
+	if args.SerialNumber == "" && args.SpiffeID == "" {
+		return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: "SerialNumber or SpiffeID is required"}
+	}
+
+	var reply structs.CARevokedCert
+	if err := s.agent.RPC(req.Context(), "ConnectCA.Revoke", &args, &reply); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
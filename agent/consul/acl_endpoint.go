@@ -39,6 +39,10 @@ var ACLEndpointSummaries = []prometheus.SummaryDefinition{
 		Name: []string{"acl", "token", "clone"},
 		Help: "",
 	},
+	{
+		Name: []string{"acl", "token", "exchange"},
+		Help: "",
+	},
 	{
 		Name: []string{"acl", "token", "upsert"},
 		Help: "",
@@ -289,7 +293,7 @@ func (a *ACL) TokenRead(args *structs.ACLTokenGetRequest, reply *structs.ACLToke
 		// Only ACLRead privileges are required to list tokens
 		// However if you do not have ACLWrite as well the token
 		// secrets will be redacted
-		if authz, err = a.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext); err != nil {
+		if authz, err = a.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, &authzContext); err != nil {
 			return err
 		} else if err := authz.ToAllowAuthorizer().ACLReadAllowed(&authzContext); err != nil {
 			return err
@@ -474,7 +478,7 @@ func (a *ACL) TokenClone(args *structs.ACLTokenSetRequest, reply *structs.ACLTok
 	defer metrics.MeasureSince([]string{"acl", "token", "clone"}, time.Now())
 
 	var authzContext acl.AuthorizerContext
-	authz, err := a.srv.ResolveTokenAndDefaultMeta(args.Token, &args.ACLToken.EnterpriseMeta, &authzContext)
+	authz, err := a.srv.ResolveRequestTokenAndDefaultMeta(args, &args.ACLToken.EnterpriseMeta, &authzContext)
 	if err != nil {
 		return err
 	} else if err := authz.ToAllowAuthorizer().ACLWriteAllowed(&authzContext); err != nil {
@@ -524,6 +528,78 @@ func (a *ACL) TokenClone(args *structs.ACLTokenSetRequest, reply *structs.ACLTok
 	return err
 }
 
+// TokenExchange creates a short-lived local token for the caller. The child
+// token may only be granted a subset of the privileges of the caller's token
+// and must not outlive it. Unlike TokenSet it does not require acl:write.
+func (a *ACL) TokenExchange(args *structs.ACLTokenSetRequest, reply *structs.ACLToken) error {
+	if err := a.aclPreCheck(); err != nil {
+		return err
+	}
+
+	if err := a.srv.validateEnterpriseRequest(&args.ACLToken.EnterpriseMeta, true); err != nil {
+		return err
+	}
+
+	// Exchanged tokens are always local.
+	if !a.srv.LocalTokensEnabled() {
+		return fmt.Errorf("Local tokens are disabled")
+	}
+
+	if done, err := a.srv.ForwardRPC("ACL.TokenExchange", args, reply); done {
+		return err
+	}
+
+	defer metrics.MeasureSince([]string{"acl", "token", "exchange"}, time.Now())
+
+	var authzContext acl.AuthorizerContext
+	authz, err := a.srv.ResolveRequestTokenAndDefaultMeta(args, &args.ACLToken.EnterpriseMeta, &authzContext)
+	if err != nil {
+		return err
+	}
+
+	parent, ok := authz.ACLIdentity.(*structs.ACLToken)
+	if !ok || parent.AccessorID == acl.AnonymousTokenID {
+		return acl.PermissionDenied("token exchange requires an ACL token")
+	}
+
+	template := &args.ACLToken
+	switch {
+	case template.AccessorID != "" || template.SecretID != "":
+		return fmt.Errorf("AccessorID and SecretID cannot be set for an exchanged token")
+	case template.ExpirationTime != nil:
+		return fmt.Errorf("ExpirationTime cannot be set for an exchanged token, use ExpirationTTL")
+	case template.ExpirationTTL <= 0:
+		return fmt.Errorf("ExpirationTTL is required for an exchanged token")
+	}
+
+	child := &structs.ACLToken{
+		Policies:          template.Policies,
+		Roles:             template.Roles,
+		ServiceIdentities: template.ServiceIdentities,
+		NodeIdentities:    template.NodeIdentities,
+		TemplatedPolicies: template.TemplatedPolicies,
+		Local:             true,
+		Description:       template.Description,
+		ExpirationTTL:     template.ExpirationTTL,
+		EnterpriseMeta:    template.EnterpriseMeta,
+	}
+	if child.Description == "" {
+		child.Description = fmt.Sprintf("exchanged from token %s", parent.AccessorID)
+	}
+
+	updated, err := a.srv.aclTokenWriter().CreateChecked(child, func(token *structs.ACLToken) error {
+		if parent.HasExpirationTime() && token.ExpirationTime.After(*parent.ExpirationTime) {
+			return fmt.Errorf("ExpirationTTL would outlive the parent token, which expires at %s",
+				parent.ExpirationTime.Format(time.RFC3339))
+		}
+		return a.srv.checkTokenExchangeSubset(parent, token, authz.Authorizer, &authzContext)
+	})
+	if err == nil {
+		*reply = *updated
+	}
+	return err
+}
+
 func (a *ACL) TokenSet(args *structs.ACLTokenSetRequest, reply *structs.ACLToken) error {
 	if err := a.aclPreCheck(); err != nil {
 		return err
@@ -548,7 +624,7 @@ func (a *ACL) TokenSet(args *structs.ACLTokenSetRequest, reply *structs.ACLToken
 
 	// Verify token is permitted to modify ACLs
 	var authzContext acl.AuthorizerContext
-	if authz, err := a.srv.ResolveTokenAndDefaultMeta(args.Token, &args.ACLToken.EnterpriseMeta, &authzContext); err != nil {
+	if authz, err := a.srv.ResolveRequestTokenAndDefaultMeta(args, &args.ACLToken.EnterpriseMeta, &authzContext); err != nil {
 		return err
 	} else if err := authz.ToAllowAuthorizer().ACLWriteAllowed(&authzContext); err != nil {
 		return err
@@ -592,7 +668,7 @@ func (a *ACL) TokenDelete(args *structs.ACLTokenDeleteRequest, reply *string) er
 
 	// Verify token is permitted to modify ACLs
 	var authzContext acl.AuthorizerContext
-	if authz, err := a.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext); err != nil {
+	if authz, err := a.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, &authzContext); err != nil {
 		return err
 	} else if err := authz.ToAllowAuthorizer().ACLWriteAllowed(&authzContext); err != nil {
 		return err
@@ -679,7 +755,7 @@ func (a *ACL) TokenList(args *structs.ACLTokenListRequest, reply *structs.ACLTok
 
 	var authzContext acl.AuthorizerContext
 	var requestMeta acl.EnterpriseMeta
-	authz, err := a.srv.ResolveTokenAndDefaultMeta(args.Token, &requestMeta, &authzContext)
+	authz, err := a.srv.ResolveRequestTokenAndDefaultMeta(args, &requestMeta, &authzContext)
 	if err != nil {
 		return err
 	}
@@ -748,7 +824,7 @@ func (a *ACL) TokenBatchRead(args *structs.ACLTokenBatchGetRequest, reply *struc
 		return err
 	}
 
-	authz, err := a.srv.ResolveToken(args.Token)
+	authz, err := a.srv.ResolveRequestToken(args)
 	if err != nil {
 		return err
 	}
@@ -801,7 +877,7 @@ func (a *ACL) PolicyRead(args *structs.ACLPolicyGetRequest, reply *structs.ACLPo
 	}
 
 	var authzContext acl.AuthorizerContext
-	if authz, err := a.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext); err != nil {
+	if authz, err := a.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, &authzContext); err != nil {
 		return err
 	} else if err := authz.ToAllowAuthorizer().ACLReadAllowed(&authzContext); err != nil {
 		return err
@@ -841,7 +917,7 @@ func (a *ACL) PolicyBatchRead(args *structs.ACLPolicyBatchGetRequest, reply *str
 		return err
 	}
 
-	authz, err := a.srv.ResolveToken(args.Token)
+	authz, err := a.srv.ResolveRequestToken(args)
 	if err != nil {
 		return err
 	}
@@ -882,7 +958,7 @@ func (a *ACL) PolicySet(args *structs.ACLPolicySetRequest, reply *structs.ACLPol
 	// Verify token is permitted to modify ACLs
 	var authzContext acl.AuthorizerContext
 
-	if authz, err := a.srv.ResolveTokenAndDefaultMeta(args.Token, &args.Policy.EnterpriseMeta, &authzContext); err != nil {
+	if authz, err := a.srv.ResolveRequestTokenAndDefaultMeta(args, &args.Policy.EnterpriseMeta, &authzContext); err != nil {
 		return err
 	} else if err := authz.ToAllowAuthorizer().ACLWriteAllowed(&authzContext); err != nil {
 		return err
@@ -1012,7 +1088,7 @@ func (a *ACL) PolicyDelete(args *structs.ACLPolicyDeleteRequest, reply *string)
 	// Verify token is permitted to modify ACLs
 	var authzContext acl.AuthorizerContext
 
-	if authz, err := a.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext); err != nil {
+	if authz, err := a.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, &authzContext); err != nil {
 		return err
 	} else if err := authz.ToAllowAuthorizer().ACLWriteAllowed(&authzContext); err != nil {
 		return err
@@ -1065,7 +1141,7 @@ func (a *ACL) PolicyList(args *structs.ACLPolicyListRequest, reply *structs.ACLP
 
 	var authzContext acl.AuthorizerContext
 
-	authz, err := a.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext)
+	authz, err := a.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, &authzContext)
 	if err != nil {
 		return err
 	} else if err := authz.ToAllowAuthorizer().ACLReadAllowed(&authzContext); err != nil {
@@ -1190,7 +1266,7 @@ func (a *ACL) RoleRead(args *structs.ACLRoleGetRequest, reply *structs.ACLRoleRe
 
 	var authzContext acl.AuthorizerContext
 
-	if authz, err := a.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext); err != nil {
+	if authz, err := a.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, &authzContext); err != nil {
 		return err
 	} else if err := authz.ToAllowAuthorizer().ACLReadAllowed(&authzContext); err != nil {
 		return err
@@ -1230,7 +1306,7 @@ func (a *ACL) RoleBatchRead(args *structs.ACLRoleBatchGetRequest, reply *structs
 		return err
 	}
 
-	authz, err := a.srv.ResolveToken(args.Token)
+	authz, err := a.srv.ResolveRequestToken(args)
 	if err != nil {
 		return err
 	}
@@ -1271,7 +1347,7 @@ func (a *ACL) RoleSet(args *structs.ACLRoleSetRequest, reply *structs.ACLRole) e
 	// Verify token is permitted to modify ACLs
 	var authzContext acl.AuthorizerContext
 
-	if authz, err := a.srv.ResolveTokenAndDefaultMeta(args.Token, &args.Role.EnterpriseMeta, &authzContext); err != nil {
+	if authz, err := a.srv.ResolveRequestTokenAndDefaultMeta(args, &args.Role.EnterpriseMeta, &authzContext); err != nil {
 		return err
 	} else if err := authz.ToAllowAuthorizer().ACLWriteAllowed(&authzContext); err != nil {
 		return err
@@ -1450,7 +1526,7 @@ func (a *ACL) RoleDelete(args *structs.ACLRoleDeleteRequest, reply *string) erro
 	// Verify token is permitted to modify ACLs
 	var authzContext acl.AuthorizerContext
 
-	if authz, err := a.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext); err != nil {
+	if authz, err := a.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, &authzContext); err != nil {
 		return err
 	} else if err := authz.ToAllowAuthorizer().ACLWriteAllowed(&authzContext); err != nil {
 		return err
@@ -1499,7 +1575,7 @@ func (a *ACL) RoleList(args *structs.ACLRoleListRequest, reply *structs.ACLRoleL
 
 	var authzContext acl.AuthorizerContext
 
-	authz, err := a.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext)
+	authz, err := a.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, &authzContext)
 	if err != nil {
 		return err
 	} else if err := authz.ToAllowAuthorizer().ACLReadAllowed(&authzContext); err != nil {
@@ -1598,7 +1674,7 @@ func (a *ACL) BindingRuleRead(args *structs.ACLBindingRuleGetRequest, reply *str
 
 	var authzContext acl.AuthorizerContext
 
-	authz, err := a.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext)
+	authz, err := a.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, &authzContext)
 	if err != nil {
 		return err
 	} else if err := authz.ToAllowAuthorizer().ACLReadAllowed(&authzContext); err != nil {
@@ -1642,7 +1718,7 @@ func (a *ACL) BindingRuleSet(args *structs.ACLBindingRuleSetRequest, reply *stru
 	var authzContext acl.AuthorizerContext
 
 	// Verify token is permitted to modify ACLs
-	if authz, err := a.srv.ResolveTokenAndDefaultMeta(args.Token, &args.BindingRule.EnterpriseMeta, &authzContext); err != nil {
+	if authz, err := a.srv.ResolveRequestTokenAndDefaultMeta(args, &args.BindingRule.EnterpriseMeta, &authzContext); err != nil {
 		return err
 	} else if err := authz.ToAllowAuthorizer().ACLWriteAllowed(&authzContext); err != nil {
 		return err
@@ -1765,7 +1841,7 @@ func (a *ACL) BindingRuleDelete(args *structs.ACLBindingRuleDeleteRequest, reply
 	var authzContext acl.AuthorizerContext
 
 	// Verify token is permitted to modify ACLs
-	if authz, err := a.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext); err != nil {
+	if authz, err := a.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, &authzContext); err != nil {
 		return err
 	} else if err := authz.ToAllowAuthorizer().ACLWriteAllowed(&authzContext); err != nil {
 		return err
@@ -1816,7 +1892,7 @@ func (a *ACL) BindingRuleList(args *structs.ACLBindingRuleListRequest, reply *st
 
 	var authzContext acl.AuthorizerContext
 
-	authz, err := a.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext)
+	authz, err := a.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, &authzContext)
 	if err != nil {
 		return err
 	} else if err := authz.ToAllowAuthorizer().ACLReadAllowed(&authzContext); err != nil {
@@ -1856,7 +1932,7 @@ func (a *ACL) AuthMethodRead(args *structs.ACLAuthMethodGetRequest, reply *struc
 
 	var authzContext acl.AuthorizerContext
 
-	if authz, err := a.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext); err != nil {
+	if authz, err := a.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, &authzContext); err != nil {
 		return err
 	} else if err := authz.ToAllowAuthorizer().ACLReadAllowed(&authzContext); err != nil {
 		return err
@@ -1901,7 +1977,7 @@ func (a *ACL) AuthMethodSet(args *structs.ACLAuthMethodSetRequest, reply *struct
 	// Verify token is permitted to modify ACLs
 	var authzContext acl.AuthorizerContext
 
-	if authz, err := a.srv.ResolveTokenAndDefaultMeta(args.Token, &args.AuthMethod.EnterpriseMeta, &authzContext); err != nil {
+	if authz, err := a.srv.ResolveRequestTokenAndDefaultMeta(args, &args.AuthMethod.EnterpriseMeta, &authzContext); err != nil {
 		return err
 	} else if err := authz.ToAllowAuthorizer().ACLWriteAllowed(&authzContext); err != nil {
 		return err
@@ -2013,7 +2089,7 @@ func (a *ACL) AuthMethodDelete(args *structs.ACLAuthMethodDeleteRequest, reply *
 	// Verify token is permitted to modify ACLs
 	var authzContext acl.AuthorizerContext
 
-	if authz, err := a.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext); err != nil {
+	if authz, err := a.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, &authzContext); err != nil {
 		return err
 	} else if err := authz.ToAllowAuthorizer().ACLWriteAllowed(&authzContext); err != nil {
 		return err
@@ -2069,7 +2145,7 @@ func (a *ACL) AuthMethodList(args *structs.ACLAuthMethodListRequest, reply *stru
 
 	var authzContext acl.AuthorizerContext
 
-	authz, err := a.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext)
+	authz, err := a.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, &authzContext)
 	if err != nil {
 		return err
 	} else if err := authz.ToAllowAuthorizer().ACLReadAllowed(&authzContext); err != nil {
@@ -2188,7 +2264,7 @@ func (a *ACL) Authorize(args *structs.RemoteACLAuthorizationRequest, reply *[]st
 		return err
 	}
 
-	authz, err := a.srv.ResolveToken(args.Token)
+	authz, err := a.srv.ResolveRequestToken(args)
 	if err != nil {
 		return err
 	}
@@ -545,6 +545,120 @@ func TestACLEndpoint_TokenClone(t *testing.T) {
 	})
 }
 
+func TestACLEndpoint_TokenExchange(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	_, srv, codec := testACLServerWithConfig(t, func(c *Config) {
+		c.ACLTokenMinExpirationTTL = 10 * time.Millisecond
+		c.ACLTokenMaxExpirationTTL = 5 * time.Minute
+	}, false)
+	waitForLeaderEstablishment(t, srv)
+
+	parent, err := upsertTestTokenWithPolicyRules(codec, TestDefaultInitialManagementToken, "dc1", `
+		service_prefix "" { policy = "read" }
+		service_prefix "web" { policy = "write" }
+		node_prefix "" { policy = "read" }
+		key_prefix "ci/" { policy = "write" }`)
+	require.NoError(t, err)
+
+	narrow, err := upsertTestPolicyWithRules(codec, TestDefaultInitialManagementToken, "dc1", `
+		service "web" { policy = "write" }
+		key_prefix "ci/job-1/" { policy = "write" }`)
+	require.NoError(t, err)
+
+	broad, err := upsertTestPolicyWithRules(codec, TestDefaultInitialManagementToken, "dc1", `
+		key_prefix "" { policy = "read" }`)
+	require.NoError(t, err)
+
+	endpoint := ACL{srv: srv}
+
+	exchange := func(token string, child structs.ACLToken) (*structs.ACLToken, error) {
+		req := structs.ACLTokenSetRequest{
+			Datacenter:   "dc1",
+			ACLToken:     child,
+			WriteRequest: structs.WriteRequest{Token: token},
+		}
+		var out structs.ACLToken
+		if err := endpoint.TokenExchange(&req, &out); err != nil {
+			return nil, err
+		}
+		return &out, nil
+	}
+
+	t.Run("subset", func(t *testing.T) {
+		child, err := exchange(parent.SecretID, structs.ACLToken{
+			Policies:          []structs.ACLTokenPolicyLink{{ID: narrow.ID}},
+			ServiceIdentities: []*structs.ACLServiceIdentity{{ServiceName: "web"}},
+			ExpirationTTL:     time.Minute,
+		})
+		require.NoError(t, err)
+		require.True(t, child.Local)
+		require.NotNil(t, child.ExpirationTime)
+		require.Equal(t, "exchanged from token "+parent.AccessorID, child.Description)
+		require.Equal(t, []structs.ACLTokenPolicyLink{{ID: narrow.ID, Name: narrow.Name}}, child.Policies)
+
+		// the child token works without acl:write
+		authz, err := srv.ResolveToken(child.SecretID)
+		require.NoError(t, err)
+		require.Equal(t, acl.Allow, authz.KeyWrite("ci/job-1/status", nil))
+		require.Equal(t, acl.Deny, authz.KeyWrite("ci/job-2/status", nil))
+	})
+
+	t.Run("not a subset", func(t *testing.T) {
+		_, err := exchange(parent.SecretID, structs.ACLToken{
+			Policies:      []structs.ACLTokenPolicyLink{{ID: broad.ID}},
+			ExpirationTTL: time.Minute,
+		})
+		require.True(t, acl.IsErrPermissionDenied(err))
+		require.Contains(t, err.Error(), `key:read on ""`)
+
+		// service identities grant service:write on the service
+		_, err = exchange(parent.SecretID, structs.ACLToken{
+			ServiceIdentities: []*structs.ACLServiceIdentity{{ServiceName: "db"}},
+			ExpirationTTL:     time.Minute,
+		})
+		require.True(t, acl.IsErrPermissionDenied(err))
+	})
+
+	t.Run("expiration required", func(t *testing.T) {
+		_, err := exchange(parent.SecretID, structs.ACLToken{
+			Policies: []structs.ACLTokenPolicyLink{{ID: narrow.ID}},
+		})
+		require.ErrorContains(t, err, "ExpirationTTL is required")
+	})
+
+	t.Run("cannot outlive parent", func(t *testing.T) {
+		expiring, err := upsertTestToken(codec, TestDefaultInitialManagementToken, "dc1", func(t *structs.ACLToken) {
+			t.Policies = []structs.ACLTokenPolicyLink{{ID: narrow.ID}}
+			t.ExpirationTTL = time.Minute
+		})
+		require.NoError(t, err)
+
+		_, err = exchange(expiring.SecretID, structs.ACLToken{
+			Policies:      []structs.ACLTokenPolicyLink{{ID: narrow.ID}},
+			ExpirationTTL: 2 * time.Minute,
+		})
+		require.ErrorContains(t, err, "would outlive the parent token")
+
+		_, err = exchange(expiring.SecretID, structs.ACLToken{
+			Policies:      []structs.ACLTokenPolicyLink{{ID: narrow.ID}},
+			ExpirationTTL: 30 * time.Second,
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("anonymous", func(t *testing.T) {
+		_, err := exchange("", structs.ACLToken{
+			ExpirationTTL: time.Minute,
+		})
+		require.True(t, acl.IsErrPermissionDenied(err))
+	})
+}
+
 func TestACLEndpoint_TokenSet(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
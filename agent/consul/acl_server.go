@@ -6,9 +6,11 @@ package consul
 import (
 	"crypto/subtle"
 	"fmt"
+	"net"
 	"time"
 
 	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/acl/resolver"
 	"github.com/hashicorp/consul/agent/consul/auth"
 	"github.com/hashicorp/consul/agent/consul/authmethod"
 	"github.com/hashicorp/consul/agent/structs"
@@ -170,6 +172,45 @@ func (s *Server) ResolveIdentityFromToken(token string) (bool, structs.ACLIdenti
 	return s.InPrimaryDatacenter() || index > 0, nil, defaultErr
 }
 
+// aclRequest is an RPC request carrying the token to authorize it with and
+// the address of the client that made it.
+type aclRequest interface {
+	TokenSecret() string
+	RequestSourceIP() string
+}
+
+// ResolveRequestToken resolves the token of an RPC request. Conditional rules
+// with source_cidrs are evaluated against the address the request came from.
+func (s *Server) ResolveRequestToken(req aclRequest) (resolver.Result, error) {
+	result, err := s.ResolveToken(req.TokenSecret())
+	if err != nil {
+		return result, err
+	}
+	return withRequestSourceIP(result, req), nil
+}
+
+// ResolveRequestTokenAndDefaultMeta is the same as ResolveTokenAndDefaultMeta
+// for the token of an RPC request. Conditional rules with source_cidrs are
+// evaluated against the address the request came from.
+func (s *Server) ResolveRequestTokenAndDefaultMeta(
+	req aclRequest,
+	entMeta *acl.EnterpriseMeta,
+	authzContext *acl.AuthorizerContext,
+) (resolver.Result, error) {
+	result, err := s.ResolveTokenAndDefaultMeta(req.TokenSecret(), entMeta, authzContext)
+	if err != nil {
+		return result, err
+	}
+	return withRequestSourceIP(result, req), nil
+}
+
+func withRequestSourceIP(result resolver.Result, req aclRequest) resolver.Result {
+	if ip := net.ParseIP(req.RequestSourceIP()); ip != nil {
+		result.Authorizer = acl.WithSourceIP(result.Authorizer, ip)
+	}
+	return result
+}
+
 func (s *serverACLResolverBackend) ResolvePolicyFromID(policyID string) (bool, *structs.ACLPolicy, error) {
 	index, policy, err := s.fsm.State().ACLPolicyGetByID(nil, policyID, nil)
 	if err != nil {
@@ -198,8 +239,13 @@ func (s *serverACLResolverBackend) ResolveRoleFromID(roleID string) (bool, *stru
 	return s.InPrimaryDatacenter() || index > 0, role, acl.ErrNotFound
 }
 
-func (s *Server) filterACL(token string, subj interface{}) error {
-	return filterACL(s.ACLResolver, token, subj)
+func (s *Server) filterACL(req aclRequest, subj interface{}) error {
+	authorizer, err := s.ResolveRequestToken(req)
+	if err != nil {
+		return err
+	}
+	filterACLWithAuthorizer(s.ACLResolver.logger, authorizer, subj)
+	return nil
 }
 
 func (s *Server) filterACLWithAuthorizer(authorizer acl.Authorizer, subj interface{}) {
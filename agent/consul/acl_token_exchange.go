@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package consul
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// checkTokenExchangeSubset returns a permission denied error if the policies,
+// roles and identities linked to child grant any access that the parent
+// token's authorizer does not.
+func (s *Server) checkTokenExchangeSubset(parent, child *structs.ACLToken, parentAuthz acl.Authorizer, authzContext *acl.AuthorizerContext) error {
+	parentPolicies, err := s.ACLResolver.resolvePoliciesForIdentity(parent)
+	if err != nil {
+		return err
+	}
+	childPolicies, err := s.ACLResolver.resolvePoliciesForIdentity(child)
+	if err != nil {
+		return err
+	}
+
+	var conf acl.Config
+	if s.ACLResolver.aclConf != nil {
+		conf = *s.ACLResolver.aclConf
+	}
+	setEnterpriseConf(child.EnterpriseMetadata(), &conf)
+
+	// Parse the policies rather than using the resolver's cache, the child's
+	// rules are modified while compiling them.
+	parse := func(policies structs.ACLPolicies) ([]*acl.Policy, error) {
+		parsed := make([]*acl.Policy, 0, len(policies))
+		for _, policy := range policies {
+			p, err := acl.NewPolicyFromSource(policy.Rules, &conf, policy.EnterprisePolicyMeta())
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse %q: %v", policy.Name, err)
+			}
+			parsed = append(parsed, p)
+		}
+		return parsed, nil
+	}
+
+	parsedParent, err := parse(parentPolicies)
+	if err != nil {
+		return err
+	}
+	parsedChild, err := parse(childPolicies)
+	if err != nil {
+		return err
+	}
+
+	return acl.CheckPolicySubset(parsedChild, parsedParent, parentAuthz, &conf, authzContext)
+}
@@ -65,6 +65,17 @@ type TokenWriterStore interface {
 // Create a new token. Setting fromLogin to true changes behavior slightly for
 // tokens created by login (as opposed to set manually via the API).
 func (w *TokenWriter) Create(token *structs.ACLToken, fromLogin bool) (*structs.ACLToken, error) {
+	return w.create(token, fromLogin, nil)
+}
+
+// CreateChecked creates a new token like Create, but calls check with the
+// token once its role and policy links have been resolved, before it is
+// persisted. The token is not created if check returns an error.
+func (w *TokenWriter) CreateChecked(token *structs.ACLToken, check func(*structs.ACLToken) error) (*structs.ACLToken, error) {
+	return w.create(token, false, check)
+}
+
+func (w *TokenWriter) create(token *structs.ACLToken, fromLogin bool, check func(*structs.ACLToken) error) (*structs.ACLToken, error) {
 	if err := w.checkCanWriteToken(token); err != nil {
 		return nil, err
 	}
@@ -150,7 +161,7 @@ func (w *TokenWriter) Create(token *structs.ACLToken, fromLogin bool) (*structs.
 		}
 	}
 
-	return w.write(token, nil, fromLogin)
+	return w.write(token, nil, fromLogin, check)
 }
 
 // Update an existing token.
@@ -213,7 +224,7 @@ func (w *TokenWriter) Update(token *structs.ACLToken) (*structs.ACLToken, error)
 
 	token.CreateTime = match.CreateTime
 
-	return w.write(token, match, false)
+	return w.write(token, match, false, nil)
 }
 
 // Delete the ACL token with the given SecretID from the state store.
@@ -284,7 +295,7 @@ func (w *TokenWriter) tokenIDInUse(id string) (bool, error) {
 	return false, nil
 }
 
-func (w *TokenWriter) write(token, existing *structs.ACLToken, fromLogin bool) (*structs.ACLToken, error) {
+func (w *TokenWriter) write(token, existing *structs.ACLToken, fromLogin bool, check func(*structs.ACLToken) error) (*structs.ACLToken, error) {
 	roles, err := w.normalizeRoleLinks(token.Roles, &token.EnterpriseMeta)
 	if err != nil {
 		return nil, err
@@ -315,6 +326,12 @@ func (w *TokenWriter) write(token, existing *structs.ACLToken, fromLogin bool) (
 	}
 	token.TemplatedPolicies = templatedPolicies
 
+	if check != nil {
+		if err := check(token); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := w.enterpriseValidation(token, existing); err != nil {
 		return nil, err
 	}
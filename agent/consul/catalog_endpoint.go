@@ -115,7 +115,7 @@ func (c *Catalog) Register(args *structs.RegisterRequest, reply *struct{}) error
 	defer metrics.MeasureSince([]string{"catalog", "register"}, time.Now())
 
 	// Fetch the ACL token, if any.
-	authz, err := c.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, nil)
+	authz, err := c.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, nil)
 	if err != nil {
 		return err
 	}
@@ -267,16 +267,39 @@ func vetRegisterWithACL(
 	subj *structs.RegisterRequest,
 	ns *structs.NodeServices,
 ) error {
-	var authzContext acl.AuthorizerContext
-	subj.FillAuthzContext(&authzContext)
-
 	// Vet the node info. This allows service updates to re-post the required
 	// node info for each request without having to have node "write"
 	// privileges.
 	needsNode := ns == nil || subj.ChangesNode(ns.Node)
 
+	// Conditional rules on node metadata are evaluated against the metadata
+	// stored for the node, so that a request can't grant itself access by
+	// posting different metadata. When the request changes the node, the
+	// requested metadata must be allowed as well.
+	var metas []map[string]string
+	if ns != nil && ns.Node != nil {
+		metas = append(metas, ns.Node.Meta)
+	}
+	if needsNode {
+		metas = append(metas, subj.NodeMeta)
+	}
+	allowed := func(check func(*acl.AuthorizerContext) error) error {
+		for _, meta := range metas {
+			var authzContext acl.AuthorizerContext
+			subj.FillAuthzContext(&authzContext)
+			authzContext.NodeMeta = meta
+			if err := check(&authzContext); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	nodeWriteAllowed := func(ctx *acl.AuthorizerContext) error {
+		return authz.ToAllowAuthorizer().NodeWriteAllowed(subj.Node, ctx)
+	}
+
 	if needsNode {
-		if err := authz.ToAllowAuthorizer().NodeWriteAllowed(subj.Node, &authzContext); err != nil {
+		if err := allowed(nodeWriteAllowed); err != nil {
 			return err
 		}
 	}
@@ -285,7 +308,10 @@ func vetRegisterWithACL(
 	// the given service, and that we can write to any existing service that
 	// is being modified by id (if any).
 	if subj.Service != nil {
-		if err := authz.ToAllowAuthorizer().ServiceWriteAllowed(subj.Service.Service, &authzContext); err != nil {
+		err := allowed(func(ctx *acl.AuthorizerContext) error {
+			return authz.ToAllowAuthorizer().ServiceWriteAllowed(subj.Service.Service, ctx)
+		})
+		if err != nil {
 			return err
 		}
 
@@ -298,6 +324,9 @@ func vetRegisterWithACL(
 				// the regular ACL policy.
 				var secondaryCtx acl.AuthorizerContext
 				other.FillAuthzContext(&secondaryCtx)
+				if ns.Node != nil {
+					secondaryCtx.NodeMeta = ns.Node.Meta
+				}
 
 				if err := authz.ToAllowAuthorizer().ServiceWriteAllowed(other.Service, &secondaryCtx); err != nil {
 					return acl.ErrPermissionDenied
@@ -329,7 +358,7 @@ func vetRegisterWithACL(
 
 		// Node-level check.
 		if check.ServiceID == "" {
-			if err := authz.ToAllowAuthorizer().NodeWriteAllowed(subj.Node, &authzContext); err != nil {
+			if err := allowed(nodeWriteAllowed); err != nil {
 				return err
 			}
 			continue
@@ -387,7 +416,7 @@ func (c *Catalog) Deregister(args *structs.DeregisterRequest, reply *struct{}) e
 	}
 
 	// Fetch the ACL token, if any.
-	authz, err := c.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, nil)
+	authz, err := c.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, nil)
 	if err != nil {
 		return err
 	}
@@ -541,7 +570,7 @@ func (c *Catalog) ListNodes(args *structs.DCSpecificRequest, reply *structs.Inde
 			// that results they don't have access to have been removed.  If they were
 			// also allowed to run the bexpr filter on the data, they could potentially
 			// infer the specific attributes of data they don't have access to.
-			if err := c.srv.filterACL(args.Token, reply); err != nil {
+			if err := c.srv.filterACL(args, reply); err != nil {
 				return err
 			}
 
@@ -574,7 +603,7 @@ func (c *Catalog) ListServices(args *structs.DCSpecificRequest, reply *structs.I
 		return errors.New("listing service names imported from a peer is not supported")
 	}
 
-	authz, err := c.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, nil)
+	authz, err := c.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, nil)
 	if err != nil {
 		return err
 	}
@@ -667,7 +696,7 @@ func (c *Catalog) ServiceList(args *structs.DCSpecificRequest, reply *structs.In
 		return err
 	}
 
-	authz, err := c.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, nil)
+	authz, err := c.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, nil)
 	if err != nil {
 		return err
 	}
@@ -736,7 +765,7 @@ func (c *Catalog) ServiceNodes(args *structs.ServiceSpecificRequest, reply *stru
 	authzContext := acl.AuthorizerContext{
 		Peer: args.PeerName,
 	}
-	authz, err := c.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext)
+	authz, err := c.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, &authzContext)
 	if err != nil {
 		return err
 	}
@@ -837,7 +866,7 @@ func (c *Catalog) ServiceNodes(args *structs.ServiceSpecificRequest, reply *stru
 			// that results they don't have access to have been removed.  If they were
 			// also allowed to run the bexpr filter on the data, they could potentially
 			// infer the specific attributes of data they don't have access to.
-			if err := c.srv.filterACL(args.Token, reply); err != nil {
+			if err := c.srv.filterACL(args, reply); err != nil {
 				return err
 			}
 
@@ -906,7 +935,7 @@ func (c *Catalog) NodeServices(args *structs.NodeSpecificRequest, reply *structs
 		return err
 	}
 
-	_, err = c.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, nil)
+	_, err = c.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, nil)
 	if err != nil {
 		return err
 	}
@@ -933,7 +962,7 @@ func (c *Catalog) NodeServices(args *structs.NodeSpecificRequest, reply *structs
 			// that results they don't have access to have been removed.  If they were
 			// also allowed to run the bexpr filter on the data, they could potentially
 			// infer the specific attributes of data they don't have access to.
-			if err := c.srv.filterACL(args.Token, reply); err != nil {
+			if err := c.srv.filterACL(args, reply); err != nil {
 				return err
 			}
 
@@ -967,7 +996,7 @@ func (c *Catalog) NodeServiceList(args *structs.NodeSpecificRequest, reply *stru
 		return err
 	}
 
-	_, err = c.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, nil)
+	_, err = c.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, nil)
 	if err != nil {
 		return err
 	}
@@ -1045,7 +1074,7 @@ func (c *Catalog) NodeServiceList(args *structs.NodeSpecificRequest, reply *stru
 			// that results they don't have access to have been removed.  If they were
 			// also allowed to run the bexpr filter on the data, they could potentially
 			// infer the specific attributes of data they don't have access to.
-			if err := c.srv.filterACL(args.Token, reply); err != nil {
+			if err := c.srv.filterACL(args, reply); err != nil {
 				return err
 			}
 
@@ -1065,7 +1094,7 @@ func (c *Catalog) GatewayServices(args *structs.ServiceSpecificRequest, reply *s
 	}
 
 	var authzContext acl.AuthorizerContext
-	authz, err := c.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext)
+	authz, err := c.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, &authzContext)
 	if err != nil {
 		return err
 	}
@@ -1116,7 +1145,7 @@ func (c *Catalog) GatewayServices(args *structs.ServiceSpecificRequest, reply *s
 			}
 			reply.Index, reply.Services = index, services
 
-			if err := c.srv.filterACL(args.Token, reply); err != nil {
+			if err := c.srv.filterACL(args, reply); err != nil {
 				return err
 			}
 			return nil
@@ -1131,7 +1160,7 @@ func (c *Catalog) VirtualIPForService(args *structs.ServiceSpecificRequest, repl
 	authzContext := acl.AuthorizerContext{
 		Peer: args.PeerName,
 	}
-	authz, err := c.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext)
+	authz, err := c.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, &authzContext)
 	if err != nil {
 		return err
 	}
@@ -3981,6 +3981,63 @@ func TestVetRegisterWithACL(t *testing.T) {
 		require.NoError(t, vetRegisterWithACL(resolver.Result{Authorizer: acl.ManageAll()}, args, nil))
 	})
 
+	t.Run("Conditional rules are evaluated against the node metadata", func(t *testing.T) {
+		authz := appendAuthz(t, acl.DenyAll(), `
+		node_prefix "" {
+		  policy = "write"
+		  condition {
+		    node_meta {
+		      env = "dev"
+		    }
+		  }
+		}
+		service "web" {
+		  policy = "write"
+		}`)
+		result := resolver.Result{Authorizer: authz}
+
+		args := &structs.RegisterRequest{
+			Node:     "node",
+			Address:  "127.0.0.1",
+			NodeMeta: map[string]string{"env": "dev"},
+		}
+		require.NoError(t, vetRegisterWithACL(result, args, nil))
+
+		args.NodeMeta = map[string]string{"env": "prod"}
+		err := vetRegisterWithACL(result, args, nil)
+		require.True(t, acl.IsErrPermissionDenied(err))
+
+		// An existing node is checked against its stored metadata, so the
+		// request can't claim different metadata to gain access.
+		prod := &structs.NodeServices{
+			Node: &structs.Node{
+				Node:    "node",
+				Address: "127.0.0.1",
+				Meta:    map[string]string{"env": "prod"},
+			},
+			Services: make(map[string]*structs.NodeService),
+		}
+		args.NodeMeta = map[string]string{"env": "dev"}
+		err = vetRegisterWithACL(result, args, prod)
+		require.True(t, acl.IsErrPermissionDenied(err))
+
+		// Moving a dev node to prod requires access to both.
+		dev := &structs.NodeServices{
+			Node: &structs.Node{
+				Node:    "node",
+				Address: "127.0.0.1",
+				Meta:    map[string]string{"env": "dev"},
+			},
+			Services: make(map[string]*structs.NodeService),
+		}
+		args.NodeMeta = map[string]string{"env": "prod"}
+		err = vetRegisterWithACL(result, args, dev)
+		require.True(t, acl.IsErrPermissionDenied(err))
+
+		args.NodeMeta = dev.Node.Meta
+		require.NoError(t, vetRegisterWithACL(result, args, dev))
+	})
+
 	var perms acl.Authorizer = acl.DenyAll()
 	var resolvedPerms resolver.Result
 
@@ -48,7 +48,7 @@ func (c *ConfigEntry) Apply(args *structs.ConfigEntryRequest, reply *bool) error
 	defer metrics.MeasureSince([]string{"config_entry", "apply"}, time.Now())
 
 	entMeta := args.Entry.GetEnterpriseMeta()
-	authz, err := c.srv.ResolveTokenAndDefaultMeta(args.Token, entMeta, nil)
+	authz, err := c.srv.ResolveRequestTokenAndDefaultMeta(args, entMeta, nil)
 	if err != nil {
 		return err
 	}
@@ -167,7 +167,7 @@ func (c *ConfigEntry) Get(args *structs.ConfigEntryQuery, reply *structs.ConfigE
 	}
 	defer metrics.MeasureSince([]string{"config_entry", "get"}, time.Now())
 
-	authz, err := c.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, nil)
+	authz, err := c.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, nil)
 	if err != nil {
 		return err
 	}
@@ -212,7 +212,7 @@ func (c *ConfigEntry) List(args *structs.ConfigEntryQuery, reply *structs.Indexe
 	}
 	defer metrics.MeasureSince([]string{"config_entry", "list"}, time.Now())
 
-	authz, err := c.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, nil)
+	authz, err := c.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, nil)
 	if err != nil {
 		return err
 	}
@@ -326,7 +326,7 @@ func (c *ConfigEntry) ListAll(args *structs.ConfigEntryListAllRequest, reply *st
 	}
 	defer metrics.MeasureSince([]string{"config_entry", "listAll"}, time.Now())
 
-	authz, err := c.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, nil)
+	authz, err := c.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, nil)
 	if err != nil {
 		return err
 	}
@@ -390,7 +390,7 @@ func (c *ConfigEntry) Delete(args *structs.ConfigEntryRequest, reply *structs.Co
 	}
 	defer metrics.MeasureSince([]string{"config_entry", "delete"}, time.Now())
 
-	authz, err := c.srv.ResolveTokenAndDefaultMeta(args.Token, args.Entry.GetEnterpriseMeta(), nil)
+	authz, err := c.srv.ResolveRequestTokenAndDefaultMeta(args, args.Entry.GetEnterpriseMeta(), nil)
 	if err != nil {
 		return err
 	}
@@ -453,7 +453,7 @@ func (c *ConfigEntry) ResolveServiceConfig(args *structs.ServiceConfigRequest, r
 	defer metrics.MeasureSince([]string{"config_entry", "resolve_service_config"}, time.Now())
 
 	var authzContext acl.AuthorizerContext
-	authz, err := c.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext)
+	authz, err := c.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, &authzContext)
 	if err != nil {
 		return err
 	}
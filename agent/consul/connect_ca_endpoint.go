@@ -5,8 +5,10 @@ package consul
 
 import (
 	"fmt"
+	"sort"
 	"time"
 
+	"github.com/hashicorp/go-bexpr"
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-memdb"
 
@@ -63,7 +65,7 @@ func (s *ConnectCA) ConfigurationGet(
 	}
 
 	// This action requires operator read access.
-	authz, err := s.srv.ResolveToken(args.Token)
+	authz, err := s.srv.ResolveRequestToken(args)
 	if err != nil {
 		return err
 	}
@@ -95,7 +97,7 @@ func (s *ConnectCA) ConfigurationSet(
 	}
 
 	// This action requires operator write access.
-	authz, err := s.srv.ResolveToken(args.Token)
+	authz, err := s.srv.ResolveRequestToken(args)
 	if err != nil {
 		return err
 	}
@@ -152,12 +154,12 @@ func (s *ConnectCA) Sign(
 		return err
 	}
 
-	authz, err := s.srv.ResolveToken(args.Token)
+	authz, err := s.srv.ResolveRequestToken(args)
 	if err != nil {
 		return err
 	}
 
-	cert, err := s.srv.caManager.AuthorizeAndSignCertificate(csr, authz)
+	cert, err := s.srv.caManager.AuthorizeAndSignCertificate(csr, authz, args.Node)
 	if err != nil {
 		return err
 	}
@@ -165,6 +167,100 @@ func (s *ConnectCA) Sign(
 	return nil
 }
 
+// Revoke revokes leaf certificates issued in this datacenter.
+func (s *ConnectCA) Revoke(
+	args *structs.CARevokeRequest,
+	reply *structs.CARevokedCert) error {
+	// Exit early if Connect hasn't been enabled.
+	if !s.srv.config.ConnectEnabled {
+		return ErrConnectNotEnabled
+	}
+
+	if done, err := s.srv.ForwardRPC("ConnectCA.Revoke", args, reply); done {
+		return err
+	}
+
+	// This action requires operator write access.
+	authz, err := s.srv.ResolveRequestToken(args)
+	if err != nil {
+		return err
+	}
+	if err := authz.ToAllowAuthorizer().OperatorWriteAllowed(nil); err != nil {
+		return err
+	}
+
+	revoked, err := s.srv.caManager.RevokeCertificate(args)
+	if err != nil {
+		return err
+	}
+	*reply = *revoked
+	return nil
+}
+
+// Certs returns the inventory of the leaf certificates issued in the
+// datacenter that have not expired yet.
+func (s *ConnectCA) Certs(
+	args *structs.CACertsRequest,
+	reply *structs.IndexedIssuedCerts) error {
+	// Exit early if Connect hasn't been enabled.
+	if !s.srv.config.ConnectEnabled {
+		return ErrConnectNotEnabled
+	}
+
+	if done, err := s.srv.ForwardRPC("ConnectCA.Certs", args, reply); done {
+		return err
+	}
+
+	// This action requires operator read access.
+	authz, err := s.srv.ResolveRequestToken(args)
+	if err != nil {
+		return err
+	}
+	if err := authz.ToAllowAuthorizer().OperatorReadAllowed(nil); err != nil {
+		return err
+	}
+
+	filter, err := bexpr.CreateFilter(args.Filter, nil, reply.Certs)
+	if err != nil {
+		return err
+	}
+
+	return s.srv.blockingQuery(
+		&args.QueryOptions, &reply.QueryMeta,
+		func(ws memdb.WatchSet, state *state.Store) error {
+			index, certs, err := state.CALeafCerts(ws)
+			if err != nil {
+				return err
+			}
+
+			now := time.Now()
+			result := make([]*structs.IssuedCert, 0, len(certs))
+			for _, c := range certs {
+				// Expired certs are only pruned periodically.
+				if !now.Before(c.ValidBefore) {
+					continue
+				}
+				if args.ExpiringWithin > 0 && c.ValidBefore.Sub(now) > args.ExpiringWithin {
+					continue
+				}
+				result = append(result, c)
+			}
+			sort.Slice(result, func(i, j int) bool {
+				return result[i].ValidBefore.Before(result[j].ValidBefore)
+			})
+
+			raw, err := filter.Execute(result)
+			if err != nil {
+				return err
+			}
+
+			reply.Index = index
+			reply.Certs = raw.([]*structs.IssuedCert)
+			return nil
+		},
+	)
+}
+
 // SignIntermediate signs an intermediate certificate for a remote datacenter.
 func (s *ConnectCA) SignIntermediate(
 	args *structs.CASignRequest,
@@ -184,7 +280,7 @@ func (s *ConnectCA) SignIntermediate(
 	}
 
 	// This action requires operator write access.
-	authz, err := s.srv.ResolveToken(args.Token)
+	authz, err := s.srv.ResolveRequestToken(args)
 	if err != nil {
 		return err
 	}
@@ -1185,3 +1185,300 @@ func TestConnectCASignValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestConnectCARevoke(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	dir1, s1 := testServer(t)
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+
+	// Sign a leaf cert to revoke.
+	spiffeID := connect.TestSpiffeIDService(t, "web")
+	csr, _ := connect.TestCSR(t, spiffeID)
+	var leaf structs.IssuedCert
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.Sign", &structs.CASignRequest{
+		Datacenter: "dc1",
+		CSR:        csr,
+	}, &leaf))
+
+	// Nothing is revoked yet, so no revocation list is served.
+	var roots structs.IndexedCARoots
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.Roots", &structs.DCSpecificRequest{Datacenter: "dc1"}, &roots))
+	require.Empty(t, roots.RevokedCerts)
+	require.Empty(t, roots.RevocationLists)
+
+	// A revocation requires a serial number or SPIFFE ID.
+	var revoked structs.CARevokedCert
+	err := msgpackrpc.CallWithCodec(codec, "ConnectCA.Revoke", &structs.CARevokeRequest{Datacenter: "dc1"}, &revoked)
+	testutil.RequireErrorContains(t, err, "a serial number or SPIFFE ID is required")
+
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.Revoke", &structs.CARevokeRequest{
+		Datacenter:   "dc1",
+		SerialNumber: leaf.SerialNumber,
+		Reason:       "key compromise",
+	}, &revoked))
+	require.Equal(t, leaf.SerialNumber, revoked.SerialNumber)
+	require.NotZero(t, revoked.CreateIndex)
+	require.True(t, revoked.Matches(&leaf))
+	// A revoked serial expires with its cert.
+	require.True(t, leaf.ValidBefore.Equal(revoked.ExpiresAt))
+
+	retry.Run(t, func(r *retry.R) {
+		var roots structs.IndexedCARoots
+		require.NoError(r, msgpackrpc.CallWithCodec(codec, "ConnectCA.Roots", &structs.DCSpecificRequest{Datacenter: "dc1"}, &roots))
+		require.Len(r, roots.RevokedCerts, 1)
+		require.True(r, roots.IsRevoked(&leaf))
+		require.Len(r, roots.RevocationLists, 1)
+
+		list := roots.RevocationLists[0]
+		require.Equal(r, "dc1", list.Datacenter)
+		require.Equal(r, roots.Active().SigningKeyID, list.SigningKeyID)
+		require.Equal(r, 1, list.NumRevoked)
+
+		// The list is signed by the leaf signing cert and contains the serial.
+		block, _ := pem.Decode([]byte(list.RevocationListPEM))
+		require.NotNil(r, block)
+		crl, err := x509.ParseRevocationList(block.Bytes)
+		require.NoError(r, err)
+		require.Len(r, crl.RevokedCertificateEntries, 1)
+		require.Equal(r, leaf.SerialNumber, connect.EncodeSerialNumber(crl.RevokedCertificateEntries[0].SerialNumber))
+
+		signer := testParseCert(t, roots.Active().RootCert)
+		if n := len(roots.Active().IntermediateCerts); n > 0 {
+			signer = testParseCert(t, roots.Active().IntermediateCerts[n-1])
+		}
+		require.NoError(r, crl.CheckSignatureFrom(signer))
+	})
+
+	// Revoking an identity covers the certs issued before the revocation, which
+	// are valid for the longest leaf cert TTL at most.
+	var configOK bool
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConfigEntry.Apply", &structs.ConfigEntryRequest{
+		Datacenter: "dc1",
+		Entry: &structs.ServiceConfigEntry{
+			Kind:     structs.ServiceDefaults,
+			Name:     "api",
+			LeafCert: &structs.LeafCertConfig{TTL: 90 * time.Hour},
+		},
+	}, &configOK))
+	require.True(t, configOK)
+
+	var other structs.IssuedCert
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.Sign", &structs.CASignRequest{
+		Datacenter: "dc1",
+		CSR:        csr,
+	}, &other))
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.Revoke", &structs.CARevokeRequest{
+		Datacenter: "dc1",
+		SpiffeID:   spiffeID.URI().String(),
+	}, &revoked))
+	require.Empty(t, revoked.SerialNumber)
+	require.Equal(t, spiffeID.URI().String(), revoked.SpiffeID)
+	require.Equal(t, 90*time.Hour, revoked.ExpiresAt.Sub(revoked.RevokedAt))
+
+	// The revocation list contains the serials of the certs the identity
+	// revocation covers, each of them once.
+	retry.Run(t, func(r *retry.R) {
+		var roots structs.IndexedCARoots
+		require.NoError(r, msgpackrpc.CallWithCodec(codec, "ConnectCA.Roots", &structs.DCSpecificRequest{Datacenter: "dc1"}, &roots))
+		require.Len(r, roots.RevokedCerts, 2)
+		require.True(r, roots.IsRevoked(&other))
+		require.Len(r, roots.RevocationLists, 1)
+		require.Equal(r, 2, roots.RevocationLists[0].NumRevoked)
+
+		block, _ := pem.Decode([]byte(roots.RevocationLists[0].RevocationListPEM))
+		require.NotNil(r, block)
+		crl, err := x509.ParseRevocationList(block.Bytes)
+		require.NoError(r, err)
+		var serials []string
+		for _, entry := range crl.RevokedCertificateEntries {
+			serials = append(serials, connect.EncodeSerialNumber(entry.SerialNumber))
+		}
+		require.ElementsMatch(r, []string{leaf.SerialNumber, other.SerialNumber}, serials)
+	})
+}
+
+func TestServedRevocationLists(t *testing.T) {
+	active := &structs.CARoot{SigningKeyID: "aa"}
+	local := &structs.CARevocationList{Datacenter: "dc1", SigningKeyID: "aa", RevocationListPEM: "local", NumRevoked: 1}
+	localEmpty := &structs.CARevocationList{Datacenter: "dc1", SigningKeyID: "aa", RevocationListPEM: "local"}
+	previous := &structs.CARevocationList{Datacenter: "dc1", SigningKeyID: "bb", RevocationListPEM: "previous"}
+	remote := &structs.CARevocationList{Datacenter: "dc2", SigningKeyID: "cc", RevocationListPEM: "remote"}
+	placeholder := &structs.CARevocationList{Datacenter: "dc3"}
+
+	tests := []struct {
+		name   string
+		lists  []*structs.CARevocationList
+		active *structs.CARoot
+		want   []*structs.CARevocationList
+	}{
+		{
+			name:   "no active root",
+			lists:  []*structs.CARevocationList{local},
+			active: nil,
+		},
+		{
+			name:   "nothing revoked",
+			lists:  []*structs.CARevocationList{localEmpty, remote},
+			active: active,
+		},
+		{
+			name:   "active signing cert not covered",
+			lists:  []*structs.CARevocationList{previous, remote},
+			active: active,
+		},
+		{
+			name:   "datacenter without a list",
+			lists:  []*structs.CARevocationList{local, placeholder},
+			active: active,
+		},
+		{
+			name:   "all lists sorted",
+			lists:  []*structs.CARevocationList{remote, local, previous},
+			active: active,
+			want:   []*structs.CARevocationList{local, previous, remote},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, servedRevocationLists("dc1", tc.lists, tc.active))
+		})
+	}
+}
+
+func TestConnectCACerts(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	dir1, s1 := testServer(t)
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+
+	// Sign leaf certs for two services on different nodes.
+	sign := func(service, node string) structs.IssuedCert {
+		csr, _ := connect.TestCSR(t, connect.TestSpiffeIDService(t, service))
+		var leaf structs.IssuedCert
+		require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.Sign", &structs.CASignRequest{
+			Datacenter: "dc1",
+			CSR:        csr,
+			Node:       node,
+		}, &leaf))
+		return leaf
+	}
+	web := sign("web", "node1")
+	api := sign("api", "node2")
+
+	var roots structs.IndexedCARoots
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.Roots", &structs.DCSpecificRequest{Datacenter: "dc1"}, &roots))
+	require.Equal(t, roots.Active().SigningKeyID, web.SigningKeyID)
+	require.Equal(t, "node1", web.Node)
+
+	var certs structs.IndexedIssuedCerts
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.Certs", &structs.CACertsRequest{Datacenter: "dc1"}, &certs))
+	require.Len(t, certs.Certs, 2)
+	require.Equal(t, api.ModifyIndex, certs.Index)
+
+	byService := make(map[string]*structs.IssuedCert)
+	for _, c := range certs.Certs {
+		require.Empty(t, c.CertPEM)
+		byService[c.Service] = c
+	}
+	require.Equal(t, web.SerialNumber, byService["web"].SerialNumber)
+	require.Equal(t, web.ServiceURI, byService["web"].ServiceURI)
+	require.Equal(t, "node1", byService["web"].Node)
+	require.Equal(t, web.SigningKeyID, byService["web"].SigningKeyID)
+	require.Equal(t, web.ModifyIndex, byService["web"].ModifyIndex)
+	require.Equal(t, "node2", byService["api"].Node)
+
+	// Filter on the certs.
+	certs = structs.IndexedIssuedCerts{}
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.Certs", &structs.CACertsRequest{
+		Datacenter:   "dc1",
+		QueryOptions: structs.QueryOptions{Filter: `Node == "node2"`},
+	}, &certs))
+	require.Len(t, certs.Certs, 1)
+	require.Equal(t, api.SerialNumber, certs.Certs[0].SerialNumber)
+
+	// The certs are valid for the default leaf cert TTL of 72h.
+	certs = structs.IndexedIssuedCerts{}
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.Certs", &structs.CACertsRequest{
+		Datacenter:     "dc1",
+		ExpiringWithin: 24 * time.Hour,
+	}, &certs))
+	require.Empty(t, certs.Certs)
+
+	certs = structs.IndexedIssuedCerts{}
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.Certs", &structs.CACertsRequest{
+		Datacenter:     "dc1",
+		ExpiringWithin: 96 * time.Hour,
+	}, &certs))
+	require.Len(t, certs.Certs, 2)
+}
+
+func TestCAManager_UpdateLeafCertInventory(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	_, s1 := testServerWithConfig(t)
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+
+	now := time.Now()
+	expired := &structs.IssuedCert{
+		SerialNumber: "0a:0b",
+		Service:      "web",
+		ServiceURI:   "spiffe://11111111-2222-3333-4444-555555555555.consul/ns/default/dc/dc1/svc/web",
+		ValidAfter:   now.Add(-2 * time.Hour),
+		ValidBefore:  now.Add(-time.Hour),
+	}
+	valid := &structs.IssuedCert{
+		SerialNumber: "0c:0d",
+		Service:      "web",
+		ServiceURI:   expired.ServiceURI,
+		ValidAfter:   now.Add(-time.Hour),
+		ValidBefore:  now.Add(time.Hour),
+	}
+	for _, c := range []*structs.IssuedCert{expired, valid} {
+		_, err := s1.raftApplyMsgpack(structs.ConnectCALeafRequestType, &structs.CALeafRequest{
+			Op:   structs.CALeafOpSetCert,
+			Cert: c,
+		})
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, s1.caManager.updateLeafCertInventory())
+
+	_, certs, err := s1.fsm.State().CALeafCerts(nil)
+	require.NoError(t, err)
+	require.Len(t, certs, 1)
+	require.Equal(t, valid.SerialNumber, certs[0].SerialNumber)
+}
+
+func TestCurrentLeafCerts(t *testing.T) {
+	now := time.Now()
+	webOld := &structs.IssuedCert{SerialNumber: "01", ServiceURI: "web", Node: "node1", ValidAfter: now.Add(-2 * time.Hour)}
+	webNew := &structs.IssuedCert{SerialNumber: "02", ServiceURI: "web", Node: "node1", ValidAfter: now.Add(-time.Hour)}
+	webOtherNode := &structs.IssuedCert{SerialNumber: "03", ServiceURI: "web", Node: "node2", ValidAfter: now.Add(-2 * time.Hour)}
+	agent := &structs.IssuedCert{SerialNumber: "04", AgentURI: "agent", Node: "node1", ValidAfter: now.Add(-2 * time.Hour)}
+
+	current := currentLeafCerts([]*structs.IssuedCert{webNew, webOld, webOtherNode, agent})
+	require.ElementsMatch(t, []*structs.IssuedCert{webNew, webOtherNode, agent}, current)
+}
@@ -146,7 +146,7 @@ func (c *Coordinate) Update(args *structs.CoordinateUpdateRequest, reply *struct
 
 	// Fetch the ACL token, if any, and enforce the node policy if enabled.
 	var authzContext acl.AuthorizerContext
-	authz, err := c.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext)
+	authz, err := c.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, &authzContext)
 	if err != nil {
 		return err
 	}
@@ -205,7 +205,7 @@ func (c *Coordinate) ListNodes(args *structs.DCSpecificRequest, reply *structs.I
 		return err
 	}
 
-	_, err := c.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, nil)
+	_, err := c.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, nil)
 	if err != nil {
 		return err
 	}
@@ -223,7 +223,7 @@ func (c *Coordinate) ListNodes(args *structs.DCSpecificRequest, reply *structs.I
 			}
 
 			reply.Index, reply.Coordinates = index, coords
-			if err := c.srv.filterACL(args.Token, reply); err != nil {
+			if err := c.srv.filterACL(args, reply); err != nil {
 				return err
 			}
 
@@ -239,7 +239,7 @@ func (c *Coordinate) Node(args *structs.NodeSpecificRequest, reply *structs.Inde
 
 	// Fetch the ACL token, if any, and enforce the node policy if enabled.
 	var authzContext acl.AuthorizerContext
-	authz, err := c.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext)
+	authz, err := c.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, &authzContext)
 	if err != nil {
 		return err
 	}
@@ -35,7 +35,7 @@ func (c *DiscoveryChain) Get(args *structs.DiscoveryChainRequest, reply *structs
 	// Fetch the ACL token, if any.
 	entMeta := args.GetEnterpriseMeta()
 	var authzContext acl.AuthorizerContext
-	authz, err := c.srv.ResolveTokenAndDefaultMeta(args.Token, entMeta, &authzContext)
+	authz, err := c.srv.ResolveRequestTokenAndDefaultMeta(args, entMeta, &authzContext)
 	if err != nil {
 		return err
 	}
@@ -65,6 +65,7 @@ func (c *DiscoveryChain) Get(args *structs.DiscoveryChainRequest, reply *structs
 				EvaluateInNamespace:    entMeta.NamespaceOrDefault(),
 				EvaluateInPartition:    entMeta.PartitionOrDefault(),
 				EvaluateInDatacenter:   evalDC,
+				EvaluateInPeer:         args.EvaluateInPeer,
 				OverrideMeshGateway:    args.OverrideMeshGateway,
 				OverrideProtocol:       args.OverrideProtocol,
 				OverrideConnectTimeout: args.OverrideConnectTimeout,
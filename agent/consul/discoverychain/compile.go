@@ -23,6 +23,12 @@ type CompileRequest struct {
 	EvaluateInDatacenter  string
 	EvaluateInTrustDomain string
 
+	// EvaluateInPeer compiles the chain for the service of the same name
+	// imported from this cluster peer, as used by upstreams with an explicit
+	// destination peer. Local routers, splitters and resolvers for the service
+	// are ignored in favor of the ones exported by the peer.
+	EvaluateInPeer string
+
 	// OverrideMeshGateway allows for the setting to be overridden for any
 	// resolver in the compiled chain.
 	OverrideMeshGateway structs.MeshGatewayConfig
@@ -97,6 +103,7 @@ func Compile(req CompileRequest) (*structs.CompiledDiscoveryChain, error) {
 		evaluateInNamespace:    evaluateInNamespace,
 		evaluateInDatacenter:   evaluateInDatacenter,
 		evaluateInTrustDomain:  evaluateInTrustDomain,
+		evaluateInPeer:         req.EvaluateInPeer,
 		overrideMeshGateway:    req.OverrideMeshGateway,
 		overrideProtocol:       req.OverrideProtocol,
 		overrideConnectTimeout: req.OverrideConnectTimeout,
@@ -123,6 +130,9 @@ func Compile(req CompileRequest) (*structs.CompiledDiscoveryChain, error) {
 			c.resolvers[k] = v
 		}
 	}
+	if c.evaluateInPeer != "" {
+		delete(c.resolvers, structs.NewServiceID(serviceName, c.GetEnterpriseMeta()))
+	}
 
 	return c.compile()
 }
@@ -135,6 +145,7 @@ type compiler struct {
 	evaluateInPartition    string
 	evaluateInDatacenter   string
 	evaluateInTrustDomain  string
+	evaluateInPeer         string
 	overrideMeshGateway    structs.MeshGatewayConfig
 	overrideProtocol       string
 	overrideConnectTimeout time.Duration
@@ -164,6 +175,10 @@ type compiler struct {
 	// disableAdvancedRoutingFeatures is set to true if overrideProtocol is set to tcp
 	disableAdvancedRoutingFeatures bool
 
+	// usesGRPCRouteMatches is set to true if the router in the compiled chain
+	// has routes with gRPC match criteria
+	usesGRPCRouteMatches bool
+
 	// customizedBy indicates which override values customized how the
 	// compilation behaved.
 	//
@@ -241,6 +256,15 @@ func (c *compiler) recordServiceProtocol(sid structs.ServiceID) error {
 	if serviceDefault := c.entries.GetService(sid); serviceDefault != nil {
 		return c.recordProtocol(sid, serviceDefault.Protocol)
 	}
+	// A service redirected to a cluster peer without local service-defaults
+	// takes the protocol exported by that peer, if it has one and the chain
+	// has no protocol yet.
+	if target := c.getPeerRedirectTarget(sid); target != nil && c.protocol == "" {
+		serviceDefault := c.entries.GetImportedService(target.Peer, target.ServiceID())
+		if serviceDefault != nil && serviceDefault.Protocol != "" {
+			return c.recordProtocol(sid, serviceDefault.Protocol)
+		}
+	}
 	if proxyDefault := c.entries.GetProxyDefaults(sid.PartitionOrDefault()); proxyDefault != nil {
 		if proxyDefault.Protocol != "" {
 			return c.recordProtocol(sid, proxyDefault.Protocol)
@@ -249,6 +273,61 @@ func (c *compiler) recordServiceProtocol(sid structs.ServiceID) error {
 	return c.recordProtocol(sid, "")
 }
 
+// recordImportedServiceProtocol fills in the protocol of the chain from the
+// service-defaults exported by the peer of a peered target. It never fails
+// compilation: the protocol is only used when nothing local set one, since the
+// peer may change its service-defaults at any time.
+func (c *compiler) recordImportedServiceProtocol(target *structs.DiscoveryTarget) {
+	if c.protocol != "" {
+		return
+	}
+	serviceDefault := c.entries.GetImportedService(target.Peer, target.ServiceID())
+	if serviceDefault == nil || serviceDefault.Protocol == "" {
+		return
+	}
+	c.protocol = strings.ToLower(serviceDefault.Protocol)
+}
+
+// getPeerRedirectTarget returns the target that the resolver for the local
+// service redirects to if that target lives in a cluster peer. When the chain
+// is evaluated in a peer, the service at the top of the chain always resolves
+// to that peer.
+func (c *compiler) getPeerRedirectTarget(sid structs.ServiceID) *structs.DiscoveryTarget {
+	if c.evaluateInPeer != "" {
+		if sid != structs.NewServiceID(c.serviceName, c.GetEnterpriseMeta()) {
+			return nil
+		}
+		return c.newTarget(structs.DiscoveryTargetOpts{
+			Service:   sid.ID,
+			Namespace: sid.NamespaceOrDefault(),
+			Peer:      c.evaluateInPeer,
+		})
+	}
+	resolver := c.resolvers[sid]
+	if resolver == nil || resolver.Redirect == nil || resolver.Redirect.Peer == "" {
+		return nil
+	}
+	return c.rewriteTarget(c.newTarget(structs.DiscoveryTargetOpts{
+		Service:   sid.ID,
+		Namespace: sid.NamespaceOrDefault(),
+		Partition: sid.PartitionOrDefault(),
+	}), resolver.Redirect.ToDiscoveryTargetOpts())
+}
+
+// getImportedResolver returns a copy of the service-resolver exported by the
+// target's peer. Redirects and failover are dropped since they refer to
+// services from the point of view of the exporting cluster.
+func (c *compiler) getImportedResolver(target *structs.DiscoveryTarget) *structs.ServiceResolverConfigEntry {
+	imported := c.entries.GetImportedResolver(target.Peer, target.ServiceID())
+	if imported == nil {
+		return nil
+	}
+	resolver := *imported
+	resolver.Redirect = nil
+	resolver.Failover = nil
+	return &resolver
+}
+
 func (c *compiler) recordProtocol(fromService structs.ServiceID, protocol string) error {
 	if protocol == "" {
 		protocol = "tcp"
@@ -275,6 +354,12 @@ func (c *compiler) compile() (*structs.CompiledDiscoveryChain, error) {
 		return nil, err
 	}
 
+	// A chain evaluated in a peer that did not export a protocol is treated
+	// like any other service without one.
+	if c.protocol == "" {
+		c.protocol = "tcp"
+	}
+
 	// We don't need these intermediates anymore.
 	c.splitterNodes = nil
 	c.resolveNodes = nil
@@ -310,6 +395,15 @@ func (c *compiler) compile() (*structs.CompiledDiscoveryChain, error) {
 		}
 	}
 
+	if c.usesGRPCRouteMatches && c.protocol != "grpc" {
+		return nil, &structs.ConfigEntryGraphError{
+			Message: fmt.Sprintf(
+				"discovery chain %q uses a protocol %q that does not permit gRPC route matches",
+				c.serviceName, c.protocol,
+			),
+		}
+	}
+
 	if c.overrideProtocol != "" {
 		if c.overrideProtocol != c.protocol {
 			c.protocol = c.overrideProtocol
@@ -476,6 +570,10 @@ func (c *compiler) flattenAdjacentSplitterNodes() error {
 						Definition: newDef,
 						Weight:     structs.NormalizeServiceSplitWeight(effectiveWeight),
 						NextNode:   innerSplit.NextNode,
+						MirrorNode: innerSplit.MirrorNode,
+					}
+					if newDiscoverySplit.MirrorNode == "" {
+						newDiscoverySplit.MirrorNode = split.MirrorNode
 					}
 
 					fixedSplits = append(fixedSplits, newDiscoverySplit)
@@ -535,10 +633,16 @@ func (c *compiler) removeUnusedNodes() error {
 		case structs.DiscoveryGraphNodeTypeRouter:
 			for _, route := range node.Routes {
 				todo[route.NextNode] = struct{}{}
+				if route.MirrorNode != "" {
+					todo[route.MirrorNode] = struct{}{}
+				}
 			}
 		case structs.DiscoveryGraphNodeTypeSplitter:
 			for _, split := range node.Splits {
 				todo[split.NextNode] = struct{}{}
+				if split.MirrorNode != "" {
+					todo[split.MirrorNode] = struct{}{}
+				}
 			}
 		case structs.DiscoveryGraphNodeTypeResolver:
 			// nothing special
@@ -576,21 +680,36 @@ func (c *compiler) assembleChain() error {
 	}
 
 	// Extract the service meta for the service named by this discovery chain and add extensions from the service
-	// defaults.
-	if serviceDefault := c.entries.GetService(sid); serviceDefault != nil {
+	// defaults. Local service defaults do not apply to a service imported from a peer.
+	if serviceDefault := c.entries.GetService(sid); serviceDefault != nil && c.evaluateInPeer == "" {
 		c.serviceMeta = serviceDefault.GetMeta()
 		c.envoyExtensions = append(c.envoyExtensions, serviceDefault.EnvoyExtensions...)
 	}
 
 	// Check for short circuit path.
-	if len(c.resolvers) == 0 && c.entries.IsChainEmpty() {
+	if len(c.resolvers) == 0 && c.entries.IsChainEmpty() && c.evaluateInPeer == "" {
 		// Materialize defaults and cache.
 		c.resolvers[sid] = c.newDefaultServiceResolver(sid, "")
 	}
 
 	// The only router we consult is the one for the service name at the top of
-	// the chain.
-	router := c.entries.GetRouter(sid)
+	// the chain. Without a local router, a service redirected to a cluster
+	// peer uses the router exported by that peer, provided the peer also
+	// exported a protocol that permits routing.
+	var router *structs.ServiceRouterConfigEntry
+	if c.evaluateInPeer == "" {
+		router = c.entries.GetRouter(sid)
+	}
+	var routerPeer string
+	if router == nil {
+		if target := c.getPeerRedirectTarget(sid); target != nil {
+			serviceDefault := c.entries.GetImportedService(target.Peer, target.ServiceID())
+			if serviceDefault != nil && enableAdvancedRoutingForProtocol(serviceDefault.Protocol) {
+				router = c.entries.GetImportedRouter(target.Peer, target.ServiceID())
+				routerPeer = target.Peer
+			}
+		}
+	}
 	if router != nil && c.disableAdvancedRoutingFeatures {
 		router = nil
 		c.customizedBy.Protocol = true
@@ -599,10 +718,17 @@ func (c *compiler) assembleChain() error {
 	if router == nil {
 		// If no router is configured, move on down the line to the next hop of
 		// the chain.
-		node, err := c.getSplitterOrResolverNode(c.newTarget(structs.DiscoveryTargetOpts{
-			Service: c.serviceName,
-		}))
-
+		var (
+			node *structs.DiscoveryGraphNode
+			err  error
+		)
+		if c.evaluateInPeer != "" {
+			node, err = c.getResolverNode(c.getPeerRedirectTarget(sid), false)
+		} else {
+			node, err = c.getSplitterOrResolverNode(c.newTarget(structs.DiscoveryTargetOpts{
+				Service: c.serviceName,
+			}))
+		}
 		if err != nil {
 			return err
 		}
@@ -619,8 +745,10 @@ func (c *compiler) assembleChain() error {
 		Routes: make([]*structs.DiscoveryRoute, 0, len(router.Routes)+1),
 	}
 	c.usesAdvancedRoutingFeatures = true
-	if err := c.recordServiceProtocol(routerID); err != nil {
-		return err
+	if routerPeer == "" {
+		if err := c.recordServiceProtocol(routerID); err != nil {
+			return err
+		}
 	}
 
 	for i := range router.Routes {
@@ -633,6 +761,10 @@ func (c *compiler) assembleChain() error {
 		compiledRoute := &structs.DiscoveryRoute{Definition: &route}
 		routeNode.Routes = append(routeNode.Routes, compiledRoute)
 
+		if route.Match != nil && route.Match.GRPC != nil {
+			c.usesGRPCRouteMatches = true
+		}
+
 		dest := route.Destination
 		if dest == nil {
 			dest = &structs.ServiceRouteDestination{
@@ -650,7 +782,9 @@ func (c *compiler) assembleChain() error {
 			node *structs.DiscoveryGraphNode
 			err  error
 		)
-		if dest.ServiceSubset == "" {
+		// Splitters are never imported, so peered destinations always resolve
+		// directly.
+		if dest.ServiceSubset == "" && routerPeer == "" {
 			node, err = c.getSplitterOrResolverNode(
 				c.newTarget(structs.DiscoveryTargetOpts{
 					Service:   svc,
@@ -665,6 +799,7 @@ func (c *compiler) assembleChain() error {
 					ServiceSubset: dest.ServiceSubset,
 					Namespace:     destNamespace,
 					Partition:     destPartition,
+					Peer:          routerPeer,
 				}),
 				false,
 			)
@@ -673,6 +808,14 @@ func (c *compiler) assembleChain() error {
 			return err
 		}
 		compiledRoute.NextNode = node.MapKey()
+
+		if dest.Mirror != nil {
+			mirrorNode, err := c.getMirrorNode(dest.Mirror, &router.EnterpriseMeta, routerPeer)
+			if err != nil {
+				return err
+			}
+			compiledRoute.MirrorNode = mirrorNode.MapKey()
+		}
 	}
 
 	// If we have a router, we'll add a catch-all route at the end to send
@@ -681,8 +824,17 @@ func (c *compiler) assembleChain() error {
 		Service:   router.Name,
 		Namespace: router.NamespaceOrDefault(),
 		Partition: router.PartitionOrDefault(),
+		Peer:      routerPeer,
+	}
+	var (
+		defaultDestinationNode *structs.DiscoveryGraphNode
+		err                    error
+	)
+	if routerPeer == "" {
+		defaultDestinationNode, err = c.getSplitterOrResolverNode(c.newTarget(opts))
+	} else {
+		defaultDestinationNode, err = c.getResolverNode(c.newTarget(opts), false)
 	}
-	defaultDestinationNode, err := c.getSplitterOrResolverNode(c.newTarget(opts))
 	if err != nil {
 		return err
 	}
@@ -847,6 +999,14 @@ func (c *compiler) getSplitterNode(sid structs.ServiceID) (*structs.DiscoveryGra
 		}
 		splitNode.Splits = append(splitNode.Splits, compiledSplit)
 
+		if split.Mirror != nil {
+			mirrorNode, err := c.getMirrorNode(split.Mirror, &sid.EnterpriseMeta, "")
+			if err != nil {
+				return nil, err
+			}
+			compiledSplit.MirrorNode = mirrorNode.MapKey()
+		}
+
 		svc := defaultIfEmpty(split.Service, sid.ID)
 		splitID := structs.ServiceID{
 			ID:             svc,
@@ -893,6 +1053,21 @@ func (c *compiler) getSplitterNode(sid structs.ServiceID) (*structs.DiscoveryGra
 	return splitNode, nil
 }
 
+// getMirrorNode returns the resolver node of the shadow service named by a
+// mirror policy. Mirrored requests are never split further so the shadow
+// service always resolves directly. A non-empty peer resolves the shadow
+// service in that cluster peer.
+func (c *compiler) getMirrorNode(mirror *structs.MirrorPolicy, defaultEntMeta *acl.EnterpriseMeta, peer string) (*structs.DiscoveryGraphNode, error) {
+	mirrorID := structs.NewServiceID(mirror.Service, mirror.GetEnterpriseMeta(defaultEntMeta))
+	return c.getResolverNode(c.newTarget(structs.DiscoveryTargetOpts{
+		Service:       mirrorID.ID,
+		ServiceSubset: mirror.ServiceSubset,
+		Namespace:     mirrorID.NamespaceOrDefault(),
+		Partition:     mirrorID.PartitionOrDefault(),
+		Peer:          peer,
+	}), false)
+}
+
 // getResolverNode handles most of the code to handle redirection/rewriting
 // capabilities from a resolver config entry. It recurses into itself to
 // _generate_ targets used for failover out of convenience.
@@ -912,14 +1087,14 @@ RESOLVE_AGAIN:
 
 	targetID := target.ServiceID()
 
-	// Only validate protocol if it is not a peered service.
-	// TODO: Add in remote peer protocol validation when building a chain.
-	// This likely would require querying the imported services, which
-	// shouldn't belong in the discovery chain compilation here.
+	// Only validate protocol if it is not a peered service. Peered services
+	// may fill in the protocol from the service-defaults exported by the peer.
 	if target.Peer == "" {
 		if err := c.recordServiceProtocol(targetID); err != nil {
 			return nil, err
 		}
+	} else {
+		c.recordImportedServiceProtocol(target)
 	}
 
 	// Fetch the config entry.
@@ -929,6 +1104,11 @@ RESOLVE_AGAIN:
 		resolver = c.newDefaultServiceResolver(targetID, target.Peer)
 		c.resolvers[targetID] = resolver
 	}
+	if target.Peer != "" {
+		if imported := c.getImportedResolver(target); imported != nil {
+			resolver = imported
+		}
+	}
 
 	if _, ok := redirectHistory[target.ID]; ok {
 		redirectOrder = append(redirectOrder, target.ID)
@@ -1003,6 +1183,7 @@ RESOLVE_AGAIN:
 			Target:         target.ID,
 			ConnectTimeout: connectTimeout,
 			RequestTimeout: resolver.RequestTimeout,
+			RetryPolicy:    resolver.RetryPolicy,
 		},
 		LoadBalancer: resolver.LoadBalancer,
 	}
@@ -41,12 +41,19 @@ func TestCompile(t *testing.T) {
 		"subset split":                                     testcase_SubsetSplit(),
 		"service split":                                    testcase_ServiceSplit(),
 		"split bypasses next splitter":                     testcase_SplitBypassesSplit(),
+		"router with mirror":                               testcase_RouterWithMirror(),
+		"split with mirror":                                testcase_SplitWithMirror(),
 		"service redirect":                                 testcase_ServiceRedirect(),
 		"service and subset redirect":                      testcase_ServiceAndSubsetRedirect(),
 		"datacenter redirect":                              testcase_DatacenterRedirect(),
 		"redirect to cluster peer":                         testcase_PeerRedirect(),
 		"redirect to cluster peer http proxy-defaults":     testcase_PeerRedirectProxyDefHTTP(),
 		"redirect to cluster peer http service-defaults":   testcase_PeerRedirectSvcDefHTTP(),
+		"redirect to cluster peer imported resolver":       testcase_PeerRedirectImportedResolver(),
+		"redirect to cluster peer imported router":         testcase_PeerRedirectImportedRouter(),
+		"redirect to cluster peer keeps local protocol":    testcase_PeerRedirectLocalProtocolOverridesImported(),
+		"evaluate in cluster peer":                         testcase_EvaluateInPeer(),
+		"evaluate in cluster peer imported router":         testcase_EvaluateInPeerImportedRouter(),
 		"datacenter redirect with mesh gateways":           testcase_DatacenterRedirect_WithMeshGateways(),
 		"service failover":                                 testcase_ServiceFailover(),
 		"service failover through redirect":                testcase_ServiceFailoverThroughRedirect(),
@@ -74,7 +81,9 @@ func TestCompile(t *testing.T) {
 		"splitter requires valid protocol":        testcase_SplitterRequiresValidProtocol(),
 		"router requires valid protocol":          testcase_RouterRequiresValidProtocol(),
 		"split to unsplittable protocol":          testcase_SplitToUnsplittableProtocol(),
+		"splitter requires http-like protocol":    testcase_SplitterRequiresHTTPLikeProtocol(),
 		"route to unroutable protocol":            testcase_RouteToUnroutableProtocol(),
+		"grpc route match requires grpc protocol": testcase_GRPCRouteMatchRequiresGRPCProtocol(),
 		"failover crosses protocols":              testcase_FailoverCrossesProtocols(),
 		"redirect crosses protocols":              testcase_RedirectCrossesProtocols(),
 		"redirect to missing subset":              testcase_RedirectToMissingSubset(),
@@ -891,6 +900,205 @@ func testcase_ServiceSplit() compileTestCase {
 	return compileTestCase{entries: entries, expect: expect}
 }
 
+func testcase_RouterWithMirror() compileTestCase {
+	entries := newEntries()
+	setServiceProtocol(entries, "main", "http")
+	setServiceProtocol(entries, "shadow", "http")
+
+	router := &structs.ServiceRouterConfigEntry{
+		Kind: "service-router",
+		Name: "main",
+		Routes: []structs.ServiceRoute{
+			{
+				Match: &structs.ServiceRouteMatch{
+					HTTP: &structs.ServiceRouteHTTPMatch{
+						PathPrefix: "/api",
+					},
+				},
+				Destination: &structs.ServiceRouteDestination{
+					Mirror: &structs.MirrorPolicy{
+						Service:    "shadow",
+						Percentage: 25,
+					},
+				},
+			},
+		},
+	}
+	entries.AddRouters(router)
+
+	expect := &structs.CompiledDiscoveryChain{
+		Protocol:  "http",
+		StartNode: "router:main.default.default",
+		Nodes: map[string]*structs.DiscoveryGraphNode{
+			"router:main.default.default": {
+				Type: structs.DiscoveryGraphNodeTypeRouter,
+				Name: "main.default.default",
+				Routes: []*structs.DiscoveryRoute{
+					{
+						Definition: &router.Routes[0],
+						NextNode:   "resolver:main.default.default.dc1",
+						MirrorNode: "resolver:shadow.default.default.dc1",
+					},
+					{
+						Definition: newDefaultServiceRoute("main", "default", "default"),
+						NextNode:   "resolver:main.default.default.dc1",
+					},
+				},
+			},
+			"resolver:main.default.default.dc1": {
+				Type: structs.DiscoveryGraphNodeTypeResolver,
+				Name: "main.default.default.dc1",
+				Resolver: &structs.DiscoveryResolver{
+					Default:        true,
+					ConnectTimeout: 5 * time.Second,
+					Target:         "main.default.default.dc1",
+				},
+			},
+			"resolver:shadow.default.default.dc1": {
+				Type: structs.DiscoveryGraphNodeTypeResolver,
+				Name: "shadow.default.default.dc1",
+				Resolver: &structs.DiscoveryResolver{
+					Default:        true,
+					ConnectTimeout: 5 * time.Second,
+					Target:         "shadow.default.default.dc1",
+				},
+			},
+		},
+		Targets: map[string]*structs.DiscoveryTarget{
+			"main.default.default.dc1":   newTarget(structs.DiscoveryTargetOpts{Service: "main"}, nil),
+			"shadow.default.default.dc1": newTarget(structs.DiscoveryTargetOpts{Service: "shadow"}, nil),
+		},
+	}
+
+	return compileTestCase{entries: entries, expect: expect}
+}
+
+func testcase_SplitWithMirror() compileTestCase {
+	entries := newEntries()
+	setServiceProtocol(entries, "main", "http")
+	setServiceProtocol(entries, "foo", "http")
+	setServiceProtocol(entries, "bar", "http")
+	setServiceProtocol(entries, "shadow", "http")
+
+	mirror := &structs.MirrorPolicy{
+		Service:       "shadow",
+		ServiceSubset: "v2",
+		Percentage:    10,
+	}
+	entries.AddSplitters(
+		&structs.ServiceSplitterConfigEntry{
+			Kind: "service-splitter",
+			Name: "main",
+			Splits: []structs.ServiceSplit{
+				{Weight: 60, Service: "foo", Mirror: mirror},
+				{Weight: 40, Service: "bar"},
+			},
+		},
+		// The mirror on the split to foo is kept when the foo splitter is
+		// flattened into the main splitter.
+		&structs.ServiceSplitterConfigEntry{
+			Kind: "service-splitter",
+			Name: "foo",
+			Splits: []structs.ServiceSplit{
+				{Weight: 50, Service: "foo"},
+				{Weight: 50, Service: "bar"},
+			},
+		},
+	)
+	entries.AddResolvers(
+		&structs.ServiceResolverConfigEntry{
+			Kind: "service-resolver",
+			Name: "shadow",
+			Subsets: map[string]structs.ServiceResolverSubset{
+				"v2": {
+					Filter: "Service.Meta.version == 2",
+				},
+			},
+		},
+	)
+
+	expect := &structs.CompiledDiscoveryChain{
+		Protocol:  "http",
+		StartNode: "splitter:main.default.default",
+		Nodes: map[string]*structs.DiscoveryGraphNode{
+			"splitter:main.default.default": {
+				Type: structs.DiscoveryGraphNodeTypeSplitter,
+				Name: "main.default.default",
+				Splits: []*structs.DiscoverySplit{
+					{
+						Definition: &structs.ServiceSplit{
+							Weight:  50,
+							Service: "foo",
+							Mirror:  mirror,
+						},
+						Weight:     30,
+						NextNode:   "resolver:foo.default.default.dc1",
+						MirrorNode: "resolver:v2.shadow.default.default.dc1",
+					},
+					{
+						Definition: &structs.ServiceSplit{
+							Weight:  50,
+							Service: "bar",
+							Mirror:  mirror,
+						},
+						Weight:     30,
+						NextNode:   "resolver:bar.default.default.dc1",
+						MirrorNode: "resolver:v2.shadow.default.default.dc1",
+					},
+					{
+						Definition: &structs.ServiceSplit{
+							Weight:  40,
+							Service: "bar",
+						},
+						Weight:   40,
+						NextNode: "resolver:bar.default.default.dc1",
+					},
+				},
+			},
+			"resolver:foo.default.default.dc1": {
+				Type: structs.DiscoveryGraphNodeTypeResolver,
+				Name: "foo.default.default.dc1",
+				Resolver: &structs.DiscoveryResolver{
+					Default:        true,
+					ConnectTimeout: 5 * time.Second,
+					Target:         "foo.default.default.dc1",
+				},
+			},
+			"resolver:bar.default.default.dc1": {
+				Type: structs.DiscoveryGraphNodeTypeResolver,
+				Name: "bar.default.default.dc1",
+				Resolver: &structs.DiscoveryResolver{
+					Default:        true,
+					ConnectTimeout: 5 * time.Second,
+					Target:         "bar.default.default.dc1",
+				},
+			},
+			"resolver:v2.shadow.default.default.dc1": {
+				Type: structs.DiscoveryGraphNodeTypeResolver,
+				Name: "v2.shadow.default.default.dc1",
+				Resolver: &structs.DiscoveryResolver{
+					ConnectTimeout: 5 * time.Second,
+					Target:         "v2.shadow.default.default.dc1",
+				},
+			},
+		},
+		Targets: map[string]*structs.DiscoveryTarget{
+			"foo.default.default.dc1": newTarget(structs.DiscoveryTargetOpts{Service: "foo"}, nil),
+			"bar.default.default.dc1": newTarget(structs.DiscoveryTargetOpts{Service: "bar"}, nil),
+			"v2.shadow.default.default.dc1": newTarget(structs.DiscoveryTargetOpts{
+				Service:       "shadow",
+				ServiceSubset: "v2",
+			}, func(t *structs.DiscoveryTarget) {
+				t.Subset = structs.ServiceResolverSubset{
+					Filter: "Service.Meta.version == 2",
+				}
+			}),
+		},
+	}
+
+	return compileTestCase{entries: entries, expect: expect}
+}
+
 func testcase_SplitBypassesSplit() compileTestCase {
 	entries := newEntries()
 	setServiceProtocol(entries, "main", "http")
@@ -1236,6 +1444,337 @@ func testcase_PeerRedirectSvcDefHTTP() compileTestCase {
 	return compileTestCase{entries: entries, expect: expect}
 }
 
+func testcase_PeerRedirectImportedResolver() compileTestCase {
+	entries := newEntries()
+	entries.AddResolvers(
+		&structs.ServiceResolverConfigEntry{
+			Kind: "service-resolver",
+			Name: "main",
+			Redirect: &structs.ServiceResolverRedirect{
+				Service: "other",
+				Peer:    "cluster-01",
+			},
+		},
+	)
+	entries.AddImportedEntries("cluster-01",
+		&structs.ServiceConfigEntry{
+			Kind:     structs.ServiceDefaults,
+			Name:     "other",
+			Protocol: "http",
+		},
+		&structs.ServiceResolverConfigEntry{
+			Kind:           "service-resolver",
+			Name:           "other",
+			ConnectTimeout: 33 * time.Second,
+			// Failover refers to the exporting cluster's services and is
+			// ignored by importers.
+			Failover: map[string]structs.ServiceResolverFailover{
+				"*": {Service: "backup"},
+			},
+		},
+	)
+
+	expect := &structs.CompiledDiscoveryChain{
+		Protocol:  "http",
+		StartNode: "resolver:other.default.default.external.cluster-01",
+		Nodes: map[string]*structs.DiscoveryGraphNode{
+			"resolver:other.default.default.external.cluster-01": {
+				Type: structs.DiscoveryGraphNodeTypeResolver,
+				Name: "other.default.default.external.cluster-01",
+				Resolver: &structs.DiscoveryResolver{
+					ConnectTimeout: 33 * time.Second,
+					Target:         "other.default.default.external.cluster-01",
+				},
+			},
+		},
+		Targets: map[string]*structs.DiscoveryTarget{
+			"other.default.default.external.cluster-01": newTarget(structs.DiscoveryTargetOpts{
+				Service: "other",
+				Peer:    "cluster-01",
+			}, func(t *structs.DiscoveryTarget) {
+				t.SNI = ""
+				t.Name = ""
+				t.Datacenter = ""
+				t.ConnectTimeout = 33 * time.Second
+			}),
+		},
+	}
+	return compileTestCase{entries: entries, expect: expect}
+}
+
+func testcase_PeerRedirectLocalProtocolOverridesImported() compileTestCase {
+	entries := newEntries()
+	setServiceProtocol(entries, "main", "grpc")
+	entries.AddResolvers(
+		&structs.ServiceResolverConfigEntry{
+			Kind: "service-resolver",
+			Name: "main",
+			Redirect: &structs.ServiceResolverRedirect{
+				Service: "other",
+				Peer:    "cluster-01",
+			},
+		},
+	)
+	// The imported protocol conflicts with the local one, which must not
+	// break the chain since the peer controls it.
+	entries.AddImportedEntries("cluster-01",
+		&structs.ServiceConfigEntry{
+			Kind:     structs.ServiceDefaults,
+			Name:     "other",
+			Protocol: "http",
+		},
+	)
+
+	expect := &structs.CompiledDiscoveryChain{
+		Protocol:  "grpc",
+		StartNode: "resolver:other.default.default.external.cluster-01",
+		Nodes: map[string]*structs.DiscoveryGraphNode{
+			"resolver:other.default.default.external.cluster-01": {
+				Type: structs.DiscoveryGraphNodeTypeResolver,
+				Name: "other.default.default.external.cluster-01",
+				Resolver: &structs.DiscoveryResolver{
+					Default:        true,
+					ConnectTimeout: 5 * time.Second,
+					Target:         "other.default.default.external.cluster-01",
+				},
+			},
+		},
+		Targets: map[string]*structs.DiscoveryTarget{
+			"other.default.default.external.cluster-01": newTarget(structs.DiscoveryTargetOpts{
+				Service: "other",
+				Peer:    "cluster-01",
+			}, func(t *structs.DiscoveryTarget) {
+				t.SNI = ""
+				t.Name = ""
+				t.Datacenter = ""
+			}),
+		},
+	}
+	return compileTestCase{entries: entries, expect: expect}
+}
+
+func testcase_PeerRedirectImportedRouter() compileTestCase {
+	entries := newEntries()
+	entries.AddResolvers(
+		&structs.ServiceResolverConfigEntry{
+			Kind: "service-resolver",
+			Name: "main",
+			Redirect: &structs.ServiceResolverRedirect{
+				Service: "main",
+				Peer:    "cluster-01",
+			},
+		},
+	)
+	entries.AddImportedEntries("cluster-01",
+		&structs.ServiceConfigEntry{
+			Kind:     structs.ServiceDefaults,
+			Name:     "main",
+			Protocol: "http",
+		},
+		&structs.ServiceRouterConfigEntry{
+			Kind: "service-router",
+			Name: "main",
+			Routes: []structs.ServiceRoute{
+				newSimpleRoute("admin"),
+			},
+		},
+	)
+
+	router := entries.GetImportedRouter("cluster-01", structs.NewServiceID("main", nil))
+
+	peerTarget := func(service string) *structs.DiscoveryTarget {
+		return newTarget(structs.DiscoveryTargetOpts{
+			Service: service,
+			Peer:    "cluster-01",
+		}, func(t *structs.DiscoveryTarget) {
+			t.SNI = ""
+			t.Name = ""
+			t.Datacenter = ""
+		})
+	}
+
+	expect := &structs.CompiledDiscoveryChain{
+		Protocol:  "http",
+		StartNode: "router:main.default.default",
+		Nodes: map[string]*structs.DiscoveryGraphNode{
+			"router:main.default.default": {
+				Type: structs.DiscoveryGraphNodeTypeRouter,
+				Name: "main.default.default",
+				Routes: []*structs.DiscoveryRoute{
+					{
+						Definition: &router.Routes[0],
+						NextNode:   "resolver:admin.default.default.external.cluster-01",
+					},
+					{
+						Definition: newDefaultServiceRoute("main", "default", "default"),
+						NextNode:   "resolver:main.default.default.external.cluster-01",
+					},
+				},
+			},
+			"resolver:admin.default.default.external.cluster-01": {
+				Type: structs.DiscoveryGraphNodeTypeResolver,
+				Name: "admin.default.default.external.cluster-01",
+				Resolver: &structs.DiscoveryResolver{
+					Default:        true,
+					ConnectTimeout: 5 * time.Second,
+					Target:         "admin.default.default.external.cluster-01",
+				},
+			},
+			"resolver:main.default.default.external.cluster-01": {
+				Type: structs.DiscoveryGraphNodeTypeResolver,
+				Name: "main.default.default.external.cluster-01",
+				Resolver: &structs.DiscoveryResolver{
+					ConnectTimeout: 5 * time.Second,
+					Target:         "main.default.default.external.cluster-01",
+				},
+			},
+		},
+		Targets: map[string]*structs.DiscoveryTarget{
+			"admin.default.default.external.cluster-01": peerTarget("admin"),
+			"main.default.default.external.cluster-01":  peerTarget("main"),
+		},
+	}
+	return compileTestCase{entries: entries, expect: expect}
+}
+
+func testcase_EvaluateInPeer() compileTestCase {
+	entries := newEntries()
+	// Local entries for a service of the same name do not apply to the
+	// service imported from the peer.
+	setServiceProtocol(entries, "main", "http")
+	entries.AddResolvers(
+		&structs.ServiceResolverConfigEntry{
+			Kind:           "service-resolver",
+			Name:           "main",
+			ConnectTimeout: 33 * time.Second,
+		},
+	)
+
+	expect := &structs.CompiledDiscoveryChain{
+		Protocol:  "tcp",
+		Default:   true,
+		StartNode: "resolver:main.default.default.external.cluster-01",
+		Nodes: map[string]*structs.DiscoveryGraphNode{
+			"resolver:main.default.default.external.cluster-01": {
+				Type: structs.DiscoveryGraphNodeTypeResolver,
+				Name: "main.default.default.external.cluster-01",
+				Resolver: &structs.DiscoveryResolver{
+					Default:        true,
+					ConnectTimeout: 5 * time.Second,
+					Target:         "main.default.default.external.cluster-01",
+				},
+			},
+		},
+		Targets: map[string]*structs.DiscoveryTarget{
+			"main.default.default.external.cluster-01": newTarget(structs.DiscoveryTargetOpts{
+				Service: "main",
+				Peer:    "cluster-01",
+			}, func(t *structs.DiscoveryTarget) {
+				t.SNI = ""
+				t.Name = ""
+				t.Datacenter = ""
+			}),
+		},
+	}
+	return compileTestCase{
+		entries: entries,
+		setup: func(req *CompileRequest) {
+			req.EvaluateInPeer = "cluster-01"
+		},
+		expect: expect,
+	}
+}
+
+func testcase_EvaluateInPeerImportedRouter() compileTestCase {
+	entries := newEntries()
+	entries.AddRouters(
+		&structs.ServiceRouterConfigEntry{
+			Kind: "service-router",
+			Name: "main",
+			Routes: []structs.ServiceRoute{
+				newSimpleRoute("local"),
+			},
+		},
+	)
+	entries.AddImportedEntries("cluster-01",
+		&structs.ServiceConfigEntry{
+			Kind:     structs.ServiceDefaults,
+			Name:     "main",
+			Protocol: "http",
+		},
+		&structs.ServiceRouterConfigEntry{
+			Kind: "service-router",
+			Name: "main",
+			Routes: []structs.ServiceRoute{
+				newSimpleRoute("admin"),
+			},
+		},
+	)
+
+	router := entries.GetImportedRouter("cluster-01", structs.NewServiceID("main", nil))
+
+	peerTarget := func(service string) *structs.DiscoveryTarget {
+		return newTarget(structs.DiscoveryTargetOpts{
+			Service: service,
+			Peer:    "cluster-01",
+		}, func(t *structs.DiscoveryTarget) {
+			t.SNI = ""
+			t.Name = ""
+			t.Datacenter = ""
+		})
+	}
+
+	expect := &structs.CompiledDiscoveryChain{
+		Protocol:  "http",
+		StartNode: "router:main.default.default",
+		Nodes: map[string]*structs.DiscoveryGraphNode{
+			"router:main.default.default": {
+				Type: structs.DiscoveryGraphNodeTypeRouter,
+				Name: "main.default.default",
+				Routes: []*structs.DiscoveryRoute{
+					{
+						Definition: &router.Routes[0],
+						NextNode:   "resolver:admin.default.default.external.cluster-01",
+					},
+					{
+						Definition: newDefaultServiceRoute("main", "default", "default"),
+						NextNode:   "resolver:main.default.default.external.cluster-01",
+					},
+				},
+			},
+			"resolver:admin.default.default.external.cluster-01": {
+				Type: structs.DiscoveryGraphNodeTypeResolver,
+				Name: "admin.default.default.external.cluster-01",
+				Resolver: &structs.DiscoveryResolver{
+					Default:        true,
+					ConnectTimeout: 5 * time.Second,
+					Target:         "admin.default.default.external.cluster-01",
+				},
+			},
+			"resolver:main.default.default.external.cluster-01": {
+				Type: structs.DiscoveryGraphNodeTypeResolver,
+				Name: "main.default.default.external.cluster-01",
+				Resolver: &structs.DiscoveryResolver{
+					Default:        true,
+					ConnectTimeout: 5 * time.Second,
+					Target:         "main.default.default.external.cluster-01",
+				},
+			},
+		},
+		Targets: map[string]*structs.DiscoveryTarget{
+			"admin.default.default.external.cluster-01": peerTarget("admin"),
+			"main.default.default.external.cluster-01":  peerTarget("main"),
+		},
+	}
+	return compileTestCase{
+		entries: entries,
+		setup: func(req *CompileRequest) {
+			req.EvaluateInPeer = "cluster-01"
+		},
+		expect: expect,
+	}
+}
+
 func testcase_DatacenterRedirect_WithMeshGateways() compileTestCase {
 	entries := newEntries()
 	entries.AddProxyDefaults(&structs.ProxyConfigEntry{
@@ -2587,6 +3126,28 @@ func testcase_SplitterRequiresValidProtocol() compileTestCase {
 	}
 }
 
+func testcase_SplitterRequiresHTTPLikeProtocol() compileTestCase {
+	entries := newEntries()
+	setServiceProtocol(entries, "main", "redis")
+
+	entries.AddSplitters(
+		&structs.ServiceSplitterConfigEntry{
+			Kind: structs.ServiceSplitter,
+			Name: "main",
+			Splits: []structs.ServiceSplit{
+				{Weight: 90, Namespace: "v1"},
+				{Weight: 10, Namespace: "v2"},
+			},
+		},
+	)
+
+	return compileTestCase{
+		entries:        entries,
+		expectErr:      "does not permit advanced routing or splitting behavior",
+		expectGraphErr: true,
+	}
+}
+
 func testcase_RouterRequiresValidProtocol() compileTestCase {
 	entries := newEntries()
 	setServiceProtocol(entries, "main", "tcp")
@@ -2669,6 +3230,36 @@ func testcase_RouteToUnroutableProtocol() compileTestCase {
 	}
 }
 
+func testcase_GRPCRouteMatchRequiresGRPCProtocol() compileTestCase {
+	entries := newEntries()
+	setServiceProtocol(entries, "main", "http")
+
+	entries.AddRouters(
+		&structs.ServiceRouterConfigEntry{
+			Kind: structs.ServiceRouter,
+			Name: "main",
+			Routes: []structs.ServiceRoute{
+				{
+					Match: &structs.ServiceRouteMatch{
+						GRPC: &structs.ServiceRouteGRPCMatch{
+							Service: "helloworld.Greeter",
+						},
+					},
+					Destination: &structs.ServiceRouteDestination{
+						Namespace: "other",
+					},
+				},
+			},
+		},
+	)
+
+	return compileTestCase{
+		entries:        entries,
+		expectErr:      "does not permit gRPC route matches",
+		expectGraphErr: true,
+	}
+}
+
 func testcase_FailoverCrossesProtocols() compileTestCase {
 	entries := newEntries()
 	setServiceProtocol(entries, "main", "grpc")
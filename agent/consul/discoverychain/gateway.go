@@ -24,6 +24,7 @@ type GatewayChainSynthesizer struct {
 	hostname          string
 	matchesByHostname map[string][]hostnameMatch
 	tcpRoutes         []structs.TCPRouteConfigEntry
+	tlsRoutes         []structs.TLSRouteConfigEntry
 }
 
 type hostnameMatch struct {
@@ -50,6 +51,11 @@ func (l *GatewayChainSynthesizer) AddTCPRoute(route structs.TCPRouteConfigEntry)
 	l.tcpRoutes = append(l.tcpRoutes, route)
 }
 
+// AddTLSRoute adds a TLSRoute to use in synthesizing a discovery chain
+func (l *GatewayChainSynthesizer) AddTLSRoute(route structs.TLSRouteConfigEntry) {
+	l.tlsRoutes = append(l.tlsRoutes, route)
+}
+
 // SetHostname sets the base hostname for a listener that this is being synthesized for
 func (l *GatewayChainSynthesizer) SetHostname(hostname string) {
 	l.hostname = hostname
@@ -107,7 +113,7 @@ func initHostMatches(hostname string, route *structs.HTTPRouteConfigEntry, curre
 //
 // This is currently used to help API gateways masquarade as ingress gateways
 // by providing a set of virtual config entries that change the routing behavior
-// to upstreams referenced in the given HTTPRoutes or TCPRoutes.
+// to upstreams referenced in the given HTTPRoutes, TCPRoutes or TLSRoutes.
 func (l *GatewayChainSynthesizer) Synthesize(chains ...*structs.CompiledDiscoveryChain) ([]structs.IngressService, []*structs.CompiledDiscoveryChain, error) {
 	if len(chains) == 0 {
 		return nil, nil, fmt.Errorf("must provide at least one compiled discovery chain")
@@ -116,7 +122,7 @@ func (l *GatewayChainSynthesizer) Synthesize(chains ...*structs.CompiledDiscover
 	services, set := l.synthesizeEntries()
 
 	if len(set) == 0 {
-		// we can't actually compile a discovery chain, i.e. we're using a TCPRoute-based listener, instead, just return the ingresses
+		// we can't actually compile a discovery chain, i.e. we're using a TCPRoute or TLSRoute-based listener, instead, just return the ingresses
 		// and the pre-compiled discovery chains
 		return services, chains, nil
 	}
@@ -305,5 +311,9 @@ func (l *GatewayChainSynthesizer) synthesizeEntries() ([]structs.IngressService,
 		services = append(services, synthesizeTCPRouteDiscoveryChain(route)...)
 	}
 
+	for _, route := range l.tlsRoutes {
+		services = append(services, synthesizeTLSRouteDiscoveryChain(route, l.hostname)...)
+	}
+
 	return services, entries
 }
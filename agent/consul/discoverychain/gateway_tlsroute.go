@@ -0,0 +1,23 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package discoverychain
+
+import "github.com/hashicorp/consul/agent/structs"
+
+func synthesizeTLSRouteDiscoveryChain(route structs.TLSRouteConfigEntry, listenerHostname string) []structs.IngressService {
+	hosts := route.FilteredHostnames(listenerHostname)
+
+	services := make([]structs.IngressService, 0, len(route.Services))
+	for _, service := range route.Services {
+		ingress := structs.IngressService{
+			Name:           service.Name,
+			Hosts:          hosts,
+			EnterpriseMeta: service.EnterpriseMeta,
+		}
+
+		services = append(services, ingress)
+	}
+
+	return services
+}
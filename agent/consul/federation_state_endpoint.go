@@ -61,7 +61,7 @@ func (c *FederationState) Apply(args *structs.FederationStateRequest, reply *boo
 	defer metrics.MeasureSince([]string{"federation_state", "apply"}, time.Now())
 
 	// Fetch the ACL token, if any.
-	authz, err := c.srv.ResolveToken(args.Token)
+	authz, err := c.srv.ResolveRequestToken(args)
 	if err != nil {
 		return err
 	}
@@ -107,7 +107,7 @@ func (c *FederationState) Get(args *structs.FederationStateQuery, reply *structs
 	defer metrics.MeasureSince([]string{"federation_state", "get"}, time.Now())
 
 	// Fetch the ACL token, if any.
-	authz, err := c.srv.ResolveToken(args.Token)
+	authz, err := c.srv.ResolveRequestToken(args)
 	if err != nil {
 		return err
 	}
@@ -146,7 +146,7 @@ func (c *FederationState) List(args *structs.DCSpecificRequest, reply *structs.I
 	defer metrics.MeasureSince([]string{"federation_state", "list"}, time.Now())
 
 	// Fetch the ACL token, if any.
-	authz, err := c.srv.ResolveToken(args.Token)
+	authz, err := c.srv.ResolveRequestToken(args)
 	if err != nil {
 		return err
 	}
@@ -210,7 +210,7 @@ func (c *FederationState) ListMeshGateways(args *structs.DCSpecificRequest, repl
 			}
 
 			reply.Index, reply.DatacenterNodes = index, dump
-			if err := c.srv.filterACL(args.Token, reply); err != nil {
+			if err := c.srv.filterACL(args, reply); err != nil {
 				return err
 			}
 
@@ -143,8 +143,11 @@ func init() {
 	registerCommand(structs.PeeringWriteType, (*FSM).applyPeeringWrite)
 	registerCommand(structs.PeeringDeleteType, (*FSM).applyPeeringDelete)
 	registerCommand(structs.PeeringTerminateByIDType, (*FSM).applyPeeringTerminate)
+	registerCommand(structs.PeeringRTTWriteType, (*FSM).applyPeeringRTTWrite)
 	registerCommand(structs.PeeringTrustBundleWriteType, (*FSM).applyPeeringTrustBundleWrite)
 	registerCommand(structs.PeeringTrustBundleDeleteType, (*FSM).applyPeeringTrustBundleDelete)
+	registerCommand(structs.PeeringConfigEntriesWriteType, (*FSM).applyPeeringConfigEntriesWrite)
+	registerCommand(structs.PeeringConfigEntriesDeleteType, (*FSM).applyPeeringConfigEntriesDelete)
 	registerCommand(structs.PeeringSecretsWriteType, (*FSM).applyPeeringSecretsWrite)
 	registerCommand(structs.ResourceOperationType, (*FSM).applyResourceOperation)
 	registerCommand(structs.UpdateVirtualIPRequestType, (*FSM).applyManualVirtualIPs)
@@ -477,6 +480,25 @@ func ApplyConnectCAOperationFromRequest(state *state.Store, req *structs.CAReque
 		}
 
 		return sn
+	case structs.CAOpRevokeCerts:
+		if err := state.CARevokeCerts(index, req.RevokedCerts); err != nil {
+			return err
+		}
+
+		return true
+	case structs.CAOpDeleteRevokedCerts:
+		if err := state.CADeleteRevokedCerts(index, req.RevokedCerts); err != nil {
+			return err
+		}
+
+		return true
+	case structs.CAOpSetRevocationLists:
+		act, err := state.CARevocationListSetCAS(index, req.Index, req.RevocationLists)
+		if err != nil {
+			return err
+		}
+
+		return act
 	default:
 		return fmt.Errorf("Invalid CA operation '%s'", req.Op)
 	}
@@ -498,6 +520,19 @@ func (c *FSM) applyConnectCALeafOperation(buf []byte, index uint64) interface{}
 			return err
 		}
 		return index
+	case structs.CALeafOpSetCert:
+		if req.Cert == nil {
+			return fmt.Errorf("Missing issued certificate")
+		}
+		if err := c.state.CALeafCertSet(index, req.Cert); err != nil {
+			return err
+		}
+		return index
+	case structs.CALeafOpDeleteCerts:
+		if err := c.state.CADeleteLeafCerts(index, req.SerialNumbers); err != nil {
+			return err
+		}
+		return true
 	default:
 		c.logger.Warn("Invalid CA Leaf operation", "operation", req.Op)
 		return fmt.Errorf("Invalid CA operation '%s'", req.Op)
@@ -791,6 +826,18 @@ func (c *FSM) applyPeeringTerminate(buf []byte, index uint64) interface{} {
 	return c.state.PeeringTerminateByID(index, req.ID)
 }
 
+func (c *FSM) applyPeeringRTTWrite(buf []byte, index uint64) interface{} {
+	var req pbpeering.PeeringRTTWriteRequest
+	if err := structs.DecodeProto(buf, &req); err != nil {
+		panic(fmt.Errorf("failed to decode peering rtt write request: %v", err))
+	}
+
+	defer metrics.MeasureSinceWithLabels([]string{"fsm", "peering"}, time.Now(),
+		[]metrics.Label{{Name: "op", Value: "rtt_write"}})
+
+	return c.state.PeeringRTTWrite(index, req.ID, req.RTT)
+}
+
 func (c *FSM) applyPeeringTrustBundleWrite(buf []byte, index uint64) interface{} {
 	var req pbpeering.PeeringTrustBundleWriteRequest
 	if err := decodePeeringTrustBundleWriteRequest(buf, &req); err != nil {
@@ -827,6 +874,42 @@ func (c *FSM) applyPeeringTrustBundleDelete(buf []byte, index uint64) interface{
 	return c.state.PeeringTrustBundleDelete(index, q)
 }
 
+func (c *FSM) applyPeeringConfigEntriesWrite(buf []byte, index uint64) interface{} {
+	var req pbpeering.PeeringConfigEntriesWriteRequest
+	if err := decodePeeringConfigEntriesWriteRequest(buf, &req); err != nil {
+		if errors.Is(err, ErrDroppingTenantedReq) {
+			c.logger.Warn("dropping tenanted peering config entries write request")
+			return nil
+		}
+		panic(fmt.Errorf("failed to decode peering config entries write request: %v", err))
+	}
+
+	defer metrics.MeasureSinceWithLabels([]string{"fsm", "peering_config_entries"}, time.Now(),
+		[]metrics.Label{{Name: "op", Value: "write"}})
+
+	return c.state.PeeringConfigEntriesWrite(index, req.PeeringConfigEntries)
+}
+
+func (c *FSM) applyPeeringConfigEntriesDelete(buf []byte, index uint64) interface{} {
+	var req pbpeering.PeeringConfigEntriesDeleteRequest
+	if err := decodePeeringConfigEntriesDeleteRequest(buf, &req); err != nil {
+		if errors.Is(err, ErrDroppingTenantedReq) {
+			c.logger.Warn("dropping tenanted peering config entries delete request")
+			return nil
+		}
+		panic(fmt.Errorf("failed to decode peering config entries delete request: %v", err))
+	}
+
+	defer metrics.MeasureSinceWithLabels([]string{"fsm", "peering_config_entries"}, time.Now(),
+		[]metrics.Label{{Name: "op", Value: "delete"}})
+
+	q := state.Query{
+		Value:          req.Name,
+		EnterpriseMeta: *structs.NodeEnterpriseMetaInPartition(req.Partition),
+	}
+	return c.state.PeeringConfigEntriesDelete(index, q)
+}
+
 func (f *FSM) applyResourceOperation(buf []byte, idx uint64) any {
 	return f.deps.StorageBackend.Apply(buf, idx)
 }
@@ -136,6 +136,20 @@ func decodePeeringTrustBundleDeleteRequest(buf []byte, req *pbpeering.PeeringTru
 	return decodePeeringTrustBundleDelete(buf, req)
 }
 
+func decodePeeringConfigEntriesWriteRequest(buf []byte, req *pbpeering.PeeringConfigEntriesWriteRequest) error {
+	if !structs.CEDowngrade {
+		return structs.DecodeProto(buf, req)
+	}
+	return decodePeeringConfigEntriesWrite(buf, req)
+}
+
+func decodePeeringConfigEntriesDeleteRequest(buf []byte, req *pbpeering.PeeringConfigEntriesDeleteRequest) error {
+	if !structs.CEDowngrade {
+		return structs.DecodeProto(buf, req)
+	}
+	return decodePeeringConfigEntriesDelete(buf, req)
+}
+
 func decodeConfigEntryOperationRequest(buf []byte, req *structs.ConfigEntryRequest) error {
 	if !structs.CEDowngrade {
 		return structs.Decode(buf, req)
@@ -542,6 +542,30 @@ func decodePeeringTrustBundleDelete(buf []byte, req *pbpeering.PeeringTrustBundl
 	return nil
 }
 
+func decodePeeringConfigEntriesWrite(buf []byte, req *pbpeering.PeeringConfigEntriesWriteRequest) error {
+	if err := structs.DecodeProto(buf, req); err != nil {
+		return err
+	}
+
+	if IsEnterpriseData("", req.PeeringConfigEntries.Partition) {
+		return ErrDroppingTenantedReq
+	}
+
+	return nil
+}
+
+func decodePeeringConfigEntriesDelete(buf []byte, req *pbpeering.PeeringConfigEntriesDeleteRequest) error {
+	if err := structs.DecodeProto(buf, req); err != nil {
+		return err
+	}
+
+	if IsEnterpriseData("", req.Partition) {
+		return ErrDroppingTenantedReq
+	}
+
+	return nil
+}
+
 func decodeConfigEntryOperation(buf []byte, req *structs.ConfigEntryRequest) error {
 
 	newReq := &ShadowConfigEntryRequest{
@@ -623,10 +647,16 @@ func MakeShadowConfigEntry(kind, name string) (structs.ConfigEntry, error) {
 		return &ShadowFileSystemCertificateConfigEntry{FileSystemCertificateConfigEntry: &structs.FileSystemCertificateConfigEntry{Name: name}}, nil
 	case structs.InlineCertificate:
 		return &ShadowInlineCertificateConfigEntry{InlineCertificateConfigEntry: &structs.InlineCertificateConfigEntry{Name: name}}, nil
+	case structs.ACMECertificate:
+		return &ShadowACMECertificateConfigEntry{ACMECertificateConfigEntry: &structs.ACMECertificateConfigEntry{Name: name}}, nil
 	case structs.HTTPRoute:
 		return &ShadowHTTPRouteConfigEntry{HTTPRouteConfigEntry: &structs.HTTPRouteConfigEntry{Name: name}}, nil
 	case structs.TCPRoute:
 		return &ShadowTCPRouteConfigEntry{TCPRouteConfigEntry: &structs.TCPRouteConfigEntry{Name: name}}, nil
+	case structs.GRPCRoute:
+		return &ShadowGRPCRouteConfigEntry{GRPCRouteConfigEntry: &structs.GRPCRouteConfigEntry{Name: name}}, nil
+	case structs.TLSRoute:
+		return &ShadowTLSRouteConfigEntry{TLSRouteConfigEntry: &structs.TLSRouteConfigEntry{Name: name}}, nil
 	case structs.JWTProvider:
 		return &ShadowJWTProviderConfigEntry{JWTProviderConfigEntry: &structs.JWTProviderConfigEntry{Name: name}}, nil
 	default:
@@ -951,6 +981,15 @@ func (s ShadowInlineCertificateConfigEntry) GetRealConfigEntry() structs.ConfigE
 	return s.InlineCertificateConfigEntry
 }
 
+type ShadowACMECertificateConfigEntry struct {
+	ShadowBase
+	*structs.ACMECertificateConfigEntry
+}
+
+func (s ShadowACMECertificateConfigEntry) GetRealConfigEntry() structs.ConfigEntry {
+	return s.ACMECertificateConfigEntry
+}
+
 type ShadowHTTPService struct {
 	ShadowBase
 	*structs.HTTPService
@@ -1012,6 +1051,55 @@ func (s ShadowTCPRouteConfigEntry) GetRealConfigEntry() structs.ConfigEntry {
 	return s.TCPRouteConfigEntry
 }
 
+type ShadowGRPCRouteRule struct {
+	Services []ShadowHTTPService
+	*structs.GRPCRouteRule
+}
+type ShadowGRPCRouteConfigEntry struct {
+	ShadowBase
+	Parents []ShadowResourceReference
+	Rules   []ShadowGRPCRouteRule
+	*structs.GRPCRouteConfigEntry
+}
+
+func (s ShadowGRPCRouteConfigEntry) GetRealConfigEntry() structs.ConfigEntry {
+	for _, parent := range s.Parents {
+		if !IsEnterpriseData(parent.Namespace, parent.Partition) {
+			s.GRPCRouteConfigEntry.Parents = append(s.GRPCRouteConfigEntry.Parents, *parent.ResourceReference)
+		}
+	}
+	for _, rule := range s.Rules {
+		for _, svc := range rule.Services {
+			if !IsEnterpriseData(svc.Namespace, svc.Partition) {
+				rule.GRPCRouteRule.Services = append(rule.GRPCRouteRule.Services, *svc.HTTPService)
+			}
+		}
+		s.GRPCRouteConfigEntry.Rules = append(s.GRPCRouteConfigEntry.Rules, *rule.GRPCRouteRule)
+	}
+	return s.GRPCRouteConfigEntry
+}
+
+type ShadowTLSRouteConfigEntry struct {
+	ShadowBase
+	Parents  []ShadowResourceReference
+	Services []ShadowTCPService
+	*structs.TLSRouteConfigEntry
+}
+
+func (s ShadowTLSRouteConfigEntry) GetRealConfigEntry() structs.ConfigEntry {
+	for _, parent := range s.Parents {
+		if !IsEnterpriseData(parent.Namespace, parent.Partition) {
+			s.TLSRouteConfigEntry.Parents = append(s.TLSRouteConfigEntry.Parents, *parent.ResourceReference)
+		}
+	}
+	for _, svc := range s.Services {
+		if !IsEnterpriseData(svc.Namespace, svc.Partition) {
+			s.TLSRouteConfigEntry.Services = append(s.TLSRouteConfigEntry.Services, *svc.TCPService)
+		}
+	}
+	return s.TLSRouteConfigEntry
+}
+
 type ShadowJWTProviderConfigEntry struct {
 	ShadowBase
 	*structs.JWTProviderConfigEntry
@@ -30,6 +30,9 @@ func init() {
 	registerRestorer(structs.ConnectCARequestType, restoreConnectCA)
 	registerRestorer(structs.ConnectCAProviderStateType, restoreConnectCAProviderState)
 	registerRestorer(structs.ConnectCAConfigType, restoreConnectCAConfig)
+	registerRestorer(structs.ConnectCARevokedCertType, restoreConnectCARevokedCert)
+	registerRestorer(structs.ConnectCARevocationListType, restoreConnectCARevocationList)
+	registerRestorer(structs.ConnectCALeafCertType, restoreConnectCALeafCert)
 	registerRestorer(structs.IndexRequestType, restoreIndex)
 	registerRestorer(structs.ACLTokenSetRequestType, restoreToken)
 	registerRestorer(structs.ACLPolicySetRequestType, restorePolicy)
@@ -43,6 +46,7 @@ func init() {
 	registerRestorer(structs.FreeVirtualIPRequestType, restoreFreeVirtualIP)
 	registerRestorer(structs.PeeringWriteType, restorePeering)
 	registerRestorer(structs.PeeringTrustBundleWriteType, restorePeeringTrustBundle)
+	registerRestorer(structs.PeeringConfigEntriesWriteType, restorePeeringConfigEntries)
 	registerRestorer(structs.PeeringSecretsWriteType, restorePeeringSecrets)
 }
 
@@ -83,6 +87,12 @@ func persistCE(s *snapshot, sink raft.SnapshotSink, encoder *codec.Encoder) erro
 	if err := s.persistConnectCAConfig(sink, encoder); err != nil {
 		return err
 	}
+	if err := s.persistConnectCARevocations(sink, encoder); err != nil {
+		return err
+	}
+	if err := s.persistConnectCALeafCerts(sink, encoder); err != nil {
+		return err
+	}
 	if err := s.persistConfigEntries(sink, encoder); err != nil {
 		return err
 	}
@@ -101,6 +111,9 @@ func persistCE(s *snapshot, sink raft.SnapshotSink, encoder *codec.Encoder) erro
 	if err := s.persistPeeringTrustBundles(sink, encoder); err != nil {
 		return err
 	}
+	if err := s.persistPeeringConfigEntries(sink, encoder); err != nil {
+		return err
+	}
 	if err := s.persistPeeringSecrets(sink, encoder); err != nil {
 		return err
 	}
@@ -422,6 +435,56 @@ func (s *snapshot) persistConnectCAProviderState(sink raft.SnapshotSink,
 	return nil
 }
 
+func (s *snapshot) persistConnectCARevocations(sink raft.SnapshotSink,
+	encoder *codec.Encoder) error {
+	revoked, err := s.state.CARevokedCerts()
+	if err != nil {
+		return err
+	}
+
+	for _, r := range revoked {
+		if _, err := sink.Write([]byte{byte(structs.ConnectCARevokedCertType)}); err != nil {
+			return err
+		}
+		if err := encoder.Encode(r); err != nil {
+			return err
+		}
+	}
+
+	lists, err := s.state.CARevocationLists()
+	if err != nil {
+		return err
+	}
+
+	for _, l := range lists {
+		if _, err := sink.Write([]byte{byte(structs.ConnectCARevocationListType)}); err != nil {
+			return err
+		}
+		if err := encoder.Encode(l); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *snapshot) persistConnectCALeafCerts(sink raft.SnapshotSink,
+	encoder *codec.Encoder) error {
+	certs, err := s.state.CALeafCerts()
+	if err != nil {
+		return err
+	}
+
+	for _, c := range certs {
+		if _, err := sink.Write([]byte{byte(structs.ConnectCALeafCertType)}); err != nil {
+			return err
+		}
+		if err := encoder.Encode(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *snapshot) persistLegacyIntentions(sink raft.SnapshotSink,
 	encoder *codec.Encoder) error {
 	//nolint:staticcheck
@@ -594,6 +657,24 @@ func (s *snapshot) persistPeeringTrustBundles(sink raft.SnapshotSink, encoder *c
 	return nil
 }
 
+func (s *snapshot) persistPeeringConfigEntries(sink raft.SnapshotSink, encoder *codec.Encoder) error {
+	entries, err := s.state.PeeringConfigEntries()
+	if err != nil {
+		return err
+	}
+
+	for entry := entries.Next(); entry != nil; entry = entries.Next() {
+		if _, err := sink.Write([]byte{byte(structs.PeeringConfigEntriesWriteType)}); err != nil {
+			return err
+		}
+		if err := encoder.Encode(entry.(*pbpeering.PeeringConfigEntries)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (s *snapshot) persistPeeringSecrets(sink raft.SnapshotSink, encoder *codec.Encoder) error {
 	secrets, err := s.state.PeeringSecrets()
 	if err != nil {
@@ -761,6 +842,39 @@ func restoreConnectCAConfig(header *SnapshotHeader, restore *state.Restore, deco
 	return nil
 }
 
+func restoreConnectCARevokedCert(header *SnapshotHeader, restore *state.Restore, decoder *codec.Decoder) error {
+	var req structs.CARevokedCert
+	if err := decoder.Decode(&req); err != nil {
+		return err
+	}
+	if err := restore.CARevokedCert(&req); err != nil {
+		return err
+	}
+	return nil
+}
+
+func restoreConnectCARevocationList(header *SnapshotHeader, restore *state.Restore, decoder *codec.Decoder) error {
+	var req structs.CARevocationList
+	if err := decoder.Decode(&req); err != nil {
+		return err
+	}
+	if err := restore.CARevocationList(&req); err != nil {
+		return err
+	}
+	return nil
+}
+
+func restoreConnectCALeafCert(header *SnapshotHeader, restore *state.Restore, decoder *codec.Decoder) error {
+	var req structs.IssuedCert
+	if err := decoder.Decode(&req); err != nil {
+		return err
+	}
+	if err := restore.CALeafCert(&req); err != nil {
+		return err
+	}
+	return nil
+}
+
 func restoreIndex(header *SnapshotHeader, restore *state.Restore, decoder *codec.Decoder) error {
 	var req state.IndexEntry
 	if err := decoder.Decode(&req); err != nil {
@@ -913,6 +1027,17 @@ func restorePeeringTrustBundle(header *SnapshotHeader, restore *state.Restore, d
 	return nil
 }
 
+func restorePeeringConfigEntries(header *SnapshotHeader, restore *state.Restore, decoder *codec.Decoder) error {
+	var req pbpeering.PeeringConfigEntries
+	if err := decoder.Decode(&req); err != nil {
+		return err
+	}
+	if err := restore.PeeringConfigEntries(&req); err != nil {
+		return err
+	}
+	return nil
+}
+
 func restorePeeringSecrets(header *SnapshotHeader, restore *state.Restore, decoder *codec.Decoder) error {
 	var req pbpeering.PeeringSecrets
 	if err := decoder.Decode(&req); err != nil {
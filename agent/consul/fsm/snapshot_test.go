@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/consul/internal/storage"
 	"github.com/hashicorp/consul/lib/stringslice"
 	"github.com/hashicorp/consul/proto-public/pbresource"
+	"github.com/hashicorp/consul/proto/private/pbconfigentry"
 	"github.com/hashicorp/consul/proto/private/pbpeering"
 	"github.com/hashicorp/consul/proto/private/prototest"
 	"github.com/hashicorp/consul/sdk/testutil"
@@ -249,6 +250,37 @@ func TestFSM_SnapshotRestore_CE(t *testing.T) {
 	err = fsm.state.CASetConfig(17, caConfig)
 	require.NoError(t, err)
 
+	// CA revocations
+	revokedCert := &structs.CARevokedCert{
+		SerialNumber: "0a:0b",
+		Reason:       "key compromise",
+		RevokedAt:    time.Now().UTC().Round(time.Second),
+		ExpiresAt:    time.Now().UTC().Round(time.Second).Add(time.Hour),
+	}
+	require.NoError(t, fsm.state.CARevokeCerts(17, []*structs.CARevokedCert{revokedCert}))
+	revocationList := &structs.CARevocationList{
+		Datacenter:        "dc1",
+		SigningKeyID:      "aa:bb",
+		RevocationListPEM: "crl",
+		NumRevoked:        1,
+		RevokedIndex:      17,
+	}
+	ok, err = fsm.state.CARevocationListSetCAS(17, 0, []*structs.CARevocationList{revocationList})
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// CA leaf cert inventory
+	issuedCert := &structs.IssuedCert{
+		SerialNumber: "0c:0d",
+		Service:      "web",
+		ServiceURI:   "spiffe://11111111-2222-3333-4444-555555555555.consul/ns/default/dc/dc1/svc/web",
+		Node:         "foo",
+		SigningKeyID: "aa:bb",
+		ValidAfter:   time.Now().UTC().Round(time.Second),
+		ValidBefore:  time.Now().UTC().Round(time.Second).Add(time.Hour),
+	}
+	require.NoError(t, fsm.state.CALeafCertSet(17, issuedCert))
+
 	// Config entries
 	serviceConfig := &structs.ServiceConfigEntry{
 		Kind:     structs.ServiceDefaults,
@@ -525,6 +557,18 @@ func TestFSM_SnapshotRestore_CE(t *testing.T) {
 		RootPEMs:    []string{"qux certificate bundle"},
 	}))
 
+	// Peering Config Entries
+	require.NoError(t, fsm.state.PeeringConfigEntriesWrite(32, &pbpeering.PeeringConfigEntries{
+		PeerName: "qux",
+		Entries: []*pbconfigentry.ConfigEntry{
+			pbconfigentry.ConfigEntryFromStructs(&structs.ServiceConfigEntry{
+				Kind:     structs.ServiceDefaults,
+				Name:     "web",
+				Protocol: "http",
+			}),
+		},
+	}))
+
 	// Issue two more secrets writes so that there are three secrets associated with the peering:
 	// - Establishment: "389bbcdf-1c31-47d6-ae96-f2a3f4c45f84"
 	// - Pending: "0b7812d4-32d9-4e54-b1b3-4d97084982a0"
@@ -811,6 +855,21 @@ func TestFSM_SnapshotRestore_CE(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, caConfig, caConf)
 
+	// Verify CA revocations are restored.
+	_, revokedCerts, err := fsm2.state.CARevokedCerts(nil)
+	require.NoError(t, err)
+	require.Equal(t, []*structs.CARevokedCert{revokedCert}, revokedCerts)
+	_, revocationLists, err := fsm2.state.CARevocationLists(nil)
+	require.NoError(t, err)
+	require.Equal(t, []*structs.CARevocationList{revocationList}, revocationLists)
+
+	// Verify the CA leaf cert inventory is restored.
+	_, issuedCerts, err := fsm2.state.CALeafCerts(nil)
+	require.NoError(t, err)
+	require.Len(t, issuedCerts, 1)
+	issuedCert.CreateIndex, issuedCert.ModifyIndex = 17, 17
+	require.Equal(t, issuedCert, issuedCerts[0])
+
 	// Verify config entries are restored
 	_, serviceConfEntry, err := fsm2.state.ConfigEntry(nil, structs.ServiceDefaults, "foo", structs.DefaultEnterpriseMetaInDefaultPartition())
 	require.NoError(t, err)
@@ -919,6 +978,19 @@ func TestFSM_SnapshotRestore_CE(t *testing.T) {
 	require.Len(t, ptbRestored.RootPEMs, 1)
 	require.Equal(t, "qux certificate bundle", ptbRestored.RootPEMs[0])
 
+	// Verify peering config entries are restored
+	idx, pceRestored, err := fsm2.state.PeeringConfigEntriesRead(nil, state.Query{
+		Value: "qux",
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(32), idx)
+	require.NotNil(t, pceRestored)
+	require.Len(t, pceRestored.Entries, 1)
+	restoredEntry := pbconfigentry.ConfigEntryToStructs(pceRestored.Entries[0])
+	require.Equal(t, structs.ServiceDefaults, restoredEntry.GetKind())
+	require.Equal(t, "web", restoredEntry.GetName())
+	require.Equal(t, "http", restoredEntry.(*structs.ServiceConfigEntry).Protocol)
+
 	// Verify resources are restored.
 	resourceRestored, err := storageBackend2.Read(context.Background(), storage.EventualConsistency, resource.Id)
 	require.NoError(t, err)
@@ -0,0 +1,541 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package gateways
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/acme"
+
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/hashicorp/consul/agent/consul/controller"
+	"github.com/hashicorp/consul/agent/consul/fsm"
+	"github.com/hashicorp/consul/agent/consul/state"
+	"github.com/hashicorp/consul/agent/consul/stream"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+)
+
+var (
+	// acmeChallengePropagationDelay is how long to wait after publishing
+	// challenges before asking the ACME server to validate them, giving the
+	// gateways time to pick up the new challenge routes.
+	acmeChallengePropagationDelay = 15 * time.Second
+
+	// acmeRetryInterval is the minimum time between failed issuance attempts
+	// for the same acme-certificate.
+	acmeRetryInterval = time.Minute
+
+	// acmeIssueTimeout bounds a single issuance attempt.
+	acmeIssueTimeout = 5 * time.Minute
+)
+
+// acmeCertificateReconciler issues and renews the certificates of
+// acme-certificate config entries.
+type acmeCertificateReconciler struct {
+	fsm     *fsm.FSM
+	logger  hclog.Logger
+	updater *Updater
+
+	// enqueue adds a request back to the controller's queue. It is called
+	// when an issuance attempt running in the background completes so that
+	// the result is picked up by Reconcile.
+	enqueue func(requests ...controller.Request)
+
+	lock sync.Mutex
+	// failures tracks the last failed issuance attempt per entry so that the
+	// status updates written on failure do not immediately trigger a retry.
+	failures map[string]time.Time
+	// issuing holds the cancel functions of the issuance attempts in
+	// progress. An ACME server can take minutes to validate challenges so
+	// attempts don't run on the controller's workers, which would hold up
+	// every other entry.
+	issuing map[string]context.CancelFunc
+	// results holds the outcome of completed issuance attempts until they
+	// are picked up by Reconcile.
+	results map[string]error
+}
+
+// NewACMECertificateController creates a controller that obtains certificates
+// for acme-certificate config entries, answering HTTP-01 challenges through
+// the gateways that reference them, and renews them before they expire.
+func NewACMECertificateController(fsm *fsm.FSM, publisher state.EventPublisher, updater *Updater, logger hclog.Logger) controller.Controller {
+	reconciler := newACMECertificateReconciler(fsm, updater, logger)
+	c := controller.New(publisher, reconciler).
+		WithLogger(logger.With("controller", "acmeCertificateController")).
+		Subscribe(&stream.SubscribeRequest{
+			Topic:   state.EventTopicACMECertificate,
+			Subject: stream.SubjectWildcard,
+		})
+	reconciler.enqueue = c.Enqueue
+	return c
+}
+
+func newACMECertificateReconciler(fsm *fsm.FSM, updater *Updater, logger hclog.Logger) *acmeCertificateReconciler {
+	return &acmeCertificateReconciler{
+		fsm:      fsm,
+		logger:   logger,
+		updater:  updater,
+		failures: make(map[string]time.Time),
+		issuing:  make(map[string]context.CancelFunc),
+		results:  make(map[string]error),
+	}
+}
+
+// Reconcile starts issuing a certificate for the requested acme-certificate if
+// it has none, if its hostnames changed, or if it is due for renewal. The
+// request is enqueued again when the attempt completes, at which point its
+// result is recorded and the request is requeued for when the certificate is
+// due for renewal.
+func (r *acmeCertificateReconciler) Reconcile(ctx context.Context, req controller.Request) error {
+	if req.Kind != structs.ACMECertificate {
+		return nil
+	}
+	logger := acmeCertificateRequestLogger(r.logger, req)
+
+	entry, err := r.getEntry(req)
+	if err != nil {
+		logger.Warn("error fetching acme-certificate", "error", err)
+		return err
+	}
+	if entry == nil {
+		r.forget(req)
+		return nil
+	}
+
+	issuing, issueErr, completed := r.issuanceState(req)
+	switch {
+	case issuing:
+		// The request is enqueued again once the attempt completes.
+		return nil
+	case completed && issueErr != nil:
+		logger.Warn("error issuing certificate", "error", issueErr)
+		r.recordFailure(req, time.Now())
+		if err := r.setIssuanceFailed(req, issueErr); err != nil {
+			logger.Warn("error updating acme-certificate status", "error", err)
+		}
+		return controller.RequeueAfter(acmeRetryInterval)
+	case completed:
+		r.clearFailure(req)
+		logger.Info("issued certificate", "hostnames", entry.Hostnames)
+	}
+
+	now := time.Now()
+	if renewAt, ok := acmeRenewalTime(entry); ok && now.Before(renewAt) {
+		return controller.RequeueAfter(renewAt.Sub(now))
+	}
+
+	if wait := r.retryWait(req, now); wait > 0 {
+		return controller.RequeueAfter(wait)
+	}
+
+	logger.Debug("issuing certificate", "hostnames", entry.Hostnames)
+	r.startIssuance(ctx, req, entry)
+	return nil
+}
+
+// startIssuance runs issue for the entry in the background, enqueueing the
+// request when it completes.
+func (r *acmeCertificateReconciler) startIssuance(ctx context.Context, req controller.Request, entry *structs.ACMECertificateConfigEntry) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	r.lock.Lock()
+	r.issuing[req.Key()] = cancel
+	r.lock.Unlock()
+
+	go func() {
+		defer cancel()
+		err := r.issue(ctx, req, entry)
+
+		r.lock.Lock()
+		delete(r.issuing, req.Key())
+		r.results[req.Key()] = err
+		r.lock.Unlock()
+
+		if r.enqueue != nil {
+			r.enqueue(req)
+		}
+	}()
+}
+
+// issuanceState returns whether an issuance attempt for the request is in
+// progress, and the result of the last attempt if it completed since the
+// previous call.
+func (r *acmeCertificateReconciler) issuanceState(req controller.Request) (issuing bool, err error, completed bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if _, ok := r.issuing[req.Key()]; ok {
+		return true, nil, false
+	}
+	err, completed = r.results[req.Key()]
+	delete(r.results, req.Key())
+	return false, err, completed
+}
+
+// issue runs the ACME order flow for the entry and stores the resulting
+// certificate on it.
+func (r *acmeCertificateReconciler) issue(ctx context.Context, req controller.Request, entry *structs.ACMECertificateConfigEntry) error {
+	ctx, cancel := context.WithTimeout(ctx, acmeIssueTimeout)
+	defer cancel()
+
+	accountKey, accountKeyPEM, err := acmeAccountKey(entry.AccountKey)
+	if err != nil {
+		return err
+	}
+	client, err := newACMEClient(entry, accountKey)
+	if err != nil {
+		return err
+	}
+
+	account := &acme.Account{}
+	if entry.Email != "" {
+		account.Contact = []string{"mailto:" + entry.Email}
+	}
+	if _, err := client.Register(ctx, account, acme.AcceptTOS); err != nil && !errors.Is(err, acme.ErrAccountAlreadyExists) {
+		return fmt.Errorf("error registering ACME account: %w", err)
+	}
+
+	order, err := client.AuthorizeOrder(ctx, acme.DomainIDs(entry.Hostnames...))
+	if err != nil {
+		return fmt.Errorf("error creating ACME order: %w", err)
+	}
+
+	var (
+		challenges []structs.ACMEChallenge
+		accepted   []*acme.Challenge
+		authzURLs  []string
+	)
+	for _, authzURL := range order.AuthzURLs {
+		authz, err := client.GetAuthorization(ctx, authzURL)
+		if err != nil {
+			return fmt.Errorf("error fetching ACME authorization: %w", err)
+		}
+		if authz.Status == acme.StatusValid {
+			continue
+		}
+
+		var challenge *acme.Challenge
+		for _, c := range authz.Challenges {
+			if c.Type == "http-01" {
+				challenge = c
+				break
+			}
+		}
+		if challenge == nil {
+			return fmt.Errorf("ACME server did not offer an http-01 challenge for %q", authz.Identifier.Value)
+		}
+
+		keyAuth, err := client.HTTP01ChallengeResponse(challenge.Token)
+		if err != nil {
+			return err
+		}
+		challenges = append(challenges, structs.ACMEChallenge{
+			Hostname:         authz.Identifier.Value,
+			Token:            challenge.Token,
+			KeyAuthorization: keyAuth,
+		})
+		accepted = append(accepted, challenge)
+		authzURLs = append(authzURLs, authzURL)
+	}
+
+	// Publish the challenges so that the gateways start answering them.
+	entry, err = r.update(req, entry, func(e *structs.ACMECertificateConfigEntry, u *structs.StatusUpdater) {
+		e.AccountKey = accountKeyPEM
+		e.Challenges = challenges
+		u.SetCondition(certificatePending(e))
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(accepted) > 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(acmeChallengePropagationDelay):
+		}
+	}
+
+	for _, challenge := range accepted {
+		if _, err := client.Accept(ctx, challenge); err != nil {
+			return fmt.Errorf("error accepting ACME challenge: %w", err)
+		}
+	}
+	for _, authzURL := range authzURLs {
+		if _, err := client.WaitAuthorization(ctx, authzURL); err != nil {
+			return fmt.Errorf("error validating ACME challenge: %w", err)
+		}
+	}
+
+	order, err = client.WaitOrder(ctx, order.URI)
+	if err != nil {
+		return fmt.Errorf("error waiting for ACME order: %w", err)
+	}
+
+	certKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: entry.Hostnames[0]},
+		DNSNames: entry.Hostnames,
+	}, certKey)
+	if err != nil {
+		return err
+	}
+	chain, _, err := client.CreateOrderCert(ctx, order.FinalizeURL, csr, true)
+	if err != nil {
+		return fmt.Errorf("error finalizing ACME order: %w", err)
+	}
+
+	var certPEM []byte
+	for _, der := range chain {
+		certPEM = append(certPEM, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})...)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(certKey)
+	if err != nil {
+		return err
+	}
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+
+	_, err = r.update(req, entry, func(e *structs.ACMECertificateConfigEntry, u *structs.StatusUpdater) {
+		e.Certificate = string(certPEM)
+		e.PrivateKey = string(keyPEM)
+		e.Challenges = nil
+		u.SetCondition(certificateIssued(e))
+	})
+	return err
+}
+
+// setIssuanceFailed clears any published challenges and records the error on
+// the entry's status.
+func (r *acmeCertificateReconciler) setIssuanceFailed(req controller.Request, issueErr error) error {
+	entry, err := r.getEntry(req)
+	if err != nil || entry == nil {
+		return err
+	}
+	_, err = r.update(req, entry, func(e *structs.ACMECertificateConfigEntry, u *structs.StatusUpdater) {
+		e.Challenges = nil
+		u.SetCondition(certificateIssuanceFailed(e, issueErr))
+	})
+	return err
+}
+
+// update applies mutate to a copy of entry and writes it along with its
+// status. It returns the stored entry, or an error if the entry was modified
+// concurrently.
+func (r *acmeCertificateReconciler) update(req controller.Request, entry *structs.ACMECertificateConfigEntry, mutate func(*structs.ACMECertificateConfigEntry, *structs.StatusUpdater)) (*structs.ACMECertificateConfigEntry, error) {
+	updated := entry.DeepCopy()
+	updater := structs.NewStatusUpdater(updated)
+	mutate(updated, updater)
+	if e, ok := updater.UpdateEntry(); ok {
+		updated = e.(*structs.ACMECertificateConfigEntry)
+	}
+
+	if err := r.updater.UpdateWithStatus(updated); err != nil {
+		return nil, err
+	}
+
+	stored, err := r.getEntry(req)
+	if err != nil {
+		return nil, err
+	}
+	if stored == nil || stored.ModifyIndex == entry.ModifyIndex ||
+		stored.AccountKey != updated.AccountKey ||
+		stored.Certificate != updated.Certificate ||
+		len(stored.Challenges) != len(updated.Challenges) {
+		return nil, fmt.Errorf("acme-certificate %q was modified during issuance", req.Name)
+	}
+	return stored, nil
+}
+
+func (r *acmeCertificateReconciler) getEntry(req controller.Request) (*structs.ACMECertificateConfigEntry, error) {
+	_, entry, err := r.fsm.State().ConfigEntry(nil, req.Kind, req.Name, req.Meta)
+	if err != nil || entry == nil {
+		return nil, err
+	}
+	return entry.(*structs.ACMECertificateConfigEntry), nil
+}
+
+func (r *acmeCertificateReconciler) retryWait(req controller.Request, now time.Time) time.Duration {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	last, ok := r.failures[req.Key()]
+	if !ok {
+		return 0
+	}
+	return acmeRetryInterval - now.Sub(last)
+}
+
+func (r *acmeCertificateReconciler) recordFailure(req controller.Request, at time.Time) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.failures[req.Key()] = at
+}
+
+func (r *acmeCertificateReconciler) clearFailure(req controller.Request) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	delete(r.failures, req.Key())
+}
+
+// forget cancels any issuance attempt in progress for a deleted entry and
+// drops everything tracked for it.
+func (r *acmeCertificateReconciler) forget(req controller.Request) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if cancel, ok := r.issuing[req.Key()]; ok {
+		cancel()
+		delete(r.issuing, req.Key())
+	}
+	delete(r.failures, req.Key())
+	delete(r.results, req.Key())
+}
+
+// acmeRenewalTime returns when the entry's certificate should be renewed. It
+// returns false if there is no usable certificate for the entry's hostnames.
+// If RenewBefore exceeds the certificate's lifetime the certificate is renewed
+// after two thirds of its lifetime instead.
+func acmeRenewalTime(entry *structs.ACMECertificateConfigEntry) (time.Time, bool) {
+	if !entry.IsIssued() {
+		return time.Time{}, false
+	}
+	block, _ := pem.Decode([]byte(entry.Certificate))
+	if block == nil {
+		return time.Time{}, false
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	want := slices.Clone(entry.Hostnames)
+	have := slices.Clone(cert.DNSNames)
+	sort.Strings(want)
+	sort.Strings(have)
+	if !slices.Equal(want, have) {
+		return time.Time{}, false
+	}
+
+	lifetime := cert.NotAfter.Sub(cert.NotBefore)
+	renewBefore := entry.RenewBeforeOrDefault()
+	if renewBefore >= lifetime {
+		return cert.NotBefore.Add(lifetime * 2 / 3), true
+	}
+	return cert.NotAfter.Add(-renewBefore), true
+}
+
+// acmeAccountKey parses the PEM encoded account key, generating a new one if
+// it is empty.
+func acmeAccountKey(keyPEM string) (crypto.Signer, string, error) {
+	if keyPEM == "" {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			return nil, "", err
+		}
+		der, err := x509.MarshalECPrivateKey(key)
+		if err != nil {
+			return nil, "", err
+		}
+		return key, string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})), nil
+	}
+
+	block, _ := pem.Decode([]byte(keyPEM))
+	if block == nil {
+		return nil, "", errors.New("failed to parse ACME account key PEM")
+	}
+	key, err := x509.ParseECPrivateKey(block.Bytes)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to parse ACME account key: %w", err)
+	}
+	return key, keyPEM, nil
+}
+
+func newACMEClient(entry *structs.ACMECertificateConfigEntry, key crypto.Signer) (*acme.Client, error) {
+	client := &acme.Client{
+		Key:          key,
+		DirectoryURL: entry.DirectoryURL,
+		UserAgent:    "consul",
+	}
+	if entry.DirectoryCACertificate != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(entry.DirectoryCACertificate)) {
+			return nil, errors.New("failed to parse DirectoryCACertificate PEM")
+		}
+		client.HTTPClient = &http.Client{
+			Transport: &http.Transport{
+				Proxy:           http.ProxyFromEnvironment,
+				TLSClientConfig: &tls.Config{RootCAs: pool},
+			},
+		}
+	}
+	return client, nil
+}
+
+func acmeCertificateRequestLogger(logger hclog.Logger, request controller.Request) hclog.Logger {
+	meta := request.Meta
+	return logger.With("acme-certificate", request.Name, "namespace", meta.NamespaceOrDefault(), "partition", meta.PartitionOrDefault())
+}
+
+func acmeCertificateRef(entry *structs.ACMECertificateConfigEntry) structs.ResourceReference {
+	return structs.ResourceReference{
+		Kind:           structs.ACMECertificate,
+		Name:           entry.Name,
+		EnterpriseMeta: entry.EnterpriseMeta,
+	}
+}
+
+// certificatePending returns a condition used while challenges for a new
+// certificate are being answered.
+func certificatePending(entry *structs.ACMECertificateConfigEntry) structs.Condition {
+	return structs.NewCertificateCondition(
+		api.CertificateConditionIssued,
+		api.ConditionStatusFalse,
+		api.CertificateReasonPending,
+		"answering ACME challenges",
+		acmeCertificateRef(entry),
+	)
+}
+
+// certificateIssued returns a condition used once a certificate is stored.
+func certificateIssued(entry *structs.ACMECertificateConfigEntry) structs.Condition {
+	return structs.NewCertificateCondition(
+		api.CertificateConditionIssued,
+		api.ConditionStatusTrue,
+		api.CertificateReasonIssued,
+		"certificate issued",
+		acmeCertificateRef(entry),
+	)
+}
+
+// certificateIssuanceFailed returns a condition used when the last issuance
+// attempt failed.
+func certificateIssuanceFailed(entry *structs.ACMECertificateConfigEntry, err error) structs.Condition {
+	return structs.NewCertificateCondition(
+		api.CertificateConditionIssued,
+		api.ConditionStatusFalse,
+		api.CertificateReasonIssuanceFailed,
+		err.Error(),
+		acmeCertificateRef(entry),
+	)
+}
@@ -0,0 +1,749 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package gateways
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/consul/controller"
+	"github.com/hashicorp/consul/agent/consul/fsm"
+	"github.com/hashicorp/consul/agent/consul/state"
+	"github.com/hashicorp/consul/agent/consul/stream"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+)
+
+func TestACMECertificateReconciler(t *testing.T) {
+	setACMETestTimings(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	fsm, updater := newACMETestFSM(t, ctx)
+	reconciler, enqueued := newACMETestReconciler(fsm, updater)
+
+	// The fake ACME server validates challenges by looking them up on the
+	// stored entry, standing in for the gateways serving them.
+	server := newFakeACMEServer(t, func(hostname, token string) (string, bool) {
+		entry := getACMETestEntry(t, fsm, "cert")
+		for _, challenge := range entry.Challenges {
+			if challenge.Hostname == hostname && challenge.Token == token {
+				return challenge.KeyAuthorization, true
+			}
+		}
+		return "", false
+	})
+
+	entry := &structs.ACMECertificateConfigEntry{
+		Kind:                   structs.ACMECertificate,
+		Name:                   "cert",
+		DirectoryURL:           server.URL + "/dir",
+		DirectoryCACertificate: server.CACertificate(),
+		Email:                  "admin@example.com",
+		Hostnames:              []string{"example.com", "www.example.com"},
+	}
+	require.NoError(t, fsm.State().EnsureConfigEntry(1, entry))
+
+	req := controller.Request{Kind: structs.ACMECertificate, Name: "cert", Meta: acl.DefaultEnterpriseMeta()}
+
+	t.Run("issue", func(t *testing.T) {
+		err := reconcileACMETest(t, ctx, reconciler, enqueued, req)
+		var requeue controller.RequeueAfterError
+		require.ErrorAs(t, err, &requeue)
+
+		stored := getACMETestEntry(t, fsm, "cert")
+		require.True(t, stored.IsIssued())
+		require.NotEmpty(t, stored.AccountKey)
+		require.Empty(t, stored.Challenges)
+		require.Len(t, stored.Status.Conditions, 1)
+		require.Equal(t, string(api.CertificateConditionIssued), stored.Status.Conditions[0].Type)
+		require.Equal(t, string(api.ConditionStatusTrue), stored.Status.Conditions[0].Status)
+
+		cert := parseACMETestCertificate(t, stored.Certificate)
+		require.ElementsMatch(t, []string{"example.com", "www.example.com"}, cert.DNSNames)
+		require.Equal(t, 1, server.Orders())
+		require.Equal(t, 2, server.Validations())
+	})
+
+	t.Run("requeue until renewal", func(t *testing.T) {
+		err := reconciler.Reconcile(ctx, req)
+		var requeue controller.RequeueAfterError
+		require.ErrorAs(t, err, &requeue)
+
+		// The fake CA issues 90 day certificates and the default is to renew
+		// 30 days before expiry.
+		require.InDelta(t, (60 * 24 * time.Hour).Seconds(), time.Duration(requeue).Seconds(), time.Minute.Seconds())
+		require.Equal(t, 1, server.Orders())
+	})
+
+	t.Run("user writes keep the certificate", func(t *testing.T) {
+		issued := getACMETestEntry(t, fsm, "cert")
+
+		updated := entry.DeepCopy()
+		updated.Hostnames = []string{"example.com"}
+		require.NoError(t, fsm.State().EnsureConfigEntry(100, updated))
+
+		stored := getACMETestEntry(t, fsm, "cert")
+		require.Equal(t, issued.Certificate, stored.Certificate)
+		require.Equal(t, issued.PrivateKey, stored.PrivateKey)
+		require.Equal(t, issued.AccountKey, stored.AccountKey)
+	})
+
+	t.Run("hostname change reissues", func(t *testing.T) {
+		accountKey := getACMETestEntry(t, fsm, "cert").AccountKey
+
+		err := reconcileACMETest(t, ctx, reconciler, enqueued, req)
+		var requeue controller.RequeueAfterError
+		require.ErrorAs(t, err, &requeue)
+
+		stored := getACMETestEntry(t, fsm, "cert")
+		cert := parseACMETestCertificate(t, stored.Certificate)
+		require.Equal(t, []string{"example.com"}, cert.DNSNames)
+		require.Equal(t, accountKey, stored.AccountKey)
+		require.Equal(t, 2, server.Orders())
+		require.Equal(t, 1, server.Accounts())
+	})
+
+	t.Run("failed validation", func(t *testing.T) {
+		issued := getACMETestEntry(t, fsm, "cert")
+
+		updated := entry.DeepCopy()
+		updated.Hostnames = []string{"api.example.com"}
+		require.NoError(t, fsm.State().EnsureConfigEntry(200, updated))
+		server.FailValidation("api.example.com")
+
+		err := reconcileACMETest(t, ctx, reconciler, enqueued, req)
+		var requeue controller.RequeueAfterError
+		require.ErrorAs(t, err, &requeue)
+		require.Equal(t, acmeRetryInterval, time.Duration(requeue))
+
+		stored := getACMETestEntry(t, fsm, "cert")
+		require.Empty(t, stored.Challenges)
+		require.Equal(t, issued.Certificate, stored.Certificate)
+		require.Len(t, stored.Status.Conditions, 1)
+		require.Equal(t, string(api.ConditionStatusFalse), stored.Status.Conditions[0].Status)
+		require.Equal(t, string(api.CertificateReasonIssuanceFailed), stored.Status.Conditions[0].Reason)
+
+		// The failure is not retried until the retry interval passes.
+		orders := server.Orders()
+		err = reconciler.Reconcile(ctx, req)
+		require.ErrorAs(t, err, &requeue)
+		require.Equal(t, orders, server.Orders())
+	})
+
+	t.Run("deleted", func(t *testing.T) {
+		require.NoError(t, fsm.State().DeleteConfigEntry(300, structs.ACMECertificate, "cert", nil))
+		require.NoError(t, reconciler.Reconcile(ctx, req))
+		require.Empty(t, reconciler.failures)
+		require.Empty(t, reconciler.issuing)
+		require.Empty(t, reconciler.results)
+	})
+}
+
+func TestACMECertificateReconciler_SlowDirectory(t *testing.T) {
+	setACMETestTimings(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	fsm, updater := newACMETestFSM(t, ctx)
+	reconciler, enqueued := newACMETestReconciler(fsm, updater)
+
+	// A directory that never responds holds up its own issuance but not
+	// those of other entries.
+	stalled := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-stalled:
+		case <-r.Context().Done():
+		}
+	}))
+	t.Cleanup(slow.Close)
+	t.Cleanup(func() { close(stalled) })
+
+	server := newFakeACMEServer(t, func(hostname, token string) (string, bool) {
+		entry := getACMETestEntry(t, fsm, "cert")
+		for _, challenge := range entry.Challenges {
+			if challenge.Hostname == hostname && challenge.Token == token {
+				return challenge.KeyAuthorization, true
+			}
+		}
+		return "", false
+	})
+
+	require.NoError(t, fsm.State().EnsureConfigEntry(1, &structs.ACMECertificateConfigEntry{
+		Kind:         structs.ACMECertificate,
+		Name:         "slow",
+		DirectoryURL: slow.URL + "/dir",
+		Hostnames:    []string{"slow.example.com"},
+	}))
+	require.NoError(t, fsm.State().EnsureConfigEntry(2, &structs.ACMECertificateConfigEntry{
+		Kind:                   structs.ACMECertificate,
+		Name:                   "cert",
+		DirectoryURL:           server.URL + "/dir",
+		DirectoryCACertificate: server.CACertificate(),
+		Hostnames:              []string{"example.com"},
+	}))
+
+	slowReq := controller.Request{Kind: structs.ACMECertificate, Name: "slow", Meta: acl.DefaultEnterpriseMeta()}
+	req := controller.Request{Kind: structs.ACMECertificate, Name: "cert", Meta: acl.DefaultEnterpriseMeta()}
+
+	require.NoError(t, reconciler.Reconcile(ctx, slowReq))
+
+	// Reconciling the slow entry again while its attempt is in progress
+	// doesn't start another one.
+	require.NoError(t, reconciler.Reconcile(ctx, slowReq))
+
+	err := reconcileACMETest(t, ctx, reconciler, enqueued, req)
+	var requeue controller.RequeueAfterError
+	require.ErrorAs(t, err, &requeue)
+	require.True(t, getACMETestEntry(t, fsm, "cert").IsIssued())
+	require.False(t, getACMETestEntry(t, fsm, "slow").IsIssued())
+
+	// Deleting the slow entry cancels its attempt.
+	require.NoError(t, fsm.State().DeleteConfigEntry(3, structs.ACMECertificate, "slow", nil))
+	require.NoError(t, reconciler.Reconcile(ctx, slowReq))
+	select {
+	case got := <-enqueued:
+		require.Equal(t, slowReq, got)
+	case <-ctx.Done():
+		t.Fatal("cancelled issuance did not complete")
+	}
+	require.NoError(t, reconciler.Reconcile(ctx, slowReq))
+	require.Empty(t, reconciler.issuing)
+	require.Empty(t, reconciler.results)
+}
+
+func TestACMERenewalTime(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	certificate := func(lifetime time.Duration, hostnames ...string) string {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		der, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+			SerialNumber: big.NewInt(1),
+			NotBefore:    now,
+			NotAfter:     now.Add(lifetime),
+			DNSNames:     hostnames,
+		}, &x509.Certificate{SerialNumber: big.NewInt(1)}, &key.PublicKey, key)
+		require.NoError(t, err)
+		return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	}
+
+	for name, tc := range map[string]struct {
+		entry    *structs.ACMECertificateConfigEntry
+		expected time.Time
+		ok       bool
+	}{
+		"not issued": {
+			entry: &structs.ACMECertificateConfigEntry{Hostnames: []string{"example.com"}},
+		},
+		"default renew before": {
+			entry: &structs.ACMECertificateConfigEntry{
+				Hostnames:   []string{"example.com"},
+				Certificate: certificate(90*24*time.Hour, "example.com"),
+				PrivateKey:  "key",
+			},
+			expected: now.Add(60 * 24 * time.Hour),
+			ok:       true,
+		},
+		"custom renew before": {
+			entry: &structs.ACMECertificateConfigEntry{
+				Hostnames:   []string{"example.com"},
+				RenewBefore: 24 * time.Hour,
+				Certificate: certificate(90*24*time.Hour, "example.com"),
+				PrivateKey:  "key",
+			},
+			expected: now.Add(89 * 24 * time.Hour),
+			ok:       true,
+		},
+		"short lived certificate": {
+			entry: &structs.ACMECertificateConfigEntry{
+				Hostnames:   []string{"example.com"},
+				Certificate: certificate(6*24*time.Hour, "example.com"),
+				PrivateKey:  "key",
+			},
+			expected: now.Add(4 * 24 * time.Hour),
+			ok:       true,
+		},
+		"hostnames in different order": {
+			entry: &structs.ACMECertificateConfigEntry{
+				Hostnames:   []string{"b.example.com", "a.example.com"},
+				Certificate: certificate(90*24*time.Hour, "a.example.com", "b.example.com"),
+				PrivateKey:  "key",
+			},
+			expected: now.Add(60 * 24 * time.Hour),
+			ok:       true,
+		},
+		"hostnames changed": {
+			entry: &structs.ACMECertificateConfigEntry{
+				Hostnames:   []string{"example.com", "www.example.com"},
+				Certificate: certificate(90*24*time.Hour, "example.com"),
+				PrivateKey:  "key",
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			renewAt, ok := acmeRenewalTime(tc.entry)
+			require.Equal(t, tc.ok, ok)
+			require.True(t, tc.expected.Equal(renewAt), "expected %s, got %s", tc.expected, renewAt)
+		})
+	}
+}
+
+func TestNewACMECertificateController(t *testing.T) {
+	t.Parallel()
+
+	publisher := stream.NewEventPublisher(1 * time.Millisecond)
+	fsm := fsm.NewFromDeps(fsm.Deps{
+		Logger: hclog.New(nil),
+		NewStateStore: func() *state.Store {
+			return state.NewStateStoreWithEventPublisher(nil, publisher)
+		},
+		Publisher:      publisher,
+		StorageBackend: fsm.NullStorageBackend,
+	})
+
+	updater := &Updater{
+		UpdateWithStatus: func(entry structs.ControlledConfigEntry) error { return nil },
+		Update:           func(entry structs.ConfigEntry) error { return nil },
+		Delete:           func(entry structs.ConfigEntry) error { return nil },
+	}
+
+	require.NotNil(t, NewACMECertificateController(fsm, publisher, updater, hclog.Default()))
+}
+
+// newACMETestReconciler returns a reconciler that sends the requests it
+// enqueues on the returned channel.
+func newACMETestReconciler(fsm *fsm.FSM, updater *Updater) (*acmeCertificateReconciler, chan controller.Request) {
+	enqueued := make(chan controller.Request, 10)
+	reconciler := newACMECertificateReconciler(fsm, updater, hclog.Default())
+	reconciler.enqueue = func(requests ...controller.Request) {
+		for _, req := range requests {
+			enqueued <- req
+		}
+	}
+	return reconciler, enqueued
+}
+
+// reconcileACMETest reconciles the request, and if that started an issuance
+// attempt waits for it to complete and reconciles the request again to pick
+// up its result.
+func reconcileACMETest(t *testing.T, ctx context.Context, reconciler *acmeCertificateReconciler, enqueued chan controller.Request, req controller.Request) error {
+	t.Helper()
+	if err := reconciler.Reconcile(ctx, req); err != nil {
+		return err
+	}
+	select {
+	case got := <-enqueued:
+		require.Equal(t, req, got)
+	case <-ctx.Done():
+		t.Fatal("issuance did not complete")
+	}
+	return reconciler.Reconcile(ctx, req)
+}
+
+func setACMETestTimings(t *testing.T) {
+	propagationDelay, retryInterval := acmeChallengePropagationDelay, acmeRetryInterval
+	acmeChallengePropagationDelay = 0
+	acmeRetryInterval = time.Hour
+	t.Cleanup(func() {
+		acmeChallengePropagationDelay, acmeRetryInterval = propagationDelay, retryInterval
+	})
+}
+
+func newACMETestFSM(t *testing.T, ctx context.Context) (*fsm.FSM, *Updater) {
+	publisher := stream.NewEventPublisher(1 * time.Millisecond)
+	go publisher.Run(ctx)
+
+	fsm := fsm.NewFromDeps(fsm.Deps{
+		Logger: hclog.New(nil),
+		NewStateStore: func() *state.Store {
+			return state.NewStateStoreWithEventPublisher(nil, publisher)
+		},
+		Publisher:      publisher,
+		StorageBackend: fsm.NullStorageBackend,
+	})
+
+	var (
+		index     uint64 = 1000
+		indexLock sync.Mutex
+	)
+	nextIndex := func() uint64 {
+		indexLock.Lock()
+		defer indexLock.Unlock()
+		index++
+		return index
+	}
+	updater := &Updater{
+		UpdateWithStatus: func(entry structs.ControlledConfigEntry) error {
+			_, err := fsm.State().EnsureConfigEntryWithStatusCAS(nextIndex(), entry.GetRaftIndex().ModifyIndex, entry)
+			return err
+		},
+		Update: func(entry structs.ConfigEntry) error {
+			_, err := fsm.State().EnsureConfigEntryCAS(nextIndex(), entry.GetRaftIndex().ModifyIndex, entry)
+			return err
+		},
+		Delete: func(entry structs.ConfigEntry) error {
+			_, err := fsm.State().DeleteConfigEntryCAS(nextIndex(), entry.GetRaftIndex().ModifyIndex, entry)
+			return err
+		},
+	}
+	return fsm, updater
+}
+
+func getACMETestEntry(t *testing.T, fsm *fsm.FSM, name string) *structs.ACMECertificateConfigEntry {
+	_, entry, err := fsm.State().ConfigEntry(nil, structs.ACMECertificate, name, nil)
+	require.NoError(t, err)
+	require.NotNil(t, entry)
+	return entry.(*structs.ACMECertificateConfigEntry)
+}
+
+func parseACMETestCertificate(t *testing.T, certPEM string) *x509.Certificate {
+	block, _ := pem.Decode([]byte(certPEM))
+	require.NotNil(t, block)
+	cert, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+	return cert
+}
+
+// fakeACMEServer is a minimal RFC 8555 server in the spirit of Pebble. It
+// does not verify request signatures, and it validates HTTP-01 challenges by
+// calling a lookup function rather than making requests to the hostnames.
+type fakeACMEServer struct {
+	*httptest.Server
+
+	t      *testing.T
+	lookup func(hostname, token string) (string, bool)
+	caKey  *ecdsa.PrivateKey
+	caCert *x509.Certificate
+	caPEM  []byte
+
+	lock        sync.Mutex
+	nonce       int
+	accounts    map[string]string // JWK thumbprint to account URL
+	orders      []*fakeACMEOrder
+	authzs      []*fakeACMEAuthz
+	failing     map[string]bool
+	validations int
+}
+
+type fakeACMEOrder struct {
+	account     string
+	identifiers []string
+	authzs      []int
+	certificate []byte
+}
+
+type fakeACMEAuthz struct {
+	account  string
+	hostname string
+	token    string
+	status   string
+}
+
+func newFakeACMEServer(t *testing.T, lookup func(hostname, token string) (string, bool)) *fakeACMEServer {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Fake ACME CA"},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(365 * 24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	caCert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	s := &fakeACMEServer{
+		t:        t,
+		lookup:   lookup,
+		caKey:    caKey,
+		caCert:   caCert,
+		caPEM:    pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		accounts: make(map[string]string),
+		failing:  make(map[string]bool),
+	}
+	s.Server = httptest.NewTLSServer(http.HandlerFunc(s.handle))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// CACertificate returns the PEM encoded certificate of the server's listener.
+func (s *fakeACMEServer) CACertificate() string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: s.Certificate().Raw}))
+}
+
+// FailValidation makes challenges for hostname fail validation.
+func (s *fakeACMEServer) FailValidation(hostname string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.failing[hostname] = true
+}
+
+func (s *fakeACMEServer) Accounts() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return len(s.accounts)
+}
+
+func (s *fakeACMEServer) Orders() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return len(s.orders)
+}
+
+func (s *fakeACMEServer) Validations() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.validations
+}
+
+func (s *fakeACMEServer) handle(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.nonce++
+	w.Header().Set("Replay-Nonce", fmt.Sprintf("nonce-%d", s.nonce))
+
+	if r.URL.Path == "/dir" {
+		s.writeJSON(w, http.StatusOK, map[string]string{
+			"newNonce":   s.URL + "/nonce",
+			"newAccount": s.URL + "/account",
+			"newOrder":   s.URL + "/order",
+		})
+		return
+	}
+	if r.URL.Path == "/nonce" {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	jwk, kid, payload := s.decodeJWS(r)
+
+	var id int
+	switch {
+	case r.URL.Path == "/account":
+		thumbprint := fakeACMEThumbprint(s.t, jwk)
+		if url, ok := s.accounts[thumbprint]; ok {
+			w.Header().Set("Location", url)
+			s.writeJSON(w, http.StatusOK, map[string]string{"status": "valid"})
+			return
+		}
+		url := fmt.Sprintf("%s/account/%d", s.URL, len(s.accounts))
+		s.accounts[thumbprint] = url
+		w.Header().Set("Location", url)
+		s.writeJSON(w, http.StatusCreated, map[string]string{"status": "valid"})
+
+	case r.URL.Path == "/order":
+		var req struct {
+			Identifiers []struct{ Type, Value string }
+		}
+		require.NoError(s.t, json.Unmarshal(payload, &req))
+		order := &fakeACMEOrder{account: kid}
+		for _, identifier := range req.Identifiers {
+			order.identifiers = append(order.identifiers, identifier.Value)
+			order.authzs = append(order.authzs, len(s.authzs))
+			s.authzs = append(s.authzs, &fakeACMEAuthz{
+				account:  kid,
+				hostname: identifier.Value,
+				token:    fmt.Sprintf("token-%d", len(s.authzs)),
+				status:   "pending",
+			})
+		}
+		s.orders = append(s.orders, order)
+		w.Header().Set("Location", fmt.Sprintf("%s/order/%d", s.URL, len(s.orders)-1))
+		s.writeJSON(w, http.StatusCreated, s.orderResponse(len(s.orders)-1))
+
+	case scanID(r.URL.Path, "/order/%d", &id):
+		w.Header().Set("Location", s.URL+r.URL.Path)
+		s.writeJSON(w, http.StatusOK, s.orderResponse(id))
+
+	case scanID(r.URL.Path, "/authz/%d", &id):
+		s.writeJSON(w, http.StatusOK, s.authzResponse(id))
+
+	case scanID(r.URL.Path, "/challenge/%d", &id):
+		authz := s.authzs[id]
+		s.validations++
+
+		// Validation happens synchronously, so release the lock while looking
+		// up the challenge in case the lookup blocks on the state store.
+		s.lock.Unlock()
+		keyAuth, ok := s.lookup(authz.hostname, authz.token)
+		s.lock.Lock()
+
+		thumbprint := ""
+		for tp, url := range s.accounts {
+			if url == authz.account {
+				thumbprint = tp
+			}
+		}
+		if ok && !s.failing[authz.hostname] && keyAuth == authz.token+"."+thumbprint {
+			authz.status = "valid"
+		} else {
+			authz.status = "invalid"
+		}
+		s.writeJSON(w, http.StatusOK, s.authzResponse(id)["challenges"].([]map[string]string)[0])
+
+	case scanID(r.URL.Path, "/finalize/%d", &id):
+		var req struct{ CSR string }
+		require.NoError(s.t, json.Unmarshal(payload, &req))
+		der, err := base64.RawURLEncoding.DecodeString(req.CSR)
+		require.NoError(s.t, err)
+		csr, err := x509.ParseCertificateRequest(der)
+		require.NoError(s.t, err)
+		require.ElementsMatch(s.t, s.orders[id].identifiers, csr.DNSNames)
+
+		cert, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+			SerialNumber: big.NewInt(int64(id + 2)),
+			Subject:      pkix.Name{CommonName: csr.DNSNames[0]},
+			DNSNames:     csr.DNSNames,
+			NotBefore:    time.Now(),
+			NotAfter:     time.Now().Add(90 * 24 * time.Hour),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		}, s.caCert, csr.PublicKey, s.caKey)
+		require.NoError(s.t, err)
+		s.orders[id].certificate = append(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert}), s.caPEM...)
+
+		w.Header().Set("Location", fmt.Sprintf("%s/order/%d", s.URL, id))
+		s.writeJSON(w, http.StatusOK, s.orderResponse(id))
+
+	case scanID(r.URL.Path, "/certificate/%d", &id):
+		w.Header().Set("Content-Type", "application/pem-certificate-chain")
+		w.WriteHeader(http.StatusOK)
+		w.Write(s.orders[id].certificate)
+
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *fakeACMEServer) orderResponse(id int) map[string]interface{} {
+	order := s.orders[id]
+	status := "ready"
+	var authzURLs []string
+	for _, authz := range order.authzs {
+		authzURLs = append(authzURLs, fmt.Sprintf("%s/authz/%d", s.URL, authz))
+		switch s.authzs[authz].status {
+		case "invalid":
+			status = "invalid"
+		case "pending":
+			if status != "invalid" {
+				status = "pending"
+			}
+		}
+	}
+	var identifiers []map[string]string
+	for _, identifier := range order.identifiers {
+		identifiers = append(identifiers, map[string]string{"type": "dns", "value": identifier})
+	}
+	resp := map[string]interface{}{
+		"status":         status,
+		"identifiers":    identifiers,
+		"authorizations": authzURLs,
+		"finalize":       fmt.Sprintf("%s/finalize/%d", s.URL, id),
+	}
+	if order.certificate != nil {
+		resp["status"] = "valid"
+		resp["certificate"] = fmt.Sprintf("%s/certificate/%d", s.URL, id)
+	}
+	return resp
+}
+
+func (s *fakeACMEServer) authzResponse(id int) map[string]interface{} {
+	authz := s.authzs[id]
+	return map[string]interface{}{
+		"status":     authz.status,
+		"identifier": map[string]string{"type": "dns", "value": authz.hostname},
+		"challenges": []map[string]string{{
+			"type":   "http-01",
+			"url":    fmt.Sprintf("%s/challenge/%d", s.URL, id),
+			"token":  authz.token,
+			"status": authz.status,
+		}},
+	}
+}
+
+// decodeJWS returns the JWK or key ID from the protected header of a request
+// along with its payload.
+func (s *fakeACMEServer) decodeJWS(r *http.Request) (json.RawMessage, string, []byte) {
+	body, err := io.ReadAll(r.Body)
+	require.NoError(s.t, err)
+
+	var jws struct {
+		Protected string `json:"protected"`
+		Payload   string `json:"payload"`
+	}
+	require.NoError(s.t, json.Unmarshal(body, &jws))
+
+	protected, err := base64.RawURLEncoding.DecodeString(jws.Protected)
+	require.NoError(s.t, err)
+	var header struct {
+		JWK json.RawMessage `json:"jwk"`
+		KID string          `json:"kid"`
+	}
+	require.NoError(s.t, json.Unmarshal(protected, &header))
+
+	payload, err := base64.RawURLEncoding.DecodeString(jws.Payload)
+	require.NoError(s.t, err)
+	return header.JWK, header.KID, payload
+}
+
+func (s *fakeACMEServer) writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	require.NoError(s.t, json.NewEncoder(w).Encode(v))
+}
+
+// fakeACMEThumbprint computes the RFC 7638 thumbprint of an EC JWK.
+func fakeACMEThumbprint(t *testing.T, jwk json.RawMessage) string {
+	var key struct {
+		Crv string `json:"crv"`
+		Kty string `json:"kty"`
+		X   string `json:"x"`
+		Y   string `json:"y"`
+	}
+	require.NoError(t, json.Unmarshal(jwk, &key))
+	require.Equal(t, "EC", key.Kty)
+
+	canonical := fmt.Sprintf(`{"crv":%q,"kty":%q,"x":%q,"y":%q}`, key.Crv, key.Kty, key.X, key.Y)
+	sum := sha256.Sum256([]byte(canonical))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func scanID(path, format string, id *int) bool {
+	prefix := strings.TrimSuffix(format, "%d")
+	if !strings.HasPrefix(path, prefix) {
+		return false
+	}
+	_, err := fmt.Sscanf(path, format, id)
+	return err == nil
+}
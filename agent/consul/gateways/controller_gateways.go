@@ -61,7 +61,11 @@ func (r *apiGatewayReconciler) Reconcile(ctx context.Context, req controller.Req
 		return reconcileEntry(r.fsm.State(), r.logger, ctx, req, r.reconcileHTTPRoute, r.cleanupRoute)
 	case structs.TCPRoute:
 		return reconcileEntry(r.fsm.State(), r.logger, ctx, req, r.reconcileTCPRoute, r.cleanupRoute)
-	case structs.InlineCertificate, structs.FileSystemCertificate:
+	case structs.GRPCRoute:
+		return reconcileEntry(r.fsm.State(), r.logger, ctx, req, r.reconcileGRPCRoute, r.cleanupRoute)
+	case structs.TLSRoute:
+		return reconcileEntry(r.fsm.State(), r.logger, ctx, req, r.reconcileTLSRoute, r.cleanupRoute)
+	case structs.InlineCertificate, structs.FileSystemCertificate, structs.ACMECertificate:
 		return r.enqueueCertificateReferencedGateways(r.fsm.State(), ctx, req)
 	case structs.JWTProvider:
 		return r.enqueueJWTProviderReferencedGatewaysAndHTTPRoutes(r.fsm.State(), ctx, req)
@@ -477,7 +481,7 @@ func (r *apiGatewayReconciler) reconcileRoute(_ context.Context, req controller.
 			continue
 		}
 
-		if chain.Protocol != string(route.GetProtocol()) {
+		if chain.Protocol != routeServiceProtocol(route) {
 			updater.SetCondition(routeInvalidDiscoveryChain(errInvalidProtocol))
 			continue
 		}
@@ -533,6 +537,31 @@ func (r *apiGatewayReconciler) reconcileTCPRoute(ctx context.Context, req contro
 	return r.reconcileRoute(ctx, req, store, route)
 }
 
+// reconcileGRPCRoute is a thin wrapper around recnocileRoute for a GRPCRoutes
+func (r *apiGatewayReconciler) reconcileGRPCRoute(ctx context.Context, req controller.Request, store *state.Store, route *structs.GRPCRouteConfigEntry) error {
+	return r.reconcileRoute(ctx, req, store, route)
+}
+
+// reconcileTLSRoute is a thin wrapper around recnocileRoute for a TLSRoutes
+func (r *apiGatewayReconciler) reconcileTLSRoute(ctx context.Context, req controller.Request, store *state.Store, route *structs.TLSRouteConfigEntry) error {
+	return r.reconcileRoute(ctx, req, store, route)
+}
+
+// routeServiceProtocol returns the protocol that the services targeted by a
+// route must use. This is usually the protocol of the listeners the route
+// binds to, but gRPC routes bind to http listeners and TLS routes pass
+// connections through to tcp services.
+func routeServiceProtocol(route structs.BoundRoute) string {
+	switch route.(type) {
+	case *structs.GRPCRouteConfigEntry:
+		return "grpc"
+	case *structs.TLSRouteConfigEntry:
+		return "tcp"
+	default:
+		return string(route.GetProtocol())
+	}
+}
+
 // NewAPIGatewayController initializes a controller that reconciles all APIGateway objects
 func NewAPIGatewayController(fsm *fsm.FSM, publisher state.EventPublisher, updater *Updater, logger hclog.Logger) controller.Controller {
 	reconciler := &apiGatewayReconciler{
@@ -557,6 +586,16 @@ func NewAPIGatewayController(fsm *fsm.FSM, publisher state.EventPublisher, updat
 			Topic:   state.EventTopicTCPRoute,
 			Subject: stream.SubjectWildcard,
 		},
+	).Subscribe(
+		&stream.SubscribeRequest{
+			Topic:   state.EventTopicGRPCRoute,
+			Subject: stream.SubjectWildcard,
+		},
+	).Subscribe(
+		&stream.SubscribeRequest{
+			Topic:   state.EventTopicTLSRoute,
+			Subject: stream.SubjectWildcard,
+		},
 	).Subscribe(
 		&stream.SubscribeRequest{
 			Topic:   state.EventTopicBoundAPIGateway,
@@ -567,6 +606,11 @@ func NewAPIGatewayController(fsm *fsm.FSM, publisher state.EventPublisher, updat
 			Topic:   state.EventTopicInlineCertificate,
 			Subject: stream.SubjectWildcard,
 		},
+	).Subscribe(
+		&stream.SubscribeRequest{
+			Topic:   state.EventTopicACMECertificate,
+			Subject: stream.SubjectWildcard,
+		},
 	).Subscribe(
 		&stream.SubscribeRequest{
 			Topic:   state.EventTopicJWTProvider,
@@ -693,7 +737,14 @@ func (g *gatewayMeta) updateRouteBinding(route structs.BoundRoute) (bool, []stru
 			}
 
 			isValidJWT := true
-			if httpRoute, ok := route.(*structs.HTTPRouteConfigEntry); ok {
+			var httpRoute *structs.HTTPRouteConfigEntry
+			switch route := route.(type) {
+			case *structs.HTTPRouteConfigEntry:
+				httpRoute = route
+			case *structs.GRPCRouteConfigEntry:
+				httpRoute = route.ToHTTPRoute()
+			}
+			if httpRoute != nil {
 				var jwtErrors map[structs.ResourceReference]error
 				isValidJWT, jwtErrors = g.validateJWTForRoute(httpRoute)
 				for ref, err := range jwtErrors {
@@ -764,7 +815,9 @@ func (g *gatewayMeta) bindRoute(listener *structs.APIGatewayListener, bound *str
 		return false, fmt.Errorf("failed to bind route to gateway %s: route has not been accepted", g.Gateway.Name)
 	}
 
-	if route, ok := route.(*structs.HTTPRouteConfigEntry); ok {
+	if route, ok := route.(interface {
+		FilteredHostnames(listenerHostname string) []string
+	}); ok {
 		// check our hostnames
 		hostnames := route.FilteredHostnames(listener.GetHostname())
 		if len(hostnames) == 0 {
@@ -1168,26 +1221,18 @@ func requestToResourceRef(req controller.Request) structs.ResourceReference {
 	return ref
 }
 
-// retrieveAllRoutesFromStore retrieves all HTTP and TCP routes from the given store
+// retrieveAllRoutesFromStore retrieves all HTTP, TCP, gRPC and TLS routes from the given store
 func retrieveAllRoutesFromStore(store *state.Store) ([]structs.BoundRoute, error) {
-	_, httpRoutes, err := store.ConfigEntriesByKind(nil, structs.HTTPRoute, wildcardMeta())
-	if err != nil {
-		return nil, err
-	}
-
-	_, tcpRoutes, err := store.ConfigEntriesByKind(nil, structs.TCPRoute, wildcardMeta())
-	if err != nil {
-		return nil, err
-	}
-
-	routes := make([]structs.BoundRoute, 0, len(tcpRoutes)+len(httpRoutes))
-
-	for _, route := range httpRoutes {
-		routes = append(routes, route.(*structs.HTTPRouteConfigEntry))
-	}
+	var routes []structs.BoundRoute
+	for _, kind := range []string{structs.HTTPRoute, structs.TCPRoute, structs.GRPCRoute, structs.TLSRoute} {
+		_, entries, err := store.ConfigEntriesByKind(nil, kind, wildcardMeta())
+		if err != nil {
+			return nil, err
+		}
 
-	for _, route := range tcpRoutes {
-		routes = append(routes, route.(*structs.TCPRouteConfigEntry))
+		for _, entry := range entries {
+			routes = append(routes, entry.(structs.BoundRoute))
+		}
 	}
 
 	return routes, nil
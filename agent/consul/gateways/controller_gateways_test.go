@@ -95,6 +95,128 @@ func TestBoundAPIGatewayBindRoute(t *testing.T) {
 			},
 			expectedDidBind: true,
 		},
+		"Bind TLS Route to Gateway": {
+			gateway: gatewayMeta{
+				BoundGateway: &structs.BoundAPIGatewayConfigEntry{
+					Kind: structs.BoundAPIGateway,
+					Name: "Gateway",
+					Listeners: []structs.BoundAPIGatewayListener{
+						{
+							Name:   "Listener",
+							Routes: []structs.ResourceReference{},
+						},
+					},
+				},
+				Gateway: &structs.APIGatewayConfigEntry{
+					Kind: structs.APIGateway,
+					Name: "Gateway",
+					Listeners: []structs.APIGatewayListener{
+						{
+							Name:     "Listener",
+							Protocol: structs.ListenerProtocolTLS,
+							Hostname: "*.example.com",
+						},
+					},
+					Status: structs.Status{
+						Conditions: []structs.Condition{
+							gatewayAccepted(),
+						},
+					},
+				},
+			},
+			route: &structs.TLSRouteConfigEntry{
+				Kind: structs.TLSRoute,
+				Name: "Route",
+				Parents: []structs.ResourceReference{
+					{
+						Kind:        structs.APIGateway,
+						Name:        "Gateway",
+						SectionName: "Listener",
+					},
+				},
+				Hostnames: []string{"db.example.com"},
+				Status: structs.Status{
+					Conditions: []structs.Condition{
+						routeAccepted(),
+					},
+				},
+			},
+			expectedBoundGateway: structs.BoundAPIGatewayConfigEntry{
+				Kind: structs.BoundAPIGateway,
+				Name: "Gateway",
+				Listeners: []structs.BoundAPIGatewayListener{
+					{
+						Name: "Listener",
+						Routes: []structs.ResourceReference{
+							{
+								Kind: structs.TLSRoute,
+								Name: "Route",
+							},
+						},
+					},
+				},
+			},
+			expectedDidBind: true,
+		},
+		"GRPC Route cannot bind to Gateway because it has no matching hostnames": {
+			gateway: gatewayMeta{
+				BoundGateway: &structs.BoundAPIGatewayConfigEntry{
+					Kind: structs.BoundAPIGateway,
+					Name: "Gateway",
+					Listeners: []structs.BoundAPIGatewayListener{
+						{
+							Name:   "Listener",
+							Routes: []structs.ResourceReference{},
+						},
+					},
+				},
+				Gateway: &structs.APIGatewayConfigEntry{
+					Kind: structs.APIGateway,
+					Name: "Gateway",
+					Listeners: []structs.APIGatewayListener{
+						{
+							Name:     "Listener",
+							Protocol: structs.ListenerProtocolHTTP,
+							Hostname: "api.example.com",
+						},
+					},
+					Status: structs.Status{
+						Conditions: []structs.Condition{
+							gatewayAccepted(),
+						},
+					},
+				},
+			},
+			route: &structs.GRPCRouteConfigEntry{
+				Kind: structs.GRPCRoute,
+				Name: "Route",
+				Parents: []structs.ResourceReference{
+					{
+						Kind:        structs.APIGateway,
+						Name:        "Gateway",
+						SectionName: "Listener",
+					},
+				},
+				Hostnames: []string{"grpc.example.com"},
+				Status: structs.Status{
+					Conditions: []structs.Condition{
+						routeAccepted(),
+					},
+				},
+			},
+			expectedBoundGateway: structs.BoundAPIGatewayConfigEntry{
+				Kind: structs.BoundAPIGateway,
+				Name: "Gateway",
+				Listeners: []structs.BoundAPIGatewayListener{
+					{
+						Name:   "Listener",
+						Routes: []structs.ResourceReference{},
+					},
+				},
+			},
+			expectedDidBind: false,
+			expectedErr:     fmt.Errorf("failed to bind route to gateway Gateway: listener Listener is does not have any hostnames that match the route"),
+		},
 		"Bind TCP Route with wildcard section name to all listeners on Gateway": {
 			gateway: gatewayMeta{
 				BoundGateway: &structs.BoundAPIGatewayConfigEntry{
@@ -37,7 +37,7 @@ func (h *Health) ChecksInState(args *structs.ChecksInStateRequest,
 		return err
 	}
 
-	_, err = h.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, nil)
+	_, err = h.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, nil)
 	if err != nil {
 		return err
 	}
@@ -71,7 +71,7 @@ func (h *Health) ChecksInState(args *structs.ChecksInStateRequest,
 			// that results they don't have access to have been removed.  If they were
 			// also allowed to run the bexpr filter on the data, they could potentially
 			// infer the specific attributes of data they don't have access to.
-			if err := h.srv.filterACL(args.Token, reply); err != nil {
+			if err := h.srv.filterACL(args, reply); err != nil {
 				return err
 			}
 
@@ -97,7 +97,7 @@ func (h *Health) NodeChecks(args *structs.NodeSpecificRequest,
 		return err
 	}
 
-	_, err = h.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, nil)
+	_, err = h.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, nil)
 	if err != nil {
 		return err
 	}
@@ -124,7 +124,7 @@ func (h *Health) NodeChecks(args *structs.NodeSpecificRequest,
 			// that results they don't have access to have been removed.  If they were
 			// also allowed to run the bexpr filter on the data, they could potentially
 			// infer the specific attributes of data they don't have access to.
-			if err := h.srv.filterACL(args.Token, reply); err != nil {
+			if err := h.srv.filterACL(args, reply); err != nil {
 				return err
 			}
 
@@ -157,7 +157,7 @@ func (h *Health) ServiceChecks(args *structs.ServiceSpecificRequest,
 		return err
 	}
 
-	_, err = h.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, nil)
+	_, err = h.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, nil)
 	if err != nil {
 		return err
 	}
@@ -192,7 +192,7 @@ func (h *Health) ServiceChecks(args *structs.ServiceSpecificRequest,
 			// Note: we filter the results with ACLs *after* applying the user-supplied
 			// bexpr filter, to ensure QueryMeta.ResultsFilteredByACLs does not include
 			// results that would be filtered out even if the user did have permission.
-			if err := h.srv.filterACL(args.Token, reply); err != nil {
+			if err := h.srv.filterACL(args, reply); err != nil {
 				return err
 			}
 
@@ -227,7 +227,7 @@ func (h *Health) ServiceNodes(args *structs.ServiceSpecificRequest, reply *struc
 	authzContext := acl.AuthorizerContext{
 		Peer: args.PeerName,
 	}
-	authz, err := h.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext)
+	authz, err := h.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, &authzContext)
 	if err != nil {
 		return err
 	}
@@ -321,7 +321,7 @@ func (h *Health) ServiceNodes(args *structs.ServiceSpecificRequest, reply *struc
 				// that results they don't have access to have been removed.  If they were
 				// also allowed to run the bexpr filter on the data, they could potentially
 				// infer the specific attributes of data they don't have access to.
-				if err := h.srv.filterACL(arg.Token, &thisReply); err != nil {
+				if err := h.srv.filterACL(arg, &thisReply); err != nil {
 					return err
 				}
 
@@ -109,7 +109,7 @@ func (s *Intention) Apply(args *structs.IntentionRequest, reply *string) error {
 
 	// Get the ACL token for the request for the checks below.
 	var entMeta acl.EnterpriseMeta
-	authz, err := s.srv.ACLResolver.ResolveTokenAndDefaultMeta(args.Token, &entMeta, nil)
+	authz, err := s.srv.ResolveRequestTokenAndDefaultMeta(args, &entMeta, nil)
 	if err != nil {
 		return err
 	}
@@ -432,7 +432,7 @@ func (s *Intention) Get(args *structs.IntentionQueryRequest, reply *structs.Inde
 
 	// Get the ACL token for the request for the checks below.
 	var entMeta acl.EnterpriseMeta
-	authz, err := s.srv.ResolveTokenAndDefaultMeta(args.Token, &entMeta, nil)
+	authz, err := s.srv.ResolveRequestTokenAndDefaultMeta(args, &entMeta, nil)
 	if err != nil {
 		return err
 	}
@@ -514,7 +514,7 @@ func (s *Intention) List(args *structs.IntentionListRequest, reply *structs.Inde
 	}
 
 	var authzContext acl.AuthorizerContext
-	if _, err := s.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext); err != nil {
+	if _, err := s.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, &authzContext); err != nil {
 		return err
 	}
 
@@ -559,7 +559,7 @@ func (s *Intention) List(args *structs.IntentionListRequest, reply *structs.Inde
 			// that results they don't have access to have been removed.  If they were
 			// also allowed to run the bexpr filter on the data, they could potentially
 			// infer the specific attributes of data they don't have access to.
-			if err := s.srv.filterACL(args.Token, reply); err != nil {
+			if err := s.srv.filterACL(args, reply); err != nil {
 				return err
 			}
 
@@ -588,7 +588,7 @@ func (s *Intention) Match(args *structs.IntentionQueryRequest, reply *structs.In
 
 	// Get the ACL token for the request for the checks below.
 	var entMeta acl.EnterpriseMeta
-	authz, err := s.srv.ResolveTokenAndDefaultMeta(args.Token, &entMeta, nil)
+	authz, err := s.srv.ResolveRequestTokenAndDefaultMeta(args, &entMeta, nil)
 	if err != nil {
 		return err
 	}
@@ -710,7 +710,7 @@ func (s *Intention) Check(args *structs.IntentionQueryRequest, reply *structs.In
 
 	// Get the ACL token for the request for the checks below.
 	var entMeta acl.EnterpriseMeta
-	authz, err := s.srv.ResolveTokenAndDefaultMeta(args.Token, &entMeta, nil)
+	authz, err := s.srv.ResolveRequestTokenAndDefaultMeta(args, &entMeta, nil)
 	if err != nil {
 		return err
 	}
@@ -38,7 +38,7 @@ func (m *Internal) NodeInfo(args *structs.NodeSpecificRequest,
 		return err
 	}
 
-	_, err := m.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, nil)
+	_, err := m.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, nil)
 	if err != nil {
 		return err
 	}
@@ -53,7 +53,7 @@ func (m *Internal) NodeInfo(args *structs.NodeSpecificRequest,
 			}
 
 			reply.Index, reply.Dump = index, dump
-			return m.srv.filterACL(args.Token, reply)
+			return m.srv.filterACL(args, reply)
 		})
 }
 
@@ -64,7 +64,7 @@ func (m *Internal) NodeDump(args *structs.DCSpecificRequest,
 		return err
 	}
 
-	_, err := m.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, nil)
+	_, err := m.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, nil)
 	if err != nil {
 		return err
 	}
@@ -128,7 +128,7 @@ func (m *Internal) NodeDump(args *structs.DCSpecificRequest,
 			// that results they don't have access to have been removed.  If they were
 			// also allowed to run the bexpr filter on the data, they could potentially
 			// infer the specific attributes of data they don't have access to.
-			if err := m.srv.filterACL(args.Token, reply); err != nil {
+			if err := m.srv.filterACL(args, reply); err != nil {
 				return err
 			}
 
@@ -153,7 +153,7 @@ func (m *Internal) ServiceDump(args *structs.ServiceDumpRequest, reply *structs.
 		return err
 	}
 
-	_, err := m.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, nil)
+	_, err := m.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, nil)
 	if err != nil {
 		return err
 	}
@@ -253,7 +253,7 @@ func (m *Internal) ServiceDump(args *structs.ServiceDumpRequest, reply *structs.
 			// that results they don't have access to have been removed.  If they were
 			// also allowed to run the bexpr filter on the data, they could potentially
 			// infer the specific attributes of data they don't have access to.
-			if err := m.srv.filterACL(args.Token, reply); err != nil {
+			if err := m.srv.filterACL(args, reply); err != nil {
 				return err
 			}
 
@@ -280,7 +280,7 @@ func (m *Internal) CatalogOverview(args *structs.DCSpecificRequest, reply *struc
 		return err
 	}
 
-	authz, err := m.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, nil)
+	authz, err := m.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, nil)
 	if err != nil {
 		return err
 	}
@@ -306,7 +306,7 @@ func (m *Internal) ServiceTopology(args *structs.ServiceSpecificRequest, reply *
 	}
 
 	var authzContext acl.AuthorizerContext
-	authz, err := m.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext)
+	authz, err := m.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, &authzContext)
 	if err != nil {
 		return err
 	}
@@ -331,7 +331,7 @@ func (m *Internal) ServiceTopology(args *structs.ServiceSpecificRequest, reply *
 			reply.Index = index
 			reply.ServiceTopology = topology
 
-			if err := m.srv.filterACL(args.Token, reply); err != nil {
+			if err := m.srv.filterACL(args, reply); err != nil {
 				return err
 			}
 			return nil
@@ -374,7 +374,7 @@ func (m *Internal) IntentionUpstreamsDestination(args *structs.ServiceSpecificRe
 
 func (m *Internal) internalUpstreams(args *structs.ServiceSpecificRequest, reply *structs.IndexedServiceList, intentionTarget structs.IntentionTargetType) error {
 
-	authz, err := m.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, nil)
+	authz, err := m.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, nil)
 	if err != nil {
 		return err
 	}
@@ -433,7 +433,7 @@ func (m *Internal) GatewayServiceDump(args *structs.ServiceSpecificRequest, repl
 	}
 
 	var authzContext acl.AuthorizerContext
-	authz, err := m.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext)
+	authz, err := m.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, &authzContext)
 	if err != nil {
 		return err
 	}
@@ -490,7 +490,7 @@ func (m *Internal) GatewayServiceDump(args *structs.ServiceSpecificRequest, repl
 			}
 			reply.Index, reply.Dump = maxIdx, result
 
-			if err := m.srv.filterACL(args.Token, reply); err != nil {
+			if err := m.srv.filterACL(args, reply); err != nil {
 				return err
 			}
 			return nil
@@ -511,7 +511,7 @@ func (m *Internal) ServiceGateways(args *structs.ServiceSpecificRequest, reply *
 	}
 
 	var authzContext acl.AuthorizerContext
-	authz, err := m.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext)
+	authz, err := m.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, &authzContext)
 	if err != nil {
 		return err
 	}
@@ -540,7 +540,7 @@ func (m *Internal) ServiceGateways(args *structs.ServiceSpecificRequest, reply *
 
 			reply.Index, reply.Nodes = maxIdx, gateways
 
-			if err := m.srv.filterACL(args.Token, reply); err != nil {
+			if err := m.srv.filterACL(args, reply); err != nil {
 				return err
 			}
 			return nil
@@ -564,7 +564,7 @@ func (m *Internal) GatewayIntentions(args *structs.IntentionQueryRequest, reply
 	var entMeta acl.EnterpriseMeta
 	var authzContext acl.AuthorizerContext
 
-	authz, err := m.srv.ResolveTokenAndDefaultMeta(args.Token, &entMeta, &authzContext)
+	authz, err := m.srv.ResolveRequestTokenAndDefaultMeta(args, &entMeta, &authzContext)
 	if err != nil {
 		return err
 	}
@@ -626,7 +626,7 @@ func (m *Internal) GatewayIntentions(args *structs.IntentionQueryRequest, reply
 				reply.Intentions = make(structs.Intentions, 0)
 			}
 
-			if err := m.srv.filterACL(args.Token, reply); err != nil {
+			if err := m.srv.filterACL(args, reply); err != nil {
 				return err
 			}
 			return nil
@@ -642,7 +642,7 @@ func (m *Internal) ExportedPeeredServices(args *structs.DCSpecificRequest, reply
 		return err
 	}
 
-	authz, err := m.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, nil)
+	authz, err := m.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, nil)
 	if err != nil {
 		return err
 	}
@@ -672,7 +672,7 @@ func (m *Internal) ExportedServicesForPeer(args *structs.ServiceDumpRequest, rep
 	}
 
 	var authzCtx acl.AuthorizerContext
-	authz, err := m.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzCtx)
+	authz, err := m.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, &authzCtx)
 	if err != nil {
 		return err
 	}
@@ -730,7 +730,7 @@ func (m *Internal) PeeredUpstreams(args *structs.PartitionSpecificRequest, reply
 	}
 
 	var authzCtx acl.AuthorizerContext
-	authz, err := m.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzCtx)
+	authz, err := m.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, &authzCtx)
 	if err != nil {
 		return err
 	}
@@ -769,7 +769,7 @@ func (m *Internal) AssignManualServiceVIPs(args *structs.AssignServiceManualVIPs
 	}
 
 	var authzCtx acl.AuthorizerContext
-	authz, err := m.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzCtx)
+	authz, err := m.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, &authzCtx)
 	if err != nil {
 		return err
 	}
@@ -841,7 +841,7 @@ func (m *Internal) EventFire(args *structs.EventFireRequest,
 	}
 
 	// Check ACLs
-	authz, err := m.srv.ResolveTokenAndDefaultMeta(args.Token, nil, nil)
+	authz, err := m.srv.ResolveRequestTokenAndDefaultMeta(args, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -873,7 +873,7 @@ func (m *Internal) KeyringOperation(
 	}
 
 	// Check ACLs
-	authz, err := m.srv.ACLResolver.ResolveToken(args.Token)
+	authz, err := m.srv.ResolveRequestToken(args)
 	if err != nil {
 		return err
 	}
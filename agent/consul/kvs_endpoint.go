@@ -78,6 +78,10 @@ func kvsPreApply(logger hclog.Logger, srv *Server, authz resolver.Result, op api
 		return false, fmt.Errorf("unknown KV operation: %s", op)
 	}
 
+	if err := kvsCheckPeeredKeys(srv, op, dirEnt); err != nil {
+		return false, err
+	}
+
 	// If this is a lock, we must check for a lock-delay. Since lock-delay
 	// is based on wall-time, each peer would expire the lock-delay at a slightly
 	// different time. This means the enforcement of lock-delay cannot be done
@@ -99,6 +103,41 @@ func kvsPreApply(logger hclog.Logger, srv *Server, authz resolver.Result, op api
 	return true, nil
 }
 
+// kvsCheckPeeredKeys rejects writes to keys that were imported from cluster
+// peers. These are only managed by peering replication.
+func kvsCheckPeeredKeys(srv *Server, op api.KVOp, dirEnt *structs.DirEntry) error {
+	errReadOnly := fmt.Errorf("keys under %q are imported from cluster peers and are read-only", structs.PeeredKVPrefix)
+
+	// enumcover:api.KVOp
+	switch op {
+	case api.KVDeleteTree:
+		if strings.HasPrefix(dirEnt.Key, structs.PeeredKVPrefix) {
+			return errReadOnly
+		}
+		if !strings.HasPrefix(structs.PeeredKVPrefix, dirEnt.Key) {
+			return nil
+		}
+		// The tree being deleted covers imported keys, which is only
+		// allowed if there are none.
+		_, entries, err := srv.fsm.State().KVSList(nil, structs.PeeredKVPrefix, &dirEnt.EnterpriseMeta)
+		if err != nil {
+			return err
+		}
+		if len(entries) > 0 {
+			return errReadOnly
+		}
+
+	case api.KVSet, api.KVCAS, api.KVDelete, api.KVDeleteCAS, api.KVLock, api.KVUnlock:
+		if strings.HasPrefix(dirEnt.Key, structs.PeeredKVPrefix) {
+			return errReadOnly
+		}
+
+	case api.KVGet, api.KVGetTree, api.KVGetOrEmpty, api.KVCheckSession, api.KVCheckIndex, api.KVCheckNotExists:
+		// Reads and checks are allowed.
+	}
+	return nil
+}
+
 // Apply is used to apply a KVS update request to the data store.
 func (k *KVS) Apply(args *structs.KVSRequest, reply *bool) error {
 	if done, err := k.srv.ForwardRPC("KVS.Apply", args, reply); done {
@@ -107,7 +146,7 @@ func (k *KVS) Apply(args *structs.KVSRequest, reply *bool) error {
 	defer metrics.MeasureSince([]string{"kvs", "apply"}, time.Now())
 
 	// Perform the pre-apply checks.
-	authz, err := k.srv.ResolveTokenAndDefaultMeta(args.Token, &args.DirEnt.EnterpriseMeta, nil)
+	authz, err := k.srv.ResolveRequestTokenAndDefaultMeta(args, &args.DirEnt.EnterpriseMeta, nil)
 	if err != nil {
 		return err
 	}
@@ -145,7 +184,7 @@ func (k *KVS) Get(args *structs.KeyRequest, reply *structs.IndexedDirEntries) er
 	}
 
 	var authzContext acl.AuthorizerContext
-	authz, err := k.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext)
+	authz, err := k.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, &authzContext)
 	if err != nil {
 		return err
 	}
@@ -185,7 +224,7 @@ func (k *KVS) List(args *structs.KeyRequest, reply *structs.IndexedDirEntries) e
 	}
 
 	var authzContext acl.AuthorizerContext
-	authz, err := k.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext)
+	authz, err := k.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, &authzContext)
 	if err != nil {
 		return err
 	}
@@ -240,7 +279,7 @@ func (k *KVS) ListKeys(args *structs.KeyListRequest, reply *structs.IndexedKeyLi
 	}
 
 	var authzContext acl.AuthorizerContext
-	authz, err := k.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext)
+	authz, err := k.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, &authzContext)
 	if err != nil {
 		return err
 	}
@@ -4,6 +4,8 @@
 package consul
 
 import (
+	"context"
+	"net"
 	"os"
 	"testing"
 	"time"
@@ -132,6 +134,127 @@ func TestKVS_Apply_ACLDeny(t *testing.T) {
 	}
 }
 
+func TestKVS_Apply_ACLSourceCIDRs(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	_, s1 := testServerWithConfig(t, func(c *Config) {
+		c.PrimaryDatacenter = "dc1"
+		c.ACLsEnabled = true
+		c.ACLInitialManagementToken = "root"
+		c.ACLResolverSettings.ACLDefaultPolicy = "deny"
+	})
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	testrpc.WaitForTestAgent(t, s1.RPC, "dc1", testrpc.WithToken("root"))
+
+	loopback := createTokenWithPolicyNameFull(t, codec, "loopback", `
+	key_prefix "app/" {
+	  policy = "write"
+	  condition {
+	    source_cidrs = ["127.0.0.0/8"]
+	  }
+	}`, "root").SecretID
+	private := createTokenWithPolicyNameFull(t, codec, "private", `
+	key_prefix "app/" {
+	  policy = "write"
+	  condition {
+	    source_cidrs = ["10.0.0.0/8"]
+	  }
+	}`, "root").SecretID
+
+	apply := func(token string, call func(args *structs.KVSRequest, reply *bool) error) error {
+		args := structs.KVSRequest{
+			Datacenter: "dc1",
+			Op:         api.KVSet,
+			DirEnt: structs.DirEntry{
+				Key:   "app/foo",
+				Value: []byte("test"),
+			},
+			WriteRequest: structs.WriteRequest{Token: token},
+		}
+		var reply bool
+		return call(&args, &reply)
+	}
+
+	t.Run("network", func(t *testing.T) {
+		call := func(args *structs.KVSRequest, reply *bool) error {
+			return msgpackrpc.CallWithCodec(codec, "KVS.Apply", args, reply)
+		}
+		require.NoError(t, apply(loopback, call))
+		err := apply(private, call)
+		require.True(t, acl.IsErrPermissionDenied(err), "err: %v", err)
+	})
+
+	t.Run("in-memory", func(t *testing.T) {
+		ctx := ContextWithRemoteAddr(context.Background(), &net.TCPAddr{IP: net.ParseIP("10.1.2.3"), Port: 1234})
+		call := func(args *structs.KVSRequest, reply *bool) error {
+			// The source claimed by the client is never trusted.
+			args.SourceIP = "127.0.0.1"
+			return s1.RPC(ctx, "KVS.Apply", args, reply)
+		}
+		require.NoError(t, apply(private, call))
+		err := apply(loopback, call)
+		require.True(t, acl.IsErrPermissionDenied(err), "err: %v", err)
+
+		// Internal requests have no source address.
+		call = func(args *structs.KVSRequest, reply *bool) error {
+			return s1.RPC(context.Background(), "KVS.Apply", args, reply)
+		}
+		err = apply(private, call)
+		require.True(t, acl.IsErrPermissionDenied(err), "err: %v", err)
+	})
+}
+
+func TestKVS_Apply_PeeredKeysReadOnly(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	_, s1 := testServer(t)
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	testrpc.WaitForTestAgent(t, s1.RPC, "dc1")
+
+	apply := func(op api.KVOp, key string) error {
+		arg := structs.KVSRequest{
+			Datacenter: "dc1",
+			Op:         op,
+			DirEnt: structs.DirEntry{
+				Key:   key,
+				Value: []byte("test"),
+			},
+		}
+		var out bool
+		return msgpackrpc.CallWithCodec(codec, "KVS.Apply", &arg, &out)
+	}
+
+	// Deleting the whole tree is allowed while nothing has been imported.
+	require.NoError(t, apply(api.KVSet, "foo"))
+	require.NoError(t, apply(api.KVDeleteTree, ""))
+
+	// Simulate a key imported from a peer.
+	require.NoError(t, s1.fsm.State().KVSSet(100, &structs.DirEntry{
+		Key:   structs.PeeredKVPrefixForPeer("billing") + "config",
+		Value: []byte("imported"),
+	}))
+
+	for _, op := range []api.KVOp{api.KVSet, api.KVCAS, api.KVDelete, api.KVDeleteCAS, api.KVLock, api.KVUnlock, api.KVDeleteTree} {
+		err := apply(op, structs.PeeredKVPrefixForPeer("billing")+"config")
+		require.ErrorContains(t, err, "are imported from cluster peers and are read-only", "op %s", op)
+	}
+	require.ErrorContains(t, apply(api.KVDeleteTree, ""), "are imported from cluster peers and are read-only")
+
+	// Other keys are unaffected.
+	require.NoError(t, apply(api.KVSet, "foo"))
+	require.NoError(t, apply(api.KVDeleteTree, "foo"))
+}
+
 func TestKVS_Get(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
@@ -57,6 +57,7 @@ func (s *Server) startConnectLeader(ctx context.Context) error {
 	s.leaderRoutineManager.Start(ctx, caSigningMetricRoutineName, signingCAExpiryMonitor(s).Monitor)
 	s.leaderRoutineManager.Start(ctx, virtualIPCheckRoutineName, s.runVirtualIPVersionCheck)
 	s.leaderRoutineManager.Start(ctx, configEntryControllersRoutineName, s.runConfigEntryControllers)
+	s.leaderRoutineManager.Start(ctx, acmeCertificateControllerRoutineName, s.runACMECertificateController)
 
 	return s.startIntentionConfigEntryMigration(ctx)
 }
@@ -70,10 +71,23 @@ func (s *Server) stopConnectLeader() {
 	s.leaderRoutineManager.Stop(caSigningMetricRoutineName)
 	s.leaderRoutineManager.Stop(virtualIPCheckRoutineName)
 	s.leaderRoutineManager.Stop(configEntryControllersRoutineName)
+	s.leaderRoutineManager.Stop(acmeCertificateControllerRoutineName)
 }
 
 func (s *Server) runConfigEntryControllers(ctx context.Context) error {
-	updater := &gateways.Updater{
+	logger := s.logger.Named(logging.APIGatewayController)
+	return gateways.NewAPIGatewayController(s.fsm, s.publisher, s.configEntryControllerUpdater(), logger).Run(ctx)
+}
+
+func (s *Server) runACMECertificateController(ctx context.Context) error {
+	logger := s.logger.Named(logging.ACMECertificateController)
+	return gateways.NewACMECertificateController(s.fsm, s.publisher, s.configEntryControllerUpdater(), logger).Run(ctx)
+}
+
+// configEntryControllerUpdater returns an Updater that persists config entry
+// changes made by controllers through raft.
+func (s *Server) configEntryControllerUpdater() *gateways.Updater {
+	return &gateways.Updater{
 		UpdateWithStatus: func(entry structs.ControlledConfigEntry) error {
 			_, err := s.leaderRaftApply("ConfigEntry.Apply", structs.ConfigEntryRequestType, &structs.ConfigEntryRequest{
 				Op:    structs.ConfigEntryUpsertWithStatusCAS,
@@ -96,8 +110,6 @@ func (s *Server) runConfigEntryControllers(ctx context.Context) error {
 			return err
 		},
 	}
-	logger := s.logger.Named(logging.APIGatewayController)
-	return gateways.NewAPIGatewayController(s.fsm, s.publisher, updater, logger).Run(ctx)
 }
 
 func (s *Server) runCARootPruning(ctx context.Context) error {
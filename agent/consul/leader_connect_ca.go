@@ -4,7 +4,9 @@
 package consul
 
 import (
+	"bytes"
 	"context"
+	"crypto/ed25519"
 	"crypto/x509"
 	"errors"
 	"fmt"
@@ -46,7 +48,8 @@ type caServerDelegate interface {
 
 	State() *state.Store
 	IsLeader() bool
-	ApplyCALeafRequest() (uint64, error)
+	ApplyCALeafRequest(cert *structs.IssuedCert) (uint64, error)
+	DeleteCALeafCerts(serialNumbers []string) error
 
 	forwardDC(method, dc string, args interface{}, reply interface{}) error
 	generateCASignRequest(csr string) *structs.CASignRequest
@@ -99,19 +102,13 @@ func (c *caDelegateWithState) ApplyCARequest(req *structs.CARequest) (interface{
 	return c.Server.raftApplyMsgpack(structs.ConnectCARequestType, req)
 }
 
-func (c *caDelegateWithState) ApplyCALeafRequest() (uint64, error) {
-	// TODO(banks): when we implement IssuedCerts table we can use the insert to
-	// that as the raft index to return in response.
-	//
-	// UPDATE(mkeeler): The original implementation relied on updating the CAConfig
-	// and using its index as the ModifyIndex for certs. This was buggy. The long
-	// term goal is still to insert some metadata into raft about the certificates
-	// and use that raft index for the ModifyIndex. This is a partial step in that
-	// direction except that we only are setting an index and not storing the
-	// metadata.
+// ApplyCALeafRequest records the issued cert in the inventory of leaf certs and
+// returns the raft index of the insert to use as the ModifyIndex of the cert.
+func (c *caDelegateWithState) ApplyCALeafRequest(cert *structs.IssuedCert) (uint64, error) {
 	req := structs.CALeafRequest{
-		Op:         structs.CALeafOpIncrementIndex,
+		Op:         structs.CALeafOpSetCert,
 		Datacenter: c.Server.config.Datacenter,
+		Cert:       cert,
 	}
 	resp, err := c.Server.raftApplyMsgpack(structs.ConnectCALeafRequestType|structs.IgnoreUnknownTypeFlag, &req)
 	if err != nil {
@@ -125,6 +122,18 @@ func (c *caDelegateWithState) ApplyCALeafRequest() (uint64, error) {
 	return modIdx, err
 }
 
+// DeleteCALeafCerts removes the leaf certs with the given serial numbers from
+// the inventory of issued certs.
+func (c *caDelegateWithState) DeleteCALeafCerts(serialNumbers []string) error {
+	req := structs.CALeafRequest{
+		Op:            structs.CALeafOpDeleteCerts,
+		Datacenter:    c.Server.config.Datacenter,
+		SerialNumbers: serialNumbers,
+	}
+	_, err := c.Server.raftApplyMsgpack(structs.ConnectCALeafRequestType|structs.IgnoreUnknownTypeFlag, &req)
+	return err
+}
+
 func (c *caDelegateWithState) generateCASignRequest(csr string) *structs.CASignRequest {
 	return &structs.CASignRequest{
 		Datacenter:   c.Server.config.PrimaryDatacenter,
@@ -338,6 +347,8 @@ func (c *CAManager) Start(ctx context.Context) {
 func (c *CAManager) Stop() {
 	c.leaderRoutineManager.Stop(secondaryCARootWatchRoutineName)
 	c.leaderRoutineManager.Stop(intermediateCertRenewWatchRoutineName)
+	c.leaderRoutineManager.Stop(caRevocationListRoutineName)
+	c.leaderRoutineManager.Stop(caLeafCertInventoryRoutineName)
 	c.leaderRoutineManager.Stop(backgroundCAInitializationRoutineName)
 
 	if provider, _ := c.getCAProvider(); provider != nil {
@@ -358,6 +369,8 @@ func (c *CAManager) startPostInitializeRoutines(ctx context.Context) {
 	}
 
 	c.leaderRoutineManager.Start(ctx, intermediateCertRenewWatchRoutineName, c.runRenewIntermediate)
+	c.leaderRoutineManager.Start(ctx, caRevocationListRoutineName, c.runRevocationLists)
+	c.leaderRoutineManager.Start(ctx, caLeafCertInventoryRoutineName, c.runLeafCertInventory)
 }
 
 func (c *CAManager) backgroundCAInitialization(ctx context.Context) error {
@@ -464,6 +477,8 @@ func (c *CAManager) newProvider(conf *structs.CAConfiguration) (ca.Provider, err
 		return ca.NewVaultProvider(logger), nil
 	case structs.AWSCAProvider:
 		return ca.NewAWSProvider(logger), nil
+	case structs.ExternalSignerCAProvider:
+		return ca.NewExternalSignerProvider(c.delegate, logger), nil
 	default:
 		if c.providerShim != nil {
 			return c.providerShim, nil
@@ -965,12 +980,31 @@ func (c *CAManager) primaryUpdateRootCA(newProvider ca.Provider, args *structs.C
 		// either by swapping the provider type or changing the provider's config
 		// to use a different root certificate.
 
+		// Providers with an offline root key can't be cross-signed by the old
+		// provider without the operator, so the operator supplies the new root
+		// already cross-signed by the current one.
+		var xcCert string
+		if xc, ok := newProvider.(ca.OperatorCrossSigned); ok {
+			xcCert, err = xc.CrossSignedRootCert()
+			if err != nil {
+				return fmt.Errorf("CA provider error: %s", err)
+			}
+			if xcCert != "" {
+				if err := validateCrossSignedRoot(xcCert, root.RootCert, newRoot); err != nil {
+					return fmt.Errorf("invalid cross-signed root cert: %w", err)
+				}
+			}
+		}
+
 		// First up, check that the current provider actually supports
 		// cross-signing.
 		canXSign, err := oldProvider.SupportsCrossSigning()
 		if err != nil {
 			return fmt.Errorf("CA provider error: %s", err)
 		}
+		if xcCert != "" {
+			canXSign = true
+		}
 		if !canXSign && !args.Config.ForceWithoutCrossSigning {
 			return errors.New("The current CA Provider does not support cross-signing. " +
 				"You can try again with ForceWithoutCrossSigningSet but this may cause " +
@@ -983,10 +1017,13 @@ func (c *CAManager) primaryUpdateRootCA(newProvider ca.Provider, args *structs.C
 		// If ForceWithoutCrossSigning wasn't set, attempt to have the old CA generate a
 		// cross-signed intermediate.
 		if canXSign && !args.Config.ForceWithoutCrossSigning {
-			// Have the old provider cross-sign the new root
-			xcCert, err := oldProvider.CrossSignCA(newRoot)
-			if err != nil {
-				return err
+			// Have the old provider cross-sign the new root, unless the operator
+			// already did.
+			if xcCert == "" {
+				xcCert, err = oldProvider.CrossSignCA(newRoot)
+				if err != nil {
+					return err
+				}
 			}
 
 			// Add the cross signed cert to the new CA's intermediates (to be attached
@@ -1038,6 +1075,29 @@ func (c *CAManager) primaryUpdateRootCA(newProvider ca.Provider, args *structs.C
 	return nil
 }
 
+// validateCrossSignedRoot checks that the operator supplied cross-signed cert
+// is the new root signed by the current root, so that leaf certs of the new
+// root are trusted by proxies that only know the current one.
+func validateCrossSignedRoot(xcPEM, currentRootPEM string, newRoot *x509.Certificate) error {
+	xc, err := connect.ParseCert(xcPEM)
+	if err != nil {
+		return err
+	}
+	currentRoot, err := connect.ParseCert(currentRootPEM)
+	if err != nil {
+		return fmt.Errorf("error parsing current root cert: %w", err)
+	}
+
+	if !bytes.Equal(xc.RawSubjectPublicKeyInfo, newRoot.RawSubjectPublicKeyInfo) ||
+		!bytes.Equal(xc.RawSubject, newRoot.RawSubject) {
+		return fmt.Errorf("cert is not for the new root")
+	}
+	if err := xc.CheckSignatureFrom(currentRoot); err != nil {
+		return fmt.Errorf("cert is not signed by the current root: %w", err)
+	}
+	return nil
+}
+
 // primaryRenewIntermediate regenerates the intermediate cert in the primary datacenter.
 // This is only run for CAs that require an intermediary in the primary DC, such as Vault.
 // It should only be called while the state lock is held by setting the state to non-ready.
@@ -1422,8 +1482,9 @@ func (l *connectSignRateLimiter) getCSRRateLimiterWithLimit(limit rate.Limit) *r
 
 // AuthorizeAndSignCertificate signs a leaf certificate for the service or agent
 // identified by the SPIFFE ID in the given CSR's SAN. It performs authorization
-// using the given acl.Authorizer.
-func (c *CAManager) AuthorizeAndSignCertificate(csr *x509.CertificateRequest, authz acl.Authorizer) (*structs.IssuedCert, error) {
+// using the given acl.Authorizer. The node requesting the certificate is
+// recorded in the inventory of issued certificates when it's known.
+func (c *CAManager) AuthorizeAndSignCertificate(csr *x509.CertificateRequest, authz acl.Authorizer, node string) (*structs.IssuedCert, error) {
 	// Note that only one spiffe id is allowed currently. If more than one is desired
 	// in future implmentations, then each ID should have authorization checks.
 	if len(csr.URIs) != 1 {
@@ -1493,10 +1554,16 @@ func (c *CAManager) AuthorizeAndSignCertificate(csr *x509.CertificateRequest, au
 		return nil, connect.InvalidCSRError("SPIFFE ID in CSR must be a service, mesh-gateway, or agent ID")
 	}
 
-	return c.SignCertificate(csr, spiffeID)
+	return c.signCertificate(csr, spiffeID, node)
 }
 
+// SignCertificate signs a leaf certificate for the given SPIFFE ID without
+// authorization checks.
 func (c *CAManager) SignCertificate(csr *x509.CertificateRequest, spiffeID connect.CertURI) (*structs.IssuedCert, error) {
+	return c.signCertificate(csr, spiffeID, "")
+}
+
+func (c *CAManager) signCertificate(csr *x509.CertificateRequest, spiffeID connect.CertURI, node string) (*structs.IssuedCert, error) {
 	provider, caRoot := c.getCAProvider()
 	if provider == nil {
 		return nil, fmt.Errorf("CA is uninitialized and unable to sign certificates yet: provider is nil")
@@ -1606,8 +1673,26 @@ func (c *CAManager) SignCertificate(csr *x509.CertificateRequest, spiffeID conne
 		}
 	}
 
+	if _, ok := csr.PublicKey.(ed25519.PublicKey); ok && !ca.SupportsLeafKeyType(provider, connect.PrivateKeyTypeEd25519) {
+		return nil, connect.InvalidCSRError("the %s CA provider can't sign leaf certificates for Ed25519 keys",
+			providerPrettyName(config.Provider))
+	}
+
+	var leafTTL time.Duration
+	if isService {
+		leafTTL, err = c.serviceLeafCertTTL(serviceID, commonCfg)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// All seems to be in order, actually sign it.
-	pem, err := provider.Sign(csr)
+	var pem string
+	if p, ok := provider.(ca.LeafTTLSigner); ok && leafTTL > 0 {
+		pem, err = p.SignWithTTL(csr, leafTTL)
+	} else {
+		pem, err = provider.Sign(csr)
+	}
 	if err == ca.ErrRateLimited {
 		return nil, ErrRateLimited
 	}
@@ -1620,11 +1705,6 @@ func (c *CAManager) SignCertificate(csr *x509.CertificateRequest, spiffeID conne
 		pem = pem + lib.EnsureTrailingNewline(p)
 	}
 
-	modIdx, err := c.delegate.ApplyCALeafRequest()
-	if err != nil {
-		return nil, err
-	}
-
 	cert, err := connect.ParseCert(pem)
 	if err != nil {
 		return nil, err
@@ -1634,13 +1714,11 @@ func (c *CAManager) SignCertificate(csr *x509.CertificateRequest, spiffeID conne
 	reply := structs.IssuedCert{
 		SerialNumber:   connect.EncodeSerialNumber(cert.SerialNumber),
 		CertPEM:        pem,
+		Node:           node,
+		SigningKeyID:   connect.EncodeSigningKeyID(cert.AuthorityKeyId),
 		ValidAfter:     cert.NotBefore,
 		ValidBefore:    cert.NotAfter,
 		EnterpriseMeta: entMeta,
-		RaftIndex: structs.RaftIndex{
-			ModifyIndex: modIdx,
-			CreateIndex: modIdx,
-		},
 	}
 
 	switch {
@@ -1653,15 +1731,50 @@ func (c *CAManager) SignCertificate(csr *x509.CertificateRequest, spiffeID conne
 	case isAgent:
 		reply.Agent = agentID.Agent
 		reply.AgentURI = cert.URIs[0].String()
+		if reply.Node == "" {
+			reply.Node = agentID.Agent
+		}
 	case isServer:
 		reply.ServerURI = cert.URIs[0].String()
 	default:
 		return nil, errors.New("not possible")
 	}
 
+	modIdx, err := c.delegate.ApplyCALeafRequest(&reply)
+	if err != nil {
+		return nil, err
+	}
+	reply.CreateIndex = modIdx
+	reply.ModifyIndex = modIdx
+
 	return &reply, nil
 }
 
+// serviceLeafCertTTL returns the leaf cert TTL the service overrides in its
+// service-defaults config entry, or zero if it doesn't. The TTL is capped so
+// leaf certs are renewed well before the intermediate that signed them.
+func (c *CAManager) serviceLeafCertTTL(id *connect.SpiffeIDService, commonCfg *structs.CommonCAProviderConfig) (time.Duration, error) {
+	_, entry, err := c.delegate.State().ConfigEntry(nil, structs.ServiceDefaults, id.Service, id.GetEnterpriseMeta())
+	if err != nil {
+		return 0, fmt.Errorf("failed to read service-defaults for %q: %w", id.Service, err)
+	}
+	svcDefaults, ok := entry.(*structs.ServiceConfigEntry)
+	if !ok || svcDefaults.LeafCert == nil {
+		return 0, nil
+	}
+
+	return capServiceLeafCertTTL(svcDefaults.LeafCert.TTL, commonCfg), nil
+}
+
+// capServiceLeafCertTTL caps the leaf cert TTL a service-defaults config entry
+// sets so leaf certs are renewed well before the intermediate that signed them.
+func capServiceLeafCertTTL(ttl time.Duration, commonCfg *structs.CommonCAProviderConfig) time.Duration {
+	if limit := commonCfg.IntermediateCertTTL / 3; limit > 0 && ttl > limit {
+		return limit
+	}
+	return ttl
+}
+
 func (c *CAManager) checkExpired(pem string) error {
 	cert, err := connect.ParseCert(pem)
 	if err != nil {
@@ -1694,6 +1807,8 @@ func providerPrettyName(provider string) string {
 		return "Vault"
 	case "aws-pca":
 		return "Aws-Pca"
+	case "external-signer":
+		return "External-Signer"
 	case "provider-name":
 		return "Provider-Name"
 	default:
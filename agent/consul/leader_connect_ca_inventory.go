@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package consul
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/armon/go-metrics"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+const (
+	// leafCertInventoryInterval is how often expired leaf certs are pruned from
+	// the inventory and the leaf cert metrics are emitted.
+	leafCertInventoryInterval = 5 * time.Minute
+
+	// leafCertPruneBatchSize is the maximum number of expired leaf certs
+	// removed from the inventory in a single raft operation.
+	leafCertPruneBatchSize = 1000
+)
+
+// leafCertExpiryWindows are the durations the number of leaf certs about to
+// expire is reported for, along with the value of the "within" label.
+var leafCertExpiryWindows = []struct {
+	within time.Duration
+	label  string
+}{
+	{within: time.Hour, label: "1h"},
+	{within: 24 * time.Hour, label: "24h"},
+}
+
+// runLeafCertInventory periodically prunes the expired leaf certs from the
+// inventory of issued certs and emits the leaf cert metrics.
+func (c *CAManager) runLeafCertInventory(ctx context.Context) error {
+	ticker := time.NewTicker(leafCertInventoryInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := c.updateLeafCertInventory(); err != nil {
+			c.logger.Error("error updating leaf cert inventory",
+				"routine", caLeafCertInventoryRoutineName,
+				"error", err,
+			)
+		}
+
+		select {
+		case <-ctx.Done():
+			// "Zero-out" the metrics on exit so that when prometheus scrapes
+			// them from a non-leader, it does not get stale values.
+			nan := float32(math.NaN())
+			emitLeafCertMetrics(nan, []float32{nan, nan})
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// updateLeafCertInventory does a single pass of runLeafCertInventory.
+func (c *CAManager) updateLeafCertInventory() error {
+	_, certs, err := c.delegate.State().CALeafCerts(nil)
+	if err != nil {
+		return err
+	}
+
+	now := c.timeNow()
+	var expired []string
+	var unexpired []*structs.IssuedCert
+	for _, cert := range certs {
+		if !now.Before(cert.ValidBefore) {
+			expired = append(expired, cert.SerialNumber)
+			continue
+		}
+		unexpired = append(unexpired, cert)
+	}
+
+	for len(expired) > 0 {
+		batch := expired[:min(len(expired), leafCertPruneBatchSize)]
+		expired = expired[len(batch):]
+		if err := c.delegate.DeleteCALeafCerts(batch); err != nil {
+			return fmt.Errorf("error pruning expired leaf certs: %w", err)
+		}
+	}
+
+	current := currentLeafCerts(unexpired)
+	expiring := make([]float32, len(leafCertExpiryWindows))
+	for _, cert := range current {
+		for i, window := range leafCertExpiryWindows {
+			if cert.ValidBefore.Sub(now) <= window.within {
+				expiring[i]++
+			}
+		}
+	}
+	emitLeafCertMetrics(float32(len(current)), expiring)
+	return nil
+}
+
+// currentLeafCerts returns the newest cert of each SPIFFE ID and node. Older
+// certs have been replaced already so they aren't expected to be renewed.
+func currentLeafCerts(certs []*structs.IssuedCert) []*structs.IssuedCert {
+	newest := make(map[string]*structs.IssuedCert)
+	for _, cert := range certs {
+		key := cert.URI() + "\x00" + cert.Node
+		if prev, ok := newest[key]; !ok || prev.ValidAfter.Before(cert.ValidAfter) {
+			newest[key] = cert
+		}
+	}
+
+	current := make([]*structs.IssuedCert, 0, len(newest))
+	for _, cert := range newest {
+		current = append(current, cert)
+	}
+	return current
+}
+
+// emitLeafCertMetrics sets the leaf cert gauges. expiring holds the value
+// for each of the leafCertExpiryWindows.
+func emitLeafCertMetrics(current float32, expiring []float32) {
+	metrics.SetGauge(metricsKeyMeshLeafCertsCurrent, current)
+	for i, window := range leafCertExpiryWindows {
+		metrics.SetGaugeWithLabels(metricsKeyMeshLeafCertsExpiring, expiring[i],
+			[]metrics.Label{{Name: "within", Value: window.label}})
+	}
+}
@@ -0,0 +1,435 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package consul
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-memdb"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/connect"
+	"github.com/hashicorp/consul/agent/connect/ca"
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+const (
+	// revocationListMinValidity is the minimum time a certificate revocation
+	// list is valid for. Lists are signed for twice the longest leaf cert TTL
+	// so the list of a rotated out signing cert outlives the leaf certs it
+	// issued.
+	revocationListMinValidity = 24 * time.Hour
+
+	// revocationListMaxWait is the maximum time between two checks of the
+	// revocation lists when nothing changes.
+	revocationListMaxWait = time.Hour
+)
+
+// RevokeCertificate records the revocation of the leaf certificates described
+// by args. The revocation list routine then signs a new certificate
+// revocation list with the active leaf signing cert.
+func (c *CAManager) RevokeCertificate(args *structs.CARevokeRequest) (*structs.CARevokedCert, error) {
+	revoked := &structs.CARevokedCert{
+		SpiffeID: args.SpiffeID,
+		Reason:   args.Reason,
+	}
+
+	if args.SerialNumber == "" && args.SpiffeID == "" {
+		return nil, fmt.Errorf("a serial number or SPIFFE ID is required")
+	}
+	if args.SerialNumber != "" {
+		sn, err := connect.ParseSerialNumber(args.SerialNumber)
+		if err != nil {
+			return nil, err
+		}
+		revoked.SerialNumber = connect.EncodeSerialNumber(sn)
+	}
+	if args.SpiffeID != "" {
+		if _, err := connect.ParseCertURIFromString(args.SpiffeID); err != nil {
+			return nil, fmt.Errorf("invalid SPIFFE ID %q: %w", args.SpiffeID, err)
+		}
+	}
+
+	provider, _ := c.getCAProvider()
+	if provider == nil {
+		return nil, fmt.Errorf("internal error: CA provider is nil")
+	}
+	if _, ok := provider.(ca.RevocationListSigner); !ok {
+		return nil, fmt.Errorf("the CA provider does not support revoking certificates")
+	}
+
+	now := c.timeNow()
+	revoked.RevokedAt = now
+	expiresAt, err := c.revokedCertExpiry(revoked, now)
+	if err != nil {
+		return nil, err
+	}
+	revoked.ExpiresAt = expiresAt
+
+	req := &structs.CARequest{
+		Op:           structs.CAOpRevokeCerts,
+		RevokedCerts: []*structs.CARevokedCert{revoked},
+	}
+	if _, err := c.delegate.ApplyCARequest(req); err != nil {
+		return nil, err
+	}
+
+	// Read the revocation back to return its raft indexes.
+	_, all, err := c.delegate.State().CARevokedCerts(nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range all {
+		if r.ID() == revoked.ID() {
+			return r, nil
+		}
+	}
+	return revoked, nil
+}
+
+// revokedCertExpiry returns the time after which no certificate the revocation
+// covers is valid anymore. A revoked serial number expires with its cert in the
+// inventory of issued certs. Every other certificate a revocation covers was
+// issued before now, so it's valid for the longest leaf cert TTL at most.
+func (c *CAManager) revokedCertExpiry(revoked *structs.CARevokedCert, now time.Time) (time.Time, error) {
+	if revoked.SerialNumber != "" {
+		_, issued, err := c.delegate.State().CALeafCerts(nil)
+		if err != nil {
+			return time.Time{}, err
+		}
+		for _, cert := range issued {
+			if cert.SerialNumber == revoked.SerialNumber {
+				return cert.ValidBefore, nil
+			}
+		}
+	}
+
+	ttl, err := c.maxLeafCertTTL()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return now.Add(ttl), nil
+}
+
+// runRevocationLists keeps the certificate revocation lists in the state store
+// up to date. It signs a new list whenever certificates are revoked or the
+// leaf signing cert changes, copies the lists other datacenters publish in
+// their federation states, and prunes the revocations of expired certificates.
+func (c *CAManager) runRevocationLists(ctx context.Context) error {
+	retryLoopBackoff(ctx, func() error {
+		ws := memdb.NewWatchSet()
+		wait, err := c.updateRevocationLists(ws)
+		if err != nil {
+			return err
+		}
+
+		watchCtx, cancel := context.WithTimeout(ctx, wait)
+		defer cancel()
+		ws.WatchCtx(watchCtx)
+		return nil
+	}, func(err error) {
+		c.logger.Error("error updating certificate revocation lists",
+			"routine", caRevocationListRoutineName,
+			"error", err,
+		)
+	})
+	return nil
+}
+
+// updateRevocationLists does a single pass of runRevocationLists. It returns
+// how long to wait for changes to ws before the next pass.
+func (c *CAManager) updateRevocationLists(ws memdb.WatchSet) (time.Duration, error) {
+	state := c.delegate.State()
+	now := c.timeNow()
+	wait := revocationListMaxWait
+
+	revokedIdx, revoked, err := state.CARevokedCerts(ws)
+	if err != nil {
+		return 0, err
+	}
+	var expired, current []*structs.CARevokedCert
+	for _, r := range revoked {
+		if !now.Before(r.ExpiresAt) {
+			expired = append(expired, r)
+			continue
+		}
+		current = append(current, r)
+		wait = min(wait, r.ExpiresAt.Sub(now))
+	}
+	if len(expired) > 0 {
+		req := &structs.CARequest{
+			Op:           structs.CAOpDeleteRevokedCerts,
+			RevokedCerts: expired,
+		}
+		if _, err := c.delegate.ApplyCARequest(req); err != nil {
+			return 0, fmt.Errorf("error pruning expired revoked certificates: %w", err)
+		}
+		// The deletion changes the revoked certificates, so start over.
+		return 0, nil
+	}
+
+	listsIdx, lists, err := state.CARevocationLists(ws)
+	if err != nil {
+		return 0, err
+	}
+	_, fedStates, err := state.FederationStateList(ws)
+	if err != nil {
+		return 0, err
+	}
+	// Watch the roots to re-sign the list as soon as the signing cert changes.
+	if _, _, err := state.CARoots(ws); err != nil {
+		return 0, err
+	}
+
+	dc := c.serverConf.Datacenter
+	var newLists []*structs.CARevocationList
+
+	provider, _ := c.getCAProvider()
+	if signer, ok := provider.(ca.RevocationListSigner); ok {
+		signingCertPEM, err := provider.ActiveLeafSigningCert()
+		if err != nil {
+			return 0, err
+		}
+		signingCert, err := connect.ParseCert(signingCertPEM)
+		if err != nil {
+			return 0, fmt.Errorf("error parsing leaf signing cert: %w", err)
+		}
+		keyID := connect.EncodeSigningKeyID(signingCert.SubjectKeyId)
+
+		var active *structs.CARevocationList
+		for _, l := range lists {
+			if l.Datacenter != dc {
+				continue
+			}
+			if l.SigningKeyID == keyID {
+				active = l
+				continue
+			}
+			// Keep the lists of previous signing certs until they expire so
+			// proxies keep accepting the certificates those issued.
+			if now.Before(l.NextUpdate) {
+				newLists = append(newLists, l)
+				wait = min(wait, l.NextUpdate.Sub(now))
+			}
+		}
+
+		if active == nil || active.RevokedIndex != revokedIdx || !lessThanHalfTimePassed(now, active.ThisUpdate, active.NextUpdate) {
+			leafCertTTL, err := c.maxLeafCertTTL()
+			if err != nil {
+				return 0, err
+			}
+			// The certs an identity revocation covers were all recorded in
+			// the inventory before the revocation, so there is no need to
+			// watch it for changes.
+			_, issued, err := state.CALeafCerts(nil)
+			if err != nil {
+				return 0, err
+			}
+			active, err = c.signRevocationList(signer, keyID, current, issued, revokedIdx, leafCertTTL)
+			if err != nil {
+				return 0, err
+			}
+		}
+		newLists = append(newLists, active)
+		wait = min(wait, active.ThisUpdate.Add(halfTime(active.ThisUpdate, active.NextUpdate)).Sub(now))
+	}
+
+	for _, fedState := range fedStates {
+		if fedState.Datacenter == dc {
+			continue
+		}
+		if len(fedState.RevocationLists) == 0 {
+			// Record that the datacenter doesn't publish a revocation list, so
+			// that none is served while its certificates can't be checked.
+			newLists = append(newLists, &structs.CARevocationList{Datacenter: fedState.Datacenter})
+			continue
+		}
+		newLists = append(newLists, fedState.RevocationLists...)
+	}
+
+	if sameRevocationLists(lists, newLists) {
+		return wait, nil
+	}
+
+	req := &structs.CARequest{
+		Op:              structs.CAOpSetRevocationLists,
+		Index:           listsIdx,
+		RevocationLists: newLists,
+	}
+	resp, err := c.delegate.ApplyCARequest(req)
+	if err != nil {
+		return 0, err
+	}
+	if respOk, ok := resp.(bool); ok && !respOk {
+		return 0, fmt.Errorf("could not atomically update revocation lists")
+	}
+	c.logger.Debug("updated certificate revocation lists", "revoked", len(current))
+	return wait, nil
+}
+
+// signRevocationList signs a certificate revocation list of the given revoked
+// certificates with the active leaf signing cert of the provider. Revocations
+// of an identity are expanded into the serial numbers of the issued certs
+// they cover.
+func (c *CAManager) signRevocationList(
+	signer ca.RevocationListSigner,
+	keyID string,
+	revoked []*structs.CARevokedCert,
+	issued []*structs.IssuedCert,
+	revokedIdx uint64,
+	leafCertTTL time.Duration,
+) (*structs.CARevocationList, error) {
+	now := c.timeNow()
+	// Backdate the list like leaf certs so that proxies with clocks that are
+	// slightly behind accept it right away.
+	thisUpdate := now.Add(-1 * time.Minute)
+	nextUpdate := thisUpdate.Add(max(2*leafCertTTL, revocationListMinValidity))
+
+	template := &x509.RevocationList{
+		Number:     big.NewInt(now.UnixNano()),
+		ThisUpdate: thisUpdate,
+		NextUpdate: nextUpdate,
+	}
+	listed := make(map[string]struct{})
+	add := func(serialNumber string, revokedAt time.Time) error {
+		if _, ok := listed[serialNumber]; ok {
+			return nil
+		}
+		listed[serialNumber] = struct{}{}
+
+		sn, err := connect.ParseSerialNumber(serialNumber)
+		if err != nil {
+			return err
+		}
+		template.RevokedCertificateEntries = append(template.RevokedCertificateEntries, x509.RevocationListEntry{
+			SerialNumber:   sn,
+			RevocationTime: revokedAt,
+		})
+		return nil
+	}
+	for _, r := range revoked {
+		if r.SerialNumber != "" {
+			if err := add(r.SerialNumber, r.RevokedAt); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		for _, cert := range issued {
+			if !r.Matches(cert) {
+				continue
+			}
+			if err := add(cert.SerialNumber, r.RevokedAt); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	pem, err := signer.SignRevocationList(template)
+	if err != nil {
+		return nil, fmt.Errorf("error signing revocation list: %w", err)
+	}
+
+	return &structs.CARevocationList{
+		Datacenter:        c.serverConf.Datacenter,
+		SigningKeyID:      keyID,
+		RevocationListPEM: pem,
+		NumRevoked:        len(template.RevokedCertificateEntries),
+		RevokedIndex:      revokedIdx,
+		ThisUpdate:        thisUpdate,
+		NextUpdate:        nextUpdate,
+	}, nil
+}
+
+// maxLeafCertTTL returns the longest TTL of the leaf certs the CA issues,
+// which is the leaf cert TTL of the current CA configuration or a longer one
+// a service-defaults config entry sets.
+func (c *CAManager) maxLeafCertTTL() (time.Duration, error) {
+	state := c.delegate.State()
+	_, config, err := state.CAConfig(nil)
+	if err != nil {
+		return 0, err
+	}
+	if config == nil {
+		return 0, fmt.Errorf("CA is not initialized")
+	}
+	common, err := config.GetCommonConfig()
+	if err != nil {
+		return 0, err
+	}
+
+	_, entries, err := state.ConfigEntriesByKind(nil, structs.ServiceDefaults, acl.WildcardEnterpriseMeta())
+	if err != nil {
+		return 0, err
+	}
+	ttl := common.LeafCertTTL
+	for _, entry := range entries {
+		svcDefaults, ok := entry.(*structs.ServiceConfigEntry)
+		if !ok || svcDefaults.LeafCert == nil {
+			continue
+		}
+		ttl = max(ttl, capServiceLeafCertTTL(svcDefaults.LeafCert.TTL, common))
+	}
+	return ttl, nil
+}
+
+// sameRevocationLists returns true if both sets of revocation lists contain
+// the same lists, regardless of order.
+func sameRevocationLists(a, b []*structs.CARevocationList) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	byID := make(map[string]*structs.CARevocationList, len(a))
+	for _, l := range a {
+		byID[l.ID()] = l
+	}
+	for _, l := range b {
+		other, ok := byID[l.ID()]
+		if !ok || other.RevocationListPEM != l.RevocationListPEM || other.RevokedIndex != l.RevokedIndex {
+			return false
+		}
+	}
+	return true
+}
+
+// servedRevocationLists returns the revocation lists proxies should enforce.
+// Once Envoy has a revocation list it rejects every certificate whose issuer
+// doesn't have one, so the lists are only served when every datacenter
+// publishes one, there is one for the local active leaf signing cert, and a
+// certificate is actually revoked.
+func servedRevocationLists(dc string, lists []*structs.CARevocationList, activeRoot *structs.CARoot) []*structs.CARevocationList {
+	if activeRoot == nil {
+		return nil
+	}
+
+	var (
+		served        []*structs.CARevocationList
+		activeCovered bool
+		anyRevoked    bool
+	)
+	for _, l := range lists {
+		if l.RevocationListPEM == "" {
+			return nil
+		}
+		if l.Datacenter == dc && l.SigningKeyID == activeRoot.SigningKeyID {
+			activeCovered = true
+		}
+		if l.NumRevoked > 0 {
+			anyRevoked = true
+		}
+		served = append(served, l)
+	}
+	if !activeCovered || !anyRevoked {
+		return nil
+	}
+
+	sort.Slice(served, func(i, j int) bool {
+		return served[i].ID() < served[j].ID()
+	})
+	return served
+}
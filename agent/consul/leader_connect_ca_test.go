@@ -203,10 +203,14 @@ func (m *mockCAServerDelegate) ServersSupportMultiDCConnectCA() error {
 	return nil
 }
 
-func (m *mockCAServerDelegate) ApplyCALeafRequest() (uint64, error) {
+func (m *mockCAServerDelegate) ApplyCALeafRequest(_ *structs.IssuedCert) (uint64, error) {
 	return 3, nil
 }
 
+func (m *mockCAServerDelegate) DeleteCALeafCerts(serialNumbers []string) error {
+	return m.store.CADeleteLeafCerts(3, serialNumbers)
+}
+
 // ApplyCARequest mirrors FSM.applyConnectCAOperation because that functionality
 // is not exported.
 func (m *mockCAServerDelegate) ApplyCARequest(req *structs.CARequest) (interface{}, error) {
@@ -552,6 +556,25 @@ func TestCADelegateWithState_GenerateCASignRequest(t *testing.T) {
 	require.Equal(t, "east", req.RequestDatacenter())
 }
 
+func TestValidateCrossSignedRoot(t *testing.T) {
+	oldRoot := connect.TestCA(t, nil)
+	newRoot := connect.TestCA(t, oldRoot)
+	newRootCert, err := connect.ParseCert(newRoot.RootCert)
+	require.NoError(t, err)
+
+	// TestCA sets SigningCert to the new root cross-signed by the old one.
+	require.NoError(t, validateCrossSignedRoot(newRoot.SigningCert, oldRoot.RootCert, newRootCert))
+
+	otherRoot := connect.TestCA(t, nil)
+	err = validateCrossSignedRoot(newRoot.SigningCert, otherRoot.RootCert, newRootCert)
+	require.ErrorContains(t, err, "cert is not signed by the current root")
+
+	otherCert, err := connect.ParseCert(otherRoot.RootCert)
+	require.NoError(t, err)
+	err = validateCrossSignedRoot(newRoot.SigningCert, oldRoot.RootCert, otherCert)
+	require.ErrorContains(t, err, "cert is not for the new root")
+}
+
 func TestCAManager_Initialize_Logging(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
@@ -1297,6 +1320,65 @@ func TestCAManager_Sign_SpiffeIDServer(t *testing.T) {
 	require.Equal(t, fmt.Sprintf("spiffe://%s/agent/server/dc/dc1", roots.TrustDomain), cert.ServerURI)
 }
 
+func TestCAManager_Sign_ServiceLeafCertOverrides(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	_, s1 := testServerWithConfig(t)
+	testrpc.WaitForTestAgent(t, s1.RPC, "dc1")
+
+	codec := rpcClient(t, s1)
+	roots := structs.IndexedCARoots{}
+
+	retry.Run(t, func(r *retry.R) {
+		err := msgpackrpc.CallWithCodec(codec, "ConnectCA.Roots", &structs.DCSpecificRequest{}, &roots)
+		require.NoError(r, err)
+		require.Len(r, roots.Roots, 1)
+	})
+
+	var applied bool
+	err := msgpackrpc.CallWithCodec(codec, "ConfigEntry.Apply", &structs.ConfigEntryRequest{
+		Datacenter: "dc1",
+		Entry: &structs.ServiceConfigEntry{
+			Kind: structs.ServiceDefaults,
+			Name: "web",
+			LeafCert: &structs.LeafCertConfig{
+				PrivateKeyType: connect.PrivateKeyTypeEd25519,
+				TTL:            2 * time.Hour,
+			},
+		},
+	}, &applied)
+	require.NoError(t, err)
+	require.True(t, applied)
+
+	pk, _, err := connect.GeneratePrivateKeyWithConfig(connect.PrivateKeyTypeEd25519, 0)
+	require.NoError(t, err)
+
+	for _, service := range []string{"web", "api"} {
+		spiffeID := &connect.SpiffeIDService{
+			Host:       roots.TrustDomain,
+			Namespace:  "default",
+			Datacenter: "dc1",
+			Service:    service,
+		}
+		csr, err := connect.CreateCSR(spiffeID, pk, nil, nil)
+		require.NoError(t, err)
+
+		req := structs.CASignRequest{CSR: csr}
+		cert := structs.IssuedCert{}
+		require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.Sign", &req, &cert))
+		verifyLeafCert(t, roots.Roots[0], cert.CertPEM)
+
+		// Only web overrides the TTL, api gets the default of the CA.
+		expectedTTL := 2 * time.Hour
+		if service == "api" {
+			expectedTTL = 72 * time.Hour
+		}
+		require.WithinDuration(t, time.Now().Add(expectedTTL), cert.ValidBefore, 2*time.Minute)
+	}
+}
+
 func TestCAManager_AuthorizeAndSignCertificate(t *testing.T) {
 	conf := DefaultConfig()
 	conf.PrimaryDatacenter = "dc1"
@@ -1422,7 +1504,7 @@ func TestCAManager_AuthorizeAndSignCertificate(t *testing.T) {
 				authz = acl.AllowAll()
 			}
 
-			cert, err := manager.AuthorizeAndSignCertificate(tc.getCSR(), authz)
+			cert, err := manager.AuthorizeAndSignCertificate(tc.getCSR(), authz, "")
 			if tc.expectErr != "" {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), tc.expectErr)
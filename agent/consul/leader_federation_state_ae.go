@@ -165,17 +165,27 @@ func (s *Server) fetchFederationStateAntiEntropyDetails(
 				return err
 			}
 
+			// Fetch the revocation lists signed in this datacenter so the other
+			// datacenters can check the certificates it issues.
+			idx3, lists, err := state.CARevocationLists(ws)
+			if err != nil {
+				return err
+			}
+			var localLists []*structs.CARevocationList
+			for _, l := range lists {
+				if l.Datacenter == s.config.Datacenter {
+					localLists = append(localLists, l)
+				}
+			}
+
 			curr := &structs.FederationState{
-				Datacenter:   s.config.Datacenter,
-				MeshGateways: raw,
+				Datacenter:      s.config.Datacenter,
+				MeshGateways:    raw,
+				RevocationLists: localLists,
 			}
 
 			// Compute the maximum index seen.
-			if idx2 > idx1 {
-				queryMeta.Index = idx2
-			} else {
-				queryMeta.Index = idx1
-			}
+			queryMeta.Index = max(idx1, idx2, idx3)
 
 			prevFedState = prev
 			currFedState = curr
@@ -22,6 +22,8 @@ import (
 var (
 	metricsKeyMeshRootCAExpiry          = []string{"mesh", "active-root-ca", "expiry"}
 	metricsKeyMeshActiveSigningCAExpiry = []string{"mesh", "active-signing-ca", "expiry"}
+	metricsKeyMeshLeafCertsCurrent      = []string{"mesh", "leaf-certs", "current"}
+	metricsKeyMeshLeafCertsExpiring     = []string{"mesh", "leaf-certs", "expiring"}
 )
 
 var LeaderCertExpirationGauges = []prometheus.GaugeDefinition{
@@ -33,6 +35,20 @@ var LeaderCertExpirationGauges = []prometheus.GaugeDefinition{
 		Name: metricsKeyMeshActiveSigningCAExpiry,
 		Help: "Seconds until the service mesh signing certificate expires. Updated every hour",
 	},
+	{
+		Name: metricsKeyMeshLeafCertsCurrent,
+		Help: "Number of service mesh leaf certificates that are the newest of their identity and node. Updated every 5 minutes",
+	},
+	{
+		Name:        metricsKeyMeshLeafCertsExpiring,
+		Help:        "Number of current service mesh leaf certificates that expire within the duration of the within label. Updated every 5 minutes",
+		ConstLabels: []metrics.Label{{Name: "within", Value: "1h"}},
+	},
+	{
+		Name:        metricsKeyMeshLeafCertsExpiring,
+		Help:        "Number of current service mesh leaf certificates that expire within the duration of the within label. Updated every 5 minutes",
+		ConstLabels: []metrics.Label{{Name: "within", Value: "24h"}},
+	},
 }
 
 func rootCAExpiryMonitor(s *Server) CertExpirationMonitor {
@@ -71,11 +71,14 @@ var (
 	maxFastRetryBackoff = 8192 * time.Millisecond
 	// maxRetryBackoffPeering is the maximum number of seconds we'll wait between retries when attempting to re-establish a peering connection.
 	maxRetryBackoffPeering = 64
+	// peeringRTTSyncInterval is how often the leader stores the round trip times measured over peering streams.
+	peeringRTTSyncInterval = 30 * time.Second
 )
 
 func (s *Server) startPeeringStreamSync(ctx context.Context) {
 	s.leaderRoutineManager.Start(ctx, peeringStreamsRoutineName, s.runPeeringSync)
 	s.leaderRoutineManager.Start(ctx, peeringStreamsMetricsRoutineName, s.runPeeringMetrics)
+	s.leaderRoutineManager.Start(ctx, peeringRTTSyncRoutineName, s.runPeeringRTTSync)
 }
 
 func (s *Server) runPeeringMetrics(ctx context.Context) error {
@@ -141,6 +144,75 @@ func (s *Server) emitPeeringMetricsOnce(metricsImpl *metrics.Metrics) error {
 	return nil
 }
 
+// runPeeringRTTSync periodically stores the round trip times measured over the
+// heartbeats of peering streams alongside the peerings, so that prepared
+// queries can fail over to the nearest peers. Both dialing and accepting peers
+// are measured, through mesh gateways when peering through them.
+func (s *Server) runPeeringRTTSync(ctx context.Context) error {
+	ticker := time.NewTicker(peeringRTTSyncInterval)
+	defer ticker.Stop()
+
+	logger := s.loggers.Named(logging.Peering).Named("rtt")
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := s.syncPeeringRTTsOnce(); err != nil {
+				logger.Error("error storing peering round trip times", "error", err)
+			}
+		}
+	}
+}
+
+func (s *Server) syncPeeringRTTsOnce() error {
+	_, peers, err := s.fsm.State().PeeringList(nil, *structs.NodeEnterpriseMetaInPartition(structs.WildcardSpecifier))
+	if err != nil {
+		return err
+	}
+
+	var merr *multierror.Error
+	for _, peer := range peers {
+		if !peer.IsActive() {
+			continue
+		}
+
+		// The round trip time is only known while the stream is connected.
+		var rtt *time.Duration
+		if status, found := s.peerStreamServer.StreamStatus(peer.ID); found && status.Connected {
+			rtt = status.RTT
+		}
+
+		if !peeringRTTChanged(pbpeering.DurationPtrFromProto(peer.RTT), rtt) {
+			continue
+		}
+
+		err := s.peeringBackend.PeeringRTTWrite(&pbpeering.PeeringRTTWriteRequest{
+			ID:  peer.ID,
+			RTT: pbpeering.DurationPtrToProto(rtt),
+		})
+		if err != nil {
+			merr = multierror.Append(merr, fmt.Errorf("failed to store round trip time for peer %q: %w", peer.Name, err))
+		}
+	}
+	return merr.ErrorOrNil()
+}
+
+// peeringRTTChanged reports whether a newly measured round trip time differs
+// enough from the stored one to be worth writing to the state store. Small
+// fluctuations are ignored to avoid a Raft write for every probe.
+func peeringRTTChanged(prev, next *time.Duration) bool {
+	if prev == nil || next == nil {
+		return prev != next
+	}
+	delta := *next - *prev
+	if delta < 0 {
+		delta = -delta
+	}
+	return delta*10 > *prev
+}
+
 func (s *Server) runPeeringSync(ctx context.Context) error {
 	logger := s.logger.Named("peering-syncer")
 	cancelFns := make(map[string]context.CancelFunc)
@@ -162,6 +234,7 @@ func (s *Server) stopPeeringStreamSync() {
 	// will be a no-op when not started
 	s.leaderRoutineManager.Stop(peeringStreamsRoutineName)
 	s.leaderRoutineManager.Stop(peeringStreamsMetricsRoutineName)
+	s.leaderRoutineManager.Stop(peeringRTTSyncRoutineName)
 }
 
 // syncPeeringsAndBlock is a long-running goroutine that is responsible for watching
@@ -344,6 +417,10 @@ func (s *Server) establishStream(ctx context.Context,
 	nextServerAddr := make(chan string)
 	go s.watchAddresses(streamCtx, peer.ID, nextServerAddr)
 
+	// rejectedPendingSecret tracks a pending stream secret that the acceptor refused,
+	// so that the following attempts fall back to the active secret.
+	var rejectedPendingSecret string
+
 	// Establish a stream-specific retry so that retrying stream/conn errors isn't dependent on state store changes.
 	go retryLoopBackoffPeering(streamCtx, logger, func() error {
 		// Try a new address on each iteration by advancing the ring buffer on errors.
@@ -376,16 +453,28 @@ func (s *Server) establishStream(ctx context.Context,
 		defer conn.Close()
 
 		client := pbpeerstream.NewPeerStreamServiceClient(conn)
-		stream, err := client.StreamResources(streamCtx)
+		stream, err := client.StreamResources(peerstream.AdvertiseResourceURLs(streamCtx))
 		if err != nil {
 			return err
 		}
 
+		// The secrets are read on every attempt because the acceptor may have rotated them
+		// since the stream was first established.
+		if latest, err := s.fsm.State().PeeringSecretsRead(nil, peer.ID); err != nil {
+			return fmt.Errorf("failed to read secret for peering: %w", err)
+		} else if latest != nil {
+			secret = latest
+		}
+		streamSecretID := secret.GetStream().GetActiveSecretID()
+		if pending := secret.GetStream().GetPendingSecretID(); pending != "" && pending != rejectedPendingSecret {
+			streamSecretID = pending
+		}
+
 		initialReq := &pbpeerstream.ReplicationMessage{
 			Payload: &pbpeerstream.ReplicationMessage_Open_{
 				Open: &pbpeerstream.ReplicationMessage_Open{
 					PeerID:         peer.PeerID,
-					StreamSecretID: secret.GetStream().GetActiveSecretID(),
+					StreamSecretID: streamSecretID,
 					Remote: &pbpeering.RemoteInfo{
 						Partition:  peer.Partition,
 						Datacenter: s.config.Datacenter,
@@ -398,12 +487,21 @@ func (s *Server) establishStream(ctx context.Context,
 			return fmt.Errorf("failed to send initial stream request: %w", err)
 		}
 
+		// The acceptor advertises the optional resource types it supports in the
+		// response header. Acceptors on older versions don't advertise any.
+		header, err := stream.Header()
+		if err != nil {
+			return fmt.Errorf("failed to read stream header: %w", err)
+		}
+
 		streamReq := peerstream.HandleStreamRequest{
-			LocalID:   peer.ID,
-			RemoteID:  peer.PeerID,
-			PeerName:  peer.Name,
-			Partition: peer.Partition,
-			Stream:    stream,
+			LocalID:               peer.ID,
+			RemoteID:              peer.PeerID,
+			PeerName:              peer.Name,
+			Partition:             peer.Partition,
+			Stream:                stream,
+			RemoteResourceURLs:    peerstream.RemoteResourceURLs(header),
+			RejectedPendingSecret: rejectedPendingSecret,
 		}
 		err = s.peerStreamServer.HandleStream(streamReq)
 		// A nil error indicates that the peering was deleted and the stream needs to be gracefully shutdown.
@@ -413,6 +511,10 @@ func (s *Server) establishStream(ctx context.Context,
 			cancel()
 			logger.Info("closed outbound stream")
 		}
+		if streamSecretID != secret.GetStream().GetActiveSecretID() && isErrCode(err, codes.PermissionDenied) {
+			logger.Warn("peer rejected the pending stream secret; falling back to the active secret")
+			rejectedPendingSecret = streamSecretID
+		}
 		return err
 
 	}, func(err error) {
@@ -550,6 +652,14 @@ func (s *Server) removePeeringAndData(ctx context.Context, logger hclog.Logger,
 		logger.Error("Failed to remove trust bundle for peer", "error", err)
 		return
 	}
+	if err := s.deleteKVsFromPeer(ctx, limiter, entMeta, peer.Name); err != nil {
+		logger.Error("Failed to remove KV entries for peer", "error", err)
+		return
+	}
+	if err := s.deleteConfigEntriesFromPeer(ctx, limiter, entMeta, peer.Name); err != nil {
+		logger.Error("Failed to remove config entries for peer", "error", err)
+		return
+	}
 
 	if err := limiter.Wait(ctx); err != nil {
 		return
@@ -652,6 +762,56 @@ func (s *Server) deleteTrustBundleFromPeer(ctx context.Context, limiter *rate.Li
 	return err
 }
 
+// deleteConfigEntriesFromPeer deletes the config entries imported from a peer, if present.
+func (s *Server) deleteConfigEntriesFromPeer(ctx context.Context, limiter *rate.Limiter, entMeta acl.EnterpriseMeta, peerName string) error {
+	_, entries, err := s.fsm.State().PeeringConfigEntriesRead(nil, state.Query{Value: peerName, EnterpriseMeta: entMeta})
+	if err != nil {
+		return err
+	}
+	if entries == nil {
+		return nil
+	}
+
+	if err := limiter.Wait(ctx); err != nil {
+		return err
+	}
+
+	req := &pbpeering.PeeringConfigEntriesDeleteRequest{
+		Name:      peerName,
+		Partition: entMeta.PartitionOrDefault(),
+	}
+	_, err = s.raftApplyProtobuf(structs.PeeringConfigEntriesDeleteType, req)
+	return err
+}
+
+// deleteKVsFromPeer deletes the KV entries imported from the given peer name.
+func (s *Server) deleteKVsFromPeer(ctx context.Context, limiter *rate.Limiter, entMeta acl.EnterpriseMeta, peerName string) error {
+	// Imported entries are always stored in the default namespace of the partition.
+	kvEntMeta := structs.DefaultEnterpriseMetaInPartition(entMeta.PartitionOrDefault())
+	prefix := structs.PeeredKVPrefixForPeer(peerName)
+	_, entries, err := s.fsm.State().KVSList(nil, prefix, kvEntMeta)
+	if err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+
+	if err := limiter.Wait(ctx); err != nil {
+		return err
+	}
+
+	req := &structs.KVSRequest{
+		Op: api.KVDeleteTree,
+		DirEnt: structs.DirEntry{
+			Key:            prefix,
+			EnterpriseMeta: *kvEntMeta,
+		},
+	}
+	_, err = s.raftApplyMsgpack(structs.KVSRequestType, req)
+	return err
+}
+
 // retryLoopBackoffPeering re-runs loopFn with a backoff on error. errFn is run whenever
 // loopFn returns an error. retryTimeFn is used to calculate the time between retries on error.
 // It is passed the number of errors in a row that loopFn has returned and the latest error
@@ -24,6 +24,7 @@ import (
 	"google.golang.org/grpc/codes"
 	grpcstatus "google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/hashicorp/consul/acl"
@@ -32,7 +33,9 @@ import (
 	"github.com/hashicorp/consul/agent/structs"
 	"github.com/hashicorp/consul/api"
 	"github.com/hashicorp/consul/proto/private/pbcommon"
+	"github.com/hashicorp/consul/proto/private/pbconfigentry"
 	"github.com/hashicorp/consul/proto/private/pbpeering"
+	"github.com/hashicorp/consul/proto/private/pbpeerstream"
 	"github.com/hashicorp/consul/sdk/freeport"
 	"github.com/hashicorp/consul/sdk/testutil"
 	"github.com/hashicorp/consul/sdk/testutil/retry"
@@ -641,7 +644,7 @@ func TestLeader_Peering_DeferredDeletion(t *testing.T) {
 		},
 	}))
 
-	// Insert imported data: nodes, services, checks, trust bundle
+	// Insert imported data: nodes, services, checks, trust bundle, KV entries, config entries
 	lastIdx = insertTestPeeringData(t, s1.fsm.State(), peerName, lastIdx)
 
 	// Mark the peering for deletion to trigger the termination sequence.
@@ -672,6 +675,14 @@ func TestLeader_Peering_DeferredDeletion(t *testing.T) {
 		_, tb, err := s1.fsm.State().PeeringTrustBundleRead(nil, state.Query{Value: peerName})
 		require.NoError(r, err)
 		require.Nil(r, tb)
+
+		_, kvs, err := s1.fsm.State().KVSList(nil, structs.PeeredKVPrefixForPeer(peerName), defaultMeta)
+		require.NoError(r, err)
+		require.Empty(r, kvs)
+
+		_, ces, err := s1.fsm.State().PeeringConfigEntriesRead(nil, state.Query{Value: peerName})
+		require.NoError(r, err)
+		require.Nil(r, ces)
 	})
 
 	// The leader routine should pick up the deletion and finish deleting the peering.
@@ -1029,10 +1040,140 @@ func insertTestPeeringData(t *testing.T, store *state.Store, peer string, lastId
 		},
 	}))
 
+	lastIdx++
+	require.NoError(t, store.KVSSet(lastIdx, &structs.DirEntry{
+		Key:   structs.PeeredKVPrefixForPeer(peer) + "config",
+		Value: []byte("imported"),
+	}))
+
+	lastIdx++
+	require.NoError(t, store.PeeringConfigEntriesWrite(lastIdx, &pbpeering.PeeringConfigEntries{
+		PeerName: peer,
+		Entries: []*pbconfigentry.ConfigEntry{
+			pbconfigentry.ConfigEntryFromStructs(&structs.ServiceConfigEntry{
+				Kind:     structs.ServiceDefaults,
+				Name:     "foo",
+				Protocol: "http",
+			}),
+		},
+	}))
+
 	return lastIdx
 }
 
 // TODO(peering): once we move away from keeping state in stream tracker only on leaders, move this test to consul/server_test maybe
+func TestLeader_Peering_RotateStreamSecret(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	ca := connect.TestCA(t, nil)
+	_, acceptor := testServerWithConfig(t, func(c *Config) {
+		c.NodeName = "acceptor"
+		c.Datacenter = "dc1"
+		c.TLSConfig.Domain = "consul"
+		c.GRPCTLSPort = freeport.GetOne(t)
+		c.CAConfig = &structs.CAConfiguration{
+			ClusterID: connect.TestClusterID,
+			Provider:  structs.ConsulCAProvider,
+			Config: map[string]interface{}{
+				"PrivateKey": ca.SigningKey,
+				"RootCert":   ca.RootCert,
+			},
+		}
+	})
+	testrpc.WaitForLeader(t, acceptor.RPC, "dc1")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	conn, err := grpc.DialContext(ctx, acceptor.config.RPCAddr.String(),
+		grpc.WithContextDialer(newServerDialer(acceptor.config.RPCAddr.String())),
+		//nolint:staticcheck
+		grpc.WithInsecure(),
+		grpc.WithBlock())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	acceptorClient := pbpeering.NewPeeringServiceClient(conn)
+
+	resp, err := acceptorClient.GenerateToken(ctx, &pbpeering.GenerateTokenRequest{
+		PeerName: "my-peer-dialer",
+	})
+	require.NoError(t, err)
+
+	_, dialer := testServerWithConfig(t, func(c *Config) {
+		c.NodeName = "dialer"
+		c.Datacenter = "dc2"
+		c.PrimaryDatacenter = "dc2"
+	})
+	testrpc.WaitForLeader(t, dialer.RPC, "dc2")
+
+	conn, err = grpc.DialContext(ctx, dialer.config.RPCAddr.String(),
+		grpc.WithContextDialer(newServerDialer(dialer.config.RPCAddr.String())),
+		//nolint:staticcheck
+		grpc.WithInsecure(),
+		grpc.WithBlock())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	dialerClient := pbpeering.NewPeeringServiceClient(conn)
+
+	_, err = dialerClient.Establish(ctx, &pbpeering.EstablishRequest{
+		PeerName:     "my-peer-acceptor",
+		PeeringToken: resp.PeeringToken,
+	})
+	require.NoError(t, err)
+
+	p, err := dialerClient.PeeringRead(ctx, &pbpeering.PeeringReadRequest{Name: "my-peer-acceptor"})
+	require.NoError(t, err)
+
+	retry.Run(t, func(r *retry.R) {
+		status, found := acceptor.peerStreamServer.StreamStatus(p.Peering.PeerID)
+		require.True(r, found)
+		require.True(r, status.Connected)
+	})
+
+	// Wait for the stream secret exchanged while establishing to be promoted.
+	var original string
+	retry.Run(t, func(r *retry.R) {
+		secrets, err := acceptor.fsm.State().PeeringSecretsRead(nil, p.Peering.PeerID)
+		require.NoError(r, err)
+		require.Empty(r, secrets.GetStream().GetPendingSecretID())
+		original = secrets.GetStream().GetActiveSecretID()
+		require.NotEmpty(r, original)
+	})
+
+	// Rotation must be initiated by the acceptor.
+	_, err = dialerClient.RotateSecret(ctx, &pbpeering.RotateSecretRequest{PeerName: "my-peer-acceptor"})
+	testutil.RequireErrorContains(t, err, "rotation must be initiated by the cluster that generated the peering token")
+
+	_, err = acceptorClient.RotateSecret(ctx, &pbpeering.RotateSecretRequest{PeerName: "my-peer-dialer"})
+	require.NoError(t, err)
+
+	// The dialer reconnects with the new secret, which leads both sides to retire the original one.
+	retry.Run(t, func(r *retry.R) {
+		acceptorSecrets, err := acceptor.fsm.State().PeeringSecretsRead(nil, p.Peering.PeerID)
+		require.NoError(r, err)
+		require.Empty(r, acceptorSecrets.GetStream().GetPendingSecretID())
+
+		rotated := acceptorSecrets.GetStream().GetActiveSecretID()
+		require.NotEmpty(r, rotated)
+		require.NotEqual(r, original, rotated)
+
+		dialerSecrets, err := dialer.fsm.State().PeeringSecretsRead(nil, p.Peering.ID)
+		require.NoError(r, err)
+		require.Equal(r, rotated, dialerSecrets.GetStream().GetActiveSecretID())
+		require.Empty(r, dialerSecrets.GetStream().GetPendingSecretID())
+	})
+
+	retry.Run(t, func(r *retry.R) {
+		status, found := dialer.peerStreamServer.StreamStatus(p.Peering.ID)
+		require.True(r, found)
+		require.True(r, status.Connected)
+	})
+}
+
 func TestLeader_Peering_ImportedExportedServicesCount(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
@@ -1600,6 +1741,112 @@ func TestLeader_Peering_NoEstablishmentWhenPeeringDisabled(t *testing.T) {
 	}, 7*time.Second, 1*time.Second, "peering should not have been established")
 }
 
+func TestLeader_Peering_RTTSync(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	orig := peeringRTTSyncInterval
+	peeringRTTSyncInterval = 100 * time.Millisecond
+	t.Cleanup(func() {
+		peeringRTTSyncInterval = orig
+	})
+
+	_, s1 := testServerWithConfig(t, func(c *Config) {
+		c.NodeName = "s1.dc1"
+		c.Datacenter = "dc1"
+		c.TLSConfig.Domain = "consul"
+	})
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+
+	var (
+		acceptorID = "cc56f0b8-3885-4e78-8d7b-614a0c45712d"
+		dialerID   = "9e650110-ac74-4c5a-a6a8-9348b2bed4e9"
+	)
+
+	// Simulate a peering that this cluster accepted and one that it dials by
+	// writing them to the state store.
+	require.NoError(t, s1.fsm.State().PeeringWrite(1, &pbpeering.PeeringWriteRequest{
+		Peering: &pbpeering.Peering{
+			ID:    acceptorID,
+			Name:  "my-peer-acceptor",
+			State: pbpeering.PeeringState_ACTIVE,
+		},
+	}))
+	require.NoError(t, s1.fsm.State().PeeringWrite(2, &pbpeering.PeeringWriteRequest{
+		Peering: &pbpeering.Peering{
+			ID:                  dialerID,
+			Name:                "my-peer-dialer",
+			PeerID:              "a7a2ba2e-2a2b-4d4c-8a0c-2ef1b2d1ab2c",
+			PeerServerAddresses: []string{"127.0.0.1:5300"},
+			State:               pbpeering.PeeringState_ACTIVE,
+		},
+	}))
+
+	readRTT := func(r require.TestingT, id string) *durationpb.Duration {
+		_, peering, err := s1.fsm.State().PeeringReadByID(nil, id)
+		require.NoError(r, err)
+		require.NotNil(r, peering)
+		return peering.RTT
+	}
+
+	// Pretend that the acceptor's stream measured a round trip time from an
+	// echoed heartbeat.
+	mst, err := s1.peerStreamServer.Tracker.Connected(acceptorID)
+	require.NoError(t, err)
+	now := time.Now()
+	mst.TrackHeartbeatEcho(&pbpeerstream.ReplicationMessage_Heartbeat{
+		EchoSentAt: timestamppb.New(now.Add(-25 * time.Millisecond)),
+		EchoDelay:  durationpb.New(5 * time.Millisecond),
+	}, now)
+
+	// The dialer's stream is connected to a peer that doesn't echo heartbeats.
+	_, err = s1.peerStreamServer.Tracker.Connected(dialerID)
+	require.NoError(t, err)
+
+	retry.Run(t, func(r *retry.R) {
+		rtt := readRTT(r, acceptorID)
+		require.NotNil(r, rtt)
+		require.Equal(r, 20*time.Millisecond, rtt.AsDuration())
+	})
+	require.Nil(t, readRTT(t, dialerID))
+
+	// Once the stream is disconnected the stored RTT should be cleared.
+	s1.peerStreamServer.Tracker.DisconnectedGracefully(acceptorID)
+
+	retry.Run(t, func(r *retry.R) {
+		require.Nil(r, readRTT(r, acceptorID))
+	})
+}
+
+func TestLeader_Peering_peeringRTTChanged(t *testing.T) {
+	ms := func(n int) *time.Duration {
+		d := time.Duration(n) * time.Millisecond
+		return &d
+	}
+
+	cases := map[string]struct {
+		prev, next *time.Duration
+		expect     bool
+	}{
+		"both unknown":            {prev: nil, next: nil, expect: false},
+		"becomes known":           {prev: nil, next: ms(10), expect: true},
+		"becomes unknown":         {prev: ms(10), next: nil, expect: true},
+		"small increase":          {prev: ms(100), next: ms(105), expect: false},
+		"small decrease":          {prev: ms(100), next: ms(95), expect: false},
+		"significant increase":    {prev: ms(100), next: ms(120), expect: true},
+		"significant decrease":    {prev: ms(100), next: ms(80), expect: true},
+		"unchanged":               {prev: ms(100), next: ms(100), expect: false},
+		"from zero to measurable": {prev: ms(0), next: ms(1), expect: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expect, peeringRTTChanged(tc.prev, tc.next))
+		})
+	}
+}
+
 // Test peeringRetryTimeout when the errors are FailedPrecondition errors because these
 // errors have a different backoff.
 func TestLeader_Peering_peeringRetryTimeout_failedPreconditionErrors(t *testing.T) {
@@ -36,7 +36,7 @@ func (op *Operator) Usage(args *structs.OperatorUsageRequest, reply *structs.Usa
 	}
 
 	var authzContext acl.AuthorizerContext
-	authz, err := op.srv.ResolveTokenAndDefaultMeta(args.Token, structs.DefaultEnterpriseMetaInDefaultPartition(), &authzContext)
+	authz, err := op.srv.ResolveRequestTokenAndDefaultMeta(args, structs.DefaultEnterpriseMetaInDefaultPartition(), &authzContext)
 	if err != nil {
 		return err
 	}
@@ -332,6 +332,16 @@ func (b *PeeringBackend) PeeringTerminateByID(req *pbpeering.PeeringTerminateByI
 	return err
 }
 
+func (b *PeeringBackend) PeeringRTTWrite(req *pbpeering.PeeringRTTWriteRequest) error {
+	_, err := b.srv.raftApplyProtobuf(structs.PeeringRTTWriteType, req)
+	return err
+}
+
+func (b *PeeringBackend) PeeringConfigEntriesWrite(req *pbpeering.PeeringConfigEntriesWriteRequest) error {
+	_, err := b.srv.raftApplyProtobuf(structs.PeeringConfigEntriesWriteType, req)
+	return err
+}
+
 func (b *PeeringBackend) PeeringTrustBundleWrite(req *pbpeering.PeeringTrustBundleWriteRequest) error {
 	_, err := b.srv.raftApplyProtobuf(structs.PeeringTrustBundleWriteType, req)
 	return err
@@ -347,6 +357,17 @@ func (b *PeeringBackend) CatalogDeregister(req *structs.DeregisterRequest) error
 	return err
 }
 
+func (b *PeeringBackend) KVSTxn(ops structs.TxnOps) error {
+	resp, err := b.srv.leaderRaftApply("Txn.Apply", structs.TxnRequestType, &structs.TxnRequest{Ops: ops})
+	if err != nil {
+		return err
+	}
+	if txnResp, ok := resp.(structs.TxnResponse); ok {
+		return txnResp.Error()
+	}
+	return fmt.Errorf("unexpected return type %T", resp)
+}
+
 func (b *PeeringBackend) ResolveTokenAndDefaultMeta(token string, entMeta *acl.EnterpriseMeta, authzCtx *acl.AuthorizerContext) (resolver.Result, error) {
 	return b.srv.ResolveTokenAndDefaultMeta(token, entMeta, authzCtx)
 }
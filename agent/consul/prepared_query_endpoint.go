@@ -5,6 +5,7 @@ package consul
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -81,7 +82,7 @@ func (p *PreparedQuery) Apply(args *structs.PreparedQueryRequest, reply *string)
 	*reply = args.Query.ID
 
 	// Get the ACL token for the request for the checks below.
-	authz, err := p.srv.ResolveToken(args.Token)
+	authz, err := p.srv.ResolveRequestToken(args)
 	if err != nil {
 		return err
 	}
@@ -196,8 +197,8 @@ func parseService(svc *structs.ServiceQuery) error {
 		return fmt.Errorf("Bad NearestN '%d', must be >= 0", svc.Failover.NearestN)
 	}
 
-	if (failover.NearestN != 0 || len(failover.Datacenters) != 0) && len(failover.Targets) != 0 {
-		return fmt.Errorf("Targets cannot be populated with NearestN or Datacenters")
+	if len(failover.Datacenters) != 0 && len(failover.Targets) != 0 {
+		return fmt.Errorf("Targets cannot be populated with Datacenters")
 	}
 
 	// Make sure the metadata filters are valid
@@ -260,11 +261,11 @@ func (p *PreparedQuery) Get(args *structs.PreparedQuerySpecificRequest,
 			reply.Index = index
 			reply.Queries = structs.PreparedQueries{query}
 			if _, ok := query.GetACLPrefix(); !ok {
-				return p.srv.filterACL(args.Token, &reply.Queries[0])
+				return p.srv.filterACL(args, &reply.Queries[0])
 			}
 
 			// Otherwise, attempt to filter it the usual way.
-			if err := p.srv.filterACL(args.Token, reply); err != nil {
+			if err := p.srv.filterACL(args, reply); err != nil {
 				return err
 			}
 
@@ -296,7 +297,7 @@ func (p *PreparedQuery) List(args *structs.DCSpecificRequest, reply *structs.Ind
 			}
 
 			reply.Index, reply.Queries = index, queries
-			return p.srv.filterACL(args.Token, reply)
+			return p.srv.filterACL(args, reply)
 		})
 }
 
@@ -334,7 +335,7 @@ func (p *PreparedQuery) Explain(args *structs.PreparedQueryExecuteRequest,
 	queries := &structs.IndexedPreparedQueries{
 		Queries: structs.PreparedQueries{query},
 	}
-	if err := p.srv.filterACL(args.Token, queries); err != nil {
+	if err := p.srv.filterACL(args, queries); err != nil {
 		return err
 	}
 
@@ -390,11 +391,11 @@ func (p *PreparedQuery) Execute(args *structs.PreparedQueryExecuteRequest,
 
 		// If they supplied a token with the query, use that, otherwise use the
 		// token passed in with the request.
-		token := args.QueryOptions.Token
+		opts := args.QueryOptions
 		if query.Token != "" {
-			token = query.Token
+			opts.Token = query.Token
 		}
-		if err := p.srv.filterACL(token, reply); err != nil {
+		if err := p.srv.filterACL(opts, reply); err != nil {
 			return err
 		}
 
@@ -404,7 +405,7 @@ func (p *PreparedQuery) Execute(args *structs.PreparedQueryExecuteRequest,
 		// though, since this is essentially a misconfiguration.
 
 		// We have to do this ourselves since we are not doing a blocking RPC.
-		p.srv.SetQueryMeta(&reply.QueryMeta, token)
+		p.srv.SetQueryMeta(&reply.QueryMeta, opts.Token)
 
 		// Shuffle the results in case coordinates are not available if they
 		// requested an RTT sort.
@@ -518,16 +519,16 @@ func (p *PreparedQuery) ExecuteRemote(args *structs.PreparedQueryExecuteRemoteRe
 
 	// If they supplied a token with the query, use that, otherwise use the
 	// token passed in with the request.
-	token := args.QueryOptions.Token
+	opts := args.QueryOptions
 	if args.Query.Token != "" {
-		token = args.Query.Token
+		opts.Token = args.Query.Token
 	}
-	if err := p.srv.filterACL(token, reply); err != nil {
+	if err := p.srv.filterACL(opts, reply); err != nil {
 		return err
 	}
 
 	// We have to do this ourselves since we are not doing a blocking RPC.
-	p.srv.SetQueryMeta(&reply.QueryMeta, token)
+	p.srv.SetQueryMeta(&reply.QueryMeta, opts.Token)
 
 	// We don't bother trying to do an RTT sort here since we are by
 	// definition in another DC. We just shuffle to make sure that we
@@ -694,6 +695,7 @@ type queryServer interface {
 	GetLocalDC() string
 	ExecuteRemote(args *structs.PreparedQueryExecuteRemoteRequest, reply *structs.PreparedQueryExecuteResponse) error
 	GetSamenessGroupFailoverTargets(name string, entMeta acl.EnterpriseMeta) ([]structs.QueryFailoverTarget, error)
+	GetPeeringRTT(peer string, entMeta acl.EnterpriseMeta) (time.Duration, bool, error)
 }
 
 // queryServerWrapper applies the queryServer interface to a Server.
@@ -745,6 +747,22 @@ func (q *queryServerWrapper) GetOtherDatacentersByDistance() ([]string, error) {
 	return result, nil
 }
 
+// GetPeeringRTT returns the most recently measured round trip time to the
+// given peer, and false if it has not been measured.
+func (q *queryServerWrapper) GetPeeringRTT(peer string, entMeta acl.EnterpriseMeta) (time.Duration, bool, error) {
+	_, peering, err := q.srv.fsm.State().PeeringRead(nil, state.Query{
+		Value:          peer,
+		EnterpriseMeta: *structs.NodeEnterpriseMetaInPartition(entMeta.PartitionOrEmpty()),
+	})
+	if err != nil {
+		return 0, false, err
+	}
+	if peering == nil || !peering.IsActive() || peering.RTT == nil {
+		return 0, false, nil
+	}
+	return peering.RTT.AsDuration(), true, nil
+}
+
 // queryFailover runs an algorithm to determine which DCs to try and then calls
 // them to try to locate alternative services.
 func queryFailover(q queryServer, query structs.PreparedQuery,
@@ -765,10 +783,11 @@ func queryFailover(q queryServer, query structs.PreparedQuery,
 	}
 
 	// Build a candidate list of failover targets to try, starting with the nearest N target
-	// from RTTs.
+	// from RTTs. When explicit targets are given, NearestN only orders the
+	// peers among them and never adds datacenters that weren't listed.
 	var targets []structs.QueryFailoverTarget
 	index := make(map[string]struct{})
-	if query.Service.Failover.NearestN > 0 {
+	if query.Service.Failover.NearestN > 0 && len(query.Service.Failover.Targets) == 0 {
 		for i, dc := range nearest {
 			if !(i < query.Service.Failover.NearestN) {
 				break
@@ -779,8 +798,50 @@ func queryFailover(q queryServer, query structs.PreparedQuery,
 		}
 	}
 
+	// Then add the nearest N peers from the explicit targets, based on their
+	// measured RTTs. Peers that haven't been measured yet keep their place in
+	// the list below.
+	failoverTargets := query.Service.Failover.AsTargets()
+	nearestPeers := make(map[int]struct{})
+	if query.Service.Failover.NearestN > 0 {
+		type peerRTT struct {
+			index int
+			rtt   time.Duration
+		}
+		var peers []peerRTT
+		for i, target := range failoverTargets {
+			if target.Peer == "" {
+				continue
+			}
+
+			rtt, ok, err := q.GetPeeringRTT(target.Peer, target.EnterpriseMeta)
+			if err != nil {
+				return err
+			}
+			if ok {
+				peers = append(peers, peerRTT{index: i, rtt: rtt})
+			}
+		}
+		sort.SliceStable(peers, func(i, j int) bool {
+			return peers[i].rtt < peers[j].rtt
+		})
+
+		for i, peer := range peers {
+			if !(i < query.Service.Failover.NearestN) {
+				break
+			}
+
+			targets = append(targets, failoverTargets[peer.index])
+			nearestPeers[peer.index] = struct{}{}
+		}
+	}
+
 	// Then add any targets explicitly listed that weren't selected above.
-	for _, target := range query.Service.Failover.AsTargets() {
+	for i, target := range failoverTargets {
+		if _, ok := nearestPeers[i]; ok {
+			continue
+		}
+
 		// This will prevent a log of other log spam if we do not
 		// attempt to talk to datacenters we don't know about.
 		if dc := target.Datacenter; dc != "" {
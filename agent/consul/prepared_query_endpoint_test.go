@@ -94,16 +94,8 @@ func TestPreparedQuery_Apply(t *testing.T) {
 		t.Fatalf("bad: %v", err)
 	}
 
-	// Fix that and ensure Targets and NearestN cannot be set at the same time.
-	query.Query.Service.Failover.NearestN = 1
-	query.Query.Service.Failover.Targets = []structs.QueryFailoverTarget{{Peer: "peer"}}
-	err = msgpackrpc.CallWithCodec(codec, "PreparedQuery.Apply", &query, &reply)
-	if err == nil || !strings.Contains(err.Error(), "Targets cannot be populated with") {
-		t.Fatalf("bad: %v", err)
-	}
-
 	// Fix that and ensure Targets and Datacenters cannot be set at the same time.
-	query.Query.Service.Failover.NearestN = 0
+	query.Query.Service.Failover.NearestN = 1
 	query.Query.Service.Failover.Datacenters = []string{"dc2"}
 	query.Query.Service.Failover.Targets = []structs.QueryFailoverTarget{{Peer: "peer"}}
 	err = msgpackrpc.CallWithCodec(codec, "PreparedQuery.Apply", &query, &reply)
@@ -111,13 +103,16 @@ func TestPreparedQuery_Apply(t *testing.T) {
 		t.Fatalf("bad: %v", err)
 	}
 
-	// Fix that and make sure it propagates an error from the Raft apply.
-	query.Query.Service.Failover.Targets = nil
+	// Fix that, leaving Targets combined with NearestN which is allowed, and
+	// make sure it propagates an error from the Raft apply.
+	query.Query.Service.Failover.Datacenters = nil
 	query.Query.Session = "nope"
 	err = msgpackrpc.CallWithCodec(codec, "PreparedQuery.Apply", &query, &reply)
 	if err == nil || !strings.Contains(err.Error(), "invalid session") {
 		t.Fatalf("bad: %v", err)
 	}
+	query.Query.Service.Failover.NearestN = 0
+	query.Query.Service.Failover.Targets = nil
 
 	// Fix that and make sure the apply goes through.
 	query.Query.Session = ""
@@ -2825,6 +2820,7 @@ type mockQueryServer struct {
 	Logger           hclog.Logger
 	LogBuffer        *bytes.Buffer
 	SamenessGroup    map[string]*structs.SamenessGroupConfigEntry
+	PeerRTTs         map[string]time.Duration
 }
 
 func (m *mockQueryServer) JoinQueryLog() string {
@@ -2852,6 +2848,11 @@ func (m *mockQueryServer) GetOtherDatacentersByDistance() ([]string, error) {
 	return m.Datacenters, m.DatacentersError
 }
 
+func (m *mockQueryServer) GetPeeringRTT(peer string, _ acl.EnterpriseMeta) (time.Duration, bool, error) {
+	rtt, ok := m.PeerRTTs[peer]
+	return rtt, ok, nil
+}
+
 func (m *mockQueryServer) ExecuteRemote(args *structs.PreparedQueryExecuteRemoteRequest, reply *structs.PreparedQueryExecuteResponse) error {
 	peerName := args.Query.Service.Peer
 	partitionName := args.Query.Service.PartitionOrEmpty()
@@ -3270,6 +3271,8 @@ func TestPreparedQuery_queryFailover(t *testing.T) {
 		name               string
 		targets            []structs.QueryFailoverTarget
 		datacenters        []string
+		nearestN           int
+		peerRTTs           map[string]time.Duration
 		queryfn            func(args *structs.PreparedQueryExecuteRemoteRequest, reply *structs.PreparedQueryExecuteResponse) error
 		expectedPeer       string
 		expectedDatacenter string
@@ -3314,15 +3317,88 @@ func TestPreparedQuery_queryFailover(t *testing.T) {
 			expectedReplies:    2,
 			expectedQuery:      "peer:cluster-01|dc44:PreparedQuery.ExecuteRemote",
 		},
+		{
+			name: "failover nearest peer by rtt",
+			targets: []structs.QueryFailoverTarget{
+				{Peer: "cluster-01"},
+				{Datacenter: "dc44"},
+				{Peer: "cluster-02"},
+				{Peer: "cluster-03"},
+			},
+			queryfn: func(args *structs.PreparedQueryExecuteRemoteRequest, reply *structs.PreparedQueryExecuteResponse) error {
+				if args.Query.Service.Peer == "cluster-03" {
+					reply.Nodes = nodes()
+				}
+				return nil
+			},
+			datacenters: []string{"dc44"},
+			nearestN:    1,
+			peerRTTs: map[string]time.Duration{
+				"cluster-01": 50 * time.Millisecond,
+				"cluster-02": 10 * time.Millisecond,
+			},
+			expectedPeer:       "cluster-03",
+			expectedDatacenter: "",
+			expectedReplies:    4,
+			expectedQuery:      "peer:cluster-02|peer:cluster-01|dc44:PreparedQuery.ExecuteRemote|peer:cluster-03",
+		},
+		{
+			name: "failover nearest peers never adds unlisted datacenters",
+			targets: []structs.QueryFailoverTarget{
+				{Peer: "cluster-01"},
+				{Peer: "cluster-02"},
+			},
+			queryfn: func(args *structs.PreparedQueryExecuteRemoteRequest, reply *structs.PreparedQueryExecuteResponse) error {
+				if args.Query.Service.Peer == "cluster-01" {
+					reply.Nodes = nodes()
+				}
+				return nil
+			},
+			datacenters: []string{"dc2", "dc3"},
+			nearestN:    2,
+			peerRTTs: map[string]time.Duration{
+				"cluster-01": 30 * time.Millisecond,
+				"cluster-02": 10 * time.Millisecond,
+			},
+			expectedPeer:       "cluster-01",
+			expectedDatacenter: "",
+			expectedReplies:    2,
+			expectedQuery:      "peer:cluster-02|peer:cluster-01",
+		},
+		{
+			name: "failover nearest peers skips unmeasured peers",
+			targets: []structs.QueryFailoverTarget{
+				{Peer: "cluster-01"},
+				{Peer: "cluster-02"},
+				{Peer: "cluster-03"},
+			},
+			queryfn: func(args *structs.PreparedQueryExecuteRemoteRequest, reply *structs.PreparedQueryExecuteResponse) error {
+				if args.Query.Service.Peer == "cluster-02" {
+					reply.Nodes = nodes()
+				}
+				return nil
+			},
+			nearestN: 2,
+			peerRTTs: map[string]time.Duration{
+				"cluster-02": 20 * time.Millisecond,
+				"cluster-03": 5 * time.Millisecond,
+			},
+			expectedPeer:       "cluster-02",
+			expectedDatacenter: "",
+			expectedReplies:    2,
+			expectedQuery:      "peer:cluster-03|peer:cluster-02",
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			query.Service.Failover.NearestN = tt.nearestN
 			query.Service.Failover.Datacenters = nil
 			query.Service.Failover.Targets = tt.targets
 
 			mock := &mockQueryServer{
 				Datacenters: tt.datacenters,
+				PeerRTTs:    tt.peerRTTs,
 				QueryFn:     tt.queryfn,
 			}
 
@@ -27,6 +27,7 @@ import (
 	"google.golang.org/grpc"
 
 	msgpackrpc "github.com/hashicorp/consul-net-rpc/net-rpc-msgpackrpc"
+	"github.com/hashicorp/consul-net-rpc/net/rpc"
 
 	"github.com/hashicorp/consul/acl"
 	"github.com/hashicorp/consul/agent/blockingquery"
@@ -419,7 +420,10 @@ func (s *Server) handleMultiplexV2(conn net.Conn) {
 // handleConsulConn is used to service a single Consul RPC connection
 func (s *Server) handleConsulConn(conn net.Conn) {
 	defer conn.Close()
-	rpcCodec := msgpackrpc.NewCodecFromHandle(true, true, conn, structs.MsgpackHandle)
+	rpcCodec := &sourceIPCodec{
+		ServerCodec: msgpackrpc.NewCodecFromHandle(true, true, conn, structs.MsgpackHandle),
+		srv:         s,
+	}
 	for {
 		select {
 		case <-s.shutdownCh:
@@ -449,6 +453,80 @@ func (s *Server) handleConsulConn(conn net.Conn) {
 	}
 }
 
+// sourceIPCodec records the address of the remote end of the connection as
+// the source of every request read from it.
+type sourceIPCodec struct {
+	rpc.ServerCodec
+	srv *Server
+}
+
+func (c *sourceIPCodec) ReadRequestBody(args interface{}) error {
+	if err := c.ServerCodec.ReadRequestBody(args); err != nil {
+		return err
+	}
+	c.srv.setRequestSourceIP(args, c.SourceAddr())
+	return nil
+}
+
+// sourceIPRequest is implemented by requests that record the address of the
+// client that made them, see structs.QueryOptions and structs.WriteRequest.
+type sourceIPRequest interface {
+	RequestSourceIP() string
+	SetRequestSourceIP(string)
+}
+
+// setRequestSourceIP records the address the request was received from as its
+// source. Requests forwarded by another server keep the source recorded by the
+// server that received them from the client.
+func (s *Server) setRequestSourceIP(args interface{}, addr net.Addr) {
+	req, ok := args.(sourceIPRequest)
+	if !ok {
+		return
+	}
+
+	ip := addrIP(addr)
+	if ip == nil {
+		req.SetRequestSourceIP("")
+		return
+	}
+	if req.RequestSourceIP() != "" && s.isServerIP(ip) {
+		return
+	}
+	req.SetRequestSourceIP(ip.String())
+}
+
+// isServerIP returns true if ip is the address of a known server in any
+// datacenter.
+func (s *Server) isServerIP(ip net.IP) bool {
+	found := false
+	for _, dc := range s.router.GetDatacenters() {
+		s.router.CheckServers(dc, func(srv *metadata.Server) bool {
+			if addrIP(srv.Addr).Equal(ip) {
+				found = true
+			}
+			return !found
+		})
+		if found {
+			return true
+		}
+	}
+	return false
+}
+
+func addrIP(addr net.Addr) net.IP {
+	switch a := addr.(type) {
+	case nil:
+		return nil
+	case *net.TCPAddr:
+		return a.IP
+	}
+	host, _, err := net.SplitHostPort(addr.String())
+	if err != nil {
+		return nil
+	}
+	return net.ParseIP(host)
+}
+
 // handleInsecureConsulConn is used to service a single Consul INSECURERPC connection
 func (s *Server) handleInsecureConn(conn net.Conn) {
 	defer conn.Close()
@@ -741,6 +741,36 @@ func TestRPC_readUint32(t *testing.T) {
 	}
 }
 
+func TestServer_setRequestSourceIP(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	_, s1 := testServer(t)
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+
+	client := &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 1234}
+	server := &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 1234}
+
+	// The address of a client always replaces the source it claims.
+	args := &structs.KVSRequest{WriteRequest: structs.WriteRequest{SourceIP: "10.1.2.3"}}
+	s1.setRequestSourceIP(args, client)
+	require.Equal(t, "192.0.2.1", args.SourceIP)
+
+	s1.setRequestSourceIP(args, nil)
+	require.Equal(t, "", args.SourceIP)
+
+	// Requests forwarded by a server keep the source recorded by that server.
+	query := &structs.DCSpecificRequest{QueryOptions: structs.QueryOptions{SourceIP: "10.1.2.3"}}
+	s1.setRequestSourceIP(query, server)
+	require.Equal(t, "10.1.2.3", query.SourceIP)
+
+	query.SourceIP = ""
+	s1.setRequestSourceIP(query, server)
+	require.Equal(t, "127.0.0.1", query.SourceIP)
+}
+
 func TestRPC_LocalTokenStrippedOnForward(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
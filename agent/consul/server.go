@@ -130,6 +130,9 @@ const (
 	aclRoleReplicationRoutineName         = "ACL role replication"
 	aclTokenReplicationRoutineName        = "ACL token replication"
 	aclTokenReapingRoutineName            = "acl token reaping"
+	acmeCertificateControllerRoutineName  = "ACME certificate controller"
+	caLeafCertInventoryRoutineName        = "CA leaf cert inventory"
+	caRevocationListRoutineName           = "CA revocation lists"
 	caRootPruningRoutineName              = "CA root pruning"
 	caRootMetricRoutineName               = "CA root expiration metric"
 	caSigningMetricRoutineName            = "CA signing expiration metric"
@@ -146,6 +149,7 @@ const (
 	peeringStreamsRoutineName             = "streaming peering resources"
 	peeringDeletionRoutineName            = "peering deferred deletion"
 	peeringStreamsMetricsRoutineName      = "metrics for streaming peering resources"
+	peeringRTTSyncRoutineName             = "peering rtt sync"
 	raftLogVerifierRoutineName            = "raft log verifier"
 )
 
@@ -1622,6 +1626,7 @@ type inmemCodec struct {
 	reply      interface{}
 	err        error
 	sourceAddr net.Addr
+	srv        *Server
 }
 
 func (i *inmemCodec) ReadRequestHeader(req *rpc.Request) error {
@@ -1633,6 +1638,7 @@ func (i *inmemCodec) ReadRequestBody(args interface{}) error {
 	sourceValue := reflect.Indirect(reflect.Indirect(reflect.ValueOf(i.args)))
 	dst := reflect.Indirect(reflect.Indirect(reflect.ValueOf(args)))
 	dst.Set(sourceValue)
+	i.srv.setRequestSourceIP(args, i.sourceAddr)
 	return nil
 }
 
@@ -1663,6 +1669,7 @@ func (s *Server) RPC(ctx context.Context, method string, args interface{}, reply
 		args:       args,
 		reply:      reply,
 		sourceAddr: remoteAddr,
+		srv:        s,
 	}
 
 	// Enforce the RPC limit.
@@ -29,6 +29,16 @@ func (s *Server) getCARoots(ws memdb.WatchSet, state *state.Store) (*structs.Ind
 
 	indexedRoots.TrustDomain = trustDomain
 
+	revokedIdx, revoked, err := state.CARevokedCerts(ws)
+	if err != nil {
+		return nil, err
+	}
+	listsIdx, lists, err := state.CARevocationLists(ws)
+	if err != nil {
+		return nil, err
+	}
+	index = max(index, revokedIdx, listsIdx)
+
 	indexedRoots.Index, indexedRoots.Roots = index, roots
 	if indexedRoots.Roots == nil {
 		indexedRoots.Roots = make(structs.CARoots, 0)
@@ -69,6 +79,16 @@ func (s *Server) getCARoots(ws memdb.WatchSet, state *state.Store) (*structs.Ind
 		}
 	}
 
+	// These are also pointers into the memdb store, so they are copied too.
+	for _, r := range revoked {
+		revokedCopy := *r
+		indexedRoots.RevokedCerts = append(indexedRoots.RevokedCerts, &revokedCopy)
+	}
+	for _, l := range servedRevocationLists(s.config.Datacenter, lists, indexedRoots.Active()) {
+		listCopy := *l
+		indexedRoots.RevocationLists = append(indexedRoots.RevocationLists, &listCopy)
+	}
+
 	return indexedRoots, nil
 }
 
@@ -66,7 +66,7 @@ func (s *Session) Apply(args *structs.SessionRequest, reply *string) error {
 	var authzContext acl.AuthorizerContext
 
 	// Fetch the ACL token, if any, and apply the policy.
-	authz, err := s.srv.ResolveTokenAndDefaultMeta(args.Token, &args.Session.EnterpriseMeta, &authzContext)
+	authz, err := s.srv.ResolveRequestTokenAndDefaultMeta(args, &args.Session.EnterpriseMeta, &authzContext)
 	if err != nil {
 		return err
 	}
@@ -178,7 +178,7 @@ func (s *Session) Get(args *structs.SessionSpecificRequest,
 	fixupSessionSpecificRequest(args)
 
 	var authzContext acl.AuthorizerContext
-	authz, err := s.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext)
+	authz, err := s.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, &authzContext)
 	if err != nil {
 		return err
 	}
@@ -216,7 +216,7 @@ func (s *Session) List(args *structs.SessionSpecificRequest,
 	}
 
 	var authzContext acl.AuthorizerContext
-	authz, err := s.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext)
+	authz, err := s.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, &authzContext)
 	if err != nil {
 		return err
 	}
@@ -248,7 +248,7 @@ func (s *Session) NodeSessions(args *structs.NodeSpecificRequest,
 	}
 
 	var authzContext acl.AuthorizerContext
-	authz, err := s.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext)
+	authz, err := s.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, &authzContext)
 	if err != nil {
 		return err
 	}
@@ -285,7 +285,7 @@ func (s *Session) Renew(args *structs.SessionSpecificRequest,
 
 	// Fetch the ACL token, if any, and apply the policy.
 	var authzContext acl.AuthorizerContext
-	authz, err := s.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext)
+	authz, err := s.srv.ResolveRequestTokenAndDefaultMeta(args, &args.EnterpriseMeta, &authzContext)
 	if err != nil {
 		return err
 	}
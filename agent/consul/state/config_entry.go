@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/consul/agent/structs"
 	"github.com/hashicorp/consul/lib"
 	"github.com/hashicorp/consul/lib/maps"
+	"github.com/hashicorp/consul/proto/private/pbconfigentry"
 )
 
 var (
@@ -260,12 +261,18 @@ func ensureConfigEntryTxn(tx WriteTxn, idx uint64, statusUpdate bool, conf struc
 			if controlledConf, ok := conf.(structs.ControlledConfigEntry); ok {
 				controlledConf.SetStatus(existing.(structs.ControlledConfigEntry).GetStatus())
 			}
+			if stateConf, ok := conf.(structs.ControllerStateConfigEntry); ok {
+				stateConf.InheritControllerState(existingConf)
+			}
 		}
 	} else {
 		if !statusUpdate {
 			if controlledConf, ok := conf.(structs.ControlledConfigEntry); ok {
 				controlledConf.SetStatus(controlledConf.DefaultStatus())
 			}
+			if stateConf, ok := conf.(structs.ControllerStateConfigEntry); ok {
+				stateConf.InheritControllerState(nil)
+			}
 		}
 		raftIndex.CreateIndex = idx
 	}
@@ -648,8 +655,11 @@ func validateProposedConfigEntryInGraph(
 	case structs.BoundAPIGateway:
 	case structs.FileSystemCertificate:
 	case structs.InlineCertificate:
+	case structs.ACMECertificate:
 	case structs.HTTPRoute:
 	case structs.TCPRoute:
+	case structs.GRPCRoute:
+	case structs.TLSRoute:
 	case structs.RateLimitIPConfig:
 	case structs.JWTProvider:
 		if newEntry == nil && existingEntry != nil {
@@ -1478,6 +1488,15 @@ func serviceDiscoveryChainTxn(
 	if err != nil {
 		return 0, nil, nil, err
 	}
+	if _, ok := entries.Peers[req.EvaluateInPeer]; req.EvaluateInPeer != "" && !ok {
+		idx, err := readDiscoveryChainPeerTxn(tx, ws, entries, req.EvaluateInPeer, structs.DefaultEnterpriseMetaInPartition(entMeta.PartitionOrDefault()))
+		if err != nil {
+			return 0, nil, nil, err
+		}
+		if idx > index {
+			index = idx
+		}
+	}
 	req.Entries = entries
 
 	_, config, err := caConfigTxn(tx, ws)
@@ -1901,19 +1920,13 @@ func readDiscoveryChainConfigEntriesTxn(
 	}
 
 	for peerName := range todoPeers {
-		q := Query{
-			Value:          peerName,
-			EnterpriseMeta: *peerEntMeta,
-		}
-		idx, entry, err := peeringReadTxn(tx, ws, q)
+		idx, err := readDiscoveryChainPeerTxn(tx, ws, res, peerName, peerEntMeta)
 		if err != nil {
 			return 0, nil, err
 		}
 		if idx > maxIdx {
 			maxIdx = idx
 		}
-
-		res.Peers[peerName] = entry
 	}
 
 	// Strip nils now that they are no longer necessary.
@@ -1941,6 +1954,45 @@ func readDiscoveryChainConfigEntriesTxn(
 	return maxIdx, res, nil
 }
 
+// readDiscoveryChainPeerTxn adds the peering and the config entries imported
+// from it to the chain set.
+func readDiscoveryChainPeerTxn(
+	tx ReadTxn,
+	ws memdb.WatchSet,
+	res *configentry.DiscoveryChainSet,
+	peerName string,
+	entMeta *acl.EnterpriseMeta,
+) (uint64, error) {
+	q := Query{
+		Value:          peerName,
+		EnterpriseMeta: *entMeta,
+	}
+	idx, entry, err := peeringReadTxn(tx, ws, q)
+	if err != nil {
+		return 0, err
+	}
+	maxIdx := idx
+
+	res.Peers[peerName] = entry
+
+	idx, imported, err := peeringConfigEntriesReadTxn(tx, ws, q)
+	if err != nil {
+		return 0, err
+	}
+	if idx > maxIdx {
+		maxIdx = idx
+	}
+	for _, raw := range imported.GetEntries() {
+		switch raw.Kind {
+		case pbconfigentry.Kind_KindServiceDefaults,
+			pbconfigentry.Kind_KindServiceResolver,
+			pbconfigentry.Kind_KindServiceRouter:
+			res.AddImportedEntries(peerName, pbconfigentry.ConfigEntryToStructs(raw))
+		}
+	}
+	return maxIdx, nil
+}
+
 // anyKey returns any key from the provided map if any exist. Useful for using
 // a map as a simple work queue of sorts.
 func anyKey(m map[structs.ServiceID]struct{}) (structs.ServiceID, bool) {
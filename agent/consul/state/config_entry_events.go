@@ -23,8 +23,11 @@ var configEntryKindToTopic = map[string]stream.Topic{
 	structs.APIGateway:            EventTopicAPIGateway,
 	structs.TCPRoute:              EventTopicTCPRoute,
 	structs.HTTPRoute:             EventTopicHTTPRoute,
+	structs.GRPCRoute:             EventTopicGRPCRoute,
+	structs.TLSRoute:              EventTopicTLSRoute,
 	structs.FileSystemCertificate: EventTopicFileSystemCertificate,
 	structs.InlineCertificate:     EventTopicInlineCertificate,
+	structs.ACMECertificate:       EventTopicACMECertificate,
 	structs.BoundAPIGateway:       EventTopicBoundAPIGateway,
 	structs.RateLimitIPConfig:     EventTopicIPRateLimit,
 	structs.SamenessGroup:         EventTopicSamenessGroup,
@@ -148,6 +151,18 @@ func (s *Store) HTTPRouteSnapshot(req stream.SubscribeRequest, buf stream.Snapsh
 	return s.configEntrySnapshot(structs.HTTPRoute, req, buf)
 }
 
+// GRPCRouteSnapshot is a stream.SnapshotFunc that returns a snapshot of
+// grpc-route config entries.
+func (s *Store) GRPCRouteSnapshot(req stream.SubscribeRequest, buf stream.SnapshotAppender) (uint64, error) {
+	return s.configEntrySnapshot(structs.GRPCRoute, req, buf)
+}
+
+// TLSRouteSnapshot is a stream.SnapshotFunc that returns a snapshot of
+// tls-route config entries.
+func (s *Store) TLSRouteSnapshot(req stream.SubscribeRequest, buf stream.SnapshotAppender) (uint64, error) {
+	return s.configEntrySnapshot(structs.TLSRoute, req, buf)
+}
+
 // FileSystemCertificateSnapshot is a stream.SnapshotFunc that returns a snapshot of
 // inline-certificate config entries.
 func (s *Store) FileSystemCertificateSnapshot(req stream.SubscribeRequest, buf stream.SnapshotAppender) (uint64, error) {
@@ -160,6 +175,12 @@ func (s *Store) InlineCertificateSnapshot(req stream.SubscribeRequest, buf strea
 	return s.configEntrySnapshot(structs.InlineCertificate, req, buf)
 }
 
+// ACMECertificateSnapshot is a stream.SnapshotFunc that returns a snapshot of
+// acme-certificate config entries.
+func (s *Store) ACMECertificateSnapshot(req stream.SubscribeRequest, buf stream.SnapshotAppender) (uint64, error) {
+	return s.configEntrySnapshot(structs.ACMECertificate, req, buf)
+}
+
 // BoundAPIGatewaySnapshot is a stream.SnapshotFunc that returns a snapshot of
 // bound-api-gateway config entries.
 func (s *Store) BoundAPIGatewaySnapshot(req stream.SubscribeRequest, buf stream.SnapshotAppender) (uint64, error) {
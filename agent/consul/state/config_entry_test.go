@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/consul/agent/configentry"
 	"github.com/hashicorp/consul/agent/consul/discoverychain"
 	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/proto/private/pbconfigentry"
 	"github.com/hashicorp/consul/proto/private/pbpeering"
 	"github.com/hashicorp/consul/proto/private/prototest"
 	"github.com/hashicorp/consul/sdk/testutil"
@@ -2396,6 +2397,18 @@ func TestStore_ReadDiscoveryChainConfigEntries_FetchPeers(t *testing.T) {
 	err := s.PeeringWrite(0, &pbpeering.PeeringWriteRequest{Peering: cluster01Peering})
 	require.NoError(t, err)
 
+	err = s.PeeringConfigEntriesWrite(1, &pbpeering.PeeringConfigEntries{
+		PeerName: "cluster-01",
+		Entries: []*pbconfigentry.ConfigEntry{
+			pbconfigentry.ConfigEntryFromStructs(&structs.ServiceConfigEntry{
+				Kind:     structs.ServiceDefaults,
+				Name:     "main",
+				Protocol: "grpc",
+			}),
+		},
+	})
+	require.NoError(t, err)
+
 	_, entrySet, err := s.readDiscoveryChainConfigEntries(nil, "main", nil, nil)
 	require.NoError(t, err)
 
@@ -2407,6 +2420,11 @@ func TestStore_ReadDiscoveryChainConfigEntries_FetchPeers(t *testing.T) {
 		"cluster-01": cluster01Peering,
 		"cluster-02": nil,
 	})
+
+	require.Len(t, entrySet.ImportedEntries, 1)
+	imported := entrySet.GetImportedService("cluster-01", structs.NewServiceID("main", nil))
+	require.NotNil(t, imported)
+	require.Equal(t, "grpc", imported.Protocol)
 }
 
 // TODO(rb): add ServiceIntentions tests
@@ -3729,6 +3747,49 @@ func TestStateStore_DiscoveryChain_AttachVirtualIPs(t *testing.T) {
 
 }
 
+func TestStateStore_DiscoveryChain_EvaluateInPeer(t *testing.T) {
+	s := testStateStore(t)
+
+	require.NoError(t, s.CASetConfig(0, &structs.CAConfiguration{Provider: "consul"}))
+
+	require.NoError(t, s.PeeringWrite(1, &pbpeering.PeeringWriteRequest{
+		Peering: &pbpeering.Peering{
+			ID:   testFooPeerID,
+			Name: "cluster-01",
+		},
+	}))
+	require.NoError(t, s.PeeringConfigEntriesWrite(2, &pbpeering.PeeringConfigEntries{
+		PeerName: "cluster-01",
+		Entries: []*pbconfigentry.ConfigEntry{
+			pbconfigentry.ConfigEntryFromStructs(&structs.ServiceConfigEntry{
+				Kind:     structs.ServiceDefaults,
+				Name:     "foo",
+				Protocol: "http",
+			}),
+			pbconfigentry.ConfigEntryFromStructs(&structs.ServiceResolverConfigEntry{
+				Kind:           structs.ServiceResolver,
+				Name:           "foo",
+				ConnectTimeout: 33 * time.Second,
+			}),
+		},
+	}))
+
+	req := discoverychain.CompileRequest{
+		ServiceName:          "foo",
+		EvaluateInNamespace:  "default",
+		EvaluateInPartition:  "default",
+		EvaluateInDatacenter: "dc1",
+		EvaluateInPeer:       "cluster-01",
+	}
+	idx, chain, _, err := s.ServiceDiscoveryChain(nil, "foo", structs.DefaultEnterpriseMetaInDefaultPartition(), req)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), idx)
+	require.False(t, chain.Default)
+	require.Equal(t, "http", chain.Protocol)
+	require.Equal(t, "resolver:foo.default.default.external.cluster-01", chain.StartNode)
+	require.Equal(t, 33*time.Second, chain.Nodes[chain.StartNode].Resolver.ConnectTimeout)
+}
+
 func TestFindJWTProviderNameReferences(t *testing.T) {
 	oktaProvider := structs.IntentionJWTProvider{Name: "okta"}
 	auth0Provider := structs.IntentionJWTProvider{Name: "auth0"}
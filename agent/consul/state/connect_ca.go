@@ -18,6 +18,8 @@ const (
 	tableConnectCAConfig        = "connect-ca-config"
 	tableConnectCARoots         = "connect-ca-roots"
 	tableConnectCALeafCerts     = "connect-ca-leaf-certs"
+	tableConnectCARevokedCerts  = "connect-ca-revoked-certs"
+	tableConnectCARevocations   = "connect-ca-revocation-lists"
 )
 
 // caBuiltinProviderTableSchema returns a new table schema used for storing
@@ -77,6 +79,94 @@ func caRootTableSchema() *memdb.TableSchema {
 	}
 }
 
+// caRevokedCertTableSchema returns a new table schema used for storing the
+// leaf certificates revoked in this datacenter.
+func caRevokedCertTableSchema() *memdb.TableSchema {
+	return &memdb.TableSchema{
+		Name: tableConnectCARevokedCerts,
+		Indexes: map[string]*memdb.IndexSchema{
+			indexID: {
+				Name:         indexID,
+				AllowMissing: false,
+				Unique:       true,
+				Indexer: indexerSingle[string, *structs.CARevokedCert]{
+					readIndex:  indexFromStringCaseSensitive,
+					writeIndex: indexFromCARevokedCert,
+				},
+			},
+		},
+	}
+}
+
+func indexFromCARevokedCert(c *structs.CARevokedCert) ([]byte, error) {
+	id := c.ID()
+	if id == "" {
+		return nil, errMissingValueForIndex
+	}
+
+	var b indexBuilder
+	b.String(id)
+	return b.Bytes(), nil
+}
+
+// caRevocationListTableSchema returns a new table schema used for storing
+// the certificate revocation lists of this and other datacenters.
+func caRevocationListTableSchema() *memdb.TableSchema {
+	return &memdb.TableSchema{
+		Name: tableConnectCARevocations,
+		Indexes: map[string]*memdb.IndexSchema{
+			indexID: {
+				Name:         indexID,
+				AllowMissing: false,
+				Unique:       true,
+				Indexer: indexerSingle[string, *structs.CARevocationList]{
+					readIndex:  indexFromStringCaseSensitive,
+					writeIndex: indexFromCARevocationList,
+				},
+			},
+		},
+	}
+}
+
+func indexFromCARevocationList(l *structs.CARevocationList) ([]byte, error) {
+	if l.Datacenter == "" {
+		return nil, errMissingValueForIndex
+	}
+
+	var b indexBuilder
+	b.String(l.ID())
+	return b.Bytes(), nil
+}
+
+// caLeafCertTableSchema returns a new table schema used for storing the
+// inventory of leaf certificates issued in this datacenter.
+func caLeafCertTableSchema() *memdb.TableSchema {
+	return &memdb.TableSchema{
+		Name: tableConnectCALeafCerts,
+		Indexes: map[string]*memdb.IndexSchema{
+			indexID: {
+				Name:         indexID,
+				AllowMissing: false,
+				Unique:       true,
+				Indexer: indexerSingle[string, *structs.IssuedCert]{
+					readIndex:  indexFromStringCaseSensitive,
+					writeIndex: indexFromIssuedCert,
+				},
+			},
+		},
+	}
+}
+
+func indexFromIssuedCert(c *structs.IssuedCert) ([]byte, error) {
+	if c.SerialNumber == "" {
+		return nil, errMissingValueForIndex
+	}
+
+	var b indexBuilder
+	b.String(c.SerialNumber)
+	return b.Bytes(), nil
+}
+
 // CAConfig is used to pull the CA config from the snapshot.
 func (s *Snapshot) CAConfig() (*structs.CAConfiguration, error) {
 	c, err := s.tx.First(tableConnectCAConfig, "id")
@@ -498,3 +588,258 @@ func (s *Store) CAIncrementProviderSerialNumber(idx uint64) (uint64, error) {
 	err = tx.Commit()
 	return next, err
 }
+
+// CARevokedCerts is used to pull the revoked certificates for the snapshot.
+func (s *Snapshot) CARevokedCerts() ([]*structs.CARevokedCert, error) {
+	iter, err := s.tx.Get(tableConnectCARevokedCerts, indexID)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []*structs.CARevokedCert
+	for v := iter.Next(); v != nil; v = iter.Next() {
+		ret = append(ret, v.(*structs.CARevokedCert))
+	}
+	return ret, nil
+}
+
+// CARevokedCert is used when restoring from a snapshot.
+func (s *Restore) CARevokedCert(c *structs.CARevokedCert) error {
+	if err := s.tx.Insert(tableConnectCARevokedCerts, c); err != nil {
+		return fmt.Errorf("failed restoring revoked certificate: %s", err)
+	}
+	if err := indexUpdateMaxTxn(s.tx, c.ModifyIndex, tableConnectCARevokedCerts); err != nil {
+		return fmt.Errorf("failed updating index: %s", err)
+	}
+	return nil
+}
+
+// CARevokedCerts returns the revoked leaf certificates.
+func (s *Store) CARevokedCerts(ws memdb.WatchSet) (uint64, []*structs.CARevokedCert, error) {
+	tx := s.db.Txn(false)
+	defer tx.Abort()
+
+	return caRevokedCertsTxn(tx, ws)
+}
+
+func caRevokedCertsTxn(tx ReadTxn, ws memdb.WatchSet) (uint64, []*structs.CARevokedCert, error) {
+	idx := maxIndexTxn(tx, tableConnectCARevokedCerts)
+
+	iter, err := tx.Get(tableConnectCARevokedCerts, indexID)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed revoked certificate lookup: %s", err)
+	}
+	ws.Add(iter.WatchCh())
+
+	var results []*structs.CARevokedCert
+	for v := iter.Next(); v != nil; v = iter.Next() {
+		results = append(results, v.(*structs.CARevokedCert))
+	}
+	return idx, results, nil
+}
+
+// CARevokeCerts records the given revocations. Revoking a certificate or an
+// identity again replaces the previous revocation.
+func (s *Store) CARevokeCerts(idx uint64, certs []*structs.CARevokedCert) error {
+	tx := s.db.WriteTxn(idx)
+	defer tx.Abort()
+
+	for _, c := range certs {
+		if c.SerialNumber == "" && c.SpiffeID == "" {
+			return fmt.Errorf("revoked certificate requires a serial number or SPIFFE ID")
+		}
+		c.CreateIndex = idx
+		c.ModifyIndex = idx
+		if err := tx.Insert(tableConnectCARevokedCerts, c); err != nil {
+			return fmt.Errorf("failed inserting revoked certificate: %s", err)
+		}
+	}
+	if err := tx.Insert(tableIndex, &IndexEntry{tableConnectCARevokedCerts, idx}); err != nil {
+		return fmt.Errorf("failed updating index: %s", err)
+	}
+
+	return tx.Commit()
+}
+
+// CADeleteRevokedCerts removes the given revocations, which is used once the
+// certificates they cover have expired.
+func (s *Store) CADeleteRevokedCerts(idx uint64, certs []*structs.CARevokedCert) error {
+	tx := s.db.WriteTxn(idx)
+	defer tx.Abort()
+
+	for _, c := range certs {
+		if _, err := tx.DeleteAll(tableConnectCARevokedCerts, indexID, c.ID()); err != nil {
+			return fmt.Errorf("failed deleting revoked certificate: %s", err)
+		}
+	}
+	if err := tx.Insert(tableIndex, &IndexEntry{tableConnectCARevokedCerts, idx}); err != nil {
+		return fmt.Errorf("failed updating index: %s", err)
+	}
+
+	return tx.Commit()
+}
+
+// CARevocationLists is used to pull the revocation lists for the snapshot.
+func (s *Snapshot) CARevocationLists() ([]*structs.CARevocationList, error) {
+	iter, err := s.tx.Get(tableConnectCARevocations, indexID)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []*structs.CARevocationList
+	for v := iter.Next(); v != nil; v = iter.Next() {
+		ret = append(ret, v.(*structs.CARevocationList))
+	}
+	return ret, nil
+}
+
+// CARevocationList is used when restoring from a snapshot. Revocation lists
+// don't carry a raft index, so the table index starts over and the leader's
+// next check-and-set uses it.
+func (s *Restore) CARevocationList(l *structs.CARevocationList) error {
+	if err := s.tx.Insert(tableConnectCARevocations, l); err != nil {
+		return fmt.Errorf("failed restoring revocation list: %s", err)
+	}
+	return nil
+}
+
+// CARevocationLists returns the certificate revocation lists of this and
+// other datacenters.
+func (s *Store) CARevocationLists(ws memdb.WatchSet) (uint64, []*structs.CARevocationList, error) {
+	tx := s.db.Txn(false)
+	defer tx.Abort()
+
+	return caRevocationListsTxn(tx, ws)
+}
+
+func caRevocationListsTxn(tx ReadTxn, ws memdb.WatchSet) (uint64, []*structs.CARevocationList, error) {
+	idx := maxIndexTxn(tx, tableConnectCARevocations)
+
+	iter, err := tx.Get(tableConnectCARevocations, indexID)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed revocation list lookup: %s", err)
+	}
+	ws.Add(iter.WatchCh())
+
+	var results []*structs.CARevocationList
+	for v := iter.Next(); v != nil; v = iter.Next() {
+		results = append(results, v.(*structs.CARevocationList))
+	}
+	return idx, results, nil
+}
+
+// CARevocationListSetCAS replaces all the revocation lists using a
+// check-and-set operation.
+//
+// The first boolean result returns whether the transaction succeeded or not.
+func (s *Store) CARevocationListSetCAS(idx, cidx uint64, lists []*structs.CARevocationList) (bool, error) {
+	tx := s.db.WriteTxn(idx)
+	defer tx.Abort()
+
+	if midx := maxIndexTxn(tx, tableConnectCARevocations); midx != cidx {
+		return false, nil
+	}
+
+	if _, err := tx.DeleteAll(tableConnectCARevocations, indexID); err != nil {
+		return false, err
+	}
+	for _, l := range lists {
+		if err := tx.Insert(tableConnectCARevocations, l); err != nil {
+			return false, fmt.Errorf("failed inserting revocation list: %s", err)
+		}
+	}
+	if err := tx.Insert(tableIndex, &IndexEntry{tableConnectCARevocations, idx}); err != nil {
+		return false, fmt.Errorf("failed updating index: %s", err)
+	}
+
+	err := tx.Commit()
+	return err == nil, err
+}
+
+// CALeafCerts is used to pull the issued leaf certificates for the snapshot.
+func (s *Snapshot) CALeafCerts() ([]*structs.IssuedCert, error) {
+	iter, err := s.tx.Get(tableConnectCALeafCerts, indexID)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []*structs.IssuedCert
+	for v := iter.Next(); v != nil; v = iter.Next() {
+		ret = append(ret, v.(*structs.IssuedCert))
+	}
+	return ret, nil
+}
+
+// CALeafCert is used when restoring from a snapshot.
+func (s *Restore) CALeafCert(c *structs.IssuedCert) error {
+	if err := s.tx.Insert(tableConnectCALeafCerts, c); err != nil {
+		return fmt.Errorf("failed restoring issued certificate: %s", err)
+	}
+	if err := indexUpdateMaxTxn(s.tx, c.ModifyIndex, tableConnectCALeafCerts); err != nil {
+		return fmt.Errorf("failed updating index: %s", err)
+	}
+	return nil
+}
+
+// CALeafCerts returns the inventory of issued leaf certificates.
+func (s *Store) CALeafCerts(ws memdb.WatchSet) (uint64, []*structs.IssuedCert, error) {
+	tx := s.db.Txn(false)
+	defer tx.Abort()
+
+	idx := maxIndexTxn(tx, tableConnectCALeafCerts)
+
+	iter, err := tx.Get(tableConnectCALeafCerts, indexID)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed issued certificate lookup: %s", err)
+	}
+	ws.Add(iter.WatchCh())
+
+	var results []*structs.IssuedCert
+	for v := iter.Next(); v != nil; v = iter.Next() {
+		results = append(results, v.(*structs.IssuedCert))
+	}
+	return idx, results, nil
+}
+
+// CALeafCertSet records an issued leaf certificate in the inventory. The
+// certificate and private key PEMs are never stored.
+func (s *Store) CALeafCertSet(idx uint64, cert *structs.IssuedCert) error {
+	tx := s.db.WriteTxn(idx)
+	defer tx.Abort()
+
+	if cert.SerialNumber == "" {
+		return fmt.Errorf("issued certificate requires a serial number")
+	}
+
+	c := *cert
+	c.CertPEM = ""
+	c.PrivateKeyPEM = ""
+	c.CreateIndex = idx
+	c.ModifyIndex = idx
+	if err := tx.Insert(tableConnectCALeafCerts, &c); err != nil {
+		return fmt.Errorf("failed inserting issued certificate: %s", err)
+	}
+	if err := indexUpdateMaxTxn(tx, idx, tableConnectCALeafCerts); err != nil {
+		return fmt.Errorf("failed updating index: %s", err)
+	}
+
+	return tx.Commit()
+}
+
+// CADeleteLeafCerts removes the issued leaf certificates with the given
+// serial numbers from the inventory, which is used once they have expired.
+func (s *Store) CADeleteLeafCerts(idx uint64, serialNumbers []string) error {
+	tx := s.db.WriteTxn(idx)
+	defer tx.Abort()
+
+	for _, sn := range serialNumbers {
+		if _, err := tx.DeleteAll(tableConnectCALeafCerts, indexID, sn); err != nil {
+			return fmt.Errorf("failed deleting issued certificate: %s", err)
+		}
+	}
+	if err := indexUpdateMaxTxn(tx, idx, tableConnectCALeafCerts); err != nil {
+		return fmt.Errorf("failed updating index: %s", err)
+	}
+
+	return tx.Commit()
+}
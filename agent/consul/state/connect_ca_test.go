@@ -6,6 +6,7 @@ package state
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/consul/proto/private/prototest"
 	"github.com/hashicorp/consul/sdk/testutil"
@@ -495,3 +496,123 @@ func TestStore_CABuiltinProvider_Snapshot_Restore(t *testing.T) {
 		assert.Equal(t, state, res)
 	}
 }
+
+func TestStore_CARevokedCerts(t *testing.T) {
+	s := testStateStore(t)
+
+	bySerial := &structs.CARevokedCert{SerialNumber: "0a:0b", Reason: "lost"}
+	byIdentity := &structs.CARevokedCert{SpiffeID: "spiffe://11111111-2222-3333-4444-555555555555.consul/ns/default/dc/dc1/svc/web"}
+	require.NoError(t, s.CARevokeCerts(5, []*structs.CARevokedCert{bySerial, byIdentity}))
+
+	ws := memdb.NewWatchSet()
+	idx, revoked, err := s.CARevokedCerts(ws)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), idx)
+	require.Len(t, revoked, 2)
+	for _, r := range revoked {
+		require.Equal(t, uint64(5), r.CreateIndex)
+	}
+
+	// Revoking the same serial again replaces the previous revocation.
+	again := &structs.CARevokedCert{SerialNumber: "0a:0b", Reason: "compromised"}
+	require.NoError(t, s.CARevokeCerts(6, []*structs.CARevokedCert{again}))
+	require.True(t, watchFired(ws))
+
+	idx, revoked, err = s.CARevokedCerts(nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(6), idx)
+	require.Len(t, revoked, 2)
+
+	// A revocation requires a serial number or SPIFFE ID.
+	require.Error(t, s.CARevokeCerts(7, []*structs.CARevokedCert{{Reason: "empty"}}))
+
+	require.NoError(t, s.CADeleteRevokedCerts(8, []*structs.CARevokedCert{byIdentity}))
+	idx, revoked, err = s.CARevokedCerts(nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), idx)
+	require.Equal(t, []*structs.CARevokedCert{again}, revoked)
+}
+
+func TestStore_CARevocationListSetCAS(t *testing.T) {
+	s := testStateStore(t)
+
+	lists := []*structs.CARevocationList{
+		{Datacenter: "dc1", SigningKeyID: "aa", RevocationListPEM: "crl1"},
+		{Datacenter: "dc2"},
+	}
+	ok, err := s.CARevocationListSetCAS(5, 0, lists)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ws := memdb.NewWatchSet()
+	idx, actual, err := s.CARevocationLists(ws)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), idx)
+	require.ElementsMatch(t, lists, actual)
+
+	// A stale check-and-set index is rejected.
+	ok, err = s.CARevocationListSetCAS(6, 0, lists[:1])
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.False(t, watchFired(ws))
+
+	// The lists are replaced as a whole.
+	ok, err = s.CARevocationListSetCAS(7, 5, lists[:1])
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.True(t, watchFired(ws))
+
+	idx, actual, err = s.CARevocationLists(nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), idx)
+	require.Equal(t, lists[:1], actual)
+}
+
+func TestStore_CALeafCerts(t *testing.T) {
+	s := testStateStore(t)
+
+	web := &structs.IssuedCert{
+		SerialNumber:  "0a:0b",
+		CertPEM:       "cert",
+		PrivateKeyPEM: "key",
+		Service:       "web",
+		ServiceURI:    "spiffe://11111111-2222-3333-4444-555555555555.consul/ns/default/dc/dc1/svc/web",
+		Node:          "node1",
+		ValidAfter:    time.Now().UTC().Round(time.Second),
+		ValidBefore:   time.Now().UTC().Round(time.Second).Add(time.Hour),
+	}
+	require.NoError(t, s.CALeafCertSet(5, web))
+
+	ws := memdb.NewWatchSet()
+	idx, certs, err := s.CALeafCerts(ws)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), idx)
+	require.Len(t, certs, 1)
+
+	// The PEMs are never stored and the given cert isn't modified.
+	require.Empty(t, certs[0].CertPEM)
+	require.Empty(t, certs[0].PrivateKeyPEM)
+	require.Equal(t, uint64(5), certs[0].CreateIndex)
+	require.Equal(t, uint64(5), certs[0].ModifyIndex)
+	require.Equal(t, "cert", web.CertPEM)
+	require.Zero(t, web.CreateIndex)
+
+	agent := &structs.IssuedCert{
+		SerialNumber: "0c:0d",
+		Agent:        "node1",
+		AgentURI:     "spiffe://11111111-2222-3333-4444-555555555555.consul/agent/client/dc/dc1/id/node1",
+		Node:         "node1",
+	}
+	require.NoError(t, s.CALeafCertSet(6, agent))
+	require.True(t, watchFired(ws))
+
+	// A cert requires a serial number.
+	require.Error(t, s.CALeafCertSet(7, &structs.IssuedCert{Service: "web"}))
+
+	require.NoError(t, s.CADeleteLeafCerts(8, []string{"0a:0b", "ff:ff"}))
+	idx, certs, err = s.CALeafCerts(nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), idx)
+	require.Len(t, certs, 1)
+	require.Equal(t, "0c:0d", certs[0].SerialNumber)
+}
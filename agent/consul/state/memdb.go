@@ -209,11 +209,14 @@ var (
 	EventTopicHTTPRoute             = pbsubscribe.Topic_HTTPRoute
 	EventTopicFileSystemCertificate = pbsubscribe.Topic_FileSystemCertificate
 	EventTopicInlineCertificate     = pbsubscribe.Topic_InlineCertificate
+	EventTopicACMECertificate       = pbsubscribe.Topic_ACMECertificate
 	EventTopicBoundAPIGateway       = pbsubscribe.Topic_BoundAPIGateway
 	EventTopicIPRateLimit           = pbsubscribe.Topic_IPRateLimit
 	EventTopicSamenessGroup         = pbsubscribe.Topic_SamenessGroup
 	EventTopicJWTProvider           = pbsubscribe.Topic_JWTProvider
 	EventTopicExportedServices      = pbsubscribe.Topic_ExportedServices
+	EventTopicGRPCRoute             = pbsubscribe.Topic_GRPCRoute
+	EventTopicTLSRoute              = pbsubscribe.Topic_TLSRoute
 )
 
 func processDBChanges(tx ReadTxn, changes Changes) ([]stream.Event, error) {
@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/go-memdb"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/hashicorp/consul/acl"
 	"github.com/hashicorp/consul/agent/configentry"
@@ -21,10 +22,11 @@ import (
 )
 
 const (
-	tablePeering             = "peering"
-	tablePeeringTrustBundles = "peering-trust-bundles"
-	tablePeeringSecrets      = "peering-secrets"
-	tablePeeringSecretUUIDs  = "peering-secret-uuids"
+	tablePeering              = "peering"
+	tablePeeringTrustBundles  = "peering-trust-bundles"
+	tablePeeringConfigEntries = "peering-config-entries"
+	tablePeeringSecrets       = "peering-secrets"
+	tablePeeringSecretUUIDs   = "peering-secret-uuids"
 )
 
 func peeringTableSchema() *memdb.TableSchema {
@@ -81,6 +83,24 @@ func peeringTrustBundlesTableSchema() *memdb.TableSchema {
 	}
 }
 
+func peeringConfigEntriesTableSchema() *memdb.TableSchema {
+	return &memdb.TableSchema{
+		Name: tablePeeringConfigEntries,
+		Indexes: map[string]*memdb.IndexSchema{
+			indexID: {
+				Name:         indexID,
+				AllowMissing: false,
+				Unique:       true,
+				Indexer: indexerSingleWithPrefix[Query, *pbpeering.PeeringConfigEntries, any]{
+					readIndex:   indexPeeringFromQuery,
+					writeIndex:  indexFromPeeringConfigEntries,
+					prefixIndex: prefixIndexFromQueryNoNamespace,
+				},
+			},
+		},
+	}
+}
+
 func peeringSecretsTableSchema() *memdb.TableSchema {
 	return &memdb.TableSchema{
 		Name: tablePeeringSecrets,
@@ -211,21 +231,7 @@ func (s *Store) peeringSecretsWriteTxn(tx WriteTxn, req *pbpeering.SecretsWriteR
 	// If the peering came from a peering token no validation is done for the given secrets.
 	// Dialing peers do not need to validate uniqueness because the secrets were generated elsewhere.
 	if peering.ShouldDial() {
-		r, ok := req.Request.(*pbpeering.SecretsWriteRequest_Establish)
-		if !ok {
-			return fmt.Errorf("invalid request type %T when persisting stream secret for dialing peer", req.Request)
-		}
-
-		secrets := pbpeering.PeeringSecrets{
-			PeerID: req.PeerID,
-			Stream: &pbpeering.PeeringSecrets_Stream{
-				ActiveSecretID: r.Establish.ActiveStreamSecret,
-			},
-		}
-		if err := tx.Insert(tablePeeringSecrets, &secrets); err != nil {
-			return fmt.Errorf("failed inserting peering: %w", err)
-		}
-		return nil
+		return dialerPeeringSecretsWriteTxn(tx, req)
 	}
 
 	// If the peering token was generated locally, validate that the newly introduced UUID is still unique.
@@ -241,6 +247,10 @@ func (s *Store) peeringSecretsWriteTxn(tx WriteTxn, req *pbpeering.SecretsWriteR
 	// Active stream secrets doesn't need to be checked for uniqueness because it is only ever promoted from pending.
 	case *pbpeering.SecretsWriteRequest_ExchangeSecret:
 		newSecretID = r.ExchangeSecret.PendingStreamSecret
+
+	// When rotating the stream secret a new pending stream secret is generated.
+	case *pbpeering.SecretsWriteRequest_RotateStream:
+		newSecretID = r.RotateStream.PendingStreamSecret
 	}
 
 	if newSecretID != "" {
@@ -350,6 +360,26 @@ func (s *Store) peeringSecretsWriteTxn(tx WriteTxn, req *pbpeering.SecretsWriteR
 			toDelete = append(toDelete, existingActive)
 		}
 
+	case *pbpeering.SecretsWriteRequest_RotateStream:
+		if existing.GetStream().GetActiveSecretID() == "" {
+			return fmt.Errorf("cannot rotate stream secret: peering was not established yet")
+		}
+
+		// Store the newly-generated pending stream secret, overwriting any that existed.
+		// The active secret remains valid until the dialing peer opens a stream with the pending one.
+		secrets.Stream = &pbpeering.PeeringSecrets_Stream{
+			PendingSecretID: r.RotateStream.GetPendingStreamSecret(),
+			ActiveSecretID:  existing.GetStream().GetActiveSecretID(),
+		}
+
+		// Avoid invalidating existing establishment secrets when rotating stream secrets.
+		secrets.Establishment = existing.GetEstablishment()
+
+		// A pending secret from an earlier rotation that was never used is replaced.
+		if existingPending := existing.GetStream().GetPendingSecretID(); existingPending != "" {
+			toDelete = append(toDelete, existingPending)
+		}
+
 	case *pbpeering.SecretsWriteRequest_Establish:
 		// This should never happen. Dialing peers are the only ones that can call Establish,
 		// and the peering secrets for dialing peers should have been inserted earlier in the function.
@@ -370,6 +400,56 @@ func (s *Store) peeringSecretsWriteTxn(tx WriteTxn, req *pbpeering.SecretsWriteR
 	return nil
 }
 
+// dialerPeeringSecretsWriteTxn persists the stream secrets of a dialing peer.
+// The active secret is written when establishing the peering. When the
+// accepting peer rotates the secrets, the new secret is stored as pending until
+// a stream opened with it is accepted, at which point it is promoted.
+func dialerPeeringSecretsWriteTxn(tx WriteTxn, req *pbpeering.SecretsWriteRequest) error {
+	secrets := pbpeering.PeeringSecrets{
+		PeerID: req.PeerID,
+	}
+
+	switch r := req.Request.(type) {
+	case *pbpeering.SecretsWriteRequest_Establish:
+		secrets.Stream = &pbpeering.PeeringSecrets_Stream{
+			ActiveSecretID: r.Establish.ActiveStreamSecret,
+		}
+
+	case *pbpeering.SecretsWriteRequest_RotateStream:
+		existing, err := peeringSecretsReadByPeerIDTxn(tx, nil, req.PeerID)
+		if err != nil {
+			return err
+		}
+		if existing.GetStream().GetActiveSecretID() == "" {
+			return fmt.Errorf("cannot rotate stream secret: no known secrets for peering")
+		}
+		secrets.Stream = &pbpeering.PeeringSecrets_Stream{
+			ActiveSecretID:  existing.GetStream().GetActiveSecretID(),
+			PendingSecretID: r.RotateStream.GetPendingStreamSecret(),
+		}
+
+	case *pbpeering.SecretsWriteRequest_PromotePending:
+		existing, err := peeringSecretsReadByPeerIDTxn(tx, nil, req.PeerID)
+		if err != nil {
+			return err
+		}
+		if existing.GetStream().GetPendingSecretID() != r.PromotePending.GetActiveStreamSecret() {
+			return fmt.Errorf("invalid pending stream secret")
+		}
+		secrets.Stream = &pbpeering.PeeringSecrets_Stream{
+			ActiveSecretID: r.PromotePending.GetActiveStreamSecret(),
+		}
+
+	default:
+		return fmt.Errorf("invalid request type %T when persisting stream secret for dialing peer", req.Request)
+	}
+
+	if err := tx.Insert(tablePeeringSecrets, &secrets); err != nil {
+		return fmt.Errorf("failed inserting peering: %w", err)
+	}
+	return nil
+}
+
 func (s *Store) PeeringSecretsDelete(idx uint64, peerID string, dialer bool) error {
 	tx := s.db.WriteTxn(idx)
 	defer tx.Abort()
@@ -706,6 +786,39 @@ func (s *Store) PeeringTerminateByID(idx uint64, id string) error {
 	return tx.Commit()
 }
 
+// PeeringRTTWrite stores the measured round trip time to a peer, leaving the
+// rest of the peering untouched.
+func (s *Store) PeeringRTTWrite(idx uint64, id string, rtt *durationpb.Duration) error {
+	tx := s.db.WriteTxn(idx)
+	defer tx.Abort()
+
+	existing, err := peeringReadByIDTxn(tx, nil, id)
+	if err != nil {
+		return fmt.Errorf("failed to read peering %q: %w", id, err)
+	}
+	if existing == nil || !existing.IsActive() {
+		return nil
+	}
+
+	c := proto.Clone(existing)
+	clone, ok := c.(*pbpeering.Peering)
+	if !ok {
+		return fmt.Errorf("invalid type %T, expected *pbpeering.Peering", existing)
+	}
+
+	clone.RTT = rtt
+	clone.ModifyIndex = idx
+
+	if err := tx.Insert(tablePeering, clone); err != nil {
+		return fmt.Errorf("failed inserting peering: %w", err)
+	}
+
+	if err := updatePeeringTableIndexes(tx, idx, clone.PartitionOrDefault()); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
 // ExportedServicesForPeer returns the list of typical and proxy services
 // exported to a peer.
 //
@@ -730,6 +843,110 @@ func (s *Store) ExportedServicesForPeer(ws memdb.WatchSet, peerID string, dc str
 	return exportedServicesForPeerTxn(ws, tx, peering, dc)
 }
 
+// ExportedKeyPrefixesForPeer returns the sorted list of KV prefixes exported
+// to a peer.
+func (s *Store) ExportedKeyPrefixesForPeer(ws memdb.WatchSet, peerID string) (uint64, []string, error) {
+	tx := s.db.ReadTxn()
+	defer tx.Abort()
+
+	peering, err := peeringReadByIDTxn(tx, ws, peerID)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to read peering: %w", err)
+	}
+	if peering == nil {
+		return 0, nil, nil
+	}
+
+	maxIdx := peering.ModifyIndex
+
+	entMeta := structs.NodeEnterpriseMetaInPartition(peering.Partition)
+	idx, exportConf, err := getExportedServicesConfigEntryTxn(tx, ws, nil, entMeta)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to fetch exported-services config entry: %w", err)
+	}
+	if idx > maxIdx {
+		maxIdx = idx
+	}
+	if exportConf == nil {
+		return maxIdx, nil, nil
+	}
+
+	var prefixes []string
+	for _, kp := range exportConf.KeyPrefixes {
+		for _, consumer := range kp.Consumers {
+			if consumer.Peer == peering.Name {
+				prefixes = append(prefixes, kp.Prefix)
+				break
+			}
+		}
+	}
+	sort.Strings(prefixes)
+
+	return maxIdx, prefixes, nil
+}
+
+// ExportedConfigEntriesForPeer returns the config entries exported to a peer
+// alongside the services they are named after. Kinds listed in the
+// exported-services config entry that are not defined are skipped.
+func (s *Store) ExportedConfigEntriesForPeer(ws memdb.WatchSet, peerID string) (uint64, []structs.ConfigEntry, error) {
+	tx := s.db.ReadTxn()
+	defer tx.Abort()
+
+	peering, err := peeringReadByIDTxn(tx, ws, peerID)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to read peering: %w", err)
+	}
+	if peering == nil {
+		return 0, nil, nil
+	}
+
+	maxIdx := peering.ModifyIndex
+
+	entMeta := structs.NodeEnterpriseMetaInPartition(peering.Partition)
+	idx, exportConf, err := getExportedServicesConfigEntryTxn(tx, ws, nil, entMeta)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to fetch exported-services config entry: %w", err)
+	}
+	if idx > maxIdx {
+		maxIdx = idx
+	}
+	if exportConf == nil {
+		return maxIdx, nil, nil
+	}
+
+	var entries []structs.ConfigEntry
+	for _, svc := range exportConf.Services {
+		if len(svc.ConfigEntries) == 0 || !exportedToPeer(svc.Consumers, peering.Name) {
+			continue
+		}
+
+		svcEntMeta := acl.NewEnterpriseMetaWithPartition(entMeta.PartitionOrDefault(), svc.Namespace)
+		for _, kind := range svc.ConfigEntries {
+			idx, entry, err := configEntryTxn(tx, ws, kind, svc.Name, &svcEntMeta)
+			if err != nil {
+				return 0, nil, fmt.Errorf("failed to read %s config entry for service %q: %w", kind, svc.Name, err)
+			}
+			if idx > maxIdx {
+				maxIdx = idx
+			}
+			if entry != nil {
+				entries = append(entries, entry)
+			}
+		}
+	}
+
+	return maxIdx, entries, nil
+}
+
+func exportedToPeer(consumers []structs.ServiceConsumer, peerName string) bool {
+	for _, consumer := range consumers {
+		if consumer.Peer == peerName {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *Store) ExportedServicesForAllPeersByName(ws memdb.WatchSet, dc string, entMeta acl.EnterpriseMeta) (uint64, map[string]structs.ServiceList, error) {
 	tx := s.db.ReadTxn()
 	defer tx.Abort()
@@ -1347,6 +1564,110 @@ func (s *Store) PeeringTrustBundleDelete(idx uint64, q Query) error {
 	return tx.Commit()
 }
 
+// PeeringConfigEntriesRead returns the config entries imported from the peer
+// name given as the query value.
+func (s *Store) PeeringConfigEntriesRead(ws memdb.WatchSet, q Query) (uint64, *pbpeering.PeeringConfigEntries, error) {
+	tx := s.db.ReadTxn()
+	defer tx.Abort()
+
+	return peeringConfigEntriesReadTxn(tx, ws, q)
+}
+
+func peeringConfigEntriesReadTxn(tx ReadTxn, ws memdb.WatchSet, q Query) (uint64, *pbpeering.PeeringConfigEntries, error) {
+	watchCh, raw, err := tx.FirstWatch(tablePeeringConfigEntries, indexID, q)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed peering config entries lookup: %w", err)
+	}
+
+	pce, ok := raw.(*pbpeering.PeeringConfigEntries)
+	if raw != nil && !ok {
+		return 0, nil, fmt.Errorf("invalid type %T", raw)
+	}
+	ws.Add(watchCh)
+
+	if pce == nil {
+		// Return the tables index so caller can watch it for changes if the entries don't exist
+		return maxIndexWatchTxn(tx, ws, partitionedIndexEntryName(tablePeeringConfigEntries, q.PartitionOrDefault())), nil, nil
+	}
+	return pce.ModifyIndex, pce, nil
+}
+
+// PeeringConfigEntriesWrite writes the config entries imported from a peer to
+// the state store, replacing any previously imported set.
+// If there is no corresponding peering, then an error is returned.
+func (s *Store) PeeringConfigEntriesWrite(idx uint64, pce *pbpeering.PeeringConfigEntries) error {
+	tx := s.db.WriteTxn(idx)
+	defer tx.Abort()
+
+	if pce.PeerName == "" {
+		return errors.New("missing peer name")
+	}
+
+	q := Query{
+		Value:          pce.PeerName,
+		EnterpriseMeta: *structs.NodeEnterpriseMetaInPartition(pce.Partition),
+	}
+	_, existingPeering, err := peeringReadTxn(tx, nil, q)
+	if err != nil {
+		return err
+	}
+	if existingPeering == nil {
+		return fmt.Errorf("cannot write peering config entries for unknown peering %s", pce.PeerName)
+	}
+	if existingPeering.State == pbpeering.PeeringState_DELETING {
+		return fmt.Errorf("cannot write to peering that is marked for deletion")
+	}
+
+	existingRaw, err := tx.First(tablePeeringConfigEntries, indexID, q)
+	if err != nil {
+		return fmt.Errorf("failed peering config entries lookup: %w", err)
+	}
+	existing, ok := existingRaw.(*pbpeering.PeeringConfigEntries)
+	if existingRaw != nil && !ok {
+		return fmt.Errorf("invalid type %T", existingRaw)
+	}
+
+	if existing != nil {
+		pce.CreateIndex = existing.CreateIndex
+	} else {
+		pce.CreateIndex = idx
+	}
+	pce.ModifyIndex = idx
+
+	if err := tx.Insert(tablePeeringConfigEntries, pce); err != nil {
+		return fmt.Errorf("failed inserting peering config entries: %w", err)
+	}
+	if err := updatePeeringConfigEntriesTableIndexes(tx, idx, pce.PartitionOrDefault()); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// PeeringConfigEntriesDelete deletes the config entries imported from the
+// peer name given as the query value.
+func (s *Store) PeeringConfigEntriesDelete(idx uint64, q Query) error {
+	tx := s.db.WriteTxn(idx)
+	defer tx.Abort()
+
+	existing, err := tx.First(tablePeeringConfigEntries, indexID, q)
+	if err != nil {
+		return fmt.Errorf("failed peering config entries lookup: %v", err)
+	}
+
+	if existing == nil {
+		return nil
+	}
+
+	if err := tx.Delete(tablePeeringConfigEntries, existing); err != nil {
+		return fmt.Errorf("failed deleting peering config entries: %v", err)
+	}
+
+	if err := updatePeeringConfigEntriesTableIndexes(tx, idx, q.PartitionOrDefault()); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
 func (s *Snapshot) Peerings() (memdb.ResultIterator, error) {
 	return s.tx.Get(tablePeering, indexName)
 }
@@ -1355,6 +1676,10 @@ func (s *Snapshot) PeeringTrustBundles() (memdb.ResultIterator, error) {
 	return s.tx.Get(tablePeeringTrustBundles, indexID)
 }
 
+func (s *Snapshot) PeeringConfigEntries() (memdb.ResultIterator, error) {
+	return s.tx.Get(tablePeeringConfigEntries, indexID)
+}
+
 func (s *Snapshot) PeeringSecrets() (memdb.ResultIterator, error) {
 	return s.tx.Get(tablePeeringSecrets, indexID)
 }
@@ -1381,6 +1706,16 @@ func (r *Restore) PeeringTrustBundle(ptb *pbpeering.PeeringTrustBundle) error {
 	return nil
 }
 
+func (r *Restore) PeeringConfigEntries(pce *pbpeering.PeeringConfigEntries) error {
+	if err := r.tx.Insert(tablePeeringConfigEntries, pce); err != nil {
+		return fmt.Errorf("failed restoring peering config entries: %w", err)
+	}
+	if err := updatePeeringConfigEntriesTableIndexes(r.tx, pce.ModifyIndex, pce.PartitionOrDefault()); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (r *Restore) PeeringSecrets(p *pbpeering.PeeringSecrets) error {
 	if err := r.tx.Insert(tablePeeringSecrets, p); err != nil {
 		return fmt.Errorf("failed restoring peering secrets: %w", err)
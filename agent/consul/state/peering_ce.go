@@ -38,6 +38,16 @@ func indexFromPeeringTrustBundle(ptb *pbpeering.PeeringTrustBundle) ([]byte, err
 	return b.Bytes(), nil
 }
 
+func indexFromPeeringConfigEntries(pce *pbpeering.PeeringConfigEntries) ([]byte, error) {
+	if pce.PeerName == "" {
+		return nil, errMissingValueForIndex
+	}
+
+	var b indexBuilder
+	b.String(strings.ToLower(pce.PeerName))
+	return b.Bytes(), nil
+}
+
 func updatePeeringTableIndexes(tx WriteTxn, idx uint64, _ string) error {
 	if err := tx.Insert(tableIndex, &IndexEntry{Key: tablePeering, Value: idx}); err != nil {
 		return fmt.Errorf("failed updating table index: %w", err)
@@ -51,3 +61,10 @@ func updatePeeringTrustBundlesTableIndexes(tx WriteTxn, idx uint64, _ string) er
 	}
 	return nil
 }
+
+func updatePeeringConfigEntriesTableIndexes(tx WriteTxn, idx uint64, _ string) error {
+	if err := tx.Insert(tableIndex, &IndexEntry{Key: tablePeeringConfigEntries, Value: idx}); err != nil {
+		return fmt.Errorf("failed updating table index: %w", err)
+	}
+	return nil
+}
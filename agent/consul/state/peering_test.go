@@ -9,12 +9,14 @@ import (
 
 	"github.com/hashicorp/go-memdb"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/hashicorp/consul/acl"
 	"github.com/hashicorp/consul/agent/connect"
 	"github.com/hashicorp/consul/agent/structs"
 	"github.com/hashicorp/consul/proto/private/pbcommon"
+	"github.com/hashicorp/consul/proto/private/pbconfigentry"
 	"github.com/hashicorp/consul/proto/private/pbpeering"
 	"github.com/hashicorp/consul/proto/private/prototest"
 	"github.com/hashicorp/consul/sdk/testutil"
@@ -436,7 +438,15 @@ func TestStore_PeeringSecretsWrite(t *testing.T) {
 			expectErr: "invalid request type",
 		},
 		{
-			name: "dialing peer invalid request type - promote pending",
+			name: "no secret IDs were embedded when rotating stream secret",
+			input: &pbpeering.SecretsWriteRequest{
+				PeerID:  testFooPeerID,
+				Request: &pbpeering.SecretsWriteRequest_RotateStream{},
+			},
+			expectErr: "missing secret ID",
+		},
+		{
+			name: "dialing peer cannot promote unknown pending secret",
 			seed: &testSeed{
 				peering: &pbpeering.Peering{
 					Name:                "foo",
@@ -446,14 +456,98 @@ func TestStore_PeeringSecretsWrite(t *testing.T) {
 			},
 			input: &pbpeering.SecretsWriteRequest{
 				PeerID: testFooPeerID,
-				// Dialing peer must only write secrets from Establish
 				Request: &pbpeering.SecretsWriteRequest_PromotePending{
 					PromotePending: &pbpeering.SecretsWriteRequest_PromotePendingRequest{
 						ActiveStreamSecret: testFooSecretID,
 					},
 				},
 			},
-			expectErr: "invalid request type",
+			expectErr: "invalid pending stream secret",
+		},
+		{
+			name: "dialing peer cannot rotate without existing secrets",
+			seed: &testSeed{
+				peering: &pbpeering.Peering{
+					Name:                "foo",
+					ID:                  testFooPeerID,
+					PeerServerAddresses: []string{"10.0.0.1:5300"},
+				},
+			},
+			input: &pbpeering.SecretsWriteRequest{
+				PeerID: testFooPeerID,
+				Request: &pbpeering.SecretsWriteRequest_RotateStream{
+					RotateStream: &pbpeering.SecretsWriteRequest_RotateStreamRequest{
+						PendingStreamSecret: testFooSecretID,
+					},
+				},
+			},
+			expectErr: "no known secrets for peering",
+		},
+		{
+			name: "dialing peer stores rotated secret as pending",
+			seed: &testSeed{
+				peering: &pbpeering.Peering{
+					Name:                "foo",
+					ID:                  testFooPeerID,
+					PeerServerAddresses: []string{"10.0.0.1:5300"},
+				},
+				secrets: &pbpeering.PeeringSecrets{
+					PeerID: testFooPeerID,
+					Stream: &pbpeering.PeeringSecrets_Stream{
+						ActiveSecretID: testSecretOne,
+					},
+				},
+			},
+			input: &pbpeering.SecretsWriteRequest{
+				PeerID: testFooPeerID,
+				Request: &pbpeering.SecretsWriteRequest_RotateStream{
+					RotateStream: &pbpeering.SecretsWriteRequest_RotateStreamRequest{
+						PendingStreamSecret: testSecretTwo,
+					},
+				},
+			},
+			expect: &pbpeering.PeeringSecrets{
+				PeerID: testFooPeerID,
+				Stream: &pbpeering.PeeringSecrets_Stream{
+					ActiveSecretID:  testSecretOne,
+					PendingSecretID: testSecretTwo,
+				},
+			},
+			// Only the seeded UUID is present, the rotated secret is not tracked.
+			expectUUIDs: []string{testSecretOne},
+		},
+		{
+			name: "dialing peer promotes pending secret",
+			seed: &testSeed{
+				peering: &pbpeering.Peering{
+					Name:                "foo",
+					ID:                  testFooPeerID,
+					PeerServerAddresses: []string{"10.0.0.1:5300"},
+				},
+				secrets: &pbpeering.PeeringSecrets{
+					PeerID: testFooPeerID,
+					Stream: &pbpeering.PeeringSecrets_Stream{
+						ActiveSecretID:  testSecretOne,
+						PendingSecretID: testSecretTwo,
+					},
+				},
+			},
+			input: &pbpeering.SecretsWriteRequest{
+				PeerID: testFooPeerID,
+				Request: &pbpeering.SecretsWriteRequest_PromotePending{
+					PromotePending: &pbpeering.SecretsWriteRequest_PromotePendingRequest{
+						ActiveStreamSecret: testSecretTwo,
+					},
+				},
+			},
+			expect: &pbpeering.PeeringSecrets{
+				PeerID: testFooPeerID,
+				Stream: &pbpeering.PeeringSecrets_Stream{
+					ActiveSecretID: testSecretTwo,
+				},
+			},
+			// Only the seeded UUIDs are present, dialing peers do not update them.
+			expectUUIDs: []string{testSecretOne, testSecretTwo},
 		},
 		{
 			name: "dialing peer does not track UUIDs",
@@ -799,6 +893,102 @@ func TestStore_PeeringSecretsWrite(t *testing.T) {
 			},
 			expectUUIDs: []string{testSecretTwo, testSecretThree},
 		},
+		{
+			name: "cannot rotate stream secret without active secret",
+			seed: &testSeed{
+				peering: &pbpeering.Peering{
+					Name: "foo",
+					ID:   testFooPeerID,
+				},
+				secrets: &pbpeering.PeeringSecrets{
+					PeerID: testFooPeerID,
+					Establishment: &pbpeering.PeeringSecrets_Establishment{
+						SecretID: testSecretOne,
+					},
+				},
+			},
+			input: &pbpeering.SecretsWriteRequest{
+				PeerID: testFooPeerID,
+				Request: &pbpeering.SecretsWriteRequest_RotateStream{
+					RotateStream: &pbpeering.SecretsWriteRequest_RotateStreamRequest{
+						PendingStreamSecret: testSecretTwo,
+					},
+				},
+			},
+			expectErr: "peering was not established yet",
+		},
+		{
+			name: "rotate stream secret to generate new pending secret",
+			seed: &testSeed{
+				peering: &pbpeering.Peering{
+					Name: "foo",
+					ID:   testFooPeerID,
+				},
+				secrets: &pbpeering.PeeringSecrets{
+					PeerID: testFooPeerID,
+					Establishment: &pbpeering.PeeringSecrets_Establishment{
+						SecretID: testSecretOne,
+					},
+					Stream: &pbpeering.PeeringSecrets_Stream{
+						ActiveSecretID: testSecretTwo,
+					},
+				},
+			},
+			input: &pbpeering.SecretsWriteRequest{
+				PeerID: testFooPeerID,
+				Request: &pbpeering.SecretsWriteRequest_RotateStream{
+					RotateStream: &pbpeering.SecretsWriteRequest_RotateStreamRequest{
+						PendingStreamSecret: testSecretThree,
+					},
+				},
+			},
+			expect: &pbpeering.PeeringSecrets{
+				PeerID: testFooPeerID,
+				Establishment: &pbpeering.PeeringSecrets_Establishment{
+					// Establishment secret remains valid when rotating a stream secret.
+					SecretID: testSecretOne,
+				},
+				Stream: &pbpeering.PeeringSecrets_Stream{
+					// Active secret remains valid until the pending secret is promoted.
+					ActiveSecretID:  testSecretTwo,
+					PendingSecretID: testSecretThree,
+				},
+			},
+			expectUUIDs: []string{testSecretOne, testSecretTwo, testSecretThree},
+		},
+		{
+			name: "rotate stream secret replaces pending stream secret",
+			seed: &testSeed{
+				peering: &pbpeering.Peering{
+					Name: "foo",
+					ID:   testFooPeerID,
+				},
+				secrets: &pbpeering.PeeringSecrets{
+					PeerID: testFooPeerID,
+					Stream: &pbpeering.PeeringSecrets_Stream{
+						ActiveSecretID:  testSecretOne,
+						PendingSecretID: testSecretTwo,
+					},
+				},
+			},
+			input: &pbpeering.SecretsWriteRequest{
+				PeerID: testFooPeerID,
+				Request: &pbpeering.SecretsWriteRequest_RotateStream{
+					RotateStream: &pbpeering.SecretsWriteRequest_RotateStreamRequest{
+						PendingStreamSecret: testSecretThree,
+					},
+				},
+			},
+			expect: &pbpeering.PeeringSecrets{
+				PeerID: testFooPeerID,
+				Stream: &pbpeering.PeeringSecrets_Stream{
+					ActiveSecretID:  testSecretOne,
+					PendingSecretID: testSecretThree,
+				},
+			},
+			// Two is no longer in use and gets deleted.
+			expectUUIDs: []string{testSecretOne, testSecretThree},
+		},
 	}
 	for _, tc := range tcs {
 		t.Run(tc.name, func(t *testing.T) {
@@ -1601,6 +1791,38 @@ func TestStore_PeeringTerminateByID(t *testing.T) {
 	require.Equal(t, pbpeering.PeeringState_TERMINATED, p.State)
 }
 
+func TestStore_PeeringRTTWrite(t *testing.T) {
+	s := NewStateStore(nil)
+	insertTestPeerings(t, s)
+
+	// id corresponding to default/foo
+	const id = testFooPeerID
+
+	_, before, err := s.PeeringReadByID(nil, id)
+	require.NoError(t, err)
+
+	require.NoError(t, s.PeeringRTTWrite(10, id, durationpb.New(25*time.Millisecond)))
+
+	_, p, err := s.PeeringReadByID(nil, id)
+	require.NoError(t, err)
+	require.Equal(t, 25*time.Millisecond, p.RTT.AsDuration())
+	require.Equal(t, uint64(10), p.ModifyIndex)
+
+	// Nothing but the RTT and the modify index is changed.
+	p.RTT = nil
+	p.ModifyIndex = before.ModifyIndex
+	prototest.AssertDeepEqual(t, before, p)
+
+	require.NoError(t, s.PeeringRTTWrite(11, id, nil))
+
+	_, p, err = s.PeeringReadByID(nil, id)
+	require.NoError(t, err)
+	require.Nil(t, p.RTT)
+
+	// Writing the RTT of an unknown peering is a no-op.
+	require.NoError(t, s.PeeringRTTWrite(12, testUUID(), durationpb.New(time.Second)))
+}
+
 func TestStateStore_PeeringTrustBundleList(t *testing.T) {
 	s := NewStateStore(nil)
 	insertTestPeeringTrustBundles(t, s)
@@ -1780,6 +2002,74 @@ func TestStore_PeeringTrustBundleDelete(t *testing.T) {
 	require.Nil(t, ptb)
 }
 
+func TestStore_PeeringConfigEntries(t *testing.T) {
+	s := NewStateStore(nil)
+
+	newEntries := func(protocol string) *pbpeering.PeeringConfigEntries {
+		return &pbpeering.PeeringConfigEntries{
+			PeerName: "my-peering",
+			Entries: []*pbconfigentry.ConfigEntry{
+				pbconfigentry.ConfigEntryFromStructs(&structs.ServiceConfigEntry{
+					Kind:     structs.ServiceDefaults,
+					Name:     "web",
+					Protocol: protocol,
+				}),
+			},
+		}
+	}
+	q := Query{Value: "my-peering"}
+
+	testutil.RunStep(t, "write for unknown peering fails", func(t *testing.T) {
+		err := s.PeeringConfigEntriesWrite(1, newEntries("http"))
+		require.ErrorContains(t, err, "cannot write peering config entries for unknown peering my-peering")
+	})
+
+	require.NoError(t, s.PeeringWrite(2, &pbpeering.PeeringWriteRequest{
+		Peering: &pbpeering.Peering{
+			ID:   testUUID(),
+			Name: "my-peering",
+		},
+	}))
+
+	testutil.RunStep(t, "write and read", func(t *testing.T) {
+		ws := memdb.NewWatchSet()
+		idx, pce, err := s.PeeringConfigEntriesRead(ws, q)
+		require.NoError(t, err)
+		require.Nil(t, pce)
+		require.Zero(t, idx)
+
+		require.NoError(t, s.PeeringConfigEntriesWrite(3, newEntries("http")))
+		require.True(t, watchFired(ws))
+
+		idx, pce, err = s.PeeringConfigEntriesRead(nil, q)
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), idx)
+		require.Equal(t, uint64(3), pce.CreateIndex)
+		require.Len(t, pce.Entries, 1)
+	})
+
+	testutil.RunStep(t, "overwrite keeps create index", func(t *testing.T) {
+		require.NoError(t, s.PeeringConfigEntriesWrite(4, newEntries("grpc")))
+
+		idx, pce, err := s.PeeringConfigEntriesRead(nil, q)
+		require.NoError(t, err)
+		require.Equal(t, uint64(4), idx)
+		require.Equal(t, uint64(3), pce.CreateIndex)
+
+		entry := pbconfigentry.ConfigEntryToStructs(pce.Entries[0])
+		require.Equal(t, "grpc", entry.(*structs.ServiceConfigEntry).Protocol)
+	})
+
+	testutil.RunStep(t, "delete", func(t *testing.T) {
+		require.NoError(t, s.PeeringConfigEntriesDelete(5, q))
+
+		idx, pce, err := s.PeeringConfigEntriesRead(nil, q)
+		require.NoError(t, err)
+		require.Nil(t, pce)
+		require.Equal(t, uint64(5), idx)
+	})
+}
+
 func TestStateStore_ExportedServicesForAllPeersByName(t *testing.T) {
 	s := NewStateStore(nil)
 	var lastIdx uint64
@@ -2303,6 +2593,175 @@ func TestStateStore_ExportedServicesForPeer(t *testing.T) {
 	})
 }
 
+func TestStateStore_ExportedKeyPrefixesForPeer(t *testing.T) {
+	s := NewStateStore(nil)
+
+	var lastIdx uint64
+
+	lastIdx++
+	require.NoError(t, s.PeeringWrite(lastIdx, &pbpeering.PeeringWriteRequest{
+		Peering: &pbpeering.Peering{
+			ID:   testUUID(),
+			Name: "my-peering",
+		},
+	}))
+
+	_, p, err := s.PeeringRead(nil, Query{
+		Value: "my-peering",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, p)
+
+	ws := memdb.NewWatchSet()
+
+	testutil.RunStep(t, "no exported key prefixes", func(t *testing.T) {
+		idx, prefixes, err := s.ExportedKeyPrefixesForPeer(ws, p.ID)
+		require.NoError(t, err)
+		require.Equal(t, lastIdx, idx)
+		require.Empty(t, prefixes)
+	})
+
+	testutil.RunStep(t, "only prefixes exported to the peer are returned", func(t *testing.T) {
+		entry := &structs.ExportedServicesConfigEntry{
+			Name: "default",
+			KeyPrefixes: []structs.ExportedKeyPrefix{
+				{
+					Prefix:    "web/",
+					Consumers: []structs.ServiceConsumer{{Peer: "my-peering"}},
+				},
+				{
+					Prefix:    "db/",
+					Consumers: []structs.ServiceConsumer{{Peer: "other-peering"}},
+				},
+				{
+					Prefix: "app/",
+					Consumers: []structs.ServiceConsumer{
+						{Peer: "other-peering"},
+						{Peer: "my-peering"},
+					},
+				},
+			},
+		}
+		lastIdx++
+		require.NoError(t, s.EnsureConfigEntry(lastIdx, entry))
+
+		require.True(t, watchFired(ws))
+		ws = memdb.NewWatchSet()
+
+		idx, prefixes, err := s.ExportedKeyPrefixesForPeer(ws, p.ID)
+		require.NoError(t, err)
+		require.Equal(t, lastIdx, idx)
+		require.Equal(t, []string{"app/", "web/"}, prefixes)
+	})
+
+	testutil.RunStep(t, "unknown peering", func(t *testing.T) {
+		idx, prefixes, err := s.ExportedKeyPrefixesForPeer(nil, testUUID())
+		require.NoError(t, err)
+		require.Zero(t, idx)
+		require.Empty(t, prefixes)
+	})
+}
+
+func TestStateStore_ExportedConfigEntriesForPeer(t *testing.T) {
+	s := NewStateStore(nil)
+
+	var lastIdx uint64
+
+	lastIdx++
+	require.NoError(t, s.PeeringWrite(lastIdx, &pbpeering.PeeringWriteRequest{
+		Peering: &pbpeering.Peering{
+			ID:   testUUID(),
+			Name: "my-peering",
+		},
+	}))
+
+	_, p, err := s.PeeringRead(nil, Query{
+		Value: "my-peering",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, p)
+
+	ensureConfigEntry := func(t *testing.T, entry structs.ConfigEntry) {
+		t.Helper()
+		require.NoError(t, entry.Normalize())
+		require.NoError(t, entry.Validate())
+
+		lastIdx++
+		require.NoError(t, s.EnsureConfigEntry(lastIdx, entry))
+	}
+
+	ensureConfigEntry(t, &structs.ServiceConfigEntry{
+		Kind:     structs.ServiceDefaults,
+		Name:     "web",
+		Protocol: "http",
+	})
+	ensureConfigEntry(t, &structs.ServiceConfigEntry{
+		Kind:     structs.ServiceDefaults,
+		Name:     "db",
+		Protocol: "tcp",
+	})
+
+	ws := memdb.NewWatchSet()
+
+	testutil.RunStep(t, "no exported config entries", func(t *testing.T) {
+		idx, entries, err := s.ExportedConfigEntriesForPeer(ws, p.ID)
+		require.NoError(t, err)
+		require.Equal(t, lastIdx, idx)
+		require.Empty(t, entries)
+	})
+
+	testutil.RunStep(t, "only entries exported to the peer are returned", func(t *testing.T) {
+		ensureConfigEntry(t, &structs.ExportedServicesConfigEntry{
+			Name: "default",
+			Services: []structs.ExportedService{
+				{
+					Name:          "web",
+					Consumers:     []structs.ServiceConsumer{{Peer: "my-peering"}},
+					ConfigEntries: []string{structs.ServiceDefaults, structs.ServiceResolver},
+				},
+				{
+					Name:          "db",
+					Consumers:     []structs.ServiceConsumer{{Peer: "other-peering"}},
+					ConfigEntries: []string{structs.ServiceDefaults},
+				},
+			},
+		})
+
+		require.True(t, watchFired(ws))
+		ws = memdb.NewWatchSet()
+
+		idx, entries, err := s.ExportedConfigEntriesForPeer(ws, p.ID)
+		require.NoError(t, err)
+		require.Equal(t, lastIdx, idx)
+		require.Len(t, entries, 1)
+		require.Equal(t, structs.ServiceDefaults, entries[0].GetKind())
+		require.Equal(t, "web", entries[0].GetName())
+	})
+
+	testutil.RunStep(t, "writing an exported entry fires the watch", func(t *testing.T) {
+		ensureConfigEntry(t, &structs.ServiceResolverConfigEntry{
+			Kind:           structs.ServiceResolver,
+			Name:           "web",
+			ConnectTimeout: 5 * time.Second,
+		})
+
+		require.True(t, watchFired(ws))
+
+		idx, entries, err := s.ExportedConfigEntriesForPeer(nil, p.ID)
+		require.NoError(t, err)
+		require.Equal(t, lastIdx, idx)
+		require.Len(t, entries, 2)
+		require.Equal(t, structs.ServiceResolver, entries[1].GetKind())
+	})
+
+	testutil.RunStep(t, "unknown peering", func(t *testing.T) {
+		idx, entries, err := s.ExportedConfigEntriesForPeer(nil, testUUID())
+		require.NoError(t, err)
+		require.Zero(t, idx)
+		require.Empty(t, entries)
+	})
+}
+
 func TestStateStore_PeeringsForService(t *testing.T) {
 	type testPeering struct {
 		peering *pbpeering.Peering
@@ -20,6 +20,9 @@ func newDBSchema() *memdb.DBSchema {
 		bindingRulesTableSchema,
 		caBuiltinProviderTableSchema,
 		caConfigTableSchema,
+		caLeafCertTableSchema,
+		caRevocationListTableSchema,
+		caRevokedCertTableSchema,
 		caRootTableSchema,
 		checksTableSchema,
 		configTableSchema,
@@ -35,6 +38,7 @@ func newDBSchema() *memdb.DBSchema {
 		nodesTableSchema,
 		peeringTableSchema,
 		peeringTrustBundlesTableSchema,
+		peeringConfigEntriesTableSchema,
 		peeringSecretsTableSchema,
 		peeringSecretUUIDsTableSchema,
 		policiesTableSchema,
@@ -0,0 +1,209 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package compression
+
+import (
+	"fmt"
+
+	envoy_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_brotli_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/brotli/compressor/v3"
+	envoy_gzip_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/gzip/compressor/v3"
+	envoy_zstd_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/zstd/compressor/v3"
+	envoy_compressor_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/compressor/v3"
+	"github.com/hashicorp/go-multierror"
+	"github.com/mitchellh/mapstructure"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/hashicorp/consul/api"
+	ext_cmn "github.com/hashicorp/consul/envoyextensions/extensioncommon"
+)
+
+const (
+	algorithmGzip   = "gzip"
+	algorithmBrotli = "brotli"
+	algorithmZstd   = "zstd"
+)
+
+// compressorLibraries maps each supported algorithm to the name and config of
+// its Envoy compressor library.
+var compressorLibraries = map[string]struct {
+	name   string
+	config proto.Message
+}{
+	algorithmGzip:   {"envoy.compression.gzip.compressor", &envoy_gzip_v3.Gzip{}},
+	algorithmBrotli: {"envoy.compression.brotli.compressor", &envoy_brotli_v3.Brotli{}},
+	algorithmZstd:   {"envoy.compression.zstd.compressor", &envoy_zstd_v3.Zstd{}},
+}
+
+type compression struct {
+	ext_cmn.BasicExtensionAdapter
+
+	// ProxyType identifies the type of Envoy proxy that this extension applies to.
+	// The extension will only be configured for proxies that match this type and
+	// will be ignored for all other proxy types.
+	ProxyType api.ServiceKind
+	// ListenerType controls which listener the extension applies to. It supports "inbound" or "outbound" listeners.
+	ListenerType string
+
+	// Algorithms lists the compression algorithms in order of preference. Envoy
+	// compresses responses with the first algorithm accepted by the client.
+	Algorithms []string
+	// MinContentLength is the minimum response size, in bytes, to compress.
+	MinContentLength *int
+	// ContentTypes lists the response content types to compress. Envoy uses a
+	// default list of common text types when empty.
+	ContentTypes []string
+	// DisableOnETagHeader disables compression of responses with an ETag header.
+	DisableOnETagHeader bool
+	// RemoveAcceptEncodingHeader removes the Accept-Encoding header from requests
+	// so that the upstream does not compress responses itself.
+	RemoveAcceptEncodingHeader bool
+}
+
+var _ ext_cmn.BasicExtension = (*compression)(nil)
+
+// Constructor follows a specific function signature required for the extension registration.
+func Constructor(ext api.EnvoyExtension) (ext_cmn.EnvoyExtender, error) {
+	c := &compression{}
+	if ext.Name != api.BuiltinCompressionExtension {
+		return nil, fmt.Errorf("expected extension name %q but got %q", api.BuiltinCompressionExtension, ext.Name)
+	}
+	if err := c.fromArguments(ext.Arguments); err != nil {
+		return nil, err
+	}
+	return &ext_cmn.BasicEnvoyExtender{
+		Extension: c,
+	}, nil
+}
+
+// CanApply indicates if the compression extension can be applied to the given extension runtime configuration.
+func (c *compression) CanApply(config *ext_cmn.RuntimeConfig) bool {
+	return config.Kind == c.ProxyType
+}
+
+func (c *compression) matchesListenerDirection(isInboundListener bool) bool {
+	return (!isInboundListener && c.ListenerType == "outbound") || (isInboundListener && c.ListenerType == "inbound")
+}
+
+// PatchFilters inserts a compressor filter for each configured algorithm into the filter chain
+// of the HTTP connection manager. Listeners without an HTTP connection manager are not modified.
+func (c *compression) PatchFilters(_ *ext_cmn.RuntimeConfig, filters []*envoy_listener_v3.Filter, isInboundListener bool) ([]*envoy_listener_v3.Filter, error) {
+	if !c.matchesListenerDirection(isInboundListener) {
+		return filters, nil
+	}
+	if _, _, err := ext_cmn.GetHTTPConnectionManager(filters...); err != nil {
+		return filters, nil
+	}
+
+	for i, algorithm := range c.Algorithms {
+		compressor, err := c.toEnvoyCompressor(algorithm, i == 0)
+		if err != nil {
+			return filters, err
+		}
+		compressorFilter, err := ext_cmn.MakeEnvoyHTTPFilter("envoy.filters.http.compressor."+algorithm, compressor)
+		if err != nil {
+			return filters, err
+		}
+		filters, err = ext_cmn.InsertHTTPFilter(filters, compressorFilter, ext_cmn.InsertOptions{
+			Location:   ext_cmn.InsertBeforeFirstMatch,
+			FilterName: "envoy.filters.http.router",
+		})
+		if err != nil {
+			return filters, err
+		}
+	}
+	return filters, nil
+}
+
+func (c *compression) toEnvoyCompressor(algorithm string, first bool) (*envoy_compressor_v3.Compressor, error) {
+	library := compressorLibraries[algorithm]
+	libraryConfig, err := anypb.New(library.config)
+	if err != nil {
+		return nil, err
+	}
+
+	commonConfig := &envoy_compressor_v3.Compressor_CommonDirectionConfig{
+		ContentType: c.ContentTypes,
+	}
+	if c.MinContentLength != nil {
+		commonConfig.MinContentLength = wrapperspb.UInt32(uint32(*c.MinContentLength))
+	}
+
+	return &envoy_compressor_v3.Compressor{
+		CompressorLibrary: &envoy_core_v3.TypedExtensionConfig{
+			Name:        library.name,
+			TypedConfig: libraryConfig,
+		},
+		ResponseDirectionConfig: &envoy_compressor_v3.Compressor_ResponseDirectionConfig{
+			CommonConfig:               commonConfig,
+			DisableOnEtagHeader:        c.DisableOnETagHeader,
+			RemoveAcceptEncodingHeader: c.RemoveAcceptEncodingHeader,
+		},
+		// Prefer the algorithms in the configured order when the client
+		// accepts several of them equally.
+		ChooseFirst: first,
+	}, nil
+}
+
+func (c *compression) fromArguments(args map[string]any) error {
+	if err := mapstructure.Decode(args, c); err != nil {
+		return fmt.Errorf("error decoding extension arguments: %v", err)
+	}
+	c.normalize()
+	return c.validate()
+}
+
+func (c *compression) normalize() {
+	if c.ProxyType == "" {
+		c.ProxyType = api.ServiceKindConnectProxy
+	}
+
+	if c.ListenerType == "" {
+		c.ListenerType = "inbound"
+	}
+
+	if len(c.Algorithms) == 0 {
+		c.Algorithms = []string{algorithmGzip}
+	}
+}
+
+func (c *compression) validate() error {
+	var resultErr error
+	if c.ProxyType != api.ServiceKindConnectProxy {
+		resultErr = multierror.Append(resultErr, fmt.Errorf("unsupported ProxyType %q, only %q is supported",
+			c.ProxyType,
+			api.ServiceKindConnectProxy))
+	}
+
+	if c.ListenerType != "inbound" && c.ListenerType != "outbound" {
+		resultErr = multierror.Append(resultErr, fmt.Errorf(`unexpected ListenerType %q, supported values are "inbound" or "outbound"`, c.ListenerType))
+	}
+
+	seen := make(map[string]bool, len(c.Algorithms))
+	for i, algorithm := range c.Algorithms {
+		if _, ok := compressorLibraries[algorithm]; !ok {
+			resultErr = multierror.Append(resultErr, fmt.Errorf(`unsupported Algorithms[%d] %q, supported values are "gzip", "brotli" or "zstd"`, i, algorithm))
+			continue
+		}
+		if seen[algorithm] {
+			resultErr = multierror.Append(resultErr, fmt.Errorf("duplicate Algorithms[%d] %q", i, algorithm))
+		}
+		seen[algorithm] = true
+	}
+
+	if c.MinContentLength != nil && (*c.MinContentLength < 0 || int64(*c.MinContentLength) > int64(^uint32(0))) {
+		resultErr = multierror.Append(resultErr, fmt.Errorf("MinContentLength must be between 0 and %d", ^uint32(0)))
+	}
+
+	for i, contentType := range c.ContentTypes {
+		if contentType == "" {
+			resultErr = multierror.Append(resultErr, fmt.Errorf("ContentTypes[%d] must not be empty", i))
+		}
+	}
+
+	return resultErr
+}
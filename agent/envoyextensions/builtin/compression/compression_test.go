@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package compression
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/envoyextensions/extensioncommon"
+)
+
+func TestConstructor(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		extName  string
+		args     map[string]any
+		expected *compression
+		errMsg   string
+	}{
+		"invalid name": {
+			extName: "invalid",
+			errMsg:  `expected extension name "builtin/compression"`,
+		},
+		"invalid proxy type": {
+			args:   map[string]any{"ProxyType": "invalid"},
+			errMsg: `unsupported ProxyType`,
+		},
+		"invalid listener type": {
+			args:   map[string]any{"ListenerType": "invalid"},
+			errMsg: `unexpected ListenerType "invalid"`,
+		},
+		"unsupported algorithm": {
+			args:   map[string]any{"Algorithms": []string{"gzip", "lzma"}},
+			errMsg: `unsupported Algorithms[1] "lzma"`,
+		},
+		"duplicate algorithm": {
+			args:   map[string]any{"Algorithms": []string{"gzip", "zstd", "gzip"}},
+			errMsg: `duplicate Algorithms[2] "gzip"`,
+		},
+		"negative min content length": {
+			args:   map[string]any{"MinContentLength": -1},
+			errMsg: `MinContentLength must be between 0 and 4294967295`,
+		},
+		"empty content type": {
+			args:   map[string]any{"ContentTypes": []string{"text/html", ""}},
+			errMsg: `ContentTypes[1] must not be empty`,
+		},
+		"defaults": {
+			expected: &compression{
+				ProxyType:    api.ServiceKindConnectProxy,
+				ListenerType: "inbound",
+				Algorithms:   []string{"gzip"},
+			},
+		},
+		"valid": {
+			args: map[string]any{
+				"ListenerType":               "outbound",
+				"Algorithms":                 []string{"zstd", "brotli", "gzip"},
+				"MinContentLength":           1024,
+				"ContentTypes":               []string{"application/json"},
+				"DisableOnETagHeader":        true,
+				"RemoveAcceptEncodingHeader": true,
+			},
+			expected: &compression{
+				ProxyType:                  api.ServiceKindConnectProxy,
+				ListenerType:               "outbound",
+				Algorithms:                 []string{"zstd", "brotli", "gzip"},
+				MinContentLength:           intPtr(1024),
+				ContentTypes:               []string{"application/json"},
+				DisableOnETagHeader:        true,
+				RemoveAcceptEncodingHeader: true,
+			},
+		},
+	}
+	for name, c := range cases {
+		c := c
+		t.Run(name, func(t *testing.T) {
+			extName := api.BuiltinCompressionExtension
+			if c.extName != "" {
+				extName = c.extName
+			}
+			ext, err := Constructor(api.EnvoyExtension{Name: extName, Arguments: c.args})
+			if c.errMsg == "" {
+				require.NoError(t, err)
+				require.Equal(t, &extensioncommon.BasicEnvoyExtender{Extension: c.expected}, ext)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), c.errMsg)
+			}
+		})
+	}
+}
+
+func intPtr(i int) *int {
+	return &i
+}
@@ -0,0 +1,258 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cors
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	envoy_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_cors_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/cors/v3"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/hashicorp/go-multierror"
+	"github.com/mitchellh/mapstructure"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/hashicorp/consul/api"
+	ext_cmn "github.com/hashicorp/consul/envoyextensions/extensioncommon"
+)
+
+const corsFilterName = "envoy.filters.http.cors"
+
+type cors struct {
+	ext_cmn.BasicExtensionAdapter
+
+	// ProxyType identifies the type of Envoy proxy that this extension applies to.
+	// The extension will only be configured for proxies that match this type and
+	// will be ignored for all other proxy types.
+	ProxyType api.ServiceKind
+	// ListenerType controls which listener the extension applies to. It supports "inbound" or "outbound" listeners.
+	ListenerType string
+
+	// AllowOrigins lists the origins that are allowed to make cross-origin requests.
+	// An origin of "*" allows all origins.
+	AllowOrigins []string
+	// AllowOriginRegexes lists regular expressions matching the origins that are
+	// allowed to make cross-origin requests.
+	AllowOriginRegexes []string
+	// AllowMethods lists the methods allowed in cross-origin requests.
+	AllowMethods []string
+	// AllowHeaders lists the request headers allowed in cross-origin requests.
+	AllowHeaders []string
+	// ExposeHeaders lists the response headers that browsers may expose to scripts.
+	ExposeHeaders []string
+	// MaxAge is the number of seconds that browsers may cache the result of a
+	// preflight request.
+	MaxAge *int
+	// AllowCredentials indicates whether cross-origin requests may include credentials.
+	AllowCredentials bool
+	// AllowPrivateNetworkAccess indicates whether preflight requests for
+	// private network access are allowed.
+	AllowPrivateNetworkAccess bool
+}
+
+var _ ext_cmn.BasicExtension = (*cors)(nil)
+
+// Constructor follows a specific function signature required for the extension registration.
+func Constructor(ext api.EnvoyExtension) (ext_cmn.EnvoyExtender, error) {
+	c := &cors{}
+	if ext.Name != api.BuiltinCORSExtension {
+		return nil, fmt.Errorf("expected extension name %q but got %q", api.BuiltinCORSExtension, ext.Name)
+	}
+	if err := c.fromArguments(ext.Arguments); err != nil {
+		return nil, err
+	}
+	return &ext_cmn.BasicEnvoyExtender{
+		Extension: c,
+	}, nil
+}
+
+// CanApply indicates if the CORS extension can be applied to the given extension runtime configuration.
+func (c *cors) CanApply(config *ext_cmn.RuntimeConfig) bool {
+	return config.Kind == c.ProxyType
+}
+
+func (c *cors) matchesListenerDirection(isInboundListener bool) bool {
+	return (!isInboundListener && c.ListenerType == "outbound") || (isInboundListener && c.ListenerType == "inbound")
+}
+
+// PatchFilters inserts a CORS filter into the filter chain of the HTTP connection manager and
+// sets the CORS policy on its inline route configuration, if any. Listeners without an HTTP
+// connection manager are not modified.
+func (c *cors) PatchFilters(_ *ext_cmn.RuntimeConfig, filters []*envoy_listener_v3.Filter, isInboundListener bool) ([]*envoy_listener_v3.Filter, error) {
+	if !c.matchesListenerDirection(isInboundListener) {
+		return filters, nil
+	}
+	if _, _, err := ext_cmn.GetHTTPConnectionManager(filters...); err != nil {
+		return filters, nil
+	}
+
+	corsFilter, err := ext_cmn.MakeEnvoyHTTPFilter(corsFilterName, &envoy_cors_v3.Cors{})
+	if err != nil {
+		return filters, err
+	}
+	filters, err = ext_cmn.InsertHTTPFilter(filters, corsFilter, ext_cmn.InsertOptions{
+		Location:   ext_cmn.InsertBeforeFirstMatch,
+		FilterName: "envoy.filters.http.router",
+	})
+	if err != nil {
+		return filters, err
+	}
+
+	return ext_cmn.PatchInlineRouteConfiguration(filters, c.patchRouteConfiguration)
+}
+
+// PatchRoute sets the CORS policy on route configurations fetched with RDS.
+func (c *cors) PatchRoute(p ext_cmn.RoutePayload) (*envoy_route_v3.RouteConfiguration, bool, error) {
+	if !c.matchesListenerDirection(p.IsInbound()) {
+		return p.Message, false, nil
+	}
+
+	routeConfig := proto.Clone(p.Message).(*envoy_route_v3.RouteConfiguration)
+	if err := c.patchRouteConfiguration(routeConfig); err != nil {
+		return p.Message, false, err
+	}
+	return routeConfig, true, nil
+}
+
+// patchRouteConfiguration sets the CORS policy on every virtual host of the route configuration.
+func (c *cors) patchRouteConfiguration(routeConfig *envoy_route_v3.RouteConfiguration) error {
+	policy, err := anypb.New(c.toEnvoyCorsPolicy())
+	if err != nil {
+		return err
+	}
+	for _, vh := range routeConfig.VirtualHosts {
+		if vh.TypedPerFilterConfig == nil {
+			vh.TypedPerFilterConfig = make(map[string]*anypb.Any)
+		}
+		vh.TypedPerFilterConfig[corsFilterName] = policy
+	}
+	return nil
+}
+
+func (c *cors) toEnvoyCorsPolicy() *envoy_cors_v3.CorsPolicy {
+	policy := &envoy_cors_v3.CorsPolicy{
+		AllowMethods:  strings.Join(c.AllowMethods, ","),
+		AllowHeaders:  strings.Join(c.AllowHeaders, ","),
+		ExposeHeaders: strings.Join(c.ExposeHeaders, ","),
+	}
+	for _, origin := range c.AllowOrigins {
+		policy.AllowOriginStringMatch = append(policy.AllowOriginStringMatch, &envoy_type_matcher_v3.StringMatcher{
+			MatchPattern: &envoy_type_matcher_v3.StringMatcher_Exact{Exact: origin},
+		})
+	}
+	for _, regex := range c.AllowOriginRegexes {
+		policy.AllowOriginStringMatch = append(policy.AllowOriginStringMatch, &envoy_type_matcher_v3.StringMatcher{
+			MatchPattern: &envoy_type_matcher_v3.StringMatcher_SafeRegex{
+				SafeRegex: &envoy_type_matcher_v3.RegexMatcher{Regex: regex},
+			},
+		})
+	}
+	if c.MaxAge != nil {
+		policy.MaxAge = strconv.Itoa(*c.MaxAge)
+	}
+	if c.AllowCredentials {
+		policy.AllowCredentials = wrapperspb.Bool(true)
+	}
+	if c.AllowPrivateNetworkAccess {
+		policy.AllowPrivateNetworkAccess = wrapperspb.Bool(true)
+	}
+	return policy
+}
+
+func (c *cors) fromArguments(args map[string]any) error {
+	if err := mapstructure.Decode(args, c); err != nil {
+		return fmt.Errorf("error decoding extension arguments: %v", err)
+	}
+	c.normalize()
+	return c.validate()
+}
+
+func (c *cors) normalize() {
+	if c.ProxyType == "" {
+		c.ProxyType = api.ServiceKindConnectProxy
+	}
+
+	if c.ListenerType == "" {
+		c.ListenerType = "inbound"
+	}
+
+	for i, method := range c.AllowMethods {
+		c.AllowMethods[i] = strings.ToUpper(method)
+	}
+}
+
+func (c *cors) validate() error {
+	var resultErr error
+	if c.ProxyType != api.ServiceKindConnectProxy {
+		resultErr = multierror.Append(resultErr, fmt.Errorf("unsupported ProxyType %q, only %q is supported",
+			c.ProxyType,
+			api.ServiceKindConnectProxy))
+	}
+
+	if c.ListenerType != "inbound" && c.ListenerType != "outbound" {
+		resultErr = multierror.Append(resultErr, fmt.Errorf(`unexpected ListenerType %q, supported values are "inbound" or "outbound"`, c.ListenerType))
+	}
+
+	if len(c.AllowOrigins) == 0 && len(c.AllowOriginRegexes) == 0 {
+		resultErr = multierror.Append(resultErr, fmt.Errorf("at least one of AllowOrigins or AllowOriginRegexes must be set"))
+	}
+
+	for i, origin := range c.AllowOrigins {
+		if origin == "" {
+			resultErr = multierror.Append(resultErr, fmt.Errorf("AllowOrigins[%d] must not be empty", i))
+		}
+	}
+
+	for i, regex := range c.AllowOriginRegexes {
+		if _, err := regexp.Compile(regex); err != nil {
+			resultErr = multierror.Append(resultErr, fmt.Errorf("failed to compile AllowOriginRegexes[%d] %q: %w", i, regex, err))
+		}
+	}
+
+	for i, method := range c.AllowMethods {
+		if !isToken(method) {
+			resultErr = multierror.Append(resultErr, fmt.Errorf("invalid AllowMethods[%d] %q", i, method))
+		}
+	}
+
+	for _, field := range []struct {
+		name    string
+		headers []string
+	}{
+		{"AllowHeaders", c.AllowHeaders},
+		{"ExposeHeaders", c.ExposeHeaders},
+	} {
+		for i, header := range field.headers {
+			if header != "*" && !isToken(header) {
+				resultErr = multierror.Append(resultErr, fmt.Errorf("invalid %s[%d] %q", field.name, i, header))
+			}
+		}
+	}
+
+	if c.MaxAge != nil && *c.MaxAge < 0 {
+		resultErr = multierror.Append(resultErr, fmt.Errorf("MaxAge must not be negative"))
+	}
+
+	return resultErr
+}
+
+// isToken returns true if s is a valid token as defined by RFC 9110, which
+// is the syntax of both HTTP methods and header names.
+func isToken(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r > 0x7e || r <= 0x20 || strings.ContainsRune(`"(),/:;<=>?@[\]{}`, r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/envoyextensions/extensioncommon"
+)
+
+func TestConstructor(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		extName  string
+		args     map[string]any
+		expected *cors
+		errMsg   string
+	}{
+		"invalid name": {
+			extName: "invalid",
+			errMsg:  `expected extension name "builtin/cors"`,
+		},
+		"invalid proxy type": {
+			args: map[string]any{
+				"ProxyType":    "invalid",
+				"AllowOrigins": []string{"*"},
+			},
+			errMsg: `unsupported ProxyType`,
+		},
+		"invalid listener type": {
+			args: map[string]any{
+				"ListenerType": "invalid",
+				"AllowOrigins": []string{"*"},
+			},
+			errMsg: `unexpected ListenerType "invalid"`,
+		},
+		"no origins": {
+			args:   map[string]any{"AllowMethods": []string{"GET"}},
+			errMsg: `at least one of AllowOrigins or AllowOriginRegexes must be set`,
+		},
+		"invalid origin regex": {
+			args:   map[string]any{"AllowOriginRegexes": []string{"(foo"}},
+			errMsg: `failed to compile AllowOriginRegexes[0] "(foo"`,
+		},
+		"invalid method": {
+			args: map[string]any{
+				"AllowOrigins": []string{"*"},
+				"AllowMethods": []string{"GET", "NOT VALID"},
+			},
+			errMsg: `invalid AllowMethods[1] "NOT VALID"`,
+		},
+		"invalid header": {
+			args: map[string]any{
+				"AllowOrigins":  []string{"*"},
+				"ExposeHeaders": []string{"x-ok", "x:bad"},
+			},
+			errMsg: `invalid ExposeHeaders[1] "x:bad"`,
+		},
+		"negative max age": {
+			args: map[string]any{
+				"AllowOrigins": []string{"*"},
+				"MaxAge":       -1,
+			},
+			errMsg: `MaxAge must not be negative`,
+		},
+		"valid": {
+			args: map[string]any{
+				"AllowOrigins":       []string{"https://example.com"},
+				"AllowOriginRegexes": []string{`https://.*\.example\.com`},
+				"AllowMethods":       []string{"get", "POST"},
+				"AllowHeaders":       []string{"*"},
+				"MaxAge":             600,
+				"AllowCredentials":   true,
+			},
+			expected: &cors{
+				ProxyType:          api.ServiceKindConnectProxy,
+				ListenerType:       "inbound",
+				AllowOrigins:       []string{"https://example.com"},
+				AllowOriginRegexes: []string{`https://.*\.example\.com`},
+				AllowMethods:       []string{"GET", "POST"},
+				AllowHeaders:       []string{"*"},
+				MaxAge:             intPtr(600),
+				AllowCredentials:   true,
+			},
+		},
+	}
+	for name, c := range cases {
+		c := c
+		t.Run(name, func(t *testing.T) {
+			extName := api.BuiltinCORSExtension
+			if c.extName != "" {
+				extName = c.extName
+			}
+			ext, err := Constructor(api.EnvoyExtension{Name: extName, Arguments: c.args})
+			if c.errMsg == "" {
+				require.NoError(t, err)
+				require.Equal(t, &extensioncommon.BasicEnvoyExtender{Extension: c.expected}, ext)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), c.errMsg)
+			}
+		})
+	}
+}
+
+func intPtr(i int) *int {
+	return &i
+}
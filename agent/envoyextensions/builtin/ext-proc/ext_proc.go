@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package extproc
+
+import (
+	"fmt"
+
+	envoy_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	"github.com/mitchellh/mapstructure"
+
+	"github.com/hashicorp/consul/api"
+	ext_cmn "github.com/hashicorp/consul/envoyextensions/extensioncommon"
+	"github.com/hashicorp/go-multierror"
+)
+
+type extProc struct {
+	ext_cmn.BasicExtensionAdapter
+
+	// ProxyType identifies the type of Envoy proxy that this extension applies to.
+	// The extension will only be configured for proxies that match this type and
+	// will be ignored for all other proxy types.
+	ProxyType api.ServiceKind
+	// InsertOptions controls how the extension inserts the filter.
+	InsertOptions ext_cmn.InsertOptions
+	// ListenerType controls which listener the extension applies to. It supports "inbound" or "outbound" listeners.
+	ListenerType string
+	// Config holds the extension configuration.
+	Config extProcConfig
+}
+
+var _ ext_cmn.BasicExtension = (*extProc)(nil)
+
+func Constructor(ext api.EnvoyExtension) (ext_cmn.EnvoyExtender, error) {
+	proc, err := newExtProc(ext)
+	if err != nil {
+		return nil, err
+	}
+	return &ext_cmn.BasicEnvoyExtender{
+		Extension: proc,
+	}, nil
+}
+
+// CanApply indicates if the ext-proc extension can be applied to the given extension runtime configuration.
+func (p *extProc) CanApply(config *ext_cmn.RuntimeConfig) bool {
+	return config.Kind == p.ProxyType
+}
+
+// PatchClusters modifies the cluster resources for the ext-proc extension.
+//
+// If the extension is configured to target an external processing service running on the local host
+// network this func will insert a cluster for calling that service. It does nothing if the extension is
+// configured to target an upstream service because the existing cluster for the upstream will be
+// used directly by the filter, or if the filter is not inserted because the service is not HTTP.
+func (p *extProc) PatchClusters(cfg *ext_cmn.RuntimeConfig, c ext_cmn.ClusterMap) (ext_cmn.ClusterMap, error) {
+	if !isHTTPProtocol(cfg.Protocol) {
+		return c, nil
+	}
+	cluster, err := p.Config.toEnvoyCluster(cfg)
+	if err != nil {
+		return c, err
+	}
+	if cluster != nil {
+		c[cluster.Name] = cluster
+	}
+	return c, nil
+}
+
+func (p *extProc) matchesListenerDirection(isInboundListener bool) bool {
+	return (!isInboundListener && p.ListenerType == "outbound") || (isInboundListener && p.ListenerType == "inbound")
+}
+
+// PatchFilters inserts an ext-proc filter into the filter chain of the HTTP connection manager.
+// Envoy only supports external processing of HTTP requests, so listeners for other protocols are
+// not modified.
+func (p *extProc) PatchFilters(cfg *ext_cmn.RuntimeConfig, filters []*envoy_listener_v3.Filter, isInboundListener bool) ([]*envoy_listener_v3.Filter, error) {
+	if !p.matchesListenerDirection(isInboundListener) {
+		return filters, nil
+	}
+
+	if !isHTTPProtocol(cfg.Protocol) {
+		return filters, nil
+	}
+
+	p.configureInsertOptions()
+
+	extProcFilter, err := p.Config.toEnvoyHttpFilter(cfg)
+	if err != nil {
+		return filters, err
+	}
+	return ext_cmn.InsertHTTPFilter(filters, extProcFilter, p.InsertOptions)
+}
+
+func isHTTPProtocol(protocol string) bool {
+	switch protocol {
+	case "grpc", "http2", "http":
+		return true
+	default:
+		return false
+	}
+}
+
+func newExtProc(ext api.EnvoyExtension) (*extProc, error) {
+	proc := &extProc{}
+	if ext.Name != api.BuiltinExtProcExtension {
+		return proc, fmt.Errorf("expected extension name %q but got %q", api.BuiltinExtProcExtension, ext.Name)
+	}
+	if err := proc.fromArguments(ext.Arguments); err != nil {
+		return proc, err
+	}
+	// The filter's failure mode is always configured based on whether or not the extension is required.
+	proc.Config.failureModeAllow = !ext.Required
+	return proc, nil
+}
+
+func (p *extProc) fromArguments(args map[string]any) error {
+	if err := mapstructure.Decode(args, p); err != nil {
+		return err
+	}
+	p.normalize()
+	return p.validate()
+}
+
+func (p *extProc) configureInsertOptions() {
+	// If the insert options have been expressly configured, then use them.
+	if p.InsertOptions.Location != "" {
+		return
+	}
+
+	// Configure the default, insert the filter immediately before the router filter.
+	p.InsertOptions.Location = ext_cmn.InsertBeforeFirstMatch
+	p.InsertOptions.FilterName = "envoy.filters.http.router"
+}
+
+func (p *extProc) normalize() {
+	if p.ProxyType == "" {
+		p.ProxyType = api.ServiceKindConnectProxy
+	}
+
+	if p.ListenerType == "" {
+		p.ListenerType = "inbound"
+	}
+
+	p.Config.normalize()
+}
+
+func (p *extProc) validate() error {
+	var resultErr error
+	if p.ProxyType != api.ServiceKindConnectProxy {
+		resultErr = multierror.Append(resultErr, fmt.Errorf("unsupported ProxyType %q, only %q is supported",
+			p.ProxyType,
+			api.ServiceKindConnectProxy))
+	}
+
+	if p.ListenerType != "inbound" && p.ListenerType != "outbound" {
+		resultErr = multierror.Append(resultErr, fmt.Errorf(`unexpected ListenerType %q, supported values are "inbound" or "outbound"`, p.ListenerType))
+	}
+
+	if err := p.Config.validate(); err != nil {
+		resultErr = multierror.Append(resultErr, err)
+	}
+
+	return resultErr
+}
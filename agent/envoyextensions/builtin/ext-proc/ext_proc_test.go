@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package extproc
+
+import (
+	"testing"
+
+	envoy_ext_proc_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/envoyextensions/extensioncommon"
+)
+
+func TestConstructor(t *testing.T) {
+	t.Parallel()
+	localTarget := map[string]any{
+		"GrpcService": map[string]any{
+			"Target": map[string]any{
+				"URI": "localhost:9191",
+			},
+		},
+	}
+	withConfig := func(cfg map[string]any) map[string]any {
+		merged := map[string]any{}
+		for k, v := range localTarget {
+			merged[k] = v
+		}
+		for k, v := range cfg {
+			merged[k] = v
+		}
+		return map[string]any{"Config": merged}
+	}
+
+	cases := map[string]struct {
+		extName  string
+		args     map[string]any
+		required bool
+		errMsg   string
+		expected *envoy_ext_proc_v3.ExternalProcessor
+	}{
+		"invalid name": {
+			extName: "invalid",
+			errMsg:  `expected extension name "builtin/ext-proc"`,
+		},
+		"invalid proxy type": {
+			args:   map[string]any{"ProxyType": "invalid", "Config": localTarget},
+			errMsg: `unsupported ProxyType`,
+		},
+		"invalid listener type": {
+			args:   map[string]any{"ListenerType": "invalid", "Config": localTarget},
+			errMsg: `unexpected ListenerType "invalid"`,
+		},
+		"no grpc service": {
+			args:   map[string]any{"ProxyType": "connect-proxy"},
+			errMsg: `Config.GrpcService must be set`,
+		},
+		"non-loopback address": {
+			args: map[string]any{
+				"Config": map[string]any{
+					"GrpcService": map[string]any{
+						"Target": map[string]any{
+							"URI": "10.0.0.1:9191",
+						},
+					},
+				},
+			},
+			errMsg: `invalid host for Target.URI "10.0.0.1:9191": expected "localhost", "127.0.0.1", or "::1"`,
+		},
+		"uri and service target": {
+			args: map[string]any{
+				"Config": map[string]any{
+					"GrpcService": map[string]any{
+						"Target": map[string]any{
+							"URI": "localhost:9191",
+							"Service": map[string]any{
+								"Name": "test-service",
+							},
+						},
+					},
+				},
+			},
+			errMsg: `exactly one of Target.Service or Target.URI must be set`,
+		},
+		"invalid target timeout": {
+			args: map[string]any{
+				"Config": map[string]any{
+					"GrpcService": map[string]any{
+						"Target": map[string]any{
+							"URI":     "localhost:9191",
+							"Timeout": "one",
+						},
+					},
+				},
+			},
+			errMsg: `failed to parse Target.Timeout "one" as a duration`,
+		},
+		"invalid header mode": {
+			args: withConfig(map[string]any{
+				"ProcessingMode": map[string]any{"RequestHeaderMode": "always"},
+			}),
+			errMsg: `unsupported ProcessingMode.RequestHeaderMode "ALWAYS"`,
+		},
+		"invalid body mode": {
+			args: withConfig(map[string]any{
+				"ProcessingMode": map[string]any{"ResponseBodyMode": "send"},
+			}),
+			errMsg: `unsupported ProcessingMode.ResponseBodyMode "SEND"`,
+		},
+		"invalid message timeout": {
+			args:   withConfig(map[string]any{"MessageTimeout": "soon"}),
+			errMsg: `failed to parse Config.MessageTimeout "soon" as a duration`,
+		},
+		"message timeout too long": {
+			args:   withConfig(map[string]any{"MessageTimeout": "2h"}),
+			errMsg: `Config.MessageTimeout "2h" must be between 0s and 1h0m0s`,
+		},
+		"max message timeout less than message timeout": {
+			args: withConfig(map[string]any{
+				"MessageTimeout":    "1s",
+				"MaxMessageTimeout": "500ms",
+			}),
+			errMsg: `Config.MaxMessageTimeout "500ms" must not be less than Config.MessageTimeout "1s"`,
+		},
+		"defaults": {
+			args: withConfig(nil),
+			expected: &envoy_ext_proc_v3.ExternalProcessor{
+				FailureModeAllow: true,
+			},
+		},
+		"required": {
+			args:     withConfig(nil),
+			required: true,
+			expected: &envoy_ext_proc_v3.ExternalProcessor{},
+		},
+		"processing mode": {
+			args: withConfig(map[string]any{
+				"ProcessingMode": map[string]any{
+					"RequestHeaderMode":  "send",
+					"ResponseHeaderMode": "SKIP",
+					"RequestBodyMode":    "buffered",
+					"ResponseBodyMode":   "STREAMED",
+				},
+			}),
+			expected: &envoy_ext_proc_v3.ExternalProcessor{
+				FailureModeAllow: true,
+				ProcessingMode: &envoy_ext_proc_v3.ProcessingMode{
+					RequestHeaderMode:  envoy_ext_proc_v3.ProcessingMode_SEND,
+					ResponseHeaderMode: envoy_ext_proc_v3.ProcessingMode_SKIP,
+					RequestBodyMode:    envoy_ext_proc_v3.ProcessingMode_BUFFERED,
+					ResponseBodyMode:   envoy_ext_proc_v3.ProcessingMode_STREAMED,
+				},
+			},
+		},
+	}
+	for name, c := range cases {
+		c := c
+		t.Run(name, func(t *testing.T) {
+			extName := api.BuiltinExtProcExtension
+			if c.extName != "" {
+				extName = c.extName
+			}
+			ext, err := newExtProc(api.EnvoyExtension{Name: extName, Arguments: c.args, Required: c.required})
+			if c.errMsg == "" {
+				require.NoError(t, err)
+
+				httpFilter, err := ext.Config.toEnvoyHttpFilter(&extensioncommon.RuntimeConfig{})
+				require.NoError(t, err)
+
+				var got envoy_ext_proc_v3.ExternalProcessor
+				require.NoError(t, httpFilter.GetTypedConfig().UnmarshalTo(&got))
+				require.Equal(t, c.expected.FailureModeAllow, got.FailureModeAllow)
+				require.Equal(t, c.expected.ProcessingMode.String(), got.ProcessingMode.String())
+				require.Equal(t, LocalExtProcClusterName, got.GrpcService.GetEnvoyGrpc().GetClusterName())
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), c.errMsg)
+			}
+		})
+	}
+}
@@ -0,0 +1,469 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package extproc
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	envoy_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoy_ext_proc_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
+	envoy_http_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_upstreams_http_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
+	"github.com/hashicorp/go-multierror"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/api"
+	cmn "github.com/hashicorp/consul/envoyextensions/extensioncommon"
+)
+
+const (
+	LocalExtProcClusterName = "local_ext_proc"
+
+	localhost     = "localhost"
+	localhostIPv4 = "127.0.0.1"
+	localhostIPv6 = "::1"
+
+	// maxMessageTimeout is the longest message timeout accepted by Envoy.
+	maxMessageTimeout = time.Hour
+)
+
+type extProcConfig struct {
+	AllowModeOverride      bool
+	AsyncMode              bool
+	DisableClearRouteCache bool
+	GrpcService            *GrpcService
+	MaxMessageTimeout      string
+	MessageTimeout         string
+	ProcessingMode         *ProcessingMode
+	RequestAttributes      []string
+	ResponseAttributes     []string
+	StatPrefix             string
+
+	failureModeAllow  bool
+	maxMessageTimeout *time.Duration
+	messageTimeout    *time.Duration
+}
+
+func (c *extProcConfig) normalize() {
+	c.GrpcService.normalize()
+	c.ProcessingMode.normalize()
+}
+
+func (c *extProcConfig) validate() error {
+	var resultErr error
+	if c.GrpcService == nil {
+		resultErr = multierror.Append(resultErr, fmt.Errorf("Config.GrpcService must be set"))
+	} else if err := c.GrpcService.validate(); err != nil {
+		resultErr = multierror.Append(resultErr, fmt.Errorf("failed to validate Config.GrpcService: %w", err))
+	}
+
+	if err := c.ProcessingMode.validate(); err != nil {
+		resultErr = multierror.Append(resultErr, fmt.Errorf("failed to validate Config.ProcessingMode: %w", err))
+	}
+
+	var err error
+	if c.messageTimeout, err = parseMessageTimeout("MessageTimeout", c.MessageTimeout); err != nil {
+		resultErr = multierror.Append(resultErr, err)
+	}
+	if c.maxMessageTimeout, err = parseMessageTimeout("MaxMessageTimeout", c.MaxMessageTimeout); err != nil {
+		resultErr = multierror.Append(resultErr, err)
+	}
+	if c.messageTimeout != nil && c.maxMessageTimeout != nil && *c.maxMessageTimeout < *c.messageTimeout {
+		resultErr = multierror.Append(resultErr, fmt.Errorf("Config.MaxMessageTimeout %q must not be less than Config.MessageTimeout %q",
+			c.MaxMessageTimeout, c.MessageTimeout))
+	}
+
+	return resultErr
+}
+
+func parseMessageTimeout(field, s string) (*time.Duration, error) {
+	if s == "" {
+		return nil, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Config.%s %q as a duration: %w", field, s, err)
+	}
+	if d < 0 || d > maxMessageTimeout {
+		return nil, fmt.Errorf("Config.%s %q must be between 0s and %s", field, s, maxMessageTimeout)
+	}
+	return &d, nil
+}
+
+func (c extProcConfig) envoyGrpcService(cfg *cmn.RuntimeConfig) (*envoy_core_v3.GrpcService, error) {
+	target := c.GrpcService.Target
+	clusterName, err := c.getClusterName(cfg, target)
+	if err != nil {
+		return nil, err
+	}
+
+	var initialMetadata []*envoy_core_v3.HeaderValue
+	for _, meta := range c.GrpcService.InitialMetadata {
+		initialMetadata = append(initialMetadata, meta.toEnvoy())
+	}
+
+	return &envoy_core_v3.GrpcService{
+		TargetSpecifier: &envoy_core_v3.GrpcService_EnvoyGrpc_{
+			EnvoyGrpc: &envoy_core_v3.GrpcService_EnvoyGrpc{
+				ClusterName: clusterName,
+				Authority:   c.GrpcService.Authority,
+			},
+		},
+		Timeout:         target.timeoutDurationPB(),
+		InitialMetadata: initialMetadata,
+	}, nil
+}
+
+// getClusterName returns the name of the cluster for the external processing service.
+// If the extension is configured with an upstream ext-proc service then the name of the cluster for
+// that upstream is returned. If the extension is configured with a URI, the only allowed host is `localhost`
+// and the extension will insert a new cluster with the name "local_ext_proc", so we use that name.
+func (c extProcConfig) getClusterName(cfg *cmn.RuntimeConfig, target *Target) (string, error) {
+	var err error
+	clusterName := LocalExtProcClusterName
+	if target.isService() {
+		if clusterName, err = target.clusterName(cfg); err != nil {
+			return "", err
+		}
+	}
+	return clusterName, nil
+}
+
+// toEnvoyCluster returns an Envoy cluster for connecting to the ext_proc service.
+// If the extension is configured with the ext_proc service locally via the URI set to localhost,
+// this func will return a new cluster definition that will allow the proxy to connect to the ext_proc
+// service running on localhost on the configured port.
+//
+// If the extension is configured with the ext_proc service as an upstream there is no need to insert
+// a new cluster so this method returns nil.
+func (c *extProcConfig) toEnvoyCluster(_ *cmn.RuntimeConfig) (*envoy_cluster_v3.Cluster, error) {
+	target := c.GrpcService.Target
+
+	// If the target is an upstream we do not need to create a cluster. We will use the cluster of the upstream.
+	if target.isService() {
+		return nil, nil
+	}
+
+	host, port, err := target.addr()
+	if err != nil {
+		return nil, err
+	}
+
+	clusterType := &envoy_cluster_v3.Cluster_Type{Type: envoy_cluster_v3.Cluster_STATIC}
+	if host == localhost {
+		// If the host is "localhost" use a STRICT_DNS cluster type to perform DNS lookup.
+		clusterType = &envoy_cluster_v3.Cluster_Type{Type: envoy_cluster_v3.Cluster_STRICT_DNS}
+	}
+
+	// By default HTTP/1.1 is used for the transport protocol. gRPC requires that we explicitly configure HTTP/2
+	httpProtoOpts := &envoy_upstreams_http_v3.HttpProtocolOptions{
+		UpstreamProtocolOptions: &envoy_upstreams_http_v3.HttpProtocolOptions_ExplicitHttpConfig_{
+			ExplicitHttpConfig: &envoy_upstreams_http_v3.HttpProtocolOptions_ExplicitHttpConfig{
+				ProtocolConfig: &envoy_upstreams_http_v3.HttpProtocolOptions_ExplicitHttpConfig_Http2ProtocolOptions{},
+			},
+		},
+	}
+	httpProtoOptsAny, err := anypb.New(httpProtoOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &envoy_cluster_v3.Cluster{
+		Name:                 LocalExtProcClusterName,
+		ClusterDiscoveryType: clusterType,
+		ConnectTimeout:       target.timeoutDurationPB(),
+		LoadAssignment: &envoy_endpoint_v3.ClusterLoadAssignment{
+			ClusterName: LocalExtProcClusterName,
+			Endpoints: []*envoy_endpoint_v3.LocalityLbEndpoints{
+				{
+					LbEndpoints: []*envoy_endpoint_v3.LbEndpoint{{
+						HostIdentifier: &envoy_endpoint_v3.LbEndpoint_Endpoint{
+							Endpoint: &envoy_endpoint_v3.Endpoint{
+								Address: &envoy_core_v3.Address{
+									Address: &envoy_core_v3.Address_SocketAddress{
+										SocketAddress: &envoy_core_v3.SocketAddress{
+											Address: host,
+											PortSpecifier: &envoy_core_v3.SocketAddress_PortValue{
+												PortValue: uint32(port),
+											},
+										},
+									},
+								},
+							},
+						},
+					}},
+				},
+			},
+		},
+		TypedExtensionProtocolOptions: map[string]*anypb.Any{
+			"envoy.extensions.upstreams.http.v3.HttpProtocolOptions": httpProtoOptsAny,
+		},
+	}, nil
+}
+
+func (c extProcConfig) toEnvoyHttpFilter(cfg *cmn.RuntimeConfig) (*envoy_http_v3.HttpFilter, error) {
+	grpcSvc, err := c.envoyGrpcService(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	extProcFilter := &envoy_ext_proc_v3.ExternalProcessor{
+		GrpcService:            grpcSvc,
+		FailureModeAllow:       c.failureModeAllow,
+		ProcessingMode:         c.ProcessingMode.toEnvoy(),
+		AsyncMode:              c.AsyncMode,
+		RequestAttributes:      c.RequestAttributes,
+		ResponseAttributes:     c.ResponseAttributes,
+		StatPrefix:             c.StatPrefix,
+		DisableClearRouteCache: c.DisableClearRouteCache,
+		AllowModeOverride:      c.AllowModeOverride,
+	}
+	if c.messageTimeout != nil {
+		extProcFilter.MessageTimeout = durationpb.New(*c.messageTimeout)
+	}
+	if c.maxMessageTimeout != nil {
+		extProcFilter.MaxMessageTimeout = durationpb.New(*c.maxMessageTimeout)
+	}
+
+	return cmn.MakeEnvoyHTTPFilter("envoy.filters.http.ext_proc", extProcFilter)
+}
+
+type GrpcService struct {
+	Target          *Target
+	Authority       string
+	InitialMetadata []*HeaderValue
+}
+
+func (v *GrpcService) normalize() {
+	if v == nil {
+		return
+	}
+	v.Target.normalize()
+}
+
+func (v *GrpcService) validate() error {
+	var resultErr error
+	if v == nil {
+		return resultErr
+	}
+
+	if v.Target == nil {
+		resultErr = multierror.Append(resultErr, fmt.Errorf("GrpcService.Target must be set"))
+	}
+	if err := v.Target.validate(); err != nil {
+		resultErr = multierror.Append(resultErr, err)
+	}
+	return resultErr
+}
+
+type HeaderValue struct {
+	Key   string
+	Value string
+}
+
+func (h *HeaderValue) toEnvoy() *envoy_core_v3.HeaderValue {
+	if h == nil {
+		return nil
+	}
+	return &envoy_core_v3.HeaderValue{Key: h.Key, Value: h.Value}
+}
+
+// ProcessingMode controls which parts of requests and responses Envoy sends to the
+// external processing service. Header and trailer modes are one of "DEFAULT", "SEND" or
+// "SKIP", and body modes are one of "NONE", "STREAMED", "BUFFERED" or "BUFFERED_PARTIAL".
+type ProcessingMode struct {
+	RequestHeaderMode   string
+	ResponseHeaderMode  string
+	RequestBodyMode     string
+	ResponseBodyMode    string
+	RequestTrailerMode  string
+	ResponseTrailerMode string
+}
+
+func (m *ProcessingMode) normalize() {
+	if m == nil {
+		return
+	}
+	for _, mode := range []*string{
+		&m.RequestHeaderMode, &m.ResponseHeaderMode,
+		&m.RequestBodyMode, &m.ResponseBodyMode,
+		&m.RequestTrailerMode, &m.ResponseTrailerMode,
+	} {
+		*mode = strings.ToUpper(*mode)
+	}
+}
+
+func (m *ProcessingMode) validate() error {
+	var resultErr error
+	if m == nil {
+		return resultErr
+	}
+
+	headerModes := map[string]string{
+		"RequestHeaderMode":   m.RequestHeaderMode,
+		"ResponseHeaderMode":  m.ResponseHeaderMode,
+		"RequestTrailerMode":  m.RequestTrailerMode,
+		"ResponseTrailerMode": m.ResponseTrailerMode,
+	}
+	for field, mode := range headerModes {
+		if _, ok := envoy_ext_proc_v3.ProcessingMode_HeaderSendMode_value[mode]; mode != "" && !ok {
+			resultErr = multierror.Append(resultErr, fmt.Errorf(`unsupported ProcessingMode.%s %q, supported values are "DEFAULT", "SEND" or "SKIP"`, field, mode))
+		}
+	}
+
+	bodyModes := map[string]string{
+		"RequestBodyMode":  m.RequestBodyMode,
+		"ResponseBodyMode": m.ResponseBodyMode,
+	}
+	for field, mode := range bodyModes {
+		if _, ok := envoy_ext_proc_v3.ProcessingMode_BodySendMode_value[mode]; mode != "" && !ok {
+			resultErr = multierror.Append(resultErr, fmt.Errorf(`unsupported ProcessingMode.%s %q, supported values are "NONE", "STREAMED", "BUFFERED" or "BUFFERED_PARTIAL"`, field, mode))
+		}
+	}
+	return resultErr
+}
+
+func (m *ProcessingMode) toEnvoy() *envoy_ext_proc_v3.ProcessingMode {
+	if m == nil {
+		return nil
+	}
+	headerMode := func(mode string) envoy_ext_proc_v3.ProcessingMode_HeaderSendMode {
+		return envoy_ext_proc_v3.ProcessingMode_HeaderSendMode(envoy_ext_proc_v3.ProcessingMode_HeaderSendMode_value[mode])
+	}
+	bodyMode := func(mode string) envoy_ext_proc_v3.ProcessingMode_BodySendMode {
+		return envoy_ext_proc_v3.ProcessingMode_BodySendMode(envoy_ext_proc_v3.ProcessingMode_BodySendMode_value[mode])
+	}
+	return &envoy_ext_proc_v3.ProcessingMode{
+		RequestHeaderMode:   headerMode(m.RequestHeaderMode),
+		ResponseHeaderMode:  headerMode(m.ResponseHeaderMode),
+		RequestBodyMode:     bodyMode(m.RequestBodyMode),
+		ResponseBodyMode:    bodyMode(m.ResponseBodyMode),
+		RequestTrailerMode:  headerMode(m.RequestTrailerMode),
+		ResponseTrailerMode: headerMode(m.ResponseTrailerMode),
+	}
+}
+
+type Target struct {
+	Service api.CompoundServiceName
+	URI     string
+	Timeout string
+
+	timeout *time.Duration
+	host    string
+	port    int
+}
+
+// addr returns the host and port for the target when the target is a URI.
+// It returns a non-nil error if the target is not a URI.
+func (t Target) addr() (string, int, error) {
+	if !t.isURI() {
+		return "", 0, fmt.Errorf("target is not configured with a URI, set Target.URI")
+	}
+	return t.host, t.port, nil
+}
+
+// clusterName returns the cluster name for the target when the target is an upstream service.
+// It searches through the upstreams in the provided runtime configuration and returns the name
+// of the cluster for the first upstream service that matches the target service.
+// It returns a non-nil error if a matching cluster is not found or if the target is not an
+// upstream service.
+func (t Target) clusterName(cfg *cmn.RuntimeConfig) (string, error) {
+	if !t.isService() {
+		return "", fmt.Errorf("target is not configured with an upstream service, set Target.Service")
+	}
+
+	for service, upstream := range cfg.Upstreams {
+		if service == t.Service {
+			for sni := range upstream.SNIs {
+				return sni, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("no upstream definition found for service %q", t.Service.Name)
+}
+
+func (t Target) isService() bool {
+	return t.Service.Name != ""
+}
+
+func (t Target) isURI() bool {
+	return t.URI != ""
+}
+
+func (t *Target) normalize() {
+	if t == nil {
+		return
+	}
+	t.Service.Namespace = acl.NamespaceOrDefault(t.Service.Namespace)
+	t.Service.Partition = acl.PartitionOrDefault(t.Service.Partition)
+}
+
+// timeoutDurationPB returns the target's timeout as a *durationpb.Duration.
+// It returns nil if the timeout has not been explicitly set.
+func (t *Target) timeoutDurationPB() *durationpb.Duration {
+	if t == nil || t.timeout == nil {
+		return nil
+	}
+	return durationpb.New(*t.timeout)
+}
+
+func (t *Target) validate() error {
+	var err, resultErr error
+	if t == nil {
+		return resultErr
+	}
+
+	if t.isURI() == t.isService() {
+		resultErr = multierror.Append(resultErr, fmt.Errorf("exactly one of Target.Service or Target.URI must be set"))
+	}
+
+	if t.isURI() {
+		t.host, t.port, err = parseAddr(t.URI)
+		if err == nil {
+			switch t.host {
+			case localhost, localhostIPv4, localhostIPv6:
+			default:
+				resultErr = multierror.Append(resultErr,
+					fmt.Errorf("invalid host for Target.URI %q: expected %q, %q, or %q", t.URI, localhost, localhostIPv4, localhostIPv6))
+			}
+		} else {
+			resultErr = multierror.Append(resultErr, fmt.Errorf("invalid format for Target.URI %q: expected host:port", t.URI))
+		}
+	}
+
+	if t.Timeout != "" {
+		if d, err := time.ParseDuration(t.Timeout); err == nil {
+			t.timeout = &d
+		} else {
+			resultErr = multierror.Append(resultErr, fmt.Errorf("failed to parse Target.Timeout %q as a duration: %w", t.Timeout, err))
+		}
+	}
+	return resultErr
+}
+
+func parseAddr(s string) (host string, port int, err error) {
+	// Strip the protocol if one was provided
+	if _, addr, hasProto := strings.Cut(s, "://"); hasProto {
+		s = addr
+	}
+	idx := strings.LastIndex(s, ":")
+	switch idx {
+	case -1, len(s) - 1:
+		err = fmt.Errorf("invalid input format %q: expected host:port", s)
+	case 0:
+		host = localhost
+		port, err = strconv.Atoi(s[idx+1:])
+	default:
+		host = s[:idx]
+		port, err = strconv.Atoi(s[idx+1:])
+	}
+	return
+}
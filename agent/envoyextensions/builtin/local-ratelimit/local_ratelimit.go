@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package localratelimit
+
+import (
+	"fmt"
+
+	envoy_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_local_ratelimit_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/hashicorp/go-multierror"
+	"github.com/mitchellh/mapstructure"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/hashicorp/consul/api"
+	ext_cmn "github.com/hashicorp/consul/envoyextensions/extensioncommon"
+)
+
+const (
+	localRateLimitFilterName = "envoy.filters.http.local_ratelimit"
+	localRateLimitStatPrefix = "local_ratelimit"
+)
+
+type localRateLimit struct {
+	ext_cmn.BasicExtensionAdapter
+
+	// ProxyType identifies the type of Envoy proxy that this extension applies to.
+	// The extension will only be configured for proxies that match this type and
+	// will be ignored for all other proxy types.
+	ProxyType api.ServiceKind
+	// ListenerType controls which listener the extension applies to. It supports "inbound" or "outbound" listeners.
+	ListenerType string
+	// StatusCode is the HTTP status code returned to rate limited requests.
+	// Envoy returns 429 when unset.
+	StatusCode *int
+	// TokenBucket limits every request on the listener that is not matched by
+	// one of the Routes.
+	TokenBucket *TokenBucket
+	// Routes limits the requests that match a path with their own token bucket.
+	Routes []Route
+}
+
+var _ ext_cmn.BasicExtension = (*localRateLimit)(nil)
+
+// Constructor follows a specific function signature required for the extension registration.
+func Constructor(ext api.EnvoyExtension) (ext_cmn.EnvoyExtender, error) {
+	l := &localRateLimit{}
+	if ext.Name != api.BuiltinLocalRateLimitExtension {
+		return nil, fmt.Errorf("expected extension name %q but got %q", api.BuiltinLocalRateLimitExtension, ext.Name)
+	}
+	if err := l.fromArguments(ext.Arguments); err != nil {
+		return nil, err
+	}
+	return &ext_cmn.BasicEnvoyExtender{
+		Extension: l,
+	}, nil
+}
+
+// CanApply indicates if the local rate limit extension can be applied to the given extension runtime configuration.
+func (l *localRateLimit) CanApply(config *ext_cmn.RuntimeConfig) bool {
+	return config.Kind == l.ProxyType
+}
+
+func (l *localRateLimit) matchesListenerDirection(isInboundListener bool) bool {
+	return (!isInboundListener && l.ListenerType == "outbound") || (isInboundListener && l.ListenerType == "inbound")
+}
+
+// PatchFilters inserts a local rate limit filter into the filter chain of the HTTP connection
+// manager and configures the token buckets on its inline route configuration, if any. Listeners
+// without an HTTP connection manager are not modified.
+//
+// The filter itself has no token bucket, so requests are only limited on the virtual hosts and
+// routes configured by the extension.
+func (l *localRateLimit) PatchFilters(_ *ext_cmn.RuntimeConfig, filters []*envoy_listener_v3.Filter, isInboundListener bool) ([]*envoy_listener_v3.Filter, error) {
+	if !l.matchesListenerDirection(isInboundListener) {
+		return filters, nil
+	}
+	if _, _, err := ext_cmn.GetHTTPConnectionManager(filters...); err != nil {
+		return filters, nil
+	}
+
+	rateLimitFilter, err := ext_cmn.MakeEnvoyHTTPFilter(localRateLimitFilterName, &envoy_local_ratelimit_v3.LocalRateLimit{
+		StatPrefix: localRateLimitStatPrefix,
+	})
+	if err != nil {
+		return filters, err
+	}
+	filters, err = ext_cmn.InsertHTTPFilter(filters, rateLimitFilter, ext_cmn.InsertOptions{
+		Location:   ext_cmn.InsertBeforeFirstMatch,
+		FilterName: "envoy.filters.http.router",
+	})
+	if err != nil {
+		return filters, err
+	}
+
+	return ext_cmn.PatchInlineRouteConfiguration(filters, l.patchRouteConfiguration)
+}
+
+// PatchRoute configures the token buckets on route configurations fetched with RDS.
+func (l *localRateLimit) PatchRoute(p ext_cmn.RoutePayload) (*envoy_route_v3.RouteConfiguration, bool, error) {
+	if !l.matchesListenerDirection(p.IsInbound()) {
+		return p.Message, false, nil
+	}
+
+	routeConfig := proto.Clone(p.Message).(*envoy_route_v3.RouteConfiguration)
+	if err := l.patchRouteConfiguration(routeConfig); err != nil {
+		return p.Message, false, err
+	}
+	return routeConfig, true, nil
+}
+
+func (l *localRateLimit) patchRouteConfiguration(routeConfig *envoy_route_v3.RouteConfiguration) error {
+	var defaultConfig *anypb.Any
+	if l.TokenBucket != nil {
+		var err error
+		defaultConfig, err = anypb.New(l.toEnvoyLocalRateLimit(l.TokenBucket))
+		if err != nil {
+			return err
+		}
+	}
+
+	routeConfigs := make([]*anypb.Any, len(l.Routes))
+	for i, r := range l.Routes {
+		var err error
+		routeConfigs[i], err = anypb.New(l.toEnvoyLocalRateLimit(&r.TokenBucket))
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, vh := range routeConfig.VirtualHosts {
+		if defaultConfig != nil {
+			setTypedPerFilterConfig(&vh.TypedPerFilterConfig, defaultConfig)
+		}
+		for i, r := range l.Routes {
+			vh.Routes = r.apply(vh.Routes, routeConfigs[i])
+		}
+	}
+	return nil
+}
+
+func (l *localRateLimit) toEnvoyLocalRateLimit(bucket *TokenBucket) *envoy_local_ratelimit_v3.LocalRateLimit {
+	cfg := &envoy_local_ratelimit_v3.LocalRateLimit{
+		StatPrefix:  localRateLimitStatPrefix,
+		TokenBucket: bucket.toEnvoy(),
+		// The filter is disabled and not enforced unless explicitly
+		// configured to be.
+		FilterEnabled:  fullRuntimeFraction("local_rate_limit_enabled"),
+		FilterEnforced: fullRuntimeFraction("local_rate_limit_enforced"),
+	}
+	if l.StatusCode != nil {
+		cfg.Status = &envoy_type_v3.HttpStatus{Code: envoy_type_v3.StatusCode(*l.StatusCode)}
+	}
+	return cfg
+}
+
+func setTypedPerFilterConfig(m *map[string]*anypb.Any, cfg *anypb.Any) {
+	if *m == nil {
+		*m = make(map[string]*anypb.Any)
+	}
+	(*m)[localRateLimitFilterName] = cfg
+}
+
+func (l *localRateLimit) fromArguments(args map[string]any) error {
+	if err := mapstructure.Decode(args, l); err != nil {
+		return fmt.Errorf("error decoding extension arguments: %v", err)
+	}
+	l.normalize()
+	return l.validate()
+}
+
+func (l *localRateLimit) normalize() {
+	if l.ProxyType == "" {
+		l.ProxyType = api.ServiceKindConnectProxy
+	}
+
+	if l.ListenerType == "" {
+		l.ListenerType = "inbound"
+	}
+}
+
+func (l *localRateLimit) validate() error {
+	var resultErr error
+	if l.ProxyType != api.ServiceKindConnectProxy {
+		resultErr = multierror.Append(resultErr, fmt.Errorf("unsupported ProxyType %q, only %q is supported",
+			l.ProxyType,
+			api.ServiceKindConnectProxy))
+	}
+
+	if l.ListenerType != "inbound" && l.ListenerType != "outbound" {
+		resultErr = multierror.Append(resultErr, fmt.Errorf(`unexpected ListenerType %q, supported values are "inbound" or "outbound"`, l.ListenerType))
+	}
+
+	if l.StatusCode != nil {
+		if _, ok := envoy_type_v3.StatusCode_name[int32(*l.StatusCode)]; !ok || *l.StatusCode < 400 {
+			resultErr = multierror.Append(resultErr, fmt.Errorf("unsupported StatusCode %d, must be a 4xx or 5xx status code supported by Envoy", *l.StatusCode))
+		}
+	}
+
+	if l.TokenBucket == nil && len(l.Routes) == 0 {
+		resultErr = multierror.Append(resultErr, fmt.Errorf("at least one of TokenBucket or Routes must be set"))
+	}
+
+	if l.TokenBucket != nil {
+		if err := l.TokenBucket.validate(); err != nil {
+			resultErr = multierror.Append(resultErr, fmt.Errorf("failed to validate TokenBucket: %w", err))
+		}
+	}
+
+	for i := range l.Routes {
+		if err := l.Routes[i].validate(); err != nil {
+			resultErr = multierror.Append(resultErr, fmt.Errorf("failed to validate Routes[%d]: %w", i, err))
+		}
+	}
+
+	return resultErr
+}
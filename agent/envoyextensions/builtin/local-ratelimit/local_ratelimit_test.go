@@ -0,0 +1,277 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package localratelimit
+
+import (
+	"testing"
+
+	envoy_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/hashicorp/consul/api"
+)
+
+func TestConstructor(t *testing.T) {
+	t.Parallel()
+	bucket := map[string]any{
+		"MaxTokens":    10,
+		"FillInterval": "1s",
+	}
+	cases := map[string]struct {
+		extName string
+		args    map[string]any
+		errMsg  string
+	}{
+		"invalid name": {
+			extName: "invalid",
+			errMsg:  `expected extension name "builtin/local-ratelimit"`,
+		},
+		"invalid proxy type": {
+			args:   map[string]any{"ProxyType": "invalid", "TokenBucket": bucket},
+			errMsg: `unsupported ProxyType`,
+		},
+		"invalid listener type": {
+			args:   map[string]any{"ListenerType": "invalid", "TokenBucket": bucket},
+			errMsg: `unexpected ListenerType "invalid"`,
+		},
+		"no limits": {
+			args:   map[string]any{},
+			errMsg: `at least one of TokenBucket or Routes must be set`,
+		},
+		"invalid status code": {
+			args:   map[string]any{"StatusCode": 200, "TokenBucket": bucket},
+			errMsg: `unsupported StatusCode 200`,
+		},
+		"invalid token bucket": {
+			args: map[string]any{
+				"TokenBucket": map[string]any{
+					"TokensPerFill": 0,
+					"FillInterval":  "10ms",
+				},
+			},
+			errMsg: `failed to validate TokenBucket`,
+		},
+		"invalid fill interval": {
+			args: map[string]any{
+				"TokenBucket": map[string]any{
+					"MaxTokens":    10,
+					"FillInterval": "one",
+				},
+			},
+			errMsg: `failed to parse FillInterval "one" as a duration`,
+		},
+		"route without path": {
+			args: map[string]any{
+				"Routes": []map[string]any{{"TokenBucket": bucket}},
+			},
+			errMsg: `exactly one of PathExact, PathPrefix or PathRegex must be set`,
+		},
+		"route with several paths": {
+			args: map[string]any{
+				"Routes": []map[string]any{{
+					"PathExact":   "/api",
+					"PathPrefix":  "/api",
+					"TokenBucket": bucket,
+				}},
+			},
+			errMsg: `exactly one of PathExact, PathPrefix or PathRegex must be set`,
+		},
+		"relative route path": {
+			args: map[string]any{
+				"Routes": []map[string]any{{
+					"PathPrefix":  "api",
+					"TokenBucket": bucket,
+				}},
+			},
+			errMsg: `PathPrefix "api" must begin with a '/'`,
+		},
+		"invalid route regex": {
+			args: map[string]any{
+				"Routes": []map[string]any{{
+					"PathRegex":   "/(api",
+					"TokenBucket": bucket,
+				}},
+			},
+			errMsg: `failed to compile PathRegex "/(api"`,
+		},
+		"valid": {
+			args: map[string]any{
+				"StatusCode":  503,
+				"TokenBucket": bucket,
+				"Routes": []map[string]any{
+					{"PathPrefix": "/api", "TokenBucket": bucket},
+					{"PathExact": "/login", "TokenBucket": bucket},
+					{"PathRegex": "/users/[0-9]+", "TokenBucket": bucket},
+				},
+			},
+		},
+	}
+	for name, c := range cases {
+		c := c
+		t.Run(name, func(t *testing.T) {
+			extName := api.BuiltinLocalRateLimitExtension
+			if c.extName != "" {
+				extName = c.extName
+			}
+			_, err := Constructor(api.EnvoyExtension{Name: extName, Arguments: c.args})
+			if c.errMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), c.errMsg)
+			}
+		})
+	}
+}
+
+func TestRouteApply(t *testing.T) {
+	cfg, err := anypb.New(wrapperspb.String("limit"))
+	require.NoError(t, err)
+	earlierCfg, err := anypb.New(wrapperspb.String("earlier"))
+	require.NoError(t, err)
+
+	prefix := func(p string) *envoy_route_v3.RouteMatch {
+		return &envoy_route_v3.RouteMatch{PathSpecifier: &envoy_route_v3.RouteMatch_Prefix{Prefix: p}}
+	}
+	exact := func(p string) *envoy_route_v3.RouteMatch {
+		return &envoy_route_v3.RouteMatch{PathSpecifier: &envoy_route_v3.RouteMatch_Path{Path: p}}
+	}
+	withHeader := func(m *envoy_route_v3.RouteMatch) *envoy_route_v3.RouteMatch {
+		m.Headers = []*envoy_route_v3.HeaderMatcher{{Name: "x-debug"}}
+		return m
+	}
+	route := func(m *envoy_route_v3.RouteMatch, cluster string) *envoy_route_v3.Route {
+		return &envoy_route_v3.Route{
+			Match: m,
+			Action: &envoy_route_v3.Route_Route{
+				Route: &envoy_route_v3.RouteAction{
+					ClusterSpecifier: &envoy_route_v3.RouteAction_Cluster{Cluster: cluster},
+				},
+			},
+		}
+	}
+	limited := func(r *envoy_route_v3.Route, cfg *anypb.Any) *envoy_route_v3.Route {
+		r.TypedPerFilterConfig = map[string]*anypb.Any{localRateLimitFilterName: cfg}
+		return r
+	}
+
+	cases := map[string]struct {
+		rule     Route
+		routes   []*envoy_route_v3.Route
+		expected []*envoy_route_v3.Route
+	}{
+		"same path": {
+			rule:   Route{PathPrefix: "/api"},
+			routes: []*envoy_route_v3.Route{route(prefix("/api"), "api"), route(prefix("/"), "web")},
+			expected: []*envoy_route_v3.Route{
+				limited(route(prefix("/api"), "api"), cfg),
+				route(prefix("/"), "web"),
+			},
+		},
+		"copies the catch-all route": {
+			rule:   Route{PathPrefix: "/api"},
+			routes: []*envoy_route_v3.Route{route(prefix("/"), "web")},
+			expected: []*envoy_route_v3.Route{
+				limited(route(prefix("/api"), "web"), cfg),
+				route(prefix("/"), "web"),
+			},
+		},
+		"limits narrower routes and copies the covering route": {
+			rule: Route{PathPrefix: "/api"},
+			routes: []*envoy_route_v3.Route{
+				route(exact("/api/admin"), "admin"),
+				route(withHeader(prefix("/api")), "debug"),
+				route(prefix("/a"), "a"),
+				route(prefix("/"), "web"),
+			},
+			expected: []*envoy_route_v3.Route{
+				limited(route(exact("/api/admin"), "admin"), cfg),
+				limited(route(withHeader(prefix("/api")), "debug"), cfg),
+				limited(route(prefix("/api"), "a"), cfg),
+				route(prefix("/a"), "a"),
+				route(prefix("/"), "web"),
+			},
+		},
+		"exact path": {
+			rule:   Route{PathExact: "/login"},
+			routes: []*envoy_route_v3.Route{route(prefix("/"), "web")},
+			expected: []*envoy_route_v3.Route{
+				limited(route(exact("/login"), "web"), cfg),
+				route(prefix("/"), "web"),
+			},
+		},
+		"regex is only copied from the catch-all route": {
+			rule:   Route{PathRegex: "/users/[0-9]+"},
+			routes: []*envoy_route_v3.Route{route(prefix("/users"), "users"), route(prefix("/"), "web")},
+			expected: []*envoy_route_v3.Route{
+				route(prefix("/users"), "users"),
+				limited(route(&envoy_route_v3.RouteMatch{
+					PathSpecifier: &envoy_route_v3.RouteMatch_SafeRegex{
+						SafeRegex: &envoy_type_matcher_v3.RegexMatcher{Regex: "/users/[0-9]+"},
+					},
+				}, "web"), cfg),
+				route(prefix("/"), "web"),
+			},
+		},
+		"earlier rule applies": {
+			rule:   Route{PathPrefix: "/api/v1"},
+			routes: []*envoy_route_v3.Route{limited(route(prefix("/api"), "web"), earlierCfg), route(prefix("/"), "web")},
+			expected: []*envoy_route_v3.Route{
+				limited(route(prefix("/api"), "web"), earlierCfg),
+				route(prefix("/"), "web"),
+			},
+		},
+		"copies routes with header matchers": {
+			rule: Route{PathPrefix: "/api"},
+			routes: []*envoy_route_v3.Route{
+				route(withHeader(prefix("/")), "debug"),
+				route(prefix("/"), "web"),
+				route(prefix("/api"), "unreachable"),
+			},
+			expected: []*envoy_route_v3.Route{
+				limited(route(withHeader(prefix("/api")), "debug"), cfg),
+				route(withHeader(prefix("/")), "debug"),
+				limited(route(prefix("/api"), "web"), cfg),
+				route(prefix("/"), "web"),
+				route(prefix("/api"), "unreachable"),
+			},
+		},
+		"case insensitive route": {
+			rule: Route{PathExact: "/login"},
+			routes: []*envoy_route_v3.Route{
+				route(&envoy_route_v3.RouteMatch{
+					PathSpecifier: &envoy_route_v3.RouteMatch_Path{Path: "/LOGIN"},
+					CaseSensitive: wrapperspb.Bool(false),
+				}, "login"),
+			},
+			expected: []*envoy_route_v3.Route{
+				limited(route(exact("/login"), "login"), cfg),
+				route(&envoy_route_v3.RouteMatch{
+					PathSpecifier: &envoy_route_v3.RouteMatch_Path{Path: "/LOGIN"},
+					CaseSensitive: wrapperspb.Bool(false),
+				}, "login"),
+			},
+		},
+		"no covering route": {
+			rule:     Route{PathPrefix: "/api"},
+			routes:   []*envoy_route_v3.Route{route(prefix("/web"), "web")},
+			expected: []*envoy_route_v3.Route{route(prefix("/web"), "web")},
+		},
+	}
+
+	for name, c := range cases {
+		c := c
+		t.Run(name, func(t *testing.T) {
+			got := c.rule.apply(c.routes, cfg)
+			require.Len(t, got, len(c.expected))
+			for i := range c.expected {
+				require.True(t, proto.Equal(c.expected[i], got[i]), "route %d: expected %v, got %v", i, c.expected[i], got[i])
+			}
+		})
+	}
+}
@@ -0,0 +1,257 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package localratelimit
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	envoy_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/hashicorp/go-multierror"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// minFillInterval is the shortest fill interval accepted by Envoy.
+const minFillInterval = 50 * time.Millisecond
+
+// TokenBucket configures the rate at which requests are allowed. Each request
+// consumes a token and is rejected when the bucket is empty.
+type TokenBucket struct {
+	// MaxTokens is the size of the bucket, and the number of tokens it starts with.
+	MaxTokens int
+	// TokensPerFill is the number of tokens added to the bucket every fill interval.
+	// It defaults to one.
+	TokensPerFill *int
+	// FillInterval is the interval at which tokens are added to the bucket, as a
+	// duration string such as "1s".
+	FillInterval string
+
+	fillInterval time.Duration
+}
+
+func (b *TokenBucket) validate() error {
+	var resultErr error
+	if b.MaxTokens <= 0 {
+		resultErr = multierror.Append(resultErr, fmt.Errorf("MaxTokens must be greater than 0"))
+	}
+
+	if b.TokensPerFill != nil && *b.TokensPerFill <= 0 {
+		resultErr = multierror.Append(resultErr, fmt.Errorf("TokensPerFill must be greater than 0"))
+	}
+
+	if b.FillInterval == "" {
+		resultErr = multierror.Append(resultErr, fmt.Errorf("FillInterval is required"))
+	} else if d, err := time.ParseDuration(b.FillInterval); err != nil {
+		resultErr = multierror.Append(resultErr, fmt.Errorf("failed to parse FillInterval %q as a duration: %w", b.FillInterval, err))
+	} else if d < minFillInterval {
+		resultErr = multierror.Append(resultErr, fmt.Errorf("FillInterval must be at least %s", minFillInterval))
+	} else {
+		b.fillInterval = d
+	}
+
+	return resultErr
+}
+
+func (b *TokenBucket) toEnvoy() *envoy_type_v3.TokenBucket {
+	bucket := &envoy_type_v3.TokenBucket{
+		MaxTokens:    uint32(b.MaxTokens),
+		FillInterval: durationpb.New(b.fillInterval),
+	}
+	if b.TokensPerFill != nil {
+		bucket.TokensPerFill = wrapperspb.UInt32(uint32(*b.TokensPerFill))
+	}
+	return bucket
+}
+
+// Route limits the requests whose path matches with a token bucket. Exactly
+// one of PathExact, PathPrefix and PathRegex must be set.
+type Route struct {
+	PathExact  string
+	PathPrefix string
+	PathRegex  string
+
+	TokenBucket TokenBucket
+}
+
+func (r *Route) validate() error {
+	var resultErr error
+
+	count := 0
+	for _, path := range []string{r.PathExact, r.PathPrefix, r.PathRegex} {
+		if path != "" {
+			count++
+		}
+	}
+	if count != 1 {
+		resultErr = multierror.Append(resultErr, fmt.Errorf("exactly one of PathExact, PathPrefix or PathRegex must be set"))
+	}
+
+	if r.PathExact != "" && !strings.HasPrefix(r.PathExact, "/") {
+		resultErr = multierror.Append(resultErr, fmt.Errorf("PathExact %q must begin with a '/'", r.PathExact))
+	}
+	if r.PathPrefix != "" && !strings.HasPrefix(r.PathPrefix, "/") {
+		resultErr = multierror.Append(resultErr, fmt.Errorf("PathPrefix %q must begin with a '/'", r.PathPrefix))
+	}
+	if r.PathRegex != "" {
+		if _, err := regexp.Compile(r.PathRegex); err != nil {
+			resultErr = multierror.Append(resultErr, fmt.Errorf("failed to compile PathRegex %q: %w", r.PathRegex, err))
+		}
+	}
+
+	if err := r.TokenBucket.validate(); err != nil {
+		resultErr = multierror.Append(resultErr, fmt.Errorf("failed to validate TokenBucket: %w", err))
+	}
+
+	return resultErr
+}
+
+// apply sets cfg on the routes whose requests all match the path of r. For
+// each route that matches requests on the path of r along with other requests,
+// it adds a copy of the route restricted to the path of r, so that those
+// requests are limited without changing where they are routed. Routes after
+// the first one that matches every request on the path of r are not modified.
+//
+// Routes that were already limited by an earlier Route are left unchanged, so
+// when the paths of several Routes overlap, the first one applies.
+func (r *Route) apply(routes []*envoy_route_v3.Route, cfg *anypb.Any) []*envoy_route_v3.Route {
+	patched := make([]*envoy_route_v3.Route, 0, len(routes)+1)
+	for i, route := range routes {
+		switch {
+		case isLimited(route):
+		case r.contains(route.Match):
+			setTypedPerFilterConfig(&route.TypedPerFilterConfig, cfg)
+		case r.containedBy(route.Match):
+			patched = append(patched, r.restrict(route, cfg))
+		}
+		patched = append(patched, route)
+
+		if r.containedBy(route.Match) && matchesAllRequestsOnPath(route.Match) {
+			// No request on the path of r reaches the remaining routes.
+			return append(patched, routes[i+1:]...)
+		}
+	}
+	return patched
+}
+
+// restrict returns a copy of route that only matches requests on the path of r,
+// limited by cfg.
+func (r *Route) restrict(route *envoy_route_v3.Route, cfg *anypb.Any) *envoy_route_v3.Route {
+	limited := proto.Clone(route).(*envoy_route_v3.Route)
+	limited.Name = ""
+	limited.Match.PathSpecifier = r.toEnvoyRouteMatch().PathSpecifier
+	limited.Match.CaseSensitive = nil
+	limited.TypedPerFilterConfig = nil
+	setTypedPerFilterConfig(&limited.TypedPerFilterConfig, cfg)
+	return limited
+}
+
+func isLimited(route *envoy_route_v3.Route) bool {
+	_, ok := route.TypedPerFilterConfig[localRateLimitFilterName]
+	return ok
+}
+
+// contains returns true if every request matched by m also matches the path of r.
+func (r *Route) contains(m *envoy_route_v3.RouteMatch) bool {
+	if !isCaseSensitive(m) {
+		return false
+	}
+	switch {
+	case r.PathPrefix != "":
+		switch {
+		case m.GetPrefix() != "":
+			return strings.HasPrefix(m.GetPrefix(), r.PathPrefix)
+		case m.GetPath() != "":
+			return strings.HasPrefix(m.GetPath(), r.PathPrefix)
+		case m.GetPathSeparatedPrefix() != "":
+			return strings.HasPrefix(m.GetPathSeparatedPrefix(), r.PathPrefix)
+		}
+	case r.PathExact != "":
+		return m.GetPath() == r.PathExact
+	case r.PathRegex != "":
+		return m.GetSafeRegex().GetRegex() == r.PathRegex
+	}
+	return false
+}
+
+// containedBy returns true if the path of m matches every path that matches r.
+// The headers and query parameters matched by m are not considered.
+func (r *Route) containedBy(m *envoy_route_v3.RouteMatch) bool {
+	hasPrefix := strings.HasPrefix
+	equal := func(a, b string) bool { return a == b }
+	if !isCaseSensitive(m) {
+		hasPrefix = func(s, prefix string) bool {
+			return strings.HasPrefix(strings.ToLower(s), strings.ToLower(prefix))
+		}
+		equal = strings.EqualFold
+	}
+
+	switch {
+	case m.GetPrefix() != "":
+		switch {
+		case r.PathPrefix != "":
+			return hasPrefix(r.PathPrefix, m.GetPrefix())
+		case r.PathExact != "":
+			return hasPrefix(r.PathExact, m.GetPrefix())
+		case r.PathRegex != "":
+			return m.GetPrefix() == "/"
+		}
+	case m.GetPath() != "":
+		return r.PathExact != "" && equal(r.PathExact, m.GetPath())
+	case m.GetSafeRegex() != nil:
+		return r.PathRegex != "" && r.PathRegex == m.GetSafeRegex().GetRegex()
+	}
+	return false
+}
+
+func isCaseSensitive(m *envoy_route_v3.RouteMatch) bool {
+	return m.CaseSensitive == nil || m.CaseSensitive.Value
+}
+
+// matchesAllRequestsOnPath returns true if m matches requests on its path
+// regardless of their headers and query parameters.
+func matchesAllRequestsOnPath(m *envoy_route_v3.RouteMatch) bool {
+	return len(m.Headers) == 0 &&
+		len(m.QueryParameters) == 0 &&
+		m.RuntimeFraction == nil &&
+		m.Grpc == nil &&
+		m.TlsContext == nil &&
+		len(m.DynamicMetadata) == 0
+}
+
+func (r *Route) toEnvoyRouteMatch() *envoy_route_v3.RouteMatch {
+	switch {
+	case r.PathPrefix != "":
+		return &envoy_route_v3.RouteMatch{
+			PathSpecifier: &envoy_route_v3.RouteMatch_Prefix{Prefix: r.PathPrefix},
+		}
+	case r.PathExact != "":
+		return &envoy_route_v3.RouteMatch{
+			PathSpecifier: &envoy_route_v3.RouteMatch_Path{Path: r.PathExact},
+		}
+	default:
+		return &envoy_route_v3.RouteMatch{
+			PathSpecifier: &envoy_route_v3.RouteMatch_SafeRegex{
+				SafeRegex: &envoy_type_matcher_v3.RegexMatcher{Regex: r.PathRegex},
+			},
+		}
+	}
+}
+
+func fullRuntimeFraction(runtimeKey string) *envoy_core_v3.RuntimeFractionalPercent {
+	return &envoy_core_v3.RuntimeFractionalPercent{
+		DefaultValue: &envoy_type_v3.FractionalPercent{
+			Numerator:   100,
+			Denominator: envoy_type_v3.FractionalPercent_HUNDRED,
+		},
+		RuntimeKey: runtimeKey,
+	}
+}
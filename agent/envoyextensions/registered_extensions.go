@@ -10,7 +10,11 @@ import (
 	"github.com/hashicorp/go-version"
 
 	awslambda "github.com/hashicorp/consul/agent/envoyextensions/builtin/aws-lambda"
+	"github.com/hashicorp/consul/agent/envoyextensions/builtin/compression"
+	"github.com/hashicorp/consul/agent/envoyextensions/builtin/cors"
 	extauthz "github.com/hashicorp/consul/agent/envoyextensions/builtin/ext-authz"
+	extproc "github.com/hashicorp/consul/agent/envoyextensions/builtin/ext-proc"
+	localratelimit "github.com/hashicorp/consul/agent/envoyextensions/builtin/local-ratelimit"
 	"github.com/hashicorp/consul/agent/envoyextensions/builtin/lua"
 	otelaccesslogging "github.com/hashicorp/consul/agent/envoyextensions/builtin/otel-access-logging"
 	propertyoverride "github.com/hashicorp/consul/agent/envoyextensions/builtin/property-override"
@@ -28,6 +32,10 @@ var extensionConstructors = map[string]extensionConstructor{
 	api.BuiltinPropertyOverrideExtension:  propertyoverride.Constructor,
 	api.BuiltinWasmExtension:              wasm.Constructor,
 	api.BuiltinExtAuthzExtension:          extauthz.Constructor,
+	api.BuiltinExtProcExtension:           extproc.Constructor,
+	api.BuiltinCORSExtension:              cors.Constructor,
+	api.BuiltinCompressionExtension:       compression.Constructor,
+	api.BuiltinLocalRateLimitExtension:    localratelimit.Constructor,
 }
 
 // ConstructExtension attempts to lookup and build an extension from the registry with the
@@ -16,13 +16,13 @@ type MockCAManager struct {
 	mock.Mock
 }
 
-// AuthorizeAndSignCertificate provides a mock function with given fields: csr, authz
-func (_m *MockCAManager) AuthorizeAndSignCertificate(csr *x509.CertificateRequest, authz acl.Authorizer) (*structs.IssuedCert, error) {
-	ret := _m.Called(csr, authz)
+// AuthorizeAndSignCertificate provides a mock function with given fields: csr, authz, node
+func (_m *MockCAManager) AuthorizeAndSignCertificate(csr *x509.CertificateRequest, authz acl.Authorizer, node string) (*structs.IssuedCert, error) {
+	ret := _m.Called(csr, authz, node)
 
 	var r0 *structs.IssuedCert
-	if rf, ok := ret.Get(0).(func(*x509.CertificateRequest, acl.Authorizer) *structs.IssuedCert); ok {
-		r0 = rf(csr, authz)
+	if rf, ok := ret.Get(0).(func(*x509.CertificateRequest, acl.Authorizer, string) *structs.IssuedCert); ok {
+		r0 = rf(csr, authz, node)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*structs.IssuedCert)
@@ -30,8 +30,8 @@ func (_m *MockCAManager) AuthorizeAndSignCertificate(csr *x509.CertificateReques
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*x509.CertificateRequest, acl.Authorizer) error); ok {
-		r1 = rf(csr, authz)
+	if rf, ok := ret.Get(1).(func(*x509.CertificateRequest, acl.Authorizer, string) error); ok {
+		r1 = rf(csr, authz, node)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -50,7 +50,7 @@ type ACLResolver interface {
 
 //go:generate mockery --name CAManager --inpackage
 type CAManager interface {
-	AuthorizeAndSignCertificate(csr *x509.CertificateRequest, authz acl.Authorizer) (*structs.IssuedCert, error)
+	AuthorizeAndSignCertificate(csr *x509.CertificateRequest, authz acl.Authorizer, node string) (*structs.IssuedCert, error)
 }
 
 func NewServer(cfg Config) *Server {
@@ -73,7 +73,7 @@ func (s *Server) Sign(ctx context.Context, req *pbconnectca.SignRequest) (*pbcon
 		return nil, status.Error(codes.Unauthenticated, err.Error())
 	}
 
-	cert, err := s.CAManager.AuthorizeAndSignCertificate(csr, authz)
+	cert, err := s.CAManager.AuthorizeAndSignCertificate(csr, authz, "")
 	switch {
 	case connect.IsInvalidCSRError(err):
 		return nil, status.Error(codes.InvalidArgument, err.Error())
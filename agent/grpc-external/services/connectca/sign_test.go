@@ -96,7 +96,7 @@ func TestSign_PermissionDenied(t *testing.T) {
 		Return(testutils.ACLsDisabled(t), nil)
 
 	caManager := &MockCAManager{}
-	caManager.On("AuthorizeAndSignCertificate", mock.Anything, mock.Anything).
+	caManager.On("AuthorizeAndSignCertificate", mock.Anything, mock.Anything, "").
 		Return(nil, acl.ErrPermissionDenied)
 
 	server := NewServer(Config{
@@ -122,7 +122,7 @@ func TestSign_InvalidCSR(t *testing.T) {
 		Return(testutils.ACLsDisabled(t), nil)
 
 	caManager := &MockCAManager{}
-	caManager.On("AuthorizeAndSignCertificate", mock.Anything, mock.Anything).
+	caManager.On("AuthorizeAndSignCertificate", mock.Anything, mock.Anything, "").
 		Return(nil, connect.InvalidCSRError("nope"))
 
 	server := NewServer(Config{
@@ -148,7 +148,7 @@ func TestSign_RateLimited(t *testing.T) {
 		Return(testutils.ACLsDisabled(t), nil)
 
 	caManager := &MockCAManager{}
-	caManager.On("AuthorizeAndSignCertificate", mock.Anything, mock.Anything).
+	caManager.On("AuthorizeAndSignCertificate", mock.Anything, mock.Anything, "").
 		Return(nil, errors.New("Rate limit reached, try again later"))
 
 	server := NewServer(Config{
@@ -174,7 +174,7 @@ func TestSign_InternalError(t *testing.T) {
 		Return(testutils.ACLsDisabled(t), nil)
 
 	caManager := &MockCAManager{}
-	caManager.On("AuthorizeAndSignCertificate", mock.Anything, mock.Anything).
+	caManager.On("AuthorizeAndSignCertificate", mock.Anything, mock.Anything, "").
 		Return(nil, errors.New("something went very wrong"))
 
 	server := NewServer(Config{
@@ -200,7 +200,7 @@ func TestSign_Success(t *testing.T) {
 		Return(testutils.ACLsDisabled(t), nil)
 
 	caManager := &MockCAManager{}
-	caManager.On("AuthorizeAndSignCertificate", mock.Anything, mock.Anything).
+	caManager.On("AuthorizeAndSignCertificate", mock.Anything, mock.Anything, "").
 		Return(&structs.IssuedCert{CertPEM: "this is the PEM"}, nil)
 
 	server := NewServer(Config{
@@ -226,7 +226,7 @@ func TestSign_RPCForwarding(t *testing.T) {
 		Return(testutils.ACLsDisabled(t), nil)
 
 	caManager := &MockCAManager{}
-	caManager.On("AuthorizeAndSignCertificate", mock.Anything, mock.Anything).
+	caManager.On("AuthorizeAndSignCertificate", mock.Anything, mock.Anything, "").
 		Return(&structs.IssuedCert{CertPEM: "leader response"}, nil)
 
 	leader := NewServer(Config{
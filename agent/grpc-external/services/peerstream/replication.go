@@ -4,6 +4,7 @@
 package peerstream
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"strings"
@@ -17,6 +18,8 @@ import (
 	"github.com/hashicorp/consul/agent/cache"
 	"github.com/hashicorp/consul/agent/consul/state"
 	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/proto/private/pbconfigentry"
 	"github.com/hashicorp/consul/proto/private/pbpeering"
 	"github.com/hashicorp/consul/proto/private/pbpeerstream"
 	"github.com/hashicorp/consul/proto/private/pbservice"
@@ -123,6 +126,54 @@ func makeServerAddrsResponse(
 	}, nil
 }
 
+func makeExportedKVEntriesResponse(
+	update cache.UpdateEvent,
+) (*pbpeerstream.ReplicationMessage_Response, error) {
+	any, _, err := marshalToProtoAny[*pbpeerstream.ExportedKVEntries](update.Result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal: %w", err)
+	}
+
+	return &pbpeerstream.ReplicationMessage_Response{
+		ResourceURL: pbpeerstream.TypeURLExportedKVEntries,
+		ResourceID:  "kv-entries",
+		Operation:   pbpeerstream.Operation_OPERATION_UPSERT,
+		Resource:    any,
+	}, nil
+}
+
+func makeExportedConfigEntriesResponse(
+	update cache.UpdateEvent,
+) (*pbpeerstream.ReplicationMessage_Response, error) {
+	any, _, err := marshalToProtoAny[*pbpeering.PeeringConfigEntries](update.Result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal: %w", err)
+	}
+
+	return &pbpeerstream.ReplicationMessage_Response{
+		ResourceURL: pbpeerstream.TypeURLPeeringConfigEntries,
+		ResourceID:  "config-entries",
+		Operation:   pbpeerstream.Operation_OPERATION_UPSERT,
+		Resource:    any,
+	}, nil
+}
+
+func makeStreamSecretResponse(
+	update cache.UpdateEvent,
+) (*pbpeerstream.ReplicationMessage_Response, error) {
+	any, _, err := marshalToProtoAny[*pbpeerstream.StreamSecret](update.Result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal: %w", err)
+	}
+
+	return &pbpeerstream.ReplicationMessage_Response{
+		ResourceURL: pbpeerstream.TypeURLStreamSecret,
+		ResourceID:  "stream-secret",
+		Operation:   pbpeerstream.Operation_OPERATION_UPSERT,
+		Resource:    any,
+	}, nil
+}
+
 // marshalToProtoAny takes any input and returns:
 // the protobuf.Any type, the asserted T type, and any errors
 // during marshalling or type assertion.
@@ -260,6 +311,30 @@ func (s *Server) handleUpsert(
 		}
 
 		return s.handleUpsertServerAddrs(peerName, partition, addrs)
+
+	case pbpeerstream.TypeURLPeeringConfigEntries:
+		entries := &pbpeering.PeeringConfigEntries{}
+		if err := resource.UnmarshalTo(entries); err != nil {
+			return fmt.Errorf("failed to unmarshal resource: %w", err)
+		}
+
+		return s.handleUpsertConfigEntries(peerName, partition, entries)
+
+	case pbpeerstream.TypeURLExportedKVEntries:
+		export := &pbpeerstream.ExportedKVEntries{}
+		if err := resource.UnmarshalTo(export); err != nil {
+			return fmt.Errorf("failed to unmarshal resource: %w", err)
+		}
+
+		return s.handleUpsertKVEntries(peerName, partition, export)
+
+	case pbpeerstream.TypeURLStreamSecret:
+		secret := &pbpeerstream.StreamSecret{}
+		if err := resource.UnmarshalTo(secret); err != nil {
+			return fmt.Errorf("failed to unmarshal resource: %w", err)
+		}
+
+		return s.handleUpsertStreamSecret(peerName, partition, secret)
 	default:
 		return fmt.Errorf("unexpected resourceURL: %s", resourceURL)
 	}
@@ -559,6 +634,32 @@ func (s *Server) handleUpsertRoots(
 	return s.Backend.PeeringTrustBundleWrite(req)
 }
 
+// handleUpsertConfigEntries replaces the config entries imported from the peer
+// with the full set of exported entries.
+func (s *Server) handleUpsertConfigEntries(
+	peerName string,
+	partition string,
+	entries *pbpeering.PeeringConfigEntries,
+) error {
+	// Guard against a peer sending config entries that cannot be exported.
+	for _, entry := range entries.Entries {
+		switch entry.Kind {
+		case pbconfigentry.Kind_KindServiceDefaults, pbconfigentry.Kind_KindServiceResolver, pbconfigentry.Kind_KindServiceRouter:
+		default:
+			return fmt.Errorf("config entries of kind %q cannot be imported", entry.Kind)
+		}
+	}
+
+	// We override the partition and peer name so that the entries get stored
+	// in the importing partition with a reference to the peer they were imported from.
+	entries.Partition = partition
+	entries.PeerName = peerName
+	req := &pbpeering.PeeringConfigEntriesWriteRequest{
+		PeeringConfigEntries: entries,
+	}
+	return s.Backend.PeeringConfigEntriesWrite(req)
+}
+
 func (s *Server) handleUpsertServerAddrs(
 	peerName string,
 	partition string,
@@ -586,6 +687,132 @@ func (s *Server) handleUpsertServerAddrs(
 	return s.Backend.PeeringWrite(req)
 }
 
+// handleUpsertStreamSecret persists the stream secret state sent by the
+// accepting peer. A new pending secret is stored alongside the active one so
+// that it is used on the next connection, and an empty pending secret
+// indicates that the acceptor has promoted ours, so it becomes the active one.
+func (s *Server) handleUpsertStreamSecret(
+	peerName string,
+	partition string,
+	secret *pbpeerstream.StreamSecret,
+) error {
+	q := state.Query{
+		Value:          peerName,
+		EnterpriseMeta: *structs.DefaultEnterpriseMetaInPartition(partition),
+	}
+	_, existing, err := s.GetStore().PeeringRead(nil, q)
+	if err != nil {
+		return fmt.Errorf("failed to read peering: %w", err)
+	}
+	if existing == nil || !existing.IsActive() {
+		return fmt.Errorf("peering does not exist or has been marked for deletion")
+	}
+
+	secrets, err := s.GetStore().PeeringSecretsRead(nil, existing.ID)
+	if err != nil {
+		return fmt.Errorf("failed to read peering secrets: %w", err)
+	}
+	localPending := secrets.GetStream().GetPendingSecretID()
+
+	var req *pbpeering.SecretsWriteRequest
+	switch pending := secret.GetPendingSecretID(); {
+	case pending != "" && pending != localPending:
+		req = &pbpeering.SecretsWriteRequest{
+			PeerID: existing.ID,
+			Request: &pbpeering.SecretsWriteRequest_RotateStream{
+				RotateStream: &pbpeering.SecretsWriteRequest_RotateStreamRequest{
+					PendingStreamSecret: pending,
+				},
+			},
+		}
+
+	case pending == "" && localPending != "":
+		req = &pbpeering.SecretsWriteRequest{
+			PeerID: existing.ID,
+			Request: &pbpeering.SecretsWriteRequest_PromotePending{
+				PromotePending: &pbpeering.SecretsWriteRequest_PromotePendingRequest{
+					ActiveStreamSecret: localPending,
+				},
+			},
+		}
+
+	default:
+		return nil
+	}
+
+	return s.Backend.PeeringWrite(&pbpeering.PeeringWriteRequest{
+		Peering:        existing,
+		SecretsRequest: req,
+	})
+}
+
+// handleUpsertKVEntries reconciles the KV entries imported from the peer, stored
+// under structs.PeeredKVPrefixForPeer, against the full set of exported entries.
+// Entries that are new or have changed are written and stored entries that are
+// no longer exported get deleted, all in a single transaction.
+func (s *Server) handleUpsertKVEntries(
+	peerName string,
+	partition string,
+	export *pbpeerstream.ExportedKVEntries,
+) error {
+	peerPrefix := structs.PeeredKVPrefixForPeer(peerName)
+	entMeta := structs.DefaultEnterpriseMetaInPartition(partition)
+
+	_, stored, err := s.GetStore().KVSList(nil, peerPrefix, entMeta)
+	if err != nil {
+		return fmt.Errorf("failed to read imported KV entries: %w", err)
+	}
+	storedByKey := make(map[string]*structs.DirEntry, len(stored))
+	for _, entry := range stored {
+		storedByKey[entry.Key] = entry
+	}
+
+	var ops structs.TxnOps
+	exported := make(map[string]struct{}, len(export.Entries))
+	for _, entry := range export.Entries {
+		key := peerPrefix + entry.Key
+		exported[key] = struct{}{}
+
+		if prev, ok := storedByKey[key]; ok && prev.Flags == entry.Flags && bytes.Equal(prev.Value, entry.Value) {
+			continue
+		}
+		ops = append(ops, &structs.TxnOp{
+			KV: &structs.TxnKVOp{
+				Verb: api.KVSet,
+				DirEnt: structs.DirEntry{
+					Key:            key,
+					Flags:          entry.Flags,
+					Value:          entry.Value,
+					EnterpriseMeta: *entMeta,
+				},
+			},
+		})
+	}
+
+	for key := range storedByKey {
+		if _, ok := exported[key]; ok {
+			continue
+		}
+		ops = append(ops, &structs.TxnOp{
+			KV: &structs.TxnKVOp{
+				Verb: api.KVDelete,
+				DirEnt: structs.DirEntry{
+					Key:            key,
+					EnterpriseMeta: *entMeta,
+				},
+			},
+		})
+	}
+
+	if len(ops) == 0 {
+		return nil
+	}
+	if err := s.Backend.KVSTxn(ops); err != nil {
+		return fmt.Errorf("failed to write imported KV entries: %w", err)
+	}
+	return nil
+}
+
 func makeACKReply(resourceURL, nonce string) *pbpeerstream.ReplicationMessage {
 	return makeReplicationRequest(&pbpeerstream.ReplicationMessage_Request{
 		ResourceURL:   resourceURL,
@@ -6,6 +6,7 @@ package peerstream
 import (
 	"time"
 
+	"github.com/armon/go-metrics/prometheus"
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-memdb"
 	"google.golang.org/grpc"
@@ -27,6 +28,34 @@ const (
 	defaultIncomingHeartbeatTimeout  = 2 * time.Minute
 )
 
+var (
+	replicationPendingUpdatesKey = []string{"peering", "replication", "pending_updates"}
+	replicationLastApplyAgeKey   = []string{"peering", "replication", "last_apply_age"}
+	replicationNacksKey          = []string{"peering", "replication", "nacks"}
+	replicationApplyErrorsKey    = []string{"peering", "replication", "apply_errors"}
+
+	ReplicationGauges = []prometheus.GaugeDefinition{
+		{
+			Name: replicationPendingUpdatesKey,
+			Help: "Measures the number of resource updates sent to a peer that were not acknowledged yet, split by resource type.",
+		},
+		{
+			Name: replicationLastApplyAgeKey,
+			Help: "Measures the number of seconds since a resource update from a peer was last applied successfully, split by resource type.",
+		},
+	}
+	ReplicationCounters = []prometheus.CounterDefinition{
+		{
+			Name: replicationNacksKey,
+			Help: "Counts the number of resource updates sent to a peer that the peer failed to apply, split by resource type.",
+		},
+		{
+			Name: replicationApplyErrorsKey,
+			Help: "Counts the number of resource updates received from a peer that failed to be applied, split by resource type.",
+		},
+	}
+)
+
 type Server struct {
 	Config
 
@@ -106,8 +135,11 @@ type Backend interface {
 	PeeringSecretsWrite(req *pbpeering.SecretsWriteRequest) error
 	PeeringTerminateByID(req *pbpeering.PeeringTerminateByIDRequest) error
 	PeeringTrustBundleWrite(req *pbpeering.PeeringTrustBundleWriteRequest) error
+	PeeringConfigEntriesWrite(req *pbpeering.PeeringConfigEntriesWriteRequest) error
 	CatalogRegister(req *structs.RegisterRequest) error
 	CatalogDeregister(req *structs.DeregisterRequest) error
+	// KVSTxn applies the KV operations in a single transaction.
+	KVSTxn(ops structs.TxnOps) error
 	PeeringWrite(req *pbpeering.PeeringWriteRequest) error
 }
 
@@ -120,6 +152,9 @@ type StateStore interface {
 	PeeringTrustBundleList(ws memdb.WatchSet, entMeta acl.EnterpriseMeta) (uint64, []*pbpeering.PeeringTrustBundle, error)
 	PeeringSecretsRead(ws memdb.WatchSet, peerID string) (*pbpeering.PeeringSecrets, error)
 	ExportedServicesForPeer(ws memdb.WatchSet, peerID, dc string) (uint64, *structs.ExportedServiceList, error)
+	ExportedKeyPrefixesForPeer(ws memdb.WatchSet, peerID string) (uint64, []string, error)
+	ExportedConfigEntriesForPeer(ws memdb.WatchSet, peerID string) (uint64, []structs.ConfigEntry, error)
+	KVSList(ws memdb.WatchSet, prefix string, entMeta *acl.EnterpriseMeta) (uint64, structs.DirEntries, error)
 	ServiceDump(ws memdb.WatchSet, kind structs.ServiceKind, useKind bool, entMeta *acl.EnterpriseMeta, peerName string) (uint64, structs.CheckServiceNodes, error)
 	CheckServiceNodes(ws memdb.WatchSet, serviceName string, entMeta *acl.EnterpriseMeta, peerName string) (uint64, structs.CheckServiceNodes, error)
 	NodeServiceList(ws memdb.WatchSet, nodeNameOrID string, entMeta *acl.EnterpriseMeta, peerName string) (uint64, *structs.NodeServiceList, error)
@@ -8,6 +8,7 @@ import (
 	"crypto/subtle"
 	"fmt"
 	"io"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/go-hclog"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	grpcstatus "google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
@@ -245,12 +247,20 @@ func (s *Server) StreamResources(stream pbpeerstream.PeerStreamService_StreamRes
 		return grpcstatus.Error(codes.InvalidArgument, "expected PeerID to be empty; the wrong end of peering is being dialed")
 	}
 
+	// Advertise the optional resource types we support to the dialer, which
+	// reads them from the response header.
+	if err := stream.SendHeader(metadata.MD{resourceURLsMetadataKey: pbpeerstream.OptionalTypeURLs}); err != nil {
+		return grpcstatus.Errorf(codes.Internal, "failed to send stream header: %v", err)
+	}
+	md, _ := metadata.FromIncomingContext(stream.Context())
+
 	streamReq := HandleStreamRequest{
-		LocalID:   p.ID,
-		RemoteID:  "",
-		PeerName:  p.Name,
-		Partition: p.Partition,
-		Stream:    stream,
+		LocalID:            p.ID,
+		RemoteID:           "",
+		PeerName:           p.Name,
+		Partition:          p.Partition,
+		Stream:             stream,
+		RemoteResourceURLs: RemoteResourceURLs(md),
 	}
 	err = s.HandleStream(streamReq)
 	// A nil error indicates that the peering was deleted and the stream needs to be gracefully shutdown.
@@ -278,12 +288,51 @@ type HandleStreamRequest struct {
 
 	// Stream is the open stream to the peer cluster.
 	Stream BidirectionalStream
+
+	// RemoteResourceURLs are the optional resource types the peer advertised
+	// support for.
+	RemoteResourceURLs []string
+
+	// RejectedPendingSecret is a pending stream secret the acceptor refused
+	// when the dialer last connected with it.
+	RejectedPendingSecret string
 }
 
 func (r HandleStreamRequest) IsAcceptor() bool {
 	return r.RemoteID == ""
 }
 
+// remoteSupports returns whether the peer can serve and be subscribed to the
+// given resource type.
+func (r HandleStreamRequest) remoteSupports(resourceURL string) bool {
+	if !slices.Contains(pbpeerstream.OptionalTypeURLs, resourceURL) {
+		return true
+	}
+	return slices.Contains(r.RemoteResourceURLs, resourceURL)
+}
+
+// resourceURLsMetadataKey is the gRPC metadata key peers advertise the optional
+// resource types they support in. The dialer sends it with the stream request
+// and the acceptor in the response header.
+const resourceURLsMetadataKey = "consul-peering-resource-urls"
+
+// AdvertiseResourceURLs returns a context that advertises the optional
+// resource types this server supports to the acceptor of a peering stream
+// opened with it.
+func AdvertiseResourceURLs(ctx context.Context) context.Context {
+	kv := make([]string, 0, 2*len(pbpeerstream.OptionalTypeURLs))
+	for _, resourceURL := range pbpeerstream.OptionalTypeURLs {
+		kv = append(kv, resourceURLsMetadataKey, resourceURL)
+	}
+	return metadata.AppendToOutgoingContext(ctx, kv...)
+}
+
+// RemoteResourceURLs returns the optional resource types a peer advertised in
+// the metadata of a peering stream.
+func RemoteResourceURLs(md metadata.MD) []string {
+	return md.Get(resourceURLsMetadataKey)
+}
+
 // DrainStream attempts to gracefully drain the stream when the connection is going to be torn down.
 // Tearing down the connection too quickly can lead our peer receiving a context cancellation error before the stream termination message.
 // Handling the termination message is important to set the expectation that the peering will not be reestablished unless recreated.
@@ -330,6 +379,7 @@ func (s *Server) realHandleStream(streamReq HandleStreamRequest) error {
 	if err != nil {
 		return fmt.Errorf("failed to register stream: %v", err)
 	}
+	status.TrackRemoteResourceURLs(streamReq.RemoteResourceURLs)
 
 	var trustDomain string
 	if s.ConnectEnabled {
@@ -387,14 +437,24 @@ func (s *Server) realHandleStream(streamReq HandleStreamRequest) error {
 		pbpeerstream.TypeURLExportedService,
 		pbpeerstream.TypeURLExportedServiceList,
 		pbpeerstream.TypeURLPeeringTrustBundle,
+		pbpeerstream.TypeURLExportedKVEntries,
+		pbpeerstream.TypeURLPeeringConfigEntries,
 	}
 	// Acceptors should not subscribe to server address updates, because they should always have an empty list.
+	// They also issue the stream secrets, so only dialers subscribe to them.
 	if !streamReq.IsAcceptor() {
-		resources = append(resources, pbpeerstream.TypeURLPeeringServerAddresses)
+		resources = append(resources,
+			pbpeerstream.TypeURLPeeringServerAddresses,
+			pbpeerstream.TypeURLStreamSecret,
+		)
 	}
 
-	// Subscribe to all relevant resource types.
+	// Subscribe to all relevant resource types the peer supports.
 	for _, resourceURL := range resources {
+		if !streamReq.remoteSupports(resourceURL) {
+			logger.Debug("peer does not support resource type, skipping subscription", "resourceURL", resourceURL)
+			continue
+		}
 		sub := makeReplicationRequest(&pbpeerstream.ReplicationMessage_Request{
 			ResourceURL: resourceURL,
 			PeerID:      streamReq.RemoteID,
@@ -441,12 +501,14 @@ func (s *Server) realHandleStream(streamReq HandleStreamRequest) error {
 			case <-tick.C:
 				heartbeat := &pbpeerstream.ReplicationMessage{
 					Payload: &pbpeerstream.ReplicationMessage_Heartbeat_{
-						Heartbeat: &pbpeerstream.ReplicationMessage_Heartbeat{},
+						Heartbeat: status.newHeartbeat(time.Now()),
 					},
 				}
 				if err := streamSend(heartbeat); err != nil {
 					logger.Warn("error sending heartbeat", "err", err)
 				}
+
+				emitReplicationMetrics(metrics.Default(), streamReq, status.GetStatus(), time.Now())
 			}
 		}
 	}()
@@ -528,7 +590,10 @@ func (s *Server) realHandleStream(streamReq HandleStreamRequest) error {
 
 			if req := msg.GetRequest(); req != nil {
 				if !pbpeerstream.KnownTypeURL(req.ResourceURL) {
-					return grpcstatus.Errorf(codes.InvalidArgument, "subscription request to unknown resource URL: %s", req.ResourceURL)
+					// Peers on newer versions may subscribe to resource types we
+					// don't know yet, which must not take the stream down.
+					logger.Warn("ignoring subscription request to unknown resource URL", "resourceURL", req.ResourceURL)
+					continue
 				}
 
 				// There are different formats of requests depending upon where in the stream lifecycle we are.
@@ -596,12 +661,14 @@ func (s *Server) realHandleStream(streamReq HandleStreamRequest) error {
 				switch {
 				case req.Error == nil: // ACK
 					// TODO(peering): handle ACK fully
-					status.TrackAck()
+					status.TrackResourceAck(req.ResourceURL, req.ResponseNonce)
 
 				case req.Error != nil: // NACK
 					// TODO(peering): handle NACK fully
 					logger.Warn("client peer was unable to apply resource", "code", req.Error.Code, "error", req.Error.Message)
-					status.TrackNack(fmt.Sprintf("client peer was unable to apply resource: %s", req.Error.Message))
+					status.TrackResourceNack(req.ResourceURL, req.ResponseNonce,
+						fmt.Sprintf("client peer was unable to apply resource: %s", req.Error.Message))
+					metrics.IncrCounterWithLabels(replicationNacksKey, 1, replicationMetricLabels(streamReq, req.ResourceURL))
 
 				default:
 					// This branch might be dead code, but it could also happen
@@ -616,9 +683,10 @@ func (s *Server) realHandleStream(streamReq HandleStreamRequest) error {
 				reply, err := s.processResponse(streamReq.PeerName, streamReq.Partition, status, resp)
 				if err != nil {
 					logger.Error("failed to persist resource", "resourceURL", resp.ResourceURL, "resourceID", resp.ResourceID)
-					status.TrackRecvError(err.Error())
+					status.TrackResourceApplyError(resp.ResourceURL, resp.ResourceID, resp.Nonce, err.Error())
+					metrics.IncrCounterWithLabels(replicationApplyErrorsKey, 1, replicationMetricLabels(streamReq, resp.ResourceURL))
 				} else {
-					status.TrackRecvResourceSuccess()
+					status.TrackResourceApplied(resp.ResourceURL, resp.ResourceID, resp.Nonce)
 				}
 
 				// We are replying ACK or NACK depending on whether we successfully processed the response.
@@ -626,6 +694,13 @@ func (s *Server) realHandleStream(streamReq HandleStreamRequest) error {
 					return fmt.Errorf("failed to send to stream: %v", err)
 				}
 
+				// When the acceptor rotates the stream secret, the dialer reconnects with the pending
+				// secret so that the acceptor can retire the previous one.
+				if err == nil && resp.ResourceURL == pbpeerstream.TypeURLStreamSecret && s.shouldReconnectWithPendingSecret(streamReq) {
+					logger.Info("peering stream secret was rotated by our peer; reconnecting with the new secret")
+					return errStreamSecretRotated
+				}
+
 				continue
 			}
 
@@ -639,8 +714,9 @@ func (s *Server) realHandleStream(streamReq HandleStreamRequest) error {
 				return nil
 			}
 
-			if msg.GetHeartbeat() != nil {
+			if heartbeat := msg.GetHeartbeat(); heartbeat != nil {
 				status.TrackRecvHeartbeat()
+				status.TrackHeartbeatEcho(heartbeat, time.Now())
 
 				// Reset the heartbeat timeout by creating a new context.
 				// We first must cancel the old context so there's no leaks. This is safe to do because we're only
@@ -688,6 +764,27 @@ func (s *Server) realHandleStream(streamReq HandleStreamRequest) error {
 					continue
 				}
 
+			case update.CorrelationID == subExportedConfigEntries:
+				resp, err = makeExportedConfigEntriesResponse(update)
+				if err != nil {
+					logger.Error("failed to create exported config entries response", "error", err)
+					continue
+				}
+
+			case update.CorrelationID == subExportedKVEntries:
+				resp, err = makeExportedKVEntriesResponse(update)
+				if err != nil {
+					logger.Error("failed to create exported kv entries response", "error", err)
+					continue
+				}
+
+			case update.CorrelationID == subStreamSecret:
+				resp, err = makeStreamSecretResponse(update)
+				if err != nil {
+					logger.Error("failed to create stream secret response", "error", err)
+					continue
+				}
+
 			default:
 				logger.Warn("unrecognized update type from subscription manager: " + update.CorrelationID)
 				continue
@@ -705,10 +802,29 @@ func (s *Server) realHandleStream(streamReq HandleStreamRequest) error {
 				// note: govet warns of context leak but it is cleaned up in a defer
 				return fmt.Errorf("failed to push data for %q: %w", update.CorrelationID, err)
 			}
+			status.TrackResourceSent(resp.ResourceURL, resp.ResourceID, resp.Nonce)
 		}
 	}
 }
 
+// errStreamSecretRotated is returned by dialers after persisting a pending stream
+// secret. It uses the FailedPrecondition code so that the stream is re-established
+// right away, this time authorized with the pending secret.
+var errStreamSecretRotated = grpcstatus.Error(codes.FailedPrecondition, "peering stream secret was rotated; reconnecting with the pending secret")
+
+// shouldReconnectWithPendingSecret returns true if the peering has a stream
+// secret that has not been promoted to be the active one yet, and that the
+// acceptor didn't already refuse. Reconnecting with a refused secret would only
+// fall back to the active one and receive the same pending secret again.
+func (s *Server) shouldReconnectWithPendingSecret(streamReq HandleStreamRequest) bool {
+	secrets, err := s.GetStore().PeeringSecretsRead(nil, streamReq.LocalID)
+	if err != nil {
+		return false
+	}
+	pending := secrets.GetStream().GetPendingSecretID()
+	return pending != "" && pending != streamReq.RejectedPendingSecret
+}
+
 func getTrustDomain(store StateStore, logger hclog.Logger) (string, error) {
 	_, cfg, err := store.CAConfig(nil)
 	switch {
@@ -722,6 +838,35 @@ func getTrustDomain(store StateStore, logger hclog.Logger) (string, error) {
 	return connect.SpiffeIDSigningForCluster(cfg.ClusterID).Host(), nil
 }
 
+// replicationMetricLabels returns the labels for the replication metrics of a
+// resource type on the given stream.
+func replicationMetricLabels(streamReq HandleStreamRequest, resourceURL string) []metrics.Label {
+	labels := []metrics.Label{
+		{Name: "peer_name", Value: streamReq.PeerName},
+		{Name: "peer_id", Value: streamReq.LocalID},
+		{Name: "resource_type", Value: resourceURL[strings.LastIndex(resourceURL, ".")+1:]},
+	}
+	if streamReq.Partition != "" {
+		labels = append(labels, metrics.Label{Name: "partition", Value: streamReq.Partition})
+	}
+	return labels
+}
+
+// emitReplicationMetrics emits the replication lag gauges for each resource
+// type replicated over the stream.
+func emitReplicationMetrics(metricsImpl *metrics.Metrics, streamReq HandleStreamRequest, status Status, now time.Time) {
+	for url, r := range status.ExportedResources {
+		metricsImpl.SetGaugeWithLabels(replicationPendingUpdatesKey, float32(r.PendingUpdates), replicationMetricLabels(streamReq, url))
+	}
+	for url, r := range status.ImportedResources {
+		if r.LastApply == nil {
+			continue
+		}
+		age := now.Sub(*r.LastApply).Seconds()
+		metricsImpl.SetGaugeWithLabels(replicationLastApplyAgeKey, float32(age), replicationMetricLabels(streamReq, url))
+	}
+}
+
 func (s *Server) StreamStatus(peerID string) (resp Status, found bool) {
 	return s.Tracker.StreamStatus(peerID)
 }
@@ -760,6 +905,10 @@ func logTraceProto(logger hclog.Logger, pb proto.Message, received bool) {
 			clone.GetOpen().StreamSecretID = "hidden"
 			pbToLog = clone
 		}
+		if clone.GetResponse().GetResourceURL() == pbpeerstream.TypeURLStreamSecret {
+			clone.GetResponse().Resource = nil
+			pbToLog = clone
+		}
 	case *pbpeerstream.ReplicationMessage_Open:
 		clone := &pbpeerstream.ReplicationMessage_Open{}
 		proto.Merge(clone, msg)
@@ -14,11 +14,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/armon/go-metrics"
 	"github.com/hashicorp/go-uuid"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/genproto/googleapis/rpc/code"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	newproto "google.golang.org/protobuf/proto"
@@ -33,6 +35,7 @@ import (
 	"github.com/hashicorp/consul/lib"
 	"github.com/hashicorp/consul/logging"
 	"github.com/hashicorp/consul/proto/private/pbcommon"
+	"github.com/hashicorp/consul/proto/private/pbconfigentry"
 	"github.com/hashicorp/consul/proto/private/pbpeering"
 	"github.com/hashicorp/consul/proto/private/pbpeerstream"
 	"github.com/hashicorp/consul/proto/private/pbservice"
@@ -546,6 +549,72 @@ func TestStreamResources_Server_Terminate(t *testing.T) {
 	prototest.AssertDeepEqual(t, expect, receivedTerm)
 }
 
+func TestStreamResources_Server_OptionalResourceTypes(t *testing.T) {
+	srv, store := newTestServer(t, nil)
+	writePeeringToBeDialed(t, store, 1, "my-peer")
+	writeInitialRootsAndCA(t, store)
+
+	// A dialer on an older version doesn't advertise any optional resource
+	// types.
+	client := NewMockClient(context.Background())
+	errCh := make(chan error, 1)
+	client.ErrCh = errCh
+	go func() {
+		if err := srv.StreamResources(client.ReplicationStream); err != nil {
+			errCh <- err
+		}
+	}()
+	require.NoError(t, client.Send(&pbpeerstream.ReplicationMessage{
+		Payload: &pbpeerstream.ReplicationMessage_Open_{
+			Open: &pbpeerstream.ReplicationMessage_Open{
+				PeerID:         testPeerID,
+				StreamSecretID: testPendingStreamSecretID,
+			},
+		},
+	}))
+
+	testutil.RunStep(t, "only required resource types are subscribed to", func(t *testing.T) {
+		var got []string
+		for i := 0; i < 3; i++ {
+			msg, err := client.Recv()
+			require.NoError(t, err)
+			got = append(got, msg.GetRequest().GetResourceURL())
+		}
+		require.ElementsMatch(t, []string{
+			pbpeerstream.TypeURLExportedService,
+			pbpeerstream.TypeURLExportedServiceList,
+			pbpeerstream.TypeURLPeeringTrustBundle,
+		}, got)
+
+		_, err := client.Recv()
+		require.ErrorIs(t, err, io.EOF)
+
+		status, ok := srv.StreamStatus(testPeerID)
+		require.True(t, ok)
+		require.False(t, status.SupportsRemoteResource(pbpeerstream.TypeURLStreamSecret))
+	})
+
+	testutil.RunStep(t, "unknown subscriptions are ignored", func(t *testing.T) {
+		for _, resourceURL := range []string{
+			"type.googleapis.com/hashicorp.consul.internal.peerstream.Unknown",
+			pbpeerstream.TypeURLPeeringTrustBundle,
+		} {
+			require.NoError(t, client.Send(&pbpeerstream.ReplicationMessage{
+				Payload: &pbpeerstream.ReplicationMessage_Request_{
+					Request: &pbpeerstream.ReplicationMessage_Request{
+						ResourceURL: resourceURL,
+					},
+				},
+			}))
+		}
+
+		// The stream is still up and serves the known subscription.
+		msg, err := client.RecvWithTimeout(time.Second)
+		require.NoError(t, err)
+		require.Equal(t, pbpeerstream.TypeURLPeeringTrustBundle, msg.GetResponse().GetResourceURL())
+	})
+}
+
 func TestStreamResources_Server_StreamTracker(t *testing.T) {
 	it := incrementalTime{
 		base: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
@@ -568,6 +637,7 @@ func TestStreamResources_Server_StreamTracker(t *testing.T) {
 			status, ok := srv.StreamStatus(testPeerID)
 			require.True(r, ok)
 			require.True(r, status.Connected)
+			require.True(r, status.SupportsRemoteResource(pbpeerstream.TypeURLStreamSecret))
 		})
 	})
 
@@ -613,7 +683,11 @@ func TestStreamResources_Server_StreamTracker(t *testing.T) {
 		retry.Run(t, func(r *retry.R) {
 			rStatus, ok := srv.StreamStatus(testPeerID)
 			require.True(r, ok)
-			require.Equal(r, expect, rStatus)
+			require.Equal(r, expect, withoutReplicationDetail(rStatus))
+
+			exported := rStatus.ExportedResources[pbpeerstream.TypeURLExportedService]
+			require.Equal(r, "1", exported.LastAckedNonce)
+			require.Equal(r, &lastSendAck, exported.LastAck)
 		})
 	})
 
@@ -653,7 +727,17 @@ func TestStreamResources_Server_StreamTracker(t *testing.T) {
 		retry.Run(t, func(r *retry.R) {
 			rStatus, ok := srv.StreamStatus(testPeerID)
 			require.True(r, ok)
-			require.Equal(r, expect, rStatus)
+			require.Equal(r, expect, withoutReplicationDetail(rStatus))
+
+			exported := rStatus.ExportedResources[pbpeerstream.TypeURLExportedService]
+			require.Equal(r, &lastNack, exported.LastNack)
+			require.Equal(r, lastNackMsg, exported.LastNackMessage)
+			require.Equal(r, []ReplicationError{{
+				Time:        lastNack,
+				Exported:    true,
+				ResourceURL: pbpeerstream.TypeURLExportedService,
+				Message:     lastNackMsg,
+			}}, rStatus.ErrorHistory)
 		})
 	})
 
@@ -706,7 +790,15 @@ func TestStreamResources_Server_StreamTracker(t *testing.T) {
 		retry.Run(t, func(r *retry.R) {
 			status, ok := srv.StreamStatus(testPeerID)
 			require.True(r, ok)
-			require.Equal(r, expect, status)
+			require.Equal(r, expect, withoutReplicationDetail(status))
+
+			require.Equal(r, ImportedResourceStatus{
+				LastNonce: "21",
+				LastApply: &lastRecvResourceSuccess,
+			}, status.ImportedResources[pbpeerstream.TypeURLExportedService])
+			require.Equal(r, ImportedServiceStatus{
+				LastApply: &lastRecvResourceSuccess,
+			}, status.ImportedServiceStatuses["api"])
 		})
 	})
 
@@ -764,7 +856,21 @@ func TestStreamResources_Server_StreamTracker(t *testing.T) {
 		retry.Run(t, func(r *retry.R) {
 			status, ok := srv.StreamStatus(testPeerID)
 			require.True(r, ok)
-			require.Equal(r, expect, status)
+			require.Equal(r, expect, withoutReplicationDetail(status))
+
+			require.Equal(r, ImportedResourceStatus{
+				LastNonce:             "24",
+				LastApply:             &lastRecvResourceSuccess,
+				LastApplyError:        &lastRecvError,
+				LastApplyErrorMessage: lastRecvErrorMsg,
+			}, status.ImportedResources[pbpeerstream.TypeURLExportedService])
+			require.Len(r, status.ErrorHistory, 2)
+			require.Equal(r, ReplicationError{
+				Time:        lastRecvError,
+				ResourceURL: pbpeerstream.TypeURLExportedService,
+				ResourceID:  "web",
+				Message:     lastRecvErrorMsg,
+			}, status.ErrorHistory[1])
 		})
 	})
 
@@ -795,7 +901,7 @@ func TestStreamResources_Server_StreamTracker(t *testing.T) {
 		retry.Run(t, func(r *retry.R) {
 			status, ok := srv.StreamStatus(testPeerID)
 			require.True(r, ok)
-			require.Equal(r, expect, status)
+			require.Equal(r, expect, withoutReplicationDetail(status))
 		})
 	})
 
@@ -824,11 +930,66 @@ func TestStreamResources_Server_StreamTracker(t *testing.T) {
 		retry.Run(t, func(r *retry.R) {
 			status, ok := srv.StreamStatus(testPeerID)
 			require.True(r, ok)
-			require.Equal(r, expect, status)
+			require.Equal(r, expect, withoutReplicationDetail(status))
 		})
 	})
 }
 
+// withoutReplicationDetail clears the per-resource replication details of a
+// status, since the nonces of the responses sent to the peer are not stable.
+func withoutReplicationDetail(s Status) Status {
+	s.ExportedResources = nil
+	s.ImportedResources = nil
+	s.ImportedServiceStatuses = nil
+	s.ErrorHistory = nil
+	s.RemoteResourceURLs = nil
+	return s
+}
+
+func TestStreamResources_emitReplicationMetrics(t *testing.T) {
+	sink := metrics.NewInmemSink(time.Minute, time.Minute)
+	cfg := metrics.DefaultConfig("us-west")
+	cfg.EnableHostname = false
+	met, err := metrics.New(cfg, sink)
+	require.NoError(t, err)
+
+	now := time.Date(2000, time.January, 1, 0, 1, 0, 0, time.UTC)
+	lastApply := now.Add(-15 * time.Second)
+
+	streamReq := HandleStreamRequest{
+		LocalID:  testPeerID,
+		PeerName: "my-peer",
+	}
+	status := Status{
+		ExportedResources: map[string]ExportedResourceStatus{
+			pbpeerstream.TypeURLExportedService: {PendingUpdates: 3},
+		},
+		ImportedResources: map[string]ImportedResourceStatus{
+			pbpeerstream.TypeURLPeeringTrustBundle: {LastApply: &lastApply},
+			// Resource types that were never applied have no lag to report.
+			pbpeerstream.TypeURLExportedKVEntries: {},
+		},
+	}
+	emitReplicationMetrics(met, streamReq, status, now)
+
+	intervals := sink.Data()
+	require.Len(t, intervals, 1)
+	intv := intervals[0]
+
+	// the keys for a Gauge value look like: {serviceName}.{prefix}.{key_name};{label=value};...
+	pendingKey := fmt.Sprintf("us-west.peering.replication.pending_updates;peer_name=my-peer;peer_id=%s;resource_type=ExportedService", testPeerID)
+	pending, ok := intv.Gauges[pendingKey]
+	require.True(t, ok, fmt.Sprintf("did not find the key %q", pendingKey))
+	require.Equal(t, float32(3), pending.Value)
+
+	ageKey := fmt.Sprintf("us-west.peering.replication.last_apply_age;peer_name=my-peer;peer_id=%s;resource_type=PeeringTrustBundle", testPeerID)
+	age, ok := intv.Gauges[ageKey]
+	require.True(t, ok, fmt.Sprintf("did not find the key %q", ageKey))
+	require.Equal(t, float32(15), age.Value)
+
+	require.Len(t, intv.Gauges, 2)
+}
+
 func TestStreamResources_Server_ServiceUpdates(t *testing.T) {
 	srv, store := newTestServer(t, nil)
 
@@ -1173,6 +1334,72 @@ func TestStreamResources_Server_CARootUpdates(t *testing.T) {
 	})
 }
 
+func TestStreamResources_Server_StreamSecretUpdates(t *testing.T) {
+	srv, store := newTestServer(t, nil)
+
+	// Create a peering
+	var lastIdx uint64 = 1
+	p := writePeeringToBeDialed(t, store, lastIdx, "my-peering")
+	require.Empty(t, p.PeerID, "should be empty if being dialed")
+
+	writeInitialRootsAndCA(t, store)
+
+	client := makeClient(t, srv, p.ID)
+
+	// Dialers subscribe to stream secret updates.
+	require.NoError(t, client.Send(&pbpeerstream.ReplicationMessage{
+		Payload: &pbpeerstream.ReplicationMessage_Request_{
+			Request: &pbpeerstream.ReplicationMessage_Request{
+				PeerID:      p.ID,
+				ResourceURL: pbpeerstream.TypeURLStreamSecret,
+			},
+		},
+	}))
+
+	expectStreamSecret := func(pending string) func(t *testing.T, msg *pbpeerstream.ReplicationMessage) {
+		return func(t *testing.T, msg *pbpeerstream.ReplicationMessage) {
+			require.Equal(t, pbpeerstream.TypeURLStreamSecret, msg.GetResponse().ResourceURL)
+			require.Equal(t, "stream-secret", msg.GetResponse().ResourceID)
+			require.Equal(t, pbpeerstream.Operation_OPERATION_UPSERT, msg.GetResponse().Operation)
+
+			var secret pbpeerstream.StreamSecret
+			require.NoError(t, msg.GetResponse().Resource.UnmarshalTo(&secret))
+			require.Equal(t, pending, secret.PendingSecretID)
+		}
+	}
+
+	testutil.RunStep(t, "initial stream secret replication", func(t *testing.T) {
+		// The pending secret used to open the stream was promoted, so there is no pending secret.
+		expectReplEvents(t, client,
+			func(t *testing.T, msg *pbpeerstream.ReplicationMessage) {
+				require.Equal(t, pbpeerstream.TypeURLPeeringTrustBundle, msg.GetResponse().ResourceURL)
+			},
+			func(t *testing.T, msg *pbpeerstream.ReplicationMessage) {
+				require.Equal(t, pbpeerstream.TypeURLExportedServiceList, msg.GetResponse().ResourceURL)
+			},
+			expectStreamSecret(""),
+		)
+	})
+
+	testutil.RunStep(t, "rotating the secret sends the pending secret", func(t *testing.T) {
+		rotated := testUUID(t)
+
+		lastIdx++
+		require.NoError(t, store.PeeringSecretsWrite(lastIdx, &pbpeering.SecretsWriteRequest{
+			PeerID: p.ID,
+			Request: &pbpeering.SecretsWriteRequest_RotateStream{
+				RotateStream: &pbpeering.SecretsWriteRequest_RotateStreamRequest{
+					PendingStreamSecret: rotated,
+				},
+			},
+		}))
+
+		expectReplEvents(t, client,
+			expectStreamSecret(rotated),
+		)
+	})
+}
+
 func TestStreamResources_Server_AckNackNonce(t *testing.T) {
 	srv, store := newTestServer(t, func(c *Config) {
 		c.incomingHeartbeatTimeout = 5 * time.Second
@@ -1403,7 +1630,12 @@ func TestStreamResources_Server_KeepsConnectionOpenWithHeartbeat(t *testing.T) {
 func makeClient(t *testing.T, srv *testServer, peerID string) *MockClient {
 	t.Helper()
 
-	client := NewMockClient(context.Background())
+	// The client advertises support for all optional resource types like a
+	// dialer on the same version would.
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.MD{
+		resourceURLsMetadataKey: pbpeerstream.OptionalTypeURLs,
+	})
+	client := NewMockClient(ctx)
 
 	errCh := make(chan error, 1)
 	client.ErrCh = errCh
@@ -1426,13 +1658,17 @@ func makeClient(t *testing.T, srv *testServer, peerID string) *MockClient {
 		},
 	}))
 
-	// Receive ExportedService, ExportedServiceList, and PeeringTrustBundle subscription requests from server
+	// Receive ExportedService, ExportedServiceList, PeeringTrustBundle, ExportedKVEntries, and PeeringConfigEntries subscription requests from server
 	receivedSub1, err := client.Recv()
 	require.NoError(t, err)
 	receivedSub2, err := client.Recv()
 	require.NoError(t, err)
 	receivedSub3, err := client.Recv()
 	require.NoError(t, err)
+	receivedSub4, err := client.Recv()
+	require.NoError(t, err)
+	receivedSub5, err := client.Recv()
+	require.NoError(t, err)
 
 	// Issue services, roots, and server address subscription to server.
 	// Note that server address may not come as an initial message
@@ -1488,11 +1724,35 @@ func makeClient(t *testing.T, srv *testServer, peerID string) *MockClient {
 				},
 			},
 		},
+		{
+			Payload: &pbpeerstream.ReplicationMessage_Request_{
+				Request: &pbpeerstream.ReplicationMessage_Request{
+					ResourceURL: pbpeerstream.TypeURLExportedKVEntries,
+					// The PeerID field is only set for the messages coming FROM
+					// the establishing side and are going to be empty from the
+					// other side.
+					PeerID: "",
+				},
+			},
+		},
+		{
+			Payload: &pbpeerstream.ReplicationMessage_Request_{
+				Request: &pbpeerstream.ReplicationMessage_Request{
+					ResourceURL: pbpeerstream.TypeURLPeeringConfigEntries,
+					// The PeerID field is only set for the messages coming FROM
+					// the establishing side and are going to be empty from the
+					// other side.
+					PeerID: "",
+				},
+			},
+		},
 	}
 	got := []*pbpeerstream.ReplicationMessage{
 		receivedSub1,
 		receivedSub2,
 		receivedSub3,
+		receivedSub4,
+		receivedSub5,
 	}
 	prototest.AssertElementsMatch(t, expect, got)
 
@@ -1541,6 +1801,10 @@ func (b *testStreamBackend) PeeringTrustBundleWrite(req *pbpeering.PeeringTrustB
 	panic("not implemented")
 }
 
+func (b *testStreamBackend) PeeringConfigEntriesWrite(req *pbpeering.PeeringConfigEntriesWriteRequest) error {
+	return b.store.PeeringConfigEntriesWrite(1, req.PeeringConfigEntries)
+}
+
 func (b *testStreamBackend) ValidateProposedPeeringSecret(id string) (bool, error) {
 	return true, nil
 }
@@ -1576,6 +1840,12 @@ func (b *testStreamBackend) CatalogDeregister(req *structs.DeregisterRequest) er
 	return nil
 }
 
+// KVSTxn mocks KV transactions through Raft by copying the logic of FSM.applyTxn.
+func (b *testStreamBackend) KVSTxn(ops structs.TxnOps) error {
+	results, errors := b.store.TxnRW(1, ops)
+	return structs.TxnResponse{Results: results, Errors: errors}.Error()
+}
+
 func Test_ExportedServicesCount(t *testing.T) {
 	peerName := "billing"
 	peerID := "1fabcd52-1d46-49b0-b1d8-71559aee47f5"
@@ -2986,6 +3256,236 @@ func Test_processResponse_ExportedServiceUpdates(t *testing.T) {
 // We ensure it gets redacted when logging a ReplicationMessage_Open or a ReplicationMessage.
 // In the stream handler we only log the ReplicationMessage_Open, but testing both guards against
 // a change in that behavior.
+func Test_processResponse_PeeringConfigEntries(t *testing.T) {
+	peerName := "billing"
+	peerID := "1fabcd52-1d46-49b0-b1d8-71559aee47f5"
+
+	srv, store := newTestServer(t, nil)
+	require.NoError(t, store.PeeringWrite(31, &pbpeering.PeeringWriteRequest{
+		Peering: &pbpeering.Peering{
+			Name: peerName,
+			ID:   peerID,
+		},
+	}))
+
+	// connect the stream
+	mst, err := srv.Tracker.Connected(peerID)
+	require.NoError(t, err)
+
+	upsert := func(t *testing.T, entries ...structs.ConfigEntry) error {
+		pce := &pbpeering.PeeringConfigEntries{}
+		for _, entry := range entries {
+			pce.Entries = append(pce.Entries, pbconfigentry.ConfigEntryFromStructs(entry))
+		}
+		in := &pbpeerstream.ReplicationMessage_Response{
+			ResourceURL: pbpeerstream.TypeURLPeeringConfigEntries,
+			ResourceID:  "config-entries",
+			Nonce:       "1",
+			Operation:   pbpeerstream.Operation_OPERATION_UPSERT,
+			Resource:    makeAnyPB(t, pce),
+		}
+		_, err := srv.processResponse(peerName, "", mst, in)
+		return err
+	}
+
+	testutil.RunStep(t, "entries are stored for the peer", func(t *testing.T) {
+		require.NoError(t, upsert(t,
+			&structs.ServiceConfigEntry{
+				Kind:     structs.ServiceDefaults,
+				Name:     "web",
+				Protocol: "http",
+			},
+			&structs.ServiceRouterConfigEntry{
+				Kind: structs.ServiceRouter,
+				Name: "web",
+				Routes: []structs.ServiceRoute{
+					{
+						Match: &structs.ServiceRouteMatch{
+							HTTP: &structs.ServiceRouteHTTPMatch{PathPrefix: "/admin"},
+						},
+						Destination: &structs.ServiceRouteDestination{Service: "admin"},
+					},
+				},
+			},
+		))
+
+		_, pce, err := store.PeeringConfigEntriesRead(nil, state.Query{Value: peerName})
+		require.NoError(t, err)
+		require.NotNil(t, pce)
+		require.Equal(t, peerName, pce.PeerName)
+		require.Len(t, pce.Entries, 2)
+
+		router, ok := pbconfigentry.ConfigEntryToStructs(pce.Entries[1]).(*structs.ServiceRouterConfigEntry)
+		require.True(t, ok)
+		require.Equal(t, "/admin", router.Routes[0].Match.HTTP.PathPrefix)
+		require.Equal(t, "admin", router.Routes[0].Destination.Service)
+	})
+
+	testutil.RunStep(t, "unexporting everything clears the entries", func(t *testing.T) {
+		require.NoError(t, upsert(t))
+
+		_, pce, err := store.PeeringConfigEntriesRead(nil, state.Query{Value: peerName})
+		require.NoError(t, err)
+		require.Empty(t, pce.Entries)
+	})
+
+	testutil.RunStep(t, "entries of other kinds are rejected", func(t *testing.T) {
+		err := upsert(t, &structs.MeshConfigEntry{})
+		require.ErrorContains(t, err, `config entries of kind "KindMeshConfig" cannot be imported`)
+	})
+}
+
+func Test_processResponse_ExportedKVEntries(t *testing.T) {
+	peerName := "billing"
+	peerID := "1fabcd52-1d46-49b0-b1d8-71559aee47f5"
+
+	srv, store := newTestServer(t, nil)
+	require.NoError(t, store.PeeringWrite(31, &pbpeering.PeeringWriteRequest{
+		Peering: &pbpeering.Peering{
+			Name: peerName,
+			ID:   peerID,
+		},
+	}))
+
+	// connect the stream
+	mst, err := srv.Tracker.Connected(peerID)
+	require.NoError(t, err)
+
+	upsert := func(t *testing.T, export *pbpeerstream.ExportedKVEntries) error {
+		in := &pbpeerstream.ReplicationMessage_Response{
+			ResourceURL: pbpeerstream.TypeURLExportedKVEntries,
+			ResourceID:  "kv-entries",
+			Nonce:       "1",
+			Operation:   pbpeerstream.Operation_OPERATION_UPSERT,
+			Resource:    makeAnyPB(t, export),
+		}
+		_, err := srv.processResponse(peerName, "", mst, in)
+		return err
+	}
+
+	storedKeys := func(t *testing.T) map[string]string {
+		_, entries, err := store.KVSList(nil, structs.PeeredKVPrefix, nil)
+		require.NoError(t, err)
+
+		out := make(map[string]string)
+		for _, e := range entries {
+			out[e.Key] = string(e.Value)
+		}
+		return out
+	}
+
+	testutil.RunStep(t, "entries are stored under the peer prefix", func(t *testing.T) {
+		require.NoError(t, upsert(t, &pbpeerstream.ExportedKVEntries{
+			Prefixes: []string{"app/"},
+			Entries: []*pbpeerstream.KVEntry{
+				{Key: "app/a", Value: []byte("1")},
+				{Key: "app/b", Value: []byte("2")},
+			},
+		}))
+
+		require.Equal(t, map[string]string{
+			"_peer/billing/app/a": "1",
+			"_peer/billing/app/b": "2",
+		}, storedKeys(t))
+	})
+
+	testutil.RunStep(t, "changed entries are updated and removed entries deleted", func(t *testing.T) {
+		require.NoError(t, upsert(t, &pbpeerstream.ExportedKVEntries{
+			Prefixes: []string{"app/"},
+			Entries: []*pbpeerstream.KVEntry{
+				{Key: "app/a", Value: []byte("3")},
+			},
+		}))
+
+		require.Equal(t, map[string]string{
+			"_peer/billing/app/a": "3",
+		}, storedKeys(t))
+	})
+
+	testutil.RunStep(t, "unexporting everything deletes all entries", func(t *testing.T) {
+		require.NoError(t, upsert(t, &pbpeerstream.ExportedKVEntries{}))
+		require.Empty(t, storedKeys(t))
+	})
+}
+
+func Test_processResponse_StreamSecret(t *testing.T) {
+	peerName := "billing"
+	peerID := "1fabcd52-1d46-49b0-b1d8-71559aee47f5"
+	active := "9a7c5f6e-2c1b-4f0d-8e43-7d1c2b3a4f5e"
+	pending := "0d8f2b1c-7a6e-4c5d-9b3a-2e1f0a9b8c7d"
+
+	srv, store := newTestServer(t, nil)
+	require.NoError(t, store.PeeringWrite(31, &pbpeering.PeeringWriteRequest{
+		Peering: &pbpeering.Peering{
+			Name:                peerName,
+			ID:                  peerID,
+			PeerID:              testUUID(t),
+			PeerServerAddresses: []string{"127.0.0.1:5300"},
+		},
+		SecretsRequest: &pbpeering.SecretsWriteRequest{
+			PeerID: peerID,
+			Request: &pbpeering.SecretsWriteRequest_Establish{
+				Establish: &pbpeering.SecretsWriteRequest_EstablishRequest{
+					ActiveStreamSecret: active,
+				},
+			},
+		},
+	}))
+
+	// connect the stream
+	mst, err := srv.Tracker.Connected(peerID)
+	require.NoError(t, err)
+
+	upsert := func(t *testing.T, secret *pbpeerstream.StreamSecret) {
+		in := &pbpeerstream.ReplicationMessage_Response{
+			ResourceURL: pbpeerstream.TypeURLStreamSecret,
+			ResourceID:  "stream-secret",
+			Nonce:       "1",
+			Operation:   pbpeerstream.Operation_OPERATION_UPSERT,
+			Resource:    makeAnyPB(t, secret),
+		}
+		_, err := srv.processResponse(peerName, "", mst, in)
+		require.NoError(t, err)
+	}
+
+	expectSecrets := func(t *testing.T, expect *pbpeering.PeeringSecrets_Stream) {
+		secrets, err := store.PeeringSecretsRead(nil, peerID)
+		require.NoError(t, err)
+		prototest.AssertDeepEqual(t, expect, secrets.GetStream())
+	}
+
+	streamReq := HandleStreamRequest{LocalID: peerID, RemoteID: testUUID(t), PeerName: peerName}
+
+	testutil.RunStep(t, "empty pending secret is a no-op", func(t *testing.T) {
+		upsert(t, &pbpeerstream.StreamSecret{})
+		expectSecrets(t, &pbpeering.PeeringSecrets_Stream{ActiveSecretID: active})
+		require.False(t, srv.shouldReconnectWithPendingSecret(streamReq))
+	})
+
+	testutil.RunStep(t, "rotated secret is stored as pending", func(t *testing.T) {
+		upsert(t, &pbpeerstream.StreamSecret{PendingSecretID: pending})
+		expectSecrets(t, &pbpeering.PeeringSecrets_Stream{
+			ActiveSecretID:  active,
+			PendingSecretID: pending,
+		})
+		require.True(t, srv.shouldReconnectWithPendingSecret(streamReq))
+	})
+
+	testutil.RunStep(t, "pending secret refused by the acceptor does not force a reconnect", func(t *testing.T) {
+		rejected := streamReq
+		rejected.RejectedPendingSecret = pending
+
+		upsert(t, &pbpeerstream.StreamSecret{PendingSecretID: pending})
+		require.False(t, srv.shouldReconnectWithPendingSecret(rejected))
+	})
+
+	testutil.RunStep(t, "pending secret is promoted once the acceptor cleared it", func(t *testing.T) {
+		upsert(t, &pbpeerstream.StreamSecret{})
+		expectSecrets(t, &pbpeering.PeeringSecrets_Stream{ActiveSecretID: pending})
+		require.False(t, srv.shouldReconnectWithPendingSecret(streamReq))
+	})
+}
+
 func TestLogTraceProto(t *testing.T) {
 	type testCase struct {
 		input proto.Message
@@ -3006,6 +3506,20 @@ func TestLogTraceProto(t *testing.T) {
 				StreamSecretID: testPendingStreamSecretID,
 			},
 		},
+		"stream secret response": {
+			input: &pbpeerstream.ReplicationMessage{
+				Payload: &pbpeerstream.ReplicationMessage_Response_{
+					Response: &pbpeerstream.ReplicationMessage_Response{
+						ResourceURL: pbpeerstream.TypeURLStreamSecret,
+						ResourceID:  "stream-secret",
+						Operation:   pbpeerstream.Operation_OPERATION_UPSERT,
+						Resource: makeAnyPB(t, &pbpeerstream.StreamSecret{
+							PendingSecretID: testPendingStreamSecretID,
+						}),
+					},
+				},
+			},
+		},
 	}
 	for name, tc := range tt {
 		t.Run(name, func(t *testing.T) {
@@ -5,10 +5,16 @@ package peerstream
 
 import (
 	"fmt"
+	"maps"
+	"slices"
 	"sync"
 	"time"
 
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/proto/private/pbpeerstream"
 )
 
 // Tracker contains a map of (PeerID -> MutableStatus).
@@ -193,9 +199,28 @@ type MutableStatus struct {
 	// to the peer before the stream's context is cancelled.
 	doneCh chan struct{}
 
+	// pendingUpdates tracks the responses sent to the peer that were not
+	// ACKed or NACKed yet, keyed by nonce.
+	pendingUpdates map[string]pendingUpdate
+
+	// heartbeatToEcho is the last heartbeat received from the peer on the
+	// current stream, and heartbeatToEchoRecvAt is when it was received. Both
+	// use the real clock rather than timeNow, since they only measure durations.
+	heartbeatToEcho       *pbpeerstream.ReplicationMessage_Heartbeat
+	heartbeatToEchoRecvAt time.Time
+
 	Status
 }
 
+type pendingUpdate struct {
+	resourceURL string
+	resourceID  string
+}
+
+// maxReplicationErrors is the number of replication errors kept in
+// Status.ErrorHistory.
+const maxReplicationErrors = 10
+
 // Status contains information about the replication stream to a peer cluster.
 // TODO(peering): There's a lot of fields here...
 type Status struct {
@@ -249,6 +274,89 @@ type Status struct {
 	ImportedServices []string
 	// ExportedServices keeps track of which service names a peer asks to export
 	ExportedServices []string
+
+	// ExportedResources tracks the replication of each resource type TO the peer, keyed by resource URL.
+	ExportedResources map[string]ExportedResourceStatus
+
+	// ImportedResources tracks the replication of each resource type FROM the peer, keyed by resource URL.
+	ImportedResources map[string]ImportedResourceStatus
+
+	// ImportedServiceStatuses tracks the import of each service in ImportedServices, keyed by service name.
+	ImportedServiceStatuses map[string]ImportedServiceStatus
+
+	// ErrorHistory holds the most recent replication errors, oldest first.
+	ErrorHistory []ReplicationError
+
+	// RemoteResourceURLs holds the optional resource types the peer advertised
+	// support for on the current stream.
+	RemoteResourceURLs []string
+
+	// RTT is the round trip time to the peer most recently measured over
+	// heartbeats. It is nil until the peer echoes one of our heartbeats, which
+	// peers on older versions never do.
+	RTT *time.Duration
+}
+
+// ExportedResourceStatus contains information about the replication of a resource type TO the peer.
+type ExportedResourceStatus struct {
+	// LastSentNonce is the nonce of the last response sent to the peer.
+	LastSentNonce string
+
+	// LastAckedNonce is the nonce of the last response ACKed by the peer.
+	LastAckedNonce string
+
+	// PendingUpdates is the number of responses sent on the current stream that
+	// were not ACKed or NACKed yet.
+	PendingUpdates uint64
+
+	// LastAck tracks the time we received the last ACK for the resource type.
+	LastAck *time.Time
+
+	// LastNack tracks the time we received the last NACK for the resource type.
+	LastNack *time.Time
+
+	// LastNackMessage tracks the error message associated with the last NACK.
+	LastNackMessage string
+}
+
+// ImportedResourceStatus contains information about the replication of a resource type FROM the peer.
+type ImportedResourceStatus struct {
+	// LastNonce is the nonce of the last response received from the peer.
+	LastNonce string
+
+	// LastApply tracks the time we last successfully stored a response.
+	LastApply *time.Time
+
+	// LastApplyError tracks the time we last failed to store a response.
+	LastApplyError *time.Time
+
+	// LastApplyErrorMessage tracks the error message of the last failure to store a response.
+	LastApplyErrorMessage string
+}
+
+// ImportedServiceStatus contains information about the import of a service exported by the peer.
+type ImportedServiceStatus struct {
+	// LastApply tracks the time we last successfully stored the service instances.
+	LastApply *time.Time
+
+	// LastApplyError tracks the time we last failed to store the service instances.
+	LastApplyError *time.Time
+
+	// LastApplyErrorMessage tracks the error message of the last failure to store the service instances.
+	LastApplyErrorMessage string
+}
+
+// ReplicationError is an error replicating a resource to or from the peer.
+type ReplicationError struct {
+	Time time.Time
+
+	// Exported is true for NACKs of resources replicated TO the peer, and false
+	// for failures to store resources replicated FROM the peer.
+	Exported bool
+
+	ResourceURL string
+	ResourceID  string
+	Message     string
 }
 
 func (s *Status) GetImportedServicesCount() uint64 {
@@ -307,6 +415,45 @@ func (s *MutableStatus) TrackRecvHeartbeat() {
 	s.mu.Unlock()
 }
 
+// TrackHeartbeatEcho records a heartbeat received from our peer at now so that
+// it can be echoed back, and measures the round trip time if it echoes one of
+// our own heartbeats.
+func (s *MutableStatus) TrackHeartbeatEcho(heartbeat *pbpeerstream.ReplicationMessage_Heartbeat, now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if heartbeat.GetSentAt() != nil {
+		s.heartbeatToEcho = heartbeat
+		s.heartbeatToEchoRecvAt = now
+	}
+
+	if heartbeat.GetEchoSentAt() == nil {
+		return
+	}
+	rtt := now.Sub(heartbeat.EchoSentAt.AsTime()) - heartbeat.GetEchoDelay().AsDuration()
+	if rtt < 0 {
+		// The clock was adjusted between sending and receiving the heartbeat.
+		return
+	}
+	s.RTT = &rtt
+}
+
+// newHeartbeat returns a heartbeat to send at now, which echoes the last
+// heartbeat received from our peer.
+func (s *MutableStatus) newHeartbeat(now time.Time) *pbpeerstream.ReplicationMessage_Heartbeat {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	heartbeat := &pbpeerstream.ReplicationMessage_Heartbeat{
+		SentAt: timestamppb.New(now),
+	}
+	if s.heartbeatToEcho != nil {
+		heartbeat.EchoSentAt = s.heartbeatToEcho.SentAt
+		heartbeat.EchoDelay = durationpb.New(now.Sub(s.heartbeatToEchoRecvAt))
+	}
+	return heartbeat
+}
+
 func (s *MutableStatus) TrackRecvError(error string) {
 	s.mu.Lock()
 	s.LastRecvError = ptr(s.timeNow().UTC())
@@ -326,9 +473,174 @@ func (s *MutableStatus) TrackConnected() {
 	s.Connected = true
 	s.DisconnectTime = &time.Time{}
 	s.DisconnectErrorMessage = ""
+
+	// Nonces are scoped to a stream, so responses sent on a previous stream
+	// will never be ACKed.
+	s.pendingUpdates = nil
+	s.heartbeatToEcho = nil
+	for url, r := range s.ExportedResources {
+		r.PendingUpdates = 0
+		s.ExportedResources[url] = r
+	}
+	s.mu.Unlock()
+}
+
+// TrackRemoteResourceURLs tracks the optional resource types the peer
+// advertised support for on the current stream.
+func (s *MutableStatus) TrackRemoteResourceURLs(urls []string) {
+	s.mu.Lock()
+	s.RemoteResourceURLs = slices.Clone(urls)
 	s.mu.Unlock()
 }
 
+// SupportsRemoteResource reports whether the peer advertised support for the
+// optional resource type on the current stream.
+func (s *Status) SupportsRemoteResource(resourceURL string) bool {
+	return slices.Contains(s.RemoteResourceURLs, resourceURL)
+}
+
+// TrackResourceSent tracks sending a response for a resource to the peer.
+func (s *MutableStatus) TrackResourceSent(resourceURL, resourceID, nonce string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.pendingUpdates == nil {
+		s.pendingUpdates = make(map[string]pendingUpdate)
+	}
+	s.pendingUpdates[nonce] = pendingUpdate{resourceURL: resourceURL, resourceID: resourceID}
+
+	r := s.ExportedResources[resourceURL]
+	r.LastSentNonce = nonce
+	r.PendingUpdates++
+	s.setExportedResourceLocked(resourceURL, r)
+}
+
+// TrackResourceAck tracks the peer ACKing the response with the given nonce.
+func (s *MutableStatus) TrackResourceAck(resourceURL, nonce string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := ptr(s.timeNow().UTC())
+	s.LastAck = now
+
+	r := s.ExportedResources[resourceURL]
+	r.LastAck = now
+	r.LastAckedNonce = nonce
+	if _, ok := s.pendingUpdates[nonce]; ok {
+		delete(s.pendingUpdates, nonce)
+		r.PendingUpdates--
+	}
+	s.setExportedResourceLocked(resourceURL, r)
+}
+
+// TrackResourceNack tracks the peer NACKing the response with the given nonce.
+func (s *MutableStatus) TrackResourceNack(resourceURL, nonce, msg string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.timeNow().UTC()
+	s.LastNack = &now
+	s.LastNackMessage = msg
+
+	r := s.ExportedResources[resourceURL]
+	r.LastNack = &now
+	r.LastNackMessage = msg
+	pending, ok := s.pendingUpdates[nonce]
+	if ok {
+		delete(s.pendingUpdates, nonce)
+		r.PendingUpdates--
+	}
+	s.setExportedResourceLocked(resourceURL, r)
+
+	s.appendErrorLocked(ReplicationError{
+		Time:        now,
+		Exported:    true,
+		ResourceURL: resourceURL,
+		ResourceID:  pending.resourceID,
+		Message:     msg,
+	})
+}
+
+func (s *MutableStatus) setExportedResourceLocked(resourceURL string, r ExportedResourceStatus) {
+	if s.ExportedResources == nil {
+		s.ExportedResources = make(map[string]ExportedResourceStatus)
+	}
+	s.ExportedResources[resourceURL] = r
+}
+
+// TrackResourceApplied tracks successfully storing a response received from the peer.
+// For exported services, it also tracks the import of the service named by resourceID.
+func (s *MutableStatus) TrackResourceApplied(resourceURL, resourceID, nonce string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := ptr(s.timeNow().UTC())
+	s.LastRecvResourceSuccess = now
+
+	r := s.ImportedResources[resourceURL]
+	r.LastNonce = nonce
+	r.LastApply = now
+	s.setImportedResourceLocked(resourceURL, r)
+
+	if resourceURL == pbpeerstream.TypeURLExportedService && resourceID != "" {
+		svc := s.ImportedServiceStatuses[resourceID]
+		svc.LastApply = now
+		s.setImportedServiceLocked(resourceID, svc)
+	}
+}
+
+// TrackResourceApplyError tracks failing to store a response received from the peer.
+// For exported services, it also tracks the import of the service named by resourceID.
+func (s *MutableStatus) TrackResourceApplyError(resourceURL, resourceID, nonce, msg string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.timeNow().UTC()
+	s.LastRecvError = &now
+	s.LastRecvErrorMessage = msg
+
+	r := s.ImportedResources[resourceURL]
+	r.LastNonce = nonce
+	r.LastApplyError = &now
+	r.LastApplyErrorMessage = msg
+	s.setImportedResourceLocked(resourceURL, r)
+
+	if resourceURL == pbpeerstream.TypeURLExportedService && resourceID != "" {
+		svc := s.ImportedServiceStatuses[resourceID]
+		svc.LastApplyError = &now
+		svc.LastApplyErrorMessage = msg
+		s.setImportedServiceLocked(resourceID, svc)
+	}
+
+	s.appendErrorLocked(ReplicationError{
+		Time:        now,
+		ResourceURL: resourceURL,
+		ResourceID:  resourceID,
+		Message:     msg,
+	})
+}
+
+func (s *MutableStatus) setImportedResourceLocked(resourceURL string, r ImportedResourceStatus) {
+	if s.ImportedResources == nil {
+		s.ImportedResources = make(map[string]ImportedResourceStatus)
+	}
+	s.ImportedResources[resourceURL] = r
+}
+
+func (s *MutableStatus) setImportedServiceLocked(serviceName string, svc ImportedServiceStatus) {
+	if s.ImportedServiceStatuses == nil {
+		s.ImportedServiceStatuses = make(map[string]ImportedServiceStatus)
+	}
+	s.ImportedServiceStatuses[serviceName] = svc
+}
+
+func (s *MutableStatus) appendErrorLocked(e ReplicationError) {
+	if len(s.ErrorHistory) >= maxReplicationErrors {
+		s.ErrorHistory = slices.Clone(s.ErrorHistory[len(s.ErrorHistory)-maxReplicationErrors+1:])
+	}
+	s.ErrorHistory = append(s.ErrorHistory, e)
+}
+
 // TrackDisconnectedGracefully tracks when the stream was disconnected in a way we expected.
 // For example, we got a terminated message, or we terminated the stream ourselves.
 func (s *MutableStatus) TrackDisconnectedGracefully() {
@@ -362,6 +674,14 @@ func (s *MutableStatus) IsConnected() bool {
 func (s *MutableStatus) GetStatus() Status {
 	s.mu.RLock()
 	copy := s.Status
+	copy.ExportedResources = maps.Clone(s.ExportedResources)
+	copy.ImportedResources = maps.Clone(s.ImportedResources)
+	copy.ImportedServiceStatuses = maps.Clone(s.ImportedServiceStatuses)
+	copy.ErrorHistory = slices.Clone(s.ErrorHistory)
+	copy.RemoteResourceURLs = slices.Clone(s.RemoteResourceURLs)
+	if s.RTT != nil {
+		copy.RTT = ptr(*s.RTT)
+	}
 	s.mu.RUnlock()
 
 	return copy
@@ -372,8 +692,17 @@ func (s *MutableStatus) SetImportedServices(serviceNames []structs.ServiceName)
 	defer s.mu.Unlock()
 
 	s.ImportedServices = make([]string, len(serviceNames))
+	imported := make(map[string]struct{}, len(serviceNames))
 	for i, sn := range serviceNames {
 		s.ImportedServices[i] = sn.String()
+		imported[sn.String()] = struct{}{}
+	}
+
+	// Forget about the services that are no longer imported.
+	for name := range s.ImportedServiceStatuses {
+		if _, ok := imported[name]; !ok {
+			delete(s.ImportedServiceStatuses, name)
+		}
 	}
 }
 
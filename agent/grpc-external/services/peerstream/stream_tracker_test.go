@@ -4,13 +4,17 @@
 package peerstream
 
 import (
+	"fmt"
 	"sort"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/proto/private/pbpeerstream"
 	"github.com/hashicorp/consul/sdk/testutil"
 )
 
@@ -327,3 +331,132 @@ func TestMutableStatus_TrackDisconnectedDueToError(t *testing.T) {
 	require.Equal(t, disconnectTime, s.DisconnectTime)
 	require.Equal(t, "disconnect err", s.DisconnectErrorMessage)
 }
+
+func TestMutableStatus_TrackResourceSentAndAcked(t *testing.T) {
+	it := incrementalTime{
+		base: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+	s := newMutableStatus(it.Now, true)
+
+	url := pbpeerstream.TypeURLExportedService
+	s.TrackResourceSent(url, "api", "1")
+	s.TrackResourceSent(url, "web", "2")
+	s.TrackResourceSent(url, "db", "3")
+
+	ackTime := it.FutureNow(1)
+	s.TrackResourceAck(url, "1")
+
+	nackTime := it.FutureNow(1)
+	s.TrackResourceNack(url, "2", "failed to apply web")
+
+	status := s.GetStatus()
+	require.Equal(t, ExportedResourceStatus{
+		LastSentNonce:   "3",
+		LastAckedNonce:  "1",
+		PendingUpdates:  1,
+		LastAck:         &ackTime,
+		LastNack:        &nackTime,
+		LastNackMessage: "failed to apply web",
+	}, status.ExportedResources[url])
+	require.Equal(t, []ReplicationError{{
+		Time:        nackTime,
+		Exported:    true,
+		ResourceURL: url,
+		ResourceID:  "web",
+		Message:     "failed to apply web",
+	}}, status.ErrorHistory)
+
+	// ACKs for unknown nonces do not affect the number of pending updates.
+	s.TrackResourceAck(url, "99")
+	require.Equal(t, uint64(1), s.GetStatus().ExportedResources[url].PendingUpdates)
+
+	// Responses sent on a previous stream are no longer pending.
+	s.TrackConnected()
+	require.Zero(t, s.GetStatus().ExportedResources[url].PendingUpdates)
+	s.TrackResourceAck(url, "3")
+	require.Zero(t, s.GetStatus().ExportedResources[url].PendingUpdates)
+}
+
+func TestMutableStatus_TrackResourceApplied(t *testing.T) {
+	it := incrementalTime{
+		base: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+	s := newMutableStatus(it.Now, true)
+
+	url := pbpeerstream.TypeURLExportedService
+	applyTime := it.FutureNow(1)
+	s.TrackResourceApplied(url, "api", "1")
+
+	errTime := it.FutureNow(1)
+	s.TrackResourceApplyError(url, "web", "2", "failed to store web")
+
+	status := s.GetStatus()
+	require.Equal(t, &applyTime, status.LastRecvResourceSuccess)
+	require.Equal(t, &errTime, status.LastRecvError)
+	require.Equal(t, ImportedResourceStatus{
+		LastNonce:             "2",
+		LastApply:             &applyTime,
+		LastApplyError:        &errTime,
+		LastApplyErrorMessage: "failed to store web",
+	}, status.ImportedResources[url])
+	require.Equal(t, map[string]ImportedServiceStatus{
+		"api": {LastApply: &applyTime},
+		"web": {LastApplyError: &errTime, LastApplyErrorMessage: "failed to store web"},
+	}, status.ImportedServiceStatuses)
+
+	// Statuses are dropped for services that are no longer imported.
+	s.SetImportedServices([]structs.ServiceName{structs.NewServiceName("api", nil)})
+	require.Equal(t, map[string]ImportedServiceStatus{
+		"api": {LastApply: &applyTime},
+	}, s.GetStatus().ImportedServiceStatuses)
+}
+
+func TestMutableStatus_ErrorHistoryIsCapped(t *testing.T) {
+	it := incrementalTime{
+		base: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+	s := newMutableStatus(it.Now, true)
+
+	for i := 0; i < maxReplicationErrors+5; i++ {
+		s.TrackResourceApplyError(pbpeerstream.TypeURLPeeringTrustBundle, "", strconv.Itoa(i), fmt.Sprintf("error %d", i))
+	}
+
+	history := s.GetStatus().ErrorHistory
+	require.Len(t, history, maxReplicationErrors)
+	require.Equal(t, "error 5", history[0].Message)
+	require.Equal(t, fmt.Sprintf("error %d", maxReplicationErrors+4), history[maxReplicationErrors-1].Message)
+}
+
+func TestMutableStatus_HeartbeatRTT(t *testing.T) {
+	local := newMutableStatus(time.Now, true)
+	remote := newMutableStatus(time.Now, true)
+
+	start := time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	// The first heartbeat has nothing to echo.
+	first := local.newHeartbeat(start)
+	require.Nil(t, first.EchoSentAt)
+	require.Nil(t, first.EchoDelay)
+
+	// Our peer receives it 10ms later and echoes it 2s after that.
+	remote.TrackHeartbeatEcho(first, start.Add(10*time.Millisecond))
+	require.Nil(t, remote.GetStatus().RTT, "nothing was echoed to our peer yet")
+
+	echo := remote.newHeartbeat(start.Add(2*time.Second + 10*time.Millisecond))
+	require.Equal(t, first.SentAt.AsTime(), echo.EchoSentAt.AsTime())
+	require.Equal(t, 2*time.Second, echo.EchoDelay.AsDuration())
+
+	// The echo takes another 15ms, so the hold time is not part of the RTT.
+	local.TrackHeartbeatEcho(echo, start.Add(2*time.Second+25*time.Millisecond))
+	require.Equal(t, ptr(25*time.Millisecond), local.GetStatus().RTT)
+
+	testutil.RunStep(t, "heartbeats from older peers are ignored", func(t *testing.T) {
+		local.TrackHeartbeatEcho(&pbpeerstream.ReplicationMessage_Heartbeat{}, start.Add(time.Minute))
+		require.Equal(t, ptr(25*time.Millisecond), local.GetStatus().RTT)
+	})
+
+	testutil.RunStep(t, "heartbeats are not echoed across streams", func(t *testing.T) {
+		remote.TrackConnected()
+		require.Nil(t, remote.newHeartbeat(start.Add(time.Minute)).EchoSentAt)
+	})
+}
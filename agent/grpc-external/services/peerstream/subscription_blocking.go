@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/hashicorp/go-memdb"
@@ -14,6 +15,9 @@ import (
 	"github.com/hashicorp/consul/agent/cache"
 	"github.com/hashicorp/consul/agent/structs"
 	"github.com/hashicorp/consul/lib/retry"
+	"github.com/hashicorp/consul/proto/private/pbconfigentry"
+	"github.com/hashicorp/consul/proto/private/pbpeering"
+	"github.com/hashicorp/consul/proto/private/pbpeerstream"
 	"github.com/hashicorp/consul/proto/private/pbservice"
 )
 
@@ -42,6 +46,127 @@ func (m *subscriptionManager) notifyExportedServicesForPeerID(ctx context.Contex
 	}, subExportedServiceList, state.updateCh)
 }
 
+// maxExportedKVEntriesSize is the maximum total size of the keys and values
+// exported to a peer. All of them are sent in a single message, which must
+// stay below the 8MiB message size limit of the peering stream.
+const maxExportedKVEntriesSize = 4 * 1024 * 1024
+
+// notifyExportedKVEntriesForPeerID watches the KV prefixes exported to the
+// peer, along with the entries under them. A result is emitted even when
+// nothing is exported so that the importer removes any stale keys.
+func (m *subscriptionManager) notifyExportedKVEntriesForPeerID(ctx context.Context, state *subscriptionState, peerID string) {
+	// Wait until this is subscribed-to.
+	select {
+	case <-m.kvEntriesSubReady:
+	case <-ctx.Done():
+		return
+	}
+
+	m.syncViaBlockingQuery(ctx, "exported-kv-entries", func(ctx context.Context, store StateStore, ws memdb.WatchSet) (interface{}, error) {
+		_, prefixes, err := store.ExportedKeyPrefixesForPeer(ws, peerID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to watch exported key prefixes for peer %q: %w", peerID, err)
+		}
+
+		entMeta := structs.DefaultEnterpriseMetaInPartition(state.partition)
+
+		// Exported prefixes may overlap, so dedupe the entries by key.
+		seen := make(map[string]struct{})
+		var entries structs.DirEntries
+		for _, prefix := range prefixes {
+			_, list, err := store.KVSList(ws, prefix, entMeta)
+			if err != nil {
+				return nil, fmt.Errorf("failed to watch key prefix %q: %w", prefix, err)
+			}
+			for _, entry := range list {
+				if _, ok := seen[entry.Key]; ok {
+					continue
+				}
+				seen[entry.Key] = struct{}{}
+				entries = append(entries, entry)
+			}
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].Key < entries[j].Key
+		})
+
+		// Keep the message below the gRPC message size limit by skipping the
+		// entries that don't fit anymore.
+		var (
+			size    int
+			skipped []string
+		)
+		fit := entries[:0]
+		for _, entry := range entries {
+			entrySize := len(entry.Key) + len(entry.Value)
+			if size+entrySize > maxExportedKVEntriesSize {
+				skipped = append(skipped, entry.Key)
+				continue
+			}
+			size += entrySize
+			fit = append(fit, entry)
+		}
+		if len(skipped) > 0 {
+			m.logger.Warn("KV entries exported to peer exceed the maximum size, skipping keys",
+				"peer_id", peerID, "max_size", maxExportedKVEntriesSize, "skipped", skipped)
+		}
+		entries = fit
+
+		return pbpeerstream.ExportedKVEntriesFromStructs(prefixes, entries), nil
+	}, subExportedKVEntries, state.updateCh)
+}
+
+// notifyExportedConfigEntriesForPeerID watches the config entries exported to
+// the peer alongside their services. A result is emitted even when nothing is
+// exported so that the importer removes any stale entries.
+func (m *subscriptionManager) notifyExportedConfigEntriesForPeerID(ctx context.Context, state *subscriptionState, peerID string) {
+	// Wait until this is subscribed-to.
+	select {
+	case <-m.configEntriesSubReady:
+	case <-ctx.Done():
+		return
+	}
+
+	m.syncViaBlockingQuery(ctx, "exported-config-entries", func(ctx context.Context, store StateStore, ws memdb.WatchSet) (interface{}, error) {
+		_, entries, err := store.ExportedConfigEntriesForPeer(ws, peerID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to watch exported config entries for peer %q: %w", peerID, err)
+		}
+
+		result := &pbpeering.PeeringConfigEntries{
+			Entries: make([]*pbconfigentry.ConfigEntry, 0, len(entries)),
+		}
+		for _, entry := range entries {
+			result.Entries = append(result.Entries, pbconfigentry.ConfigEntryFromStructs(entry))
+		}
+		return result, nil
+	}, subExportedConfigEntries, state.updateCh)
+}
+
+// notifyStreamSecretForPeerID watches the stream secrets of the peering so that
+// a pending secret issued by a rotation is sent to the dialing peer. Once the
+// dialing peer connects with the pending secret it is promoted, and an empty
+// pending secret is emitted to let the dialer retire its previous secret.
+func (m *subscriptionManager) notifyStreamSecretForPeerID(ctx context.Context, state *subscriptionState, peerID string) {
+	// Wait until this is subscribed-to.
+	select {
+	case <-m.streamSecretSubReady:
+	case <-ctx.Done():
+		return
+	}
+
+	m.syncViaBlockingQuery(ctx, "stream-secret", func(ctx context.Context, store StateStore, ws memdb.WatchSet) (interface{}, error) {
+		secrets, err := store.PeeringSecretsRead(ws, peerID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to watch stream secrets for peer %q: %w", peerID, err)
+		}
+
+		return &pbpeerstream.StreamSecret{
+			PendingSecretID: secrets.GetStream().GetPendingSecretID(),
+		}, nil
+	}, subStreamSecret, state.updateCh)
+}
+
 // TODO: add a new streaming subscription type to list-by-kind-and-partition since we're getting evictions
 func (m *subscriptionManager) notifyMeshGatewaysForPartition(ctx context.Context, state *subscriptionState, partition string) {
 	// Wait until this is subscribed-to.
@@ -44,15 +44,18 @@ type SubscriptionBackend interface {
 
 // subscriptionManager handlers requests to subscribe to events from an events publisher.
 type subscriptionManager struct {
-	logger               hclog.Logger
-	config               Config
-	trustDomain          string
-	viewStore            MaterializedViewStore
-	backend              SubscriptionBackend
-	getStore             func() StateStore
-	serviceSubReady      <-chan struct{}
-	trustBundlesSubReady <-chan struct{}
-	serverAddrsSubReady  <-chan struct{}
+	logger                hclog.Logger
+	config                Config
+	trustDomain           string
+	viewStore             MaterializedViewStore
+	backend               SubscriptionBackend
+	getStore              func() StateStore
+	serviceSubReady       <-chan struct{}
+	trustBundlesSubReady  <-chan struct{}
+	serverAddrsSubReady   <-chan struct{}
+	kvEntriesSubReady     <-chan struct{}
+	configEntriesSubReady <-chan struct{}
+	streamSecretSubReady  <-chan struct{}
 }
 
 // TODO(peering): Maybe centralize so that there is a single manager per datacenter, rather than per peering.
@@ -70,15 +73,18 @@ func newSubscriptionManager(
 	go store.Run(ctx)
 
 	return &subscriptionManager{
-		logger:               logger,
-		config:               config,
-		trustDomain:          trustDomain,
-		viewStore:            store,
-		backend:              backend,
-		getStore:             getStore,
-		serviceSubReady:      remoteSubTracker.SubscribedChan(pbpeerstream.TypeURLExportedService),
-		trustBundlesSubReady: remoteSubTracker.SubscribedChan(pbpeerstream.TypeURLPeeringTrustBundle),
-		serverAddrsSubReady:  remoteSubTracker.SubscribedChan(pbpeerstream.TypeURLPeeringServerAddresses),
+		logger:                logger,
+		config:                config,
+		trustDomain:           trustDomain,
+		viewStore:             store,
+		backend:               backend,
+		getStore:              getStore,
+		serviceSubReady:       remoteSubTracker.SubscribedChan(pbpeerstream.TypeURLExportedService),
+		trustBundlesSubReady:  remoteSubTracker.SubscribedChan(pbpeerstream.TypeURLPeeringTrustBundle),
+		serverAddrsSubReady:   remoteSubTracker.SubscribedChan(pbpeerstream.TypeURLPeeringServerAddresses),
+		kvEntriesSubReady:     remoteSubTracker.SubscribedChan(pbpeerstream.TypeURLExportedKVEntries),
+		configEntriesSubReady: remoteSubTracker.SubscribedChan(pbpeerstream.TypeURLPeeringConfigEntries),
+		streamSecretSubReady:  remoteSubTracker.SubscribedChan(pbpeerstream.TypeURLStreamSecret),
 	}
 }
 
@@ -96,6 +102,9 @@ func (m *subscriptionManager) subscribe(ctx context.Context, peerID, peerName, p
 	// Wrap our bare state store queries in goroutines that emit events.
 	go m.notifyExportedServicesForPeerID(ctx, state, peerID)
 	go m.notifyServerAddrUpdates(ctx, state.updateCh)
+	go m.notifyExportedKVEntriesForPeerID(ctx, state, peerID)
+	go m.notifyExportedConfigEntriesForPeerID(ctx, state, peerID)
+	go m.notifyStreamSecretForPeerID(ctx, state, peerID)
 	if m.config.ConnectEnabled {
 		go m.notifyMeshGatewaysForPartition(ctx, state, state.partition)
 		// If connect is enabled, watch for updates to CA roots.
@@ -287,6 +296,42 @@ func (m *subscriptionManager) handleEvent(ctx context.Context, state *subscripti
 			return err
 		}
 
+		state.sendPendingEvents(ctx, m.logger, pending)
+
+	case u.CorrelationID == subExportedKVEntries:
+		entries, ok := u.Result.(*pbpeerstream.ExportedKVEntries)
+		if !ok {
+			return fmt.Errorf("invalid type for response: %T", u.Result)
+		}
+		pending := &pendingPayload{}
+		if err := pending.Add(exportedKVEntriesPayloadID, u.CorrelationID, entries); err != nil {
+			return err
+		}
+
+		state.sendPendingEvents(ctx, m.logger, pending)
+
+	case u.CorrelationID == subExportedConfigEntries:
+		entries, ok := u.Result.(*pbpeering.PeeringConfigEntries)
+		if !ok {
+			return fmt.Errorf("invalid type for response: %T", u.Result)
+		}
+		pending := &pendingPayload{}
+		if err := pending.Add(exportedConfigEntriesPayloadID, u.CorrelationID, entries); err != nil {
+			return err
+		}
+
+		state.sendPendingEvents(ctx, m.logger, pending)
+
+	case u.CorrelationID == subStreamSecret:
+		secret, ok := u.Result.(*pbpeerstream.StreamSecret)
+		if !ok {
+			return fmt.Errorf("invalid type for response: %T", u.Result)
+		}
+		pending := &pendingPayload{}
+		if err := pending.Add(streamSecretPayloadID, u.CorrelationID, secret); err != nil {
+			return err
+		}
+
 		state.sendPendingEvents(ctx, m.logger, pending)
 	default:
 		return fmt.Errorf("unknown correlation ID: %s", u.CorrelationID)
@@ -725,9 +770,12 @@ func flattenChecks(
 }
 
 const (
-	subExportedServiceList = "exported-service-list"
-	subExportedService     = "exported-service:"
-	subMeshGateway         = "mesh-gateway:"
+	subExportedServiceList   = "exported-service-list"
+	subExportedService       = "exported-service:"
+	subMeshGateway           = "mesh-gateway:"
+	subExportedKVEntries     = "exported-kv-entries"
+	subExportedConfigEntries = "exported-config-entries"
+	subStreamSecret          = "stream-secret"
 )
 
 // NotifyStandardService will notify the given channel when there are updates
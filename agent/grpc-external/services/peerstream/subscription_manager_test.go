@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -22,6 +23,7 @@ import (
 	"github.com/hashicorp/consul/agent/structs"
 	"github.com/hashicorp/consul/api"
 	"github.com/hashicorp/consul/proto/private/pbcommon"
+	"github.com/hashicorp/consul/proto/private/pbconfigentry"
 	"github.com/hashicorp/consul/proto/private/pbpeering"
 	"github.com/hashicorp/consul/proto/private/pbpeerstream"
 	"github.com/hashicorp/consul/proto/private/pbservice"
@@ -1021,6 +1023,199 @@ type testSubscriptionBackend struct {
 	lastIdx uint64
 }
 
+func TestSubscriptionManager_ExportedConfigEntries(t *testing.T) {
+	backend := newTestSubscriptionBackend(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Create a peering
+	_, id := backend.ensurePeering(t, "my-peering")
+	partition := acl.DefaultEnterpriseMeta().PartitionOrEmpty()
+
+	// Only configure a tracker for config entry events.
+	tracker := newResourceSubscriptionTracker()
+	tracker.Subscribe(pbpeerstream.TypeURLPeeringConfigEntries)
+
+	mgr := newSubscriptionManager(ctx, testutil.Logger(t), Config{
+		Datacenter:     "dc1",
+		ConnectEnabled: true,
+	}, connect.TestTrustDomain, backend, func() StateStore {
+		return backend.store
+	}, tracker)
+	subCh := mgr.subscribe(ctx, id, "my-peering", partition)
+
+	backend.ensureConfigEntry(t, &structs.ServiceConfigEntry{
+		Kind:     structs.ServiceDefaults,
+		Name:     "web",
+		Protocol: "http",
+	})
+
+	expectEntries := func(t *testing.T, check func(t *testing.T, entries []structs.ConfigEntry)) {
+		t.Helper()
+		expectEvents(t, subCh,
+			func(t *testing.T, got cache.UpdateEvent) {
+				require.Equal(t, subExportedConfigEntries, got.CorrelationID)
+				pce, ok := got.Result.(*pbpeering.PeeringConfigEntries)
+				require.True(t, ok)
+
+				var entries []structs.ConfigEntry
+				for _, entry := range pce.Entries {
+					entries = append(entries, pbconfigentry.ConfigEntryToStructs(entry))
+				}
+				check(t, entries)
+			},
+		)
+	}
+
+	testutil.RunStep(t, "initial event is empty when nothing is exported", func(t *testing.T) {
+		expectEntries(t, func(t *testing.T, entries []structs.ConfigEntry) {
+			require.Empty(t, entries)
+		})
+	})
+
+	testutil.RunStep(t, "exporting a service sends its config entries", func(t *testing.T) {
+		backend.ensureConfigEntry(t, &structs.ExportedServicesConfigEntry{
+			Name: "default",
+			Services: []structs.ExportedService{
+				{
+					Name:          "web",
+					Consumers:     []structs.ServiceConsumer{{Peer: "my-peering"}},
+					ConfigEntries: []string{structs.ServiceDefaults, structs.ServiceRouter},
+				},
+			},
+		})
+
+		expectEntries(t, func(t *testing.T, entries []structs.ConfigEntry) {
+			require.Len(t, entries, 1)
+			require.Equal(t, structs.ServiceDefaults, entries[0].GetKind())
+			require.Equal(t, "http", entries[0].(*structs.ServiceConfigEntry).Protocol)
+		})
+	})
+
+	testutil.RunStep(t, "writing an exported entry triggers event", func(t *testing.T) {
+		backend.ensureConfigEntry(t, &structs.ServiceRouterConfigEntry{
+			Kind: structs.ServiceRouter,
+			Name: "web",
+			Routes: []structs.ServiceRoute{
+				{
+					Match: &structs.ServiceRouteMatch{
+						HTTP: &structs.ServiceRouteHTTPMatch{PathPrefix: "/admin"},
+					},
+				},
+			},
+		})
+
+		expectEntries(t, func(t *testing.T, entries []structs.ConfigEntry) {
+			require.Len(t, entries, 2)
+			require.Equal(t, structs.ServiceRouter, entries[1].GetKind())
+		})
+	})
+}
+
+func TestSubscriptionManager_ExportedKVEntries(t *testing.T) {
+	backend := newTestSubscriptionBackend(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Create a peering
+	_, id := backend.ensurePeering(t, "my-peering")
+	partition := acl.DefaultEnterpriseMeta().PartitionOrEmpty()
+
+	// Only configure a tracker for KV entry events.
+	tracker := newResourceSubscriptionTracker()
+	tracker.Subscribe(pbpeerstream.TypeURLExportedKVEntries)
+
+	mgr := newSubscriptionManager(ctx, testutil.Logger(t), Config{
+		Datacenter:     "dc1",
+		ConnectEnabled: true,
+	}, connect.TestTrustDomain, backend, func() StateStore {
+		return backend.store
+	}, tracker)
+	subCh := mgr.subscribe(ctx, id, "my-peering", partition)
+
+	backend.ensureKV(t, "app/config/a", "1")
+	backend.ensureKV(t, "app/secret", "2")
+
+	testutil.RunStep(t, "initial event is empty when nothing is exported", func(t *testing.T) {
+		expectEvents(t, subCh,
+			func(t *testing.T, got cache.UpdateEvent) {
+				require.Equal(t, subExportedKVEntries, got.CorrelationID)
+				kvs, ok := got.Result.(*pbpeerstream.ExportedKVEntries)
+				require.True(t, ok)
+
+				require.Empty(t, kvs.Prefixes)
+				require.Empty(t, kvs.Entries)
+			},
+		)
+	})
+
+	testutil.RunStep(t, "exporting a prefix sends its entries", func(t *testing.T) {
+		backend.ensureConfigEntry(t, &structs.ExportedServicesConfigEntry{
+			Name: "default",
+			KeyPrefixes: []structs.ExportedKeyPrefix{
+				{
+					Prefix:    "app/config/",
+					Consumers: []structs.ServiceConsumer{{Peer: "my-peering"}},
+				},
+				{
+					Prefix:    "app/",
+					Consumers: []structs.ServiceConsumer{{Peer: "other-peering"}},
+				},
+			},
+		})
+
+		expectEvents(t, subCh,
+			func(t *testing.T, got cache.UpdateEvent) {
+				require.Equal(t, subExportedKVEntries, got.CorrelationID)
+				kvs, ok := got.Result.(*pbpeerstream.ExportedKVEntries)
+				require.True(t, ok)
+
+				require.Equal(t, []string{"app/config/"}, kvs.Prefixes)
+				require.Len(t, kvs.Entries, 1)
+				require.Equal(t, "app/config/a", kvs.Entries[0].Key)
+				require.Equal(t, []byte("1"), kvs.Entries[0].Value)
+			},
+		)
+	})
+
+	testutil.RunStep(t, "writing under the prefix triggers event", func(t *testing.T) {
+		backend.ensureKV(t, "app/config/b", "3")
+
+		expectEvents(t, subCh,
+			func(t *testing.T, got cache.UpdateEvent) {
+				require.Equal(t, subExportedKVEntries, got.CorrelationID)
+				kvs, ok := got.Result.(*pbpeerstream.ExportedKVEntries)
+				require.True(t, ok)
+
+				require.Len(t, kvs.Entries, 2)
+				require.Equal(t, "app/config/a", kvs.Entries[0].Key)
+				require.Equal(t, "app/config/b", kvs.Entries[1].Key)
+				require.Equal(t, []byte("3"), kvs.Entries[1].Value)
+			},
+		)
+	})
+
+	testutil.RunStep(t, "entries beyond the maximum size are not exported", func(t *testing.T) {
+		backend.ensureKV(t, "app/config/0", strings.Repeat("x", maxExportedKVEntriesSize))
+		backend.ensureKV(t, "app/config/b", "4")
+
+		expectEvents(t, subCh,
+			func(t *testing.T, got cache.UpdateEvent) {
+				require.Equal(t, subExportedKVEntries, got.CorrelationID)
+				kvs, ok := got.Result.(*pbpeerstream.ExportedKVEntries)
+				require.True(t, ok)
+
+				require.Len(t, kvs.Entries, 2)
+				require.Equal(t, "app/config/a", kvs.Entries[0].Key)
+				require.Equal(t, "app/config/b", kvs.Entries[1].Key)
+				require.Equal(t, []byte("4"), kvs.Entries[1].Value)
+			},
+		)
+	})
+}
+
 func newTestSubscriptionBackend(t *testing.T) *testSubscriptionBackend {
 	publisher := stream.NewEventPublisher(10 * time.Second)
 	store, handler := newStateStore(t, publisher)
@@ -1099,6 +1294,12 @@ func (b *testSubscriptionBackend) deleteService(t *testing.T, nodeName, serviceI
 	return b.lastIdx
 }
 
+func (b *testSubscriptionBackend) ensureKV(t *testing.T, key, value string) uint64 {
+	b.lastIdx++
+	require.NoError(t, b.store.KVSSet(b.lastIdx, &structs.DirEntry{Key: key, Value: []byte(value)}))
+	return b.lastIdx
+}
+
 func (b *testSubscriptionBackend) ensureCAConfig(t *testing.T, config *structs.CAConfiguration) uint64 {
 	b.lastIdx++
 	require.NoError(t, b.store.CASetConfig(b.lastIdx, config))
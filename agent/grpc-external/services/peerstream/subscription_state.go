@@ -99,6 +99,15 @@ func (s *subscriptionState) cleanupEventVersions(logger hclog.Logger) {
 		case id == serverAddrsPayloadID:
 			keep = true
 
+		case id == exportedKVEntriesPayloadID:
+			keep = true
+
+		case id == exportedConfigEntriesPayloadID:
+			keep = true
+
+		case id == streamSecretPayloadID:
+			keep = true
+
 		case id == exportedServiceListID:
 			keep = true
 
@@ -138,12 +147,15 @@ type pendingEvent struct {
 }
 
 const (
-	serverAddrsPayloadID          = "server-addrs"
-	caRootsPayloadID              = "roots"
-	meshGatewayPayloadID          = "mesh-gateway"
-	exportedServiceListID         = "exported-service-list"
-	servicePayloadIDPrefix        = "service:"
-	discoveryChainPayloadIDPrefix = "chain:"
+	serverAddrsPayloadID           = "server-addrs"
+	caRootsPayloadID               = "roots"
+	meshGatewayPayloadID           = "mesh-gateway"
+	exportedServiceListID          = "exported-service-list"
+	exportedKVEntriesPayloadID     = "exported-kv-entries"
+	exportedConfigEntriesPayloadID = "exported-config-entries"
+	streamSecretPayloadID          = "stream-secret"
+	servicePayloadIDPrefix         = "service:"
+	discoveryChainPayloadIDPrefix  = "chain:"
 )
 
 func (p *pendingPayload) Add(id string, correlationID string, raw interface{}) error {
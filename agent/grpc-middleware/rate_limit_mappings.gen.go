@@ -19,6 +19,7 @@ var rpcRateLimitSpecs = map[string]rate.OperationSpec{
 	"/hashicorp.consul.internal.peering.PeeringService/PeeringList":                         {Type: rate.OperationTypeRead, Category: rate.OperationCategoryPeering},
 	"/hashicorp.consul.internal.peering.PeeringService/PeeringRead":                         {Type: rate.OperationTypeRead, Category: rate.OperationCategoryPeering},
 	"/hashicorp.consul.internal.peering.PeeringService/PeeringWrite":                        {Type: rate.OperationTypeWrite, Category: rate.OperationCategoryPeering},
+	"/hashicorp.consul.internal.peering.PeeringService/RotateSecret":                        {Type: rate.OperationTypeWrite, Category: rate.OperationCategoryPeering},
 	"/hashicorp.consul.internal.peering.PeeringService/TrustBundleListByService":            {Type: rate.OperationTypeRead, Category: rate.OperationCategoryPeering},
 	"/hashicorp.consul.internal.peering.PeeringService/TrustBundleRead":                     {Type: rate.OperationTypeRead, Category: rate.OperationCategoryPeering},
 	"/hashicorp.consul.internal.peerstream.PeerStreamService/ExchangeSecret":                {Type: rate.OperationTypeWrite, Category: rate.OperationCategoryPeerStream},
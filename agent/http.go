@@ -31,6 +31,7 @@ import (
 	"github.com/hashicorp/go-cleanhttp"
 
 	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/acl/resolver"
 	"github.com/hashicorp/consul/agent/cache"
 	"github.com/hashicorp/consul/agent/config"
 	"github.com/hashicorp/consul/agent/consul"
@@ -233,7 +234,7 @@ func (s *HTTPHandlers) handler() http.Handler {
 			var token string
 			s.parseToken(req, &token)
 
-			authz, err := s.agent.delegate.ResolveTokenAndDefaultMeta(token, nil, nil)
+			authz, err := s.resolveTokenAndDefaultMeta(req, token, nil, nil)
 			if err != nil {
 				resp.Header().Set(contentTypeHeader, plainContentType)
 				resp.WriteHeader(http.StatusForbidden)
@@ -1144,6 +1145,27 @@ func sourceAddrFromRequest(req *http.Request) string {
 	}
 }
 
+// resolveTokenAndDefaultMeta resolves the token of an HTTP request for an
+// authorization decision made by the agent. Conditional rules with
+// source_cidrs are evaluated against the address of the HTTP client.
+func (s *HTTPHandlers) resolveTokenAndDefaultMeta(
+	req *http.Request,
+	token string,
+	entMeta *acl.EnterpriseMeta,
+	authzContext *acl.AuthorizerContext,
+) (resolver.Result, error) {
+	result, err := s.agent.delegate.ResolveTokenAndDefaultMeta(token, entMeta, authzContext)
+	if err != nil {
+		return result, err
+	}
+	if host, _, err := net.SplitHostPort(req.RemoteAddr); err == nil {
+		if ip := net.ParseIP(host); ip != nil {
+			result.Authorizer = acl.WithSourceIP(result.Authorizer, ip)
+		}
+	}
+	return result, nil
+}
+
 // parseSource is used to parse the ?near=<node> query parameter, used for
 // sorting by RTT based on a source node. We set the source's DC to the target
 // DC in the request, if given, or else the agent's DC.
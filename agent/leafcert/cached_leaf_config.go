@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package leafcert
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/consul/agent/cacheshim"
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// NewCachedLeafConfigReader returns a LeafConfigReader that sources
+// service-defaults from the agent cache.
+func NewCachedLeafConfigReader(cache cacheshim.Cache) LeafConfigReader {
+	return &agentCacheLeafConfigReader{cache: cache}
+}
+
+type agentCacheLeafConfigReader struct {
+	cache cacheshim.Cache
+}
+
+var _ LeafConfigReader = (*agentCacheLeafConfigReader)(nil)
+
+func (r *agentCacheLeafConfigReader) LeafCertConfig(ctx context.Context, req *ConnectCALeafRequest) (*structs.LeafCertConfig, error) {
+	raw, _, err := r.cache.Get(ctx, cacheshim.ConfigEntryName, serviceDefaultsQuery(req))
+	if err != nil {
+		return nil, err
+	}
+	reply, ok := raw.(*structs.ConfigEntryResponse)
+	if !ok {
+		return nil, errors.New("invalid config entry response type")
+	}
+	entry, ok := reply.Entry.(*structs.ServiceConfigEntry)
+	if !ok || entry == nil {
+		return nil, nil
+	}
+	return entry.LeafCert, nil
+}
+
+func (r *agentCacheLeafConfigReader) Notify(ctx context.Context, req *ConnectCALeafRequest, correlationID string, ch chan<- cacheshim.UpdateEvent) error {
+	return r.cache.Notify(ctx, cacheshim.ConfigEntryName, serviceDefaultsQuery(req), correlationID, ch)
+}
+
+func serviceDefaultsQuery(req *ConnectCALeafRequest) *structs.ConfigEntryQuery {
+	return &structs.ConfigEntryQuery{
+		Kind:           structs.ServiceDefaults,
+		Name:           req.Service,
+		Datacenter:     req.Datacenter,
+		QueryOptions:   structs.QueryOptions{Token: req.Token},
+		EnterpriseMeta: req.EnterpriseMeta,
+	}
+}
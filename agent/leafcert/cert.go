@@ -133,4 +133,10 @@ type fetchState struct {
 	// use this to choose a new window for the next retry. See comment on
 	// const caChangeJitterWindow above for more.
 	consecutiveRateLimitErrs int
+
+	// keyType and keyBits are the type and size of the key of the current
+	// cert, so we can tell when the service changes them in its
+	// service-defaults.
+	keyType string
+	keyBits int
 }
@@ -10,6 +10,7 @@ import (
 	"net"
 	"time"
 
+	"github.com/hashicorp/consul/agent/cacheshim"
 	"github.com/hashicorp/consul/agent/connect"
 	"github.com/hashicorp/consul/agent/structs"
 	"github.com/hashicorp/consul/lib"
@@ -85,6 +86,9 @@ func (m *Manager) attemptLeafRefresh(
 		} else if roots == nil {
 			return nil, state, errors.New("no CA roots")
 		}
+		if roots.IsRevoked(existing) || m.leafKeyTypeChanged(req, state) {
+			return m.generateNewLeaf(req, state, false)
+		}
 		if activeRootHasKey(roots, state.authorityKeyID) {
 			return nil, state, nil
 		}
@@ -114,6 +118,21 @@ func (m *Manager) attemptLeafRefresh(
 	m.rootWatcher.Subscribe(rootUpdateCh)
 	defer m.rootWatcher.Unsubscribe(rootUpdateCh)
 
+	// Watch the service-defaults of the service so a change to the leaf key
+	// type replaces the cert right away. The first update is delivered as soon
+	// as the watch starts, which also catches changes made in between blocking
+	// calls.
+	var leafConfigCh chan cacheshim.UpdateEvent
+	if req.Service != "" && m.leafConfigReader != nil {
+		ctx, cancel := context.WithCancel(m.ctx)
+		defer cancel()
+
+		leafConfigCh = make(chan cacheshim.UpdateEvent, 1)
+		if err := m.leafConfigReader.Notify(ctx, req, "", leafConfigCh); err != nil {
+			return nil, state, err
+		}
+	}
+
 	// Setup the timeout chan outside the loop so we don't keep bumping the timeout
 	// later if we loop around.
 	timeoutTimer := time.NewTimer(req.MaxQueryTime)
@@ -138,6 +157,12 @@ func (m *Manager) attemptLeafRefresh(
 			// Cert expired or was force-expired by a root change.
 			return m.generateNewLeaf(req, state, false)
 
+		case <-leafConfigCh:
+			if m.leafKeyTypeChanged(req, state) {
+				return m.generateNewLeaf(req, state, false)
+			}
+			continue
+
 		case <-rootUpdateCh:
 			// A root cache change occurred, reload roots from cache.
 			roots, err := m.rootsReader.Get()
@@ -147,6 +172,12 @@ func (m *Manager) attemptLeafRefresh(
 				return nil, state, errors.New("no CA roots")
 			}
 
+			// A revoked cert is rejected by every proxy, so replace it right away
+			// without waiting for the jitter.
+			if roots.IsRevoked(existing) {
+				return m.generateNewLeaf(req, state, false)
+			}
+
 			// Handle _possibly_ changed roots. We still need to verify the new active
 			// root is not the same as the one our current cert was signed by since we
 			// can be notified spuriously if we are the first request since the
@@ -277,17 +308,19 @@ func (m *Manager) generateNewLeaf(
 
 	// Create a new private key
 
-	// TODO: for now we always generate EC keys on clients regardless of the key
-	// type being used by the active CA. This is fine and allowed in TLS1.2 and
-	// signing EC CSRs with an RSA key is supported by all current CA providers so
-	// it's OK. IFF we ever need to support a CA provider that refuses to sign a
-	// CSR with a different signature algorithm, or if we have compatibility
-	// issues with external PKI systems that require EC certs be signed with ECDSA
-	// from the CA (this was required in TLS1.1 but not in 1.2) then we can
-	// instead intelligently pick the key type we generate here based on the key
-	// type of the active signing CA. We already have that loaded since we need
-	// the trust domain.
-	pk, pkPEM, err := connect.GeneratePrivateKey()
+	// NOTE: we generate EC keys on clients regardless of the key type being
+	// used by the active CA unless the service overrides it in its
+	// service-defaults. This is fine and allowed in TLS1.2 and signing EC CSRs
+	// with an RSA key is supported by all current CA providers so it's OK. IFF
+	// we ever need to support a CA provider that refuses to sign a CSR with a
+	// different signature algorithm, or if we have compatibility issues with
+	// external PKI systems that require EC certs be signed with ECDSA from the
+	// CA (this was required in TLS1.1 but not in 1.2) then we can instead
+	// intelligently pick the key type we generate here based on the key type of
+	// the active signing CA. We already have that loaded since we need the
+	// trust domain.
+	keyType, keyBits := m.leafKeyTypeAndBits(req)
+	pk, pkPEM, err := connect.GeneratePrivateKeyWithConfig(keyType, keyBits)
 	if err != nil {
 		return nil, newState, err
 	}
@@ -303,6 +336,7 @@ func (m *Manager) generateNewLeaf(
 		WriteRequest: structs.WriteRequest{Token: req.Token},
 		Datacenter:   req.Datacenter,
 		CSR:          csr,
+		Node:         m.config.NodeName,
 	}
 
 	reply, err := m.certSigner.SignCert(context.Background(), &args)
@@ -347,6 +381,7 @@ func (m *Manager) generateNewLeaf(
 		return nil, newState, err
 	}
 	reply.PrivateKeyPEM = pkPEM
+	newState.keyType, newState.keyBits = keyType, keyBits
 
 	// Reset rotation state
 	newState.forceExpireAfter = time.Time{}
@@ -362,3 +397,58 @@ func (m *Manager) generateNewLeaf(
 
 	return reply, newState, nil
 }
+
+// leafKeyTypeAndBits returns the type and size of the key to generate for the
+// leaf cert. Services may override them in their service-defaults, everything
+// else gets the default EC key. If the service-defaults can't be read the
+// default is used rather than failing since it's always valid.
+func (m *Manager) leafKeyTypeAndBits(req *ConnectCALeafRequest) (string, int) {
+	if req.Service == "" || m.leafConfigReader == nil {
+		return connect.DefaultPrivateKeyType, connect.DefaultPrivateKeyBits
+	}
+
+	ctx, cancel := context.WithTimeout(m.ctx, req.MaxQueryTime)
+	defer cancel()
+	conf, err := m.leafConfigReader.LeafCertConfig(ctx, req)
+	if err != nil {
+		m.logger.Warn("failed to read leaf cert config, using the default key type",
+			"service", req.Service, "error", err)
+		return connect.DefaultPrivateKeyType, connect.DefaultPrivateKeyBits
+	}
+	if req.EnvoyCompatible && !conf.EnvoyCompatible() {
+		keyType, keyBits := conf.KeyTypeAndBits()
+		m.logger.Warn("leaf cert key type is not supported by Envoy, using the default key type",
+			"service", req.Service, "key_type", keyType, "key_bits", keyBits)
+	}
+	return leafKeyTypeForRequest(req, conf)
+}
+
+// leafKeyTypeForRequest returns the type and size of the key conf configures
+// for the leaf cert, falling back to the default when it is served to Envoy
+// and Envoy can't load it.
+func leafKeyTypeForRequest(req *ConnectCALeafRequest, conf *structs.LeafCertConfig) (string, int) {
+	if req.EnvoyCompatible && !conf.EnvoyCompatible() {
+		return connect.DefaultPrivateKeyType, connect.DefaultPrivateKeyBits
+	}
+	return conf.KeyTypeAndBits()
+}
+
+// leafKeyTypeChanged returns whether the service changed the key type or size
+// of its leaf certs since the current cert was generated. Failing to read the
+// service-defaults is not treated as a change so the cert isn't replaced with
+// one using the default key type.
+func (m *Manager) leafKeyTypeChanged(req *ConnectCALeafRequest, state fetchState) bool {
+	if req.Service == "" || m.leafConfigReader == nil {
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(m.ctx, req.MaxQueryTime)
+	defer cancel()
+	conf, err := m.leafConfigReader.LeafCertConfig(ctx, req)
+	if err != nil {
+		m.logger.Warn("failed to read leaf cert config", "service", req.Service, "error", err)
+		return false
+	}
+	keyType, keyBits := leafKeyTypeForRequest(req, conf)
+	return keyType != state.keyType || keyBits != state.keyBits
+}
@@ -38,6 +38,10 @@ const (
 )
 
 type Config struct {
+	// NodeName is the name of the local node. It's sent along with signing
+	// requests so servers can record which node requested each cert.
+	NodeName string
+
 	// LastGetTTL is the time that the certs returned by this type remain in
 	// the cache after the last get operation. If a cert isn't accessed within
 	// this duration, the certs is purged and background refreshing will cease.
@@ -100,6 +104,11 @@ type Deps struct {
 
 	// CertSigner is an interface to remotely sign certificates.
 	CertSigner CertSigner
+
+	// LeafConfigReader is an optional interface to look up the leaf cert
+	// settings services override in their service-defaults. If it's nil all
+	// leaf certs use the default key type.
+	LeafConfigReader LeafConfigReader
 }
 
 type RootsReader interface {
@@ -111,6 +120,16 @@ type CertSigner interface {
 	SignCert(ctx context.Context, args *structs.CASignRequest) (*structs.IssuedCert, error)
 }
 
+type LeafConfigReader interface {
+	// LeafCertConfig returns the leaf cert settings of the service the request
+	// is for, or nil if it doesn't override any.
+	LeafCertConfig(ctx context.Context, req *ConnectCALeafRequest) (*structs.LeafCertConfig, error)
+
+	// Notify sends an update on ch whenever the leaf cert settings of the
+	// service the request is for may have changed, until ctx is cancelled.
+	Notify(ctx context.Context, req *ConnectCALeafRequest, correlationID string, ch chan<- cacheshim.UpdateEvent) error
+}
+
 func NewManager(deps Deps) *Manager {
 	deps.Config = deps.Config.withDefaults()
 
@@ -125,10 +144,11 @@ func NewManager(deps Deps) *Manager {
 	}
 
 	m := &Manager{
-		config:      deps.Config,
-		logger:      deps.Logger,
-		certSigner:  deps.CertSigner,
-		rootsReader: deps.RootsReader,
+		config:           deps.Config,
+		logger:           deps.Logger,
+		certSigner:       deps.CertSigner,
+		rootsReader:      deps.RootsReader,
+		leafConfigReader: deps.LeafConfigReader,
 		//
 		certs:           make(map[string]*certData),
 		certsExpiryHeap: ttlcache.NewExpiryHeap(),
@@ -159,6 +179,10 @@ type Manager struct {
 	// certSigner is an interface to remotely sign certificates.
 	certSigner CertSigner
 
+	// leafConfigReader is an interface to look up the leaf cert settings of
+	// services. It may be nil.
+	leafConfigReader LeafConfigReader
+
 	// rootWatcher helps let multiple requests for leaf certs to coordinate
 	// sharing a single long-lived watch for the root certs. This allows the
 	// leaf cert requests to notice when the roots rotate and trigger their
@@ -5,10 +5,12 @@ package leafcert
 
 import (
 	"context"
+	"crypto/ecdsa"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/pem"
 	"fmt"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -657,6 +659,171 @@ func TestManager_DNSSANForService(t *testing.T) {
 	require.Equal(t, csr.DNSNames, []string{"test.example.com"})
 }
 
+// testLeafConfigReader is a LeafConfigReader backed by a map of leaf cert
+// settings by service name, which notifies its watchers on every change.
+type testLeafConfigReader struct {
+	lock     sync.Mutex
+	configs  map[string]*structs.LeafCertConfig
+	watchers []testLeafConfigWatcher
+}
+
+type testLeafConfigWatcher struct {
+	ctx     context.Context
+	service string
+	ch      chan<- cacheshim.UpdateEvent
+}
+
+func newTestLeafConfigReader(configs map[string]*structs.LeafCertConfig) *testLeafConfigReader {
+	return &testLeafConfigReader{configs: configs}
+}
+
+func (r *testLeafConfigReader) LeafCertConfig(_ context.Context, req *ConnectCALeafRequest) (*structs.LeafCertConfig, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.configs[req.Service], nil
+}
+
+func (r *testLeafConfigReader) Notify(ctx context.Context, req *ConnectCALeafRequest, correlationID string, ch chan<- cacheshim.UpdateEvent) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	w := testLeafConfigWatcher{ctx: ctx, service: req.Service, ch: ch}
+	r.watchers = append(r.watchers, w)
+	w.notify()
+	return nil
+}
+
+// Set replaces the leaf cert settings of the service and notifies the
+// watchers of the service.
+func (r *testLeafConfigReader) Set(service string, conf *structs.LeafCertConfig) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.configs[service] = conf
+	for _, w := range r.watchers {
+		if w.service == service {
+			w.notify()
+		}
+	}
+}
+
+func (w testLeafConfigWatcher) notify() {
+	if w.ctx.Err() != nil {
+		return
+	}
+	select {
+	case w.ch <- cacheshim.UpdateEvent{}:
+	default:
+	}
+}
+
+func TestManager_LeafKeyTypeFromServiceDefaults(t *testing.T) {
+	t.Parallel()
+
+	signer := newTestSigner(t, nil, nil)
+	m := NewManager(Deps{
+		Logger:      testutil.Logger(t),
+		RootsReader: signer.RootsReader,
+		CertSigner:  signer,
+		LeafConfigReader: newTestLeafConfigReader(map[string]*structs.LeafCertConfig{
+			"web":   {PrivateKeyType: "ed25519"},
+			"small": {PrivateKeyType: "ec", PrivateKeyBits: 224},
+			"big":   {PrivateKeyType: "ec", PrivateKeyBits: 384},
+		}),
+	})
+	t.Cleanup(m.Stop)
+	_ = signer.UpdateCA(t, nil)
+
+	for i, tc := range []struct {
+		service string
+		envoy   bool
+		algo    x509.PublicKeyAlgorithm
+		bits    int
+	}{
+		{service: "web", algo: x509.Ed25519},
+		{service: "api", algo: x509.ECDSA, bits: 256},
+		{service: "small", algo: x509.ECDSA, bits: 224},
+		// Envoy can't load Ed25519 or P-224 keys so certs served to it
+		// fall back to the default key type.
+		{service: "web", envoy: true, algo: x509.ECDSA, bits: 256},
+		{service: "small", envoy: true, algo: x509.ECDSA, bits: 256},
+		{service: "big", envoy: true, algo: x509.ECDSA, bits: 384},
+	} {
+		req := &ConnectCALeafRequest{Datacenter: "dc1", Service: tc.service, EnvoyCompatible: tc.envoy}
+		issued, _, err := m.Get(context.Background(), req)
+		require.NoError(t, err)
+
+		csr, err := connect.ParseCSR(signer.GetCapture(i).CSR)
+		require.NoError(t, err)
+		require.Equal(t, tc.algo, csr.PublicKeyAlgorithm)
+		if key, ok := csr.PublicKey.(*ecdsa.PublicKey); ok {
+			require.Equal(t, tc.bits, key.Curve.Params().BitSize)
+		}
+
+		_, err = tls.X509KeyPair([]byte(issued.CertPEM), []byte(issued.PrivateKeyPEM))
+		require.NoError(t, err)
+	}
+}
+
+func TestManager_LeafRegeneratedOnKeyTypeChange(t *testing.T) {
+	t.Parallel()
+
+	signer := newTestSigner(t, nil, nil)
+	leafConfigs := newTestLeafConfigReader(map[string]*structs.LeafCertConfig{})
+	m := NewManager(Deps{
+		Logger:           testutil.Logger(t),
+		RootsReader:      signer.RootsReader,
+		CertSigner:       signer,
+		LeafConfigReader: leafConfigs,
+	})
+	t.Cleanup(m.Stop)
+	_ = signer.UpdateCA(t, nil)
+
+	req := &ConnectCALeafRequest{Datacenter: "dc1", Service: "web"}
+	index, issued, err := m.testGet(req)
+	require.NoError(t, err)
+	requireLeafKeyAlgorithm(t, issued, x509.ECDSA)
+
+	testutil.RunStep(t, "blocking query wakes up on a key type change", func(t *testing.T) {
+		fetchCh := testAsyncGet(t, m, &ConnectCALeafRequest{
+			Datacenter:    "dc1",
+			Service:       "web",
+			MinQueryIndex: index,
+			MaxQueryTime:  10 * time.Second,
+		})
+
+		leafConfigs.Set("web", &structs.LeafCertConfig{PrivateKeyType: "ed25519"})
+
+		select {
+		case result := <-fetchCh:
+			require.NoError(t, result.Err)
+			require.Greater(t, result.Index, index)
+			requireLeafKeyAlgorithm(t, result.Value, x509.Ed25519)
+			index = result.Index
+		case <-time.After(5 * time.Second):
+			t.Fatal("blocking query did not wake up on the key type change")
+		}
+	})
+
+	testutil.RunStep(t, "non-blocking query regenerates on a key type change", func(t *testing.T) {
+		leafConfigs.Set("web", &structs.LeafCertConfig{PrivateKeyType: "rsa", PrivateKeyBits: 2048})
+
+		newIndex, issued, err := m.testGet(&ConnectCALeafRequest{
+			Datacenter:     "dc1",
+			Service:        "web",
+			MustRevalidate: true,
+		})
+		require.NoError(t, err)
+		require.Greater(t, newIndex, index)
+		requireLeafKeyAlgorithm(t, issued, x509.RSA)
+	})
+}
+
+func requireLeafKeyAlgorithm(t *testing.T, issued *structs.IssuedCert, algo x509.PublicKeyAlgorithm) {
+	t.Helper()
+	cert, err := connect.ParseCert(issued.CertPEM)
+	require.NoError(t, err)
+	require.Equal(t, algo, cert.PublicKeyAlgorithm)
+}
+
 func TestManager_workflow_good(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
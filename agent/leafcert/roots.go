@@ -142,7 +142,11 @@ func (r *rootWatcher) rootWatcher(ctx context.Context) {
 			// don't know if it changed or not, but there is no point waking up all
 			// Fetch calls to check this if we know none of them will need to act on
 			// this update.
-			if oldRoots != nil && oldRoots.ActiveRootID == roots.ActiveRootID {
+			//
+			// New revocations are distributed too, so that revoked certs are
+			// replaced right away.
+			if oldRoots != nil && oldRoots.ActiveRootID == roots.ActiveRootID &&
+				!hasNewRevocations(oldRoots, roots) {
 				continue
 			}
 
@@ -153,3 +157,17 @@ func (r *rootWatcher) rootWatcher(ctx context.Context) {
 		}
 	}
 }
+
+// hasNewRevocations returns true if roots revokes certs that oldRoots didn't.
+func hasNewRevocations(oldRoots, roots *structs.IndexedCARoots) bool {
+	seen := make(map[string]uint64, len(oldRoots.RevokedCerts))
+	for _, r := range oldRoots.RevokedCerts {
+		seen[r.ID()] = r.ModifyIndex
+	}
+	for _, r := range roots.RevokedCerts {
+		if idx, ok := seen[r.ID()]; !ok || idx != r.ModifyIndex {
+			return true
+		}
+	}
+	return false
+}
@@ -35,6 +35,11 @@ type ConnectCALeafRequest struct {
 	Agent   string              // Given an Agent name, not ID, the request is for a SpiffeIDAgent.
 	Kind    structs.ServiceKind // Given "mesh-gateway", the request is for a SpiffeIDMeshGateway. No other kinds supported.
 	Server  bool                // If true, the request is for a SpiffeIDServer.
+
+	// EnvoyCompatible is set when the cert for a Service is served to Envoy.
+	// Key types from the service-defaults that Envoy can't load are ignored
+	// and the default key type is generated instead.
+	EnvoyCompatible bool
 }
 
 func (r *ConnectCALeafRequest) Key() string {
@@ -69,12 +74,17 @@ func (r *ConnectCALeafRequest) Key() string {
 			return fmt.Sprintf("server:%d", v)
 		}
 	default:
-		v, err := hashstructure.Hash([]any{
+		fields := []any{
 			r.Service,
 			r.EnterpriseMeta,
 			r.DNSSAN,
 			r.IPSAN,
-		}, nil)
+		}
+		// Only added when set so the keys of other requests don't change.
+		if r.EnvoyCompatible {
+			fields = append(fields, "envoy")
+		}
+		v, err := hashstructure.Hash(fields, nil)
 		if err == nil {
 			return fmt.Sprintf("service:%d", v)
 		}
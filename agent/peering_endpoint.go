@@ -48,6 +48,9 @@ func (s *HTTPHandlers) peeringRead(resp http.ResponseWriter, req *http.Request,
 		Name:      name,
 		Partition: entMeta.PartitionOrEmpty(),
 	}
+	if _, ok := req.URL.Query()["detail"]; ok {
+		args.Detail = true
+	}
 
 	var dc string
 	options := structs.QueryOptions{}
@@ -199,6 +202,48 @@ func (s *HTTPHandlers) PeeringEstablish(resp http.ResponseWriter, req *http.Requ
 	return out.ToAPI(), nil
 }
 
+// PeeringRotateSecret issues a new stream secret for an existing peering. The
+// dialing peer switches to it the next time it connects and the previous
+// secret is retired once it has done so.
+func (s *HTTPHandlers) PeeringRotateSecret(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	if req.Body == nil {
+		return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: "The peering arguments must be provided in the body"}
+	}
+
+	var apiRequest api.PeeringRotateSecretRequest
+	if err := lib.DecodeJSON(req.Body, &apiRequest); err != nil {
+		return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: fmt.Sprintf("Body decoding failed: %v", err)}
+	}
+
+	args := pbpeering.NewRotateSecretRequestFromAPI(&apiRequest)
+	if args.PeerName == "" {
+		return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: "PeerName is required in the payload when rotating a peering secret."}
+	}
+
+	var entMeta acl.EnterpriseMeta
+	if err := s.parseEntMetaPartition(req, &entMeta); err != nil {
+		return nil, err
+	}
+	if args.Partition == "" {
+		args.Partition = entMeta.PartitionOrEmpty()
+	}
+
+	var token string
+	s.parseToken(req, &token)
+	options := structs.QueryOptions{Token: token}
+	ctx, err := external.ContextWithQueryOptions(req.Context(), options)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := s.agent.rpcClientPeering.RotateSecret(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return out.ToAPI(), nil
+}
+
 // peeringDelete initiates a deletion for a peering that matches the name and partition.
 // This assumes that the name and partition parameters are valid.
 func (s *HTTPHandlers) peeringDelete(resp http.ResponseWriter, req *http.Request, name string) (interface{}, error) {
@@ -481,6 +481,81 @@ func TestHTTP_Peering_Establish(t *testing.T) {
 	})
 }
 
+func TestHTTP_Peering_RotateSecret(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	a := NewTestAgent(t, "")
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+	testrpc.WaitForActiveCARoot(t, a.RPC, "dc1", nil)
+
+	t.Run("No Body", func(t *testing.T) {
+		req, err := http.NewRequest("POST", "/v1/peering/rotate-secret", nil)
+		require.NoError(t, err)
+		resp := httptest.NewRecorder()
+		a.srv.h.ServeHTTP(resp, req)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		body, _ := io.ReadAll(resp.Body)
+		require.Contains(t, string(body), "The peering arguments must be provided in the body")
+	})
+
+	t.Run("No Name", func(t *testing.T) {
+		req, err := http.NewRequest("POST", "/v1/peering/rotate-secret",
+			bytes.NewReader([]byte(`{}`)))
+		require.NoError(t, err)
+		resp := httptest.NewRecorder()
+		a.srv.h.ServeHTTP(resp, req)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		body, _ := io.ReadAll(resp.Body)
+		require.Contains(t, string(body), "PeerName is required")
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		a2 := NewTestAgent(t, `datacenter = "dc2"`)
+		testrpc.WaitForTestAgent(t, a2.RPC, "dc2")
+
+		bodyBytes, err := json.Marshal(&pbpeering.GenerateTokenRequest{
+			PeerName: "foo",
+		})
+		require.NoError(t, err)
+
+		req, err := http.NewRequest("POST", "/v1/peering/token", bytes.NewReader(bodyBytes))
+		require.NoError(t, err)
+		resp := httptest.NewRecorder()
+		a.srv.h.ServeHTTP(resp, req)
+		require.Equal(t, http.StatusOK, resp.Code, "expected 200, got %d: %v", resp.Code, resp.Body.String())
+
+		var r pbpeering.GenerateTokenResponse
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&r))
+
+		b, err := json.Marshal(&pbpeering.EstablishRequest{
+			PeerName:     "zip",
+			PeeringToken: r.PeeringToken,
+		})
+		require.NoError(t, err)
+
+		req, err = http.NewRequest("POST", "/v1/peering/establish", bytes.NewReader(b))
+		require.NoError(t, err)
+		resp = httptest.NewRecorder()
+		a2.srv.h.ServeHTTP(resp, req)
+		require.Equal(t, http.StatusOK, resp.Code, "expected 200, got %d: %v", resp.Code, resp.Body.String())
+
+		// The secret can be rotated once the dialer has connected with the exchanged secret.
+		retry.Run(t, func(r *retry.R) {
+			req, err := http.NewRequest("POST", "/v1/peering/rotate-secret",
+				bytes.NewReader([]byte(`{"PeerName": "foo"}`)))
+			require.NoError(r, err)
+			resp := httptest.NewRecorder()
+			a.srv.h.ServeHTTP(resp, req)
+			require.Equal(r, http.StatusOK, resp.Code, "expected 200, got %d: %v", resp.Code, resp.Body.String())
+
+			// success response does not currently return a value so {} is correct
+			require.Equal(r, "{}", resp.Body.String())
+		})
+	})
+}
+
 func TestHTTP_Peering_MethodNotAllowed(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
@@ -584,6 +659,22 @@ func TestHTTP_Peering_Read(t *testing.T) {
 		lastIndex = getIndex(t, resp)
 	})
 
+	t.Run("return foo with detail", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "/v1/peering/foo?detail", nil)
+		require.NoError(t, err)
+		resp := httptest.NewRecorder()
+		a.srv.h.ServeHTTP(resp, req)
+		require.Equal(t, http.StatusOK, resp.Code)
+
+		var apiResp api.Peering
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&apiResp))
+
+		require.Equal(t, foo.Peering.Name, apiResp.Name)
+
+		// The peering never connected, so there is no replication detail to report.
+		require.Nil(t, apiResp.StreamStatus.Replication)
+	})
+
 	t.Run("not found", func(t *testing.T) {
 		req, err := http.NewRequest("GET", "/v1/peering/baz", nil)
 		require.NoError(t, err)
@@ -55,6 +55,7 @@ func (s serverCompiledDiscoveryChain) Notify(ctx context.Context, req *structs.D
 		EvaluateInNamespace:    entMeta.NamespaceOrDefault(),
 		EvaluateInPartition:    entMeta.PartitionOrDefault(),
 		EvaluateInDatacenter:   evalDC,
+		EvaluateInPeer:         req.EvaluateInPeer,
 		OverrideMeshGateway:    req.OverrideMeshGateway,
 		OverrideProtocol:       req.OverrideProtocol,
 		OverrideConnectTimeout: req.OverrideConnectTimeout,
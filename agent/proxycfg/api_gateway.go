@@ -63,8 +63,11 @@ func (h *handlerAPIGateway) initialize(ctx context.Context) (ConfigSnapshot, err
 	snap.APIGateway.BoundListeners = make(map[string]structs.BoundAPIGatewayListener)
 	snap.APIGateway.HTTPRoutes = watch.NewMap[structs.ResourceReference, *structs.HTTPRouteConfigEntry]()
 	snap.APIGateway.TCPRoutes = watch.NewMap[structs.ResourceReference, *structs.TCPRouteConfigEntry]()
+	snap.APIGateway.GRPCRoutes = watch.NewMap[structs.ResourceReference, *structs.GRPCRouteConfigEntry]()
+	snap.APIGateway.TLSRoutes = watch.NewMap[structs.ResourceReference, *structs.TLSRouteConfigEntry]()
 	snap.APIGateway.InlineCertificates = watch.NewMap[structs.ResourceReference, *structs.InlineCertificateConfigEntry]()
 	snap.APIGateway.FileSystemCertificates = watch.NewMap[structs.ResourceReference, *structs.FileSystemCertificateConfigEntry]()
+	snap.APIGateway.ACMECertificates = watch.NewMap[structs.ResourceReference, *structs.ACMECertificateConfigEntry]()
 
 	snap.APIGateway.Upstreams = make(listenerRouteUpstreams)
 	snap.APIGateway.UpstreamsSet = make(routeUpstreamSet)
@@ -125,6 +128,11 @@ func (h *handlerAPIGateway) handleUpdate(ctx context.Context, u UpdateEvent, sna
 		if err := h.handleInlineCertConfigUpdate(ctx, u, snap); err != nil {
 			return err
 		}
+	case acmeCertificateConfigWatchID:
+		// Handle change in an attached acme-certificate config entry
+		if err := h.handleACMECertConfigUpdate(ctx, u, snap); err != nil {
+			return err
+		}
 	case routeConfigWatchID:
 		// Handle change in an attached http-route or tcp-route config entry
 		if err := h.handleRouteConfigUpdate(ctx, u, snap); err != nil {
@@ -196,6 +204,10 @@ func (h *handlerAPIGateway) handleGatewayConfigUpdate(ctx context.Context, u Upd
 					snap.APIGateway.HTTPRoutes.InitWatch(ref, cancel)
 				case structs.TCPRoute:
 					snap.APIGateway.TCPRoutes.InitWatch(ref, cancel)
+				case structs.GRPCRoute:
+					snap.APIGateway.GRPCRoutes.InitWatch(ref, cancel)
+				case structs.TLSRoute:
+					snap.APIGateway.TLSRoutes.InitWatch(ref, cancel)
 				default:
 					cancel()
 					return fmt.Errorf("unexpected route kind on gateway: %s", ref.Kind)
@@ -213,14 +225,22 @@ func (h *handlerAPIGateway) handleGatewayConfigUpdate(ctx context.Context, u Upd
 				ctx, cancel := context.WithCancel(ctx)
 				seenRefs[ref] = struct{}{}
 
-				if ref.Kind == structs.FileSystemCertificate {
+				switch ref.Kind {
+				case structs.FileSystemCertificate:
 					snap.APIGateway.FileSystemCertificates.InitWatch(ref, cancel)
 
 					err := h.subscribeToConfigEntry(ctx, ref.Kind, ref.Name, ref.EnterpriseMeta, fileSystemCertificateConfigWatchID)
 					if err != nil {
 						return err
 					}
-				} else {
+				case structs.ACMECertificate:
+					snap.APIGateway.ACMECertificates.InitWatch(ref, cancel)
+
+					err := h.subscribeToConfigEntry(ctx, ref.Kind, ref.Name, ref.EnterpriseMeta, acmeCertificateConfigWatchID)
+					if err != nil {
+						return err
+					}
+				default:
 					snap.APIGateway.InlineCertificates.InitWatch(ref, cancel)
 
 					err := h.subscribeToConfigEntry(ctx, ref.Kind, ref.Name, ref.EnterpriseMeta, inlineCertificateConfigWatchID)
@@ -250,6 +270,24 @@ func (h *handlerAPIGateway) handleGatewayConfigUpdate(ctx context.Context, u Upd
 			return true
 		})
 
+		snap.APIGateway.GRPCRoutes.ForEachKey(func(ref structs.ResourceReference) bool {
+			if _, ok := seenRefs[ref]; !ok {
+				snap.APIGateway.Upstreams.delete(ref)
+				snap.APIGateway.UpstreamsSet.delete(ref)
+				snap.APIGateway.GRPCRoutes.CancelWatch(ref)
+			}
+			return true
+		})
+
+		snap.APIGateway.TLSRoutes.ForEachKey(func(ref structs.ResourceReference) bool {
+			if _, ok := seenRefs[ref]; !ok {
+				snap.APIGateway.Upstreams.delete(ref)
+				snap.APIGateway.UpstreamsSet.delete(ref)
+				snap.APIGateway.TLSRoutes.CancelWatch(ref)
+			}
+			return true
+		})
+
 		snap.APIGateway.InlineCertificates.ForEachKey(func(ref structs.ResourceReference) bool {
 			if _, ok := seenRefs[ref]; !ok {
 				snap.APIGateway.InlineCertificates.CancelWatch(ref)
@@ -264,6 +302,13 @@ func (h *handlerAPIGateway) handleGatewayConfigUpdate(ctx context.Context, u Upd
 			return true
 		})
 
+		snap.APIGateway.ACMECertificates.ForEachKey(func(ref structs.ResourceReference) bool {
+			if _, ok := seenRefs[ref]; !ok {
+				snap.APIGateway.ACMECertificates.CancelWatch(ref)
+			}
+			return true
+		})
+
 		snap.APIGateway.BoundGatewayConfigLoaded = true
 		break
 	case *structs.APIGatewayConfigEntry:
@@ -337,6 +382,32 @@ func (h *handlerAPIGateway) handleInlineCertConfigUpdate(_ context.Context, u Up
 	return nil
 }
 
+// handleACMECertConfigUpdate stores the issued certificate and any pending
+// HTTP-01 challenges for the gateway
+func (h *handlerAPIGateway) handleACMECertConfigUpdate(_ context.Context, u UpdateEvent, snap *ConfigSnapshot) error {
+	resp, ok := u.Result.(*structs.ConfigEntryResponse)
+	if !ok {
+		return fmt.Errorf("invalid type for response: %T", u.Result)
+	} else if resp.Entry == nil {
+		return nil
+	}
+
+	cfg, ok := resp.Entry.(*structs.ACMECertificateConfigEntry)
+	if !ok {
+		return fmt.Errorf("invalid type for config entry: %T", resp.Entry)
+	}
+
+	ref := structs.ResourceReference{
+		Kind:           cfg.GetKind(),
+		Name:           cfg.GetName(),
+		EnterpriseMeta: *cfg.GetEnterpriseMeta(),
+	}
+
+	snap.APIGateway.ACMECertificates.Set(ref, cfg)
+
+	return nil
+}
+
 // handleRouteConfigUpdate builds the list of upstreams for services on
 // the route and watches the related discovery chains.
 func (h *handlerAPIGateway) handleRouteConfigUpdate(ctx context.Context, u UpdateEvent, snap *ConfigSnapshot) error {
@@ -353,112 +424,80 @@ func (h *handlerAPIGateway) handleRouteConfigUpdate(ctx context.Context, u Updat
 		EnterpriseMeta: *resp.Entry.GetEnterpriseMeta(),
 	}
 
-	seenUpstreamIDs := make(upstreamIDSet)
-	upstreams := make(map[APIGatewayListenerKey]structs.Upstreams)
-
+	// protocol is the protocol of the upstreams for the route's services. It
+	// is passed in the upstream config in order to force that protocol on the
+	// Envoy clusters.
+	var protocol string
 	switch route := resp.Entry.(type) {
 	case *structs.HTTPRouteConfigEntry:
 		snap.APIGateway.HTTPRoutes.Set(ref, route)
-
-		for _, rule := range route.Rules {
-			for _, service := range rule.Services {
-				for _, listener := range snap.APIGateway.Listeners {
-					shouldBind := false
-					for _, parent := range route.Parents {
-						if h.referenceIsForListener(parent, listener, snap) {
-							shouldBind = true
-							break
-						}
-					}
-					if !shouldBind {
-						continue
-					}
-
-					upstream := structs.Upstream{
-						DestinationName:      service.Name,
-						DestinationNamespace: service.NamespaceOrDefault(),
-						DestinationPartition: service.PartitionOrDefault(),
-						LocalBindPort:        listener.Port,
-						// Pass the protocol that was configured on the listener in order
-						// to force that protocol on the Envoy listener.
-						Config: map[string]interface{}{
-							"protocol": "http",
-						},
-					}
-
-					listenerKey := APIGatewayListenerKeyFromListener(listener)
-					upstreams[listenerKey] = append(upstreams[listenerKey], upstream)
-				}
-
-				upstreamID := NewUpstreamIDFromServiceName(service.ServiceName())
-				seenUpstreamIDs[upstreamID] = struct{}{}
-
-				watchOpts := discoveryChainWatchOpts{
-					id:         upstreamID,
-					name:       service.Name,
-					namespace:  service.NamespaceOrDefault(),
-					partition:  service.PartitionOrDefault(),
-					datacenter: h.stateConfig.source.Datacenter,
-				}
-
-				handler := &handlerUpstreams{handlerState: h.handlerState}
-				if err := handler.watchDiscoveryChain(ctx, snap, watchOpts); err != nil {
-					return fmt.Errorf("failed to watch discovery chain for %s: %w", upstreamID, err)
-				}
-			}
-		}
-
+		protocol = "http"
+	case *structs.GRPCRouteConfigEntry:
+		snap.APIGateway.GRPCRoutes.Set(ref, route)
+		protocol = "grpc"
 	case *structs.TCPRouteConfigEntry:
 		snap.APIGateway.TCPRoutes.Set(ref, route)
+		protocol = "tcp"
+	case *structs.TLSRouteConfigEntry:
+		snap.APIGateway.TLSRoutes.Set(ref, route)
+		protocol = "tcp"
+	default:
+		return fmt.Errorf("invalid type for config entry: %T", resp.Entry)
+	}
+	route := resp.Entry.(structs.BoundRoute)
 
-		for _, service := range route.Services {
-			upstreamID := NewUpstreamIDFromServiceName(service.ServiceName())
-			seenUpstreamIDs.add(upstreamID)
-
-			// For each listener, check if this route should bind and, if so, create an upstream.
-			for _, listener := range snap.APIGateway.Listeners {
-				shouldBind := false
-				for _, parent := range route.Parents {
-					if h.referenceIsForListener(parent, listener, snap) {
-						shouldBind = true
-						break
-					}
-				}
-				if !shouldBind {
-					continue
-				}
+	seenUpstreamIDs := make(upstreamIDSet)
+	upstreams := make(map[APIGatewayListenerKey]structs.Upstreams)
 
-				upstream := structs.Upstream{
-					DestinationName:      service.Name,
-					DestinationNamespace: service.NamespaceOrDefault(),
-					DestinationPartition: service.PartitionOrDefault(),
-					LocalBindPort:        listener.Port,
-					// Pass the protocol that was configured on the ingress listener in order
-					// to force that protocol on the Envoy listener.
-					Config: map[string]interface{}{
-						"protocol": "tcp",
-					},
+	for _, service := range route.GetServiceNames() {
+		upstreamID := NewUpstreamIDFromServiceName(service)
+		seenUpstreamIDs.add(upstreamID)
+
+		// For each listener, check if this route should bind and, if so, create an upstream.
+		for _, listener := range snap.APIGateway.Listeners {
+			shouldBind := false
+			for _, parent := range route.GetParents() {
+				if h.referenceIsForListener(parent, listener, snap) {
+					shouldBind = true
+					break
 				}
-
-				listenerKey := APIGatewayListenerKeyFromListener(listener)
-				upstreams[listenerKey] = append(upstreams[listenerKey], upstream)
+			}
+			if !shouldBind {
+				continue
 			}
 
-			watchOpts := discoveryChainWatchOpts{
-				id:         upstreamID,
-				name:       service.Name,
-				namespace:  service.NamespaceOrDefault(),
-				partition:  service.PartitionOrDefault(),
-				datacenter: h.stateConfig.source.Datacenter,
+			upstream := structs.Upstream{
+				DestinationName:      service.Name,
+				DestinationNamespace: service.NamespaceOrDefault(),
+				DestinationPartition: service.PartitionOrDefault(),
+				LocalBindPort:        listener.Port,
+				Config: map[string]interface{}{
+					"protocol": protocol,
+				},
 			}
 
-			handler := &handlerUpstreams{handlerState: h.handlerState}
-			if err := handler.watchDiscoveryChain(ctx, snap, watchOpts); err != nil {
-				return fmt.Errorf("failed to watch discovery chain for %s: %w", upstreamID, err)
+			// TLS passthrough listeners select the upstream by SNI, so carry
+			// the hostnames the route accepts on this listener.
+			if tlsRoute, ok := route.(*structs.TLSRouteConfigEntry); ok {
+				upstream.IngressHosts = tlsRoute.FilteredHostnames(listener.GetHostname())
 			}
+
+			listenerKey := APIGatewayListenerKeyFromListener(listener)
+			upstreams[listenerKey] = append(upstreams[listenerKey], upstream)
+		}
+
+		watchOpts := discoveryChainWatchOpts{
+			id:         upstreamID,
+			name:       service.Name,
+			namespace:  service.NamespaceOrDefault(),
+			partition:  service.PartitionOrDefault(),
+			datacenter: h.stateConfig.source.Datacenter,
+		}
+
+		handler := &handlerUpstreams{handlerState: h.handlerState}
+		if err := handler.watchDiscoveryChain(ctx, snap, watchOpts); err != nil {
+			return fmt.Errorf("failed to watch discovery chain for %s: %w", upstreamID, err)
 		}
-	default:
-		return fmt.Errorf("invalid type for config entry: %T", resp.Entry)
 	}
 
 	for listener, set := range upstreams {
@@ -556,10 +595,11 @@ func (h *handlerAPIGateway) watchIngressLeafCert(ctx context.Context, snap *Conf
 	}
 	ctx, cancel := context.WithCancel(ctx)
 	err := h.dataSources.LeafCertificate.Notify(ctx, &leafcert.ConnectCALeafRequest{
-		Datacenter:     h.source.Datacenter,
-		Token:          h.token,
-		Service:        h.service,
-		EnterpriseMeta: h.proxyID.EnterpriseMeta,
+		Datacenter:      h.source.Datacenter,
+		Token:           h.token,
+		Service:         h.service,
+		EnvoyCompatible: true,
+		EnterpriseMeta:  h.proxyID.EnterpriseMeta,
 	}, leafWatchID, h.ch)
 	if err != nil {
 		cancel()
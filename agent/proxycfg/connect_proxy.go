@@ -72,10 +72,11 @@ func (s *handlerConnectProxy) initialize(ctx context.Context) (ConfigSnapshot, e
 
 	// Watch the leaf cert
 	err = s.dataSources.LeafCertificate.Notify(ctx, &leafcert.ConnectCALeafRequest{
-		Datacenter:     s.source.Datacenter,
-		Token:          s.token,
-		Service:        s.proxyCfg.DestinationServiceName,
-		EnterpriseMeta: s.proxyID.EnterpriseMeta,
+		Datacenter:      s.source.Datacenter,
+		Token:           s.token,
+		Service:         s.proxyCfg.DestinationServiceName,
+		EnvoyCompatible: true,
+		EnterpriseMeta:  s.proxyID.EnterpriseMeta,
 	}, leafWatchID, s.ch)
 	if err != nil {
 		return snap, err
@@ -225,12 +226,14 @@ func (s *handlerConnectProxy) initialize(ctx context.Context) (ConfigSnapshot, e
 			fallthrough
 
 		case "":
+			// Upstreams with a destination peer also watch the chain compiled
+			// from the config entries exported by the peer, which replaces the
+			// peered upstream watches unless it is a default chain.
 			if u.DestinationPeer != "" {
 				err := s.setupWatchesForPeeredUpstream(ctx, snap.ConnectProxy, NewUpstreamID(&u), dc)
 				if err != nil {
 					return snap, fmt.Errorf("failed to setup watches for peered upstream %q: %w", uid.String(), err)
 				}
-				continue
 			}
 
 			err := s.dataSources.CompiledDiscoveryChain.Notify(ctx, &structs.DiscoveryChainRequest{
@@ -240,6 +243,7 @@ func (s *handlerConnectProxy) initialize(ctx context.Context) (ConfigSnapshot, e
 				EvaluateInDatacenter:   dc,
 				EvaluateInNamespace:    ns,
 				EvaluateInPartition:    partition,
+				EvaluateInPeer:         u.DestinationPeer,
 				OverrideMeshGateway:    u.MeshGateway,
 				OverrideProtocol:       cfg.Protocol,
 				OverrideConnectTimeout: cfg.ConnectTimeout(),
@@ -6,6 +6,7 @@ package proxycfg
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/consul/agent/leafcert"
 	"github.com/hashicorp/consul/agent/proxycfg/internal/watch"
@@ -72,6 +73,7 @@ func (s *handlerIngressGateway) initialize(ctx context.Context) (ConfigSnapshot,
 	snap.IngressGateway.WatchedGatewayEndpoints = make(map[UpstreamID]map[string]structs.CheckServiceNodes)
 	snap.IngressGateway.WatchedLocalGWEndpoints = watch.NewMap[string, structs.CheckServiceNodes]()
 	snap.IngressGateway.Listeners = make(map[IngressListenerKey]structs.IngressListener)
+	snap.IngressGateway.ACMECertificates = watch.NewMap[structs.ResourceReference, *structs.ACMECertificateConfigEntry]()
 	snap.IngressGateway.UpstreamPeerTrustBundles = watch.NewMap[string, *pbpeering.PeeringTrustBundle]()
 	snap.IngressGateway.PeerUpstreamEndpoints = watch.NewMap[UpstreamID, structs.CheckServiceNodes]()
 	snap.IngressGateway.PeerUpstreamEndpointsUseHostnames = make(map[UpstreamID]struct{})
@@ -120,10 +122,37 @@ func (s *handlerIngressGateway) handleUpdate(ctx context.Context, u UpdateEvent,
 			snap.IngressGateway.Listeners[key] = l
 		}
 
+		if err := s.watchACMECertificates(ctx, snap, gatewayConf); err != nil {
+			return err
+		}
+
 		if err := s.watchIngressLeafCert(ctx, snap); err != nil {
 			return err
 		}
 
+	case strings.HasPrefix(u.CorrelationID, acmeCertificateIDPrefix):
+		resp, ok := u.Result.(*structs.ConfigEntryResponse)
+		if !ok {
+			return fmt.Errorf("invalid type for response: %T", u.Result)
+		}
+
+		ref := structs.ResourceReference{
+			Kind:           structs.ACMECertificate,
+			Name:           strings.TrimPrefix(u.CorrelationID, acmeCertificateIDPrefix),
+			EnterpriseMeta: s.proxyID.EnterpriseMeta,
+		}
+
+		// A deleted acme-certificate is stored as nil so the gateway stops
+		// serving its certificate.
+		var cert *structs.ACMECertificateConfigEntry
+		if resp.Entry != nil {
+			cert, ok = resp.Entry.(*structs.ACMECertificateConfigEntry)
+			if !ok {
+				return fmt.Errorf("invalid type for config entry: %T", resp.Entry)
+			}
+		}
+		snap.IngressGateway.ACMECertificates.Set(ref, cert)
+
 	case u.CorrelationID == gatewayServicesWatchID:
 		services, ok := u.Result.(*structs.IndexedGatewayServices)
 		if !ok {
@@ -190,6 +219,55 @@ func (s *handlerIngressGateway) handleUpdate(ctx context.Context, u UpdateEvent,
 	return nil
 }
 
+// watchACMECertificates watches the acme-certificates referenced by the
+// gateway and its listeners, and cancels the watches of the ones that are no
+// longer referenced.
+func (s *handlerIngressGateway) watchACMECertificates(ctx context.Context, snap *ConfigSnapshot, gatewayConf *structs.IngressGatewayConfigEntry) error {
+	refs := make(map[structs.ResourceReference]struct{})
+	addRef := func(tls *structs.GatewayTLSConfig) {
+		if tls == nil || tls.ACMECertificate == "" {
+			return
+		}
+		refs[structs.ResourceReference{
+			Kind:           structs.ACMECertificate,
+			Name:           tls.ACMECertificate,
+			EnterpriseMeta: s.proxyID.EnterpriseMeta,
+		}] = struct{}{}
+	}
+	addRef(&gatewayConf.TLS)
+	for _, l := range gatewayConf.Listeners {
+		addRef(l.TLS)
+	}
+
+	for ref := range refs {
+		if snap.IngressGateway.ACMECertificates.IsWatched(ref) {
+			continue
+		}
+
+		ctx, cancel := context.WithCancel(ctx)
+		err := s.dataSources.ConfigEntry.Notify(ctx, &structs.ConfigEntryQuery{
+			Kind:           structs.ACMECertificate,
+			Name:           ref.Name,
+			Datacenter:     s.source.Datacenter,
+			QueryOptions:   structs.QueryOptions{Token: s.token},
+			EnterpriseMeta: ref.EnterpriseMeta,
+		}, acmeCertificateIDPrefix+ref.Name, s.ch)
+		if err != nil {
+			cancel()
+			return err
+		}
+		snap.IngressGateway.ACMECertificates.InitWatch(ref, cancel)
+	}
+
+	snap.IngressGateway.ACMECertificates.ForEachKey(func(ref structs.ResourceReference) bool {
+		if _, ok := refs[ref]; !ok {
+			snap.IngressGateway.ACMECertificates.CancelWatch(ref)
+		}
+		return true
+	})
+	return nil
+}
+
 // Note: Ingress gateways are always bound to ports and never unix sockets.
 // This means LocalBindPort is the only possibility
 func makeUpstream(g *structs.GatewayService) structs.Upstream {
@@ -222,11 +300,12 @@ func (s *handlerIngressGateway) watchIngressLeafCert(ctx context.Context, snap *
 	}
 	ctx, cancel := context.WithCancel(ctx)
 	err := s.dataSources.LeafCertificate.Notify(ctx, &leafcert.ConnectCALeafRequest{
-		Datacenter:     s.source.Datacenter,
-		Token:          s.token,
-		Service:        s.service,
-		DNSSAN:         s.generateIngressDNSSANs(snap),
-		EnterpriseMeta: s.proxyID.EnterpriseMeta,
+		Datacenter:      s.source.Datacenter,
+		Token:           s.token,
+		Service:         s.service,
+		DNSSAN:          s.generateIngressDNSSANs(snap),
+		EnvoyCompatible: true,
+		EnterpriseMeta:  s.proxyID.EnterpriseMeta,
 	}, leafWatchID, s.ch)
 	if err != nil {
 		cancel()
@@ -132,9 +132,10 @@ func TestManager_BasicLifecycle(t *testing.T) {
 		QueryOptions: structs.QueryOptions{Token: "my-token"},
 	}
 	leafReq := &leafcert.ConnectCALeafRequest{
-		Datacenter: "dc1",
-		Token:      "my-token",
-		Service:    "web",
+		Datacenter:      "dc1",
+		Token:           "my-token",
+		Service:         "web",
+		EnvoyCompatible: true,
 	}
 
 	intentionReq := &structs.ServiceSpecificRequest{
@@ -421,8 +421,11 @@ func (o *configSnapshotAPIGateway) DeepCopy() *configSnapshotAPIGateway {
 	}
 	cp.HTTPRoutes = o.HTTPRoutes.DeepCopy()
 	cp.TCPRoutes = o.TCPRoutes.DeepCopy()
+	cp.GRPCRoutes = o.GRPCRoutes.DeepCopy()
+	cp.TLSRoutes = o.TLSRoutes.DeepCopy()
 	cp.InlineCertificates = o.InlineCertificates.DeepCopy()
 	cp.FileSystemCertificates = o.FileSystemCertificates.DeepCopy()
+	cp.ACMECertificates = o.ACMECertificates.DeepCopy()
 	if o.Listeners != nil {
 		cp.Listeners = make(map[string]structs.APIGatewayListener, len(o.Listeners))
 		for k2, v2 := range o.Listeners {
@@ -518,6 +521,7 @@ func (o *configSnapshotIngressGateway) DeepCopy() *configSnapshotIngressGateway
 		cp.TLSConfig.CipherSuites = make([]types.TLSCipherSuite, len(o.TLSConfig.CipherSuites))
 		copy(cp.TLSConfig.CipherSuites, o.TLSConfig.CipherSuites)
 	}
+	cp.ACMECertificates = o.ACMECertificates.DeepCopy()
 	if o.Hosts != nil {
 		cp.Hosts = make([]string, len(o.Hosts))
 		copy(cp.Hosts, o.Hosts)
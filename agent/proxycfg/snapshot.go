@@ -741,9 +741,12 @@ type configSnapshotAPIGateway struct {
 
 	HTTPRoutes watch.Map[structs.ResourceReference, *structs.HTTPRouteConfigEntry]
 	TCPRoutes  watch.Map[structs.ResourceReference, *structs.TCPRouteConfigEntry]
+	GRPCRoutes watch.Map[structs.ResourceReference, *structs.GRPCRouteConfigEntry]
+	TLSRoutes  watch.Map[structs.ResourceReference, *structs.TLSRouteConfigEntry]
 
 	InlineCertificates     watch.Map[structs.ResourceReference, *structs.InlineCertificateConfigEntry]
 	FileSystemCertificates watch.Map[structs.ResourceReference, *structs.FileSystemCertificateConfigEntry]
+	ACMECertificates       watch.Map[structs.ResourceReference, *structs.ACMECertificateConfigEntry]
 
 	// LeafCertWatchCancel is a CancelFunc to use when refreshing this gateway's
 	// leaf cert watch with different parameters.
@@ -756,6 +759,46 @@ type configSnapshotAPIGateway struct {
 	BoundListeners map[string]structs.BoundAPIGatewayListener
 }
 
+// HTTPRoute returns the route referenced by ref as an HTTPRoute. GRPCRoutes are
+// converted to their equivalent HTTPRoute so that they can be consolidated with
+// the other routes on the same listener.
+func (c *configSnapshotAPIGateway) HTTPRoute(ref structs.ResourceReference) (*structs.HTTPRouteConfigEntry, bool) {
+	switch ref.Kind {
+	case structs.HTTPRoute:
+		return c.HTTPRoutes.Get(ref)
+	case structs.GRPCRoute:
+		route, ok := c.GRPCRoutes.Get(ref)
+		if !ok {
+			return nil, false
+		}
+		return route.ToHTTPRoute(), true
+	default:
+		return nil, false
+	}
+}
+
+// ACMEChallenges returns the pending HTTP-01 challenges of every acme-certificate
+// referenced by the gateway, ordered by token.
+func (c *configSnapshotAPIGateway) ACMEChallenges() []structs.ACMEChallenge {
+	return acmeChallenges(c.ACMECertificates)
+}
+
+// acmeChallenges returns the pending HTTP-01 challenges of the given
+// acme-certificates, ordered by token.
+func acmeChallenges(certs watch.Map[structs.ResourceReference, *structs.ACMECertificateConfigEntry]) []structs.ACMEChallenge {
+	var challenges []structs.ACMEChallenge
+	certs.ForEachKey(func(ref structs.ResourceReference) bool {
+		if cert, ok := certs.Get(ref); ok && cert != nil {
+			challenges = append(challenges, cert.Challenges...)
+		}
+		return true
+	})
+	sort.Slice(challenges, func(i, j int) bool {
+		return challenges[i].Token < challenges[j].Token
+	})
+	return challenges
+}
+
 func (c *configSnapshotAPIGateway) synthesizeChains(datacenter string, listener structs.APIGatewayListener, boundListener structs.BoundAPIGatewayListener) ([]structs.IngressService, structs.Upstreams, []*structs.CompiledDiscoveryChain, error) {
 	chains := []*structs.CompiledDiscoveryChain{}
 
@@ -809,6 +852,37 @@ DOMAIN_LOOP:
 					chains = append(chains, chain)
 				}
 			}
+		case structs.GRPCRoute:
+			route, ok := c.GRPCRoutes.Get(routeRef)
+			if !ok || listener.Protocol != structs.ListenerProtocolHTTP {
+				continue
+			}
+			synthesizer.AddHTTPRoute(*route.ToHTTPRoute())
+			for _, service := range route.GetServices() {
+				id := NewUpstreamIDFromServiceName(structs.NewServiceName(service.Name, &service.EnterpriseMeta))
+				if chain := c.DiscoveryChain[id]; chain != nil {
+					chains = append(chains, chain)
+				}
+			}
+		case structs.TLSRoute:
+			route, ok := c.TLSRoutes.Get(routeRef)
+			if !ok || listener.Protocol != structs.ListenerProtocolTLS {
+				continue
+			}
+			// TLS routes are not compiled into a new chain, the service chains
+			// are returned as-is and must line up with the synthesized services.
+			var routeChains []*structs.CompiledDiscoveryChain
+			for _, service := range route.GetServices() {
+				id := NewUpstreamIDFromServiceName(structs.NewServiceName(service.Name, &service.EnterpriseMeta))
+				if chain := c.DiscoveryChain[id]; chain != nil {
+					routeChains = append(routeChains, chain)
+				}
+			}
+			if len(routeChains) != len(route.GetServices()) {
+				continue
+			}
+			synthesizer.AddTLSRoute(*route)
+			chains = append(chains, routeChains...)
 		default:
 			return nil, nil, nil, fmt.Errorf("unknown route kind %q", routeRef.Kind)
 		}
@@ -860,6 +934,10 @@ type configSnapshotIngressGateway struct {
 	// config is preserved in the Listeners map below.
 	TLSConfig structs.GatewayTLSConfig
 
+	// ACMECertificates are the acme-certificate config entries referenced by
+	// the gateway or its listeners.
+	ACMECertificates watch.Map[structs.ResourceReference, *structs.ACMECertificateConfigEntry]
+
 	// GatewayConfigLoaded is used to determine if we have received the initial
 	// ingress-gateway config entry yet.
 	GatewayConfigLoaded bool
@@ -888,6 +966,12 @@ type configSnapshotIngressGateway struct {
 	Defaults structs.IngressServiceConfig
 }
 
+// ACMEChallenges returns the pending HTTP-01 challenges of every acme-certificate
+// referenced by the gateway, ordered by token.
+func (c *configSnapshotIngressGateway) ACMEChallenges() []structs.ACMEChallenge {
+	return acmeChallenges(c.ACMECertificates)
+}
+
 // isEmpty is a test helper
 func (c *configSnapshotIngressGateway) isEmpty() bool {
 	if c == nil {
@@ -1110,6 +1194,19 @@ func (s *ConfigSnapshot) RootPEMs() string {
 	return rootPEMs
 }
 
+// RevocationListPEMs returns the concatenated certificate revocation lists of
+// the local trust domain, or an empty string if none should be enforced.
+func (s *ConfigSnapshot) RevocationListPEMs() string {
+	if s.Roots == nil {
+		return ""
+	}
+	var crlPEMs string
+	for _, l := range s.Roots.RevocationLists {
+		crlPEMs += lib.EnsureTrailingNewline(l.RevocationListPEM)
+	}
+	return crlPEMs
+}
+
 func (s *ConfigSnapshot) MeshConfig() *structs.MeshConfigEntry {
 	switch s.Kind {
 	case structs.ServiceKindConnectProxy:
@@ -1183,10 +1280,16 @@ func (u *ConfigSnapshotUpstreams) UpstreamPeerMeta(uid UpstreamID) (structs.Peer
 
 // PeeredUpstreamIDs returns a slice of peered UpstreamIDs from explicit config entries
 // and implicit imported services.
-// Upstreams whose trust bundles have not been stored in the snapshot are ignored.
+// Upstreams whose trust bundles have not been stored in the snapshot are ignored,
+// as are upstreams that use a discovery chain compiled from the config entries
+// exported by their peer.
 func (u *ConfigSnapshotUpstreams) PeeredUpstreamIDs() []UpstreamID {
 	out := make([]UpstreamID, 0, u.PeerUpstreamEndpoints.Len())
 	u.PeerUpstreamEndpoints.ForEachKey(func(uid UpstreamID) bool {
+		if _, ok := u.DiscoveryChain[uid]; ok {
+			return true
+		}
+
 		if _, ok := u.PeerUpstreamEndpoints.Get(uid); !ok {
 			// uid might exist in the map but if Set hasn't been called, skip for now.
 			return true
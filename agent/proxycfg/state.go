@@ -44,12 +44,14 @@ const (
 	boundGatewayConfigWatchID          = "bound-gateway-config"
 	fileSystemCertificateConfigWatchID = "file-system-certificate-config"
 	inlineCertificateConfigWatchID     = "inline-certificate-config"
+	acmeCertificateConfigWatchID       = "acme-certificate-config"
 	routeConfigWatchID                 = "route-config"
 	externalServiceIDPrefix            = "external-service:"
 	serviceLeafIDPrefix                = "service-leaf:"
 	serviceConfigIDPrefix              = "service-config:"
 	serviceResolverIDPrefix            = "service-resolver:"
 	serviceIntentionsIDPrefix          = "service-intentions:"
+	acmeCertificateIDPrefix            = "acme-certificate:"
 	intentionUpstreamsID               = "intention-upstreams"
 	jwtProviderID                      = "jwt-provider"
 	peerServersWatchID                 = "peer-servers"
@@ -231,6 +231,7 @@ func genVerifyLeafWatchWithDNSSANs(expectedService string, expectedDatacenter st
 		require.Equal(t, expectedDatacenter, reqReal.Datacenter)
 		require.Equal(t, expectedService, reqReal.Service)
 		require.ElementsMatch(t, expectedDNSSANs, reqReal.DNSSAN)
+		require.True(t, reqReal.EnvoyCompatible)
 	}
 }
 
@@ -3765,12 +3766,26 @@ func TestState_WatchesAndUpdates(t *testing.T) {
 								Token: aclToken,
 							},
 						}),
-						rootsWatchID:                                   genVerifyDCSpecificWatch("dc1"),
-						leafWatchID:                                    genVerifyLeafWatch("web", "dc1"),
-						peeringTrustBundlesWatchID:                     genVerifyTrustBundleListWatch("web"),
-						peerTrustBundleIDPrefix + "peer-a":             genVerifyTrustBundleReadWatch("peer-a"),
+						fmt.Sprintf("discovery-chain:%s", extApiUID.String()): genVerifyDiscoveryChainWatch(&structs.DiscoveryChainRequest{
+							Name:                 "api-a",
+							EvaluateInDatacenter: "dc1",
+							EvaluateInNamespace:  "default",
+							EvaluateInPartition:  "default",
+							EvaluateInPeer:       "peer-a",
+							OverrideMeshGateway: structs.MeshGatewayConfig{
+								Mode: structs.MeshGatewayModeLocal,
+							},
+							Datacenter: "dc1",
+							QueryOptions: structs.QueryOptions{
+								Token: aclToken,
+							},
+						}),
+						rootsWatchID:                       genVerifyDCSpecificWatch("dc1"),
+						leafWatchID:                        genVerifyLeafWatch("web", "dc1"),
+						peeringTrustBundlesWatchID:         genVerifyTrustBundleListWatch("web"),
+						peerTrustBundleIDPrefix + "peer-a": genVerifyTrustBundleReadWatch("peer-a"),
 						upstreamPeerWatchIDPrefix + extApiUID.String(): genVerifyServiceSpecificPeeredRequest("api-a", "", "dc1", "peer-a", true),
-						"mesh-gateway:dc1":                             genVerifyGatewayWatch("dc1"),
+						"mesh-gateway:dc1": genVerifyGatewayWatch("dc1"),
 					},
 					verifySnapshot: func(t testing.TB, snap *ConfigSnapshot) {
 						require.False(t, snap.Valid(), "should not be valid")
@@ -3833,6 +3848,15 @@ func TestState_WatchesAndUpdates(t *testing.T) {
 							},
 							Err: nil,
 						},
+						{
+							CorrelationID: fmt.Sprintf("discovery-chain:%s", extApiUID.String()),
+							Result: &structs.DiscoveryChainResponse{
+								Chain: discoverychain.TestCompileConfigEntries(t, "api-a", "default", "default", "dc1", "trustdomain.consul", func(req *discoverychain.CompileRequest) {
+									req.EvaluateInPeer = "peer-a"
+								}, nil),
+							},
+							Err: nil,
+						},
 						{
 							CorrelationID: peerTrustBundleIDPrefix + "peer-a",
 							Result: &pbpeering.TrustBundleReadResponse{
@@ -3911,6 +3935,68 @@ func TestState_WatchesAndUpdates(t *testing.T) {
 						gwEp, _ := snap.ConnectProxy.WatchedLocalGWEndpoints.Get("dc1")
 						require.NotNil(t, gwEp)
 						require.Len(t, gwEp, 1)
+
+						// The peer exported nothing, so the peered upstream watches are used.
+						require.NotContains(t, snap.ConnectProxy.DiscoveryChain, extApiUID)
+						require.Contains(t, snap.ConnectProxy.PeeredUpstreamIDs(), extApiUID)
+					},
+				},
+				{
+					// The peer exports a resolver, so the chain takes over.
+					events: []UpdateEvent{
+						{
+							CorrelationID: fmt.Sprintf("discovery-chain:%s", extApiUID.String()),
+							Result: &structs.DiscoveryChainResponse{
+								Chain: discoverychain.TestCompileConfigEntries(t, "api-a", "default", "default", "dc1", "trustdomain.consul", func(req *discoverychain.CompileRequest) {
+									req.EvaluateInPeer = "peer-a"
+								}, func() *configentry.DiscoveryChainSet {
+									set := configentry.NewDiscoveryChainSet()
+									set.AddImportedEntries("peer-a", &structs.ServiceResolverConfigEntry{
+										Kind:           structs.ServiceResolver,
+										Name:           "api-a",
+										ConnectTimeout: 33 * time.Second,
+									})
+									return set
+								}()),
+							},
+							Err: nil,
+						},
+					},
+					verifySnapshot: func(t testing.TB, snap *ConfigSnapshot) {
+						require.True(t, snap.Valid())
+
+						require.Len(t, snap.ConnectProxy.DiscoveryChain, 2, "%+v", snap.ConnectProxy.DiscoveryChain)
+						require.Contains(t, snap.ConnectProxy.DiscoveryChain, extApiUID)
+						require.Len(t, snap.ConnectProxy.WatchedUpstreams[extApiUID], 1, "%+v", snap.ConnectProxy.WatchedUpstreams)
+						require.NotContains(t, snap.ConnectProxy.PeeredUpstreamIDs(), extApiUID)
+
+						require.Equal(t, 1, snap.ConnectProxy.PeerUpstreamEndpoints.Len())
+						ep, _ := snap.ConnectProxy.PeerUpstreamEndpoints.Get(extApiUID)
+						require.NotNil(t, ep)
+					},
+				},
+				{
+					// The peer stops exporting the resolver.
+					events: []UpdateEvent{
+						{
+							CorrelationID: fmt.Sprintf("discovery-chain:%s", extApiUID.String()),
+							Result: &structs.DiscoveryChainResponse{
+								Chain: discoverychain.TestCompileConfigEntries(t, "api-a", "default", "default", "dc1", "trustdomain.consul", func(req *discoverychain.CompileRequest) {
+									req.EvaluateInPeer = "peer-a"
+								}, nil),
+							},
+							Err: nil,
+						},
+					},
+					verifySnapshot: func(t testing.TB, snap *ConfigSnapshot) {
+						require.True(t, snap.Valid())
+
+						require.Len(t, snap.ConnectProxy.DiscoveryChain, 1, "%+v", snap.ConnectProxy.DiscoveryChain)
+						require.NotContains(t, snap.ConnectProxy.WatchedUpstreams, extApiUID)
+						require.Contains(t, snap.ConnectProxy.PeeredUpstreamIDs(), extApiUID)
+
+						ep, _ := snap.ConnectProxy.PeerUpstreamEndpoints.Get(extApiUID)
+						require.NotNil(t, ep)
 					},
 				},
 			},
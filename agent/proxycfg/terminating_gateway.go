@@ -200,10 +200,11 @@ func (s *handlerTerminatingGateway) handleUpdate(ctx context.Context, u UpdateEv
 			if _, ok := snap.TerminatingGateway.WatchedLeaves[svc.Service]; !ok {
 				ctx, cancel := context.WithCancel(ctx)
 				err := s.dataSources.LeafCertificate.Notify(ctx, &leafcert.ConnectCALeafRequest{
-					Datacenter:     s.source.Datacenter,
-					Token:          s.token,
-					Service:        svc.Service.Name,
-					EnterpriseMeta: svc.Service.EnterpriseMeta,
+					Datacenter:      s.source.Datacenter,
+					Token:           s.token,
+					Service:         svc.Service.Name,
+					EnvoyCompatible: true,
+					EnterpriseMeta:  svc.Service.EnterpriseMeta,
 				}, serviceLeafIDPrefix+svc.Service.String(), s.ch)
 
 				if err != nil {
@@ -72,13 +72,21 @@ func TestConfigSnapshotAPIGateway(
 		}
 		baseEvents = append(baseEvents, watch)
 
-		// Add the watch event for the discovery chain.
+		// Add the watch event for the discovery chain. gRPC routes target
+		// grpc services and TLS routes pass connections through to tcp ones.
+		protocol := string(route.GetProtocol())
+		switch route.(type) {
+		case *structs.GRPCRouteConfigEntry:
+			protocol = "grpc"
+		case *structs.TLSRouteConfigEntry:
+			protocol = "tcp"
+		}
 		entries := []structs.ConfigEntry{
 			&structs.ProxyConfigEntry{
 				Kind: structs.ProxyDefaults,
 				Name: structs.ProxyConfigGlobal,
 				Config: map[string]interface{}{
-					"protocol": route.GetProtocol(),
+					"protocol": protocol,
 				},
 			},
 			&structs.ServiceResolverConfigEntry{
@@ -2187,3 +2187,124 @@ func TestConfigSnapshotIngressGateway_TLSMixedCipherVersionListeners(t testing.T
 			},
 		})
 }
+
+// TestConfigSnapshotIngressGateway_ACMECertificate returns a gateway with a
+// plaintext HTTP listener answering ACME challenges, a TLS listener serving an
+// issued acme-certificate, and a TLS listener whose certificate is pending.
+func TestConfigSnapshotIngressGateway_ACMECertificate(t testing.T, certificate, privateKey string) *ConfigSnapshot {
+	var (
+		s1      = structs.NewServiceName("s1", nil)
+		s1UID   = NewUpstreamIDFromServiceName(s1)
+		s1Chain = discoverychain.TestCompileConfigEntries(t, "s1", "default", "default", "dc1", connect.TestClusterID+".consul", nil, nil)
+
+		s2      = structs.NewServiceName("s2", nil)
+		s2UID   = NewUpstreamIDFromServiceName(s2)
+		s2Chain = discoverychain.TestCompileConfigEntries(t, "s2", "default", "default", "dc1", connect.TestClusterID+".consul", nil, nil)
+	)
+	return TestConfigSnapshotIngressGateway(t, true, "tcp", "simple", nil,
+		func(entry *structs.IngressGatewayConfigEntry) {
+			entry.Listeners = []structs.IngressListener{
+				{
+					Port:     8080,
+					Protocol: "http",
+					Services: []structs.IngressService{
+						{Name: "s1"},
+					},
+				},
+				{
+					Port:     8443,
+					Protocol: "http",
+					Services: []structs.IngressService{
+						{Name: "s2"},
+					},
+					TLS: &structs.GatewayTLSConfig{
+						ACMECertificate: "issued",
+					},
+				},
+				{
+					Port:     9443,
+					Protocol: "http",
+					Services: []structs.IngressService{
+						{Name: "s2"},
+					},
+					TLS: &structs.GatewayTLSConfig{
+						ACMECertificate: "pending",
+					},
+				},
+			}
+		}, []UpdateEvent{
+			{
+				CorrelationID: gatewayServicesWatchID,
+				Result: &structs.IndexedGatewayServices{
+					Services: []*structs.GatewayService{
+						{
+							Service:  s1,
+							Port:     8080,
+							Protocol: "http",
+						},
+						{
+							Service:  s2,
+							Port:     8443,
+							Protocol: "http",
+						},
+						{
+							Service:  s2,
+							Port:     9443,
+							Protocol: "http",
+						},
+					},
+				},
+			},
+			{
+				CorrelationID: acmeCertificateIDPrefix + "issued",
+				Result: &structs.ConfigEntryResponse{
+					Entry: &structs.ACMECertificateConfigEntry{
+						Kind:        structs.ACMECertificate,
+						Name:        "issued",
+						Hostnames:   []string{"issued.example.com"},
+						Certificate: certificate,
+						PrivateKey:  privateKey,
+					},
+				},
+			},
+			{
+				CorrelationID: acmeCertificateIDPrefix + "pending",
+				Result: &structs.ConfigEntryResponse{
+					Entry: &structs.ACMECertificateConfigEntry{
+						Kind:      structs.ACMECertificate,
+						Name:      "pending",
+						Hostnames: []string{"pending.example.com"},
+						Challenges: []structs.ACMEChallenge{{
+							Hostname:         "pending.example.com",
+							Token:            "token",
+							KeyAuthorization: "token.thumbprint",
+						}},
+					},
+				},
+			},
+			{
+				CorrelationID: "discovery-chain:" + s1UID.String(),
+				Result: &structs.DiscoveryChainResponse{
+					Chain: s1Chain,
+				},
+			},
+			{
+				CorrelationID: "discovery-chain:" + s2UID.String(),
+				Result: &structs.DiscoveryChainResponse{
+					Chain: s2Chain,
+				},
+			},
+			{
+				CorrelationID: "upstream-target:" + s1Chain.ID() + ":" + s1UID.String(),
+				Result: &structs.IndexedCheckServiceNodes{
+					Nodes: TestUpstreamNodes(t, "s1"),
+				},
+			},
+			{
+				CorrelationID: "upstream-target:" + s2Chain.ID() + ":" + s2UID.String(),
+				Result: &structs.IndexedCheckServiceNodes{
+					Nodes: TestUpstreamNodes(t, "s2"),
+				},
+			},
+		})
+}
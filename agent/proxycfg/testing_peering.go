@@ -6,27 +6,83 @@ package proxycfg
 import (
 	"bytes"
 	"text/template"
+	"time"
 
 	"github.com/mitchellh/go-testing-interface"
 	"github.com/stretchr/testify/require"
 
+	"github.com/hashicorp/consul/agent/configentry"
+	"github.com/hashicorp/consul/agent/connect"
+	"github.com/hashicorp/consul/agent/consul/discoverychain"
 	"github.com/hashicorp/consul/agent/structs"
 	"github.com/hashicorp/consul/proto/private/pbpeering"
 )
 
 func TestConfigSnapshotPeering(t testing.T) *ConfigSnapshot {
-	return testConfigSnapshot(t, false, false, nil)
+	return testConfigSnapshot(t, false, false, nil, nil)
 }
 
 func TestConfigSnapshotPeeringWithEscapeOverrides(t testing.T) *ConfigSnapshot {
-	return testConfigSnapshot(t, true, false, nil)
+	return testConfigSnapshot(t, true, false, nil, nil)
 }
 
 func TestConfigSnapshotPeeringWithHTTP2(t testing.T, nsFn func(ns *structs.NodeService)) *ConfigSnapshot {
-	return testConfigSnapshot(t, false, true, nsFn)
+	return testConfigSnapshot(t, false, true, nsFn, nil)
 }
 
-func testConfigSnapshot(t testing.T, escapeOverride bool, useHTTP2 bool, nsFn func(ns *structs.NodeService)) *ConfigSnapshot {
+// TestConfigSnapshotPeeringWithImportedEntries returns a snapshot where the
+// peer of the payments upstream exported its service-defaults, a router that
+// sends some traffic to refunds, and a resolver.
+func TestConfigSnapshotPeeringWithImportedEntries(t testing.T) *ConfigSnapshot {
+	set := configentry.NewDiscoveryChainSet()
+	set.AddImportedEntries("cloud",
+		&structs.ServiceConfigEntry{
+			Kind:     structs.ServiceDefaults,
+			Name:     "payments",
+			Protocol: "http",
+		},
+		&structs.ServiceRouterConfigEntry{
+			Kind: structs.ServiceRouter,
+			Name: "payments",
+			Routes: []structs.ServiceRoute{
+				{
+					Match: &structs.ServiceRouteMatch{
+						HTTP: &structs.ServiceRouteHTTPMatch{
+							PathPrefix: "/refund",
+						},
+					},
+					Destination: &structs.ServiceRouteDestination{
+						Service: "refunds",
+					},
+				},
+			},
+		},
+		&structs.ServiceResolverConfigEntry{
+			Kind:           structs.ServiceResolver,
+			Name:           "payments",
+			ConnectTimeout: 33 * time.Second,
+		},
+	)
+	paymentsChain := discoverychain.TestCompileConfigEntries(t, "payments", "default", "default", "dc1", connect.TestClusterID+".consul", func(req *discoverychain.CompileRequest) {
+		req.EvaluateInPeer = "cloud"
+	}, set)
+
+	paymentsUID := NewUpstreamID(&structs.Upstream{
+		DestinationName: "payments",
+		DestinationPeer: "cloud",
+	})
+
+	return testConfigSnapshot(t, false, false, nil, []UpdateEvent{
+		{
+			CorrelationID: "discovery-chain:" + paymentsUID.String(),
+			Result: &structs.DiscoveryChainResponse{
+				Chain: paymentsChain,
+			},
+		},
+	})
+}
+
+func testConfigSnapshot(t testing.T, escapeOverride bool, useHTTP2 bool, nsFn func(ns *structs.NodeService), extraUpdates []UpdateEvent) *ConfigSnapshot {
 	var (
 		paymentsUpstream = structs.Upstream{
 			DestinationName: "payments",
@@ -75,7 +131,7 @@ func testConfigSnapshot(t testing.T, escapeOverride bool, useHTTP2 bool, nsFn fu
 		if nsFn != nil {
 			nsFn(ns)
 		}
-	}, []UpdateEvent{
+	}, append([]UpdateEvent{
 		{
 			CorrelationID: peerTrustBundleIDPrefix + "cloud",
 			Result: &pbpeering.TrustBundleReadResponse{
@@ -150,7 +206,7 @@ func testConfigSnapshot(t testing.T, escapeOverride bool, useHTTP2 bool, nsFn fu
 				},
 			},
 		},
-	})
+	}, extraUpdates...))
 }
 
 func TestConfigSnapshotPeeringTProxy(t testing.T) *ConfigSnapshot {
@@ -253,6 +253,10 @@ func setupTestVariationConfigEntriesAndSnapshot(
 		})
 	case "chain-and-splitter":
 	case "grpc-router":
+	case "grpc-route-match":
+	case "fault-injection":
+	case "retry-policy":
+	case "mirror":
 	case "chain-and-router":
 	case "lb-resolver":
 	case "register-to-terminating-gateway":
@@ -721,6 +725,222 @@ func setupTestVariationDiscoveryChain(
 				},
 			},
 		)
+	case "grpc-route-match":
+		entries = append(entries,
+			&structs.ProxyConfigEntry{
+				Kind:           structs.ProxyDefaults,
+				Name:           structs.ProxyConfigGlobal,
+				EnterpriseMeta: entMeta,
+				Protocol:       "grpc",
+				Config: map[string]interface{}{
+					"protocol": "grpc",
+				},
+			},
+			&structs.ServiceRouterConfigEntry{
+				Kind:           structs.ServiceRouter,
+				Name:           "db",
+				EnterpriseMeta: entMeta,
+				Routes: []structs.ServiceRoute{
+					{
+						Match: &structs.ServiceRouteMatch{
+							GRPC: &structs.ServiceRouteGRPCMatch{
+								Service: "fgrpc.PingServer",
+								Method:  "Ping",
+							},
+						},
+						Destination: &structs.ServiceRouteDestination{
+							Service:    "ping",
+							NumRetries: 3,
+							RetryOn:    []string{"unavailable", "resource-exhausted"},
+						},
+					},
+					{
+						Match: &structs.ServiceRouteMatch{
+							GRPC: &structs.ServiceRouteGRPCMatch{
+								Method: "Watch",
+							},
+						},
+						Destination: &structs.ServiceRouteDestination{
+							Service: "watch",
+						},
+					},
+					{
+						Match: &structs.ServiceRouteMatch{
+							GRPC: &structs.ServiceRouteGRPCMatch{
+								Service: "fgrpc.AdminServer",
+								Metadata: []structs.ServiceRouteHTTPMatchHeader{
+									{Name: "x-debug", Exact: "1"},
+								},
+							},
+						},
+						Destination: &structs.ServiceRouteDestination{
+							Service: "admin",
+						},
+					},
+				},
+			},
+		)
+	case "retry-policy":
+		entries = append(entries,
+			&structs.ServiceResolverConfigEntry{
+				Kind:           structs.ServiceResolver,
+				Name:           "db",
+				EnterpriseMeta: entMeta,
+				ConnectTimeout: 33 * time.Second,
+				RetryPolicy: &structs.ServiceResolverRetryPolicy{
+					NumRetries:        2,
+					RetryOn:           []string{"reset"},
+					RetryBaseInterval: 100 * time.Millisecond,
+					RetryBudget: &structs.RetryBudget{
+						BudgetPercent:       25,
+						MinRetryConcurrency: 5,
+					},
+				},
+			},
+			&structs.ProxyConfigEntry{
+				Kind:           structs.ProxyDefaults,
+				Name:           structs.ProxyConfigGlobal,
+				EnterpriseMeta: entMeta,
+				Protocol:       "http",
+				Config: map[string]interface{}{
+					"protocol": "http",
+				},
+			},
+			&structs.ServiceRouterConfigEntry{
+				Kind:           structs.ServiceRouter,
+				Name:           "db",
+				EnterpriseMeta: entMeta,
+				Routes: []structs.ServiceRoute{
+					{
+						Match: &structs.ServiceRouteMatch{
+							HTTP: &structs.ServiceRouteHTTPMatch{
+								PathPrefix: "/backoff",
+							},
+						},
+						Destination: &structs.ServiceRouteDestination{
+							NumRetries:        5,
+							RetryOn:           []string{"5xx"},
+							RetryBaseInterval: 250 * time.Millisecond,
+							RetryMaxInterval:  2 * time.Second,
+							PerTryTimeout:     3 * time.Second,
+							RetriableHeaders: []structs.ServiceRouteHTTPMatchHeader{
+								{Name: "x-retry", Exact: "true"},
+							},
+						},
+					},
+				},
+			},
+		)
+	case "fault-injection":
+		entries = append(entries,
+			&structs.ServiceResolverConfigEntry{
+				Kind:           structs.ServiceResolver,
+				Name:           "db",
+				EnterpriseMeta: entMeta,
+				ConnectTimeout: 33 * time.Second,
+				RequestTimeout: 33 * time.Second,
+			},
+			&structs.ProxyConfigEntry{
+				Kind:           structs.ProxyDefaults,
+				Name:           structs.ProxyConfigGlobal,
+				EnterpriseMeta: entMeta,
+				Protocol:       "http",
+				Config: map[string]interface{}{
+					"protocol": "http",
+				},
+			},
+			&structs.ServiceRouterConfigEntry{
+				Kind:           structs.ServiceRouter,
+				Name:           "db",
+				EnterpriseMeta: entMeta,
+				Routes: []structs.ServiceRoute{
+					{
+						Match: &structs.ServiceRouteMatch{
+							HTTP: &structs.ServiceRouteHTTPMatch{
+								PathPrefix: "/slow",
+							},
+						},
+						Destination: &structs.ServiceRouteDestination{
+							Service: "prefix",
+							FaultInjection: &structs.ServiceRouteFaultInjection{
+								Delay: &structs.FaultInjectionDelay{
+									FixedDelay: 2 * time.Second,
+									Percentage: 12.5,
+								},
+								Headers: []structs.ServiceRouteHTTPMatchHeader{
+									{Name: "x-fault", Present: true},
+								},
+							},
+						},
+					},
+					{
+						Match: &structs.ServiceRouteMatch{
+							HTTP: &structs.ServiceRouteHTTPMatch{
+								PathPrefix: "/broken",
+							},
+						},
+						Destination: &structs.ServiceRouteDestination{
+							Service: "prefix",
+							FaultInjection: &structs.ServiceRouteFaultInjection{
+								Abort: &structs.FaultInjectionAbort{
+									HTTPStatus: 503,
+									Percentage: 100,
+								},
+							},
+						},
+					},
+				},
+			},
+		)
+	case "mirror":
+		entries = append(entries,
+			&structs.ProxyConfigEntry{
+				Kind:           structs.ProxyDefaults,
+				Name:           structs.ProxyConfigGlobal,
+				EnterpriseMeta: entMeta,
+				Protocol:       "http",
+				Config: map[string]interface{}{
+					"protocol": "http",
+				},
+			},
+			&structs.ServiceRouterConfigEntry{
+				Kind:           structs.ServiceRouter,
+				Name:           "db",
+				EnterpriseMeta: entMeta,
+				Routes: []structs.ServiceRoute{
+					{
+						Match: &structs.ServiceRouteMatch{
+							HTTP: &structs.ServiceRouteHTTPMatch{
+								PathPrefix: "/mirrored",
+							},
+						},
+						Destination: &structs.ServiceRouteDestination{
+							Service: "prefix",
+							Mirror: &structs.MirrorPolicy{
+								Service:    "shadow",
+								Percentage: 50,
+							},
+						},
+					},
+				},
+			},
+			&structs.ServiceSplitterConfigEntry{
+				Kind:           structs.ServiceSplitter,
+				Name:           "db",
+				EnterpriseMeta: entMeta,
+				Splits: []structs.ServiceSplit{
+					{Weight: 90, Service: "big-side"},
+					{
+						Weight:  10,
+						Service: "lil-side",
+						Mirror: &structs.MirrorPolicy{
+							Service:    "shadow",
+							Percentage: 100,
+						},
+					},
+				},
+			},
+		)
 	case "chain-and-router":
 		entries = append(entries,
 			&structs.ServiceResolverConfigEntry{
@@ -97,6 +97,15 @@ func (s *handlerUpstreams) handleUpdateUpstreams(ctx context.Context, u UpdateEv
 			return fmt.Errorf("discovery-chain watch fired for unsupported kind: %s", snap.Kind)
 		}
 
+		// A default chain for an upstream with a destination peer means the
+		// peer exported nothing that changes routing or resolution, so the
+		// peered upstream watches are used instead.
+		if uid.Peer != "" && resp.Chain.Default {
+			s.clearWatchesFromChain(uid, upstreamsSnapshot)
+			reconcilePeeringWatches(upstreamsSnapshot.DiscoveryChain, upstreamsSnapshot.UpstreamConfig, upstreamsSnapshot.PeeredUpstreams, upstreamsSnapshot.PeerUpstreamEndpoints, upstreamsSnapshot.UpstreamPeerTrustBundles)
+			return nil
+		}
+
 		upstreamsSnapshot.DiscoveryChain[uid] = resp.Chain
 
 		if err := s.resetWatchesFromChain(ctx, uid, resp.Chain, upstreamsSnapshot); err != nil {
@@ -375,7 +384,9 @@ func (s *handlerUpstreams) resetWatchesFromChain(
 	//
 	// Outside of transparent mode we only watch the chain target, B,
 	// since A is a virtual service and traffic will not be sent to it.
-	if !watchedChainEndpoints && s.proxyCfg.Mode == structs.ProxyModeTransparent {
+	//
+	// Chains evaluated in a peer have no local service to associate.
+	if !watchedChainEndpoints && s.proxyCfg.Mode == structs.ProxyModeTransparent && uid.Peer == "" {
 		chainEntMeta := acl.NewEnterpriseMetaWithPartition(chain.Partition, chain.Namespace)
 
 		opts := targetWatchOpts{
@@ -445,6 +456,22 @@ func (s *handlerUpstreams) resetWatchesFromChain(
 	return nil
 }
 
+// clearWatchesFromChain stops the watches started for the discovery chain of
+// an upstream and forgets the chain.
+func (s *handlerUpstreams) clearWatchesFromChain(uid UpstreamID, snap *ConfigSnapshotUpstreams) {
+	for _, cancelFn := range snap.WatchedUpstreams[uid] {
+		cancelFn()
+	}
+	for _, cancelFn := range snap.WatchedGateways[uid] {
+		cancelFn()
+	}
+	delete(snap.DiscoveryChain, uid)
+	delete(snap.WatchedUpstreams, uid)
+	delete(snap.WatchedUpstreamEndpoints, uid)
+	delete(snap.WatchedGateways, uid)
+	delete(snap.WatchedGatewayEndpoints, uid)
+}
+
 type targetWatchOpts struct {
 	upstreamID UpstreamID
 	chainID    string
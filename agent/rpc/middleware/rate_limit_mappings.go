@@ -37,6 +37,7 @@ var rpcRateLimitSpecs = map[string]rate.OperationSpec{
 	"ACL.TokenBatchRead":    {Type: rate.OperationTypeRead, Category: rate.OperationCategoryACL},
 	"ACL.TokenClone":        {Type: rate.OperationTypeRead, Category: rate.OperationCategoryACL},
 	"ACL.TokenDelete":       {Type: rate.OperationTypeWrite, Category: rate.OperationCategoryACL},
+	"ACL.TokenExchange":     {Type: rate.OperationTypeWrite, Category: rate.OperationCategoryACL},
 	"ACL.TokenList":         {Type: rate.OperationTypeRead, Category: rate.OperationCategoryACL},
 	"ACL.TokenRead":         {Type: rate.OperationTypeRead, Category: rate.OperationCategoryACL},
 	"ACL.TokenSet":          {Type: rate.OperationTypeWrite, Category: rate.OperationCategoryACL},
@@ -64,8 +65,10 @@ var rpcRateLimitSpecs = map[string]rate.OperationSpec{
 	"ConfigEntry.ListAll":              {Type: rate.OperationTypeRead, Category: rate.OperationCategoryConfigEntry},
 	"ConfigEntry.ResolveServiceConfig": {Type: rate.OperationTypeRead, Category: rate.OperationCategoryConfigEntry},
 
+	"ConnectCA.Certs":            {Type: rate.OperationTypeRead, Category: rate.OperationCategoryConnectCA},
 	"ConnectCA.ConfigurationGet": {Type: rate.OperationTypeRead, Category: rate.OperationCategoryConnectCA},
 	"ConnectCA.ConfigurationSet": {Type: rate.OperationTypeWrite, Category: rate.OperationCategoryConnectCA},
+	"ConnectCA.Revoke":           {Type: rate.OperationTypeWrite, Category: rate.OperationCategoryConnectCA},
 	"ConnectCA.Roots":            {Type: rate.OperationTypeRead, Category: rate.OperationCategoryConnectCA},
 	"ConnectCA.Sign":             {Type: rate.OperationTypeWrite, Category: rate.OperationCategoryConnectCA},
 	"ConnectCA.SignIntermediate": {Type: rate.OperationTypeWrite, Category: rate.OperationCategoryConnectCA},
@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -191,6 +192,7 @@ type Store interface {
 	PeeringRead(ws memdb.WatchSet, q state.Query) (uint64, *pbpeering.Peering, error)
 	PeeringReadByID(ws memdb.WatchSet, id string) (uint64, *pbpeering.Peering, error)
 	PeeringList(ws memdb.WatchSet, entMeta acl.EnterpriseMeta) (uint64, []*pbpeering.Peering, error)
+	PeeringSecretsRead(ws memdb.WatchSet, peerID string) (*pbpeering.PeeringSecrets, error)
 	PeeringTrustBundleRead(ws memdb.WatchSet, q state.Query) (uint64, *pbpeering.PeeringTrustBundle, error)
 	PeeringTrustBundleList(ws memdb.WatchSet, entMeta acl.EnterpriseMeta) (uint64, []*pbpeering.PeeringTrustBundle, error)
 	TrustBundleListByService(ws memdb.WatchSet, service, dc string, entMeta acl.EnterpriseMeta) (uint64, []*pbpeering.PeeringTrustBundle, error)
@@ -662,6 +664,11 @@ func (s *Server) PeeringRead(ctx context.Context, req *pbpeering.PeeringReadRequ
 		}
 
 		res.Peering = s.reconcilePeering(peering)
+		if req.Detail {
+			if status, found := s.Tracker.StreamStatus(peering.ID); found {
+				res.Peering.StreamStatus.Replication = replicationStatusToProto(status)
+			}
+		}
 		return nil
 	})
 	if err != nil {
@@ -808,6 +815,62 @@ func (s *Server) reconcilePeering(peering *pbpeering.Peering) *pbpeering.Peering
 	}
 }
 
+// replicationStatusToProto converts the replication details tracked for a
+// peering stream, sorting them by resource URL and service name.
+func replicationStatusToProto(status peerstream.Status) *pbpeering.ReplicationStatus {
+	out := &pbpeering.ReplicationStatus{}
+	for url, r := range status.ExportedResources {
+		out.Exported = append(out.Exported, &pbpeering.ExportedResourceStatus{
+			ResourceURL:     url,
+			LastSentNonce:   r.LastSentNonce,
+			LastAckedNonce:  r.LastAckedNonce,
+			PendingUpdates:  r.PendingUpdates,
+			LastAck:         pbpeering.TimePtrToProto(r.LastAck),
+			LastNack:        pbpeering.TimePtrToProto(r.LastNack),
+			LastNackMessage: r.LastNackMessage,
+		})
+	}
+	sort.Slice(out.Exported, func(i, j int) bool {
+		return out.Exported[i].ResourceURL < out.Exported[j].ResourceURL
+	})
+
+	for url, r := range status.ImportedResources {
+		out.Imported = append(out.Imported, &pbpeering.ImportedResourceStatus{
+			ResourceURL:           url,
+			LastNonce:             r.LastNonce,
+			LastApply:             pbpeering.TimePtrToProto(r.LastApply),
+			LastApplyError:        pbpeering.TimePtrToProto(r.LastApplyError),
+			LastApplyErrorMessage: r.LastApplyErrorMessage,
+		})
+	}
+	sort.Slice(out.Imported, func(i, j int) bool {
+		return out.Imported[i].ResourceURL < out.Imported[j].ResourceURL
+	})
+
+	for name, svc := range status.ImportedServiceStatuses {
+		out.ImportedServices = append(out.ImportedServices, &pbpeering.ImportedServiceStatus{
+			Name:                  name,
+			LastApply:             pbpeering.TimePtrToProto(svc.LastApply),
+			LastApplyError:        pbpeering.TimePtrToProto(svc.LastApplyError),
+			LastApplyErrorMessage: svc.LastApplyErrorMessage,
+		})
+	}
+	sort.Slice(out.ImportedServices, func(i, j int) bool {
+		return out.ImportedServices[i].Name < out.ImportedServices[j].Name
+	})
+
+	for _, e := range status.ErrorHistory {
+		out.Errors = append(out.Errors, &pbpeering.ReplicationError{
+			Time:        structs.TimeToProto(e.Time),
+			Exported:    e.Exported,
+			ResourceURL: e.ResourceURL,
+			ResourceID:  e.ResourceID,
+			Message:     e.Message,
+		})
+	}
+	return out
+}
+
 // TODO(peering): As of writing, this method is only used in tests to set up Peerings in the state store.
 // Consider removing if we can find another way to populate state store in peering_endpoint_test.go
 func (s *Server) PeeringWrite(ctx context.Context, req *pbpeering.PeeringWriteRequest) (*pbpeering.PeeringWriteResponse, error) {
@@ -953,6 +1016,106 @@ func (s *Server) PeeringDelete(ctx context.Context, req *pbpeering.PeeringDelete
 	return &pbpeering.PeeringDeleteResponse{}, nil
 }
 
+// RotateSecret implements the PeeringService RPC method to rotate the stream
+// secret of an established peering without re-establishing it. A new pending
+// stream secret is generated and replicated to the dialing peer, which uses it
+// the next time it opens a stream. The active secret is retired once the
+// dialing peer has connected with the pending one.
+func (s *Server) RotateSecret(ctx context.Context, req *pbpeering.RotateSecretRequest) (*pbpeering.RotateSecretResponse, error) {
+	if !s.Config.PeeringEnabled {
+		return nil, peeringNotEnabledErr
+	}
+
+	if err := s.Backend.EnterpriseCheckPartitions(req.Partition); err != nil {
+		return nil, grpcstatus.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.PeerName == "" {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "missing required peer name")
+	}
+
+	var resp *pbpeering.RotateSecretResponse
+	handled, err := s.ForwardRPC(&writeRequest, func(conn *grpc.ClientConn) error {
+		ctx := external.ForwardMetadataContext(ctx)
+		var err error
+		resp, err = pbpeering.NewPeeringServiceClient(conn).RotateSecret(ctx, req)
+		return err
+	})
+	if handled || err != nil {
+		return resp, err
+	}
+
+	defer metrics.MeasureSince([]string{"peering", "rotate_secret"}, time.Now())
+
+	var authzCtx acl.AuthorizerContext
+	entMeta := structs.DefaultEnterpriseMetaInPartition(req.Partition)
+	options, err := external.QueryOptionsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	authz, err := s.Backend.ResolveTokenAndDefaultMeta(options.Token, entMeta, &authzCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authz.ToAllowAuthorizer().PeeringWriteAllowed(&authzCtx); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.getExistingPeering(req.PeerName, entMeta.PartitionOrDefault())
+	if err != nil {
+		return nil, err
+	}
+	if !existing.IsActive() {
+		return nil, grpcstatus.Errorf(codes.NotFound, "no active peering found with name %q", req.PeerName)
+	}
+
+	// Only the acceptor issues stream secrets, so rotation must be initiated
+	// from the cluster that generated the peering token.
+	if existing.ShouldDial() {
+		return nil, grpcstatus.Errorf(codes.FailedPrecondition,
+			"cannot rotate secrets for peering %q from the dialing cluster; rotation must be initiated by the cluster that generated the peering token", req.PeerName)
+	}
+
+	secrets, err := s.Backend.Store().PeeringSecretsRead(nil, existing.ID)
+	if err != nil {
+		return nil, err
+	}
+	if secrets.GetStream().GetActiveSecretID() == "" {
+		return nil, grpcstatus.Errorf(codes.FailedPrecondition, "peering %q has not been established yet", req.PeerName)
+	}
+
+	// A dialer that does not support stream secret rotation would never receive
+	// the pending secret, so refuse to rotate rather than wait on it forever.
+	if status, ok := s.Tracker.StreamStatus(existing.ID); ok && status.Connected &&
+		!status.SupportsRemoteResource(pbpeerstream.TypeURLStreamSecret) {
+		return nil, grpcstatus.Errorf(codes.FailedPrecondition,
+			"peer cluster for peering %q does not support stream secret rotation; upgrade it to a version that does", req.PeerName)
+	}
+
+	secretID, err := s.generateNewEstablishmentSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	writeReq := &pbpeering.PeeringWriteRequest{
+		Peering: existing,
+		SecretsRequest: &pbpeering.SecretsWriteRequest{
+			PeerID: existing.ID,
+			Request: &pbpeering.SecretsWriteRequest_RotateStream{
+				RotateStream: &pbpeering.SecretsWriteRequest_RotateStreamRequest{
+					PendingStreamSecret: secretID,
+				},
+			},
+		},
+	}
+	if err := s.Backend.PeeringWrite(writeReq); err != nil {
+		return nil, fmt.Errorf("failed to write peering secrets: %w", err)
+	}
+	return &pbpeering.RotateSecretResponse{}, nil
+}
+
 func (s *Server) TrustBundleRead(ctx context.Context, req *pbpeering.TrustBundleReadRequest) (*pbpeering.TrustBundleReadResponse, error) {
 	if !s.Config.PeeringEnabled {
 		return nil, peeringNotEnabledErr
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package peering
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/hashicorp/consul/agent/grpc-external/services/peerstream"
+	"github.com/hashicorp/consul/proto/private/pbpeering"
+	"github.com/hashicorp/consul/proto/private/pbpeerstream"
+	"github.com/hashicorp/consul/proto/private/prototest"
+)
+
+func TestReplicationStatusToProto(t *testing.T) {
+	ack := time.Date(2000, time.January, 1, 0, 0, 1, 0, time.UTC)
+	nack := time.Date(2000, time.January, 1, 0, 0, 2, 0, time.UTC)
+	apply := time.Date(2000, time.January, 1, 0, 0, 3, 0, time.UTC)
+
+	status := peerstream.Status{
+		ExportedResources: map[string]peerstream.ExportedResourceStatus{
+			pbpeerstream.TypeURLPeeringTrustBundle: {
+				LastSentNonce:  "2",
+				LastAckedNonce: "2",
+				LastAck:        &ack,
+			},
+			pbpeerstream.TypeURLExportedService: {
+				LastSentNonce:   "4",
+				LastAckedNonce:  "1",
+				PendingUpdates:  1,
+				LastAck:         &ack,
+				LastNack:        &nack,
+				LastNackMessage: "bad",
+			},
+		},
+		ImportedResources: map[string]peerstream.ImportedResourceStatus{
+			pbpeerstream.TypeURLExportedService: {
+				LastNonce: "7",
+				LastApply: &apply,
+			},
+		},
+		ImportedServiceStatuses: map[string]peerstream.ImportedServiceStatus{
+			"web": {LastApply: &apply},
+			"api": {LastApply: &apply},
+		},
+		ErrorHistory: []peerstream.ReplicationError{
+			{
+				Time:        nack,
+				Exported:    true,
+				ResourceURL: pbpeerstream.TypeURLExportedService,
+				ResourceID:  "db",
+				Message:     "bad",
+			},
+		},
+	}
+
+	expect := &pbpeering.ReplicationStatus{
+		Exported: []*pbpeering.ExportedResourceStatus{
+			{
+				ResourceURL:    pbpeerstream.TypeURLPeeringTrustBundle,
+				LastSentNonce:  "2",
+				LastAckedNonce: "2",
+				LastAck:        timestamppb.New(ack),
+			},
+			{
+				ResourceURL:     pbpeerstream.TypeURLExportedService,
+				LastSentNonce:   "4",
+				LastAckedNonce:  "1",
+				PendingUpdates:  1,
+				LastAck:         timestamppb.New(ack),
+				LastNack:        timestamppb.New(nack),
+				LastNackMessage: "bad",
+			},
+		},
+		Imported: []*pbpeering.ImportedResourceStatus{
+			{
+				ResourceURL: pbpeerstream.TypeURLExportedService,
+				LastNonce:   "7",
+				LastApply:   timestamppb.New(apply),
+			},
+		},
+		ImportedServices: []*pbpeering.ImportedServiceStatus{
+			{Name: "api", LastApply: timestamppb.New(apply)},
+			{Name: "web", LastApply: timestamppb.New(apply)},
+		},
+		Errors: []*pbpeering.ReplicationError{
+			{
+				Time:        timestamppb.New(nack),
+				Exported:    true,
+				ResourceURL: pbpeerstream.TypeURLExportedService,
+				ResourceID:  "db",
+				Message:     "bad",
+			},
+		},
+	}
+	prototest.AssertDeepEqual(t, expect, replicationStatusToProto(status))
+}
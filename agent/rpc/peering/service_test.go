@@ -1059,6 +1059,129 @@ func TestPeeringService_Delete_ACLEnforcement(t *testing.T) {
 
 }
 
+func TestPeeringService_RotateSecret(t *testing.T) {
+	// TODO(peering): see note on newTestServer, refactor to not use this
+	s := newTestServer(t, nil)
+	store := s.Server.FSM().State()
+
+	var (
+		acceptorID    = testUUID(t)
+		establishment = testUUID(t)
+		active        = testUUID(t)
+	)
+
+	// Write an acceptor peering that was established through an exchanged secret.
+	require.NoError(t, store.PeeringWrite(10, &pbpeering.PeeringWriteRequest{
+		Peering: &pbpeering.Peering{
+			ID:   acceptorID,
+			Name: "acceptor",
+		},
+		SecretsRequest: &pbpeering.SecretsWriteRequest{
+			PeerID: acceptorID,
+			Request: &pbpeering.SecretsWriteRequest_GenerateToken{
+				GenerateToken: &pbpeering.SecretsWriteRequest_GenerateTokenRequest{
+					EstablishmentSecret: establishment,
+				},
+			},
+		},
+	}))
+	require.NoError(t, store.PeeringSecretsWrite(11, &pbpeering.SecretsWriteRequest{
+		PeerID: acceptorID,
+		Request: &pbpeering.SecretsWriteRequest_ExchangeSecret{
+			ExchangeSecret: &pbpeering.SecretsWriteRequest_ExchangeSecretRequest{
+				EstablishmentSecret: establishment,
+				PendingStreamSecret: active,
+			},
+		},
+	}))
+	require.NoError(t, store.PeeringSecretsWrite(12, &pbpeering.SecretsWriteRequest{
+		PeerID: acceptorID,
+		Request: &pbpeering.SecretsWriteRequest_PromotePending{
+			PromotePending: &pbpeering.SecretsWriteRequest_PromotePendingRequest{
+				ActiveStreamSecret: active,
+			},
+		},
+	}))
+
+	// Write an acceptor peering that has not been established yet.
+	pendingID := testUUID(t)
+	require.NoError(t, store.PeeringWrite(13, &pbpeering.PeeringWriteRequest{
+		Peering: &pbpeering.Peering{
+			ID:   pendingID,
+			Name: "pending",
+		},
+		SecretsRequest: &pbpeering.SecretsWriteRequest{
+			PeerID: pendingID,
+			Request: &pbpeering.SecretsWriteRequest_GenerateToken{
+				GenerateToken: &pbpeering.SecretsWriteRequest_GenerateTokenRequest{
+					EstablishmentSecret: testUUID(t),
+				},
+			},
+		},
+	}))
+
+	// Write a dialer peering.
+	require.NoError(t, store.PeeringWrite(14, &pbpeering.PeeringWriteRequest{
+		Peering: &pbpeering.Peering{
+			ID:                  testUUID(t),
+			Name:                "dialer",
+			PeerID:              testUUID(t),
+			PeerServerAddresses: []string{"127.0.0.1:5300"},
+		},
+	}))
+
+	client := pbpeering.NewPeeringServiceClient(s.ClientConn(t))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	type testcase struct {
+		name      string
+		peerName  string
+		expectErr string
+	}
+	tcs := []testcase{
+		{
+			name:      "missing peer name",
+			expectErr: "missing required peer name",
+		},
+		{
+			name:      "unknown peering",
+			peerName:  "unknown",
+			expectErr: `no active peering found with name "unknown"`,
+		},
+		{
+			name:      "dialing peering",
+			peerName:  "dialer",
+			expectErr: "rotation must be initiated by the cluster that generated the peering token",
+		},
+		{
+			name:      "peering not established",
+			peerName:  "pending",
+			expectErr: `peering "pending" has not been established yet`,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := client.RotateSecret(ctx, &pbpeering.RotateSecretRequest{PeerName: tc.peerName})
+			testutil.RequireErrorContains(t, err, tc.expectErr)
+		})
+	}
+
+	t.Run("rotate secret", func(t *testing.T) {
+		_, err := client.RotateSecret(ctx, &pbpeering.RotateSecretRequest{PeerName: "acceptor"})
+		require.NoError(t, err)
+
+		secrets, err := store.PeeringSecretsRead(nil, acceptorID)
+		require.NoError(t, err)
+
+		// The active secret remains valid until the dialer connects with the pending one.
+		require.Equal(t, active, secrets.GetStream().GetActiveSecretID())
+		require.NotEmpty(t, secrets.GetStream().GetPendingSecretID())
+		require.NotEqual(t, active, secrets.GetStream().GetPendingSecretID())
+	})
+}
+
 func TestPeeringService_List(t *testing.T) {
 	// TODO(peering): see note on newTestServer, refactor to not use this
 	s := newTestServer(t, nil)
@@ -29,6 +29,7 @@ import (
 	"github.com/hashicorp/consul/agent/consul/usagemetrics"
 	"github.com/hashicorp/consul/agent/consul/xdscapacity"
 	"github.com/hashicorp/consul/agent/grpc-external/limiter"
+	"github.com/hashicorp/consul/agent/grpc-external/services/peerstream"
 	grpcInt "github.com/hashicorp/consul/agent/grpc-internal"
 	"github.com/hashicorp/consul/agent/grpc-internal/balancer"
 	"github.com/hashicorp/consul/agent/grpc-internal/resolver"
@@ -178,10 +179,12 @@ func NewBaseDeps(configLoader ConfigLoader, logOut io.Writer, providedLogger hcl
 
 	// TODO: create leafCertManager in BaseDeps once NetRPC is available without Agent
 	d.LeafCertManager = leafcert.NewManager(leafcert.Deps{
-		Logger:      d.Logger.Named("leaf-certs"),
-		CertSigner:  leafcert.NewNetRPCCertSigner(d.NetRPC),
-		RootsReader: leafcert.NewCachedRootsReader(d.Cache, cfg.Datacenter),
+		Logger:           d.Logger.Named("leaf-certs"),
+		CertSigner:       leafcert.NewNetRPCCertSigner(d.NetRPC),
+		RootsReader:      leafcert.NewCachedRootsReader(d.Cache, cfg.Datacenter),
+		LeafConfigReader: leafcert.NewCachedLeafConfigReader(d.Cache),
 		Config: leafcert.Config{
+			NodeName:                         cfg.NodeName,
 			TestOverrideCAChangeInitialDelay: cfg.ConnectTestCALeafRootChangeSpread,
 		},
 	})
@@ -369,6 +372,7 @@ func getPrometheusDefs(cfg *config.RuntimeConfig, isServer bool) ([]prometheus.G
 			consul.AutopilotGauges,
 			consul.LeaderCertExpirationGauges,
 			consul.LeaderPeeringMetrics,
+			peerstream.ReplicationGauges,
 			xdscapacity.StatsGauges,
 		)
 	}
@@ -443,6 +447,10 @@ func getPrometheusDefs(cfg *config.RuntimeConfig, isServer bool) ([]prometheus.G
 		rate.Counters,
 	}
 
+	if isServer {
+		counters = append(counters, peerstream.ReplicationCounters)
+	}
+
 	// For some unknown reason, we seem to add the raft counters above without
 	// checking if this is a server like we do above for some of the summaries
 	// above. We should probably fix that but I want to not change behavior right
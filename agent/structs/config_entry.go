@@ -41,8 +41,11 @@ const (
 	BoundAPIGateway       string = "bound-api-gateway"
 	FileSystemCertificate string = "file-system-certificate"
 	InlineCertificate     string = "inline-certificate"
+	ACMECertificate       string = "acme-certificate"
 	HTTPRoute             string = "http-route"
 	TCPRoute              string = "tcp-route"
+	GRPCRoute             string = "grpc-route"
+	TLSRoute              string = "tls-route"
 	// TODO: decide if we want to highlight 'ip' keyword in the name of RateLimitIPConfig
 	RateLimitIPConfig string = "control-plane-request-limit"
 	JWTProvider       string = "jwt-provider"
@@ -71,8 +74,11 @@ var AllConfigEntryKinds = []string{
 	BoundAPIGateway,
 	HTTPRoute,
 	TCPRoute,
+	GRPCRoute,
+	TLSRoute,
 	FileSystemCertificate,
 	InlineCertificate,
+	ACMECertificate,
 	RateLimitIPConfig,
 	JWTProvider,
 }
@@ -117,6 +123,17 @@ type ControlledConfigEntry interface {
 	ConfigEntry
 }
 
+// ControllerStateConfigEntry is an optional interface implemented by a
+// ControlledConfigEntry that stores controller-owned fields alongside its
+// Status. Like the Status, those fields are only written by the controller
+// and are carried over from the existing entry when a user updates it.
+type ControllerStateConfigEntry interface {
+	// InheritControllerState replaces the controller-owned fields with the
+	// ones from prev, or clears them if prev is nil.
+	InheritControllerState(prev ConfigEntry)
+	ControlledConfigEntry
+}
+
 // UpdatableConfigEntry is the optional interface implemented by a ConfigEntry
 // if it wants more control over how the update part of upsert works
 // differently than a straight create. By default without this implementation
@@ -178,6 +195,7 @@ type ServiceConfigEntry struct {
 	BalanceInboundConnections string                 `json:",omitempty" alias:"balance_inbound_connections"`
 	RateLimits                *RateLimits            `json:",omitempty" alias:"rate_limits"`
 	EnvoyExtensions           EnvoyExtensions        `json:",omitempty" alias:"envoy_extensions"`
+	LeafCert                  *LeafCertConfig        `json:",omitempty" alias:"leaf_cert"`
 
 	Meta               map[string]string `json:",omitempty"`
 	Hash               uint64            `json:",omitempty" hash:"ignore"`
@@ -269,7 +287,7 @@ func (e *ServiceConfigEntry) Validate() error {
 	}
 
 	switch e.Protocol {
-	case "", "http", "http2", "grpc", "tcp":
+	case "", "http", "http2", "grpc", "tcp", "redis", "postgres", "kafka":
 	default:
 		validationErr = multierror.Append(validationErr, fmt.Errorf("invalid value for protocol: %v", e.Protocol))
 	}
@@ -322,6 +340,10 @@ func (e *ServiceConfigEntry) Validate() error {
 		validationErr = multierror.Append(validationErr, err)
 	}
 
+	if err := e.LeafCert.Validate(); err != nil {
+		validationErr = multierror.Append(validationErr, fmt.Errorf("error in leaf cert config: %w", err))
+	}
+
 	if err := envoyextensions.ValidateExtensions(e.EnvoyExtensions.ToAPI()); err != nil {
 		validationErr = multierror.Append(validationErr, err)
 	}
@@ -423,6 +445,94 @@ func IsIP(address string) bool {
 	return ip != nil
 }
 
+// LeafCertConfig overrides the leaf certs issued to instances of a service.
+// Unset fields fall back to the defaults of the CA.
+type LeafCertConfig struct {
+	// PrivateKeyType is the type of key generated for the leaf cert, one of
+	// "ec", "rsa" or "ed25519". Ed25519 requires a CA provider that supports
+	// it, and like 224 bit EC keys is only used by native integrations since
+	// Envoy can't load it.
+	PrivateKeyType string `json:",omitempty" alias:"private_key_type"`
+
+	// PrivateKeyBits is the size of the key generated for the leaf cert. It
+	// defaults to 256 for EC keys and 4096 for RSA keys, and must not be set
+	// for Ed25519 keys.
+	PrivateKeyBits int `json:",omitempty" alias:"private_key_bits"`
+
+	// TTL is how long the leaf cert is valid for, instead of the LeafCertTTL
+	// of the CA configuration.
+	TTL time.Duration `json:",omitempty"`
+}
+
+// KeyTypeAndBits returns the key type and size to generate for the leaf
+// cert, defaulting to a P-256 EC key.
+func (c *LeafCertConfig) KeyTypeAndBits() (string, int) {
+	if c == nil || c.PrivateKeyType == "" {
+		return "ec", 256
+	}
+	switch {
+	case c.PrivateKeyBits != 0:
+		return c.PrivateKeyType, c.PrivateKeyBits
+	case c.PrivateKeyType == "rsa":
+		return c.PrivateKeyType, 4096
+	case c.PrivateKeyType == "ec":
+		return c.PrivateKeyType, 256
+	default:
+		return c.PrivateKeyType, 0
+	}
+}
+
+// EnvoyCompatible returns whether Envoy can load a leaf cert with the key type
+// and size of the config. Envoy only supports RSA keys and EC keys on the
+// P-256, P-384 and P-521 curves.
+func (c *LeafCertConfig) EnvoyCompatible() bool {
+	keyType, keyBits := c.KeyTypeAndBits()
+	switch keyType {
+	case "rsa":
+		return true
+	case "ec":
+		return keyBits != 224
+	default:
+		return false
+	}
+}
+
+func (c *LeafCertConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	var validationErr error
+	switch c.PrivateKeyType {
+	case "":
+		if c.PrivateKeyBits != 0 {
+			validationErr = multierror.Append(validationErr, fmt.Errorf("PrivateKeyBits requires PrivateKeyType to be set"))
+		}
+	case "ec":
+		switch c.PrivateKeyBits {
+		case 0, 224, 256, 384, 521:
+		default:
+			validationErr = multierror.Append(validationErr, fmt.Errorf("EC key length must be one of (224, 256, 384, 521) bits"))
+		}
+	case "rsa":
+		if c.PrivateKeyBits != 0 && c.PrivateKeyBits < 2048 {
+			validationErr = multierror.Append(validationErr, fmt.Errorf("RSA key length must be at least 2048 bits"))
+		}
+	case "ed25519":
+		if c.PrivateKeyBits != 0 {
+			validationErr = multierror.Append(validationErr, fmt.Errorf("PrivateKeyBits must not be set for Ed25519 keys"))
+		}
+	default:
+		validationErr = multierror.Append(validationErr, fmt.Errorf("invalid PrivateKeyType %q, must be one of (ec, rsa, ed25519)", c.PrivateKeyType))
+	}
+
+	if c.TTL != 0 && (c.TTL < MinLeafCertTTL || c.TTL > MaxLeafCertTTL) {
+		validationErr = multierror.Append(validationErr, fmt.Errorf("TTL must be between %s and %s", MinLeafCertTTL, MaxLeafCertTTL))
+	}
+
+	return validationErr
+}
+
 // RateLimits is rate limiting configuration that is applied to
 // inbound traffic for a service.
 // Rate limiting is a Consul enterprise feature.
@@ -843,10 +953,16 @@ func MakeConfigEntry(kind, name string) (ConfigEntry, error) {
 		return &FileSystemCertificateConfigEntry{Name: name}, nil
 	case InlineCertificate:
 		return &InlineCertificateConfigEntry{Name: name}, nil
+	case ACMECertificate:
+		return &ACMECertificateConfigEntry{Name: name}, nil
 	case HTTPRoute:
 		return &HTTPRouteConfigEntry{Name: name}, nil
 	case TCPRoute:
 		return &TCPRouteConfigEntry{Name: name}, nil
+	case GRPCRoute:
+		return &GRPCRouteConfigEntry{Name: name}, nil
+	case TLSRoute:
+		return &TLSRouteConfigEntry{Name: name}, nil
 	case JWTProvider:
 		return &JWTProviderConfigEntry{Name: name}, nil
 	default:
@@ -1001,9 +1117,9 @@ type UpstreamConfig struct {
 	EnvoyClusterJSON string `json:",omitempty" alias:"envoy_cluster_json"`
 
 	// Protocol describes the upstream's service protocol. Valid values are "tcp",
-	// "http" and "grpc". Anything else is treated as tcp. The enables protocol
-	// aware features like per-request metrics and connection pooling, tracing,
-	// routing etc.
+	// "http", "grpc", "redis", "postgres" and "kafka". Anything else is treated
+	// as tcp. The enables protocol aware features like per-request metrics and
+	// connection pooling, tracing, routing etc.
 	Protocol string `json:",omitempty"`
 
 	// ConnectTimeoutMs is the number of milliseconds to timeout making a new
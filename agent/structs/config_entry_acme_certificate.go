@@ -0,0 +1,261 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package structs
+
+import (
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/lib"
+)
+
+// DefaultACMERenewBefore is how long before expiry an ACME certificate is
+// renewed when RenewBefore is not set.
+const DefaultACMERenewBefore = 30 * 24 * time.Hour
+
+// ACMECertificateConfigEntry manages a certificate for the given hostnames that
+// is issued and renewed by an ACME certificate authority. Challenges are
+// answered over HTTP-01 by the api-gateways that reference the certificate.
+type ACMECertificateConfigEntry struct {
+	// Kind of config entry. This will be set to structs.ACMECertificate.
+	Kind string
+
+	// Name is used to match the config entry with its associated certificate.
+	Name string
+
+	// DirectoryURL is the URL of the ACME server's directory resource.
+	DirectoryURL string `alias:"directory_url"`
+
+	// DirectoryCACertificate is an optional PEM encoded bundle used to verify
+	// the ACME server instead of the system roots.
+	DirectoryCACertificate string `json:",omitempty" alias:"directory_ca_certificate"`
+
+	// Email is the contact address registered with the ACME account.
+	Email string `json:",omitempty"`
+
+	// Hostnames are the DNS names the certificate is issued for. Each must be
+	// routed to a gateway HTTP listener on port 80 so that HTTP-01 challenges
+	// can be answered.
+	Hostnames []string
+
+	// RenewBefore is how long before expiry the certificate is renewed.
+	// Defaults to DefaultACMERenewBefore.
+	RenewBefore time.Duration `json:",omitempty" alias:"renew_before"`
+
+	// Certificate is the issued certificate chain encoded in raw PEM format.
+	// It is managed by the ACME controller.
+	Certificate string `json:",omitempty"`
+
+	// PrivateKey is the private key for Certificate encoded in raw PEM format.
+	// It is managed by the ACME controller.
+	PrivateKey string `json:",omitempty" alias:"private_key"`
+
+	// AccountKey is the private key of the ACME account encoded in raw PEM
+	// format. It is managed by the ACME controller.
+	AccountKey string `json:",omitempty" alias:"account_key"`
+
+	// Challenges are the HTTP-01 challenges currently being answered by the
+	// gateways referencing this certificate. They are managed by the ACME
+	// controller.
+	Challenges []ACMEChallenge `json:",omitempty"`
+
+	Meta               map[string]string `json:",omitempty"`
+	Status             Status
+	Hash               uint64 `json:",omitempty" hash:"ignore"`
+	acl.EnterpriseMeta `hcl:",squash" mapstructure:",squash"`
+	RaftIndex          `hash:"ignore"`
+}
+
+// ACMEChallenge is a pending HTTP-01 challenge for one of the hostnames of an
+// ACMECertificateConfigEntry.
+type ACMEChallenge struct {
+	// Hostname is the DNS name being validated.
+	Hostname string
+
+	// Token is the challenge token, served under /.well-known/acme-challenge/.
+	Token string
+
+	// KeyAuthorization is the response body expected by the ACME server.
+	KeyAuthorization string `alias:"key_authorization"`
+}
+
+func (e *ACMECertificateConfigEntry) SetHash(h uint64) {
+	e.Hash = h
+}
+
+func (e *ACMECertificateConfigEntry) GetHash() uint64 {
+	return e.Hash
+}
+
+func (e *ACMECertificateConfigEntry) GetKind() string { return ACMECertificate }
+func (e *ACMECertificateConfigEntry) GetName() string { return e.Name }
+func (e *ACMECertificateConfigEntry) Normalize() error {
+	for i, host := range e.Hostnames {
+		e.Hostnames[i] = strings.ToLower(host)
+	}
+
+	h, err := HashConfigEntry(e)
+	if err != nil {
+		return err
+	}
+	e.Hash = h
+	return nil
+}
+func (e *ACMECertificateConfigEntry) GetMeta() map[string]string { return e.Meta }
+func (e *ACMECertificateConfigEntry) GetEnterpriseMeta() *acl.EnterpriseMeta {
+	return &e.EnterpriseMeta
+}
+func (e *ACMECertificateConfigEntry) GetRaftIndex() *RaftIndex { return &e.RaftIndex }
+
+func (e *ACMECertificateConfigEntry) Validate() error {
+	if err := validateConfigEntryMeta(e.Meta); err != nil {
+		return err
+	}
+
+	if e.DirectoryURL == "" {
+		return errors.New("DirectoryURL is required")
+	}
+	u, err := url.Parse(e.DirectoryURL)
+	if err != nil {
+		return fmt.Errorf("invalid DirectoryURL: %w", err)
+	}
+	if u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("DirectoryURL %q must be an absolute https URL", e.DirectoryURL)
+	}
+
+	if e.DirectoryCACertificate != "" {
+		if ok := x509.NewCertPool().AppendCertsFromPEM([]byte(e.DirectoryCACertificate)); !ok {
+			return errors.New("failed to parse DirectoryCACertificate PEM")
+		}
+	}
+
+	if len(e.Hostnames) == 0 {
+		return errors.New("at least one hostname is required")
+	}
+	seen := make(map[string]struct{}, len(e.Hostnames))
+	for _, host := range e.Hostnames {
+		if strings.Contains(host, "*") {
+			return fmt.Errorf("hostname %q cannot be a wildcard, HTTP-01 challenges do not support them", host)
+		}
+		if _, ok := dns.IsDomainName(host); !ok {
+			return fmt.Errorf("hostname %q must be a valid DNS hostname", host)
+		}
+		if _, ok := seen[host]; ok {
+			return fmt.Errorf("hostname %q is listed more than once", host)
+		}
+		seen[host] = struct{}{}
+	}
+
+	if e.RenewBefore < 0 {
+		return errors.New("RenewBefore cannot be negative")
+	}
+
+	return nil
+}
+
+func (e *ACMECertificateConfigEntry) CanRead(authz acl.Authorizer) error {
+	var authzContext acl.AuthorizerContext
+	e.FillAuthzContext(&authzContext)
+	return authz.ToAllowAuthorizer().MeshReadAllowed(&authzContext)
+}
+
+func (e *ACMECertificateConfigEntry) CanWrite(authz acl.Authorizer) error {
+	var authzContext acl.AuthorizerContext
+	e.FillAuthzContext(&authzContext)
+	return authz.ToAllowAuthorizer().MeshWriteAllowed(&authzContext)
+}
+
+func (e *ACMECertificateConfigEntry) DefaultStatus() Status { return Status{} }
+func (e *ACMECertificateConfigEntry) GetStatus() Status     { return e.Status }
+func (e *ACMECertificateConfigEntry) SetStatus(status Status) {
+	e.Status = status
+}
+
+func (e *ACMECertificateConfigEntry) InheritControllerState(prev ConfigEntry) {
+	existing, ok := prev.(*ACMECertificateConfigEntry)
+	if !ok || existing == nil {
+		existing = &ACMECertificateConfigEntry{}
+	}
+	e.Certificate = existing.Certificate
+	e.PrivateKey = existing.PrivateKey
+	e.AccountKey = existing.AccountKey
+	e.Challenges = existing.Challenges
+}
+
+// RenewBeforeOrDefault returns RenewBefore, falling back to
+// DefaultACMERenewBefore if it is unset.
+func (e *ACMECertificateConfigEntry) RenewBeforeOrDefault() time.Duration {
+	if e.RenewBefore > 0 {
+		return e.RenewBefore
+	}
+	return DefaultACMERenewBefore
+}
+
+// IsIssued returns whether a certificate has been issued for the entry.
+func (e *ACMECertificateConfigEntry) IsIssued() bool {
+	return e.Certificate != "" && e.PrivateKey != ""
+}
+
+// ToInlineCertificate returns the issued certificate as an inline-certificate
+// so that it can be bound to listeners in the same way. It returns nil if no
+// certificate has been issued yet.
+func (e *ACMECertificateConfigEntry) ToInlineCertificate() *InlineCertificateConfigEntry {
+	if !e.IsIssued() {
+		return nil
+	}
+	return &InlineCertificateConfigEntry{
+		Kind:           InlineCertificate,
+		Name:           e.Name,
+		Certificate:    e.Certificate,
+		PrivateKey:     e.PrivateKey,
+		Meta:           e.Meta,
+		Hash:           e.Hash,
+		EnterpriseMeta: e.EnterpriseMeta,
+		RaftIndex:      e.RaftIndex,
+	}
+}
+
+func (e *ACMECertificateConfigEntry) MarshalJSON() ([]byte, error) {
+	type Alias ACMECertificateConfigEntry
+	exported := &struct {
+		RenewBefore string `json:",omitempty"`
+		*Alias
+	}{
+		RenewBefore: e.RenewBefore.String(),
+		Alias:       (*Alias)(e),
+	}
+	if e.RenewBefore == 0 {
+		exported.RenewBefore = ""
+	}
+
+	return json.Marshal(exported)
+}
+
+func (e *ACMECertificateConfigEntry) UnmarshalJSON(data []byte) error {
+	type Alias ACMECertificateConfigEntry
+	aux := &struct {
+		RenewBefore string
+		*Alias
+	}{
+		Alias: (*Alias)(e),
+	}
+	var err error
+	if err = lib.UnmarshalJSON(data, &aux); err != nil {
+		return err
+	}
+	if aux.RenewBefore != "" {
+		if e.RenewBefore, err = time.ParseDuration(aux.RenewBefore); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package structs
+
+import (
+	"testing"
+	"time"
+)
+
+func TestACMECertificate(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]configEntryTestcase{
+		"missing directory url": {
+			entry: &ACMECertificateConfigEntry{
+				Kind:      ACMECertificate,
+				Name:      "cert-one",
+				Hostnames: []string{"example.com"},
+			},
+			validateErr: "DirectoryURL is required",
+		},
+		"plaintext directory url": {
+			entry: &ACMECertificateConfigEntry{
+				Kind:         ACMECertificate,
+				Name:         "cert-two",
+				DirectoryURL: "http://acme.example.com/directory",
+				Hostnames:    []string{"example.com"},
+			},
+			validateErr: "must be an absolute https URL",
+		},
+		"invalid directory ca certificate": {
+			entry: &ACMECertificateConfigEntry{
+				Kind:                   ACMECertificate,
+				Name:                   "cert-three",
+				DirectoryURL:           "https://acme.example.com/directory",
+				DirectoryCACertificate: "foo",
+				Hostnames:              []string{"example.com"},
+			},
+			validateErr: "failed to parse DirectoryCACertificate PEM",
+		},
+		"missing hostnames": {
+			entry: &ACMECertificateConfigEntry{
+				Kind:         ACMECertificate,
+				Name:         "cert-four",
+				DirectoryURL: "https://acme.example.com/directory",
+			},
+			validateErr: "at least one hostname is required",
+		},
+		"wildcard hostname": {
+			entry: &ACMECertificateConfigEntry{
+				Kind:         ACMECertificate,
+				Name:         "cert-five",
+				DirectoryURL: "https://acme.example.com/directory",
+				Hostnames:    []string{"*.example.com"},
+			},
+			validateErr: "cannot be a wildcard",
+		},
+		"duplicate hostname": {
+			entry: &ACMECertificateConfigEntry{
+				Kind:         ACMECertificate,
+				Name:         "cert-six",
+				DirectoryURL: "https://acme.example.com/directory",
+				Hostnames:    []string{"example.com", "EXAMPLE.com"},
+			},
+			validateErr: "is listed more than once",
+		},
+		"negative renew before": {
+			entry: &ACMECertificateConfigEntry{
+				Kind:         ACMECertificate,
+				Name:         "cert-seven",
+				DirectoryURL: "https://acme.example.com/directory",
+				Hostnames:    []string{"example.com"},
+				RenewBefore:  -time.Hour,
+			},
+			validateErr: "RenewBefore cannot be negative",
+		},
+		"valid": {
+			entry: &ACMECertificateConfigEntry{
+				Kind:                   ACMECertificate,
+				Name:                   "cert-eight",
+				DirectoryURL:           "https://acme.example.com/directory",
+				DirectoryCACertificate: validCertificate,
+				Email:                  "admin@example.com",
+				Hostnames:              []string{"Example.com", "www.example.com"},
+				RenewBefore:            7 * 24 * time.Hour,
+			},
+			expected: &ACMECertificateConfigEntry{
+				Kind:                   ACMECertificate,
+				Name:                   "cert-eight",
+				DirectoryURL:           "https://acme.example.com/directory",
+				DirectoryCACertificate: validCertificate,
+				Email:                  "admin@example.com",
+				Hostnames:              []string{"example.com", "www.example.com"},
+				RenewBefore:            7 * 24 * time.Hour,
+			},
+		},
+	}
+	testConfigEntryNormalizeAndValidate(t, cases)
+}
@@ -188,23 +188,7 @@ func (e *ServiceRouterConfigEntry) Validate() error {
 				if hdr.Name == "" {
 					return fmt.Errorf("Route[%d] Header[%d] missing required Name field", i, j)
 				}
-				hdrParts := 0
-				if hdr.Present {
-					hdrParts++
-				}
-				if hdr.Exact != "" {
-					hdrParts++
-				}
-				if hdr.Regex != "" {
-					hdrParts++
-				}
-				if hdr.Prefix != "" {
-					hdrParts++
-				}
-				if hdr.Suffix != "" {
-					hdrParts++
-				}
-				if hdrParts != 1 {
+				if hdr.matchCount() != 1 {
 					return fmt.Errorf("Route[%d] Header[%d] should only contain one of Present, Exact, Prefix, Suffix, or Regex", i, j)
 				}
 			}
@@ -243,6 +227,15 @@ func (e *ServiceRouterConfigEntry) Validate() error {
 			}
 		}
 
+		if route.Match != nil && route.Match.GRPC != nil {
+			if route.Match.HTTP != nil {
+				return fmt.Errorf("Route[%d] should only contain one of HTTP or GRPC match criteria", i)
+			}
+			if err := route.Match.GRPC.validate(); err != nil {
+				return fmt.Errorf("Route[%d] %w", i, err)
+			}
+		}
+
 		if route.Destination != nil {
 			if route.Destination.PrefixRewrite != "" && !eligibleForPrefixRewrite {
 				return fmt.Errorf("Route[%d] cannot make use of PrefixRewrite without configuring either PathExact or PathPrefix", i)
@@ -253,6 +246,27 @@ func (e *ServiceRouterConfigEntry) Validate() error {
 					return fmt.Errorf("Route[%d] contains an invalid retry condition: %q", i, r)
 				}
 			}
+
+			if err := validateRetryBackOff(
+				route.Destination.RetryBaseInterval,
+				route.Destination.RetryMaxInterval,
+				route.Destination.PerTryTimeout,
+				route.Destination.RetriableHeaders,
+			); err != nil {
+				return fmt.Errorf("Route[%d] %w", i, err)
+			}
+
+			if route.Destination.FaultInjection != nil {
+				if err := route.Destination.FaultInjection.validate(); err != nil {
+					return fmt.Errorf("Route[%d] %w", i, err)
+				}
+			}
+
+			if route.Destination.Mirror != nil {
+				if err := route.Destination.Mirror.validate(); err != nil {
+					return fmt.Errorf("Route[%d] %w", i, err)
+				}
+			}
 		}
 	}
 
@@ -325,6 +339,13 @@ func (e *ServiceRouterConfigEntry) ListRelatedServices() []ServiceID {
 			if destID != svcID {
 				found[destID] = struct{}{}
 			}
+
+			if mirror := route.Destination.Mirror; mirror != nil {
+				mirrorID := NewServiceID(mirror.Service, mirror.GetEnterpriseMeta(&e.EnterpriseMeta))
+				if mirrorID != svcID {
+					found[mirrorID] = struct{}{}
+				}
+			}
 		}
 	}
 
@@ -362,12 +383,17 @@ type ServiceRoute struct {
 type ServiceRouteMatch struct {
 	HTTP *ServiceRouteHTTPMatch `json:",omitempty"`
 
-	// If we have non-http match criteria for other protocols in the future
-	// (gRPC, redis, etc) they can go here.
+	// GRPC matches gRPC requests by service, method, and metadata. It may
+	// only be used when the discovery chain protocol is grpc.
+	GRPC *ServiceRouteGRPCMatch `json:",omitempty"`
+
+	// If we have match criteria for other protocols in the future (redis,
+	// etc) they can go here.
 }
 
 func (m *ServiceRouteMatch) IsEmpty() bool {
-	return m.HTTP == nil || m.HTTP.IsEmpty()
+	return (m.HTTP == nil || m.HTTP.IsEmpty()) &&
+		(m.GRPC == nil || m.GRPC.IsEmpty())
 }
 
 // ServiceRouteHTTPMatch is a set of http-specific match criteria.
@@ -402,6 +428,28 @@ type ServiceRouteHTTPMatchHeader struct {
 	Invert  bool   `json:",omitempty"`
 }
 
+// matchCount returns how many of the mutually exclusive match criteria are
+// set.
+func (h *ServiceRouteHTTPMatchHeader) matchCount() int {
+	n := 0
+	if h.Present {
+		n++
+	}
+	if h.Exact != "" {
+		n++
+	}
+	if h.Regex != "" {
+		n++
+	}
+	if h.Prefix != "" {
+		n++
+	}
+	if h.Suffix != "" {
+		n++
+	}
+	return n
+}
+
 type ServiceRouteHTTPMatchQueryParam struct {
 	Name    string
 	Present bool   `json:",omitempty"`
@@ -409,6 +457,45 @@ type ServiceRouteHTTPMatchQueryParam struct {
 	Regex   string `json:",omitempty"`
 }
 
+// ServiceRouteGRPCMatch is a set of gRPC-specific match criteria.
+type ServiceRouteGRPCMatch struct {
+	// Service is the fully qualified name of the gRPC service to match, such
+	// as "helloworld.Greeter". If empty, any service matches.
+	Service string `json:",omitempty"`
+
+	// Method is the name of the gRPC method to match, such as "SayHello". If
+	// empty, any method of the service matches.
+	Method string `json:",omitempty"`
+
+	// Metadata is a list of criteria that the gRPC request metadata must all
+	// match.
+	Metadata []ServiceRouteHTTPMatchHeader `json:",omitempty"`
+}
+
+func (m *ServiceRouteGRPCMatch) IsEmpty() bool {
+	return m.Service == "" &&
+		m.Method == "" &&
+		len(m.Metadata) == 0
+}
+
+func (m *ServiceRouteGRPCMatch) validate() error {
+	if strings.Contains(m.Service, "/") {
+		return fmt.Errorf("GRPC Service must not contain '/': %q", m.Service)
+	}
+	if strings.Contains(m.Method, "/") {
+		return fmt.Errorf("GRPC Method must not contain '/': %q", m.Method)
+	}
+	for j, md := range m.Metadata {
+		if md.Name == "" {
+			return fmt.Errorf("GRPC Metadata[%d] missing required Name field", j)
+		}
+		if md.matchCount() != 1 {
+			return fmt.Errorf("GRPC Metadata[%d] should only contain one of Present, Exact, Prefix, Suffix, or Regex", j)
+		}
+	}
+	return nil
+}
+
 // ServiceRouteDestination describes how to proxy the actual matching request
 // to a service.
 type ServiceRouteDestination struct {
@@ -468,21 +555,52 @@ type ServiceRouteDestination struct {
 	// eligible for retry. This again should be feasible in any reasonable proxy.
 	RetryOnStatusCodes []uint32 `json:",omitempty" alias:"retry_on_status_codes"`
 
+	// RetryBaseInterval is the base interval of the exponential back-off
+	// between retries. If unset the proxy default of 25ms is used.
+	RetryBaseInterval time.Duration `json:",omitempty" alias:"retry_base_interval"`
+
+	// RetryMaxInterval is the maximum interval between retries. If unset it
+	// defaults to 10 times RetryBaseInterval. It requires RetryBaseInterval.
+	RetryMaxInterval time.Duration `json:",omitempty" alias:"retry_max_interval"`
+
+	// PerTryTimeout is the amount of time permitted for each attempt,
+	// including the first one, as opposed to RequestTimeout which covers
+	// the request and all of its retries.
+	PerTryTimeout time.Duration `json:",omitempty" alias:"per_try_timeout"`
+
+	// RetriableHeaders is a list of response header criteria. A response
+	// matching any of them is eligible for retry.
+	RetriableHeaders []ServiceRouteHTTPMatchHeader `json:",omitempty" alias:"retriable_headers"`
+
 	// Allow HTTP header manipulation to be configured.
 	RequestHeaders  *HTTPHeaderModifiers `json:",omitempty" alias:"request_headers"`
 	ResponseHeaders *HTTPHeaderModifiers `json:",omitempty" alias:"response_headers"`
+
+	// FaultInjection delays or aborts a percentage of the requests matching
+	// this route before they are proxied to the destination.
+	FaultInjection *ServiceRouteFaultInjection `json:",omitempty" alias:"fault_injection"`
+
+	// Mirror sends a copy of a percentage of the requests matching this route
+	// to a shadow service. Responses from the shadow service are discarded.
+	Mirror *MirrorPolicy `json:",omitempty"`
 }
 
 func (e *ServiceRouteDestination) MarshalJSON() ([]byte, error) {
 	type Alias ServiceRouteDestination
 	exported := &struct {
-		RequestTimeout string `json:",omitempty"`
-		IdleTimeout    string `json:",omitempty"`
+		RequestTimeout    string `json:",omitempty"`
+		IdleTimeout       string `json:",omitempty"`
+		RetryBaseInterval string `json:",omitempty"`
+		RetryMaxInterval  string `json:",omitempty"`
+		PerTryTimeout     string `json:",omitempty"`
 		*Alias
 	}{
-		RequestTimeout: e.RequestTimeout.String(),
-		IdleTimeout:    e.IdleTimeout.String(),
-		Alias:          (*Alias)(e),
+		RequestTimeout:    e.RequestTimeout.String(),
+		IdleTimeout:       e.IdleTimeout.String(),
+		RetryBaseInterval: e.RetryBaseInterval.String(),
+		RetryMaxInterval:  e.RetryMaxInterval.String(),
+		PerTryTimeout:     e.PerTryTimeout.String(),
+		Alias:             (*Alias)(e),
 	}
 	if e.RequestTimeout == 0 {
 		exported.RequestTimeout = ""
@@ -492,14 +610,29 @@ func (e *ServiceRouteDestination) MarshalJSON() ([]byte, error) {
 		exported.IdleTimeout = ""
 	}
 
+	if e.RetryBaseInterval == 0 {
+		exported.RetryBaseInterval = ""
+	}
+
+	if e.RetryMaxInterval == 0 {
+		exported.RetryMaxInterval = ""
+	}
+
+	if e.PerTryTimeout == 0 {
+		exported.PerTryTimeout = ""
+	}
+
 	return json.Marshal(exported)
 }
 
 func (e *ServiceRouteDestination) UnmarshalJSON(data []byte) error {
 	type Alias ServiceRouteDestination
 	aux := &struct {
-		RequestTimeout string
-		IdleTimeout    string
+		RequestTimeout    string
+		IdleTimeout       string
+		RetryBaseInterval string
+		RetryMaxInterval  string
+		PerTryTimeout     string
 		*Alias
 	}{
 		Alias: (*Alias)(e),
@@ -518,11 +651,211 @@ func (e *ServiceRouteDestination) UnmarshalJSON(data []byte) error {
 			return err
 		}
 	}
+	if aux.RetryBaseInterval != "" {
+		if e.RetryBaseInterval, err = time.ParseDuration(aux.RetryBaseInterval); err != nil {
+			return err
+		}
+	}
+	if aux.RetryMaxInterval != "" {
+		if e.RetryMaxInterval, err = time.ParseDuration(aux.RetryMaxInterval); err != nil {
+			return err
+		}
+	}
+	if aux.PerTryTimeout != "" {
+		if e.PerTryTimeout, err = time.ParseDuration(aux.PerTryTimeout); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 func (d *ServiceRouteDestination) HasRetryFeatures() bool {
-	return d.NumRetries > 0 || d.RetryOnConnectFailure || len(d.RetryOnStatusCodes) > 0 || len(d.RetryOn) > 0
+	return d.NumRetries > 0 || d.RetryOnConnectFailure || len(d.RetryOnStatusCodes) > 0 || len(d.RetryOn) > 0 ||
+		d.RetryBaseInterval > 0 || d.RetryMaxInterval > 0 || d.PerTryTimeout > 0 || len(d.RetriableHeaders) > 0
+}
+
+// validateRetryBackOff checks the retry timing and retriable header settings
+// shared by route destinations and resolver retry policies.
+func validateRetryBackOff(baseInterval, maxInterval, perTryTimeout time.Duration, headers []ServiceRouteHTTPMatchHeader) error {
+	if baseInterval < 0 {
+		return fmt.Errorf("RetryBaseInterval '%s' must be >= 0", baseInterval)
+	}
+	if maxInterval < 0 {
+		return fmt.Errorf("RetryMaxInterval '%s' must be >= 0", maxInterval)
+	}
+	if maxInterval > 0 {
+		if baseInterval == 0 {
+			return fmt.Errorf("RetryMaxInterval requires RetryBaseInterval to be set")
+		}
+		if maxInterval < baseInterval {
+			return fmt.Errorf("RetryMaxInterval '%s' must not be less than RetryBaseInterval '%s'", maxInterval, baseInterval)
+		}
+	}
+	if perTryTimeout < 0 {
+		return fmt.Errorf("PerTryTimeout '%s' must be >= 0", perTryTimeout)
+	}
+	for i, hdr := range headers {
+		if hdr.Name == "" {
+			return fmt.Errorf("RetriableHeaders[%d] missing required Name field", i)
+		}
+		if hdr.matchCount() != 1 {
+			return fmt.Errorf("RetriableHeaders[%d] should only contain one of Present, Exact, Prefix, Suffix, or Regex", i)
+		}
+	}
+	return nil
+}
+
+// ServiceRouteFaultInjection configures faults to inject into the requests
+// matching a route. It is intended for testing how downstream services cope
+// with a slow or failing upstream.
+type ServiceRouteFaultInjection struct {
+	// Delay holds back a percentage of the requests for a fixed duration
+	// before they are proxied.
+	Delay *FaultInjectionDelay `json:",omitempty"`
+
+	// Abort fails a percentage of the requests without proxying them.
+	Abort *FaultInjectionAbort `json:",omitempty"`
+
+	// Headers restricts the faults to requests whose headers match all of
+	// the given criteria. If empty every request matching the route is
+	// eligible.
+	Headers []ServiceRouteHTTPMatchHeader `json:",omitempty"`
+}
+
+// FaultInjectionDelay delays a percentage of requests.
+type FaultInjectionDelay struct {
+	// FixedDelay is how long each affected request is delayed.
+	FixedDelay time.Duration `json:",omitempty" alias:"fixed_delay"`
+
+	// Percentage is the percentage of requests to delay, between 0 and 100.
+	Percentage float32 `json:",omitempty"`
+}
+
+func (d *FaultInjectionDelay) MarshalJSON() ([]byte, error) {
+	type Alias FaultInjectionDelay
+	exported := &struct {
+		FixedDelay string `json:",omitempty"`
+		*Alias
+	}{
+		FixedDelay: d.FixedDelay.String(),
+		Alias:      (*Alias)(d),
+	}
+	if d.FixedDelay == 0 {
+		exported.FixedDelay = ""
+	}
+
+	return json.Marshal(exported)
+}
+
+func (d *FaultInjectionDelay) UnmarshalJSON(data []byte) error {
+	type Alias FaultInjectionDelay
+	aux := &struct {
+		FixedDelay string
+		*Alias
+	}{
+		Alias: (*Alias)(d),
+	}
+	if err := lib.UnmarshalJSON(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if aux.FixedDelay != "" {
+		if d.FixedDelay, err = time.ParseDuration(aux.FixedDelay); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FaultInjectionAbort aborts a percentage of requests with an HTTP or gRPC
+// status. Exactly one of HTTPStatus and GRPCStatus must be set.
+type FaultInjectionAbort struct {
+	// HTTPStatus is the HTTP status code returned for aborted requests.
+	HTTPStatus uint32 `json:",omitempty" alias:"http_status"`
+
+	// GRPCStatus is the gRPC status code returned for aborted requests.
+	GRPCStatus uint32 `json:",omitempty" alias:"grpc_status"`
+
+	// Percentage is the percentage of requests to abort, between 0 and 100.
+	Percentage float32 `json:",omitempty"`
+}
+
+func (f *ServiceRouteFaultInjection) validate() error {
+	if f.Delay == nil && f.Abort == nil {
+		return fmt.Errorf("FaultInjection must configure at least one of Delay or Abort")
+	}
+
+	if f.Delay != nil {
+		if f.Delay.FixedDelay <= 0 {
+			return fmt.Errorf("FaultInjection Delay FixedDelay must be greater than 0")
+		}
+		if f.Delay.Percentage <= 0 || f.Delay.Percentage > 100 {
+			return fmt.Errorf("FaultInjection Delay Percentage must be greater than 0 and at most 100")
+		}
+	}
+
+	if f.Abort != nil {
+		switch {
+		case f.Abort.HTTPStatus != 0 && f.Abort.GRPCStatus != 0:
+			return fmt.Errorf("FaultInjection Abort should only contain one of HTTPStatus or GRPCStatus")
+		case f.Abort.HTTPStatus != 0:
+			if f.Abort.HTTPStatus < 200 || f.Abort.HTTPStatus > 599 {
+				return fmt.Errorf("FaultInjection Abort HTTPStatus must be between 200 and 599")
+			}
+		case f.Abort.GRPCStatus != 0:
+			if f.Abort.GRPCStatus > 16 {
+				return fmt.Errorf("FaultInjection Abort GRPCStatus must be between 1 and 16")
+			}
+		default:
+			return fmt.Errorf("FaultInjection Abort must configure one of HTTPStatus or GRPCStatus")
+		}
+		if f.Abort.Percentage <= 0 || f.Abort.Percentage > 100 {
+			return fmt.Errorf("FaultInjection Abort Percentage must be greater than 0 and at most 100")
+		}
+	}
+
+	for j, hdr := range f.Headers {
+		if hdr.Name == "" {
+			return fmt.Errorf("FaultInjection Header[%d] missing required Name field", j)
+		}
+		if hdr.matchCount() != 1 {
+			return fmt.Errorf("FaultInjection Header[%d] should only contain one of Present, Exact, Prefix, Suffix, or Regex", j)
+		}
+	}
+
+	return nil
+}
+
+// MirrorPolicy sends a copy of a percentage of requests to a shadow service
+// so that it can be tested against real traffic without affecting responses.
+type MirrorPolicy struct {
+	// Service is the shadow service to send the copies to.
+	Service string
+
+	// ServiceSubset is a named subset of the shadow service to use instead of
+	// its DefaultSubset (optional).
+	ServiceSubset string `json:",omitempty" alias:"service_subset"`
+
+	// Namespace is the namespace to resolve the shadow service from instead
+	// of the current namespace (optional).
+	Namespace string `json:",omitempty"`
+
+	// Partition is the partition to resolve the shadow service from instead
+	// of the current partition (optional).
+	Partition string `json:",omitempty"`
+
+	// Percentage is the percentage of requests to mirror, between 0 and 100.
+	Percentage float32 `json:",omitempty"`
+}
+
+func (m *MirrorPolicy) validate() error {
+	if m.Service == "" {
+		return fmt.Errorf("Mirror Service is required")
+	}
+	if m.Percentage <= 0 || m.Percentage > 100 {
+		return fmt.Errorf("Mirror Percentage must be greater than 0 and at most 100")
+	}
+	return nil
 }
 
 // ServiceSplitterConfigEntry defines how incoming requests are split across
@@ -636,6 +969,7 @@ func (e *ServiceSplitterConfigEntry) Validate() error {
 
 	copyAsKey := func(s ServiceSplit) ServiceSplit {
 		s.Weight = 0
+		s.Mirror = nil
 		return s
 	}
 
@@ -655,6 +989,14 @@ func (e *ServiceSplitterConfigEntry) Validate() error {
 		found[splitKey] = struct{}{}
 	}
 
+	for i, split := range e.Splits {
+		if split.Mirror != nil {
+			if err := split.Mirror.validate(); err != nil {
+				return fmt.Errorf("Split[%d] %w", i, err)
+			}
+		}
+	}
+
 	sumScaled := 0
 	for _, split := range e.Splits {
 		sumScaled += scaleWeight(split.Weight)
@@ -709,6 +1051,13 @@ func (e *ServiceSplitterConfigEntry) ListRelatedServices() []ServiceID {
 		if splitID != svcID {
 			found[splitID] = struct{}{}
 		}
+
+		if split.Mirror != nil {
+			mirrorID := NewServiceID(split.Mirror.Service, split.Mirror.GetEnterpriseMeta(&e.EnterpriseMeta))
+			if mirrorID != svcID {
+				found[mirrorID] = struct{}{}
+			}
+		}
 	}
 
 	if len(found) == 0 {
@@ -769,6 +1118,10 @@ type ServiceSplit struct {
 	// Allow HTTP header manipulation to be configured.
 	RequestHeaders  *HTTPHeaderModifiers `json:",omitempty" alias:"request_headers"`
 	ResponseHeaders *HTTPHeaderModifiers `json:",omitempty" alias:"response_headers"`
+
+	// Mirror sends a copy of a percentage of the requests sent to this split
+	// to a shadow service. Responses from the shadow service are discarded.
+	Mirror *MirrorPolicy `json:",omitempty"`
 }
 
 // MergeParent is called by the discovery chain compiler when a split directs to
@@ -836,6 +1189,12 @@ func (s *ServiceSplit) MergeParent(parent *ServiceSplit) (*ServiceSplit, error)
 	if err != nil {
 		return nil, err
 	}
+
+	// Mirror the child's traffic as the parent would have unless the child
+	// configures its own mirror.
+	if copy.Mirror == nil && parent != nil {
+		copy.Mirror = parent.Mirror
+	}
 	return &copy, nil
 }
 
@@ -901,6 +1260,11 @@ type ServiceResolverConfigEntry struct {
 	// to 15 seconds.
 	RequestTimeout time.Duration `json:",omitempty" alias:"request_timeout"`
 
+	// RetryPolicy is the default retry behavior for requests to this
+	// service. Route destinations that configure retries take precedence
+	// over it.
+	RetryPolicy *ServiceResolverRetryPolicy `json:",omitempty" alias:"retry_policy"`
+
 	// LoadBalancer determines the load balancing policy and configuration for services
 	// issuing requests to this upstream service.
 	LoadBalancer *LoadBalancer `json:",omitempty" alias:"load_balancer"`
@@ -1005,6 +1369,7 @@ func (e *ServiceResolverConfigEntry) IsDefault() bool {
 		len(e.Failover) == 0 &&
 		e.ConnectTimeout == 0 &&
 		e.RequestTimeout == 0 &&
+		e.RetryPolicy == nil &&
 		e.LoadBalancer == nil &&
 		e.PrioritizeByLocality == nil
 }
@@ -1242,6 +1607,12 @@ func (e *ServiceResolverConfigEntry) Validate() error {
 		return fmt.Errorf("Bad RequestTimeout '%s', must be >= 0", e.RequestTimeout)
 	}
 
+	if e.RetryPolicy != nil {
+		if err := e.RetryPolicy.validate(); err != nil {
+			return fmt.Errorf("Bad RetryPolicy: %w", err)
+		}
+	}
+
 	if e.LoadBalancer != nil {
 		lb := e.LoadBalancer
 
@@ -1539,6 +1910,135 @@ func (fp *ServiceResolverFailoverPolicy) validate() error {
 	return nil
 }
 
+// ServiceResolverRetryPolicy describes when and how requests to the resolved
+// service are retried. The retry fields have the same meaning as the ones on
+// ServiceRouteDestination.
+type ServiceResolverRetryPolicy struct {
+	NumRetries            uint32                        `json:",omitempty" alias:"num_retries"`
+	RetryOnConnectFailure bool                          `json:",omitempty" alias:"retry_on_connect_failure"`
+	RetryOn               []string                      `json:",omitempty" alias:"retry_on"`
+	RetryOnStatusCodes    []uint32                      `json:",omitempty" alias:"retry_on_status_codes"`
+	RetryBaseInterval     time.Duration                 `json:",omitempty" alias:"retry_base_interval"`
+	RetryMaxInterval      time.Duration                 `json:",omitempty" alias:"retry_max_interval"`
+	PerTryTimeout         time.Duration                 `json:",omitempty" alias:"per_try_timeout"`
+	RetriableHeaders      []ServiceRouteHTTPMatchHeader `json:",omitempty" alias:"retriable_headers"`
+
+	// RetryBudget limits the retries to the resolved service to a share of
+	// its active requests. Unlike the other fields it also bounds retries
+	// configured on route destinations, because it applies to the upstream
+	// cluster rather than to a route.
+	RetryBudget *RetryBudget `json:",omitempty" alias:"retry_budget"`
+}
+
+func (p *ServiceResolverRetryPolicy) MarshalJSON() ([]byte, error) {
+	type Alias ServiceResolverRetryPolicy
+	exported := &struct {
+		RetryBaseInterval string `json:",omitempty"`
+		RetryMaxInterval  string `json:",omitempty"`
+		PerTryTimeout     string `json:",omitempty"`
+		*Alias
+	}{
+		RetryBaseInterval: p.RetryBaseInterval.String(),
+		RetryMaxInterval:  p.RetryMaxInterval.String(),
+		PerTryTimeout:     p.PerTryTimeout.String(),
+		Alias:             (*Alias)(p),
+	}
+	if p.RetryBaseInterval == 0 {
+		exported.RetryBaseInterval = ""
+	}
+	if p.RetryMaxInterval == 0 {
+		exported.RetryMaxInterval = ""
+	}
+	if p.PerTryTimeout == 0 {
+		exported.PerTryTimeout = ""
+	}
+
+	return json.Marshal(exported)
+}
+
+func (p *ServiceResolverRetryPolicy) UnmarshalJSON(data []byte) error {
+	type Alias ServiceResolverRetryPolicy
+	aux := &struct {
+		RetryBaseInterval string
+		RetryMaxInterval  string
+		PerTryTimeout     string
+		*Alias
+	}{
+		Alias: (*Alias)(p),
+	}
+	if err := lib.UnmarshalJSON(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if aux.RetryBaseInterval != "" {
+		if p.RetryBaseInterval, err = time.ParseDuration(aux.RetryBaseInterval); err != nil {
+			return err
+		}
+	}
+	if aux.RetryMaxInterval != "" {
+		if p.RetryMaxInterval, err = time.ParseDuration(aux.RetryMaxInterval); err != nil {
+			return err
+		}
+	}
+	if aux.PerTryTimeout != "" {
+		if p.PerTryTimeout, err = time.ParseDuration(aux.PerTryTimeout); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// HasRetryFeatures reports whether the policy configures route retries, as
+// opposed to only a retry budget.
+func (p *ServiceResolverRetryPolicy) HasRetryFeatures() bool {
+	return p.RouteDestination().HasRetryFeatures()
+}
+
+// RouteDestination returns a route destination carrying the retry settings
+// of the policy, so that they can be rendered like a route's own retries.
+func (p *ServiceResolverRetryPolicy) RouteDestination() *ServiceRouteDestination {
+	return &ServiceRouteDestination{
+		NumRetries:            p.NumRetries,
+		RetryOnConnectFailure: p.RetryOnConnectFailure,
+		RetryOn:               p.RetryOn,
+		RetryOnStatusCodes:    p.RetryOnStatusCodes,
+		RetryBaseInterval:     p.RetryBaseInterval,
+		RetryMaxInterval:      p.RetryMaxInterval,
+		PerTryTimeout:         p.PerTryTimeout,
+		RetriableHeaders:      p.RetriableHeaders,
+	}
+}
+
+func (p *ServiceResolverRetryPolicy) validate() error {
+	for _, r := range p.RetryOn {
+		if !isValidRetryCondition(r) {
+			return fmt.Errorf("invalid retry condition: %q", r)
+		}
+	}
+	if err := validateRetryBackOff(p.RetryBaseInterval, p.RetryMaxInterval, p.PerTryTimeout, p.RetriableHeaders); err != nil {
+		return err
+	}
+	if b := p.RetryBudget; b != nil {
+		if b.BudgetPercent < 0 || b.BudgetPercent > 100 {
+			return fmt.Errorf("RetryBudget.BudgetPercent must be between 0 and 100")
+		}
+	}
+	return nil
+}
+
+// RetryBudget caps the number of concurrent retries to a percentage of the
+// active requests, which keeps retries from overwhelming a failing service.
+type RetryBudget struct {
+	// BudgetPercent is the percentage of active requests that may be
+	// retries, between 0 and 100. If unset the proxy default of 20% is used.
+	BudgetPercent float64 `json:",omitempty" alias:"budget_percent"`
+
+	// MinRetryConcurrency is the number of concurrent retries that are
+	// always allowed, regardless of the budget. If unset the proxy default
+	// of 3 is used.
+	MinRetryConcurrency uint32 `json:",omitempty" alias:"min_retry_concurrency"`
+}
+
 type ServiceResolverPrioritizeByLocality struct {
 	// Mode specifies the type of prioritization that will be performed
 	// when selecting nodes in the local partition.
@@ -1711,6 +2211,10 @@ type DiscoveryChainRequest struct {
 	EvaluateInNamespace  string
 	EvaluateInPartition  string
 
+	// EvaluateInPeer compiles the chain for the service imported from this
+	// cluster peer using the config entries exported by the peer.
+	EvaluateInPeer string
+
 	// OverrideMeshGateway allows for the mesh gateway setting to be overridden
 	// for any resolver in the compiled chain.
 	OverrideMeshGateway MeshGatewayConfig
@@ -1752,6 +2256,7 @@ func (r *DiscoveryChainRequest) CacheInfo() cache.RequestInfo {
 		EvaluateInDatacenter   string
 		EvaluateInNamespace    string
 		EvaluateInPartition    string
+		EvaluateInPeer         string
 		OverrideMeshGateway    MeshGatewayConfig
 		OverrideProtocol       string
 		OverrideConnectTimeout time.Duration
@@ -1761,6 +2266,7 @@ func (r *DiscoveryChainRequest) CacheInfo() cache.RequestInfo {
 		EvaluateInDatacenter:   r.EvaluateInDatacenter,
 		EvaluateInNamespace:    r.EvaluateInNamespace,
 		EvaluateInPartition:    r.EvaluateInPartition,
+		EvaluateInPeer:         r.EvaluateInPeer,
 		OverrideMeshGateway:    r.OverrideMeshGateway,
 		OverrideProtocol:       r.OverrideProtocol,
 		OverrideConnectTimeout: r.OverrideConnectTimeout,
@@ -23,6 +23,12 @@ func (split *ServiceSplit) GetEnterpriseMeta(_ *acl.EnterpriseMeta) *acl.Enterpr
 	return DefaultEnterpriseMetaInDefaultPartition()
 }
 
+// GetEnterpriseMeta is used to synthesize the EnterpriseMeta struct from
+// fields in the MirrorPolicy
+func (m *MirrorPolicy) GetEnterpriseMeta(_ *acl.EnterpriseMeta) *acl.EnterpriseMeta {
+	return DefaultEnterpriseMetaInDefaultPartition()
+}
+
 // GetEnterpriseMeta is used to synthesize the EnterpriseMeta struct from
 // fields in the ServiceResolverRedirect
 func (redir *ServiceResolverRedirect) GetEnterpriseMeta(_ *acl.EnterpriseMeta) *acl.EnterpriseMeta {
@@ -286,6 +286,61 @@ func TestConfigEntries_ListRelatedServices_AndACLs(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "router: mirror",
+			entry: &ServiceRouterConfigEntry{
+				Kind: ServiceRouter,
+				Name: "test",
+				Routes: []ServiceRoute{
+					{
+						Destination: &ServiceRouteDestination{
+							Service: "foo",
+							Mirror:  &MirrorPolicy{Service: "shadow", Percentage: 10},
+						},
+					},
+				},
+			},
+			expectServices: []ServiceID{NewServiceID("foo", nil), NewServiceID("shadow", nil), NewServiceID("test", nil)},
+			expectACLs: []testACL{
+				defaultDenyCase,
+				readTestCase,
+				writeTestCaseDenied,
+				{
+					name:       "cannot write test (with foo:read only)",
+					authorizer: newServiceACL(t, []string{"foo"}, []string{"test"}),
+					canRead:    true,
+					canWrite:   false,
+				},
+				{
+					name:       "can write test (with foo:read and shadow:read)",
+					authorizer: newServiceACL(t, []string{"foo", "shadow"}, []string{"test"}),
+					canRead:    true,
+					canWrite:   true,
+				},
+			},
+		},
+		{
+			name: "splitter: mirror",
+			entry: &ServiceSplitterConfigEntry{
+				Kind: ServiceSplitter,
+				Name: "test",
+				Splits: []ServiceSplit{
+					{Weight: 100, Mirror: &MirrorPolicy{Service: "shadow", Percentage: 10}},
+				},
+			},
+			expectServices: []ServiceID{NewServiceID("shadow", nil)},
+			expectACLs: []testACL{
+				defaultDenyCase,
+				readTestCase,
+				writeTestCaseDenied,
+				{
+					name:       "can write test (with shadow:read)",
+					authorizer: newServiceACL(t, []string{"shadow"}, []string{"test"}),
+					canRead:    true,
+					canWrite:   true,
+				},
+			},
+		},
 		{
 			name:  "ingress-gateway",
 			entry: &IngressGatewayConfigEntry{Name: "test"},
@@ -1580,6 +1635,55 @@ func TestServiceResolverConfigEntry(t *testing.T) {
 			},
 			validateErr: "Bad RequestTimeout",
 		},
+		{
+			name: "retry policy",
+			entry: &ServiceResolverConfigEntry{
+				Kind: ServiceResolver,
+				Name: "test",
+				RetryPolicy: &ServiceResolverRetryPolicy{
+					NumRetries:        3,
+					RetryOn:           []string{"reset", "unavailable"},
+					RetryBaseInterval: 100 * time.Millisecond,
+					RetryBudget: &RetryBudget{
+						BudgetPercent:       20,
+						MinRetryConcurrency: 3,
+					},
+				},
+			},
+		},
+		{
+			name: "retry policy with invalid retry condition",
+			entry: &ServiceResolverConfigEntry{
+				Kind: ServiceResolver,
+				Name: "test",
+				RetryPolicy: &ServiceResolverRetryPolicy{
+					RetryOn: []string{"sometimes"},
+				},
+			},
+			validateErr: `Bad RetryPolicy: invalid retry condition: "sometimes"`,
+		},
+		{
+			name: "retry policy with invalid back-off",
+			entry: &ServiceResolverConfigEntry{
+				Kind: ServiceResolver,
+				Name: "test",
+				RetryPolicy: &ServiceResolverRetryPolicy{
+					RetryMaxInterval: time.Second,
+				},
+			},
+			validateErr: "Bad RetryPolicy: RetryMaxInterval requires RetryBaseInterval to be set",
+		},
+		{
+			name: "retry policy with budget out of range",
+			entry: &ServiceResolverConfigEntry{
+				Kind: ServiceResolver,
+				Name: "test",
+				RetryPolicy: &ServiceResolverRetryPolicy{
+					RetryBudget: &RetryBudget{BudgetPercent: 120},
+				},
+			},
+			validateErr: "Bad RetryPolicy: RetryBudget.BudgetPercent must be between 0 and 100",
+		},
 	}
 
 	// Bulk add a bunch of similar validation cases.
@@ -2136,6 +2240,52 @@ func TestServiceSplitterConfigEntry(t *testing.T) {
 			},
 			validateErr: "split destination occurs more than once",
 		},
+		{
+			name: "split with mirror",
+			entry: makesplitter(
+				makesplit(50, "test", "v1", ""),
+				ServiceSplit{
+					Weight:        50,
+					Service:       "test",
+					ServiceSubset: "v2",
+					Mirror:        &MirrorPolicy{Service: "shadow", Percentage: 12.5},
+				},
+			),
+		},
+		{
+			name: "split with mirror without service",
+			entry: makesplitter(
+				ServiceSplit{
+					Weight: 100,
+					Mirror: &MirrorPolicy{Percentage: 10},
+				},
+			),
+			validateErr: "Split[0] Mirror Service is required",
+		},
+		{
+			name: "split with mirror percentage out of range",
+			entry: makesplitter(
+				ServiceSplit{
+					Weight: 100,
+					Mirror: &MirrorPolicy{Service: "shadow"},
+				},
+			),
+			validateErr: "Split[0] Mirror Percentage must be greater than 0 and at most 100",
+		},
+		{
+			name: "duplicated splits with different mirrors",
+			entry: makesplitter(
+				ServiceSplit{
+					Weight: 50,
+					Mirror: &MirrorPolicy{Service: "shadow", Percentage: 10},
+				},
+				ServiceSplit{
+					Weight: 50,
+					Mirror: &MirrorPolicy{Service: "shadow", Percentage: 20},
+				},
+			),
+			validateErr: "split destination occurs more than once",
+		},
 	} {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
@@ -2306,6 +2456,39 @@ func TestServiceSplitMergeParent(t *testing.T) {
 				Service: "foo",
 			},
 		},
+		{
+			name: "mirror inherited from parent",
+			split: &ServiceSplit{
+				Weight:  50,
+				Service: "foo",
+			},
+			parent: &ServiceSplit{
+				Weight: 50,
+				Mirror: &MirrorPolicy{Service: "shadow", Percentage: 10},
+			},
+			want: &ServiceSplit{
+				Weight:  50,
+				Service: "foo",
+				Mirror:  &MirrorPolicy{Service: "shadow", Percentage: 10},
+			},
+		},
+		{
+			name: "mirror from child wins",
+			split: &ServiceSplit{
+				Weight:  50,
+				Service: "foo",
+				Mirror:  &MirrorPolicy{Service: "child-shadow", Percentage: 20},
+			},
+			parent: &ServiceSplit{
+				Weight: 50,
+				Mirror: &MirrorPolicy{Service: "shadow", Percentage: 10},
+			},
+			want: &ServiceSplit{
+				Weight:  50,
+				Service: "foo",
+				Mirror:  &MirrorPolicy{Service: "child-shadow", Percentage: 20},
+			},
+		},
 		{
 			name:   "both nil",
 			split:  nil,
@@ -2742,6 +2925,325 @@ func TestServiceRouterConfigEntry(t *testing.T) {
 			}),
 			validateErr: "contains an invalid retry condition: \"invalid-retry-condition\"",
 		},
+		{
+			name: "route with grpc retry conditions",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service: "other",
+					RetryOn: []string{
+						"cancelled",
+						"deadline-exceeded",
+						"internal",
+						"resource-exhausted",
+						"unavailable",
+					},
+				},
+			}),
+		},
+		{
+			name: "route with retry back-off",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service:           "other",
+					NumRetries:        3,
+					RetryBaseInterval: 100 * time.Millisecond,
+					RetryMaxInterval:  time.Second,
+					PerTryTimeout:     2 * time.Second,
+					RetriableHeaders: []ServiceRouteHTTPMatchHeader{
+						{Name: "x-retry", Exact: "true"},
+					},
+				},
+			}),
+		},
+		{
+			name: "route with negative retry base interval",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service:           "other",
+					RetryBaseInterval: -1 * time.Second,
+				},
+			}),
+			validateErr: "Route[0] RetryBaseInterval '-1s' must be >= 0",
+		},
+		{
+			name: "route with retry max interval without base interval",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service:          "other",
+					RetryMaxInterval: time.Second,
+				},
+			}),
+			validateErr: "Route[0] RetryMaxInterval requires RetryBaseInterval to be set",
+		},
+		{
+			name: "route with retry max interval less than base interval",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service:           "other",
+					RetryBaseInterval: time.Second,
+					RetryMaxInterval:  100 * time.Millisecond,
+				},
+			}),
+			validateErr: "Route[0] RetryMaxInterval '100ms' must not be less than RetryBaseInterval '1s'",
+		},
+		{
+			name: "route with negative per try timeout",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service:       "other",
+					PerTryTimeout: -1 * time.Second,
+				},
+			}),
+			validateErr: "Route[0] PerTryTimeout '-1s' must be >= 0",
+		},
+		{
+			name: "route with retriable header without name",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service: "other",
+					RetriableHeaders: []ServiceRouteHTTPMatchHeader{
+						{Exact: "true"},
+					},
+				},
+			}),
+			validateErr: "Route[0] RetriableHeaders[0] missing required Name field",
+		},
+		{
+			name: "route with retriable header with several criteria",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service: "other",
+					RetriableHeaders: []ServiceRouteHTTPMatchHeader{
+						{Name: "x-retry", Exact: "true", Present: true},
+					},
+				},
+			}),
+			validateErr: "Route[0] RetriableHeaders[0] should only contain one of Present, Exact, Prefix, Suffix, or Regex",
+		},
+		////////////////
+		{
+			name: "route with grpc service and method",
+			entry: makerouter(routeMatch(&ServiceRouteMatch{
+				GRPC: &ServiceRouteGRPCMatch{
+					Service: "helloworld.Greeter",
+					Method:  "SayHello",
+				},
+			})),
+		},
+		{
+			name: "route with grpc method only",
+			entry: makerouter(routeMatch(&ServiceRouteMatch{
+				GRPC: &ServiceRouteGRPCMatch{
+					Method: "SayHello",
+				},
+			})),
+		},
+		{
+			name: "route with grpc and http match",
+			entry: makerouter(routeMatch(&ServiceRouteMatch{
+				HTTP: &ServiceRouteHTTPMatch{PathPrefix: "/"},
+				GRPC: &ServiceRouteGRPCMatch{Service: "helloworld.Greeter"},
+			})),
+			validateErr: "Route[0] should only contain one of HTTP or GRPC match criteria",
+		},
+		{
+			name: "route with grpc service containing slash",
+			entry: makerouter(routeMatch(&ServiceRouteMatch{
+				GRPC: &ServiceRouteGRPCMatch{Service: "helloworld.Greeter/SayHello"},
+			})),
+			validateErr: "Route[0] GRPC Service must not contain '/'",
+		},
+		{
+			name: "route with grpc method containing slash",
+			entry: makerouter(routeMatch(&ServiceRouteMatch{
+				GRPC: &ServiceRouteGRPCMatch{Method: "/SayHello"},
+			})),
+			validateErr: "Route[0] GRPC Method must not contain '/'",
+		},
+		{
+			name: "route with grpc metadata",
+			entry: makerouter(routeMatch(&ServiceRouteMatch{
+				GRPC: &ServiceRouteGRPCMatch{
+					Metadata: []ServiceRouteHTTPMatchHeader{
+						{Name: "x-tenant", Exact: "acme"},
+					},
+				},
+			})),
+		},
+		{
+			name: "route with grpc metadata missing name",
+			entry: makerouter(routeMatch(&ServiceRouteMatch{
+				GRPC: &ServiceRouteGRPCMatch{
+					Metadata: []ServiceRouteHTTPMatchHeader{
+						{Exact: "acme"},
+					},
+				},
+			})),
+			validateErr: "Route[0] GRPC Metadata[0] missing required Name field",
+		},
+		{
+			name: "route with grpc metadata with two matches",
+			entry: makerouter(routeMatch(&ServiceRouteMatch{
+				GRPC: &ServiceRouteGRPCMatch{
+					Metadata: []ServiceRouteHTTPMatchHeader{
+						{Name: "x-tenant", Exact: "acme", Prefix: "ac"},
+					},
+				},
+			})),
+			validateErr: "Route[0] GRPC Metadata[0] should only contain one of Present, Exact, Prefix, Suffix, or Regex",
+		},
+		////////////////
+		{
+			name: "route with fault injection",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service: "other",
+					FaultInjection: &ServiceRouteFaultInjection{
+						Delay: &FaultInjectionDelay{FixedDelay: 5 * time.Second, Percentage: 12.5},
+						Abort: &FaultInjectionAbort{HTTPStatus: 503, Percentage: 100},
+						Headers: []ServiceRouteHTTPMatchHeader{
+							{Name: "x-fault", Exact: "true"},
+						},
+					},
+				},
+			}),
+		},
+		{
+			name: "route with grpc fault injection",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service: "other",
+					FaultInjection: &ServiceRouteFaultInjection{
+						Abort: &FaultInjectionAbort{GRPCStatus: 14, Percentage: 50},
+					},
+				},
+			}),
+		},
+		{
+			name: "route with empty fault injection",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service:        "other",
+					FaultInjection: &ServiceRouteFaultInjection{},
+				},
+			}),
+			validateErr: "Route[0] FaultInjection must configure at least one of Delay or Abort",
+		},
+		{
+			name: "route with fault injection delay without duration",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service: "other",
+					FaultInjection: &ServiceRouteFaultInjection{
+						Delay: &FaultInjectionDelay{Percentage: 10},
+					},
+				},
+			}),
+			validateErr: "FixedDelay must be greater than 0",
+		},
+		{
+			name: "route with fault injection percentage out of range",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service: "other",
+					FaultInjection: &ServiceRouteFaultInjection{
+						Delay: &FaultInjectionDelay{FixedDelay: time.Second, Percentage: 101},
+					},
+				},
+			}),
+			validateErr: "Delay Percentage must be greater than 0 and at most 100",
+		},
+		{
+			name: "route with fault injection abort with both statuses",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service: "other",
+					FaultInjection: &ServiceRouteFaultInjection{
+						Abort: &FaultInjectionAbort{HTTPStatus: 503, GRPCStatus: 14, Percentage: 10},
+					},
+				},
+			}),
+			validateErr: "should only contain one of HTTPStatus or GRPCStatus",
+		},
+		{
+			name: "route with fault injection abort without status",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service: "other",
+					FaultInjection: &ServiceRouteFaultInjection{
+						Abort: &FaultInjectionAbort{Percentage: 10},
+					},
+				},
+			}),
+			validateErr: "must configure one of HTTPStatus or GRPCStatus",
+		},
+		{
+			name: "route with fault injection invalid http status",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service: "other",
+					FaultInjection: &ServiceRouteFaultInjection{
+						Abort: &FaultInjectionAbort{HTTPStatus: 99, Percentage: 10},
+					},
+				},
+			}),
+			validateErr: "HTTPStatus must be between 200 and 599",
+		},
+		{
+			name: "route with fault injection invalid grpc status",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service: "other",
+					FaultInjection: &ServiceRouteFaultInjection{
+						Abort: &FaultInjectionAbort{GRPCStatus: 17, Percentage: 10},
+					},
+				},
+			}),
+			validateErr: "GRPCStatus must be between 1 and 16",
+		},
+		{
+			name: "route with fault injection invalid header",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service: "other",
+					FaultInjection: &ServiceRouteFaultInjection{
+						Abort:   &FaultInjectionAbort{HTTPStatus: 500, Percentage: 10},
+						Headers: []ServiceRouteHTTPMatchHeader{{Name: "x-fault", Exact: "a", Prefix: "b"}},
+					},
+				},
+			}),
+			validateErr: "FaultInjection Header[0] should only contain one of Present, Exact, Prefix, Suffix, or Regex",
+		},
+		////////////////
+		{
+			name: "route with mirror",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service: "other",
+					Mirror:  &MirrorPolicy{Service: "shadow", ServiceSubset: "v2", Percentage: 100},
+				},
+			}),
+		},
+		{
+			name: "route with mirror without service",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service: "other",
+					Mirror:  &MirrorPolicy{Percentage: 100},
+				},
+			}),
+			validateErr: "Route[0] Mirror Service is required",
+		},
+		{
+			name: "route with mirror percentage out of range",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service: "other",
+					Mirror:  &MirrorPolicy{Service: "shadow", Percentage: 100.5},
+				},
+			}),
+			validateErr: "Route[0] Mirror Percentage must be greater than 0 and at most 100",
+		},
 		////////////////
 		{
 			name: "default route with case insensitive match",
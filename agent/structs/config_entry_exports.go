@@ -6,6 +6,7 @@ package structs
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/consul/acl"
 )
@@ -19,6 +20,10 @@ type ExportedServicesConfigEntry struct {
 	// to expose them to.
 	Services []ExportedService `json:",omitempty"`
 
+	// KeyPrefixes is a list of KV prefixes to be replicated to peers and the
+	// list of peers to replicate them to.
+	KeyPrefixes []ExportedKeyPrefix `json:",omitempty" alias:"key_prefixes"`
+
 	Meta               map[string]string `json:",omitempty"`
 	Hash               uint64            `json:",omitempty" hash:"ignore"`
 	acl.EnterpriseMeta `hcl:",squash" mapstructure:",squash"`
@@ -44,6 +49,24 @@ type ExportedService struct {
 
 	// Consumers is a list of downstream consumers of the service to be exported.
 	Consumers []ServiceConsumer `json:",omitempty"`
+
+	// ConfigEntries is a list of kinds of config entries named after the
+	// service to export along with it. Only service-defaults, service-resolver
+	// and service-router entries can be exported, and they are only replicated
+	// to Peer consumers.
+	ConfigEntries []string `json:",omitempty" alias:"config_entries"`
+}
+
+// ExportedKeyPrefix manages the replication of the KV entries under a prefix in
+// the local partition to peers. Replicated entries are stored read-only under
+// PeeredKVPrefix in the importing cluster.
+type ExportedKeyPrefix struct {
+	// Prefix is the KV prefix to export.
+	Prefix string
+
+	// Consumers is a list of peers to export the prefix to. Only Peer
+	// consumers are supported.
+	Consumers []ServiceConsumer `json:",omitempty"`
 }
 
 // ServiceConsumer represents a downstream consumer of the service to be exported.
@@ -110,7 +133,11 @@ func (e *ExportedServicesConfigEntry) Validate() error {
 		return err
 	}
 
-	return e.validateServices()
+	if err := e.validateServices(); err != nil {
+		return err
+	}
+
+	return e.validateKeyPrefixes()
 }
 
 func (e *ExportedServicesConfigEntry) validateServices() error {
@@ -145,6 +172,66 @@ func (e *ExportedServicesConfigEntry) validateServices() error {
 				return fmt.Errorf("Services[%d].Consumers[%d]: exporting to all peers (wildcard) is not supported", i, j)
 			}
 		}
+		if err := validateExportedConfigEntries(svc); err != nil {
+			return fmt.Errorf("Services[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func validateExportedConfigEntries(svc ExportedService) error {
+	if len(svc.ConfigEntries) > 0 && svc.Name == WildcardSpecifier {
+		return fmt.Errorf("config entries cannot be exported for all services (wildcard)")
+	}
+
+	seen := make(map[string]struct{}, len(svc.ConfigEntries))
+	for i, kind := range svc.ConfigEntries {
+		if !IsExportableConfigEntryKind(kind) {
+			return fmt.Errorf("ConfigEntries[%d]: config entries of kind %q cannot be exported", i, kind)
+		}
+		if _, ok := seen[kind]; ok {
+			return fmt.Errorf("ConfigEntries[%d]: kind %q is listed more than once", i, kind)
+		}
+		seen[kind] = struct{}{}
+	}
+	return nil
+}
+
+// IsExportableConfigEntryKind returns true if config entries of the given kind
+// can be exported to peers along with the service they are named after.
+func IsExportableConfigEntryKind(kind string) bool {
+	switch kind {
+	case ServiceDefaults, ServiceResolver, ServiceRouter:
+		return true
+	}
+	return false
+}
+
+func (e *ExportedServicesConfigEntry) validateKeyPrefixes() error {
+	seen := make(map[string]struct{}, len(e.KeyPrefixes))
+	for i, kp := range e.KeyPrefixes {
+		if kp.Prefix == "" {
+			return fmt.Errorf("KeyPrefixes[%d]: prefix cannot be empty", i)
+		}
+		if strings.HasPrefix(kp.Prefix, PeeredKVPrefix) {
+			return fmt.Errorf("KeyPrefixes[%d]: keys imported from peers under %q cannot be exported", i, PeeredKVPrefix)
+		}
+		if _, ok := seen[kp.Prefix]; ok {
+			return fmt.Errorf("KeyPrefixes[%d]: prefix %q is listed more than once", i, kp.Prefix)
+		}
+		seen[kp.Prefix] = struct{}{}
+
+		if len(kp.Consumers) == 0 {
+			return fmt.Errorf("KeyPrefixes[%d]: must have at least one consumer", i)
+		}
+		for j, consumer := range kp.Consumers {
+			if consumer.Peer == "" || consumer.Partition != "" || consumer.SamenessGroup != "" {
+				return fmt.Errorf("KeyPrefixes[%d].Consumers[%d]: key prefixes can only be exported to a Peer", i, j)
+			}
+			if consumer.Peer == WildcardSpecifier {
+				return fmt.Errorf("KeyPrefixes[%d].Consumers[%d]: exporting to all peers (wildcard) is not supported", i, j)
+			}
+		}
 	}
 	return nil
 }
@@ -158,7 +245,21 @@ func (e *ExportedServicesConfigEntry) CanRead(authz acl.Authorizer) error {
 func (e *ExportedServicesConfigEntry) CanWrite(authz acl.Authorizer) error {
 	var authzContext acl.AuthorizerContext
 	e.FillAuthzContext(&authzContext)
-	return authz.ToAllowAuthorizer().MeshWriteAllowed(&authzContext)
+	if err := authz.ToAllowAuthorizer().MeshWriteAllowed(&authzContext); err != nil {
+		return err
+	}
+
+	// Exporting a KV prefix makes its contents readable by the consuming
+	// peers, so the writer must be able to read them too.
+	for _, kp := range e.KeyPrefixes {
+		if err := authz.ToAllowAuthorizer().KeyListAllowed(kp.Prefix, &authzContext); err != nil {
+			return err
+		}
+		if err := authz.ToAllowAuthorizer().KeyReadAllowed(kp.Prefix, &authzContext); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (e *ExportedServicesConfigEntry) GetRaftIndex() *RaftIndex {
@@ -91,6 +91,142 @@ func TestExportedServicesConfigEntry(t *testing.T) {
 			},
 			validateErr: `Services[0].Consumers[0]: must define at most one of Peer, Partition, or SamenessGroup`,
 		},
+		"validate: key prefix": {
+			entry: &ExportedServicesConfigEntry{
+				Name: "default",
+				KeyPrefixes: []ExportedKeyPrefix{
+					{
+						Prefix:    "app/config/",
+						Consumers: []ServiceConsumer{{Peer: "foo"}},
+					},
+				},
+			},
+		},
+		"validate: empty key prefix": {
+			entry: &ExportedServicesConfigEntry{
+				Name: "default",
+				KeyPrefixes: []ExportedKeyPrefix{
+					{
+						Consumers: []ServiceConsumer{{Peer: "foo"}},
+					},
+				},
+			},
+			validateErr: `KeyPrefixes[0]: prefix cannot be empty`,
+		},
+		"validate: key prefix imported from peer": {
+			entry: &ExportedServicesConfigEntry{
+				Name: "default",
+				KeyPrefixes: []ExportedKeyPrefix{
+					{
+						Prefix:    "_peer/bar/app/",
+						Consumers: []ServiceConsumer{{Peer: "foo"}},
+					},
+				},
+			},
+			validateErr: `KeyPrefixes[0]: keys imported from peers under "_peer/" cannot be exported`,
+		},
+		"validate: duplicate key prefix": {
+			entry: &ExportedServicesConfigEntry{
+				Name: "default",
+				KeyPrefixes: []ExportedKeyPrefix{
+					{
+						Prefix:    "app/",
+						Consumers: []ServiceConsumer{{Peer: "foo"}},
+					},
+					{
+						Prefix:    "app/",
+						Consumers: []ServiceConsumer{{Peer: "bar"}},
+					},
+				},
+			},
+			validateErr: `KeyPrefixes[1]: prefix "app/" is listed more than once`,
+		},
+		"validate: key prefix without consumers": {
+			entry: &ExportedServicesConfigEntry{
+				Name: "default",
+				KeyPrefixes: []ExportedKeyPrefix{
+					{
+						Prefix: "app/",
+					},
+				},
+			},
+			validateErr: `KeyPrefixes[0]: must have at least one consumer`,
+		},
+		"validate: key prefix exported to partition": {
+			entry: &ExportedServicesConfigEntry{
+				Name: "default",
+				KeyPrefixes: []ExportedKeyPrefix{
+					{
+						Prefix:    "app/",
+						Consumers: []ServiceConsumer{{Partition: "foo"}},
+					},
+				},
+			},
+			validateErr: `KeyPrefixes[0].Consumers[0]: key prefixes can only be exported to a Peer`,
+		},
+		"validate: key prefix exported to wildcard peer": {
+			entry: &ExportedServicesConfigEntry{
+				Name: "default",
+				KeyPrefixes: []ExportedKeyPrefix{
+					{
+						Prefix:    "app/",
+						Consumers: []ServiceConsumer{{Peer: "*"}},
+					},
+				},
+			},
+			validateErr: `KeyPrefixes[0].Consumers[0]: exporting to all peers (wildcard) is not supported`,
+		},
+		"validate: config entries": {
+			entry: &ExportedServicesConfigEntry{
+				Name: "default",
+				Services: []ExportedService{
+					{
+						Name:          "web",
+						Consumers:     []ServiceConsumer{{Peer: "foo"}},
+						ConfigEntries: []string{ServiceDefaults, ServiceResolver, ServiceRouter},
+					},
+				},
+			},
+		},
+		"validate: config entries for wildcard service": {
+			entry: &ExportedServicesConfigEntry{
+				Name: "default",
+				Services: []ExportedService{
+					{
+						Name:          "*",
+						Consumers:     []ServiceConsumer{{Peer: "foo"}},
+						ConfigEntries: []string{ServiceDefaults},
+					},
+				},
+			},
+			validateErr: `Services[0]: config entries cannot be exported for all services (wildcard)`,
+		},
+		"validate: unsupported config entry kind": {
+			entry: &ExportedServicesConfigEntry{
+				Name: "default",
+				Services: []ExportedService{
+					{
+						Name:          "web",
+						Consumers:     []ServiceConsumer{{Peer: "foo"}},
+						ConfigEntries: []string{ServiceSplitter},
+					},
+				},
+			},
+			validateErr: `Services[0]: ConfigEntries[0]: config entries of kind "service-splitter" cannot be exported`,
+		},
+		"validate: duplicate config entry kind": {
+			entry: &ExportedServicesConfigEntry{
+				Name: "default",
+				Services: []ExportedService{
+					{
+						Name:          "web",
+						Consumers:     []ServiceConsumer{{Peer: "foo"}},
+						ConfigEntries: []string{ServiceDefaults, ServiceDefaults},
+					},
+				},
+			},
+			validateErr: `Services[0]: ConfigEntries[1]: kind "service-defaults" is listed more than once`,
+		},
 	}
 
 	testConfigEntryNormalizeAndValidate(t, cases)
@@ -141,6 +141,12 @@ type GatewayTLSConfig struct {
 	// SDS allows configuring TLS certificate from an SDS service.
 	SDS *GatewayTLSSDSConfig `json:",omitempty"`
 
+	// ACMECertificate is the name of an acme-certificate config entry, in the
+	// gateway's namespace and partition, whose certificate is served instead
+	// of a Connect certificate or one from SDS. A listener that uses one is
+	// only started once the certificate has been issued.
+	ACMECertificate string `json:",omitempty" alias:"acme_certificate"`
+
 	TLSMinVersion types.TLSVersion `json:",omitempty" alias:"tls_min_version"`
 	TLSMaxVersion types.TLSVersion `json:",omitempty" alias:"tls_max_version"`
 
@@ -248,9 +254,13 @@ func (e *IngressGatewayConfigEntry) validateServiceSDS(lis IngressListener, svc
 		lisSDSClusterSet = (lis.TLS.SDS.ClusterName != "")
 	}
 
+	// A listener that serves an acme-certificate doesn't need the gateway's
+	// SDS config.
+	lisACMESet := lis.TLS != nil && lis.TLS.ACMECertificate != ""
+
 	// If SDS was setup at gw level but without a default CertResource, the
 	// listener MUST set a CertResource.
-	if gwSDSClusterSet && !gwSDSCertSet && !lisSDSCertSet {
+	if gwSDSClusterSet && !gwSDSCertSet && !lisSDSCertSet && !lisACMESet {
 		return fmt.Errorf("TLS.SDS.CertResource is required if ClusterName is set for gateway (listener on port %d)", lis.Port)
 	}
 
@@ -286,6 +296,9 @@ func (e *IngressGatewayConfigEntry) validateServiceSDS(lis IngressListener, svc
 }
 
 func validateGatewayTLSConfig(tlsCfg GatewayTLSConfig) error {
+	if tlsCfg.ACMECertificate != "" && tlsCfg.SDS != nil {
+		return fmt.Errorf("TLS.ACMECertificate cannot be set along with TLS.SDS")
+	}
 	return validateTLSConfig(tlsCfg.TLSMinVersion, tlsCfg.TLSMaxVersion, tlsCfg.CipherSuites)
 }
 
@@ -868,20 +881,26 @@ func (e *APIGatewayConfigEntry) validateListeners() error {
 	validProtocols := map[APIGatewayListenerProtocol]bool{
 		ListenerProtocolHTTP: true,
 		ListenerProtocolTCP:  true,
+		ListenerProtocolTLS:  true,
 	}
 	allowedCertificateKinds := map[string]bool{
 		FileSystemCertificate: true,
 		InlineCertificate:     true,
+		ACMECertificate:       true,
 	}
 
 	for _, listener := range e.Listeners {
 		if !validProtocols[listener.Protocol] {
-			return fmt.Errorf("unsupported listener protocol %q, must be one of 'tcp', or 'http'", listener.Protocol)
+			return fmt.Errorf("unsupported listener protocol %q, must be one of 'tcp', 'tls', or 'http'", listener.Protocol)
 		}
 		if listener.Protocol == ListenerProtocolTCP && listener.Hostname != "" {
 			// TODO: once we have SNI matching we should be able to implement this
 			return fmt.Errorf("hostname specification is not supported when using TCP")
 		}
+		if listener.Protocol == ListenerProtocolTLS && !listener.TLS.IsEmpty() {
+			// TLS listeners pass connections through without terminating them
+			return fmt.Errorf("TLS configuration is not supported when using TLS passthrough")
+		}
 		if listener.Port <= 0 || listener.Port > 65535 {
 			return fmt.Errorf("listener port %d not in the range 1-65535", listener.Port)
 		}
@@ -890,7 +909,7 @@ func (e *APIGatewayConfigEntry) validateListeners() error {
 		}
 		for _, certificate := range listener.TLS.Certificates {
 			if !allowedCertificateKinds[certificate.Kind] {
-				return fmt.Errorf("unsupported certificate kind: %q, must be 'file-system-certificate' or 'inline-certificate'", certificate.Kind)
+				return fmt.Errorf("unsupported certificate kind: %q, must be 'file-system-certificate', 'inline-certificate' or 'acme-certificate'", certificate.Kind)
 			}
 			if certificate.Name == "" {
 				return fmt.Errorf("certificate reference must have a name")
@@ -921,6 +940,9 @@ type APIGatewayListenerProtocol string
 const (
 	ListenerProtocolHTTP APIGatewayListenerProtocol = "http"
 	ListenerProtocolTCP  APIGatewayListenerProtocol = "tcp"
+	// ListenerProtocolTLS listeners route TLS connections by their SNI
+	// hostname without terminating them.
+	ListenerProtocolTLS APIGatewayListenerProtocol = "tls"
 )
 
 // APIGatewayListener represents an individual listener for an APIGateway
@@ -934,7 +956,7 @@ type APIGatewayListener struct {
 	// Port is the port at which this listener should bind.
 	Port int
 	// Protocol is the protocol that a listener should use. It must
-	// either be http or tcp.
+	// be one of http, tcp, or tls.
 	Protocol APIGatewayListenerProtocol
 	// TLS is the TLS settings for the listener.
 	TLS APIGatewayTLSConfiguration
@@ -1167,6 +1189,8 @@ func (e *BoundAPIGatewayConfigEntry) Validate() error {
 	allowedRouteKinds := map[string]bool{
 		HTTPRoute: true,
 		TCPRoute:  true,
+		GRPCRoute: true,
+		TLSRoute:  true,
 	}
 
 	// These should already be validated by upstream validation
@@ -1183,7 +1207,7 @@ func (e *BoundAPIGatewayConfigEntry) Validate() error {
 		}
 		for _, route := range listener.Routes {
 			if !allowedRouteKinds[route.Kind] {
-				return fmt.Errorf("unsupported route kind: %q, must be one of 'http-route', or 'tcp-route'", route.Kind)
+				return fmt.Errorf("unsupported route kind: %q, must be one of 'http-route', 'tcp-route', 'grpc-route', or 'tls-route'", route.Kind)
 			}
 			if route.Name == "" {
 				return fmt.Errorf("route reference must have a name")
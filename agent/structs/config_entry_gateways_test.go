@@ -670,6 +670,81 @@ func TestIngressGatewayConfigEntry(t *testing.T) {
 			},
 			expectUnchanged: true,
 		},
+		"TLS.ACMECertificate gateway-level": {
+			entry: &IngressGatewayConfigEntry{
+				Kind: "ingress-gateway",
+				Name: "ingress-web",
+				TLS: GatewayTLSConfig{
+					ACMECertificate: "web-cert",
+				},
+				Listeners: []IngressListener{
+					{
+						Port:     1111,
+						Protocol: "http",
+						Services: []IngressService{
+							{
+								Name:  "web",
+								Hosts: []string{"web.example.com"},
+							},
+						},
+					},
+				},
+			},
+			expectUnchanged: true,
+		},
+		"TLS.ACMECertificate listener-level with gateway-level SDS cluster only": {
+			entry: &IngressGatewayConfigEntry{
+				Kind: "ingress-gateway",
+				Name: "ingress-web",
+				TLS: GatewayTLSConfig{
+					SDS: &GatewayTLSSDSConfig{
+						ClusterName: "secret-service",
+					},
+				},
+				Listeners: []IngressListener{
+					{
+						Port:     1111,
+						Protocol: "http",
+						TLS: &GatewayTLSConfig{
+							ACMECertificate: "web-cert",
+						},
+						Services: []IngressService{
+							{
+								Name:  "web",
+								Hosts: []string{"web.example.com"},
+							},
+						},
+					},
+				},
+			},
+			expectUnchanged: true,
+		},
+		"TLS.ACMECertificate with SDS": {
+			entry: &IngressGatewayConfigEntry{
+				Kind: "ingress-gateway",
+				Name: "ingress-web",
+				Listeners: []IngressListener{
+					{
+						Port:     1111,
+						Protocol: "http",
+						TLS: &GatewayTLSConfig{
+							ACMECertificate: "web-cert",
+							SDS: &GatewayTLSSDSConfig{
+								ClusterName:  "secret-service",
+								CertResource: "some-ns/web",
+							},
+						},
+						Services: []IngressService{
+							{
+								Name:  "web",
+								Hosts: []string{"web.example.com"},
+							},
+						},
+					},
+				},
+			},
+			validateErr: "TLS.ACMECertificate cannot be set along with TLS.SDS",
+		},
 		"TLS.SDS mixed TLS and non-TLS listeners": {
 			entry: &IngressGatewayConfigEntry{
 				Kind: "ingress-gateway",
@@ -1250,6 +1325,27 @@ func TestAPIGateway_Listeners(t *testing.T) {
 			},
 			validateErr: "hostname specification is not supported",
 		},
+		"tls configuration on tls passthrough listener": {
+			entry: &APIGatewayConfigEntry{
+				Kind: "api-gateway",
+				Name: "api-gw-five",
+				Listeners: []APIGatewayListener{
+					{
+						Name:     "listener",
+						Port:     443,
+						Hostname: "*.example.com",
+						Protocol: ListenerProtocolTLS,
+						TLS: APIGatewayTLSConfiguration{
+							Certificates: []ResourceReference{{
+								Kind: InlineCertificate,
+								Name: "cert",
+							}},
+						},
+					},
+				},
+			},
+			validateErr: "TLS configuration is not supported when using TLS passthrough",
+		},
 		"invalid port": {
 			entry: &APIGatewayConfigEntry{
 				Kind: "api-gateway",
@@ -6,6 +6,7 @@ package structs
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 	"time"
 
@@ -91,14 +92,7 @@ func (e *HTTPRouteConfigEntry) GetServices() []HTTPService {
 }
 
 func (e *HTTPRouteConfigEntry) Normalize() error {
-	for i, parent := range e.Parents {
-		if parent.Kind == "" {
-			parent.Kind = APIGateway
-		}
-		parent.EnterpriseMeta.Merge(e.GetEnterpriseMeta())
-		parent.EnterpriseMeta.Normalize()
-		e.Parents[i] = parent
-	}
+	normalizeRouteParents(e.Parents, e.GetEnterpriseMeta())
 
 	for i, rule := range e.Rules {
 		for j, match := range rule.Matches {
@@ -106,7 +100,7 @@ func (e *HTTPRouteConfigEntry) Normalize() error {
 		}
 
 		for j, service := range rule.Services {
-			rule.Services[j] = e.normalizeHTTPService(service)
+			rule.Services[j] = normalizeHTTPService(service, e.GetEnterpriseMeta())
 		}
 		e.Rules[i] = rule
 	}
@@ -120,8 +114,8 @@ func (e *HTTPRouteConfigEntry) Normalize() error {
 	return nil
 }
 
-func (e *HTTPRouteConfigEntry) normalizeHTTPService(service HTTPService) HTTPService {
-	service.EnterpriseMeta.Merge(e.GetEnterpriseMeta())
+func normalizeHTTPService(service HTTPService, entMeta *acl.EnterpriseMeta) HTTPService {
+	service.EnterpriseMeta.Merge(entMeta)
 	service.EnterpriseMeta.Normalize()
 	if service.Weight <= 0 {
 		service.Weight = 1
@@ -144,7 +138,29 @@ func normalizeHTTPMatch(match HTTPMatch) HTTPMatch {
 }
 
 func (e *HTTPRouteConfigEntry) Validate() error {
-	for _, host := range e.Hostnames {
+	if err := validateRouteHostnames(e.Hostnames); err != nil {
+		return err
+	}
+
+	if err := validateRouteParents(e.Parents); err != nil {
+		return err
+	}
+
+	if err := validateConfigEntryMeta(e.Meta); err != nil {
+		return err
+	}
+
+	for i, rule := range e.Rules {
+		if err := validateRule(rule); err != nil {
+			return fmt.Errorf("Rule[%d], %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func validateRouteHostnames(hostnames []string) error {
+	for _, host := range hostnames {
 		// validate that each host referenced in a valid dns name and has
 		// no wildcards in it
 		if _, ok := dns.IsDomainName(host); !ok {
@@ -155,14 +171,17 @@ func (e *HTTPRouteConfigEntry) Validate() error {
 			return fmt.Errorf("host %q must not be a wildcard", host)
 		}
 	}
+	return nil
+}
 
+func validateRouteParents(parents []ResourceReference) error {
 	validParentKinds := map[string]bool{
 		APIGateway: true,
 	}
 
-	uniques := make(map[ResourceReference]struct{}, len(e.Parents))
+	uniques := make(map[ResourceReference]struct{}, len(parents))
 
-	for _, parent := range e.Parents {
+	for _, parent := range parents {
 		if !validParentKinds[parent.Kind] {
 			return fmt.Errorf("unsupported parent kind: %q, must be 'api-gateway'", parent.Kind)
 		}
@@ -172,18 +191,18 @@ func (e *HTTPRouteConfigEntry) Validate() error {
 		}
 		uniques[parent] = struct{}{}
 	}
+	return nil
+}
 
-	if err := validateConfigEntryMeta(e.Meta); err != nil {
-		return err
-	}
-
-	for i, rule := range e.Rules {
-		if err := validateRule(rule); err != nil {
-			return fmt.Errorf("Rule[%d], %w", i, err)
+func normalizeRouteParents(parents []ResourceReference, entMeta *acl.EnterpriseMeta) {
+	for i, parent := range parents {
+		if parent.Kind == "" {
+			parent.Kind = APIGateway
 		}
+		parent.EnterpriseMeta.Merge(entMeta)
+		parent.EnterpriseMeta.Normalize()
+		parents[i] = parent
 	}
-
-	return nil
 }
 
 func validateRule(rule HTTPRouteRule) error {
@@ -324,7 +343,13 @@ func (e *HTTPRouteConfigEntry) CanWrite(authz acl.Authorizer) error {
 }
 
 func (e *HTTPRouteConfigEntry) FilteredHostnames(listenerHostname string) []string {
-	if len(e.Hostnames) == 0 {
+	return filterRouteHostnames(e.Hostnames, listenerHostname)
+}
+
+// filterRouteHostnames returns the route hostnames that a listener with the
+// given hostname accepts. A route without hostnames inherits the listener's.
+func filterRouteHostnames(routeHostnames []string, listenerHostname string) []string {
+	if len(routeHostnames) == 0 {
 		// we have no hostnames specified here, so treat it like a wildcard
 		return []string{listenerHostname}
 	}
@@ -333,7 +358,7 @@ func (e *HTTPRouteConfigEntry) FilteredHostnames(listenerHostname string) []stri
 	listenerHostname = strings.TrimPrefix(strings.TrimPrefix(listenerHostname, "*"), ".")
 
 	hostnames := []string{}
-	for _, hostname := range e.Hostnames {
+	for _, hostname := range routeHostnames {
 		if wildcardHostname {
 			if strings.HasSuffix(hostname, listenerHostname) {
 				hostnames = append(hostnames, hostname)
@@ -601,14 +626,7 @@ func (e *TCPRouteConfigEntry) GetServiceNames() []ServiceName {
 func (e *TCPRouteConfigEntry) GetServices() []TCPService { return e.Services }
 
 func (e *TCPRouteConfigEntry) Normalize() error {
-	for i, parent := range e.Parents {
-		if parent.Kind == "" {
-			parent.Kind = APIGateway
-		}
-		parent.EnterpriseMeta.Merge(e.GetEnterpriseMeta())
-		parent.EnterpriseMeta.Normalize()
-		e.Parents[i] = parent
-	}
+	normalizeRouteParents(e.Parents, e.GetEnterpriseMeta())
 
 	for i, service := range e.Services {
 		service.EnterpriseMeta.Merge(e.GetEnterpriseMeta())
@@ -625,26 +643,12 @@ func (e *TCPRouteConfigEntry) Normalize() error {
 }
 
 func (e *TCPRouteConfigEntry) Validate() error {
-	validParentKinds := map[string]bool{
-		APIGateway: true,
-	}
-
 	if len(e.Services) > 1 {
 		return fmt.Errorf("tcp-route currently only supports one service")
 	}
 
-	uniques := make(map[ResourceReference]struct{}, len(e.Parents))
-
-	for _, parent := range e.Parents {
-		if !validParentKinds[parent.Kind] {
-			return fmt.Errorf("unsupported parent kind: %q, must be 'api-gateway'", parent.Kind)
-		}
-
-		if _, ok := uniques[parent]; ok {
-			return errors.New("route parents must be unique")
-		}
-		uniques[parent] = struct{}{}
-
+	if err := validateRouteParents(e.Parents); err != nil {
+		return err
 	}
 
 	if err := validateConfigEntryMeta(e.Meta); err != nil {
@@ -676,3 +680,438 @@ type TCPService struct {
 func (s TCPService) ServiceName() ServiceName {
 	return NewServiceName(s.Name, &s.EnterpriseMeta)
 }
+
+// GRPCRouteConfigEntry manages the configuration for a gRPC route
+// with the given name.
+type GRPCRouteConfigEntry struct {
+	// Kind of the config entry. This will be set to structs.GRPCRoute.
+	Kind string
+
+	// Name is used to match the config entry with its associated set
+	// of resources.
+	Name string
+
+	// Parents is a list of gateways that this route should be bound to
+	Parents []ResourceReference
+	// Rules are a list of gRPC-based routing rules that this route should
+	// use for constructing a routing table.
+	Rules []GRPCRouteRule
+	// Hostnames are the hostnames for which this GRPCRoute should respond to requests.
+	Hostnames []string
+
+	Meta map[string]string `json:",omitempty"`
+	// Status is the asynchronous reconciliation status which a GRPCRoute propagates to the user.
+	Status             Status
+	Hash               uint64 `json:",omitempty" hash:"ignore"`
+	acl.EnterpriseMeta `hcl:",squash" mapstructure:",squash"`
+	RaftIndex          `hash:"ignore"`
+}
+
+func (e *GRPCRouteConfigEntry) SetHash(h uint64) {
+	e.Hash = h
+}
+
+func (e *GRPCRouteConfigEntry) GetHash() uint64 {
+	return e.Hash
+}
+
+func (e *GRPCRouteConfigEntry) GetKind() string                        { return GRPCRoute }
+func (e *GRPCRouteConfigEntry) GetName() string                        { return e.Name }
+func (e *GRPCRouteConfigEntry) GetMeta() map[string]string             { return e.Meta }
+func (e *GRPCRouteConfigEntry) GetEnterpriseMeta() *acl.EnterpriseMeta { return &e.EnterpriseMeta }
+func (e *GRPCRouteConfigEntry) GetRaftIndex() *RaftIndex               { return &e.RaftIndex }
+
+var _ ControlledConfigEntry = (*GRPCRouteConfigEntry)(nil)
+
+func (e *GRPCRouteConfigEntry) GetStatus() Status       { return e.Status }
+func (e *GRPCRouteConfigEntry) SetStatus(status Status) { e.Status = status }
+func (e *GRPCRouteConfigEntry) DefaultStatus() Status   { return Status{} }
+
+var _ BoundRoute = (*GRPCRouteConfigEntry)(nil)
+
+func (e *GRPCRouteConfigEntry) GetParents() []ResourceReference { return e.Parents }
+
+// GetProtocol returns http since gRPC routes bind to the same listeners as
+// HTTP routes.
+func (e *GRPCRouteConfigEntry) GetProtocol() APIGatewayListenerProtocol { return ListenerProtocolHTTP }
+
+func (e *GRPCRouteConfigEntry) GetServiceNames() []ServiceName {
+	services := []ServiceName{}
+	for _, service := range e.GetServices() {
+		services = append(services, NewServiceName(service.Name, &service.EnterpriseMeta))
+	}
+	return services
+}
+
+func (e *GRPCRouteConfigEntry) GetServices() []HTTPService {
+	targets := []HTTPService{}
+	for _, rule := range e.Rules {
+		targets = append(targets, rule.Services...)
+	}
+	return targets
+}
+
+func (e *GRPCRouteConfigEntry) Normalize() error {
+	normalizeRouteParents(e.Parents, e.GetEnterpriseMeta())
+
+	for i, rule := range e.Rules {
+		for j, match := range rule.Matches {
+			if match.Method.Match == "" {
+				match.Method.Match = GRPCMethodMatchExact
+			}
+			rule.Matches[j] = match
+		}
+
+		for j, service := range rule.Services {
+			rule.Services[j] = normalizeHTTPService(service, e.GetEnterpriseMeta())
+		}
+		e.Rules[i] = rule
+	}
+
+	h, err := HashConfigEntry(e)
+	if err != nil {
+		return err
+	}
+	e.Hash = h
+
+	return nil
+}
+
+func (e *GRPCRouteConfigEntry) Validate() error {
+	if err := validateRouteHostnames(e.Hostnames); err != nil {
+		return err
+	}
+
+	if err := validateRouteParents(e.Parents); err != nil {
+		return err
+	}
+
+	if err := validateConfigEntryMeta(e.Meta); err != nil {
+		return err
+	}
+
+	for i, rule := range e.Rules {
+		if err := validateGRPCRule(rule); err != nil {
+			return fmt.Errorf("Rule[%d], %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func validateGRPCRule(rule GRPCRouteRule) error {
+	if err := validateFilters(rule.Filters); err != nil {
+		return err
+	}
+
+	for i, match := range rule.Matches {
+		if err := validateGRPCMatch(match); err != nil {
+			return fmt.Errorf("Match[%d], %w", i, err)
+		}
+	}
+
+	for i, service := range rule.Services {
+		if err := validateHTTPService(service); err != nil {
+			return fmt.Errorf("Service[%d], %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func validateGRPCMatch(match GRPCMatch) error {
+	for i, header := range match.Headers {
+		if err := validateHTTPHeaderMatch(header); err != nil {
+			return fmt.Errorf("Headers[%d], %w", i, err)
+		}
+	}
+
+	if err := validateGRPCMethodMatch(match.Method); err != nil {
+		return fmt.Errorf("Method, %w", err)
+	}
+
+	return nil
+}
+
+func validateGRPCMethodMatch(method GRPCMethodMatch) error {
+	switch method.Match {
+	case GRPCMethodMatchExact:
+		if strings.ContainsRune(method.Service, '/') || strings.ContainsRune(method.Method, '/') {
+			return fmt.Errorf("exact type match must not contain '/'")
+		}
+	case GRPCMethodMatchRegularExpression:
+		for _, expr := range []string{method.Service, method.Method} {
+			if _, err := regexp.Compile(expr); err != nil {
+				return fmt.Errorf("invalid regex %q: %w", expr, err)
+			}
+		}
+	default:
+		return fmt.Errorf("match type should be one of exact or regex")
+	}
+	return nil
+}
+
+func (e *GRPCRouteConfigEntry) CanRead(authz acl.Authorizer) error {
+	var authzContext acl.AuthorizerContext
+	e.FillAuthzContext(&authzContext)
+	return authz.ToAllowAuthorizer().MeshReadAllowed(&authzContext)
+}
+
+func (e *GRPCRouteConfigEntry) CanWrite(authz acl.Authorizer) error {
+	var authzContext acl.AuthorizerContext
+	e.FillAuthzContext(&authzContext)
+	return authz.ToAllowAuthorizer().MeshWriteAllowed(&authzContext)
+}
+
+func (e *GRPCRouteConfigEntry) FilteredHostnames(listenerHostname string) []string {
+	return filterRouteHostnames(e.Hostnames, listenerHostname)
+}
+
+// ToHTTPRoute returns the HTTPRoute equivalent to this GRPCRoute. gRPC
+// requests are HTTP/2 POSTs to "/<service>/<method>", so each method match
+// becomes a path match and every match additionally requires a gRPC
+// content-type. This lets gRPC routes share the routing tables of HTTP
+// routes bound to the same listener.
+func (e *GRPCRouteConfigEntry) ToHTTPRoute() *HTTPRouteConfigEntry {
+	route := &HTTPRouteConfigEntry{
+		Kind:           HTTPRoute,
+		Name:           e.Name,
+		Parents:        e.Parents,
+		Hostnames:      e.Hostnames,
+		Meta:           e.Meta,
+		Status:         e.Status,
+		Hash:           e.Hash,
+		EnterpriseMeta: e.EnterpriseMeta,
+		RaftIndex:      e.RaftIndex,
+	}
+
+	for _, rule := range e.Rules {
+		httpRule := HTTPRouteRule{
+			Filters:         rule.Filters,
+			ResponseFilters: rule.ResponseFilters,
+			Services:        rule.Services,
+		}
+
+		matches := rule.Matches
+		if len(matches) == 0 {
+			matches = []GRPCMatch{{Method: GRPCMethodMatch{Match: GRPCMethodMatchExact}}}
+		}
+		for _, match := range matches {
+			httpRule.Matches = append(httpRule.Matches, match.toHTTPMatch())
+		}
+
+		route.Rules = append(route.Rules, httpRule)
+	}
+
+	return route
+}
+
+// GRPCRouteRule specifies the routing rules used to determine what upstream
+// service a gRPC request is routed to.
+type GRPCRouteRule struct {
+	// Filters is a list of HTTP-based filters used to modify a request prior
+	// to routing it to the upstream service
+	Filters HTTPFilters
+	// ResponseFilters is a list of HTTP-based filters used to modify a response
+	// returned by the upstream service
+	ResponseFilters HTTPResponseFilters
+	// Matches specified the matching criteria used in the routing table. If a
+	// request matches the given GRPCMatch configuration, then traffic is routed
+	// to services specified in the Services field.
+	Matches []GRPCMatch
+	// Services is a list of services to route to if the request matches
+	// the rules specified in the Matches field.
+	Services []HTTPService
+}
+
+// GRPCMatch specifies the criteria that should be
+// used in determining whether or not a gRPC request should
+// be routed to a given set of services.
+type GRPCMatch struct {
+	Method  GRPCMethodMatch
+	Headers []HTTPHeaderMatch
+}
+
+func (m GRPCMatch) toHTTPMatch() HTTPMatch {
+	headers := make([]HTTPHeaderMatch, 0, len(m.Headers)+1)
+	headers = append(headers, HTTPHeaderMatch{
+		Match: HTTPHeaderMatchPrefix,
+		Name:  "content-type",
+		Value: "application/grpc",
+	})
+	headers = append(headers, m.Headers...)
+
+	return HTTPMatch{
+		Headers: headers,
+		Path:    m.Method.toHTTPPathMatch(),
+	}
+}
+
+// GRPCMethodMatchType specifies how method matching criteria
+// should be applied to a request.
+type GRPCMethodMatchType string
+
+const (
+	GRPCMethodMatchExact             GRPCMethodMatchType = "exact"
+	GRPCMethodMatchRegularExpression GRPCMethodMatchType = "regex"
+)
+
+// GRPCMethodMatch specifies how a match should be done on a request's
+// gRPC service and method. An empty Service or Method matches any value.
+type GRPCMethodMatch struct {
+	Match   GRPCMethodMatchType
+	Service string
+	Method  string
+}
+
+func (m GRPCMethodMatch) toHTTPPathMatch() HTTPPathMatch {
+	service, method := m.Service, m.Method
+	if m.Match != GRPCMethodMatchRegularExpression {
+		switch {
+		case service != "" && method != "":
+			return HTTPPathMatch{Match: HTTPPathMatchExact, Value: "/" + service + "/" + method}
+		case service != "":
+			return HTTPPathMatch{Match: HTTPPathMatchPrefix, Value: "/" + service + "/"}
+		case method == "":
+			return HTTPPathMatch{Match: HTTPPathMatchPrefix, Value: "/"}
+		}
+		method = regexp.QuoteMeta(method)
+	}
+
+	if service == "" {
+		service = "[^/]+"
+	}
+	if method == "" {
+		method = "[^/]+"
+	}
+	return HTTPPathMatch{Match: HTTPPathMatchRegularExpression, Value: "/" + service + "/" + method}
+}
+
+// TLSRouteConfigEntry manages the configuration for a TLS route
+// with the given name. Connections are routed on their SNI hostname
+// without being terminated by the gateway.
+type TLSRouteConfigEntry struct {
+	// Kind of the config entry. This will be set to structs.TLSRoute.
+	Kind string
+
+	// Name is used to match the config entry with its associated set
+	// of resources.
+	Name string
+
+	// Parents is a list of gateways that this route should be bound to
+	Parents []ResourceReference
+
+	// Hostnames are the SNI hostnames for which this TLSRoute should
+	// accept connections.
+	Hostnames []string
+
+	// Services is a list of TCP-based services that this should route to.
+	// Currently, this must specify at maximum one service.
+	Services []TCPService
+
+	Meta map[string]string `json:",omitempty"`
+	// Status is the asynchronous reconciliation status which a TLSRoute propagates to the user.
+	Status             Status
+	Hash               uint64 `json:",omitempty" hash:"ignore"`
+	acl.EnterpriseMeta `hcl:",squash" mapstructure:",squash"`
+	RaftIndex          `hash:"ignore"`
+}
+
+func (e *TLSRouteConfigEntry) SetHash(h uint64) {
+	e.Hash = h
+}
+
+func (e *TLSRouteConfigEntry) GetHash() uint64 {
+	return e.Hash
+}
+
+func (e *TLSRouteConfigEntry) GetKind() string                        { return TLSRoute }
+func (e *TLSRouteConfigEntry) GetName() string                        { return e.Name }
+func (e *TLSRouteConfigEntry) GetMeta() map[string]string             { return e.Meta }
+func (e *TLSRouteConfigEntry) GetRaftIndex() *RaftIndex               { return &e.RaftIndex }
+func (e *TLSRouteConfigEntry) GetEnterpriseMeta() *acl.EnterpriseMeta { return &e.EnterpriseMeta }
+
+var _ ControlledConfigEntry = (*TLSRouteConfigEntry)(nil)
+
+func (e *TLSRouteConfigEntry) GetStatus() Status       { return e.Status }
+func (e *TLSRouteConfigEntry) SetStatus(status Status) { e.Status = status }
+func (e *TLSRouteConfigEntry) DefaultStatus() Status   { return Status{} }
+
+var _ BoundRoute = (*TLSRouteConfigEntry)(nil)
+
+func (e *TLSRouteConfigEntry) GetParents() []ResourceReference         { return e.Parents }
+func (e *TLSRouteConfigEntry) GetProtocol() APIGatewayListenerProtocol { return ListenerProtocolTLS }
+
+func (e *TLSRouteConfigEntry) GetServiceNames() []ServiceName {
+	services := []ServiceName{}
+	for _, service := range e.Services {
+		services = append(services, NewServiceName(service.Name, &service.EnterpriseMeta))
+	}
+	return services
+}
+
+func (e *TLSRouteConfigEntry) GetServices() []TCPService { return e.Services }
+
+func (e *TLSRouteConfigEntry) Normalize() error {
+	normalizeRouteParents(e.Parents, e.GetEnterpriseMeta())
+
+	for i, hostname := range e.Hostnames {
+		e.Hostnames[i] = strings.ToLower(hostname)
+	}
+
+	for i, service := range e.Services {
+		service.EnterpriseMeta.Merge(e.GetEnterpriseMeta())
+		service.EnterpriseMeta.Normalize()
+		e.Services[i] = service
+	}
+
+	h, err := HashConfigEntry(e)
+	if err != nil {
+		return err
+	}
+	e.Hash = h
+	return nil
+}
+
+func (e *TLSRouteConfigEntry) Validate() error {
+	if len(e.Services) > 1 {
+		return fmt.Errorf("tls-route currently only supports one service")
+	}
+
+	for _, host := range e.Hostnames {
+		// SNI hostnames may use a wildcard as the left-most label
+		if _, ok := dns.IsDomainName(strings.TrimPrefix(host, wildcardPrefix)); !ok {
+			return fmt.Errorf("host %q must be a valid DNS hostname", host)
+		}
+
+		if strings.ContainsRune(strings.TrimPrefix(host, wildcardPrefix), '*') {
+			return fmt.Errorf("host %q is not valid, a wildcard specifier is only allowed as the left-most label", host)
+		}
+	}
+
+	if err := validateRouteParents(e.Parents); err != nil {
+		return err
+	}
+
+	if err := validateConfigEntryMeta(e.Meta); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (e *TLSRouteConfigEntry) CanRead(authz acl.Authorizer) error {
+	var authzContext acl.AuthorizerContext
+	e.FillAuthzContext(&authzContext)
+	return authz.ToAllowAuthorizer().MeshReadAllowed(&authzContext)
+}
+
+func (e *TLSRouteConfigEntry) CanWrite(authz acl.Authorizer) error {
+	var authzContext acl.AuthorizerContext
+	e.FillAuthzContext(&authzContext)
+	return authz.ToAllowAuthorizer().MeshWriteAllowed(&authzContext)
+}
+
+func (e *TLSRouteConfigEntry) FilteredHostnames(listenerHostname string) []string {
+	return filterRouteHostnames(e.Hostnames, listenerHostname)
+}
@@ -438,6 +438,214 @@ func TestHTTPRoute(t *testing.T) {
 	testConfigEntryNormalizeAndValidate(t, cases)
 }
 
+func TestGRPCRoute(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]configEntryTestcase{
+		"normalize method match type": {
+			entry: &GRPCRouteConfigEntry{
+				Kind: GRPCRoute,
+				Name: "route-one",
+				Rules: []GRPCRouteRule{{
+					Matches: []GRPCMatch{{
+						Method: GRPCMethodMatch{Service: "helloworld.Greeter"},
+					}},
+				}},
+			},
+			normalizeOnly: true,
+			check: func(t *testing.T, entry ConfigEntry) {
+				route := entry.(*GRPCRouteConfigEntry)
+				require.Equal(t, GRPCMethodMatchExact, route.Rules[0].Matches[0].Method.Match)
+			},
+		},
+		"exact match with path separator": {
+			entry: &GRPCRouteConfigEntry{
+				Kind: GRPCRoute,
+				Name: "route-two",
+				Rules: []GRPCRouteRule{{
+					Matches: []GRPCMatch{{
+						Method: GRPCMethodMatch{
+							Match:   GRPCMethodMatchExact,
+							Service: "helloworld.Greeter/SayHello",
+						},
+					}},
+				}},
+			},
+			validateErr: "Rule[0], Match[0], Method, exact type match must not contain '/'",
+		},
+		"invalid regex": {
+			entry: &GRPCRouteConfigEntry{
+				Kind: GRPCRoute,
+				Name: "route-two",
+				Rules: []GRPCRouteRule{{
+					Matches: []GRPCMatch{{
+						Method: GRPCMethodMatch{
+							Match:  GRPCMethodMatchRegularExpression,
+							Method: "Say(",
+						},
+					}},
+				}},
+			},
+			validateErr: "invalid regex",
+		},
+		"invalid match type": {
+			entry: &GRPCRouteConfigEntry{
+				Kind: GRPCRoute,
+				Name: "route-two",
+				Rules: []GRPCRouteRule{{
+					Matches: []GRPCMatch{{
+						Method: GRPCMethodMatch{
+							Match:  "prefix",
+							Method: "Say",
+						},
+					}},
+				}},
+			},
+			validateErr: "match type should be one of exact or regex",
+		},
+		"wildcard hostname": {
+			entry: &GRPCRouteConfigEntry{
+				Kind:      GRPCRoute,
+				Name:      "route-two",
+				Hostnames: []string{"*"},
+			},
+			validateErr: "host \"*\" must not be a wildcard",
+		},
+		"invalid parent kind": {
+			entry: &GRPCRouteConfigEntry{
+				Kind: GRPCRoute,
+				Name: "route-two",
+				Parents: []ResourceReference{{
+					Kind: "route",
+					Name: "gateway",
+				}},
+			},
+			validateErr: "unsupported parent kind",
+		},
+	}
+	testConfigEntryNormalizeAndValidate(t, cases)
+}
+
+func TestGRPCRoute_ToHTTPRoute(t *testing.T) {
+	grpcContentType := HTTPHeaderMatch{
+		Match: HTTPHeaderMatchPrefix,
+		Name:  "content-type",
+		Value: "application/grpc",
+	}
+
+	cases := map[string]struct {
+		method GRPCMethodMatch
+		path   HTTPPathMatch
+	}{
+		"any": {
+			method: GRPCMethodMatch{Match: GRPCMethodMatchExact},
+			path:   HTTPPathMatch{Match: HTTPPathMatchPrefix, Value: "/"},
+		},
+		"service": {
+			method: GRPCMethodMatch{Match: GRPCMethodMatchExact, Service: "helloworld.Greeter"},
+			path:   HTTPPathMatch{Match: HTTPPathMatchPrefix, Value: "/helloworld.Greeter/"},
+		},
+		"service and method": {
+			method: GRPCMethodMatch{Match: GRPCMethodMatchExact, Service: "helloworld.Greeter", Method: "SayHello"},
+			path:   HTTPPathMatch{Match: HTTPPathMatchExact, Value: "/helloworld.Greeter/SayHello"},
+		},
+		"method": {
+			method: GRPCMethodMatch{Match: GRPCMethodMatchExact, Method: "SayHello"},
+			path:   HTTPPathMatch{Match: HTTPPathMatchRegularExpression, Value: "/[^/]+/SayHello"},
+		},
+		"regex": {
+			method: GRPCMethodMatch{Match: GRPCMethodMatchRegularExpression, Service: "helloworld\\..*"},
+			path:   HTTPPathMatch{Match: HTTPPathMatchRegularExpression, Value: "/helloworld\\..*/[^/]+"},
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			route := &GRPCRouteConfigEntry{
+				Kind:      GRPCRoute,
+				Name:      "route",
+				Hostnames: []string{"example.com"},
+				Rules: []GRPCRouteRule{{
+					Matches:  []GRPCMatch{{Method: tc.method}},
+					Services: []HTTPService{{Name: "greeter"}},
+				}},
+			}
+
+			httpRoute := route.ToHTTPRoute()
+			require.Equal(t, HTTPRoute, httpRoute.Kind)
+			require.Equal(t, []string{"example.com"}, httpRoute.Hostnames)
+			require.Len(t, httpRoute.Rules, 1)
+			require.Equal(t, []HTTPMatch{{
+				Headers: []HTTPHeaderMatch{grpcContentType},
+				Path:    tc.path,
+			}}, httpRoute.Rules[0].Matches)
+			require.Equal(t, []HTTPService{{Name: "greeter"}}, httpRoute.Rules[0].Services)
+		})
+	}
+}
+
+func TestTLSRoute(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]configEntryTestcase{
+		"multiple services": {
+			entry: &TLSRouteConfigEntry{
+				Kind: TLSRoute,
+				Name: "route-one",
+				Services: []TCPService{{
+					Name: "foo",
+				}, {
+					Name: "bar",
+				}},
+			},
+			validateErr: "tls-route currently only supports one service",
+		},
+		"normalize hostnames": {
+			entry: &TLSRouteConfigEntry{
+				Kind:      TLSRoute,
+				Name:      "route-one",
+				Hostnames: []string{"DB.Example.com"},
+				Services:  []TCPService{{Name: "foo"}},
+			},
+			normalizeOnly: true,
+			check: func(t *testing.T, entry ConfigEntry) {
+				route := entry.(*TLSRouteConfigEntry)
+				require.Equal(t, []string{"db.example.com"}, route.Hostnames)
+			},
+		},
+		"leading wildcard hostname": {
+			entry: &TLSRouteConfigEntry{
+				Kind:      TLSRoute,
+				Name:      "route-one",
+				Hostnames: []string{"*.example.com"},
+				Services:  []TCPService{{Name: "foo"}},
+			},
+		},
+		"embedded wildcard hostname": {
+			entry: &TLSRouteConfigEntry{
+				Kind:      TLSRoute,
+				Name:      "route-one",
+				Hostnames: []string{"db.*.example.com"},
+				Services:  []TCPService{{Name: "foo"}},
+			},
+			validateErr: "a wildcard specifier is only allowed as the left-most label",
+		},
+		"duplicate parents": {
+			entry: &TLSRouteConfigEntry{
+				Kind: TLSRoute,
+				Name: "route-one",
+				Parents: []ResourceReference{
+					{Kind: APIGateway, Name: "gateway"},
+					{Kind: APIGateway, Name: "gateway"},
+				},
+			},
+			validateErr: "route parents must be unique",
+		},
+	}
+	testConfigEntryNormalizeAndValidate(t, cases)
+}
+
 func TestHTTPMatch_DeepEqual(t *testing.T) {
 	type fields struct {
 		Headers []HTTPHeaderMatch
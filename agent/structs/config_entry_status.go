@@ -233,6 +233,25 @@ func NewRouteCondition(name api.RouteConditionType, status api.ConditionStatus,
 	}
 }
 
+// NewCertificateCondition is a helper to build allowable Conditions for a
+// controller-issued certificate config entry
+func NewCertificateCondition(name api.CertificateConditionType, status api.ConditionStatus, reason api.CertificateConditionReason, message string, ref ResourceReference) Condition {
+	if err := api.ValidateCertificateConditionReason(name, status, reason); err != nil {
+		// note we panic here because an invalid combination is a programmer error
+		// this  should never actually be hit
+		panic(err)
+	}
+
+	return Condition{
+		Type:               string(name),
+		Status:             string(status),
+		Reason:             string(reason),
+		Message:            message,
+		Resource:           ptrTo(ref),
+		LastTransitionTime: ptrTo(time.Now().UTC()),
+	}
+}
+
 func ptrTo[T any](val T) *T {
 	return &val
 }
@@ -286,6 +286,33 @@ func TestDecodeConfigEntry_ServiceDefaults(t *testing.T) {
 				MaxInboundConnections: 14,
 			},
 		},
+		{
+			name: "service-defaults-with-LeafCert",
+			snake: `
+				kind = "service-defaults"
+				name = "web"
+				leaf_cert {
+					private_key_type = "ed25519"
+					ttl = "12h"
+				}
+			`,
+			camel: `
+				Kind = "service-defaults"
+				Name = "web"
+				LeafCert {
+					PrivateKeyType = "ed25519"
+					TTL = "12h"
+				}
+			`,
+			expect: &ServiceConfigEntry{
+				Kind: "service-defaults",
+				Name: "web",
+				LeafCert: &LeafCertConfig{
+					PrivateKeyType: "ed25519",
+					TTL:            12 * time.Hour,
+				},
+			},
+		},
 	} {
 		tc := tc
 
@@ -641,6 +668,15 @@ func TestDecodeConfigEntry(t *testing.T) {
 						  num_retries            = 12345
 						  retry_on_connect_failure = true
 						  retry_on_status_codes    = [401, 209]
+						  retry_base_interval      = "250ms"
+						  retry_max_interval       = "2s"
+						  per_try_timeout          = "3s"
+						  retriable_headers = [
+						    {
+						      name  = "x-retry"
+						      exact = "true"
+						    },
+						  ]
 							request_headers {
 								add {
 									x-foo = "bar"
@@ -659,6 +695,22 @@ func TestDecodeConfigEntry(t *testing.T) {
 								}
 								remove = ["qux"]
 							}
+							fault_injection {
+								delay {
+									fixed_delay = "2s"
+									percentage  = 12.5
+								}
+								abort {
+									http_status = 503
+									percentage  = 5
+								}
+								headers = [
+									{
+										name  = "x-fault"
+										exact = "true"
+									},
+								]
+							}
 						}
 					},
 					{
@@ -690,6 +742,23 @@ func TestDecodeConfigEntry(t *testing.T) {
 							}
 						}
 					},
+					{
+						match {
+							grpc {
+								service = "helloworld.Greeter"
+								method  = "SayHello"
+								metadata = [
+									{
+										name  = "x-tenant"
+										exact = "acme"
+									},
+								]
+							}
+						}
+						destination {
+							retry_on = ["unavailable", "cancelled"]
+						}
+					},
 				]
 			`,
 			camel: `
@@ -743,6 +812,15 @@ func TestDecodeConfigEntry(t *testing.T) {
 						  NumRetries            = 12345
 						  RetryOnConnectFailure = true
 						  RetryOnStatusCodes    = [401, 209]
+						  RetryBaseInterval     = "250ms"
+						  RetryMaxInterval      = "2s"
+						  PerTryTimeout         = "3s"
+						  RetriableHeaders = [
+						    {
+						      Name  = "x-retry"
+						      Exact = "true"
+						    },
+						  ]
 							RequestHeaders {
 								Add {
 									x-foo = "bar"
@@ -761,6 +839,22 @@ func TestDecodeConfigEntry(t *testing.T) {
 								}
 								Remove = ["qux"]
 							}
+							FaultInjection {
+								Delay {
+									FixedDelay = "2s"
+									Percentage = 12.5
+								}
+								Abort {
+									HTTPStatus = 503
+									Percentage = 5
+								}
+								Headers = [
+									{
+										Name  = "x-fault"
+										Exact = "true"
+									},
+								]
+							}
 						}
 					},
 					{
@@ -792,6 +886,23 @@ func TestDecodeConfigEntry(t *testing.T) {
 							}
 						}
 					},
+					{
+						Match {
+							GRPC {
+								Service = "helloworld.Greeter"
+								Method  = "SayHello"
+								Metadata = [
+									{
+										Name  = "x-tenant"
+										Exact = "acme"
+									},
+								]
+							}
+						}
+						Destination {
+							RetryOn = ["unavailable", "cancelled"]
+						}
+					},
 				]
 			`,
 			expect: &ServiceRouterConfigEntry{
@@ -845,6 +956,12 @@ func TestDecodeConfigEntry(t *testing.T) {
 							NumRetries:            12345,
 							RetryOnConnectFailure: true,
 							RetryOnStatusCodes:    []uint32{401, 209},
+							RetryBaseInterval:     250 * time.Millisecond,
+							RetryMaxInterval:      2 * time.Second,
+							PerTryTimeout:         3 * time.Second,
+							RetriableHeaders: []ServiceRouteHTTPMatchHeader{
+								{Name: "x-retry", Exact: "true"},
+							},
 							RequestHeaders: &HTTPHeaderModifiers{
 								Add:    map[string]string{"x-foo": "bar"},
 								Set:    map[string]string{"bar": "baz"},
@@ -855,6 +972,22 @@ func TestDecodeConfigEntry(t *testing.T) {
 								Set:    map[string]string{"bar": "baz"},
 								Remove: []string{"qux"},
 							},
+							FaultInjection: &ServiceRouteFaultInjection{
+								Delay: &FaultInjectionDelay{
+									FixedDelay: 2 * time.Second,
+									Percentage: 12.5,
+								},
+								Abort: &FaultInjectionAbort{
+									HTTPStatus: 503,
+									Percentage: 5,
+								},
+								Headers: []ServiceRouteHTTPMatchHeader{
+									{
+										Name:  "x-fault",
+										Exact: "true",
+									},
+								},
+							},
 						},
 					},
 					{
@@ -886,6 +1019,23 @@ func TestDecodeConfigEntry(t *testing.T) {
 							},
 						},
 					},
+					{
+						Match: &ServiceRouteMatch{
+							GRPC: &ServiceRouteGRPCMatch{
+								Service: "helloworld.Greeter",
+								Method:  "SayHello",
+								Metadata: []ServiceRouteHTTPMatchHeader{
+									{
+										Name:  "x-tenant",
+										Exact: "acme",
+									},
+								},
+							},
+						},
+						Destination: &ServiceRouteDestination{
+							RetryOn: []string{"unavailable", "cancelled"},
+						},
+					},
 				},
 			},
 		},
@@ -1229,6 +1379,11 @@ func TestDecodeConfigEntry(t *testing.T) {
 						weight    = 0.9
 						service   = "other"
 						namespace = "alt"
+						mirror {
+							service        = "shadow"
+							service_subset = "v2"
+							percentage     = 50
+						}
 				  },
 				]
 			`,
@@ -1266,6 +1421,11 @@ func TestDecodeConfigEntry(t *testing.T) {
 						Weight    = 0.9
 						Service   = "other"
 						Namespace = "alt"
+						Mirror {
+							Service       = "shadow"
+							ServiceSubset = "v2"
+							Percentage    = 50
+						}
 				  },
 				]
 			`,
@@ -1295,6 +1455,11 @@ func TestDecodeConfigEntry(t *testing.T) {
 						Weight:    0.9,
 						Service:   "other",
 						Namespace: "alt",
+						Mirror: &MirrorPolicy{
+							Service:       "shadow",
+							ServiceSubset: "v2",
+							Percentage:    50,
+						},
 					},
 				},
 			},
@@ -1423,6 +1588,69 @@ func TestDecodeConfigEntry(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "service-resolver: retry policy",
+			snake: `
+				kind = "service-resolver"
+				name = "main"
+				retry_policy {
+					num_retries         = 3
+					retry_on            = ["reset", "5xx"]
+					retry_base_interval = "100ms"
+					retry_max_interval  = "1s"
+					per_try_timeout     = "2s"
+					retriable_headers = [
+						{
+							name    = "x-retry"
+							present = true
+						},
+					]
+					retry_budget {
+						budget_percent        = 25.5
+						min_retry_concurrency = 5
+					}
+				}
+			`,
+			camel: `
+				Kind = "service-resolver"
+				Name = "main"
+				RetryPolicy {
+					NumRetries        = 3
+					RetryOn           = ["reset", "5xx"]
+					RetryBaseInterval = "100ms"
+					RetryMaxInterval  = "1s"
+					PerTryTimeout     = "2s"
+					RetriableHeaders = [
+						{
+							Name    = "x-retry"
+							Present = true
+						},
+					]
+					RetryBudget {
+						BudgetPercent       = 25.5
+						MinRetryConcurrency = 5
+					}
+				}
+			`,
+			expect: &ServiceResolverConfigEntry{
+				Kind: "service-resolver",
+				Name: "main",
+				RetryPolicy: &ServiceResolverRetryPolicy{
+					NumRetries:        3,
+					RetryOn:           []string{"reset", "5xx"},
+					RetryBaseInterval: 100 * time.Millisecond,
+					RetryMaxInterval:  time.Second,
+					PerTryTimeout:     2 * time.Second,
+					RetriableHeaders: []ServiceRouteHTTPMatchHeader{
+						{Name: "x-retry", Present: true},
+					},
+					RetryBudget: &RetryBudget{
+						BudgetPercent:       25.5,
+						MinRetryConcurrency: 5,
+					},
+				},
+			},
+		},
 		{
 			name: "service-resolver: default",
 			snake: `
@@ -2348,6 +2576,45 @@ func TestDecodeConfigEntry(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "acme-certificate",
+			snake: `
+				kind = "acme-certificate"
+				name = "foo"
+				directory_url = "https://acme.example.com/directory"
+				email = "admin@example.com"
+				hostnames = ["example.com", "www.example.com"]
+				renew_before = "168h"
+				meta {
+					"foo" = "bar"
+					"gir" = "zim"
+				}
+			`,
+			camel: `
+				Kind = "acme-certificate"
+				Name = "foo"
+				DirectoryURL = "https://acme.example.com/directory"
+				Email = "admin@example.com"
+				Hostnames = ["example.com", "www.example.com"]
+				RenewBefore = "168h"
+				Meta {
+					"foo" = "bar"
+					"gir" = "zim"
+				}
+			`,
+			expect: &ACMECertificateConfigEntry{
+				Kind:         "acme-certificate",
+				Name:         "foo",
+				DirectoryURL: "https://acme.example.com/directory",
+				Email:        "admin@example.com",
+				Hostnames:    []string{"example.com", "www.example.com"},
+				RenewBefore:  168 * time.Hour,
+				Meta: map[string]string{
+					"foo": "bar",
+					"gir": "zim",
+				},
+			},
+		},
 		{
 			name: "http-route",
 			snake: `
@@ -2506,6 +2773,50 @@ func TestDecodeConfigEntry(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "exported-services with key prefixes",
+			snake: `
+				kind = "exported-services"
+				name = "default"
+				key_prefixes = [
+					{
+						prefix = "app/config/"
+						consumers = [
+							{
+								peer = "flarm"
+							}
+						]
+					}
+				]
+			`,
+			camel: `
+				Kind = "exported-services"
+				Name = "default"
+				KeyPrefixes = [
+					{
+						Prefix = "app/config/"
+						Consumers = [
+							{
+								Peer = "flarm"
+							}
+						]
+					}
+				]
+			`,
+			expect: &ExportedServicesConfigEntry{
+				Name: "default",
+				KeyPrefixes: []ExportedKeyPrefix{
+					{
+						Prefix: "app/config/",
+						Consumers: []ServiceConsumer{
+							{
+								Peer: "flarm",
+							},
+						},
+					},
+				},
+			},
+		},
 	} {
 		tc := tc
 
@@ -3279,6 +3590,27 @@ func TestServiceConfigEntry(t *testing.T) {
 			},
 			validateErr: `Invalid MutualTLSMode "invalid-mtls-mode". Must be one of "", "strict", or "permissive".`,
 		},
+		"validate: redis Protocol in service-defaults": {
+			entry: &ServiceConfigEntry{
+				Kind:     ServiceDefaults,
+				Name:     "cache",
+				Protocol: "redis",
+			},
+		},
+		"validate: postgres Protocol in service-defaults": {
+			entry: &ServiceConfigEntry{
+				Kind:     ServiceDefaults,
+				Name:     "db",
+				Protocol: "postgres",
+			},
+		},
+		"validate: kafka Protocol in service-defaults": {
+			entry: &ServiceConfigEntry{
+				Kind:     ServiceDefaults,
+				Name:     "events",
+				Protocol: "kafka",
+			},
+		},
 		"validate: invalid Protocol in service-defaults": {
 			entry: &ServiceConfigEntry{
 				Kind:     ServiceDefaults,
@@ -3287,10 +3619,79 @@ func TestServiceConfigEntry(t *testing.T) {
 			},
 			validateErr: `invalid value for protocol: blah`,
 		},
+		"validate: ed25519 leaf cert": {
+			entry: &ServiceConfigEntry{
+				Kind: ServiceDefaults,
+				Name: "web",
+				LeafCert: &LeafCertConfig{
+					PrivateKeyType: "ed25519",
+					TTL:            12 * time.Hour,
+				},
+			},
+		},
+		"validate: leaf cert key bits without type": {
+			entry: &ServiceConfigEntry{
+				Kind:     ServiceDefaults,
+				Name:     "web",
+				LeafCert: &LeafCertConfig{PrivateKeyBits: 384},
+			},
+			validateErr: `PrivateKeyBits requires PrivateKeyType to be set`,
+		},
+		"validate: invalid leaf cert key type": {
+			entry: &ServiceConfigEntry{
+				Kind:     ServiceDefaults,
+				Name:     "web",
+				LeafCert: &LeafCertConfig{PrivateKeyType: "dsa"},
+			},
+			validateErr: `invalid PrivateKeyType "dsa"`,
+		},
+		"validate: ed25519 leaf cert with key bits": {
+			entry: &ServiceConfigEntry{
+				Kind:     ServiceDefaults,
+				Name:     "web",
+				LeafCert: &LeafCertConfig{PrivateKeyType: "ed25519", PrivateKeyBits: 256},
+			},
+			validateErr: `PrivateKeyBits must not be set for Ed25519 keys`,
+		},
+		"validate: short RSA leaf cert key": {
+			entry: &ServiceConfigEntry{
+				Kind:     ServiceDefaults,
+				Name:     "web",
+				LeafCert: &LeafCertConfig{PrivateKeyType: "rsa", PrivateKeyBits: 1024},
+			},
+			validateErr: `RSA key length must be at least 2048 bits`,
+		},
+		"validate: leaf cert TTL too short": {
+			entry: &ServiceConfigEntry{
+				Kind:     ServiceDefaults,
+				Name:     "web",
+				LeafCert: &LeafCertConfig{TTL: time.Minute},
+			},
+			validateErr: `TTL must be between 1h0m0s and 8760h0m0s`,
+		},
 	}
 	testConfigEntryNormalizeAndValidate(t, cases)
 }
 
+func TestLeafCertConfig_KeyTypeAndBits(t *testing.T) {
+	cases := []struct {
+		conf         *LeafCertConfig
+		expectedType string
+		expectedBits int
+	}{
+		{conf: nil, expectedType: "ec", expectedBits: 256},
+		{conf: &LeafCertConfig{TTL: time.Hour}, expectedType: "ec", expectedBits: 256},
+		{conf: &LeafCertConfig{PrivateKeyType: "ec", PrivateKeyBits: 384}, expectedType: "ec", expectedBits: 384},
+		{conf: &LeafCertConfig{PrivateKeyType: "rsa"}, expectedType: "rsa", expectedBits: 4096},
+		{conf: &LeafCertConfig{PrivateKeyType: "ed25519"}, expectedType: "ed25519", expectedBits: 0},
+	}
+	for _, tc := range cases {
+		keyType, keyBits := tc.conf.KeyTypeAndBits()
+		require.Equal(t, tc.expectedType, keyType)
+		require.Equal(t, tc.expectedBits, keyBits)
+	}
+}
+
 func TestUpstreamConfig_MergeInto(t *testing.T) {
 	tt := []struct {
 		name        string
@@ -55,6 +55,17 @@ type IndexedCARoots struct {
 	// Roots is a list of root CA certs to trust.
 	Roots []*CARoot
 
+	// RevokedCerts is the list of leaf certificates revoked in the local
+	// datacenter that have not expired yet.
+	RevokedCerts []*CARevokedCert `json:",omitempty"`
+
+	// RevocationLists are the certificate revocation lists proxies use to
+	// reject revoked leaf certificates. Once a CRL is configured, Envoy
+	// rejects every certificate whose issuer doesn't have one, so this is
+	// only populated when a certificate is revoked and a CRL is available
+	// for the leaf signing cert of every datacenter.
+	RevocationLists []*CARevocationList `json:",omitempty"`
+
 	// QueryMeta contains the meta sent via a header. We ignore for JSON
 	// so this whole structure can be returned.
 	QueryMeta `json:"-"`
@@ -69,6 +80,16 @@ func (r IndexedCARoots) Active() *CARoot {
 	return nil
 }
 
+// IsRevoked returns true if the given leaf certificate has been revoked.
+func (r IndexedCARoots) IsRevoked(cert *IssuedCert) bool {
+	for _, revoked := range r.RevokedCerts {
+		if revoked.Matches(cert) {
+			return true
+		}
+	}
+	return false
+}
+
 // CARoot represents a root CA certificate that is trusted.
 type CARoot struct {
 	// ID is a globally unique ID (UUID) representing this CA chain. It is
@@ -190,6 +211,10 @@ type CASignRequest struct {
 	// CSR is the PEM-encoded CSR.
 	CSR string
 
+	// Node is the name of the node requesting the certificate. It's only
+	// recorded in the inventory of issued certificates.
+	Node string `json:",omitempty"`
+
 	// WriteRequest is a common struct containing ACL tokens and other
 	// write-related common elements for requests.
 	WriteRequest
@@ -235,6 +260,13 @@ type IssuedCert struct {
 	// KindURI is the cert URI value.
 	KindURI string `json:",omitempty"`
 
+	// Node is the name of the node that requested the cert, if known.
+	Node string `json:",omitempty"`
+
+	// SigningKeyID is the connect.HexString encoded id of the key of the
+	// leaf signing cert that issued the cert. It matches CARoot.SigningKeyID.
+	SigningKeyID string `json:",omitempty"`
+
 	// ValidAfter and ValidBefore are the validity periods for the
 	// certificate.
 	ValidAfter  time.Time
@@ -252,6 +284,152 @@ func (i *IssuedCert) Key() string {
 	)
 }
 
+// URI returns the SPIFFE ID the cert was issued for.
+func (i *IssuedCert) URI() string {
+	for _, uri := range []string{i.ServiceURI, i.AgentURI, i.ServerURI, i.KindURI} {
+		if uri != "" {
+			return uri
+		}
+	}
+	return ""
+}
+
+// IndexedIssuedCerts is the inventory of the leaf certificates issued in a
+// datacenter that have not expired yet.
+type IndexedIssuedCerts struct {
+	Certs []*IssuedCert
+
+	QueryMeta `json:"-"`
+}
+
+// CACertsRequest is the request for listing the issued leaf certificates.
+type CACertsRequest struct {
+	// Datacenter is the datacenter that issued the certificates.
+	Datacenter string
+
+	// ExpiringWithin only returns the certificates that expire within the
+	// given duration when it's not zero.
+	ExpiringWithin time.Duration
+
+	QueryOptions
+}
+
+// RequestDatacenter returns the datacenter for a given request.
+func (r *CACertsRequest) RequestDatacenter() string {
+	return r.Datacenter
+}
+
+// CARevokedCert is a leaf certificate, or all the leaf certificates of an
+// identity, that an operator revoked before they expired.
+type CARevokedCert struct {
+	// SerialNumber is the colon-hex encoded serial number of the revoked
+	// certificate. It is empty when every certificate issued to SpiffeID
+	// before the revocation is revoked.
+	SerialNumber string `json:",omitempty"`
+
+	// SpiffeID is the URI SAN of the revoked certificates.
+	SpiffeID string `json:",omitempty"`
+
+	// Reason is a human readable explanation for the revocation.
+	Reason string `json:",omitempty"`
+
+	// RevokedAt is the time the certificate was revoked.
+	RevokedAt time.Time
+
+	// ExpiresAt is the time after which no certificate matching this
+	// revocation can still be valid, so the revocation can be dropped.
+	ExpiresAt time.Time
+
+	RaftIndex
+}
+
+// ID returns the unique identifier of the revocation in the state store.
+func (c *CARevokedCert) ID() string {
+	if c.SerialNumber != "" {
+		return c.SerialNumber
+	}
+	return c.SpiffeID
+}
+
+// Matches returns true if the given leaf certificate is covered by the
+// revocation. Revocations of an identity apply to the certificates that were
+// issued before the revocation was committed.
+func (c *CARevokedCert) Matches(cert *IssuedCert) bool {
+	if cert == nil {
+		return false
+	}
+	if c.SerialNumber != "" {
+		return c.SerialNumber == cert.SerialNumber
+	}
+	if cert.CreateIndex >= c.CreateIndex {
+		return false
+	}
+	for _, uri := range []string{cert.ServiceURI, cert.AgentURI, cert.ServerURI, cert.KindURI} {
+		if uri != "" && uri == c.SpiffeID {
+			return true
+		}
+	}
+	return false
+}
+
+// CARevocationList is a certificate revocation list signed by a leaf signing
+// certificate.
+type CARevocationList struct {
+	// Datacenter is the datacenter whose leaf signing cert signed the list.
+	Datacenter string
+
+	// SigningKeyID is the connect.HexString encoded id of the key that
+	// signed the list. It matches CARoot.SigningKeyID of the leaf signing
+	// cert. It is empty for placeholders of datacenters that don't publish
+	// a revocation list.
+	SigningKeyID string `json:",omitempty"`
+
+	// RevocationListPEM is the PEM encoded list.
+	RevocationListPEM string `json:",omitempty"`
+
+	// NumRevoked is the number of certificates on the list.
+	NumRevoked int
+
+	// RevokedIndex is the raft index of the revoked certificates the list
+	// was signed for.
+	RevokedIndex uint64 `json:",omitempty"`
+
+	// ThisUpdate and NextUpdate are the validity period of the list.
+	ThisUpdate time.Time
+	NextUpdate time.Time
+}
+
+// ID returns the unique identifier of the list in the state store.
+func (l *CARevocationList) ID() string {
+	return l.Datacenter + "/" + l.SigningKeyID
+}
+
+// CARevokeRequest is the request for revoking leaf certificates.
+type CARevokeRequest struct {
+	// Datacenter is the datacenter that issued the certificates.
+	Datacenter string
+
+	// SerialNumber is the serial number of the certificate to revoke. Both
+	// colon-hex and plain hex encodings are accepted.
+	SerialNumber string
+
+	// SpiffeID revokes every certificate issued to the identity so far
+	// when SerialNumber is empty, or identifies the certificate otherwise.
+	SpiffeID string
+
+	// Reason is an optional human readable explanation for the revocation.
+	Reason string
+
+	// WriteRequest is a common struct containing ACL tokens and other
+	// write-related common elements for requests.
+	WriteRequest
+}
+
+// RequestDatacenter returns the datacenter for a given request.
+func (q *CARevokeRequest) RequestDatacenter() string {
+	return q.Datacenter
+}
+
 // CAOp is the operation for a request related to intentions.
 type CAOp string
 
@@ -262,6 +440,9 @@ const (
 	CAOpDeleteProviderState           CAOp = "delete-provider-state"
 	CAOpSetRootsAndConfig             CAOp = "set-roots-config"
 	CAOpIncrementProviderSerialNumber CAOp = "increment-provider-serial"
+	CAOpRevokeCerts                   CAOp = "revoke-certs"
+	CAOpDeleteRevokedCerts            CAOp = "delete-revoked-certs"
+	CAOpSetRevocationLists            CAOp = "set-revocation-lists"
 )
 
 // CARequest is used to modify connect CA data. This is used by the
@@ -274,7 +455,8 @@ type CARequest struct {
 	// Datacenter is the target for this request.
 	Datacenter string
 
-	// Index is used by CAOpSetRoots and CAOpSetConfig for a CAS operation.
+	// Index is used by CAOpSetRoots, CAOpSetConfig and CAOpSetRevocationLists
+	// for a CAS operation.
 	Index uint64
 
 	// Roots is a list of roots. This is used for CAOpSet. One root must
@@ -287,6 +469,13 @@ type CARequest struct {
 	// ProviderState is the state for the builtin CA provider.
 	ProviderState *CAConsulProviderState
 
+	// RevokedCerts is used by CAOpRevokeCerts and CAOpDeleteRevokedCerts.
+	RevokedCerts []*CARevokedCert
+
+	// RevocationLists replaces all the revocation lists for
+	// CAOpSetRevocationLists.
+	RevocationLists []*CARevocationList
+
 	// WriteRequest is a common struct containing ACL tokens and other
 	// write-related common elements for requests.
 	WriteRequest
@@ -298,9 +487,10 @@ func (q *CARequest) RequestDatacenter() string {
 }
 
 const (
-	ConsulCAProvider = "consul"
-	VaultCAProvider  = "vault"
-	AWSCAProvider    = "aws-pca"
+	ConsulCAProvider         = "consul"
+	VaultCAProvider          = "vault"
+	AWSCAProvider            = "aws-pca"
+	ExternalSignerCAProvider = "external-signer"
 )
 
 // CAConfiguration is the configuration for the current CA plugin.
@@ -568,11 +758,84 @@ type AWSCAProviderConfig struct {
 	DeleteOnExit bool
 }
 
+// ExternalSignerCAProviderConfig is the configuration of the external signer
+// CA provider. The root private key is never stored by Consul: intermediates
+// are signed through the configured Signer and only intermediate keys are
+// kept in the state store.
+type ExternalSignerCAProviderConfig struct {
+	CommonCAProviderConfig `mapstructure:",squash"`
+
+	// RootCert is the PEM encoded certificate of the offline root CA. It is
+	// required in the primary datacenter.
+	RootCert string
+
+	// CrossSignedRootCert is RootCert signed by the private key of the
+	// previous root. Consul can't cross-sign a new root with an offline key,
+	// so operators supply it when rotating to a new root.
+	CrossSignedRootCert string
+
+	// Signer is the type of signer holding the root private key, either
+	// "file" or "pkcs11".
+	Signer string
+
+	// RootKeyFile is the path to the PEM encoded root private key on the
+	// servers when Signer is "file".
+	RootKeyFile string
+
+	// PKCS11Module is the path to the PKCS#11 library, such as SoftHSM's
+	// libsofthsm2.so, when Signer is "pkcs11".
+	PKCS11Module string
+
+	// PKCS11TokenLabel is the label of the token holding the root key.
+	PKCS11TokenLabel string
+
+	// PKCS11PINFile is the path to a file on the servers holding the user
+	// PIN of the token. The PIN itself is never stored in the state store.
+	PKCS11PINFile string
+
+	// PKCS11KeyLabel is the label of the root private key on the token.
+	PKCS11KeyLabel string
+}
+
+// Validate checks that the settings required by the signer are present.
+// Secondary datacenters get their intermediates signed by the primary so
+// they don't need a root or a signer.
+func (c *ExternalSignerCAProviderConfig) Validate(isPrimary bool) error {
+	if !isPrimary {
+		return nil
+	}
+	if c.RootCert == "" {
+		return fmt.Errorf("must provide the root certificate in the primary datacenter")
+	}
+
+	switch c.Signer {
+	case "file":
+		if c.RootKeyFile == "" {
+			return fmt.Errorf("must provide RootKeyFile for the file signer")
+		}
+	case "pkcs11":
+		if c.PKCS11Module == "" {
+			return fmt.Errorf("must provide PKCS11Module for the pkcs11 signer")
+		}
+		if c.PKCS11TokenLabel == "" {
+			return fmt.Errorf("must provide PKCS11TokenLabel for the pkcs11 signer")
+		}
+		if c.PKCS11KeyLabel == "" {
+			return fmt.Errorf("must provide PKCS11KeyLabel for the pkcs11 signer")
+		}
+	default:
+		return fmt.Errorf("signer must be either 'file' or 'pkcs11'")
+	}
+	return nil
+}
+
 // CALeafOp is the operation for a request related to leaf certificates.
 type CALeafOp string
 
 const (
 	CALeafOpIncrementIndex CALeafOp = "increment-index"
+	CALeafOpSetCert        CALeafOp = "set-cert"
+	CALeafOpDeleteCerts    CALeafOp = "delete-certs"
 )
 
 // CALeafRequest is used to modify connect CA leaf data. This is used by the
@@ -585,6 +848,14 @@ type CALeafRequest struct {
 	// Datacenter is the target for this request.
 	Datacenter string
 
+	// Cert is the issued cert recorded by CALeafOpSetCert. The raft index
+	// of the operation is used as the ModifyIndex of the cert.
+	Cert *IssuedCert `json:",omitempty"`
+
+	// SerialNumbers are the serial numbers of the certs removed by
+	// CALeafOpDeleteCerts.
+	SerialNumbers []string `json:",omitempty"`
+
 	// WriteRequest is a common struct containing ACL tokens and other
 	// write-related common elements for requests.
 	WriteRequest
@@ -166,3 +166,48 @@ func TestCAProviderConfig_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestCARevokedCert_Matches(t *testing.T) {
+	const web = "spiffe://11111111-2222-3333-4444-555555555555.consul/ns/default/dc/dc1/svc/web"
+	cert := &IssuedCert{
+		SerialNumber: "0a:0b",
+		ServiceURI:   web,
+		RaftIndex:    RaftIndex{CreateIndex: 10},
+	}
+
+	tests := []struct {
+		name    string
+		revoked CARevokedCert
+		want    bool
+	}{
+		{
+			name:    "same serial",
+			revoked: CARevokedCert{SerialNumber: "0a:0b"},
+			want:    true,
+		},
+		{
+			name:    "other serial",
+			revoked: CARevokedCert{SerialNumber: "0a:0c", SpiffeID: web, RaftIndex: RaftIndex{CreateIndex: 20}},
+		},
+		{
+			name:    "identity revoked after issuance",
+			revoked: CARevokedCert{SpiffeID: web, RaftIndex: RaftIndex{CreateIndex: 20}},
+			want:    true,
+		},
+		{
+			name:    "identity revoked before issuance",
+			revoked: CARevokedCert{SpiffeID: web, RaftIndex: RaftIndex{CreateIndex: 5}},
+		},
+		{
+			name:    "other identity",
+			revoked: CARevokedCert{SpiffeID: web + "-admin", RaftIndex: RaftIndex{CreateIndex: 20}},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, tc.revoked.Matches(cert))
+			roots := IndexedCARoots{RevokedCerts: []*CARevokedCert{&tc.revoked}}
+			require.Equal(t, tc.want, roots.IsRevoked(cert))
+		})
+	}
+}
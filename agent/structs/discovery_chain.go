@@ -79,6 +79,22 @@ func (c *CompiledDiscoveryChain) CompoundServiceName() ServiceName {
 	return NewServiceName(c.ServiceName, &entMeta)
 }
 
+// HasFaultInjection returns true if any route in the chain injects faults.
+func (c *CompiledDiscoveryChain) HasFaultInjection() bool {
+	if c == nil {
+		return false
+	}
+	for _, node := range c.Nodes {
+		for _, route := range node.Routes {
+			if route.Definition != nil && route.Definition.Destination != nil &&
+				route.Definition.Destination.FaultInjection != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 const (
 	DiscoveryGraphNodeTypeRouter   = "router"
 	DiscoveryGraphNodeTypeSplitter = "splitter"
@@ -126,6 +142,8 @@ type DiscoveryResolver struct {
 	RequestTimeout time.Duration      `json:",omitempty"`
 	Target         string             `json:",omitempty"`
 	Failover       *DiscoveryFailover `json:",omitempty"`
+
+	RetryPolicy *ServiceResolverRetryPolicy `json:",omitempty"`
 }
 
 func (r *DiscoveryResolver) MarshalJSON() ([]byte, error) {
@@ -168,6 +186,10 @@ func (r *DiscoveryResolver) UnmarshalJSON(data []byte) error {
 type DiscoveryRoute struct {
 	Definition *ServiceRoute `json:",omitempty"`
 	NextNode   string        `json:",omitempty"`
+
+	// MirrorNode is the resolver node of the shadow service that requests
+	// matching the route are mirrored to, if the route has a mirror policy.
+	MirrorNode string `json:",omitempty"`
 }
 
 // compiled form of ServiceSplit
@@ -181,6 +203,10 @@ type DiscoverySplit struct {
 	// to that leg of the split.
 	Weight   float32 `json:",omitempty"`
 	NextNode string  `json:",omitempty"`
+
+	// MirrorNode is the resolver node of the shadow service that requests
+	// sent to this split are mirrored to, if the split has a mirror policy.
+	MirrorNode string `json:",omitempty"`
 }
 
 // compiled form of ServiceResolverFailover
@@ -59,6 +59,10 @@ type FederationState struct {
 	// this datacenter.
 	MeshGateways CheckServiceNodes `json:",omitempty"`
 
+	// RevocationLists are the certificate revocation lists signed by the leaf
+	// signing certs of this datacenter.
+	RevocationLists []*CARevocationList `json:",omitempty"`
+
 	// UpdatedAt keeps track of when this record was modified.
 	UpdatedAt time.Time
 
@@ -80,6 +84,16 @@ func (c *FederationState) IsSame(other *FederationState) bool {
 	// We don't include the UpdatedAt field in this comparison because that is
 	// only updated when we re-persist.
 
+	if len(c.RevocationLists) != len(other.RevocationLists) {
+		return false
+	}
+	for i := 0; i < len(c.RevocationLists); i++ {
+		if c.RevocationLists[i].ID() != other.RevocationLists[i].ID() ||
+			c.RevocationLists[i].RevocationListPEM != other.RevocationLists[i].RevocationListPEM {
+			return false
+		}
+	}
+
 	if len(c.MeshGateways) != len(other.MeshGateways) {
 		return false
 	}
@@ -20,6 +20,17 @@ type PeeringTokenRemote struct {
 	Locality   *Locality
 }
 
+// PeeredKVPrefix is the reserved KV prefix under which entries replicated from
+// peers are stored. Entries imported from a peer are stored under
+// PeeredKVPrefixForPeer and cannot be modified through the KV endpoints.
+const PeeredKVPrefix = "_peer/"
+
+// PeeredKVPrefixForPeer returns the KV prefix under which entries replicated
+// from the named peer are stored.
+func PeeredKVPrefixForPeer(peerName string) string {
+	return PeeredKVPrefix + peerName + "/"
+}
+
 type IndexedExportedServiceList struct {
 	Services map[string]ServiceList
 	QueryMeta
@@ -1,4 +1,4 @@
-// Code generated by deep-copy -pointer-receiver -o ./structs.deepcopy.go -type APIGatewayListener -type BoundAPIGatewayListener -type CARoot -type CheckServiceNode -type CheckType -type CompiledDiscoveryChain -type ConnectProxyConfig -type DiscoveryFailover -type DiscoveryGraphNode -type DiscoveryResolver -type DiscoveryRoute -type DiscoverySplit -type ExposeConfig -type ExportedServicesConfigEntry -type FileSystemCertificateConfigEntry -type GatewayService -type GatewayServiceTLSConfig -type HTTPHeaderModifiers -type HTTPRouteConfigEntry -type HashPolicy -type HealthCheck -type IndexedCARoots -type IngressListener -type InlineCertificateConfigEntry -type Intention -type IntentionPermission -type LoadBalancer -type MeshConfigEntry -type MeshDirectionalTLSConfig -type MeshTLSConfig -type Node -type NodeService -type PeeringServiceMeta -type ServiceConfigEntry -type ServiceConfigResponse -type ServiceConnect -type ServiceDefinition -type ServiceResolverConfigEntry -type ServiceResolverFailover -type ServiceRoute -type ServiceRouteDestination -type ServiceRouteMatch -type ServiceSpecificRequest -type TCPRouteConfigEntry -type Upstream -type UpstreamConfiguration -type Status -type BoundAPIGatewayConfigEntry ./; DO NOT EDIT.
+// Code generated by deep-copy -pointer-receiver -o ./structs.deepcopy.go -type APIGatewayListener -type BoundAPIGatewayListener -type CARoot -type CheckServiceNode -type CheckType -type CompiledDiscoveryChain -type ConnectProxyConfig -type DiscoveryFailover -type DiscoveryGraphNode -type DiscoveryResolver -type DiscoveryRoute -type DiscoverySplit -type ExposeConfig -type ExportedServicesConfigEntry -type FileSystemCertificateConfigEntry -type GatewayService -type GatewayServiceTLSConfig -type GRPCRouteConfigEntry -type HTTPHeaderModifiers -type HTTPRouteConfigEntry -type HashPolicy -type HealthCheck -type IndexedCARoots -type IngressListener -type InlineCertificateConfigEntry -type Intention -type IntentionPermission -type LoadBalancer -type MeshConfigEntry -type MeshDirectionalTLSConfig -type MeshTLSConfig -type Node -type NodeService -type PeeringServiceMeta -type ServiceConfigEntry -type ServiceConfigResponse -type ServiceConnect -type ServiceDefinition -type ServiceResolverConfigEntry -type ServiceResolverFailover -type ServiceRoute -type ServiceRouteDestination -type ServiceRouteMatch -type ServiceSpecificRequest -type TCPRouteConfigEntry -type TLSRouteConfigEntry -type Upstream -type UpstreamConfiguration -type Status -type BoundAPIGatewayConfigEntry ./; DO NOT EDIT.
 
 package structs
 
@@ -7,6 +7,30 @@ import (
 	"time"
 )
 
+// DeepCopy generates a deep copy of *ACMECertificateConfigEntry
+func (o *ACMECertificateConfigEntry) DeepCopy() *ACMECertificateConfigEntry {
+	var cp ACMECertificateConfigEntry = *o
+	if o.Hostnames != nil {
+		cp.Hostnames = make([]string, len(o.Hostnames))
+		copy(cp.Hostnames, o.Hostnames)
+	}
+	if o.Challenges != nil {
+		cp.Challenges = make([]ACMEChallenge, len(o.Challenges))
+		copy(cp.Challenges, o.Challenges)
+	}
+	if o.Meta != nil {
+		cp.Meta = make(map[string]string, len(o.Meta))
+		for k2, v2 := range o.Meta {
+			cp.Meta[k2] = v2
+		}
+	}
+	{
+		retV := o.Status.DeepCopy()
+		cp.Status = *retV
+	}
+	return &cp
+}
+
 // DeepCopy generates a deep copy of *APIGatewayListener
 func (o *APIGatewayListener) DeepCopy() *APIGatewayListener {
 	var cp APIGatewayListener = *o
@@ -258,6 +282,26 @@ func (o *DiscoveryResolver) DeepCopy() *DiscoveryResolver {
 	if o.Failover != nil {
 		cp.Failover = o.Failover.DeepCopy()
 	}
+	if o.RetryPolicy != nil {
+		cp.RetryPolicy = new(ServiceResolverRetryPolicy)
+		*cp.RetryPolicy = *o.RetryPolicy
+		if o.RetryPolicy.RetryOn != nil {
+			cp.RetryPolicy.RetryOn = make([]string, len(o.RetryPolicy.RetryOn))
+			copy(cp.RetryPolicy.RetryOn, o.RetryPolicy.RetryOn)
+		}
+		if o.RetryPolicy.RetryOnStatusCodes != nil {
+			cp.RetryPolicy.RetryOnStatusCodes = make([]uint32, len(o.RetryPolicy.RetryOnStatusCodes))
+			copy(cp.RetryPolicy.RetryOnStatusCodes, o.RetryPolicy.RetryOnStatusCodes)
+		}
+		if o.RetryPolicy.RetriableHeaders != nil {
+			cp.RetryPolicy.RetriableHeaders = make([]ServiceRouteHTTPMatchHeader, len(o.RetryPolicy.RetriableHeaders))
+			copy(cp.RetryPolicy.RetriableHeaders, o.RetryPolicy.RetriableHeaders)
+		}
+		if o.RetryPolicy.RetryBudget != nil {
+			cp.RetryPolicy.RetryBudget = new(RetryBudget)
+			*cp.RetryPolicy.RetryBudget = *o.RetryPolicy.RetryBudget
+		}
+	}
 	return &cp
 }
 
@@ -282,6 +326,10 @@ func (o *DiscoverySplit) DeepCopy() *DiscoverySplit {
 		if o.Definition.ResponseHeaders != nil {
 			cp.Definition.ResponseHeaders = o.Definition.ResponseHeaders.DeepCopy()
 		}
+		if o.Definition.Mirror != nil {
+			cp.Definition.Mirror = new(MirrorPolicy)
+			*cp.Definition.Mirror = *o.Definition.Mirror
+		}
 	}
 	return &cp
 }
@@ -307,6 +355,20 @@ func (o *ExportedServicesConfigEntry) DeepCopy() *ExportedServicesConfigEntry {
 				cp.Services[i2].Consumers = make([]ServiceConsumer, len(o.Services[i2].Consumers))
 				copy(cp.Services[i2].Consumers, o.Services[i2].Consumers)
 			}
+			if o.Services[i2].ConfigEntries != nil {
+				cp.Services[i2].ConfigEntries = make([]string, len(o.Services[i2].ConfigEntries))
+				copy(cp.Services[i2].ConfigEntries, o.Services[i2].ConfigEntries)
+			}
+		}
+	}
+	if o.KeyPrefixes != nil {
+		cp.KeyPrefixes = make([]ExportedKeyPrefix, len(o.KeyPrefixes))
+		copy(cp.KeyPrefixes, o.KeyPrefixes)
+		for i2 := range o.KeyPrefixes {
+			if o.KeyPrefixes[i2].Consumers != nil {
+				cp.KeyPrefixes[i2].Consumers = make([]ServiceConsumer, len(o.KeyPrefixes[i2].Consumers))
+				copy(cp.KeyPrefixes[i2].Consumers, o.KeyPrefixes[i2].Consumers)
+			}
 		}
 	}
 	if o.Meta != nil {
@@ -350,6 +412,186 @@ func (o *GatewayServiceTLSConfig) DeepCopy() *GatewayServiceTLSConfig {
 	return &cp
 }
 
+// DeepCopy generates a deep copy of *GRPCRouteConfigEntry
+func (o *GRPCRouteConfigEntry) DeepCopy() *GRPCRouteConfigEntry {
+	var cp GRPCRouteConfigEntry = *o
+	if o.Parents != nil {
+		cp.Parents = make([]ResourceReference, len(o.Parents))
+		copy(cp.Parents, o.Parents)
+	}
+	if o.Rules != nil {
+		cp.Rules = make([]GRPCRouteRule, len(o.Rules))
+		copy(cp.Rules, o.Rules)
+		for i2 := range o.Rules {
+			if o.Rules[i2].Filters.Headers != nil {
+				cp.Rules[i2].Filters.Headers = make([]HTTPHeaderFilter, len(o.Rules[i2].Filters.Headers))
+				copy(cp.Rules[i2].Filters.Headers, o.Rules[i2].Filters.Headers)
+				for i5 := range o.Rules[i2].Filters.Headers {
+					if o.Rules[i2].Filters.Headers[i5].Add != nil {
+						cp.Rules[i2].Filters.Headers[i5].Add = make(map[string]string, len(o.Rules[i2].Filters.Headers[i5].Add))
+						for k7, v7 := range o.Rules[i2].Filters.Headers[i5].Add {
+							cp.Rules[i2].Filters.Headers[i5].Add[k7] = v7
+						}
+					}
+					if o.Rules[i2].Filters.Headers[i5].Remove != nil {
+						cp.Rules[i2].Filters.Headers[i5].Remove = make([]string, len(o.Rules[i2].Filters.Headers[i5].Remove))
+						copy(cp.Rules[i2].Filters.Headers[i5].Remove, o.Rules[i2].Filters.Headers[i5].Remove)
+					}
+					if o.Rules[i2].Filters.Headers[i5].Set != nil {
+						cp.Rules[i2].Filters.Headers[i5].Set = make(map[string]string, len(o.Rules[i2].Filters.Headers[i5].Set))
+						for k7, v7 := range o.Rules[i2].Filters.Headers[i5].Set {
+							cp.Rules[i2].Filters.Headers[i5].Set[k7] = v7
+						}
+					}
+				}
+			}
+			if o.Rules[i2].Filters.URLRewrite != nil {
+				cp.Rules[i2].Filters.URLRewrite = new(URLRewrite)
+				*cp.Rules[i2].Filters.URLRewrite = *o.Rules[i2].Filters.URLRewrite
+			}
+			if o.Rules[i2].Filters.RetryFilter != nil {
+				cp.Rules[i2].Filters.RetryFilter = new(RetryFilter)
+				*cp.Rules[i2].Filters.RetryFilter = *o.Rules[i2].Filters.RetryFilter
+				if o.Rules[i2].Filters.RetryFilter.RetryOn != nil {
+					cp.Rules[i2].Filters.RetryFilter.RetryOn = make([]string, len(o.Rules[i2].Filters.RetryFilter.RetryOn))
+					copy(cp.Rules[i2].Filters.RetryFilter.RetryOn, o.Rules[i2].Filters.RetryFilter.RetryOn)
+				}
+				if o.Rules[i2].Filters.RetryFilter.RetryOnStatusCodes != nil {
+					cp.Rules[i2].Filters.RetryFilter.RetryOnStatusCodes = make([]uint32, len(o.Rules[i2].Filters.RetryFilter.RetryOnStatusCodes))
+					copy(cp.Rules[i2].Filters.RetryFilter.RetryOnStatusCodes, o.Rules[i2].Filters.RetryFilter.RetryOnStatusCodes)
+				}
+			}
+			if o.Rules[i2].Filters.TimeoutFilter != nil {
+				cp.Rules[i2].Filters.TimeoutFilter = new(TimeoutFilter)
+				*cp.Rules[i2].Filters.TimeoutFilter = *o.Rules[i2].Filters.TimeoutFilter
+			}
+			if o.Rules[i2].Filters.JWT != nil {
+				cp.Rules[i2].Filters.JWT = o.Rules[i2].Filters.JWT.DeepCopy()
+			}
+			if o.Rules[i2].ResponseFilters.Headers != nil {
+				cp.Rules[i2].ResponseFilters.Headers = make([]HTTPHeaderFilter, len(o.Rules[i2].ResponseFilters.Headers))
+				copy(cp.Rules[i2].ResponseFilters.Headers, o.Rules[i2].ResponseFilters.Headers)
+				for i5 := range o.Rules[i2].ResponseFilters.Headers {
+					if o.Rules[i2].ResponseFilters.Headers[i5].Add != nil {
+						cp.Rules[i2].ResponseFilters.Headers[i5].Add = make(map[string]string, len(o.Rules[i2].ResponseFilters.Headers[i5].Add))
+						for k7, v7 := range o.Rules[i2].ResponseFilters.Headers[i5].Add {
+							cp.Rules[i2].ResponseFilters.Headers[i5].Add[k7] = v7
+						}
+					}
+					if o.Rules[i2].ResponseFilters.Headers[i5].Remove != nil {
+						cp.Rules[i2].ResponseFilters.Headers[i5].Remove = make([]string, len(o.Rules[i2].ResponseFilters.Headers[i5].Remove))
+						copy(cp.Rules[i2].ResponseFilters.Headers[i5].Remove, o.Rules[i2].ResponseFilters.Headers[i5].Remove)
+					}
+					if o.Rules[i2].ResponseFilters.Headers[i5].Set != nil {
+						cp.Rules[i2].ResponseFilters.Headers[i5].Set = make(map[string]string, len(o.Rules[i2].ResponseFilters.Headers[i5].Set))
+						for k7, v7 := range o.Rules[i2].ResponseFilters.Headers[i5].Set {
+							cp.Rules[i2].ResponseFilters.Headers[i5].Set[k7] = v7
+						}
+					}
+				}
+			}
+			if o.Rules[i2].Matches != nil {
+				cp.Rules[i2].Matches = make([]GRPCMatch, len(o.Rules[i2].Matches))
+				copy(cp.Rules[i2].Matches, o.Rules[i2].Matches)
+				for i4 := range o.Rules[i2].Matches {
+					if o.Rules[i2].Matches[i4].Headers != nil {
+						cp.Rules[i2].Matches[i4].Headers = make([]HTTPHeaderMatch, len(o.Rules[i2].Matches[i4].Headers))
+						copy(cp.Rules[i2].Matches[i4].Headers, o.Rules[i2].Matches[i4].Headers)
+					}
+				}
+			}
+			if o.Rules[i2].Services != nil {
+				cp.Rules[i2].Services = make([]HTTPService, len(o.Rules[i2].Services))
+				copy(cp.Rules[i2].Services, o.Rules[i2].Services)
+				for i4 := range o.Rules[i2].Services {
+					if o.Rules[i2].Services[i4].Filters.Headers != nil {
+						cp.Rules[i2].Services[i4].Filters.Headers = make([]HTTPHeaderFilter, len(o.Rules[i2].Services[i4].Filters.Headers))
+						copy(cp.Rules[i2].Services[i4].Filters.Headers, o.Rules[i2].Services[i4].Filters.Headers)
+						for i7 := range o.Rules[i2].Services[i4].Filters.Headers {
+							if o.Rules[i2].Services[i4].Filters.Headers[i7].Add != nil {
+								cp.Rules[i2].Services[i4].Filters.Headers[i7].Add = make(map[string]string, len(o.Rules[i2].Services[i4].Filters.Headers[i7].Add))
+								for k9, v9 := range o.Rules[i2].Services[i4].Filters.Headers[i7].Add {
+									cp.Rules[i2].Services[i4].Filters.Headers[i7].Add[k9] = v9
+								}
+							}
+							if o.Rules[i2].Services[i4].Filters.Headers[i7].Remove != nil {
+								cp.Rules[i2].Services[i4].Filters.Headers[i7].Remove = make([]string, len(o.Rules[i2].Services[i4].Filters.Headers[i7].Remove))
+								copy(cp.Rules[i2].Services[i4].Filters.Headers[i7].Remove, o.Rules[i2].Services[i4].Filters.Headers[i7].Remove)
+							}
+							if o.Rules[i2].Services[i4].Filters.Headers[i7].Set != nil {
+								cp.Rules[i2].Services[i4].Filters.Headers[i7].Set = make(map[string]string, len(o.Rules[i2].Services[i4].Filters.Headers[i7].Set))
+								for k9, v9 := range o.Rules[i2].Services[i4].Filters.Headers[i7].Set {
+									cp.Rules[i2].Services[i4].Filters.Headers[i7].Set[k9] = v9
+								}
+							}
+						}
+					}
+					if o.Rules[i2].Services[i4].Filters.URLRewrite != nil {
+						cp.Rules[i2].Services[i4].Filters.URLRewrite = new(URLRewrite)
+						*cp.Rules[i2].Services[i4].Filters.URLRewrite = *o.Rules[i2].Services[i4].Filters.URLRewrite
+					}
+					if o.Rules[i2].Services[i4].Filters.RetryFilter != nil {
+						cp.Rules[i2].Services[i4].Filters.RetryFilter = new(RetryFilter)
+						*cp.Rules[i2].Services[i4].Filters.RetryFilter = *o.Rules[i2].Services[i4].Filters.RetryFilter
+						if o.Rules[i2].Services[i4].Filters.RetryFilter.RetryOn != nil {
+							cp.Rules[i2].Services[i4].Filters.RetryFilter.RetryOn = make([]string, len(o.Rules[i2].Services[i4].Filters.RetryFilter.RetryOn))
+							copy(cp.Rules[i2].Services[i4].Filters.RetryFilter.RetryOn, o.Rules[i2].Services[i4].Filters.RetryFilter.RetryOn)
+						}
+						if o.Rules[i2].Services[i4].Filters.RetryFilter.RetryOnStatusCodes != nil {
+							cp.Rules[i2].Services[i4].Filters.RetryFilter.RetryOnStatusCodes = make([]uint32, len(o.Rules[i2].Services[i4].Filters.RetryFilter.RetryOnStatusCodes))
+							copy(cp.Rules[i2].Services[i4].Filters.RetryFilter.RetryOnStatusCodes, o.Rules[i2].Services[i4].Filters.RetryFilter.RetryOnStatusCodes)
+						}
+					}
+					if o.Rules[i2].Services[i4].Filters.TimeoutFilter != nil {
+						cp.Rules[i2].Services[i4].Filters.TimeoutFilter = new(TimeoutFilter)
+						*cp.Rules[i2].Services[i4].Filters.TimeoutFilter = *o.Rules[i2].Services[i4].Filters.TimeoutFilter
+					}
+					if o.Rules[i2].Services[i4].Filters.JWT != nil {
+						cp.Rules[i2].Services[i4].Filters.JWT = o.Rules[i2].Services[i4].Filters.JWT.DeepCopy()
+					}
+					if o.Rules[i2].Services[i4].ResponseFilters.Headers != nil {
+						cp.Rules[i2].Services[i4].ResponseFilters.Headers = make([]HTTPHeaderFilter, len(o.Rules[i2].Services[i4].ResponseFilters.Headers))
+						copy(cp.Rules[i2].Services[i4].ResponseFilters.Headers, o.Rules[i2].Services[i4].ResponseFilters.Headers)
+						for i7 := range o.Rules[i2].Services[i4].ResponseFilters.Headers {
+							if o.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Add != nil {
+								cp.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Add = make(map[string]string, len(o.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Add))
+								for k9, v9 := range o.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Add {
+									cp.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Add[k9] = v9
+								}
+							}
+							if o.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Remove != nil {
+								cp.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Remove = make([]string, len(o.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Remove))
+								copy(cp.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Remove, o.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Remove)
+							}
+							if o.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Set != nil {
+								cp.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Set = make(map[string]string, len(o.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Set))
+								for k9, v9 := range o.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Set {
+									cp.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Set[k9] = v9
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	if o.Hostnames != nil {
+		cp.Hostnames = make([]string, len(o.Hostnames))
+		copy(cp.Hostnames, o.Hostnames)
+	}
+	if o.Meta != nil {
+		cp.Meta = make(map[string]string, len(o.Meta))
+		for k2, v2 := range o.Meta {
+			cp.Meta[k2] = v2
+		}
+	}
+	{
+		retV := o.Status.DeepCopy()
+		cp.Status = *retV
+	}
+	return &cp
+}
+
 // DeepCopy generates a deep copy of *HTTPHeaderModifiers
 func (o *HTTPHeaderModifiers) DeepCopy() *HTTPHeaderModifiers {
 	var cp HTTPHeaderModifiers = *o
@@ -603,6 +845,26 @@ func (o *IndexedCARoots) DeepCopy() *IndexedCARoots {
 			}
 		}
 	}
+	if o.RevokedCerts != nil {
+		cp.RevokedCerts = make([]*CARevokedCert, len(o.RevokedCerts))
+		copy(cp.RevokedCerts, o.RevokedCerts)
+		for i2 := range o.RevokedCerts {
+			if o.RevokedCerts[i2] != nil {
+				cp.RevokedCerts[i2] = new(CARevokedCert)
+				*cp.RevokedCerts[i2] = *o.RevokedCerts[i2]
+			}
+		}
+	}
+	if o.RevocationLists != nil {
+		cp.RevocationLists = make([]*CARevocationList, len(o.RevocationLists))
+		copy(cp.RevocationLists, o.RevocationLists)
+		for i2 := range o.RevocationLists {
+			if o.RevocationLists[i2] != nil {
+				cp.RevocationLists[i2] = new(CARevocationList)
+				*cp.RevocationLists[i2] = *o.RevocationLists[i2]
+			}
+		}
+	}
 	return &cp
 }
 
@@ -965,6 +1227,10 @@ func (o *ServiceConfigEntry) DeepCopy() *ServiceConfigEntry {
 			}
 		}
 	}
+	if o.LeafCert != nil {
+		cp.LeafCert = new(LeafCertConfig)
+		*cp.LeafCert = *o.LeafCert
+	}
 	if o.Meta != nil {
 		cp.Meta = make(map[string]string, len(o.Meta))
 		for k2, v2 := range o.Meta {
@@ -1117,6 +1383,26 @@ func (o *ServiceResolverConfigEntry) DeepCopy() *ServiceResolverConfigEntry {
 		cp.PrioritizeByLocality = new(ServiceResolverPrioritizeByLocality)
 		*cp.PrioritizeByLocality = *o.PrioritizeByLocality
 	}
+	if o.RetryPolicy != nil {
+		cp.RetryPolicy = new(ServiceResolverRetryPolicy)
+		*cp.RetryPolicy = *o.RetryPolicy
+		if o.RetryPolicy.RetryOn != nil {
+			cp.RetryPolicy.RetryOn = make([]string, len(o.RetryPolicy.RetryOn))
+			copy(cp.RetryPolicy.RetryOn, o.RetryPolicy.RetryOn)
+		}
+		if o.RetryPolicy.RetryOnStatusCodes != nil {
+			cp.RetryPolicy.RetryOnStatusCodes = make([]uint32, len(o.RetryPolicy.RetryOnStatusCodes))
+			copy(cp.RetryPolicy.RetryOnStatusCodes, o.RetryPolicy.RetryOnStatusCodes)
+		}
+		if o.RetryPolicy.RetriableHeaders != nil {
+			cp.RetryPolicy.RetriableHeaders = make([]ServiceRouteHTTPMatchHeader, len(o.RetryPolicy.RetriableHeaders))
+			copy(cp.RetryPolicy.RetriableHeaders, o.RetryPolicy.RetriableHeaders)
+		}
+		if o.RetryPolicy.RetryBudget != nil {
+			cp.RetryPolicy.RetryBudget = new(RetryBudget)
+			*cp.RetryPolicy.RetryBudget = *o.RetryPolicy.RetryBudget
+		}
+	}
 	if o.LoadBalancer != nil {
 		cp.LoadBalancer = o.LoadBalancer.DeepCopy()
 	}
@@ -1174,12 +1460,36 @@ func (o *ServiceRouteDestination) DeepCopy() *ServiceRouteDestination {
 		cp.RetryOnStatusCodes = make([]uint32, len(o.RetryOnStatusCodes))
 		copy(cp.RetryOnStatusCodes, o.RetryOnStatusCodes)
 	}
+	if o.RetriableHeaders != nil {
+		cp.RetriableHeaders = make([]ServiceRouteHTTPMatchHeader, len(o.RetriableHeaders))
+		copy(cp.RetriableHeaders, o.RetriableHeaders)
+	}
 	if o.RequestHeaders != nil {
 		cp.RequestHeaders = o.RequestHeaders.DeepCopy()
 	}
 	if o.ResponseHeaders != nil {
 		cp.ResponseHeaders = o.ResponseHeaders.DeepCopy()
 	}
+	if o.FaultInjection != nil {
+		cp.FaultInjection = new(ServiceRouteFaultInjection)
+		*cp.FaultInjection = *o.FaultInjection
+		if o.FaultInjection.Delay != nil {
+			cp.FaultInjection.Delay = new(FaultInjectionDelay)
+			*cp.FaultInjection.Delay = *o.FaultInjection.Delay
+		}
+		if o.FaultInjection.Abort != nil {
+			cp.FaultInjection.Abort = new(FaultInjectionAbort)
+			*cp.FaultInjection.Abort = *o.FaultInjection.Abort
+		}
+		if o.FaultInjection.Headers != nil {
+			cp.FaultInjection.Headers = make([]ServiceRouteHTTPMatchHeader, len(o.FaultInjection.Headers))
+			copy(cp.FaultInjection.Headers, o.FaultInjection.Headers)
+		}
+	}
+	if o.Mirror != nil {
+		cp.Mirror = new(MirrorPolicy)
+		*cp.Mirror = *o.Mirror
+	}
 	return &cp
 }
 
@@ -1202,6 +1512,14 @@ func (o *ServiceRouteMatch) DeepCopy() *ServiceRouteMatch {
 			copy(cp.HTTP.Methods, o.HTTP.Methods)
 		}
 	}
+	if o.GRPC != nil {
+		cp.GRPC = new(ServiceRouteGRPCMatch)
+		*cp.GRPC = *o.GRPC
+		if o.GRPC.Metadata != nil {
+			cp.GRPC.Metadata = make([]ServiceRouteHTTPMatchHeader, len(o.GRPC.Metadata))
+			copy(cp.GRPC.Metadata, o.GRPC.Metadata)
+		}
+	}
 	return &cp
 }
 
@@ -1245,6 +1563,34 @@ func (o *TCPRouteConfigEntry) DeepCopy() *TCPRouteConfigEntry {
 	return &cp
 }
 
+// DeepCopy generates a deep copy of *TLSRouteConfigEntry
+func (o *TLSRouteConfigEntry) DeepCopy() *TLSRouteConfigEntry {
+	var cp TLSRouteConfigEntry = *o
+	if o.Parents != nil {
+		cp.Parents = make([]ResourceReference, len(o.Parents))
+		copy(cp.Parents, o.Parents)
+	}
+	if o.Hostnames != nil {
+		cp.Hostnames = make([]string, len(o.Hostnames))
+		copy(cp.Hostnames, o.Hostnames)
+	}
+	if o.Services != nil {
+		cp.Services = make([]TCPService, len(o.Services))
+		copy(cp.Services, o.Services)
+	}
+	if o.Meta != nil {
+		cp.Meta = make(map[string]string, len(o.Meta))
+		for k2, v2 := range o.Meta {
+			cp.Meta[k2] = v2
+		}
+	}
+	{
+		retV := o.Status.DeepCopy()
+		cp.Status = *retV
+	}
+	return &cp
+}
+
 // DeepCopy generates a deep copy of *Upstream
 func (o *Upstream) DeepCopy() *Upstream {
 	var cp Upstream = *o
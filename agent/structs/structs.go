@@ -91,6 +91,12 @@ const (
 	RaftLogVerifierCheckpoint                   = 41 // Only used for log verifier, no-op on FSM.
 	ResourceOperationType                       = 42
 	UpdateVirtualIPRequestType                  = 43
+	ConnectCARevokedCertType                    = 44 // FSM snapshots only.
+	ConnectCARevocationListType                 = 45 // FSM snapshots only.
+	ConnectCALeafCertType                       = 46 // FSM snapshots only.
+	PeeringConfigEntriesWriteType               = 47
+	PeeringConfigEntriesDeleteType              = 48
+	PeeringRTTWriteType                         = 49
 )
 
 const (
@@ -160,6 +166,12 @@ var requestTypeStrings = map[MessageType]string{
 	RaftLogVerifierCheckpoint:       "RaftLogVerifierCheckpoint",
 	ResourceOperationType:           "Resource",
 	UpdateVirtualIPRequestType:      "UpdateManualVirtualIPRequestType",
+	ConnectCARevokedCertType:        "ConnectCARevokedCert",    // FSM snapshots only.
+	ConnectCARevocationListType:     "ConnectCARevocationList", // FSM snapshots only.
+	ConnectCALeafCertType:           "ConnectCALeafCert",       // FSM snapshots only.
+	PeeringConfigEntriesWriteType:   "PeeringConfigEntries",
+	PeeringConfigEntriesDeleteType:  "PeeringConfigEntriesDelete",
+	PeeringRTTWriteType:             "PeeringRTT",
 }
 
 const (
@@ -328,6 +340,11 @@ type QueryOptions struct {
 	// QueryMeta.Index, the response can be left empty and QueryMeta.NotModified
 	// will be set to true to indicate the result of the query has not changed.
 	AllowNotModifiedResponse bool `mapstructure:"allow-not-modified-response,omitempty"`
+
+	// SourceIP is the address of the client that made the request. It is
+	// recorded by the server that receives the request, and is only kept
+	// as is when the request is forwarded by another server.
+	SourceIP string `mapstructure:"-"`
 }
 
 // IsRead is always true for QueryOption.
@@ -358,6 +375,14 @@ func (q *QueryOptions) SetTokenSecret(s string) {
 	q.Token = s
 }
 
+func (q QueryOptions) RequestSourceIP() string {
+	return q.SourceIP
+}
+
+func (q *QueryOptions) SetRequestSourceIP(ip string) {
+	q.SourceIP = ip
+}
+
 // BlockingTimeout implements pool.BlockableQuery
 func (q QueryOptions) BlockingTimeout(maxQueryTime, defaultQueryTime time.Duration) time.Duration {
 	// Match logic in Server.blockingQuery.
@@ -385,6 +410,11 @@ type WriteRequest struct {
 	// Token is the ACL token ID. If not provided, the 'anonymous'
 	// token is assumed for backwards compatibility.
 	Token string
+
+	// SourceIP is the address of the client that made the request. It is
+	// recorded by the server that receives the request, and is only kept
+	// as is when the request is forwarded by another server.
+	SourceIP string `mapstructure:"-"`
 }
 
 // WriteRequest only applies to writes, always false
@@ -404,6 +434,14 @@ func (w *WriteRequest) SetTokenSecret(s string) {
 	w.Token = s
 }
 
+func (w WriteRequest) RequestSourceIP() string {
+	return w.SourceIP
+}
+
+func (w *WriteRequest) SetRequestSourceIP(ip string) {
+	w.SourceIP = ip
+}
+
 func (w WriteRequest) HasTimedOut(start time.Time, rpcHoldTimeout, _, _ time.Duration) (bool, error) {
 	return time.Since(start) > rpcHoldTimeout, nil
 }
@@ -28,6 +28,9 @@ type Config struct {
 	ACLConfigFileRegistrationToken string
 	ACLDNSToken                    string
 
+	// Rotation configures automatic rotation of the tokens above.
+	Rotation RotationConfig
+
 	EnterpriseConfig
 }
 
@@ -79,6 +82,10 @@ type persistedTokens struct {
 	Agent                  string `json:"agent,omitempty"`
 	ConfigFileRegistration string `json:"config_file_service_registration,omitempty"`
 	DNS                    string `json:"dns,omitempty"`
+
+	// Rotated holds the tokens minted by token rotation, keyed by token name,
+	// so that they can be revoked when they are rotated after a restart.
+	Rotated map[string]string `json:"rotated,omitempty"`
 }
 
 type fileStore struct {
@@ -156,6 +163,8 @@ func loadTokens(s *Store, cfg Config, tokens persistedTokens, logger Logger) {
 		s.UpdateDNSToken(cfg.ACLDNSToken, TokenSourceConfig)
 	}
 
+	s.loadRotatedTokens(tokens.Rotated)
+
 	loadEnterpriseTokens(s, cfg)
 }
 
@@ -222,6 +231,8 @@ func (p *fileStore) saveToFile(s *Store) error {
 		tokens.DNS = tok
 	}
 
+	tokens.Rotated = s.rotatedTokensCopy()
+
 	data, err := json.Marshal(tokens)
 	if err != nil {
 		p.logger.Warn("failed to persist tokens", "error", err)
@@ -0,0 +1,321 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package token
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/hashicorp/consul/lib/retry"
+)
+
+// RotationConfig configures automatic rotation of the tokens held in the Store.
+type RotationConfig struct {
+	// Enabled turns on automatic rotation of the tokens named in Tokens.
+	Enabled bool
+
+	// Tokens is the list of token names to rotate. Valid names are "default",
+	// "agent", "replication" and "config_file_service_registration".
+	Tokens []string
+
+	// Interval is how often each token is replaced with a freshly minted one.
+	Interval time.Duration
+
+	// TTL is the expiration applied to minted tokens. It must be longer than
+	// Interval so that a token is replaced before it expires. Tokens obtained
+	// from AuthMethod expire after the auth method's MaxTokenTTL instead.
+	TTL time.Duration
+
+	// AuthMethod is the name of the auth method used to log in for a new
+	// token. When set, BearerTokenFile must also be set.
+	AuthMethod string
+
+	// BearerTokenFile is the path to a file containing the credential that is
+	// presented to AuthMethod.
+	BearerTokenFile string
+
+	// BootstrapToken is a token with acl:write privileges used to mint new
+	// tokens that copy the privileges of the token being replaced. It is only
+	// used when AuthMethod is not set.
+	BootstrapToken string
+}
+
+// rotatableKinds maps the names accepted in RotationConfig.Tokens to the kind
+// of token they refer to. These match the names used by /v1/agent/token/.
+var rotatableKinds = map[string]TokenKind{
+	"default":                          TokenKindUser,
+	"agent":                            TokenKindAgent,
+	"replication":                      TokenKindReplication,
+	"config_file_service_registration": TokenKindConfigFileRegistration,
+}
+
+// RotatableKind returns the kind of token referred to by name, or false if the
+// token cannot be rotated.
+func RotatableKind(name string) (TokenKind, bool) {
+	kind, ok := rotatableKinds[name]
+	return kind, ok
+}
+
+// KindName returns the name used for the token kind in configuration and by
+// the /v1/agent/token/ endpoints.
+func KindName(kind TokenKind) string {
+	switch kind {
+	case TokenKindUser:
+		return "default"
+	case TokenKindAgent:
+		return "agent"
+	case TokenKindAgentRecovery:
+		return "agent_recovery"
+	case TokenKindReplication:
+		return "replication"
+	case TokenKindConfigFileRegistration:
+		return "config_file_service_registration"
+	case TokenKindDNS:
+		return "dns"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(kind))
+	}
+}
+
+// Minter creates and revokes the tokens used by a Rotator.
+type Minter interface {
+	// Mint returns the secret of a new token that grants the same privileges
+	// as current and expires after ttl.
+	Mint(ctx context.Context, kind TokenKind, current string, ttl time.Duration) (string, error)
+
+	// Revoke deletes the token with the given secret.
+	Revoke(ctx context.Context, secretID string) error
+}
+
+// errTokenChanged is returned when a token was changed by something other than
+// the Rotator while a replacement was being minted.
+var errTokenChanged = errors.New("token was changed while it was being rotated")
+
+// RotateToken replaces the token of the given kind with next, but only if the
+// current token is still prev. The new token is recorded as having been set
+// through the API and as minted by rotation so that both are persisted, and the
+// persisted tokens file is updated before any other change can be made to the
+// store.
+//
+// If prev was itself minted by a previous rotation it is returned so that the
+// caller can revoke it. Tokens supplied by an operator are never returned.
+func (t *Store) RotateToken(kind TokenKind, prev, next string) (string, error) {
+	var replaced string
+	err := t.WithPersistenceLock(func() error {
+		t.l.Lock()
+		defer t.l.Unlock()
+
+		dstToken, dstSource := t.tokenFieldsLocked(kind)
+		if dstToken == nil {
+			return fmt.Errorf("token %q cannot be rotated", KindName(kind))
+		}
+		if *dstToken != prev {
+			return errTokenChanged
+		}
+
+		if t.rotatedTokens == nil {
+			t.rotatedTokens = make(map[TokenKind]string)
+		}
+		if t.rotatedTokens[kind] == prev {
+			replaced = prev
+		}
+		t.rotatedTokens[kind] = next
+
+		*dstToken = next
+		*dstSource = TokenSourceAPI
+		t.sendNotificationLocked(kind)
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return replaced, nil
+}
+
+// rotatedTokensCopy returns the tokens minted by rotation, keyed by the name
+// of their kind.
+func (t *Store) rotatedTokensCopy() map[string]string {
+	t.l.RLock()
+	defer t.l.RUnlock()
+
+	if len(t.rotatedTokens) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(t.rotatedTokens))
+	for kind, secret := range t.rotatedTokens {
+		out[KindName(kind)] = secret
+	}
+	return out
+}
+
+// loadRotatedTokens restores the tokens minted by rotation from their
+// persisted form, ignoring any kind that cannot be rotated.
+func (t *Store) loadRotatedTokens(rotated map[string]string) {
+	t.l.Lock()
+	defer t.l.Unlock()
+
+	t.rotatedTokens = make(map[TokenKind]string, len(rotated))
+	for name, secret := range rotated {
+		if kind, ok := RotatableKind(name); ok {
+			t.rotatedTokens[kind] = secret
+		}
+	}
+}
+
+// tokenFieldsLocked returns pointers to the fields holding the token of the
+// given kind and its source.
+func (t *Store) tokenFieldsLocked(kind TokenKind) (*string, *TokenSource) {
+	switch kind {
+	case TokenKindUser:
+		return &t.userToken, &t.userTokenSource
+	case TokenKindAgent:
+		return &t.agentToken, &t.agentTokenSource
+	case TokenKindReplication:
+		return &t.replicationToken, &t.replicationTokenSource
+	case TokenKindConfigFileRegistration:
+		return &t.configFileRegistrationToken, &t.configFileRegistrationTokenSource
+	default:
+		return nil, nil
+	}
+}
+
+// tokenOfKind returns the raw value of the token of the given kind.
+func (t *Store) tokenOfKind(kind TokenKind) string {
+	t.l.RLock()
+	defer t.l.RUnlock()
+
+	dst, _ := t.tokenFieldsLocked(kind)
+	if dst == nil {
+		return ""
+	}
+	return *dst
+}
+
+// Rotator periodically replaces tokens in a Store with newly minted tokens
+// that expire, and revokes the tokens it replaced.
+type Rotator struct {
+	store  *Store
+	minter Minter
+	logger hclog.Logger
+
+	kinds    []TokenKind
+	interval time.Duration
+	ttl      time.Duration
+
+	// Rotated, if set, is called after the token of the given kind has been
+	// replaced in the store.
+	Rotated func(kind TokenKind)
+}
+
+// NewRotator returns a Rotator for the tokens named in cfg.Tokens.
+func NewRotator(store *Store, minter Minter, cfg RotationConfig, logger hclog.Logger) (*Rotator, error) {
+	if cfg.Interval <= 0 {
+		return nil, fmt.Errorf("rotation interval must be greater than zero")
+	}
+	if cfg.TTL <= cfg.Interval {
+		return nil, fmt.Errorf("rotation ttl (%s) must be greater than the rotation interval (%s)", cfg.TTL, cfg.Interval)
+	}
+
+	r := &Rotator{
+		store:    store,
+		minter:   minter,
+		logger:   logger,
+		interval: cfg.Interval,
+		ttl:      cfg.TTL,
+	}
+	for _, name := range cfg.Tokens {
+		kind, ok := RotatableKind(name)
+		if !ok {
+			return nil, fmt.Errorf("token %q cannot be rotated", name)
+		}
+		r.kinds = append(r.kinds, kind)
+	}
+	return r, nil
+}
+
+// Run rotates every configured token immediately and then once per interval
+// until ctx is cancelled. Failed rotations are retried with backoff.
+func (r *Rotator) Run(ctx context.Context) {
+	waiter := &retry.Waiter{
+		MinFailures: 1,
+		MinWait:     time.Second,
+		MaxWait:     r.interval,
+		Jitter:      retry.NewJitter(20),
+	}
+
+	for {
+		var err error
+		for _, kind := range r.kinds {
+			if rerr := r.Rotate(ctx, kind); rerr != nil {
+				r.logger.Error("failed to rotate token", "token", KindName(kind), "error", rerr)
+				err = rerr
+			}
+		}
+
+		wait := r.interval
+		if err != nil {
+			wait = waiter.WaitDuration()
+		} else {
+			waiter.Reset()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+	}
+}
+
+// Rotate mints a replacement for the token of the given kind, swaps it into
+// the store and revokes the replaced token if it was minted by a previous
+// rotation. Only minted tokens are ever revoked, tokens supplied by an
+// operator are left to expire or be deleted by them. Minted tokens are
+// tracked by the store so that they are still revoked after a restart.
+func (r *Rotator) Rotate(ctx context.Context, kind TokenKind) error {
+	prev := r.store.tokenOfKind(kind)
+	if prev == "" {
+		// Nothing to derive privileges from, this is likely intentional.
+		return nil
+	}
+
+	next, err := r.minter.Mint(ctx, kind, prev, r.ttl)
+	if err != nil {
+		return fmt.Errorf("failed to mint token: %w", err)
+	}
+
+	replaced, err := r.store.RotateToken(kind, prev, next)
+	if err != nil {
+		// The new token was never used, so clean it up rather than leaving it
+		// around until it expires.
+		if rerr := r.minter.Revoke(ctx, next); rerr != nil {
+			r.logger.Warn("failed to revoke unused token", "token", KindName(kind), "error", rerr)
+		}
+		if errors.Is(err, errTokenChanged) {
+			// The operator changed the token, their change takes precedence.
+			// The new token will be rotated next time around.
+			r.logger.Info("token was changed during rotation, skipping", "token", KindName(kind))
+			return nil
+		}
+		return fmt.Errorf("failed to store rotated token: %w", err)
+	}
+
+	r.logger.Info("rotated token", "token", KindName(kind))
+	if r.Rotated != nil {
+		r.Rotated(kind)
+	}
+
+	if replaced != "" {
+		if err := r.minter.Revoke(ctx, replaced); err != nil {
+			// The token has been replaced so this is not fatal, the old token
+			// will expire on its own.
+			r.logger.Warn("failed to revoke rotated token", "token", KindName(kind), "error", err)
+		}
+	}
+	return nil
+}
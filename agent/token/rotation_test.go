@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package token
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeMinter struct {
+	count   int
+	revoked []string
+	mintErr error
+
+	// beforeReturn is called before Mint returns to simulate concurrent
+	// changes to the store.
+	beforeReturn func()
+}
+
+func (m *fakeMinter) Mint(_ context.Context, kind TokenKind, current string, ttl time.Duration) (string, error) {
+	if m.mintErr != nil {
+		return "", m.mintErr
+	}
+	m.count++
+	if m.beforeReturn != nil {
+		m.beforeReturn()
+	}
+	return fmt.Sprintf("%s-%d", KindName(kind), m.count), nil
+}
+
+func (m *fakeMinter) Revoke(_ context.Context, secretID string) error {
+	m.revoked = append(m.revoked, secretID)
+	return nil
+}
+
+func TestNewRotator_Validation(t *testing.T) {
+	store := new(Store)
+	logger := hclog.NewNullLogger()
+
+	_, err := NewRotator(store, &fakeMinter{}, RotationConfig{TTL: time.Hour}, logger)
+	require.ErrorContains(t, err, "interval must be greater than zero")
+
+	_, err = NewRotator(store, &fakeMinter{}, RotationConfig{Interval: time.Hour, TTL: time.Minute}, logger)
+	require.ErrorContains(t, err, "must be greater than the rotation interval")
+
+	_, err = NewRotator(store, &fakeMinter{}, RotationConfig{
+		Interval: time.Minute,
+		TTL:      time.Hour,
+		Tokens:   []string{"agent_recovery"},
+	}, logger)
+	require.ErrorContains(t, err, `token "agent_recovery" cannot be rotated`)
+}
+
+func TestRotator_Rotate(t *testing.T) {
+	dataDir := t.TempDir()
+	store := new(Store)
+	require.NoError(t, store.Load(Config{
+		EnablePersistence: true,
+		DataDir:           dataDir,
+		ACLAgentToken:     "from-config",
+	}, hclog.NewNullLogger()))
+
+	minter := &fakeMinter{}
+	r, err := NewRotator(store, minter, RotationConfig{
+		Interval: time.Minute,
+		TTL:      time.Hour,
+		Tokens:   []string{"agent"},
+	}, hclog.NewNullLogger())
+	require.NoError(t, err)
+
+	notifier := store.Notify(TokenKindAgent)
+	defer store.StopNotify(notifier)
+
+	// The first rotation replaces the configured token but must not revoke it.
+	require.NoError(t, r.Rotate(context.Background(), TokenKindAgent))
+	tok, source := store.AgentTokenAndSource()
+	require.Equal(t, "agent-1", tok)
+	require.Equal(t, TokenSourceAPI, source)
+	require.Empty(t, minter.revoked)
+
+	select {
+	case <-notifier.Ch:
+	default:
+		t.Fatal("expected a notification for the rotated token")
+	}
+
+	persisted, err := readPersistedFromFile(filepath.Join(dataDir, tokensPath))
+	require.NoError(t, err)
+	require.Equal(t, "agent-1", persisted.Agent)
+
+	// Subsequent rotations revoke the token minted previously.
+	require.NoError(t, r.Rotate(context.Background(), TokenKindAgent))
+	require.Equal(t, "agent-2", store.AgentToken())
+	require.Equal(t, []string{"agent-1"}, minter.revoked)
+
+	persisted, err = readPersistedFromFile(filepath.Join(dataDir, tokensPath))
+	require.NoError(t, err)
+	require.Equal(t, "agent-2", persisted.Agent)
+}
+
+func TestRotator_Rotate_AfterRestart(t *testing.T) {
+	dataDir := t.TempDir()
+	cfg := Config{
+		EnablePersistence: true,
+		DataDir:           dataDir,
+		ACLDefaultToken:   "from-config",
+	}
+	rotation := RotationConfig{
+		Interval: time.Minute,
+		TTL:      time.Hour,
+		Tokens:   []string{"default"},
+	}
+
+	store := new(Store)
+	require.NoError(t, store.Load(cfg, hclog.NewNullLogger()))
+	r, err := NewRotator(store, &fakeMinter{}, rotation, hclog.NewNullLogger())
+	require.NoError(t, err)
+
+	var rotated []TokenKind
+	r.Rotated = func(kind TokenKind) { rotated = append(rotated, kind) }
+
+	require.NoError(t, r.Rotate(context.Background(), TokenKindUser))
+	require.Equal(t, "default-1", store.UserToken())
+	require.Equal(t, []TokenKind{TokenKindUser}, rotated)
+
+	persisted, err := readPersistedFromFile(filepath.Join(dataDir, tokensPath))
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"default": "default-1"}, persisted.Rotated)
+
+	// A restarted agent still knows that the persisted token was minted by
+	// rotation and revokes it once it is replaced.
+	store = new(Store)
+	require.NoError(t, store.Load(cfg, hclog.NewNullLogger()))
+	minter := &fakeMinter{count: 1}
+	r, err = NewRotator(store, minter, rotation, hclog.NewNullLogger())
+	require.NoError(t, err)
+
+	require.NoError(t, r.Rotate(context.Background(), TokenKindUser))
+	require.Equal(t, "default-2", store.UserToken())
+	require.Equal(t, []string{"default-1"}, minter.revoked)
+}
+
+func TestRotator_Rotate_TokenChanged(t *testing.T) {
+	store := new(Store)
+	store.UpdateReplicationToken("original", TokenSourceConfig)
+
+	minter := &fakeMinter{}
+	minter.beforeReturn = func() {
+		store.UpdateReplicationToken("operator", TokenSourceAPI)
+	}
+	r, err := NewRotator(store, minter, RotationConfig{
+		Interval: time.Minute,
+		TTL:      time.Hour,
+		Tokens:   []string{"replication"},
+	}, hclog.NewNullLogger())
+	require.NoError(t, err)
+
+	require.NoError(t, r.Rotate(context.Background(), TokenKindReplication))
+	require.Equal(t, "operator", store.ReplicationToken())
+	require.Equal(t, []string{"replication-1"}, minter.revoked)
+}
+
+func TestRotator_Rotate_Errors(t *testing.T) {
+	store := new(Store)
+	r, err := NewRotator(store, &fakeMinter{mintErr: fmt.Errorf("boom")}, RotationConfig{
+		Interval: time.Minute,
+		TTL:      time.Hour,
+		Tokens:   []string{"default"},
+	}, hclog.NewNullLogger())
+	require.NoError(t, err)
+
+	// An empty token is left alone.
+	require.NoError(t, r.Rotate(context.Background(), TokenKindUser))
+
+	store.UpdateUserToken("U", TokenSourceConfig)
+	require.ErrorContains(t, r.Rotate(context.Background(), TokenKindUser), "boom")
+	require.Equal(t, "U", store.UserToken())
+}
@@ -93,6 +93,10 @@ type Store struct {
 	// dnsTokenSource indicates where the dnsToken originated from.
 	dnsTokenSource TokenSource
 
+	// rotatedTokens holds the tokens minted by token rotation, keyed by kind.
+	// Only these tokens are revoked when they are rotated again.
+	rotatedTokens map[TokenKind]string
+
 	watchers     map[int]watcher
 	watcherIndex int
 
@@ -783,7 +783,7 @@ func (s *HTTPHandlers) UIMetricsProxy(resp http.ResponseWriter, req *http.Reques
 	if err := s.parseEntMetaPartition(req, &entMeta); err != nil {
 		return nil, err
 	}
-	authz, err := s.agent.delegate.ResolveTokenAndDefaultMeta(token, &entMeta, nil)
+	authz, err := s.resolveTokenAndDefaultMeta(req, token, &entMeta, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -100,6 +100,10 @@ func (s *ResourceGenerator) clustersFromSnapshotConnectProxy(cfgSnap *proxycfg.C
 		clusters = append(clusters, passthroughs...)
 	}
 
+	// A chain evaluated in a peer may route to another peered upstream, so
+	// track the chain clusters to avoid generating those twice.
+	chainClusterNames := make(map[string]struct{})
+
 	// NOTE: Any time we skip a chain below we MUST also skip that discovery chain in endpoints.go
 	// so that the sets of endpoints generated matches the sets of clusters.
 	for uid, chain := range cfgSnap.ConnectProxy.DiscoveryChain {
@@ -120,6 +124,7 @@ func (s *ResourceGenerator) clustersFromSnapshotConnectProxy(cfgSnap *proxycfg.C
 		}
 
 		for _, cluster := range upstreamClusters {
+			chainClusterNames[cluster.Name] = struct{}{}
 			clusters = append(clusters, cluster)
 		}
 	}
@@ -143,6 +148,9 @@ func (s *ResourceGenerator) clustersFromSnapshotConnectProxy(cfgSnap *proxycfg.C
 		if err != nil {
 			return nil, err
 		}
+		if _, ok := chainClusterNames[upstreamCluster.Name]; ok {
+			continue
+		}
 		clusters = append(clusters, upstreamCluster)
 	}
 
@@ -505,6 +513,7 @@ func makeMTLSTransportSocket(cfgSnap *proxycfg.ConfigSnapshot, uid proxycfg.Upst
 	if err != nil {
 		return nil, fmt.Errorf("failed to inject SAN matcher rules for cluster %q: %v", sni, err)
 	}
+	injectRevocationList(cfgSnap, commonTLSContext)
 	tlsContext := envoy_tls_v3.UpstreamTlsContext{
 		CommonTlsContext: commonTLSContext,
 		Sni:              sni,
@@ -1069,6 +1078,10 @@ func (s *ResourceGenerator) configIngressUpstreamCluster(c *envoy_cluster_v3.Clu
 	}
 
 	if threshold != nil {
+		// Keep the retry budget from the upstream's service-resolver.
+		if existing := c.CircuitBreakers.GetThresholds(); len(existing) > 0 {
+			threshold.RetryBudget = existing[0].RetryBudget
+		}
 		c.CircuitBreakers.Thresholds = []*envoy_cluster_v3.CircuitBreakers_Thresholds{threshold}
 	}
 
@@ -1258,6 +1271,9 @@ func (s *ResourceGenerator) makeUpstreamClusterForPeerService(
 	if err != nil {
 		return nil, fmt.Errorf("failed to inject SAN matcher rules for cluster %q: %v", clusterName, err)
 	}
+	if uid.Peer == "" {
+		injectRevocationList(cfgSnap, commonTLSContext)
+	}
 
 	tlsContext := &envoy_tls_v3.UpstreamTlsContext{
 		CommonTlsContext: commonTLSContext,
@@ -1361,6 +1377,7 @@ func (s *ResourceGenerator) makeUpstreamClusterForPreparedQuery(upstream structs
 	if err != nil {
 		return nil, fmt.Errorf("failed to inject SAN matcher rules for cluster %q: %v", sni, err)
 	}
+	injectRevocationList(cfgSnap, commonTLSContext)
 
 	tlsContext := &envoy_tls_v3.UpstreamTlsContext{
 		CommonTlsContext: commonTLSContext,
@@ -1549,6 +1566,8 @@ func (s *ResourceGenerator) makeUpstreamClustersForDiscoveryChain(
 				return nil, fmt.Errorf("failed to apply load balancer configuration to cluster %q: %v", groupedTarget.ClusterName, err)
 			}
 
+			injectRetryBudgetToCluster(node.Resolver.RetryPolicy, c)
+
 			if upstreamConfig.Protocol == "http2" || upstreamConfig.Protocol == "grpc" {
 				if err := s.setHttp2ProtocolOptions(c); err != nil {
 					return nil, err
@@ -1572,6 +1591,25 @@ func (s *ResourceGenerator) makeUpstreamClustersForDiscoveryChain(
 				c.TransportSocket = transportSocket
 			}
 
+			// Peered instances addressed by a hostname are provided through CDS
+			// since EDS cannot resolve hostnames, unless traffic goes through
+			// the local mesh gateway.
+			if targetUID := proxycfg.NewUpstreamIDFromTargetID(groupedTarget.Targets[0].TargetID); targetUID.Peer != "" && !forMeshGateway {
+				_, useHostnames := upstreamsSnapshot.PeerUpstreamEndpointsUseHostnames[targetUID]
+				if useHostnames && (upstream == nil || upstream.MeshGateway.Mode != structs.MeshGatewayModeLocal) {
+					ep, _ := upstreamsSnapshot.PeerUpstreamEndpoints.Get(targetUID)
+					c.EdsClusterConfig = nil
+					configureClusterWithHostnames(
+						s.Logger,
+						c,
+						"", /*TODO:make configurable?*/
+						ep,
+						true,  /*isRemote*/
+						false, /*onlyPassing*/
+					)
+				}
+			}
+
 			out = append(out, c)
 		}
 	}
@@ -2009,6 +2047,30 @@ func makeLbEndpoint(addr string, port int, health envoy_core_v3.HealthStatus, we
 	}
 }
 
+// injectRetryBudgetToCluster limits the retries to the cluster with the
+// retry budget of a resolver's retry policy, if one is configured.
+func injectRetryBudgetToCluster(policy *structs.ServiceResolverRetryPolicy, c *envoy_cluster_v3.Cluster) {
+	if policy == nil || policy.RetryBudget == nil {
+		return
+	}
+
+	budget := &envoy_cluster_v3.CircuitBreakers_Thresholds_RetryBudget{}
+	if policy.RetryBudget.BudgetPercent > 0 {
+		budget.BudgetPercent = &envoy_type_v3.Percent{Value: policy.RetryBudget.BudgetPercent}
+	}
+	if policy.RetryBudget.MinRetryConcurrency > 0 {
+		budget.MinRetryConcurrency = response.MakeUint32Value(int(policy.RetryBudget.MinRetryConcurrency))
+	}
+
+	if c.CircuitBreakers == nil {
+		c.CircuitBreakers = &envoy_cluster_v3.CircuitBreakers{}
+	}
+	if len(c.CircuitBreakers.Thresholds) == 0 {
+		c.CircuitBreakers.Thresholds = []*envoy_cluster_v3.CircuitBreakers_Thresholds{{}}
+	}
+	c.CircuitBreakers.Thresholds[0].RetryBudget = budget
+}
+
 func injectLBToCluster(ec *structs.LoadBalancer, c *envoy_cluster_v3.Cluster) error {
 	if ec == nil {
 		return nil
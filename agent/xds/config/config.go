@@ -100,9 +100,9 @@ type ProxyConfig struct {
 	LocalIdleTimeoutMs *int `mapstructure:"local_idle_timeout_ms"`
 
 	// Protocol describes the service's protocol. Valid values are "tcp",
-	// "http" and "grpc". Anything else is treated as tcp. This enables
-	// protocol aware features like per-request metrics and connection
-	// pooling, tracing, routing etc.
+	// "http", "grpc", "redis", "postgres" and "kafka". Anything else is
+	// treated as tcp. This enables protocol aware features like per-request
+	// metrics and connection pooling, tracing, routing etc.
 	Protocol string `mapstructure:"protocol"`
 
 	// BindAddress overrides the address the proxy's listener binds to. This
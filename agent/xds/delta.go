@@ -334,6 +334,13 @@ func (s *Server) processDelta(stream ADSDeltaStream, reqCh <-chan *envoy_discove
 
 			logger = logger.With("service_id", proxyID.String()) // enhance future logs
 
+			history := s.PushHistory.openStream(proxyID)
+			defer history.close()
+
+			for _, handler := range handlers {
+				handler.history = history
+			}
+
 			logger.Trace("watching proxy, pending initial proxycfg snapshot for xDS")
 
 			// Now wait for the config so we can check ACL
@@ -355,6 +362,8 @@ func (s *Server) processDelta(stream ADSDeltaStream, reqCh <-chan *envoy_discove
 
 			logger.Trace("Got initial config snapshot")
 
+			handlers[xdscommon.ListenerType].history.setService(snapshot.Service)
+
 			// Let's actually process the config we just got, or we'll miss responding
 			fallthrough
 		case stateDeltaRunning:
@@ -639,6 +648,10 @@ type xDSDeltaType struct {
 	// For example, endpoints are a child type of clusters.
 	deltaChild *xDSDeltaChild
 
+	// history records the responses sent to the proxy. It is set once the
+	// proxy is known.
+	history *pushStream
+
 	// registered indicates if this type has been requested at least once by
 	// the proxy
 	registered bool
@@ -743,10 +756,12 @@ func (t *xDSDeltaType) Recv(req *envoy_discovery_v3.DeltaDiscoveryRequest, sf xd
 		if req.ErrorDetail == nil {
 			t.logger.Trace("got ok response from envoy proxy", "nonce", req.ResponseNonce)
 			t.ack(req.ResponseNonce)
+			t.history.ack(req.ResponseNonce)
 		} else {
 			t.logger.Error("got error response from envoy proxy", "nonce", req.ResponseNonce,
 				"error", status.ErrorProto(req.ErrorDetail))
 			t.nack(req.ResponseNonce)
+			t.history.nack(req.ResponseNonce, req.ErrorDetail.GetMessage())
 			return deltaRecvResponseNack
 		}
 	}
@@ -905,6 +920,8 @@ func (t *xDSDeltaType) SendIfNew(
 	}
 	logger.Trace("sent response", "nonce", resp.Nonce)
 
+	t.history.record(resp)
+
 	// Certain xDS types are children of other types, meaning that if an update is pushed for a parent,
 	// we MUST send new data for all its children. Envoy will NOT re-subscribe to the child data upon
 	// receiving updates for the parent, so we need to handle this ourselves.
@@ -720,6 +720,96 @@ end`,
 				}, nil)
 			},
 		},
+		{
+			// Insert an ext_proc HTTP filter before the router filter, with a local gRPC service and the default config options.
+			name: "ext-proc-http-local-grpc-service",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshot(t, func(ns *structs.NodeService) {
+					ns.Proxy.Config = map[string]any{"protocol": "http"}
+					ns.Proxy.EnvoyExtensions = []structs.EnvoyExtension{
+						{
+							Name: api.BuiltinExtProcExtension,
+							Arguments: map[string]any{
+								"Config": map[string]any{
+									"GrpcService": map[string]any{
+										"Target": map[string]any{"URI": "127.0.0.1:9191"},
+									},
+								},
+							},
+						},
+					}
+				}, nil)
+			},
+		},
+		{
+			// Insert an ext_proc HTTP filter after intentions, with an upstream gRPC service and all config options.
+			name: "ext-proc-http-upstream-grpc-service",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshot(t, func(ns *structs.NodeService) {
+					ns.Proxy.Config = map[string]any{"protocol": "http"}
+					ns.Proxy.EnvoyExtensions = []structs.EnvoyExtension{
+						{
+							Name:     api.BuiltinExtProcExtension,
+							Required: true,
+							Arguments: map[string]any{
+								"InsertOptions": map[string]any{
+									"Location":   "AfterLastMatch",
+									"FilterName": "envoy.filters.http.rbac",
+								},
+								"Config": map[string]any{
+									"GrpcService": map[string]any{
+										"Target": map[string]any{
+											"Service": map[string]any{"Name": "db"},
+											"Timeout": "2s",
+										},
+										"Authority": "test-authority",
+										"InitialMetadata": []map[string]any{
+											{"Key": "init-metadata-1", "Value": "value 1"},
+										},
+									},
+									"ProcessingMode": map[string]any{
+										"RequestHeaderMode":   "SEND",
+										"ResponseHeaderMode":  "SEND",
+										"RequestBodyMode":     "BUFFERED",
+										"ResponseBodyMode":    "STREAMED",
+										"RequestTrailerMode":  "SKIP",
+										"ResponseTrailerMode": "SKIP",
+									},
+									"MessageTimeout":         "500ms",
+									"MaxMessageTimeout":      "5s",
+									"AllowModeOverride":      true,
+									"DisableClearRouteCache": true,
+									"RequestAttributes":      []string{"request.path", "source.address"},
+									"ResponseAttributes":     []string{"response.code"},
+									"StatPrefix":             "ext_proc_stats",
+								},
+							},
+						},
+					}
+				}, nil)
+			},
+		},
+		{
+			// The ext_proc extension does not apply to TCP listeners.
+			name: "ext-proc-tcp-local-grpc-service",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshot(t, func(ns *structs.NodeService) {
+					ns.Proxy.Config = map[string]any{"protocol": "tcp"}
+					ns.Proxy.EnvoyExtensions = []structs.EnvoyExtension{
+						{
+							Name: api.BuiltinExtProcExtension,
+							Arguments: map[string]any{
+								"Config": map[string]any{
+									"GrpcService": map[string]any{
+										"Target": map[string]any{"URI": "localhost:9191"},
+									},
+								},
+							},
+						},
+					}
+				}, nil)
+			},
+		},
 		{
 			// Insert an OpenTelemetry access logging extension
 			name: "otel-access-logging-http",
@@ -766,6 +856,150 @@ end`,
 				}, nil)
 			},
 		},
+		{
+			// Insert a CORS filter and set the CORS policy on the inbound route configuration.
+			name: "cors-http-inbound",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshot(t, func(ns *structs.NodeService) {
+					ns.Proxy.Config = map[string]any{"protocol": "http"}
+					ns.Proxy.EnvoyExtensions = []structs.EnvoyExtension{
+						{
+							Name: api.BuiltinCORSExtension,
+							Arguments: map[string]any{
+								"AllowOrigins":              []string{"https://example.com"},
+								"AllowOriginRegexes":        []string{`https://.*\.example\.com`},
+								"AllowMethods":              []string{"GET", "POST"},
+								"AllowHeaders":              []string{"content-type", "x-request-id"},
+								"ExposeHeaders":             []string{"x-trace-id"},
+								"MaxAge":                    600,
+								"AllowCredentials":          true,
+								"AllowPrivateNetworkAccess": true,
+							},
+						},
+					}
+				}, nil)
+			},
+		},
+		{
+			// Set the CORS policy on the outbound route configurations fetched with RDS.
+			name: "cors-http-outbound-router",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "chain-and-router", false, func(ns *structs.NodeService) {
+					ns.Proxy.EnvoyExtensions = []structs.EnvoyExtension{
+						{
+							Name: api.BuiltinCORSExtension,
+							Arguments: map[string]any{
+								"ListenerType": "outbound",
+								"AllowOrigins": []string{"*"},
+							},
+						},
+					}
+				}, nil)
+			},
+		},
+		{
+			// Insert a compressor filter for each algorithm in order of preference.
+			name: "compression-http-inbound",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshot(t, func(ns *structs.NodeService) {
+					ns.Proxy.Config = map[string]any{"protocol": "http"}
+					ns.Proxy.EnvoyExtensions = []structs.EnvoyExtension{
+						{
+							Name: api.BuiltinCompressionExtension,
+							Arguments: map[string]any{
+								"Algorithms":                 []string{"zstd", "brotli", "gzip"},
+								"MinContentLength":           1024,
+								"ContentTypes":               []string{"application/json", "text/html"},
+								"DisableOnETagHeader":        true,
+								"RemoveAcceptEncodingHeader": true,
+							},
+						},
+					}
+				}, nil)
+			},
+		},
+		{
+			// Compression does not apply to TCP listeners.
+			name: "compression-tcp-inbound",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshot(t, func(ns *structs.NodeService) {
+					ns.Proxy.Config = map[string]any{"protocol": "tcp"}
+					ns.Proxy.EnvoyExtensions = []structs.EnvoyExtension{
+						{Name: api.BuiltinCompressionExtension},
+					}
+				}, nil)
+			},
+		},
+		{
+			// Limit all inbound requests, with separate limits for some paths.
+			name: "local-ratelimit-http-inbound",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshot(t, func(ns *structs.NodeService) {
+					ns.Proxy.Config = map[string]any{"protocol": "http"}
+					ns.Proxy.EnvoyExtensions = []structs.EnvoyExtension{
+						{
+							Name: api.BuiltinLocalRateLimitExtension,
+							Arguments: map[string]any{
+								"StatusCode": 503,
+								"TokenBucket": map[string]any{
+									"MaxTokens":     100,
+									"TokensPerFill": 10,
+									"FillInterval":  "1s",
+								},
+								"Routes": []map[string]any{
+									{
+										"PathPrefix": "/api",
+										"TokenBucket": map[string]any{
+											"MaxTokens":    20,
+											"FillInterval": "100ms",
+										},
+									},
+									{
+										"PathExact": "/login",
+										"TokenBucket": map[string]any{
+											"MaxTokens":    5,
+											"FillInterval": "1m",
+										},
+									},
+								},
+							},
+						},
+					}
+				}, nil)
+			},
+		},
+		{
+			// Limit outbound requests on routes defined by a service-router.
+			name: "local-ratelimit-http-outbound-router",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "chain-and-router", false, func(ns *structs.NodeService) {
+					ns.Proxy.EnvoyExtensions = []structs.EnvoyExtension{
+						{
+							Name: api.BuiltinLocalRateLimitExtension,
+							Arguments: map[string]any{
+								"ListenerType": "outbound",
+								"Routes": []map[string]any{
+									{
+										"PathPrefix": "/prefix",
+										"TokenBucket": map[string]any{
+											"MaxTokens":    10,
+											"FillInterval": "1s",
+										},
+									},
+									{
+										"PathPrefix": "/api",
+										"TokenBucket": map[string]any{
+											"MaxTokens":    20,
+											"FillInterval": "1s",
+										},
+									},
+								},
+							},
+						},
+					}
+				}, nil)
+			},
+		},
 		{
 			name: "tproxy-and-permissive-mtls-and-envoy-extension",
 			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
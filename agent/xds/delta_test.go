@@ -379,6 +379,24 @@ func TestServer_DeltaAggregatedResources_v3_NackLoop(t *testing.T) {
 		assertDeltaChanBlocked(t, envoy.deltaStream.sendCh)
 	})
 
+	testutil.RunStep(t, "push history records the NACK", func(t *testing.T) {
+		service, entries, ok, err := scenario.server.PushHistory.Get(sid, 0)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, "web-sidecar-proxy", service)
+
+		var statuses []string
+		for _, entry := range entries {
+			statuses = append(statuses, entry.TypeURL+" "+entry.Nonce+" "+entry.Status)
+		}
+		require.Equal(t, []string{
+			xdscommon.ClusterType + " " + hexString(1) + " " + PushStatusACK,
+			xdscommon.EndpointType + " " + hexString(2) + " " + PushStatusACK,
+			xdscommon.ListenerType + " " + hexString(3) + " " + PushStatusNACK,
+			xdscommon.ListenerType + " " + hexString(4) + " " + PushStatusACK,
+		}, statuses)
+	})
+
 	envoy.Close()
 	select {
 	case err := <-errCh:
@@ -59,6 +59,10 @@ func (s *ResourceGenerator) endpointsFromSnapshotConnectProxy(cfgSnap *proxycfg.
 			cfgSnap.ConnectProxy.PeerUpstreamEndpoints.Len()+
 			len(cfgSnap.ConnectProxy.WatchedUpstreamEndpoints))
 
+	// A chain evaluated in a peer may route to another peered upstream, so
+	// track the chain clusters to avoid generating those twice.
+	chainClusterNames := make(map[string]struct{})
+
 	// NOTE: Any time we skip a chain below we MUST also skip that discovery chain in clusters.go
 	// so that the sets of endpoints generated matches the sets of clusters.
 	for uid, chain := range cfgSnap.ConnectProxy.DiscoveryChain {
@@ -86,6 +90,11 @@ func (s *ResourceGenerator) endpointsFromSnapshotConnectProxy(cfgSnap *proxycfg.
 		if err != nil {
 			return nil, err
 		}
+		for _, e := range es {
+			if la, ok := e.(*envoy_endpoint_v3.ClusterLoadAssignment); ok {
+				chainClusterNames[la.ClusterName] = struct{}{}
+			}
+		}
 		resources = append(resources, es...)
 	}
 
@@ -107,6 +116,9 @@ func (s *ResourceGenerator) endpointsFromSnapshotConnectProxy(cfgSnap *proxycfg.
 		}
 
 		clusterName := generatePeeredClusterName(uid, tbs)
+		if _, ok := chainClusterNames[clusterName]; ok {
+			continue
+		}
 
 		mgwMode := structs.MeshGatewayModeDefault
 		if upstream != nil {
@@ -136,6 +136,9 @@ func (s *ResourceGenerator) mapDiscoChainTargets(cfgSnap *proxycfg.ConfigSnapsho
 		if err != nil {
 			return failoverTargets, fmt.Errorf("failed to inject SAN matcher rules for cluster %q: %v", sni, err)
 		}
+		if targetUID.Peer == "" {
+			injectRevocationList(cfgSnap, commonTLSContext)
+		}
 
 		tlsContext := &envoy_tls_v3.UpstreamTlsContext{
 			CommonTlsContext: commonTLSContext,
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package xds
+
+import (
+	envoy_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_common_fault_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/common/fault/v3"
+	envoy_fault_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/fault/v3"
+	envoy_http_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+const envoyHTTPFaultFilterKey = "envoy.filters.http.fault"
+
+// makeFaultInjectionFilter returns the HTTP fault filter that is added to
+// connection managers whose routes inject faults. The filter itself has no
+// faults configured; they are applied per route by
+// makeFaultInjectionPerRouteConfig.
+func makeFaultInjectionFilter() (*envoy_http_v3.HttpFilter, error) {
+	return makeEnvoyHTTPFilter(envoyHTTPFaultFilterKey, &envoy_fault_v3.HTTPFault{})
+}
+
+// makeFaultInjectionPerRouteConfig converts the fault injection of a route
+// destination into the per route configuration of the HTTP fault filter.
+func makeFaultInjectionPerRouteConfig(fi *structs.ServiceRouteFaultInjection) (*anypb.Any, error) {
+	cfg := &envoy_fault_v3.HTTPFault{}
+
+	if fi.Delay != nil {
+		cfg.Delay = &envoy_common_fault_v3.FaultDelay{
+			FaultDelaySecifier: &envoy_common_fault_v3.FaultDelay_FixedDelay{
+				FixedDelay: durationpb.New(fi.Delay.FixedDelay),
+			},
+			Percentage: makeFractionalPercent(fi.Delay.Percentage),
+		}
+	}
+
+	if fi.Abort != nil {
+		cfg.Abort = &envoy_fault_v3.FaultAbort{
+			Percentage: makeFractionalPercent(fi.Abort.Percentage),
+		}
+		if fi.Abort.GRPCStatus != 0 {
+			cfg.Abort.ErrorType = &envoy_fault_v3.FaultAbort_GrpcStatus{GrpcStatus: fi.Abort.GRPCStatus}
+		} else {
+			cfg.Abort.ErrorType = &envoy_fault_v3.FaultAbort_HttpStatus{HttpStatus: fi.Abort.HTTPStatus}
+		}
+	}
+
+	for _, hdr := range fi.Headers {
+		if eh := makeHeaderMatcher(hdr); eh != nil {
+			cfg.Headers = append(cfg.Headers, eh)
+		}
+	}
+
+	return anypb.New(cfg)
+}
+
+// injectFaultInjectionToRoute adds the per route fault configuration of the
+// destination to the route, if any.
+func injectFaultInjectionToRoute(dest *structs.ServiceRouteDestination, r *envoy_route_v3.Route) error {
+	if dest == nil || dest.FaultInjection == nil {
+		return nil
+	}
+
+	cfg, err := makeFaultInjectionPerRouteConfig(dest.FaultInjection)
+	if err != nil {
+		return err
+	}
+	if r.TypedPerFilterConfig == nil {
+		r.TypedPerFilterConfig = make(map[string]*anypb.Any)
+	}
+	r.TypedPerFilterConfig[envoyHTTPFaultFilterKey] = cfg
+	return nil
+}
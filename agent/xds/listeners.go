@@ -154,7 +154,7 @@ func (s *ResourceGenerator) listenersFromSnapshotConnectProxy(cfgSnap *proxycfg.
 		}
 
 		// RDS, Envoy's Route Discovery Service, is only used for HTTP services with a customized discovery chain.
-		useRDS := chain.Protocol != "tcp" && !chain.Default
+		useRDS := structs.IsProtocolHTTPLike(chain.Protocol) && !chain.Default
 
 		var clusterName string
 		if !useRDS {
@@ -184,6 +184,7 @@ func (s *ResourceGenerator) listenersFromSnapshotConnectProxy(cfgSnap *proxycfg.
 				useRDS:          useRDS,
 				fetchTimeoutRDS: cfgSnap.GetXDSCommonConfig(s.Logger).GetXDSFetchTimeout(),
 				tracing:         tracing,
+				faultInjection:  chain.HasFaultInjection(),
 			})
 			if err != nil {
 				return nil, err
@@ -220,6 +221,7 @@ func (s *ResourceGenerator) listenersFromSnapshotConnectProxy(cfgSnap *proxycfg.
 			useRDS:          useRDS,
 			fetchTimeoutRDS: cfgSnap.GetXDSCommonConfig(s.Logger).GetXDSFetchTimeout(),
 			tracing:         tracing,
+			faultInjection:  chain.HasFaultInjection(),
 		})
 		if err != nil {
 			return nil, err
@@ -1134,7 +1136,13 @@ func injectHTTPFilterOnFilterChains(
 // since TLS validation will be done against root certs for all peers
 // that might dial this proxy.
 func (s *ResourceGenerator) injectConnectTLSForPublicListener(cfgSnap *proxycfg.ConfigSnapshot, listener *envoy_listener_v3.Listener) error {
-	transportSocket, err := createDownstreamTransportSocketForConnectTLS(cfgSnap, cfgSnap.GetProxyConfig(s.Logger), cfgSnap.PeeringTrustBundles())
+	proxyCfg := cfgSnap.GetProxyConfig(s.Logger)
+	peerBundles := cfgSnap.PeeringTrustBundles()
+	if len(peerBundles) > 0 && cfgSnap.RevocationListPEMs() != "" {
+		return s.injectConnectTLSForPublicListenerWithRevocation(cfgSnap, listener, proxyCfg, peerBundles)
+	}
+
+	transportSocket, err := createDownstreamTransportSocketForConnectTLS(cfgSnap, proxyCfg, peerBundles)
 	if err != nil {
 		return err
 	}
@@ -1145,6 +1153,72 @@ func (s *ResourceGenerator) injectConnectTLSForPublicListener(cfgSnap *proxycfg.
 	return nil
 }
 
+// injectConnectTLSForPublicListenerWithRevocation injects the TLS context of a
+// public listener whose service is exported to peers while certificate
+// revocation lists are enforced. The SPIFFE validator used for the certs of
+// peers ignores revocation lists, so every filter chain is duplicated for the
+// SNIs peers dial, and only those duplicates accept the certs of peers. The
+// original chains validate the certs of the local trust domain against the
+// revocation lists.
+func (s *ResourceGenerator) injectConnectTLSForPublicListenerWithRevocation(
+	cfgSnap *proxycfg.ConfigSnapshot,
+	listener *envoy_listener_v3.Listener,
+	proxyCfg *config.ProxyConfig,
+	peerBundles []*pbpeering.PeeringTrustBundle,
+) error {
+	localTransportSocket, err := createDownstreamTransportSocketForConnectTLS(cfgSnap, proxyCfg, nil)
+	if err != nil {
+		return err
+	}
+
+	tlsContext := makeCommonTLSContext(
+		cfgSnap.Leaf(),
+		cfgSnap.RootPEMs(),
+		makeTLSParametersFromProxyTLSConfig(cfgSnap.MeshConfigTLSIncoming()),
+	)
+	if tlsContext != nil {
+		tlsContext.AlpnProtocols = getAlpnProtocols(proxyCfg.Protocol)
+	}
+	if err := injectSpiffeValidatorConfigForPeersOnly(cfgSnap, tlsContext, peerBundles); err != nil {
+		return err
+	}
+	peerTransportSocket, err := makeDownstreamTLSTransportSocket(&envoy_tls_v3.DownstreamTlsContext{
+		CommonTlsContext:         tlsContext,
+		RequireClientCertificate: &wrapperspb.BoolValue{Value: true},
+	})
+	if err != nil {
+		return err
+	}
+
+	svc := cfgSnap.Proxy.DestinationServiceName
+	var peerSNIs []string
+	for _, bundle := range peerBundles {
+		peerSNIs = append(peerSNIs, connect.PeeredServiceSNI(svc, cfgSnap.ProxyID.NamespaceOrDefault(), cfgSnap.ProxyID.PartitionOrDefault(), bundle.PeerName, cfgSnap.Roots.TrustDomain))
+	}
+
+	chains := listener.FilterChains
+	listener.FilterChains = make([]*envoy_listener_v3.FilterChain, 0, 2*len(chains))
+	for _, chain := range chains {
+		peerChain := proto.Clone(chain).(*envoy_listener_v3.FilterChain)
+		if peerChain.FilterChainMatch == nil {
+			peerChain.FilterChainMatch = &envoy_listener_v3.FilterChainMatch{}
+		}
+		peerChain.FilterChainMatch.ServerNames = peerSNIs
+		peerChain.TransportSocket = peerTransportSocket
+
+		chain.TransportSocket = localTransportSocket
+		listener.FilterChains = append(listener.FilterChains, peerChain, chain)
+	}
+
+	// The SNI is only known to the filter chain match with the TLS inspector.
+	tlsInspector, err := makeTLSInspectorListenerFilter()
+	if err != nil {
+		return err
+	}
+	listener.ListenerFilters = append(listener.ListenerFilters, tlsInspector)
+	return nil
+}
+
 func getAlpnProtocols(protocol string) []string {
 	var alpnProtocols []string
 
@@ -1183,6 +1257,7 @@ func createDownstreamTransportSocketForConnectTLS(cfgSnap *proxycfg.ConfigSnapsh
 	if err != nil {
 		return nil, err
 	}
+	injectRevocationList(cfgSnap, tlsContext)
 
 	return makeDownstreamTLSTransportSocket(&envoy_tls_v3.DownstreamTlsContext{
 		CommonTlsContext:         tlsContext,
@@ -1195,7 +1270,17 @@ func injectSpiffeValidatorConfigForPeers(cfgSnap *proxycfg.ConfigSnapshot, tlsCo
 		return nil
 	}
 
-	spiffeConfig, err := makeSpiffeValidatorConfig(cfgSnap.Roots.TrustDomain, cfgSnap.RootPEMs(), peerBundles)
+	return injectSpiffeValidatorConfig(tlsContext, cfgSnap.Roots.TrustDomain, cfgSnap.RootPEMs(), peerBundles)
+}
+
+// injectSpiffeValidatorConfigForPeersOnly configures tlsContext to accept the
+// certs of the given peers only, and none of the local trust domain.
+func injectSpiffeValidatorConfigForPeersOnly(cfgSnap *proxycfg.ConfigSnapshot, tlsContext *envoy_tls_v3.CommonTlsContext, peerBundles []*pbpeering.PeeringTrustBundle) error {
+	return injectSpiffeValidatorConfig(tlsContext, cfgSnap.Roots.TrustDomain, "", peerBundles)
+}
+
+func injectSpiffeValidatorConfig(tlsContext *envoy_tls_v3.CommonTlsContext, trustDomain, roots string, peerBundles []*pbpeering.PeeringTrustBundle) error {
+	spiffeConfig, err := makeSpiffeValidatorConfig(trustDomain, roots, peerBundles)
 	if err != nil {
 		return err
 	}
@@ -1220,9 +1305,13 @@ func injectSpiffeValidatorConfigForPeers(cfgSnap *proxycfg.ConfigSnapshot, tlsCo
 // With cluster peering we expect peered clusters to have independent certificate authorities.
 // This means that we cannot use a single set of root CA certificates to validate client certificates for mTLS,
 // but rather we need to validate against different roots depending on the trust domain of the certificate presented.
+// The local trust domain is left out when roots is empty.
 func makeSpiffeValidatorConfig(trustDomain, roots string, peerBundles []*pbpeering.PeeringTrustBundle) (*anypb.Any, error) {
 	// Store the trust bundle for the local trust domain.
-	bundles := map[string]string{trustDomain: roots}
+	bundles := make(map[string]string)
+	if roots != "" {
+		bundles[trustDomain] = roots
+	}
 
 	// Store the trust bundle for each trust domain of the peers this proxy is exported to.
 	// This allows us to validate traffic from other trust domains.
@@ -1414,20 +1503,18 @@ func (s *ResourceGenerator) makeInboundListener(cfgSnap *proxycfg.ConfigSnapshot
 		}
 	}
 
-	filter, err := makeListenerFilter(filterOpts)
+	filters, err := makeListenerFilters(filterOpts)
 	if err != nil {
 		return nil, err
 	}
 
 	if len(l.FilterChains) > 0 {
 		// The list of FilterChains has already been initialized
-		l.FilterChains[0].Filters = append(l.FilterChains[0].Filters, filter)
+		l.FilterChains[0].Filters = append(l.FilterChains[0].Filters, filters...)
 	} else {
 		l.FilterChains = []*envoy_listener_v3.FilterChain{
 			{
-				Filters: []*envoy_listener_v3.Filter{
-					filter,
-				},
+				Filters: filters,
 			},
 		}
 	}
@@ -1669,11 +1756,11 @@ func (s *ResourceGenerator) makeTerminatingGatewayListener(
 			peerTrustBundles: peerTrustBundles,
 		}
 
-		clusterChain, err := s.makeFilterChainTerminatingGateway(cfgSnap, opts)
+		clusterChains, err := s.makeFilterChainsTerminatingGateway(cfgSnap, opts)
 		if err != nil {
 			return nil, fmt.Errorf("failed to make filter chain for cluster %q: %v", clusterName, err)
 		}
-		l.FilterChains = append(l.FilterChains, clusterChain)
+		l.FilterChains = append(l.FilterChains, clusterChains...)
 
 		// if there is a service-resolver for this service then also setup subset filter chains for it
 		if hasResolver {
@@ -1682,11 +1769,11 @@ func (s *ResourceGenerator) makeTerminatingGatewayListener(
 				subsetClusterName := connect.ServiceSNI(svc.Name, subsetName, svc.NamespaceOrDefault(), svc.PartitionOrDefault(), cfgSnap.Datacenter, cfgSnap.Roots.TrustDomain)
 
 				opts.cluster = subsetClusterName
-				subsetClusterChain, err := s.makeFilterChainTerminatingGateway(cfgSnap, opts)
+				subsetClusterChains, err := s.makeFilterChainsTerminatingGateway(cfgSnap, opts)
 				if err != nil {
 					return nil, fmt.Errorf("failed to make filter chain for cluster %q: %v", subsetClusterName, err)
 				}
-				l.FilterChains = append(l.FilterChains, subsetClusterChain)
+				l.FilterChains = append(l.FilterChains, subsetClusterChains...)
 			}
 		}
 	}
@@ -1722,11 +1809,11 @@ func (s *ResourceGenerator) makeTerminatingGatewayListener(
 
 			opts.cluster = clusterName
 			opts.address = address
-			clusterChain, err := s.makeFilterChainTerminatingGateway(cfgSnap, opts)
+			clusterChains, err := s.makeFilterChainsTerminatingGateway(cfgSnap, opts)
 			if err != nil {
 				return nil, fmt.Errorf("failed to make filter chain for cluster %q: %v", clusterName, err)
 			}
-			l.FilterChains = append(l.FilterChains, clusterChain)
+			l.FilterChains = append(l.FilterChains, clusterChains...)
 		}
 
 	}
@@ -1781,7 +1868,10 @@ type terminatingGatewayFilterChainOpts struct {
 	peerTrustBundles []*pbpeering.PeeringTrustBundle
 }
 
-func (s *ResourceGenerator) makeFilterChainTerminatingGateway(cfgSnap *proxycfg.ConfigSnapshot, tgtwyOpts terminatingGatewayFilterChainOpts) (*envoy_listener_v3.FilterChain, error) {
+// makeFilterChainsTerminatingGateway returns the filter chains of a service
+// behind the terminating gateway. Peers get a separate chain when certificate
+// revocation lists are enforced, since the SPIFFE validator ignores them.
+func (s *ResourceGenerator) makeFilterChainsTerminatingGateway(cfgSnap *proxycfg.ConfigSnapshot, tgtwyOpts terminatingGatewayFilterChainOpts) ([]*envoy_listener_v3.FilterChain, error) {
 	// We need to at least match the SNI and use the root PEMs from the local cluster
 	sniMatches := []string{tgtwyOpts.cluster}
 
@@ -1796,18 +1886,32 @@ func (s *ResourceGenerator) makeFilterChainTerminatingGateway(cfgSnap *proxycfg.
 
 	// For TCP connections, TLS is not terminated at the mesh gateway but is instead proxied through;
 	// therefore, we need to account for callers from other datacenters when setting up our filter chain.
+	var (
+		peerSNIMatches []string
+		peerTLSContext *envoy_tls_v3.DownstreamTlsContext
+	)
 	if tgtwyOpts.protocol == "tcp" {
 		for _, bundle := range tgtwyOpts.peerTrustBundles {
 			svc := tgtwyOpts.service
 			sourceSNI := connect.PeeredServiceSNI(svc.Name, svc.NamespaceOrDefault(), svc.PartitionOrDefault(), bundle.PeerName, cfgSnap.Roots.TrustDomain)
-			sniMatches = append(sniMatches, sourceSNI)
+			peerSNIMatches = append(peerSNIMatches, sourceSNI)
 		}
 
-		err := injectSpiffeValidatorConfigForPeers(cfgSnap, tlsContext.CommonTlsContext, tgtwyOpts.peerTrustBundles)
-		if err != nil {
-			return nil, err
+		if len(peerSNIMatches) > 0 && cfgSnap.RevocationListPEMs() != "" {
+			peerTLSContext = proto.Clone(tlsContext).(*envoy_tls_v3.DownstreamTlsContext)
+			err := injectSpiffeValidatorConfigForPeersOnly(cfgSnap, peerTLSContext.CommonTlsContext, tgtwyOpts.peerTrustBundles)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			sniMatches = append(sniMatches, peerSNIMatches...)
+			err := injectSpiffeValidatorConfigForPeers(cfgSnap, tlsContext.CommonTlsContext, tgtwyOpts.peerTrustBundles)
+			if err != nil {
+				return nil, err
+			}
 		}
 	}
+	injectRevocationList(cfgSnap, tlsContext.CommonTlsContext)
 
 	transportSocket, err := makeDownstreamTLSTransportSocket(tlsContext)
 	if err != nil {
@@ -1901,7 +2005,18 @@ func (s *ResourceGenerator) makeFilterChainTerminatingGateway(cfgSnap *proxycfg.
 	}
 	filterChain.Filters = append(filterChain.Filters, filter)
 
-	return filterChain, nil
+	if peerTLSContext == nil {
+		return []*envoy_listener_v3.FilterChain{filterChain}, nil
+	}
+
+	peerTransportSocket, err := makeDownstreamTLSTransportSocket(peerTLSContext)
+	if err != nil {
+		return nil, err
+	}
+	peerFilterChain := proto.Clone(filterChain).(*envoy_listener_v3.FilterChain)
+	peerFilterChain.FilterChainMatch = makeSNIFilterChainMatch(peerSNIMatches...)
+	peerFilterChain.TransportSocket = peerTransportSocket
+	return []*envoy_listener_v3.FilterChain{filterChain, peerFilterChain}, nil
 }
 
 func (s *ResourceGenerator) makeMeshGatewayListener(name, addr string, port int, cfgSnap *proxycfg.ConfigSnapshot) (*envoy_listener_v3.Listener, error) {
@@ -2227,13 +2342,14 @@ type filterChainOpts struct {
 	forwardClientDetails bool
 	forwardClientPolicy  envoy_http_v3.HttpConnectionManager_ForwardClientCertDetails
 	tracing              *envoy_http_v3.HttpConnectionManager_Tracing
+	faultInjection       bool
 }
 
 func (s *ResourceGenerator) makeUpstreamFilterChain(opts filterChainOpts) (*envoy_listener_v3.FilterChain, error) {
 	if opts.statPrefix == "" {
 		opts.statPrefix = "upstream."
 	}
-	filter, err := makeListenerFilter(listenerFilterOpts{
+	filters, err := makeListenerFilters(listenerFilterOpts{
 		useRDS:               opts.useRDS,
 		fetchTimeoutRDS:      opts.fetchTimeoutRDS,
 		protocol:             opts.protocol,
@@ -2246,6 +2362,7 @@ func (s *ResourceGenerator) makeUpstreamFilterChain(opts filterChainOpts) (*envo
 		tracing:              opts.tracing,
 		accessLogs:           opts.accessLogs,
 		logger:               s.Logger,
+		faultInjection:       opts.faultInjection,
 	})
 	if err != nil {
 		return nil, err
@@ -2256,9 +2373,7 @@ func (s *ResourceGenerator) makeUpstreamFilterChain(opts filterChainOpts) (*envo
 		return nil, err
 	}
 	return &envoy_listener_v3.FilterChain{
-		Filters: []*envoy_listener_v3.Filter{
-			filter,
-		},
+		Filters:         filters,
 		TransportSocket: transportSocket,
 	}, nil
 }
@@ -2402,12 +2517,20 @@ type listenerFilterOpts struct {
 	headersWithUnderscoresAction envoy_core_v3.HttpProtocolOptions_HeadersWithUnderscoresAction
 	useRDS                       bool
 	fetchTimeoutRDS              *durationpb.Duration
+	faultInjection               bool
 }
 
 func makeListenerFilter(opts listenerFilterOpts) (*envoy_listener_v3.Filter, error) {
 	switch opts.protocol {
 	case "grpc", "http2", "http":
 		return makeHTTPFilter(opts)
+	case "redis":
+		if opts.useRDS {
+			return nil, fmt.Errorf("RDS is not compatible with the redis proxy filter")
+		} else if opts.cluster == "" {
+			return nil, fmt.Errorf("cluster name is required for a redis proxy filter")
+		}
+		return makeRedisProxyFilter(opts)
 	case "tcp":
 		fallthrough
 	default:
@@ -2618,6 +2741,16 @@ func makeHTTPFilter(opts listenerFilterOpts) (*envoy_listener_v3.Filter, error)
 		}
 	}
 
+	// The fault filter has to run before the router so that faults configured
+	// on the matched route are applied.
+	if opts.faultInjection {
+		fault, err := makeFaultInjectionFilter()
+		if err != nil {
+			return nil, err
+		}
+		cfg.HttpFilters = append([]*envoy_http_v3.HttpFilter{fault}, cfg.HttpFilters...)
+	}
+
 	// Like injectConnectFilters for L4, here we ensure that the first filter
 	// (other than the "envoy.grpc_http1_bridge" filter) in the http filter
 	// chain of a public listener is the authz filter to prevent unauthorized
@@ -2736,6 +2869,31 @@ func makeCommonTLSContext(
 	}
 }
 
+// injectRevocationList configures the validation context of tlsContext to
+// reject the revoked leaf certificates of the local trust domain. It is a
+// no-op if nothing is revoked or the context doesn't trust the local roots
+// only, since Envoy rejects certificates from issuers without a revocation
+// list once one is configured.
+func injectRevocationList(cfgSnap *proxycfg.ConfigSnapshot, tlsContext *envoy_tls_v3.CommonTlsContext) {
+	if tlsContext == nil {
+		return
+	}
+	crlPEMs := cfgSnap.RevocationListPEMs()
+	if crlPEMs == "" {
+		return
+	}
+	typ, ok := tlsContext.ValidationContextType.(*envoy_tls_v3.CommonTlsContext_ValidationContext)
+	if !ok || typ.ValidationContext.TrustedCa == nil {
+		return
+	}
+	typ.ValidationContext.Crl = &envoy_core_v3.DataSource{
+		Specifier: &envoy_core_v3.DataSource_InlineString{
+			InlineString: crlPEMs,
+		},
+	}
+	typ.ValidationContext.OnlyVerifyLeafCertCrl = true
+}
+
 func makeDownstreamTLSTransportSocket(tlsContext *envoy_tls_v3.DownstreamTlsContext) (*envoy_core_v3.TransportSocket, error) {
 	if tlsContext == nil {
 		return nil, nil
@@ -5,6 +5,7 @@ package xds
 
 import (
 	"fmt"
+	"sort"
 
 	envoy_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
@@ -33,7 +34,10 @@ func (s *ResourceGenerator) makeAPIGatewayListeners(address string, cfgSnap *pro
 		boundListener := readyListener.boundListenerCfg
 
 		// Collect the referenced certificate config entries
-		var certs []structs.ConfigEntry
+		var (
+			certs       []structs.ConfigEntry
+			pendingACME bool
+		)
 		for _, certRef := range boundListener.Certificates {
 			switch certRef.Kind {
 			case structs.InlineCertificate:
@@ -44,10 +48,22 @@ func (s *ResourceGenerator) makeAPIGatewayListeners(address string, cfgSnap *pro
 				if cert, ok := cfgSnap.APIGateway.FileSystemCertificates.Get(certRef); ok {
 					certs = append(certs, cert)
 				}
+			case structs.ACMECertificate:
+				// acme-certificates are served like inline-certificates once issued
+				if cert, ok := cfgSnap.APIGateway.ACMECertificates.Get(certRef); ok && cert != nil && cert.IsIssued() {
+					certs = append(certs, cert.ToInlineCertificate())
+				} else {
+					pendingACME = true
+				}
 			}
 		}
 
 		isAPIGatewayWithTLS := len(boundListener.Certificates) > 0
+		if len(certs) == 0 && pendingACME {
+			// Wait until an acme-certificate is issued rather than serving TLS
+			// without any certificate.
+			continue
+		}
 
 		tlsContext, err := makeDownstreamTLSContextFromSnapshotAPIListenerConfig(cfgSnap, listenerCfg)
 		if err != nil {
@@ -143,6 +159,15 @@ func (s *ResourceGenerator) makeAPIGatewayListeners(address string, cfgSnap *pro
 			}
 			resources = append(resources, l)
 
+		} else if listenerCfg.Protocol == structs.ListenerProtocolTLS {
+			l, err := s.makeAPIGatewayTLSPassthroughListener(address, cfgSnap, readyListener)
+			if err != nil {
+				return nil, err
+			}
+			if l != nil {
+				resources = append(resources, l)
+			}
+
 		} else {
 			// If multiple upstreams share this port, make a special listener for the protocol.
 			listenerOpts := makeListenerOpts{
@@ -157,7 +182,7 @@ func (s *ResourceGenerator) makeAPIGatewayListeners(address string, cfgSnap *pro
 
 			routes := make([]*structs.HTTPRouteConfigEntry, 0, len(readyListener.routeReferences))
 			for _, routeRef := range maps.Keys(readyListener.routeReferences) {
-				route, ok := cfgSnap.APIGateway.HTTPRoutes.Get(routeRef)
+				route, ok := cfgSnap.APIGateway.HTTPRoute(routeRef)
 				if !ok {
 					return nil, fmt.Errorf("missing route for routeRef %s:%s", routeRef.Kind, routeRef.Name)
 				}
@@ -272,6 +297,99 @@ func (s *ResourceGenerator) makeAPIGatewayListeners(address string, cfgSnap *pro
 	return resources, nil
 }
 
+// makeAPIGatewayTLSPassthroughListener builds a listener that inspects the SNI
+// of incoming TLS connections and proxies them, still encrypted, to the service
+// of the tls-route that claims the requested hostname.
+func (s *ResourceGenerator) makeAPIGatewayTLSPassthroughListener(address string, cfgSnap *proxycfg.ConfigSnapshot, readyListener readyListener) (*envoy_listener_v3.Listener, error) {
+	listenerKey := readyListener.listenerKey
+
+	l := makeListener(makeListenerOpts{
+		name:       listenerKey.Protocol,
+		accessLogs: cfgSnap.Proxy.AccessLogs,
+		addr:       address,
+		port:       listenerKey.Port,
+		direction:  envoy_core_v3.TrafficDirection_OUTBOUND,
+		logger:     s.Logger,
+	})
+
+	// Routes are validated to only use leading wildcards, but two routes may
+	// still claim the same hostname. The first route to bind wins, since Envoy
+	// rejects filter chains with identical matches.
+	claimedHosts := make(map[string]struct{})
+	hasDefault := false
+	for _, u := range readyListener.upstreams {
+		uid := proxycfg.NewUpstreamID(&u)
+
+		chain := cfgSnap.APIGateway.DiscoveryChain[uid]
+		if chain == nil {
+			// Wait until a chain is present in the snapshot.
+			continue
+		}
+
+		// A "*" hostname accepts any SNI, so it becomes the catch-all chain.
+		wildcard := len(u.IngressHosts) == 0
+		var hosts []string
+		for _, host := range u.IngressHosts {
+			if host == "*" {
+				wildcard = true
+				break
+			}
+			if _, ok := claimedHosts[host]; !ok {
+				claimedHosts[host] = struct{}{}
+				hosts = append(hosts, host)
+			}
+		}
+		if wildcard {
+			if hasDefault {
+				continue
+			}
+			hasDefault = true
+			hosts = nil
+		} else if len(hosts) == 0 {
+			continue
+		}
+
+		target, err := simpleChainTarget(chain)
+		if err != nil {
+			return nil, err
+		}
+
+		filter, err := makeTCPProxyFilter(listenerFilterOpts{
+			cluster:    naming.CustomizeClusterName(target.Name, chain),
+			filterName: fmt.Sprintf("%s.%s.%s.%s", chain.ServiceName, chain.Namespace, chain.Partition, chain.Datacenter),
+			statPrefix: "ingress_upstream_",
+			accessLogs: &cfgSnap.Proxy.AccessLogs,
+			logger:     s.Logger,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		l.FilterChains = append(l.FilterChains, &envoy_listener_v3.FilterChain{
+			FilterChainMatch: makeSNIFilterChainMatch(hosts...),
+			Filters:          []*envoy_listener_v3.Filter{filter},
+		})
+	}
+
+	if len(l.FilterChains) == 0 {
+		return nil, nil
+	}
+
+	// Envoy evaluates the most specific server name first, but the catch-all
+	// chain must be last to keep the output stable.
+	sort.SliceStable(l.FilterChains, func(i, j int) bool {
+		return l.FilterChains[i].FilterChainMatch != nil && l.FilterChains[j].FilterChainMatch == nil
+	})
+
+	tlsInspector, err := makeTLSInspectorListenerFilter()
+	if err != nil {
+		return nil, err
+	}
+	l.ListenerFilters = []*envoy_listener_v3.ListenerFilter{tlsInspector}
+
+	return l, nil
+}
+
 // helper struct to persist upstream parent information when ready upstream list is built out
 type readyListener struct {
 	listenerKey      proxycfg.APIGatewayListenerKey
@@ -4,6 +4,7 @@
 package xds
 
 import (
+	"errors"
 	"fmt"
 
 	envoy_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
@@ -30,7 +31,11 @@ func (s *ResourceGenerator) makeIngressGatewayListeners(address string, cfgSnap
 		}
 
 		tlsContext, err := makeDownstreamTLSContextFromSnapshotListenerConfig(cfgSnap, listenerCfg)
-		if err != nil {
+		if errors.Is(err, errACMECertificateNotIssued) {
+			// Wait until the acme-certificate the listener serves is issued
+			// rather than serving it without TLS.
+			continue
+		} else if err != nil {
 			return nil, err
 		}
 
@@ -122,6 +127,13 @@ func (s *ResourceGenerator) makeIngressGatewayListeners(address string, cfgSnap
 				logger:           s.Logger,
 			}
 
+			for _, u := range upstreams {
+				if cfgSnap.IngressGateway.DiscoveryChain[proxycfg.NewUpstreamID(&u)].HasFaultInjection() {
+					filterOpts.faultInjection = true
+					break
+				}
+			}
+
 			// Generate any filter chains needed for services with custom TLS certs
 			// via SDS.
 			sniFilterChains, err := makeSDSOverrideFilterChains(cfgSnap, listenerKey, filterOpts)
@@ -207,7 +219,13 @@ func makeCommonTLSContextFromSnapshotListenerConfig(cfgSnap *proxycfg.ConfigSnap
 		return nil, err
 	}
 
-	if tlsCfg.SDS != nil {
+	if tlsCfg.ACMECertificate != "" {
+		cert := ingressACMECertificate(cfgSnap, tlsCfg.ACMECertificate)
+		if cert == nil {
+			return nil, errACMECertificateNotIssued
+		}
+		tlsContext = makeInlineTLSContextFromGatewayTLSConfig(*tlsCfg, cert)
+	} else if tlsCfg.SDS != nil {
 		// Set up listener TLS from SDS
 		tlsContext = makeCommonTLSContextFromGatewayTLSConfig(*tlsCfg)
 	} else if connectTLSEnabled {
@@ -217,15 +235,59 @@ func makeCommonTLSContextFromSnapshotListenerConfig(cfgSnap *proxycfg.ConfigSnap
 	return tlsContext, nil
 }
 
+// errACMECertificateNotIssued is returned when building the TLS context of an
+// ingress listener that serves an acme-certificate that hasn't been issued.
+var errACMECertificateNotIssued = errors.New("acme-certificate has not been issued")
+
+// ingressACMECertificate returns the certificate issued for the named
+// acme-certificate referenced by the ingress gateway, or nil if it hasn't been
+// issued or loaded.
+func ingressACMECertificate(cfgSnap *proxycfg.ConfigSnapshot, name string) *structs.InlineCertificateConfigEntry {
+	cert, ok := cfgSnap.IngressGateway.ACMECertificates.Get(structs.ResourceReference{
+		Kind:           structs.ACMECertificate,
+		Name:           name,
+		EnterpriseMeta: cfgSnap.ProxyID.EnterpriseMeta,
+	})
+	if !ok || cert == nil {
+		return nil
+	}
+	return cert.ToInlineCertificate()
+}
+
+// ingressListenerUsesTLS returns whether the ingress listener terminates TLS.
+func ingressListenerUsesTLS(cfgSnap *proxycfg.ConfigSnapshot, listenerCfg structs.IngressListener) (bool, error) {
+	gatewayTLSCfg := cfgSnap.IngressGateway.TLSConfig
+	if gatewayTLSCfg.Enabled || (listenerCfg.TLS != nil && listenerCfg.TLS.Enabled) {
+		return true, nil
+	}
+	tlsCfg, err := resolveListenerTLSConfig(&gatewayTLSCfg, listenerCfg)
+	if err != nil {
+		return false, err
+	}
+	return tlsCfg.ACMECertificate != "" || tlsCfg.SDS != nil, nil
+}
+
 func resolveListenerTLSConfig(gatewayTLSCfg *structs.GatewayTLSConfig, listenerCfg structs.IngressListener) (*structs.GatewayTLSConfig, error) {
 	var mergedCfg structs.GatewayTLSConfig
 
-	resolvedSDSCfg, err := resolveListenerSDSConfig(gatewayTLSCfg.SDS, listenerCfg.TLS, listenerCfg.Port)
-	if err != nil {
-		return nil, err
+	// A listener's own acme-certificate or SDS config overrides the gateway's
+	// acme-certificate.
+	switch {
+	case listenerCfg.TLS != nil && listenerCfg.TLS.ACMECertificate != "":
+		mergedCfg.ACMECertificate = listenerCfg.TLS.ACMECertificate
+	case listenerCfg.TLS != nil && listenerCfg.TLS.SDS != nil:
+	case gatewayTLSCfg != nil:
+		mergedCfg.ACMECertificate = gatewayTLSCfg.ACMECertificate
 	}
 
-	mergedCfg.SDS = resolvedSDSCfg
+	if mergedCfg.ACMECertificate == "" {
+		resolvedSDSCfg, err := resolveListenerSDSConfig(gatewayTLSCfg.SDS, listenerCfg.TLS, listenerCfg.Port)
+		if err != nil {
+			return nil, err
+		}
+
+		mergedCfg.SDS = resolvedSDSCfg
+	}
 
 	if gatewayTLSCfg != nil {
 		mergedCfg.TLSMinVersion = gatewayTLSCfg.TLSMinVersion
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package xds
+
+import (
+	"time"
+
+	envoy_kafka_broker_v3 "github.com/envoyproxy/go-control-plane/contrib/envoy/extensions/filters/network/kafka_broker/v3"
+	envoy_postgres_proxy_v3alpha "github.com/envoyproxy/go-control-plane/contrib/envoy/extensions/filters/network/postgres_proxy/v3alpha"
+	envoy_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_redis_proxy_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/redis_proxy/v3"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// defaultRedisOpTimeout is used for redis commands when no request timeout
+// is configured, since Envoy requires one.
+const defaultRedisOpTimeout = 5 * time.Second
+
+// makeRedisProxyFilter returns a redis_proxy filter that sends every command
+// to the cluster in opts. Unlike the other protocol filters it terminates the
+// filter chain, so it is used instead of a tcp proxy.
+func makeRedisProxyFilter(opts listenerFilterOpts) (*envoy_listener_v3.Filter, error) {
+	opTimeout := defaultRedisOpTimeout
+	if opts.requestTimeoutMs != nil && *opts.requestTimeoutMs > 0 {
+		opTimeout = time.Duration(*opts.requestTimeoutMs) * time.Millisecond
+	}
+
+	cfg := &envoy_redis_proxy_v3.RedisProxy{
+		StatPrefix: makeStatPrefix(opts.statPrefix, opts.filterName),
+		Settings: &envoy_redis_proxy_v3.RedisProxy_ConnPoolSettings{
+			OpTimeout: durationpb.New(opTimeout),
+		},
+		PrefixRoutes: &envoy_redis_proxy_v3.RedisProxy_PrefixRoutes{
+			CatchAllRoute: &envoy_redis_proxy_v3.RedisProxy_PrefixRoutes_Route{
+				Cluster: opts.cluster,
+			},
+		},
+	}
+	return makeFilter("envoy.filters.network.redis_proxy", cfg)
+}
+
+// makeProtocolNetworkFilter returns the network filter that decodes traffic of
+// the given protocol for metrics ahead of the tcp proxy, or nil if the
+// protocol has none.
+func makeProtocolNetworkFilter(opts listenerFilterOpts) (*envoy_listener_v3.Filter, error) {
+	statPrefix := makeStatPrefix(opts.statPrefix, opts.filterName)
+
+	switch opts.protocol {
+	case "postgres":
+		return makeFilter("envoy.filters.network.postgres_proxy", &envoy_postgres_proxy_v3alpha.PostgresProxy{
+			StatPrefix: statPrefix,
+		})
+	case "kafka":
+		return makeFilter("envoy.filters.network.kafka_broker", &envoy_kafka_broker_v3.KafkaBroker{
+			StatPrefix: statPrefix,
+		})
+	default:
+		return nil, nil
+	}
+}
+
+// makeListenerFilters returns the filters for a filter chain: the terminal
+// filter built by makeListenerFilter, preceded by the protocol network filter
+// if there is one.
+func makeListenerFilters(opts listenerFilterOpts) ([]*envoy_listener_v3.Filter, error) {
+	filter, err := makeListenerFilter(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	protocolFilter, err := makeProtocolNetworkFilter(opts)
+	if err != nil {
+		return nil, err
+	}
+	if protocolFilter == nil {
+		return []*envoy_listener_v3.Filter{filter}, nil
+	}
+	return []*envoy_listener_v3.Filter{protocolFilter, filter}, nil
+}
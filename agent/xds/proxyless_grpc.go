@@ -0,0 +1,301 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package xds
+
+import (
+	"errors"
+	"fmt"
+
+	envoy_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_http_router_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/router/v3"
+	envoy_http_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoy_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/hashicorp/consul/agent/connect"
+	"github.com/hashicorp/consul/agent/proxycfg"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/envoyextensions/xdscommon"
+)
+
+const (
+	// proxylessGRPCNodeMetaKey is the node metadata key that a gRPC client
+	// sets to true in its xDS bootstrap to receive proxyless resources.
+	proxylessGRPCNodeMetaKey = "proxyless_grpc"
+
+	// proxylessGRPCCertProviderInstance is the certificate provider instance
+	// that proxyless clients must define in their bootstrap. It supplies both
+	// the leaf certificate and the CA roots of the service.
+	proxylessGRPCCertProviderInstance = "consul"
+)
+
+// isProxylessGRPC returns true if the node identifies itself as a proxyless
+// gRPC client rather than an Envoy proxy.
+func isProxylessGRPC(node *envoy_core_v3.Node) bool {
+	return node.GetMetadata().GetFields()[proxylessGRPCNodeMetaKey].GetBoolValue()
+}
+
+// ProxylessGRPCResourcesFromSnapshot returns the xDS resources for a proxyless
+// gRPC client, keyed by type URL. The client uses the snapshot of the
+// connect-proxy it is registered as, but only the subset of resources that
+// grpc-go understands is generated: an API listener per upstream, the
+// upstream routes, clusters and endpoints. Secrets are not served since the
+// client loads its certificates through certificate providers.
+func (g *ResourceGenerator) ProxylessGRPCResourcesFromSnapshot(cfgSnap *proxycfg.ConfigSnapshot) (map[string][]proto.Message, error) {
+	if cfgSnap == nil {
+		return nil, errors.New("nil config given")
+	}
+	if cfgSnap.Kind != structs.ServiceKindConnectProxy {
+		return nil, fmt.Errorf("proxyless gRPC clients must be registered as a %s, got %q", structs.ServiceKindConnectProxy, cfgSnap.Kind)
+	}
+
+	listeners, err := g.listenersFromSnapshotProxylessGRPC(cfgSnap)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate xDS resources for %q: %v", xdscommon.ListenerType, err)
+	}
+	routes, err := g.routesFromSnapshot(cfgSnap)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate xDS resources for %q: %v", xdscommon.RouteType, err)
+	}
+	clusters, err := g.clustersFromSnapshotProxylessGRPC(cfgSnap)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate xDS resources for %q: %v", xdscommon.ClusterType, err)
+	}
+	endpoints, err := g.endpointsFromSnapshot(cfgSnap)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate xDS resources for %q: %v", xdscommon.EndpointType, err)
+	}
+
+	return map[string][]proto.Message{
+		xdscommon.ListenerType: listeners,
+		xdscommon.RouteType:    routes,
+		xdscommon.ClusterType:  clusters,
+		xdscommon.EndpointType: endpoints,
+	}, nil
+}
+
+// listenersFromSnapshotProxylessGRPC returns an API listener for every
+// upstream of the proxy. Listeners are named after the upstream's Envoy ID,
+// which is the target a client dials, e.g. "xds:///db" or
+// "xds:///prepared_query:geo-cache".
+func (s *ResourceGenerator) listenersFromSnapshotProxylessGRPC(cfgSnap *proxycfg.ConfigSnapshot) ([]proto.Message, error) {
+	var resources []proto.Message
+
+	upstreamsSnapshot, err := cfgSnap.ToConfigSnapshotUpstreams()
+	if err != nil {
+		return nil, err
+	}
+
+	for uid, chain := range cfgSnap.ConnectProxy.DiscoveryChain {
+		if _, skip := cfgSnap.ConnectProxy.GetUpstream(uid, &cfgSnap.ProxyID.EnterpriseMeta); skip {
+			continue
+		}
+
+		// As with Envoy, RDS is only used for a customized discovery chain.
+		useRDS := structs.IsProtocolHTTPLike(chain.Protocol) && !chain.Default
+
+		var clusterName string
+		if !useRDS {
+			target, err := simpleChainTarget(chain)
+			if err != nil {
+				return nil, err
+			}
+
+			clusterName = s.getTargetClusterName(upstreamsSnapshot, chain, target.ID, false)
+			if clusterName == "" {
+				continue
+			}
+		}
+
+		l, err := makeProxylessGRPCListener(uid.EnvoyID(), clusterName, useRDS)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, l)
+	}
+
+	for _, uid := range cfgSnap.ConnectProxy.PeeredUpstreamIDs() {
+		if _, skip := cfgSnap.ConnectProxy.GetUpstream(uid, &cfgSnap.ProxyID.EnterpriseMeta); skip {
+			continue
+		}
+
+		tbs, ok := cfgSnap.ConnectProxy.UpstreamPeerTrustBundles.Get(uid.Peer)
+		if !ok {
+			return nil, fmt.Errorf("trust bundle not ready for peer %s", uid.Peer)
+		}
+
+		l, err := makeProxylessGRPCListener(uid.EnvoyID(), generatePeeredClusterName(uid, tbs), false)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, l)
+	}
+
+	// Prepared queries do not have discovery chains.
+	for uid, u := range cfgSnap.ConnectProxy.UpstreamConfig {
+		if u.DestinationType != structs.UpstreamDestTypePreparedQuery {
+			continue
+		}
+
+		clusterName := connect.UpstreamSNI(u, "", cfgSnap.Datacenter, cfgSnap.Roots.TrustDomain)
+		l, err := makeProxylessGRPCListener(uid.EnvoyID(), clusterName, false)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, l)
+	}
+
+	return resources, nil
+}
+
+// makeProxylessGRPCListener returns an API listener whose HTTP connection
+// manager either fetches the route named after the listener over ADS or,
+// without RDS, routes every request to clusterName.
+func makeProxylessGRPCListener(name, clusterName string, useRDS bool) (*envoy_listener_v3.Listener, error) {
+	router, err := makeEnvoyHTTPFilter("envoy.filters.http.router", &envoy_http_router_v3.Router{})
+	if err != nil {
+		return nil, err
+	}
+
+	hcm := &envoy_http_v3.HttpConnectionManager{
+		StatPrefix:  name,
+		HttpFilters: []*envoy_http_v3.HttpFilter{router},
+	}
+	if useRDS {
+		hcm.RouteSpecifier = &envoy_http_v3.HttpConnectionManager_Rds{
+			Rds: &envoy_http_v3.Rds{
+				RouteConfigName: name,
+				ConfigSource: &envoy_core_v3.ConfigSource{
+					ResourceApiVersion: envoy_core_v3.ApiVersion_V3,
+					ConfigSourceSpecifier: &envoy_core_v3.ConfigSource_Ads{
+						Ads: &envoy_core_v3.AggregatedConfigSource{},
+					},
+				},
+			},
+		}
+	} else {
+		hcm.RouteSpecifier = &envoy_http_v3.HttpConnectionManager_RouteConfig{
+			RouteConfig: &envoy_route_v3.RouteConfiguration{
+				Name: name,
+				VirtualHosts: []*envoy_route_v3.VirtualHost{
+					{
+						Name:    name,
+						Domains: []string{"*"},
+						Routes: []*envoy_route_v3.Route{
+							{
+								Match: makeDefaultRouteMatch(),
+								Action: &envoy_route_v3.Route_Route{
+									Route: &envoy_route_v3.RouteAction{
+										ClusterSpecifier: &envoy_route_v3.RouteAction_Cluster{
+											Cluster: clusterName,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	apiListener, err := anypb.New(hcm)
+	if err != nil {
+		return nil, err
+	}
+
+	return &envoy_listener_v3.Listener{
+		Name: name,
+		ApiListener: &envoy_listener_v3.ApiListener{
+			ApiListener: apiListener,
+		},
+	}, nil
+}
+
+// clustersFromSnapshotProxylessGRPC returns the upstream clusters of the proxy
+// in a form grpc-go accepts. Clusters of a type gRPC does not support, such as
+// the local app and passthrough clusters, are dropped, and the TLS context is
+// rewritten to use certificate providers instead of inline certificates.
+func (s *ResourceGenerator) clustersFromSnapshotProxylessGRPC(cfgSnap *proxycfg.ConfigSnapshot) ([]proto.Message, error) {
+	all, err := s.clustersFromSnapshot(cfgSnap)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []proto.Message
+	for _, res := range all {
+		c, ok := res.(*envoy_cluster_v3.Cluster)
+		if !ok || !isProxylessGRPCClusterType(c) {
+			continue
+		}
+		if err := makeProxylessGRPCTransportSocket(c); err != nil {
+			return nil, fmt.Errorf("cluster %q: %w", c.Name, err)
+		}
+		resources = append(resources, c)
+	}
+	return resources, nil
+}
+
+// isProxylessGRPCClusterType returns true for the discovery types that
+// grpc-go implements: EDS, logical DNS and aggregate clusters.
+func isProxylessGRPCClusterType(c *envoy_cluster_v3.Cluster) bool {
+	if ct := c.GetClusterType(); ct != nil {
+		return ct.Name == "envoy.clusters.aggregate"
+	}
+	switch c.GetType() {
+	case envoy_cluster_v3.Cluster_EDS, envoy_cluster_v3.Cluster_LOGICAL_DNS:
+		return true
+	default:
+		return false
+	}
+}
+
+// makeProxylessGRPCTransportSocket replaces the inline leaf certificate and
+// roots of the cluster's upstream TLS context with references to the
+// certificate provider instance, keeping the SNI and SAN matchers.
+func makeProxylessGRPCTransportSocket(c *envoy_cluster_v3.Cluster) error {
+	if c.TransportSocket == nil {
+		return nil
+	}
+
+	var tlsContext envoy_tls_v3.UpstreamTlsContext
+	if err := c.TransportSocket.GetTypedConfig().UnmarshalTo(&tlsContext); err != nil {
+		return err
+	}
+
+	// grpc-go only reads the untyped SAN matchers, so the typed matchers
+	// generated for Envoy are converted back.
+	var sanMatchers []*envoy_matcher_v3.StringMatcher
+	for _, m := range tlsContext.GetCommonTlsContext().GetValidationContext().GetMatchTypedSubjectAltNames() {
+		sanMatchers = append(sanMatchers, m.Matcher)
+	}
+
+	tlsContext.CommonTlsContext = &envoy_tls_v3.CommonTlsContext{
+		TlsCertificateProviderInstance: &envoy_tls_v3.CertificateProviderPluginInstance{
+			InstanceName:    proxylessGRPCCertProviderInstance,
+			CertificateName: "default",
+		},
+		ValidationContextType: &envoy_tls_v3.CommonTlsContext_ValidationContext{
+			ValidationContext: &envoy_tls_v3.CertificateValidationContext{
+				CaCertificateProviderInstance: &envoy_tls_v3.CertificateProviderPluginInstance{
+					InstanceName:    proxylessGRPCCertProviderInstance,
+					CertificateName: "ROOTCA",
+				},
+				MatchSubjectAltNames: sanMatchers, //nolint:staticcheck
+			},
+		},
+	}
+
+	transportSocket, err := makeUpstreamTLSTransportSocket(&tlsContext)
+	if err != nil {
+		return err
+	}
+	c.TransportSocket = transportSocket
+	return nil
+}
@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package xds
+
+import (
+	"path/filepath"
+	"sort"
+	"testing"
+
+	envoy_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/hashicorp/consul/agent/proxycfg"
+	"github.com/hashicorp/consul/agent/xds/response"
+	"github.com/hashicorp/consul/envoyextensions/xdscommon"
+	"github.com/hashicorp/consul/sdk/testutil"
+)
+
+func TestProxylessGRPCResourcesFromSnapshot(t *testing.T) {
+	cases := []struct {
+		name   string
+		create func(t *testing.T) *proxycfg.ConfigSnapshot
+	}{
+		{
+			name: "connect-proxy-with-tcp-chain",
+			create: func(t *testing.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "default", false, nil, nil)
+			},
+		},
+		{
+			name: "connect-proxy-with-grpc-router",
+			create: func(t *testing.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "grpc-router", false, nil, nil)
+			},
+		},
+		{
+			name: "connect-proxy-with-chain-and-failover-to-cluster-peer",
+			create: func(t *testing.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "failover-to-cluster-peer", false, nil, nil)
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewResourceGenerator(testutil.Logger(t), nil, false)
+
+			resources, err := g.ProxylessGRPCResourcesFromSnapshot(tt.create(t))
+			require.NoError(t, err)
+			require.NotContains(t, resources, xdscommon.SecretType)
+
+			for _, typeUrl := range []string{xdscommon.ListenerType, xdscommon.RouteType, xdscommon.ClusterType, xdscommon.EndpointType} {
+				prettyName := testTypeUrlToPrettyName[typeUrl]
+				t.Run(prettyName, func(t *testing.T) {
+					items := resources[typeUrl]
+					sort.Slice(items, func(i, j int) bool {
+						return xdscommon.GetResourceName(items[i]) < xdscommon.GetResourceName(items[j])
+					})
+
+					r, err := response.CreateResponse(typeUrl, "00000001", "00000001", items)
+					require.NoError(t, err)
+
+					gotJSON := protoToJSON(t, r)
+					expectedJSON := goldenSimple(t, filepath.Join("proxyless-grpc", prettyName, tt.name), gotJSON)
+					require.JSONEq(t, expectedJSON, gotJSON)
+				})
+			}
+		})
+	}
+}
+
+func TestProxylessGRPCResourcesFromSnapshot_Clusters(t *testing.T) {
+	snap := proxycfg.TestConfigSnapshotDiscoveryChain(t, "default", false, nil, nil)
+
+	g := NewResourceGenerator(testutil.Logger(t), nil, false)
+	resources, err := g.ProxylessGRPCResourcesFromSnapshot(snap)
+	require.NoError(t, err)
+
+	require.NotEmpty(t, resources[xdscommon.ClusterType])
+	for _, res := range resources[xdscommon.ClusterType] {
+		c := res.(*envoy_cluster_v3.Cluster)
+		require.NotEqual(t, xdscommon.LocalAppClusterName, c.Name)
+
+		var tlsContext envoy_tls_v3.UpstreamTlsContext
+		require.NoError(t, c.TransportSocket.GetTypedConfig().UnmarshalTo(&tlsContext))
+
+		// grpc-go rejects inline certificates and TLS parameters.
+		common := tlsContext.CommonTlsContext
+		require.Empty(t, common.TlsCertificates)
+		require.Nil(t, common.TlsParams)
+		require.Equal(t, proxylessGRPCCertProviderInstance, common.TlsCertificateProviderInstance.InstanceName)
+
+		validation := common.GetValidationContext()
+		require.Nil(t, validation.TrustedCa)
+		require.Equal(t, proxylessGRPCCertProviderInstance, validation.CaCertificateProviderInstance.InstanceName)
+		require.NotEmpty(t, validation.MatchSubjectAltNames) //nolint:staticcheck
+		require.Empty(t, validation.MatchTypedSubjectAltNames)
+	}
+}
+
+func TestProxylessGRPCResourcesFromSnapshot_NotConnectProxy(t *testing.T) {
+	snap := proxycfg.TestConfigSnapshotMeshGateway(t, "default", nil, nil)
+
+	g := NewResourceGenerator(testutil.Logger(t), nil, false)
+	_, err := g.ProxylessGRPCResourcesFromSnapshot(snap)
+	require.ErrorContains(t, err, "proxyless gRPC clients must be registered as a connect-proxy")
+}
+
+func TestIsProxylessGRPC(t *testing.T) {
+	require.False(t, isProxylessGRPC(nil))
+	require.False(t, isProxylessGRPC(&envoy_core_v3.Node{Id: "web-sidecar-proxy"}))
+	require.True(t, isProxylessGRPC(&envoy_core_v3.Node{
+		Id: "web-sidecar-proxy",
+		Metadata: &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"proxyless_grpc": structpb.NewBoolValue(true),
+			},
+		},
+	}))
+}
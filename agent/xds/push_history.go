@@ -0,0 +1,418 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package xds
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	envoy_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// DefaultPushHistoryLimit is the number of xDS responses kept per proxy.
+const DefaultPushHistoryLimit = 20
+
+// redactedValue replaces private keys in the resources returned by the push
+// history.
+const redactedValue = "<redacted>"
+
+// Statuses of a push, set as the proxy ACKs or NACKs the response.
+const (
+	PushStatusPending = "pending"
+	PushStatusACK     = "ack"
+	PushStatusNACK    = "nack"
+)
+
+// PushHistory keeps the most recent xDS responses sent to each proxy by the
+// delta server, so that operators can inspect what was pushed and whether
+// the proxy accepted it. The history of a proxy is kept while it has a stream
+// open to the server.
+type PushHistory struct {
+	limit int
+
+	mu         sync.Mutex
+	proxies    map[structs.ServiceID]*proxyPushHistory
+	lastStream uint64
+}
+
+type proxyPushHistory struct {
+	// service is the name of the proxy service, used to authorize reads of
+	// the history.
+	service string
+	records []*pushRecord
+
+	// streams is the number of streams open for the proxy.
+	streams int
+}
+
+// pushRecord is a single response sent to a proxy. Resources are kept in
+// their wire form and only rendered when the history is read.
+type pushRecord struct {
+	// stream identifies the stream the response was sent on. Nonces are only
+	// unique within a stream.
+	stream      uint64
+	time        time.Time
+	typeURL     string
+	nonce       string
+	status      string
+	errorDetail string
+	resources   []*envoy_discovery_v3.Resource
+	removed     []string
+}
+
+// NewPushHistory returns a PushHistory that keeps up to limit responses per
+// proxy.
+func NewPushHistory(limit int) *PushHistory {
+	return &PushHistory{
+		limit:   limit,
+		proxies: make(map[structs.ServiceID]*proxyPushHistory),
+	}
+}
+
+// pushStream records the responses sent to a proxy over a single stream.
+// A nil pushStream records nothing.
+type pushStream struct {
+	history *PushHistory
+	proxyID structs.ServiceID
+	id      uint64
+}
+
+// openStream starts recording the responses sent to the proxy over a new
+// stream. The stream must be closed once it ends.
+func (h *PushHistory) openStream(proxyID structs.ServiceID) *pushStream {
+	if h == nil || h.limit <= 0 {
+		return nil
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	p, ok := h.proxies[proxyID]
+	if !ok {
+		p = &proxyPushHistory{}
+		h.proxies[proxyID] = p
+	}
+	p.streams++
+	h.lastStream++
+
+	return &pushStream{history: h, proxyID: proxyID, id: h.lastStream}
+}
+
+// close stops recording for the stream. The history of the proxy is dropped
+// once none of its streams are open.
+func (s *pushStream) close() {
+	if s == nil {
+		return
+	}
+
+	h := s.history
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	p, ok := h.proxies[s.proxyID]
+	if !ok {
+		return
+	}
+	p.streams--
+	if p.streams <= 0 {
+		delete(h.proxies, s.proxyID)
+	}
+}
+
+// setService records the name of the proxy service once it is known.
+func (s *pushStream) setService(service string) {
+	if s == nil {
+		return
+	}
+
+	h := s.history
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if p, ok := h.proxies[s.proxyID]; ok {
+		p.service = service
+	}
+}
+
+// record adds a response sent to the proxy, evicting the oldest one if the
+// limit is reached.
+func (s *pushStream) record(resp *envoy_discovery_v3.DeltaDiscoveryResponse) {
+	if s == nil {
+		return
+	}
+
+	rec := &pushRecord{
+		stream:    s.id,
+		time:      time.Now().UTC(),
+		typeURL:   resp.TypeUrl,
+		nonce:     resp.Nonce,
+		status:    PushStatusPending,
+		resources: resp.Resources,
+		removed:   resp.RemovedResources,
+	}
+
+	h := s.history
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	p, ok := h.proxies[s.proxyID]
+	if !ok {
+		return
+	}
+	p.records = append(p.records, rec)
+	if len(p.records) > h.limit {
+		p.records = p.records[len(p.records)-h.limit:]
+	}
+}
+
+// ack marks the response with the given nonce as accepted by the proxy.
+func (s *pushStream) ack(nonce string) {
+	s.setStatus(nonce, PushStatusACK, "")
+}
+
+// nack marks the response with the given nonce as rejected by the proxy.
+func (s *pushStream) nack(nonce, errorDetail string) {
+	s.setStatus(nonce, PushStatusNACK, errorDetail)
+}
+
+func (s *pushStream) setStatus(nonce, status, errorDetail string) {
+	if s == nil {
+		return
+	}
+
+	h := s.history
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	p, ok := h.proxies[s.proxyID]
+	if !ok {
+		return
+	}
+	for _, rec := range p.records {
+		if rec.stream == s.id && rec.nonce == nonce {
+			rec.status = status
+			rec.errorDetail = errorDetail
+			return
+		}
+	}
+}
+
+// PushEntry is a response sent to a proxy, as returned by Get.
+type PushEntry struct {
+	Time        time.Time
+	TypeURL     string
+	Nonce       string
+	Status      string
+	ErrorDetail string `json:",omitempty"`
+	Resources   []PushResource
+	Removed     []string `json:",omitempty"`
+}
+
+// PushResource is a resource upserted by a push along with the changes since
+// the previous push of the same resource within the history. A resource with
+// no previous push has a single "add" operation holding all of it.
+type PushResource struct {
+	Name    string
+	Version string
+	Diff    []PushDiffOp
+}
+
+// PushDiffOp is a change to a resource. Path is a JSON pointer into the JSON
+// rendering of the resource and Op is one of "add", "remove" or "replace",
+// as in a JSON patch.
+type PushDiffOp struct {
+	Op   string
+	Path string
+	Old  interface{} `json:",omitempty"`
+	New  interface{} `json:",omitempty"`
+}
+
+// Get returns the name of the proxy service and up to limit of the most
+// recent responses sent to the proxy, oldest first. A limit of zero returns
+// the full history. It returns false if nothing was recorded for the proxy.
+func (h *PushHistory) Get(proxyID structs.ServiceID, limit int) (string, []PushEntry, bool, error) {
+	if h == nil {
+		return "", nil, false, nil
+	}
+
+	h.mu.Lock()
+	p, ok := h.proxies[proxyID]
+	if !ok {
+		h.mu.Unlock()
+		return "", nil, false, nil
+	}
+	service := p.service
+	records := make([]pushRecord, 0, len(p.records))
+	for _, rec := range p.records {
+		records = append(records, *rec)
+	}
+	h.mu.Unlock()
+
+	// Diffs are computed across the full history, so a resource's previous
+	// version may come from a push that is not returned.
+	type resourceKey struct{ typeURL, name string }
+	previous := make(map[resourceKey]interface{})
+
+	entries := make([]PushEntry, 0, len(records))
+	for _, rec := range records {
+		entry := PushEntry{
+			Time:        rec.time,
+			TypeURL:     rec.typeURL,
+			Nonce:       rec.nonce,
+			Status:      rec.status,
+			ErrorDetail: rec.errorDetail,
+			Resources:   make([]PushResource, 0, len(rec.resources)),
+			Removed:     rec.removed,
+		}
+
+		for _, res := range rec.resources {
+			current, err := renderPushResource(res.Resource)
+			if err != nil {
+				return "", nil, false, err
+			}
+
+			key := resourceKey{rec.typeURL, res.Name}
+			prev, ok := previous[key]
+			var diff []PushDiffOp
+			if ok {
+				diff = diffJSON("", prev, current)
+			} else {
+				diff = []PushDiffOp{{Op: "add", Path: "", New: current}}
+			}
+			previous[key] = current
+
+			entry.Resources = append(entry.Resources, PushResource{
+				Name:    res.Name,
+				Version: res.Version,
+				Diff:    diff,
+			})
+		}
+		sort.Slice(entry.Resources, func(i, j int) bool {
+			return entry.Resources[i].Name < entry.Resources[j].Name
+		})
+
+		for _, name := range rec.removed {
+			delete(previous, resourceKey{rec.typeURL, name})
+		}
+
+		entries = append(entries, entry)
+	}
+
+	if limit > 0 && len(entries) > limit {
+		entries = entries[len(entries)-limit:]
+	}
+	return service, entries, true, nil
+}
+
+// renderPushResource converts a resource to generic JSON values with its
+// private keys redacted.
+func renderPushResource(res *anypb.Any) (interface{}, error) {
+	data, err := protojson.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+
+	var out interface{}
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, err
+	}
+	redactPrivateKeys(out)
+	return out, nil
+}
+
+func redactPrivateKeys(v interface{}) {
+	switch x := v.(type) {
+	case map[string]interface{}:
+		for k, val := range x {
+			if k == "privateKey" {
+				x[k] = redactedValue
+				continue
+			}
+			redactPrivateKeys(val)
+		}
+	case []interface{}:
+		for _, val := range x {
+			redactPrivateKeys(val)
+		}
+	}
+}
+
+// diffJSON returns the operations that turn a into b. Objects and arrays are
+// compared member by member; any other change replaces the value.
+func diffJSON(path string, a, b interface{}) []PushDiffOp {
+	switch av := a.(type) {
+	case map[string]interface{}:
+		bv, ok := b.(map[string]interface{})
+		if !ok {
+			break
+		}
+
+		keys := make(map[string]struct{}, len(av)+len(bv))
+		for k := range av {
+			keys[k] = struct{}{}
+		}
+		for k := range bv {
+			keys[k] = struct{}{}
+		}
+		sorted := make([]string, 0, len(keys))
+		for k := range keys {
+			sorted = append(sorted, k)
+		}
+		sort.Strings(sorted)
+
+		var ops []PushDiffOp
+		for _, k := range sorted {
+			p := path + "/" + escapeJSONPointer(k)
+			aval, inA := av[k]
+			bval, inB := bv[k]
+			switch {
+			case !inA:
+				ops = append(ops, PushDiffOp{Op: "add", Path: p, New: bval})
+			case !inB:
+				ops = append(ops, PushDiffOp{Op: "remove", Path: p, Old: aval})
+			default:
+				ops = append(ops, diffJSON(p, aval, bval)...)
+			}
+		}
+		return ops
+
+	case []interface{}:
+		bv, ok := b.([]interface{})
+		if !ok {
+			break
+		}
+
+		var ops []PushDiffOp
+		for i := 0; i < len(av) || i < len(bv); i++ {
+			p := path + "/" + strconv.Itoa(i)
+			switch {
+			case i >= len(av):
+				ops = append(ops, PushDiffOp{Op: "add", Path: p, New: bv[i]})
+			case i >= len(bv):
+				ops = append(ops, PushDiffOp{Op: "remove", Path: p, Old: av[i]})
+			default:
+				ops = append(ops, diffJSON(p, av[i], bv[i])...)
+			}
+		}
+		return ops
+	}
+
+	if reflect.DeepEqual(a, b) {
+		return nil
+	}
+	return []PushDiffOp{{Op: "replace", Path: path, Old: a, New: b}}
+}
+
+func escapeJSONPointer(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, "~", "~0"), "/", "~1")
+}
@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package xds
+
+import (
+	"testing"
+
+	envoy_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoy_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/envoyextensions/xdscommon"
+)
+
+func TestPushHistory(t *testing.T) {
+	proxyID := structs.NewServiceID("web-sidecar-proxy", nil)
+
+	makeResp := func(t *testing.T, nonce string, msgs ...proto.Message) *envoy_discovery_v3.DeltaDiscoveryResponse {
+		resp := &envoy_discovery_v3.DeltaDiscoveryResponse{
+			TypeUrl: xdscommon.ClusterType,
+			Nonce:   nonce,
+		}
+		for _, msg := range msgs {
+			any, err := anypb.New(msg)
+			require.NoError(t, err)
+			resp.Resources = append(resp.Resources, &envoy_discovery_v3.Resource{
+				Name:     xdscommon.GetResourceName(msg),
+				Version:  nonce,
+				Resource: any,
+			})
+		}
+		return resp
+	}
+
+	t.Run("diff and status", func(t *testing.T) {
+		h := NewPushHistory(10)
+		stream := h.openStream(proxyID)
+		stream.setService("web")
+
+		stream.record(makeResp(t, "1", &envoy_cluster_v3.Cluster{
+			Name:     "db",
+			LbPolicy: envoy_cluster_v3.Cluster_RING_HASH,
+		}))
+		stream.ack("1")
+
+		stream.record(makeResp(t, "2", &envoy_cluster_v3.Cluster{
+			Name:           "db",
+			ConnectTimeout: durationpb.New(5e9),
+		}))
+		stream.nack("2", "rejected")
+
+		resp := makeResp(t, "3")
+		resp.RemovedResources = []string{"db"}
+		stream.record(resp)
+
+		service, entries, ok, err := h.Get(proxyID, 0)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, "web", service)
+		require.Len(t, entries, 3)
+
+		require.Equal(t, PushStatusACK, entries[0].Status)
+		require.Len(t, entries[0].Resources, 1)
+		require.Equal(t, []PushDiffOp{{
+			Op:   "add",
+			Path: "",
+			New: map[string]interface{}{
+				"@type":    "type.googleapis.com/envoy.config.cluster.v3.Cluster",
+				"name":     "db",
+				"lbPolicy": "RING_HASH",
+			},
+		}}, entries[0].Resources[0].Diff)
+
+		require.Equal(t, PushStatusNACK, entries[1].Status)
+		require.Equal(t, "rejected", entries[1].ErrorDetail)
+		require.Equal(t, []PushDiffOp{
+			{Op: "add", Path: "/connectTimeout", New: "5s"},
+			{Op: "remove", Path: "/lbPolicy", Old: "RING_HASH"},
+		}, entries[1].Resources[0].Diff)
+
+		require.Equal(t, PushStatusPending, entries[2].Status)
+		require.Empty(t, entries[2].Resources)
+		require.Equal(t, []string{"db"}, entries[2].Removed)
+
+		// The limit keeps the most recent pushes.
+		_, entries, _, err = h.Get(proxyID, 1)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Equal(t, "3", entries[0].Nonce)
+	})
+
+	t.Run("evicts oldest", func(t *testing.T) {
+		h := NewPushHistory(2)
+		stream := h.openStream(proxyID)
+		for _, nonce := range []string{"1", "2", "3"} {
+			stream.record(makeResp(t, nonce, &envoy_cluster_v3.Cluster{Name: "db"}))
+		}
+
+		_, entries, ok, err := h.Get(proxyID, 0)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Len(t, entries, 2)
+		require.Equal(t, "2", entries[0].Nonce)
+		require.Equal(t, "3", entries[1].Nonce)
+
+		_, _, ok, err = h.Get(structs.NewServiceID("other", nil), 0)
+		require.NoError(t, err)
+		require.False(t, ok)
+	})
+
+	t.Run("redacts private keys", func(t *testing.T) {
+		h := NewPushHistory(1)
+		secret := &envoy_tls_v3.Secret{
+			Name: "leaf",
+			Type: &envoy_tls_v3.Secret_TlsCertificate{
+				TlsCertificate: &envoy_tls_v3.TlsCertificate{
+					CertificateChain: &envoy_core_v3.DataSource{
+						Specifier: &envoy_core_v3.DataSource_InlineString{InlineString: "cert"},
+					},
+					PrivateKey: &envoy_core_v3.DataSource{
+						Specifier: &envoy_core_v3.DataSource_InlineString{InlineString: "key"},
+					},
+				},
+			},
+		}
+		h.openStream(proxyID).record(makeResp(t, "1", secret))
+
+		_, entries, _, err := h.Get(proxyID, 0)
+		require.NoError(t, err)
+		res := entries[0].Resources[0].Diff[0].New.(map[string]interface{})
+		tlsCert := res["tlsCertificate"].(map[string]interface{})
+		require.Equal(t, redactedValue, tlsCert["privateKey"])
+		require.Equal(t, map[string]interface{}{"inlineString": "cert"}, tlsCert["certificateChain"])
+	})
+
+	t.Run("streams", func(t *testing.T) {
+		h := NewPushHistory(10)
+		first := h.openStream(proxyID)
+		first.setService("web")
+		first.record(makeResp(t, "1", &envoy_cluster_v3.Cluster{Name: "db"}))
+
+		// The proxy reconnects before the first stream is closed, and the
+		// nonces of the new stream start over.
+		second := h.openStream(proxyID)
+		first.close()
+		second.record(makeResp(t, "1", &envoy_cluster_v3.Cluster{Name: "db"}))
+		second.nack("1", "rejected")
+
+		service, entries, ok, err := h.Get(proxyID, 0)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, "web", service)
+		require.Len(t, entries, 2)
+		require.Equal(t, PushStatusPending, entries[0].Status)
+		require.Equal(t, PushStatusNACK, entries[1].Status)
+
+		// The history is dropped once the proxy has no open streams.
+		second.close()
+		_, _, ok, err = h.Get(proxyID, 0)
+		require.NoError(t, err)
+		require.False(t, ok)
+
+		// Responses sent on a closed stream are not recorded.
+		second.record(makeResp(t, "2"))
+		_, _, ok, err = h.Get(proxyID, 0)
+		require.NoError(t, err)
+		require.False(t, ok)
+	})
+}
+
+func TestDiffJSON(t *testing.T) {
+	a := map[string]interface{}{
+		"a/b":  "x",
+		"list": []interface{}{1.0, 2.0, 3.0},
+		"obj":  map[string]interface{}{"k": "v"},
+	}
+	b := map[string]interface{}{
+		"a/b":  "y",
+		"list": []interface{}{1.0, 4.0},
+		"obj":  "scalar",
+	}
+	require.Equal(t, []PushDiffOp{
+		{Op: "replace", Path: "/a~1b", Old: "x", New: "y"},
+		{Op: "replace", Path: "/list/1", Old: 2.0, New: 4.0},
+		{Op: "remove", Path: "/list/2", Old: 3.0},
+		{Op: "replace", Path: "/obj", Old: map[string]interface{}{"k": "v"}, New: "scalar"},
+	}, diffJSON("", a, b))
+
+	require.Empty(t, diffJSON("", a, a))
+}
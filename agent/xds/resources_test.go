@@ -162,6 +162,33 @@ func TestAllResourcesFromSnapshot(t *testing.T) {
 				}, nil)
 			},
 		},
+		{
+			name: "redis-listeners",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshot(t, func(ns *structs.NodeService) {
+					ns.Proxy.Config["protocol"] = "redis"
+					ns.Proxy.Upstreams[0].Config = map[string]interface{}{"protocol": "redis"}
+				}, nil)
+			},
+		},
+		{
+			name: "postgres-listeners",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshot(t, func(ns *structs.NodeService) {
+					ns.Proxy.Config["protocol"] = "postgres"
+					ns.Proxy.Upstreams[0].Config = map[string]interface{}{"protocol": "postgres"}
+				}, nil)
+			},
+		},
+		{
+			name: "kafka-listeners",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshot(t, func(ns *structs.NodeService) {
+					ns.Proxy.Config["protocol"] = "kafka"
+					ns.Proxy.Upstreams[0].Config = map[string]interface{}{"protocol": "kafka"}
+				}, nil)
+			},
+		},
 		{
 			name: "listener-bind-address",
 			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
@@ -546,6 +573,30 @@ func getConnectProxyDiscoChainTests(enterprise bool) []goldenTestCase {
 				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "chain-and-router", enterprise, nil, nil)
 			},
 		},
+		{
+			name: "connect-proxy-with-grpc-route-match",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "grpc-route-match", enterprise, nil, nil)
+			},
+		},
+		{
+			name: "connect-proxy-with-retry-policy",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "retry-policy", enterprise, nil, nil)
+			},
+		},
+		{
+			name: "connect-proxy-with-fault-injection",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "fault-injection", enterprise, nil, nil)
+			},
+		},
+		{
+			name: "connect-proxy-with-mirror",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "mirror", enterprise, nil, nil)
+			},
+		},
 		{
 			name: "connect-proxy-route-to-lb-resolver",
 			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
@@ -1000,6 +1051,81 @@ func getAPIGatewayGoldenTestCases(t *testing.T) []goldenTestCase {
 					}}, nil)
 			},
 		},
+		{
+			name: "api-gateway-with-pending-acme-certificate",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotAPIGateway(t, "default", nil, func(entry *structs.APIGatewayConfigEntry, bound *structs.BoundAPIGatewayConfigEntry) {
+					entry.Listeners = []structs.APIGatewayListener{
+						{
+							Name:     "listener",
+							Protocol: structs.ListenerProtocolTCP,
+							Port:     8080,
+							TLS: structs.APIGatewayTLSConfiguration{
+								Certificates: []structs.ResourceReference{{
+									Kind: structs.InlineCertificate,
+									Name: "certificate",
+								}},
+							},
+						},
+						{
+							Name:     "acme-listener",
+							Protocol: structs.ListenerProtocolTCP,
+							Port:     8443,
+							TLS: structs.APIGatewayTLSConfiguration{
+								Certificates: []structs.ResourceReference{{
+									Kind: structs.ACMECertificate,
+									Name: "acme",
+								}},
+							},
+						},
+					}
+					bound.Listeners = []structs.BoundAPIGatewayListener{
+						{
+							Name: "listener",
+							Certificates: []structs.ResourceReference{{
+								Kind: structs.InlineCertificate,
+								Name: "certificate",
+							}},
+							Routes: []structs.ResourceReference{{
+								Kind: structs.TCPRoute,
+								Name: "route",
+							}},
+						},
+						{
+							Name: "acme-listener",
+							Certificates: []structs.ResourceReference{{
+								Kind: structs.ACMECertificate,
+								Name: "acme",
+							}},
+							Routes: []structs.ResourceReference{{
+								Kind: structs.TCPRoute,
+								Name: "route",
+							}},
+						},
+					}
+				},
+					[]structs.BoundRoute{
+						&structs.TCPRouteConfigEntry{
+							Kind: structs.TCPRoute,
+							Name: "route",
+							Services: []structs.TCPService{{
+								Name: "service",
+							}},
+							Parents: []structs.ResourceReference{
+								{
+									Kind: structs.APIGateway,
+									Name: "api-gateway",
+								},
+							},
+						},
+					}, []structs.InlineCertificateConfigEntry{{
+						Kind:        structs.InlineCertificate,
+						Name:        "certificate",
+						PrivateKey:  gatewayTestPrivateKey,
+						Certificate: gatewayTestCertificate,
+					}}, nil)
+			},
+		},
 		{
 			name: "api-gateway-with-multiple-inline-certificates",
 			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
@@ -1361,6 +1487,115 @@ func getAPIGatewayGoldenTestCases(t *testing.T) []goldenTestCase {
 				}, nil, nil)
 			},
 		},
+		{
+			name: "api-gateway-http-listener-with-grpc-route",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotAPIGateway(t, "default", nil, func(entry *structs.APIGatewayConfigEntry, bound *structs.BoundAPIGatewayConfigEntry) {
+					entry.Listeners = []structs.APIGatewayListener{
+						{
+							Name:     "listener",
+							Protocol: structs.ListenerProtocolHTTP,
+							Port:     8080,
+						},
+					}
+					bound.Listeners = []structs.BoundAPIGatewayListener{
+						{
+							Name: "listener",
+							Routes: []structs.ResourceReference{
+								{
+									Name: "grpc-route",
+									Kind: structs.GRPCRoute,
+								},
+							},
+						},
+					}
+				}, []structs.BoundRoute{
+					&structs.GRPCRouteConfigEntry{
+						Name: "grpc-route",
+						Kind: structs.GRPCRoute,
+						Parents: []structs.ResourceReference{
+							{
+								Kind: structs.APIGateway,
+								Name: "api-gateway",
+							},
+						},
+						Rules: []structs.GRPCRouteRule{
+							{
+								Matches: []structs.GRPCMatch{
+									{
+										Method: structs.GRPCMethodMatch{
+											Match:   structs.GRPCMethodMatchExact,
+											Service: "helloworld.Greeter",
+											Method:  "SayHello",
+										},
+									},
+								},
+								Services: []structs.HTTPService{
+									{Name: "grpc-service"},
+								},
+							},
+						},
+					},
+				}, nil, nil)
+			},
+		},
+		{
+			name: "api-gateway-tls-listener-with-tls-routes",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotAPIGateway(t, "default", nil, func(entry *structs.APIGatewayConfigEntry, bound *structs.BoundAPIGatewayConfigEntry) {
+					entry.Listeners = []structs.APIGatewayListener{
+						{
+							Name:     "listener",
+							Protocol: structs.ListenerProtocolTLS,
+							Port:     8443,
+						},
+					}
+					bound.Listeners = []structs.BoundAPIGatewayListener{
+						{
+							Name: "listener",
+							Routes: []structs.ResourceReference{
+								{
+									Name: "tls-route-db",
+									Kind: structs.TLSRoute,
+								},
+								{
+									Name: "tls-route-default",
+									Kind: structs.TLSRoute,
+								},
+							},
+						},
+					}
+				}, []structs.BoundRoute{
+					&structs.TLSRouteConfigEntry{
+						Name: "tls-route-db",
+						Kind: structs.TLSRoute,
+						Parents: []structs.ResourceReference{
+							{
+								Kind: structs.APIGateway,
+								Name: "api-gateway",
+							},
+						},
+						Hostnames: []string{"db.example.com", "*.db.example.com"},
+						Services: []structs.TCPService{
+							{Name: "db"},
+						},
+					},
+					&structs.TLSRouteConfigEntry{
+						Name: "tls-route-default",
+						Kind: structs.TLSRoute,
+						Parents: []structs.ResourceReference{
+							{
+								Kind: structs.APIGateway,
+								Name: "api-gateway",
+							},
+						},
+						Services: []structs.TCPService{
+							{Name: "web"},
+						},
+					},
+				}, nil, nil)
+			},
+		},
 		{
 			name: "api-gateway-tcp-listener-with-tcp-and-http-route",
 			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
@@ -2477,6 +2712,34 @@ func getIngressGatewayGoldenTestCases() []goldenTestCase {
 					"chain-and-router", nil, nil, nil)
 			},
 		},
+		{
+			name: "ingress-with-grpc-route-match",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotIngressGateway(t, true, "grpc",
+					"grpc-route-match", nil, nil, nil)
+			},
+		},
+		{
+			name: "ingress-with-retry-policy",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotIngressGateway(t, true, "http",
+					"retry-policy", nil, nil, nil)
+			},
+		},
+		{
+			name: "ingress-with-fault-injection",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotIngressGateway(t, true, "http",
+					"fault-injection", nil, nil, nil)
+			},
+		},
+		{
+			name: "ingress-with-mirror",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotIngressGateway(t, true, "http",
+					"mirror", nil, nil, nil)
+			},
+		},
 		{
 			name:   "ingress-http-multiple-services",
 			create: proxycfg.TestConfigSnapshotIngress_HTTPMultipleServices,
@@ -2512,6 +2775,12 @@ func getIngressGatewayGoldenTestCases() []goldenTestCase {
 					}, nil)
 			},
 		},
+		{
+			name: "ingress-with-acme-certificate",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotIngressGateway_ACMECertificate(t, gatewayTestCertificate, gatewayTestPrivateKey)
+			},
+		},
 		{
 			name: "ingress-with-tls-listener-min-version",
 			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
@@ -2691,8 +2960,57 @@ func getAccessLogsGoldenTestCases() []goldenTestCase {
 	}
 }
 
+// withTestRevocationLists sets revocation lists of the local and a federated
+// datacenter that proxies have to enforce on snap.
+func withTestRevocationLists(snap *proxycfg.ConfigSnapshot) *proxycfg.ConfigSnapshot {
+	snap.Roots.RevocationLists = []*structs.CARevocationList{
+		{
+			Datacenter:        "dc1",
+			SigningKeyID:      snap.Roots.Active().SigningKeyID,
+			RevocationListPEM: "-----BEGIN X509 CRL-----\ndc1\n-----END X509 CRL-----",
+			NumRevoked:        1,
+		},
+		{
+			Datacenter:        "dc2",
+			SigningKeyID:      "aa:bb",
+			RevocationListPEM: "-----BEGIN X509 CRL-----\ndc2\n-----END X509 CRL-----",
+		},
+	}
+	return snap
+}
+
 func getTLSGoldenTestCases() []goldenTestCase {
 	return []goldenTestCase{
+		{
+			name: "connect-proxy-with-revocation-lists",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return withTestRevocationLists(proxycfg.TestConfigSnapshot(t, nil, nil))
+			},
+		},
+		{
+			name: "connect-proxy-exported-to-peers-with-revocation-lists",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return withTestRevocationLists(proxycfg.TestConfigSnapshot(t, func(ns *structs.NodeService) {
+					ns.Proxy.Upstreams = structs.Upstreams{}
+				}, []proxycfg.UpdateEvent{
+					{
+						CorrelationID: "peering-trust-bundles",
+						Result:        proxycfg.TestPeerTrustBundles(t),
+					},
+				}))
+			},
+		},
+		{
+			name: "terminating-gateway-with-peer-trust-bundle-and-revocation-lists",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return withTestRevocationLists(proxycfg.TestConfigSnapshotTerminatingGateway(t, true, nil, []proxycfg.UpdateEvent{
+					{
+						CorrelationID: "peer-trust-bundle:web",
+						Result:        proxycfg.TestPeerTrustBundles(t),
+					},
+				}))
+			},
+		},
 		{
 			name: "connect-proxy-with-tls-outgoing-min-version-auto",
 			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
@@ -2871,6 +3189,10 @@ func getPeeredGoldenTestCases() []goldenTestCase {
 				return proxycfg.TestConfigSnapshotPeeringWithHTTP2(t, nil)
 			},
 		},
+		{
+			name:   "connect-proxy-with-peered-upstreams-imported-entries",
+			create: proxycfg.TestConfigSnapshotPeeringWithImportedEntries,
+		},
 		{
 			name:   "transparent-proxy-with-peered-upstreams",
 			create: proxycfg.TestConfigSnapshotPeeringTProxy,
@@ -2924,7 +3246,7 @@ func getXDSFetchTimeoutTestCases() []goldenTestCase {
 			name: "xds-fetch-timeout-ms-term-gw",
 			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
 				// Covers cases in:
-				// - listeners.go:makeFilterChainTerminatingGateway
+				// - listeners.go:makeFilterChainsTerminatingGateway
 				// - clusters.go:makeGatewayCluster
 				return proxycfg.TestConfigSnapshotTerminatingGatewayServiceSubsetsWebAndCache(t, func(ns *structs.NodeService) {
 					ns.Proxy.Config = map[string]interface{}{
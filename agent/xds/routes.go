@@ -6,7 +6,9 @@ package xds
 import (
 	"errors"
 	"fmt"
+	"math"
 	"net"
+	"regexp"
 	"sort"
 	"strings"
 	"time"
@@ -14,6 +16,7 @@ import (
 	envoy_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoy_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slices"
 
@@ -439,6 +442,18 @@ func (s *ResourceGenerator) routesForIngressGateway(cfgSnap *proxycfg.ConfigSnap
 			}
 		}
 
+		// Plaintext listeners answer the HTTP-01 challenges of any
+		// acme-certificate referenced by the gateway.
+		if lCfg, ok := cfgSnap.IngressGateway.Listeners[listenerKey]; ok {
+			usesTLS, err := ingressListenerUsesTLS(cfgSnap, lCfg)
+			if err != nil {
+				return nil, err
+			}
+			if !usesTLS {
+				injectACMEChallengeRoutes(defaultRoute, cfgSnap.IngressGateway.ACMEChallenges())
+			}
+		}
+
 		if len(defaultRoute.VirtualHosts) > 0 {
 			result = append(result, defaultRoute)
 		}
@@ -478,7 +493,7 @@ func (s *ResourceGenerator) routesForAPIGateway(cfgSnap *proxycfg.ConfigSnapshot
 		// Consolidate all routes for this listener into the minimum possible set based on hostname matching.
 		allRoutesForListener := []*structs.HTTPRouteConfigEntry{}
 		for _, routeRef := range maps.Keys(readyListener.routeReferences) {
-			route, ok := cfgSnap.APIGateway.HTTPRoutes.Get(routeRef)
+			route, ok := cfgSnap.APIGateway.HTTPRoute(routeRef)
 			if !ok {
 				return nil, fmt.Errorf("missing route for route routeRef %s:%s", routeRef.Name, routeRef.Kind)
 			}
@@ -510,6 +525,12 @@ func (s *ResourceGenerator) routesForAPIGateway(cfgSnap *proxycfg.ConfigSnapshot
 			listenerRoute.VirtualHosts = append(listenerRoute.VirtualHosts, virtualHost)
 		}
 
+		// Plaintext HTTP listeners answer the HTTP-01 challenges of any
+		// acme-certificate referenced by the gateway.
+		if len(readyListener.boundListenerCfg.Certificates) == 0 {
+			injectACMEChallengeRoutes(listenerRoute, cfgSnap.APIGateway.ACMEChallenges())
+		}
+
 		if len(listenerRoute.VirtualHosts) > 0 {
 			// Build up the virtual hosts in a deterministic way
 			slices.SortStableFunc(listenerRoute.VirtualHosts, func(a, b *envoy_route_v3.VirtualHost) int {
@@ -525,6 +546,90 @@ func (s *ResourceGenerator) routesForAPIGateway(cfgSnap *proxycfg.ConfigSnapshot
 	return result, nil
 }
 
+// acmeChallengePathPrefix is the path under which HTTP-01 challenge tokens are
+// requested by ACME servers.
+const acmeChallengePathPrefix = "/.well-known/acme-challenge/"
+
+// injectACMEChallengeRoutes prepends a direct response route for each challenge
+// to every virtual host, so that challenges are answered regardless of which
+// virtual host the ACME server's request matches. Hostnames that no existing
+// virtual host matches get a dedicated virtual host.
+func injectACMEChallengeRoutes(routeCfg *envoy_route_v3.RouteConfiguration, challenges []structs.ACMEChallenge) {
+	if len(challenges) == 0 {
+		return
+	}
+
+	routes := make([]*envoy_route_v3.Route, 0, len(challenges))
+	for _, challenge := range challenges {
+		routes = append(routes, &envoy_route_v3.Route{
+			Match: &envoy_route_v3.RouteMatch{
+				PathSpecifier: &envoy_route_v3.RouteMatch_Path{
+					Path: acmeChallengePathPrefix + challenge.Token,
+				},
+			},
+			Action: &envoy_route_v3.Route_DirectResponse{
+				DirectResponse: &envoy_route_v3.DirectResponseAction{
+					Status: 200,
+					Body: &envoy_core_v3.DataSource{
+						Specifier: &envoy_core_v3.DataSource_InlineString{
+							InlineString: challenge.KeyAuthorization,
+						},
+					},
+				},
+			},
+		})
+	}
+
+	var uncovered []string
+	seen := make(map[string]struct{})
+	for _, challenge := range challenges {
+		if _, ok := seen[challenge.Hostname]; ok {
+			continue
+		}
+		seen[challenge.Hostname] = struct{}{}
+
+		covered := false
+		for _, vh := range routeCfg.VirtualHosts {
+			for _, domain := range vh.Domains {
+				if virtualHostDomainMatches(domain, challenge.Hostname) {
+					covered = true
+					break
+				}
+			}
+		}
+		if !covered {
+			uncovered = append(uncovered, challenge.Hostname)
+		}
+	}
+
+	for _, vh := range routeCfg.VirtualHosts {
+		vh.Routes = append(slices.Clone(routes), vh.Routes...)
+	}
+
+	if len(uncovered) > 0 {
+		routeCfg.VirtualHosts = append(routeCfg.VirtualHosts, &envoy_route_v3.VirtualHost{
+			Name:    "acme-challenges",
+			Domains: uncovered,
+			Routes:  routes,
+		})
+	}
+}
+
+// virtualHostDomainMatches returns whether an Envoy virtual host domain, which
+// may be "*" or have a leading or trailing wildcard, matches the given host.
+func virtualHostDomainMatches(domain, host string) bool {
+	switch {
+	case domain == "*":
+		return true
+	case strings.HasPrefix(domain, "*"):
+		return strings.HasSuffix(host, domain[1:])
+	case strings.HasSuffix(domain, "*"):
+		return strings.HasPrefix(host, domain[:len(domain)-1])
+	default:
+		return domain == host
+	}
+}
+
 func buildHTTPRouteUpstream(route structs.HTTPRouteConfigEntry, listener structs.APIGatewayListener) structs.Upstream {
 	return structs.Upstream{
 		DestinationName:      route.GetName(),
@@ -695,6 +800,11 @@ func (s *ResourceGenerator) makeUpstreamRouteForDiscoveryChain(
 				return nil, fmt.Errorf("failed to apply load balancer configuration to route action: %v", err)
 			}
 
+			if discoveryRoute.MirrorNode != "" {
+				mirror := discoveryRoute.Definition.Destination.Mirror
+				s.injectMirrorPolicy(upstreamsSnapshot, chain, discoveryRoute.MirrorNode, mirror.Percentage, forMeshGateway, routeAction.Route)
+			}
+
 			// TODO(rb): Better help handle the envoy case where you need (prefix=/foo/,rewrite=/) and (exact=/foo,rewrite=/) to do a full rewrite
 
 			destination := discoveryRoute.Definition.Destination
@@ -731,6 +841,14 @@ func (s *ResourceGenerator) makeUpstreamRouteForDiscoveryChain(
 				}
 			}
 
+			// Routes that don't configure their own retries fall back to the
+			// retry policy of the resolver they lead to. As with the resolver
+			// request timeout, this isn't possible after a splitter because the
+			// branches of the split share a single RouteAction.
+			if routeAction.Route.RetryPolicy == nil && nextNode.Type == structs.DiscoveryGraphNodeTypeResolver {
+				routeAction.Route.RetryPolicy = getRetryPolicyForResolver(nextNode.Resolver)
+			}
+
 			filter, err := filterBuilder.buildTypedPerFilterConfig(routeMatch, routeAction)
 			if err != nil {
 				return nil, err
@@ -739,6 +857,10 @@ func (s *ResourceGenerator) makeUpstreamRouteForDiscoveryChain(
 			route.Action = routeAction
 			route.TypedPerFilterConfig = filter
 
+			if err := injectFaultInjectionToRoute(destination, route); err != nil {
+				return nil, fmt.Errorf("failed to apply fault injection configuration to route: %v", err)
+			}
+
 			routes = append(routes, route)
 		}
 
@@ -787,6 +909,7 @@ func (s *ResourceGenerator) makeUpstreamRouteForDiscoveryChain(
 		if startNode.Resolver.RequestTimeout < 0 {
 			routeAction.Route.Timeout = durationpb.New(0 * time.Second)
 		}
+		routeAction.Route.RetryPolicy = getRetryPolicyForResolver(startNode.Resolver)
 		defaultRoute := &envoy_route_v3.Route{
 			Match:  makeDefaultRouteMatch(),
 			Action: routeAction,
@@ -839,17 +962,50 @@ func getRetryPolicyForDestination(destination *structs.ServiceRouteDestination)
 		retryPolicy.RetriableStatusCodes = destination.RetryOnStatusCodes
 	}
 
+	if len(destination.RetriableHeaders) > 0 {
+		retryStrings = append(retryStrings, "retriable-headers")
+		for _, hdr := range destination.RetriableHeaders {
+			retryPolicy.RetriableHeaders = append(retryPolicy.RetriableHeaders, makeHeaderMatcher(hdr))
+		}
+	}
+
 	retryPolicy.RetryOn = strings.Join(retryStrings, ",")
 
+	if destination.PerTryTimeout > 0 {
+		retryPolicy.PerTryTimeout = durationpb.New(destination.PerTryTimeout)
+	}
+
+	if destination.RetryBaseInterval > 0 {
+		retryPolicy.RetryBackOff = &envoy_route_v3.RetryPolicy_RetryBackOff{
+			BaseInterval: durationpb.New(destination.RetryBaseInterval),
+		}
+		if destination.RetryMaxInterval > 0 {
+			retryPolicy.RetryBackOff.MaxInterval = durationpb.New(destination.RetryMaxInterval)
+		}
+	}
+
 	return retryPolicy
 }
 
+// getRetryPolicyForResolver returns the retry policy configured on a
+// resolver, or nil if it doesn't configure any retries.
+func getRetryPolicyForResolver(resolver *structs.DiscoveryResolver) *envoy_route_v3.RetryPolicy {
+	if resolver.RetryPolicy == nil || !resolver.RetryPolicy.HasRetryFeatures() {
+		return nil
+	}
+	return getRetryPolicyForDestination(resolver.RetryPolicy.RouteDestination())
+}
+
 func makeRouteMatchForDiscoveryRoute(discoveryRoute *structs.DiscoveryRoute) *envoy_route_v3.RouteMatch {
 	match := discoveryRoute.Definition.Match
 	if match == nil || match.IsEmpty() {
 		return makeDefaultRouteMatch()
 	}
 
+	if match.GRPC != nil {
+		return makeRouteMatchForGRPC(match.GRPC)
+	}
+
 	em := &envoy_route_v3.RouteMatch{}
 
 	switch {
@@ -878,62 +1034,10 @@ func makeRouteMatchForDiscoveryRoute(discoveryRoute *structs.DiscoveryRoute) *en
 	if len(match.HTTP.Header) > 0 {
 		em.Headers = make([]*envoy_route_v3.HeaderMatcher, 0, len(match.HTTP.Header))
 		for _, hdr := range match.HTTP.Header {
-			eh := &envoy_route_v3.HeaderMatcher{
-				Name: hdr.Name,
-			}
-
-			switch {
-			case hdr.Exact != "":
-				eh.HeaderMatchSpecifier = &envoy_route_v3.HeaderMatcher_StringMatch{
-					StringMatch: &envoy_matcher_v3.StringMatcher{
-						MatchPattern: &envoy_matcher_v3.StringMatcher_Exact{
-							Exact: hdr.Exact,
-						},
-						IgnoreCase: false,
-					},
-				}
-			case hdr.Regex != "":
-				eh.HeaderMatchSpecifier = &envoy_route_v3.HeaderMatcher_StringMatch{
-					StringMatch: &envoy_matcher_v3.StringMatcher{
-						MatchPattern: &envoy_matcher_v3.StringMatcher_SafeRegex{
-							SafeRegex: response.MakeEnvoyRegexMatch(hdr.Regex),
-						},
-						IgnoreCase: false,
-					},
-				}
-
-			case hdr.Prefix != "":
-				eh.HeaderMatchSpecifier = &envoy_route_v3.HeaderMatcher_StringMatch{
-					StringMatch: &envoy_matcher_v3.StringMatcher{
-						MatchPattern: &envoy_matcher_v3.StringMatcher_Prefix{
-							Prefix: hdr.Prefix,
-						},
-						IgnoreCase: false,
-					},
-				}
-
-			case hdr.Suffix != "":
-				eh.HeaderMatchSpecifier = &envoy_route_v3.HeaderMatcher_StringMatch{
-					StringMatch: &envoy_matcher_v3.StringMatcher{
-						MatchPattern: &envoy_matcher_v3.StringMatcher_Suffix{
-							Suffix: hdr.Suffix,
-						},
-						IgnoreCase: false,
-					},
-				}
-
-			case hdr.Present:
-				eh.HeaderMatchSpecifier = &envoy_route_v3.HeaderMatcher_PresentMatch{
-					PresentMatch: true,
-				}
-			default:
+			eh := makeHeaderMatcher(hdr)
+			if eh == nil {
 				continue // skip this impossible situation
 			}
-
-			if hdr.Invert {
-				eh.InvertMatch = true
-			}
-
 			em.Headers = append(em.Headers, eh)
 		}
 	}
@@ -994,6 +1098,47 @@ func makeRouteMatchForDiscoveryRoute(discoveryRoute *structs.DiscoveryRoute) *en
 	return em
 }
 
+// makeRouteMatchForGRPC converts gRPC match criteria into a route match on
+// the request path, which gRPC sets to "/<service>/<method>".
+func makeRouteMatchForGRPC(match *structs.ServiceRouteGRPCMatch) *envoy_route_v3.RouteMatch {
+	em := &envoy_route_v3.RouteMatch{
+		// Only match requests that are actually gRPC.
+		Grpc: &envoy_route_v3.RouteMatch_GrpcRouteMatchOptions{},
+	}
+
+	switch {
+	case match.Service != "" && match.Method != "":
+		em.PathSpecifier = &envoy_route_v3.RouteMatch_Path{
+			Path: "/" + match.Service + "/" + match.Method,
+		}
+	case match.Service != "":
+		em.PathSpecifier = &envoy_route_v3.RouteMatch_Prefix{
+			Prefix: "/" + match.Service + "/",
+		}
+	case match.Method != "":
+		em.PathSpecifier = &envoy_route_v3.RouteMatch_SafeRegex{
+			SafeRegex: response.MakeEnvoyRegexMatch("/[^/]+/" + regexp.QuoteMeta(match.Method)),
+		}
+	default:
+		em.PathSpecifier = &envoy_route_v3.RouteMatch_Prefix{
+			Prefix: "/",
+		}
+	}
+
+	if len(match.Metadata) > 0 {
+		em.Headers = make([]*envoy_route_v3.HeaderMatcher, 0, len(match.Metadata))
+		for _, md := range match.Metadata {
+			eh := makeHeaderMatcher(md)
+			if eh == nil {
+				continue // skip this impossible situation
+			}
+			em.Headers = append(em.Headers, eh)
+		}
+	}
+
+	return em
+}
+
 func makeDefaultRouteMatch() *envoy_route_v3.RouteMatch {
 	return &envoy_route_v3.RouteMatch{
 		PathSpecifier: &envoy_route_v3.RouteMatch_Prefix{
@@ -1068,15 +1213,74 @@ func (s *ResourceGenerator) makeRouteActionForSplitter(
 		return nil, fmt.Errorf("number of clusters in splitter must be > 0; got %d", len(clusters))
 	}
 
-	return &envoy_route_v3.Route_Route{
-		Route: &envoy_route_v3.RouteAction{
-			ClusterSpecifier: &envoy_route_v3.RouteAction_WeightedClusters{
-				WeightedClusters: &envoy_route_v3.WeightedCluster{
-					Clusters: clusters,
-				},
+	action := &envoy_route_v3.RouteAction{
+		ClusterSpecifier: &envoy_route_v3.RouteAction_WeightedClusters{
+			WeightedClusters: &envoy_route_v3.WeightedCluster{
+				Clusters: clusters,
 			},
 		},
-	}, nil
+	}
+
+	// Envoy only supports mirroring for a whole route rather than a single
+	// weighted cluster, so a split's mirror percentage is scaled by the
+	// split's weight. This mirrors the same share of the route's traffic.
+	for _, split := range splits {
+		if split.MirrorNode == "" {
+			continue
+		}
+		pct := split.Definition.Mirror.Percentage * split.Weight / 100
+		s.injectMirrorPolicy(upstreamsSnapshot, chain, split.MirrorNode, pct, forMeshGateway, action)
+	}
+
+	return &envoy_route_v3.Route_Route{Route: action}, nil
+}
+
+// injectMirrorPolicy adds a policy to the route action that mirrors the given
+// percentage of requests to the shadow service of the mirror node. Policies
+// for the same shadow service are combined.
+func (s *ResourceGenerator) injectMirrorPolicy(
+	upstreamsSnapshot *proxycfg.ConfigSnapshotUpstreams,
+	chain *structs.CompiledDiscoveryChain,
+	mirrorNode string,
+	pct float32,
+	forMeshGateway bool,
+	action *envoy_route_v3.RouteAction,
+) {
+	node := chain.Nodes[mirrorNode]
+	if node == nil || node.Type != structs.DiscoveryGraphNodeTypeResolver {
+		return
+	}
+
+	// Mirrored requests are best effort, so skip the policy until the shadow
+	// service's cluster is available rather than failing the whole route.
+	clusterName := s.getTargetClusterName(upstreamsSnapshot, chain, node.Resolver.Target, forMeshGateway)
+	if clusterName == "" {
+		return
+	}
+
+	for _, policy := range action.RequestMirrorPolicies {
+		if policy.Cluster == clusterName {
+			fraction := policy.RuntimeFraction.DefaultValue
+			fraction.Numerator = min(fraction.Numerator+makeFractionalPercent(pct).Numerator, 1000000)
+			return
+		}
+	}
+
+	action.RequestMirrorPolicies = append(action.RequestMirrorPolicies, &envoy_route_v3.RouteAction_RequestMirrorPolicy{
+		Cluster: clusterName,
+		RuntimeFraction: &envoy_core_v3.RuntimeFractionalPercent{
+			DefaultValue: makeFractionalPercent(pct),
+		},
+	})
+}
+
+// makeFractionalPercent converts a percentage into a fraction per million so
+// that fractional percentages are preserved.
+func makeFractionalPercent(pct float32) *envoy_type_v3.FractionalPercent {
+	return &envoy_type_v3.FractionalPercent{
+		Numerator:   uint32(math.Round(float64(pct) * 10000)),
+		Denominator: envoy_type_v3.FractionalPercent_MILLION,
+	}
 }
 
 func injectLBToRouteAction(lb *structs.LoadBalancer, action *envoy_route_v3.RouteAction) error {
@@ -1148,6 +1352,68 @@ func injectLBToRouteAction(lb *structs.LoadBalancer, action *envoy_route_v3.Rout
 	return nil
 }
 
+// makeHeaderMatcher converts a header match criteria into the equivalent
+// envoy matcher. It returns nil if no criteria is set.
+func makeHeaderMatcher(hdr structs.ServiceRouteHTTPMatchHeader) *envoy_route_v3.HeaderMatcher {
+	eh := &envoy_route_v3.HeaderMatcher{
+		Name: hdr.Name,
+	}
+
+	switch {
+	case hdr.Exact != "":
+		eh.HeaderMatchSpecifier = &envoy_route_v3.HeaderMatcher_StringMatch{
+			StringMatch: &envoy_matcher_v3.StringMatcher{
+				MatchPattern: &envoy_matcher_v3.StringMatcher_Exact{
+					Exact: hdr.Exact,
+				},
+				IgnoreCase: false,
+			},
+		}
+	case hdr.Regex != "":
+		eh.HeaderMatchSpecifier = &envoy_route_v3.HeaderMatcher_StringMatch{
+			StringMatch: &envoy_matcher_v3.StringMatcher{
+				MatchPattern: &envoy_matcher_v3.StringMatcher_SafeRegex{
+					SafeRegex: response.MakeEnvoyRegexMatch(hdr.Regex),
+				},
+				IgnoreCase: false,
+			},
+		}
+
+	case hdr.Prefix != "":
+		eh.HeaderMatchSpecifier = &envoy_route_v3.HeaderMatcher_StringMatch{
+			StringMatch: &envoy_matcher_v3.StringMatcher{
+				MatchPattern: &envoy_matcher_v3.StringMatcher_Prefix{
+					Prefix: hdr.Prefix,
+				},
+				IgnoreCase: false,
+			},
+		}
+
+	case hdr.Suffix != "":
+		eh.HeaderMatchSpecifier = &envoy_route_v3.HeaderMatcher_StringMatch{
+			StringMatch: &envoy_matcher_v3.StringMatcher{
+				MatchPattern: &envoy_matcher_v3.StringMatcher_Suffix{
+					Suffix: hdr.Suffix,
+				},
+				IgnoreCase: false,
+			},
+		}
+
+	case hdr.Present:
+		eh.HeaderMatchSpecifier = &envoy_route_v3.HeaderMatcher_PresentMatch{
+			PresentMatch: true,
+		}
+	default:
+		return nil
+	}
+
+	if hdr.Invert {
+		eh.InvertMatch = true
+	}
+
+	return eh
+}
+
 func injectHeaderManipToRoute(dest *structs.ServiceRouteDestination, r *envoy_route_v3.Route) error {
 
 	if !dest.RequestHeaders.IsZero() {
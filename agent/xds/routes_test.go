@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	envoy_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/durationpb"
@@ -280,3 +281,96 @@ func TestEnvoyLBConfig_InjectToRouteAction(t *testing.T) {
 		})
 	}
 }
+
+func TestInjectACMEChallengeRoutes(t *testing.T) {
+	challengeRoute := func(token, keyAuth string) *envoy_route_v3.Route {
+		return &envoy_route_v3.Route{
+			Match: &envoy_route_v3.RouteMatch{
+				PathSpecifier: &envoy_route_v3.RouteMatch_Path{
+					Path: "/.well-known/acme-challenge/" + token,
+				},
+			},
+			Action: &envoy_route_v3.Route_DirectResponse{
+				DirectResponse: &envoy_route_v3.DirectResponseAction{
+					Status: 200,
+					Body: &envoy_core_v3.DataSource{
+						Specifier: &envoy_core_v3.DataSource_InlineString{
+							InlineString: keyAuth,
+						},
+					},
+				},
+			},
+		}
+	}
+	existingRoute := &envoy_route_v3.Route{
+		Match: &envoy_route_v3.RouteMatch{
+			PathSpecifier: &envoy_route_v3.RouteMatch_Prefix{Prefix: "/"},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		vhosts     []*envoy_route_v3.VirtualHost
+		challenges []structs.ACMEChallenge
+		expected   []*envoy_route_v3.VirtualHost
+	}{
+		{
+			name: "no challenges",
+			vhosts: []*envoy_route_v3.VirtualHost{
+				{Name: "web", Domains: []string{"example.com"}, Routes: []*envoy_route_v3.Route{existingRoute}},
+			},
+			expected: []*envoy_route_v3.VirtualHost{
+				{Name: "web", Domains: []string{"example.com"}, Routes: []*envoy_route_v3.Route{existingRoute}},
+			},
+		},
+		{
+			name: "hostnames covered by existing virtual hosts",
+			vhosts: []*envoy_route_v3.VirtualHost{
+				{Name: "web", Domains: []string{"example.com"}, Routes: []*envoy_route_v3.Route{existingRoute}},
+				{Name: "api", Domains: []string{"*.example.com"}, Routes: []*envoy_route_v3.Route{existingRoute}},
+			},
+			challenges: []structs.ACMEChallenge{
+				{Hostname: "example.com", Token: "one", KeyAuthorization: "one.thumbprint"},
+				{Hostname: "api.example.com", Token: "two", KeyAuthorization: "two.thumbprint"},
+			},
+			expected: []*envoy_route_v3.VirtualHost{
+				{Name: "web", Domains: []string{"example.com"}, Routes: []*envoy_route_v3.Route{
+					challengeRoute("one", "one.thumbprint"),
+					challengeRoute("two", "two.thumbprint"),
+					existingRoute,
+				}},
+				{Name: "api", Domains: []string{"*.example.com"}, Routes: []*envoy_route_v3.Route{
+					challengeRoute("one", "one.thumbprint"),
+					challengeRoute("two", "two.thumbprint"),
+					existingRoute,
+				}},
+			},
+		},
+		{
+			name: "uncovered hostname",
+			vhosts: []*envoy_route_v3.VirtualHost{
+				{Name: "web", Domains: []string{"example.com"}, Routes: []*envoy_route_v3.Route{existingRoute}},
+			},
+			challenges: []structs.ACMEChallenge{
+				{Hostname: "other.example.org", Token: "one", KeyAuthorization: "one.thumbprint"},
+			},
+			expected: []*envoy_route_v3.VirtualHost{
+				{Name: "web", Domains: []string{"example.com"}, Routes: []*envoy_route_v3.Route{
+					challengeRoute("one", "one.thumbprint"),
+					existingRoute,
+				}},
+				{Name: "acme-challenges", Domains: []string{"other.example.org"}, Routes: []*envoy_route_v3.Route{
+					challengeRoute("one", "one.thumbprint"),
+				}},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			routeCfg := &envoy_route_v3.RouteConfiguration{VirtualHosts: tc.vhosts}
+			injectACMEChallengeRoutes(routeCfg, tc.challenges)
+			require.Equal(t, tc.expected, routeCfg.VirtualHosts)
+		})
+	}
+}
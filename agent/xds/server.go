@@ -5,7 +5,6 @@ package xds
 
 import (
 	"context"
-	"errors"
 	"sync/atomic"
 	"time"
 
@@ -105,6 +104,10 @@ type Server struct {
 	// there has been no recent DiscoveryRequest).
 	AuthCheckFrequency time.Duration
 
+	// PushHistory records the responses sent to each proxy over incremental
+	// xDS. It is optional.
+	PushHistory *PushHistory
+
 	// ResourceMapMutateFn exclusively exists for testing purposes.
 	ResourceMapMutateFn func(resourceMap *xdscommon.IndexedResources)
 
@@ -157,19 +160,11 @@ func NewServer(
 		ResolveToken:       resolveTokenSecret,
 		CfgFetcher:         cfgFetcher,
 		AuthCheckFrequency: DefaultAuthCheckFrequency,
+		PushHistory:        NewPushHistory(DefaultPushHistoryLimit),
 		activeStreams:      &activeStreamCounters{},
 	}
 }
 
-// StreamAggregatedResources implements
-// envoy_discovery_v3.AggregatedDiscoveryServiceServer. This is the ADS endpoint which is
-// the only xDS API we directly support for now.
-//
-// Deprecated: use DeltaAggregatedResources instead
-func (s *Server) StreamAggregatedResources(stream ADSStream) error {
-	return errors.New("not implemented")
-}
-
 // Register the XDS server handlers to the given gRPC server.
 func (s *Server) Register(srv *grpc.Server) {
 	envoy_discovery_v3.RegisterAggregatedDiscoveryServiceServer(srv, s)
@@ -0,0 +1,387 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package xds
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/armon/go-metrics"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	"github.com/hashicorp/go-hclog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	external "github.com/hashicorp/consul/agent/grpc-external"
+	"github.com/hashicorp/consul/agent/grpc-external/limiter"
+	"github.com/hashicorp/consul/agent/proxycfg"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/envoyextensions/xdscommon"
+	"github.com/hashicorp/consul/logging"
+)
+
+var errSotwEnvoyUnsupported = status.Error(codes.Unimplemented,
+	"state-of-the-world xDS is only served to proxyless gRPC clients, Envoy must use incremental xDS")
+
+// StreamAggregatedResources implements
+// envoy_discovery_v3.AggregatedDiscoveryServiceServer. State-of-the-world ADS
+// is only served to proxyless gRPC clients, which identify themselves with
+// the "proxyless_grpc" node metadata; Envoy proxies must use
+// DeltaAggregatedResources instead.
+func (s *Server) StreamAggregatedResources(stream ADSStream) error {
+	defer s.activeStreams.Increment(stream.Context())()
+
+	// a channel for receiving incoming requests
+	reqCh := make(chan *envoy_discovery_v3.DiscoveryRequest)
+	reqStop := int32(0)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if atomic.LoadInt32(&reqStop) != 0 {
+				return
+			}
+			if err != nil {
+				s.Logger.Error("Error receiving new DiscoveryRequest; closing request channel", "error", err)
+				close(reqCh)
+				return
+			}
+			select {
+			case <-stream.Context().Done():
+			case reqCh <- req:
+			}
+		}
+	}()
+
+	err := s.processSotw(stream, reqCh)
+	if err != nil {
+		s.Logger.Error("Error handling ADS stream", "xdsVersion", "v3", "error", err)
+	}
+
+	// prevents writing to a closed channel if send failed on blocked recv
+	atomic.StoreInt32(&reqStop, 1)
+
+	return err
+}
+
+func (s *Server) processSotw(stream ADSStream, reqCh <-chan *envoy_discovery_v3.DiscoveryRequest) error {
+	// Handle invalid ACL tokens up-front.
+	if _, err := s.authenticate(stream.Context()); err != nil {
+		return err
+	}
+
+	// Loop state
+	var (
+		snapshot         *proxycfg.ConfigSnapshot
+		node             *envoy_config_core_v3.Node
+		stateCh          <-chan *proxycfg.ConfigSnapshot
+		drainCh          limiter.SessionTerminatedChan
+		cfgSrcTerminated proxycfg.SrcTerminatedChan
+		watchCancel      func()
+		nonce            uint64 // xDS requires a unique nonce to correlate response/request pairs
+		resourceMap      *xdscommon.IndexedResources
+	)
+
+	logger := s.Logger.Named(logging.XDS).With("xdsVersion", "v3", "proxyless", true)
+
+	// need to run a small state machine to get through initial authentication.
+	var state = stateDeltaInit
+
+	handlers := map[string]*xDSSotwType{
+		xdscommon.ListenerType: newSotwType(logger, stream, xdscommon.ListenerType),
+		xdscommon.RouteType:    newSotwType(logger, stream, xdscommon.RouteType),
+		xdscommon.ClusterType:  newSotwType(logger, stream, xdscommon.ClusterType),
+		xdscommon.EndpointType: newSotwType(logger, stream, xdscommon.EndpointType),
+	}
+
+	var authTimer <-chan time.Time
+	extendAuthTimer := func() {
+		authTimer = time.After(s.AuthCheckFrequency)
+	}
+
+	checkStreamACLs := func(snapshot *proxycfg.ConfigSnapshot) error {
+		return s.authorize(stream.Context(), snapshot)
+	}
+
+	for {
+		select {
+		case <-drainCh:
+			logger.Debug("draining stream to rebalance load")
+			metrics.IncrCounter([]string{"xds", "server", "streamDrained"}, 1)
+			return errOverwhelmed
+		case <-authTimer:
+			// It's been too long since a Discovery{Request,Response} so recheck ACLs.
+			if err := checkStreamACLs(snapshot); err != nil {
+				return err
+			}
+			extendAuthTimer()
+
+		case req, ok := <-reqCh:
+			if !ok {
+				// reqCh is closed when stream.Recv errors which is how we detect the
+				// client going away.
+				return nil
+			}
+
+			logTraceRequest(logger, "SotW xDS v3", req)
+
+			if req.TypeUrl == "" {
+				return status.Errorf(codes.InvalidArgument, "type URL is required for ADS")
+			}
+
+			if node == nil {
+				if req.Node == nil {
+					return status.Errorf(codes.InvalidArgument, "node is required on the first request")
+				}
+				if !isProxylessGRPC(req.Node) {
+					return errSotwEnvoyUnsupported
+				}
+				node = req.Node
+			}
+
+			if handler, ok := handlers[req.TypeUrl]; ok {
+				handler.Recv(req)
+			}
+
+		case cs, ok := <-stateCh:
+			if !ok {
+				// stateCh is closed either when *we* cancel the watch (on-exit via defer)
+				// or by the proxycfg.Manager when an irrecoverable error is encountered
+				// such as the ACL token getting deleted.
+				return status.Error(codes.Aborted, "xDS stream terminated due to an irrecoverable error, please try again")
+			}
+			snapshot = cs
+
+			generator := NewResourceGenerator(logger, s.CfgFetcher, false)
+			newRes, err := generator.ProxylessGRPCResourcesFromSnapshot(snapshot)
+			if err != nil {
+				return status.Errorf(codes.Unavailable, "failed to generate all xDS resources from the snapshot: %v", err)
+			}
+
+			newResourceMap := xdscommon.IndexResources(logger, newRes)
+			if s.ResourceMapMutateFn != nil {
+				s.ResourceMapMutateFn(newResourceMap)
+			}
+			resourceMap = newResourceMap
+
+		case <-cfgSrcTerminated:
+			logger.Debug("config-source sync loop terminated due to error")
+			return errConfigSyncError
+		}
+
+		// Trigger state machine
+		switch state {
+		case stateDeltaInit:
+			if node == nil {
+				continue
+			}
+
+			nodeName := node.GetMetadata().GetFields()["node_name"].GetStringValue()
+			if nodeName == "" {
+				nodeName = s.NodeName
+			}
+
+			proxyID := structs.NewServiceID(node.Id, parseEnterpriseMeta(node))
+
+			options, err := external.QueryOptionsFromContext(stream.Context())
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to watch proxy service: %s", err)
+			}
+
+			stateCh, drainCh, cfgSrcTerminated, watchCancel, err = s.ProxyWatcher.Watch(proxyID, nodeName, options.Token)
+			switch {
+			case errors.Is(err, limiter.ErrCapacityReached):
+				return errOverwhelmed
+			case err != nil:
+				return status.Errorf(codes.Internal, "failed to watch proxy: %s", err)
+			}
+			// The defer is intended to run when the stream ends, not at the end
+			// of this loop iteration.
+			defer watchCancel()
+
+			logger = logger.With("service_id", proxyID.String()) // enhance future logs
+
+			logger.Trace("watching proxy, pending initial proxycfg snapshot for xDS")
+
+			state = stateDeltaPendingInitialConfig
+		case stateDeltaPendingInitialConfig:
+			if snapshot == nil {
+				continue
+			}
+
+			state = stateDeltaRunning
+
+			if loggerName := snapshot.LoggerName(); loggerName != "" {
+				logger = logger.Named(loggerName)
+			}
+
+			logger.Trace("Got initial config snapshot")
+
+			fallthrough
+		case stateDeltaRunning:
+			// Check ACLs on every Discovery{Request,Response}.
+			if err := checkStreamACLs(snapshot); err != nil {
+				return err
+			}
+			extendAuthTimer()
+
+			for _, op := range xDSUpdateOrder {
+				if !op.Upsert {
+					continue
+				}
+				handler, ok := handlers[op.TypeUrl]
+				if !ok {
+					continue
+				}
+				if err := handler.SendIfNew(resourceMap, &nonce); err != nil {
+					return status.Errorf(codes.Unavailable,
+						"failed to send reply for type %q: %v", op.TypeUrl, err)
+				}
+			}
+		}
+	}
+}
+
+// xDSSotwType tracks the subscription of a state-of-the-world client to a
+// single resource type.
+type xDSSotwType struct {
+	logger  hclog.Logger
+	stream  ADSStream
+	typeURL string
+
+	// registered indicates if this type has been requested at least once.
+	registered bool
+
+	// wildcard indicates that the last request named no resources, which
+	// subscribes to all of them.
+	wildcard bool
+
+	// subscriptions is the set of resource names in the last request.
+	subscriptions map[string]struct{}
+
+	// dirty is set when the subscription changed so that the next call to
+	// SendIfNew responds even if the resources did not.
+	dirty bool
+
+	// version and nonce of the last response sent.
+	version string
+	nonce   string
+}
+
+func newSotwType(logger hclog.Logger, stream ADSStream, typeUrl string) *xDSSotwType {
+	return &xDSSotwType{
+		logger:        logger,
+		stream:        stream,
+		typeURL:       typeUrl,
+		subscriptions: make(map[string]struct{}),
+	}
+}
+
+// Recv handles a new discovery request for the type.
+func (t *xDSSotwType) Recv(req *envoy_discovery_v3.DiscoveryRequest) {
+	if req.ResponseNonce != "" && req.ResponseNonce != t.nonce {
+		// A request for a response that has since been superseded.
+		t.logger.Trace("ignoring request with stale nonce", "typeUrl", t.typeURL, "nonce", req.ResponseNonce)
+		return
+	}
+
+	if req.ErrorDetail != nil {
+		// There is no reason to believe that resending the same resources
+		// would be accepted, so we wait for a new snapshot instead.
+		t.logger.Error("got error response from proxyless gRPC client", "typeUrl", t.typeURL,
+			"nonce", req.ResponseNonce, "error", status.ErrorProto(req.ErrorDetail))
+	} else if req.ResponseNonce != "" {
+		t.logger.Trace("got ok response from proxyless gRPC client", "typeUrl", t.typeURL, "nonce", req.ResponseNonce)
+	}
+
+	names := make(map[string]struct{}, len(req.ResourceNames))
+	for _, name := range req.ResourceNames {
+		names[name] = struct{}{}
+	}
+	wildcard := len(names) == 0
+
+	if !t.registered || wildcard != t.wildcard || !sameNames(names, t.subscriptions) {
+		t.logger.Trace("subscription changed", "typeUrl", t.typeURL, "resources", req.ResourceNames)
+		t.registered = true
+		t.wildcard = wildcard
+		t.subscriptions = names
+		t.dirty = true
+	}
+}
+
+// SendIfNew sends every subscribed resource of the type if they changed since
+// the last response, or if the subscription did.
+func (t *xDSSotwType) SendIfNew(resourceMap *xdscommon.IndexedResources, nonce *uint64) error {
+	if t == nil || !t.registered || resourceMap == nil {
+		return nil
+	}
+
+	all := resourceMap.Index[t.typeURL]
+	names := make([]string, 0, len(all))
+	for name := range all {
+		if _, ok := t.subscriptions[name]; t.wildcard || ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	resources := make([]*anypb.Any, 0, len(names))
+	h := sha256.New()
+	for _, name := range names {
+		any, err := anypb.New(all[name])
+		if err != nil {
+			return err
+		}
+		data, err := proto.MarshalOptions{Deterministic: true}.Marshal(any)
+		if err != nil {
+			return err
+		}
+		h.Write(data)
+		resources = append(resources, any)
+	}
+	version := hex.EncodeToString(h.Sum(nil))
+
+	if !t.dirty && version == t.version {
+		return nil
+	}
+
+	*nonce++
+	resp := &envoy_discovery_v3.DiscoveryResponse{
+		VersionInfo: version,
+		Resources:   resources,
+		TypeUrl:     t.typeURL,
+		Nonce:       fmt.Sprintf("%08x", *nonce),
+	}
+
+	logger := t.logger.With("typeUrl", t.typeURL)
+	logTraceResponse(logger, "SotW xDS v3", resp)
+	if err := t.stream.Send(resp); err != nil {
+		return err
+	}
+	logger.Trace("sent response", "nonce", resp.Nonce, "resources", len(resources))
+
+	t.version = version
+	t.nonce = resp.Nonce
+	t.dirty = false
+	return nil
+}
+
+func sameNames(a, b map[string]struct{}) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for name := range a {
+		if _, ok := b[name]; !ok {
+			return false
+		}
+	}
+	return true
+}
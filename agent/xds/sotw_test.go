@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package xds
+
+import (
+	"testing"
+	"time"
+
+	envoy_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	"github.com/stretchr/testify/require"
+	rpcstatus "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/proxycfg"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/envoyextensions/xdscommon"
+	"github.com/hashicorp/consul/sdk/testutil"
+)
+
+func TestServer_StreamAggregatedResources_v3_ProxylessGRPC(t *testing.T) {
+	aclResolve := func(id string) (acl.Authorizer, error) {
+		// Allow all
+		return acl.RootAuthorizer("manage"), nil
+	}
+	scenario := newTestServerSotwScenario(t, aclResolve, "web-sidecar-proxy", "", true)
+	mgr, errCh, envoy := scenario.mgr, scenario.errCh, scenario.envoy
+
+	sid := structs.NewServiceID("web-sidecar-proxy", nil)
+
+	// Register the proxy to create state needed to Watch() on
+	mgr.RegisterProxy(t, sid)
+
+	var (
+		snap        *proxycfg.ConfigSnapshot
+		ldsResponse *envoy_discovery_v3.DiscoveryResponse
+	)
+
+	const (
+		dbCluster       = "db.default.dc1.internal.11111111-2222-3333-4444-555555555555.consul"
+		geoCacheCluster = "geo-cache.default.dc1.query.11111111-2222-3333-4444-555555555555.consul"
+	)
+
+	testutil.RunStep(t, "initial setup", func(t *testing.T) {
+		snap = newTestSnapshot(t, nil, "", nil)
+
+		// gRPC subscribes to the listener of the target it dials.
+		envoy.SendReq(t, xdscommon.ListenerType, &envoy_discovery_v3.DiscoveryRequest{
+			ResourceNames: []string{"db"},
+		})
+
+		// Check no response sent yet
+		assertSotwChanBlocked(t, envoy.stream.sendCh)
+
+		mgr.DeliverConfig(t, sid, snap)
+
+		ldsResponse = assertSotwResponseSent(t, envoy.stream.sendCh, xdscommon.ListenerType, hexString(1), "db")
+	})
+
+	testutil.RunStep(t, "ack and subscribe to clusters", func(t *testing.T) {
+		envoy.SendReq(t, xdscommon.ListenerType, &envoy_discovery_v3.DiscoveryRequest{
+			VersionInfo:   ldsResponse.VersionInfo,
+			ResponseNonce: ldsResponse.Nonce,
+			ResourceNames: []string{"db"},
+		})
+		assertSotwChanBlocked(t, envoy.stream.sendCh)
+
+		// The local app cluster is not served to gRPC.
+		envoy.SendReq(t, xdscommon.ClusterType, nil)
+		assertSotwResponseSent(t, envoy.stream.sendCh, xdscommon.ClusterType, hexString(2), dbCluster, geoCacheCluster)
+
+		envoy.SendReq(t, xdscommon.EndpointType, &envoy_discovery_v3.DiscoveryRequest{
+			ResourceNames: []string{dbCluster},
+		})
+		assertSotwResponseSent(t, envoy.stream.sendCh, xdscommon.EndpointType, hexString(3), dbCluster)
+	})
+
+	testutil.RunStep(t, "ignore stale nonce", func(t *testing.T) {
+		envoy.SendReq(t, xdscommon.EndpointType, &envoy_discovery_v3.DiscoveryRequest{
+			ResponseNonce: hexString(1),
+			ResourceNames: []string{dbCluster, geoCacheCluster},
+		})
+		assertSotwChanBlocked(t, envoy.stream.sendCh)
+	})
+
+	testutil.RunStep(t, "nack is not resent", func(t *testing.T) {
+		envoy.SendReq(t, xdscommon.EndpointType, &envoy_discovery_v3.DiscoveryRequest{
+			ResponseNonce: hexString(3),
+			ResourceNames: []string{dbCluster},
+			ErrorDetail: &rpcstatus.Status{
+				Code:    int32(codes.InvalidArgument),
+				Message: "rejected",
+			},
+		})
+		assertSotwChanBlocked(t, envoy.stream.sendCh)
+	})
+
+	testutil.RunStep(t, "subscription change", func(t *testing.T) {
+		envoy.SendReq(t, xdscommon.EndpointType, &envoy_discovery_v3.DiscoveryRequest{
+			ResponseNonce: hexString(3),
+			ResourceNames: []string{dbCluster, geoCacheCluster},
+		})
+		assertSotwResponseSent(t, envoy.stream.sendCh, xdscommon.EndpointType, hexString(4), dbCluster, geoCacheCluster)
+	})
+
+	testutil.RunStep(t, "unchanged snapshot", func(t *testing.T) {
+		mgr.DeliverConfig(t, sid, newTestSnapshot(t, snap, "", nil))
+		assertSotwChanBlocked(t, envoy.stream.sendCh)
+	})
+
+	testutil.RunStep(t, "changed snapshot", func(t *testing.T) {
+		// Switching db to grpc changes its cluster but not its endpoints.
+		mgr.DeliverConfig(t, sid, newTestSnapshot(t, snap, "grpc", nil))
+		assertSotwResponseSent(t, envoy.stream.sendCh, xdscommon.ClusterType, hexString(5), dbCluster, geoCacheCluster)
+		assertSotwChanBlocked(t, envoy.stream.sendCh)
+	})
+
+	envoy.Close()
+	select {
+	case err := <-errCh:
+		require.NoError(t, err)
+	case <-time.After(50 * time.Millisecond):
+		t.Fatalf("timed out waiting for handler to finish")
+	}
+}
+
+func TestServer_StreamAggregatedResources_v3_RejectsEnvoy(t *testing.T) {
+	aclResolve := func(id string) (acl.Authorizer, error) {
+		return acl.RootAuthorizer("manage"), nil
+	}
+	scenario := newTestServerSotwScenario(t, aclResolve, "web-sidecar-proxy", "", false)
+	errCh, envoy := scenario.errCh, scenario.envoy
+
+	envoy.SendReq(t, xdscommon.ClusterType, nil)
+
+	select {
+	case err := <-errCh:
+		require.Error(t, err)
+		require.Equal(t, codes.Unimplemented.String(), status.Code(err).String())
+	case <-time.After(50 * time.Millisecond):
+		t.Fatalf("timed out waiting for handler to finish")
+	}
+}
+
+func assertSotwChanBlocked(t *testing.T, ch chan *envoy_discovery_v3.DiscoveryResponse) {
+	t.Helper()
+	select {
+	case r := <-ch:
+		t.Fatalf("chan should block but received: %v", r)
+	case <-time.After(10 * time.Millisecond):
+		return
+	}
+}
+
+// assertSotwResponseSent checks that a response of the given type and nonce
+// that contains exactly the named resources was sent, and returns it.
+func assertSotwResponseSent(
+	t *testing.T,
+	ch chan *envoy_discovery_v3.DiscoveryResponse,
+	typeURL, nonce string,
+	names ...string,
+) *envoy_discovery_v3.DiscoveryResponse {
+	t.Helper()
+	select {
+	case got := <-ch:
+		require.Equal(t, typeURL, got.TypeUrl)
+		require.Equal(t, nonce, got.Nonce)
+		require.NotEmpty(t, got.VersionInfo)
+
+		var gotNames []string
+		for _, res := range got.Resources {
+			msg, err := res.UnmarshalNew()
+			require.NoError(t, err)
+			gotNames = append(gotNames, xdscommon.GetResourceName(msg))
+		}
+		require.ElementsMatch(t, names, gotNames)
+		return got
+	case <-time.After(50 * time.Millisecond):
+		t.Fatalf("no response received after 50ms")
+	}
+	return nil
+}
@@ -21,6 +21,7 @@ import (
 	"google.golang.org/genproto/googleapis/rpc/status"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 // TestADSDeltaStream mocks
@@ -75,6 +76,41 @@ func (s *TestADSDeltaStream) Recv() (*envoy_discovery_v3.DeltaDiscoveryRequest,
 	return r, nil
 }
 
+// TestADSStream mocks
+// discovery.AggregatedDiscoveryService_StreamAggregatedResourcesServer to allow
+// testing the state-of-the-world ADS handler.
+type TestADSStream struct {
+	stubGrpcServerStream
+	sendCh chan *envoy_discovery_v3.DiscoveryResponse
+	recvCh chan *envoy_discovery_v3.DiscoveryRequest
+}
+
+var _ ADSStream = (*TestADSStream)(nil)
+
+func NewTestADSStream(t testing.T, ctx context.Context) *TestADSStream {
+	s := &TestADSStream{
+		sendCh: make(chan *envoy_discovery_v3.DiscoveryResponse, 1),
+		recvCh: make(chan *envoy_discovery_v3.DiscoveryRequest, 1),
+	}
+	s.stubGrpcServerStream.ctx = ctx
+	return s
+}
+
+// Send implements ADSStream
+func (s *TestADSStream) Send(r *envoy_discovery_v3.DiscoveryResponse) error {
+	s.sendCh <- r
+	return nil
+}
+
+// Recv implements ADSStream
+func (s *TestADSStream) Recv() (*envoy_discovery_v3.DiscoveryRequest, error) {
+	r := <-s.recvCh
+	if r == nil {
+		return nil, io.EOF
+	}
+	return r, nil
+}
+
 // TestEnvoy is a helper to simulate Envoy ADS requests.
 type TestEnvoy struct {
 	mu sync.Mutex
@@ -88,6 +124,10 @@ type TestEnvoy struct {
 	EnvoyVersion string
 
 	deltaStream *TestADSDeltaStream // Incremental v3
+	stream      *TestADSStream      // State-of-the-world v3, for proxyless gRPC
+
+	// Proxyless makes the node identify itself as a proxyless gRPC client.
+	Proxyless bool
 
 	closed bool
 }
@@ -109,6 +149,7 @@ func NewTestEnvoy(t testing.T, proxyID, token string) *TestEnvoy {
 		token:   token,
 
 		deltaStream: NewTestADSDeltaStream(t, ctx),
+		stream:      NewTestADSStream(t, ctx),
 	}
 }
 
@@ -224,6 +265,42 @@ func (e *TestEnvoy) sendDeltaReq(
 	}
 }
 
+// SendReq sends a state-of-the-world request from the test client.
+//
+// NOTE: the input request is mutated before sending by injecting the node.
+func (e *TestEnvoy) SendReq(
+	t testing.T,
+	typeURL string,
+	req *envoy_discovery_v3.DiscoveryRequest, // optional
+) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if req == nil {
+		req = &envoy_discovery_v3.DiscoveryRequest{}
+	}
+	req.TypeUrl = typeURL
+
+	req.Node = &envoy_core_v3.Node{
+		Id:            e.proxyID,
+		Cluster:       e.proxyID,
+		UserAgentName: "grpc-go",
+	}
+	if e.Proxyless {
+		req.Node.Metadata = &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				proxylessGRPCNodeMetaKey: structpb.NewBoolValue(true),
+			},
+		}
+	}
+
+	select {
+	case e.stream.recvCh <- req:
+	case <-time.After(50 * time.Millisecond):
+		t.Fatalf("send to stream blocked for too long")
+	}
+}
+
 // Close closes the client and cancels it's request context.
 func (e *TestEnvoy) Close() error {
 	e.mu.Lock()
@@ -232,6 +309,7 @@ func (e *TestEnvoy) Close() error {
 	// unblock the recv chans to simulate recv errors when client disconnects
 	if !e.closed && e.deltaStream.recvCh != nil {
 		close(e.deltaStream.recvCh)
+		close(e.stream.recvCh)
 		e.closed = true
 	}
 	if e.cancel != nil {
@@ -226,6 +226,42 @@ func newTestServerDeltaScenario(
 	}
 }
 
+func newTestServerSotwScenario(
+	t *testing.T,
+	resolveTokenSecret ACLResolverFunc,
+	proxyID string,
+	token string,
+	proxyless bool,
+) *testServerScenario {
+	mgr := newTestManager(t)
+	envoy := NewTestEnvoy(t, proxyID, token)
+	envoy.Proxyless = proxyless
+
+	t.Cleanup(func() {
+		envoy.Close()
+	})
+
+	s := NewServer(
+		"node-123",
+		testutil.Logger(t),
+		mgr,
+		resolveTokenSecret,
+		nil, /*cfgFetcher ConfigFetcher*/
+	)
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- s.StreamAggregatedResources(envoy.stream)
+	}()
+
+	return &testServerScenario{
+		server: s,
+		mgr:    mgr,
+		envoy:  envoy,
+		errCh:  errCh,
+	}
+}
+
 func protoToSortedJSON(t *testing.T, pb proto.Message) string {
 	dup, err := copystructure.Copy(pb)
 	require.NoError(t, err)
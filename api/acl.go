@@ -826,6 +826,31 @@ func (a *ACL) TokenClone(accessorID string, description string, q *WriteOptions)
 	return &out, wm, nil
 }
 
+// TokenExchange creates a short-lived local token with a subset of the
+// privileges of the token used to make the request. The ExpirationTTL field
+// of the ACLToken structure is required, and its policies, roles and
+// identities must not grant more than the requesting token.
+func (a *ACL) TokenExchange(token *ACLToken, q *WriteOptions) (*ACLToken, *WriteMeta, error) {
+	r := a.c.newRequest("PUT", "/v1/acl/token/exchange")
+	r.setWriteOptions(q)
+	r.obj = token
+	rtt, resp, err := a.c.doRequest(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer closeResponseBody(resp)
+	if err := requireOK(resp); err != nil {
+		return nil, nil, err
+	}
+	wm := &WriteMeta{RequestTime: rtt}
+	var out ACLToken
+	if err := decodeBody(resp, &out); err != nil {
+		return nil, nil, err
+	}
+
+	return &out, wm, nil
+}
+
 // TokenDelete removes a single ACL token. The accessorID parameter must be a valid
 // Accessor ID of an existing token.
 func (a *ACL) TokenDelete(accessorID string, q *WriteOptions) (*WriteMeta, error) {
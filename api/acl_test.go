@@ -661,6 +661,33 @@ func TestAPI_ACLToken_Clone(t *testing.T) {
 	require.Equal(t, cloned, read)
 }
 
+func TestAPI_ACLToken_Exchange(t *testing.T) {
+	t.Parallel()
+	c, s := makeACLClient(t)
+	defer s.Stop()
+
+	acl := c.ACL()
+
+	initialManagement, _, err := acl.TokenReadSelf(nil)
+	require.NoError(t, err)
+	require.NotNil(t, initialManagement)
+
+	child, _, err := acl.TokenExchange(&ACLToken{
+		Policies:      []*ACLTokenPolicyLink{{ID: "00000000-0000-0000-0000-000000000001"}},
+		ExpirationTTL: time.Minute,
+	}, nil)
+	require.NoError(t, err)
+	require.NotNil(t, child)
+	require.True(t, child.Local)
+	require.NotNil(t, child.ExpirationTime)
+	require.Equal(t, "exchanged from token "+initialManagement.AccessorID, child.Description)
+
+	_, _, err = acl.TokenExchange(&ACLToken{
+		Policies: []*ACLTokenPolicyLink{{ID: "00000000-0000-0000-0000-000000000001"}},
+	}, nil)
+	require.Error(t, err)
+}
+
 func TestAPI_AuthMethod_List(t *testing.T) {
 	t.Parallel()
 	c, s := makeACLClient(t)
@@ -10,6 +10,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
+	"time"
 )
 
 // ServiceKind is the kind of service being registered.
@@ -1189,6 +1191,68 @@ func (a *Agent) ConnectCALeaf(serviceID string, q *QueryOptions) (*LeafCert, *Qu
 	return &out, qm, nil
 }
 
+// AgentXDSPush is an xDS response sent to a proxy by the agent.
+type AgentXDSPush struct {
+	Time    time.Time
+	TypeURL string
+	Nonce   string
+
+	// Status is "pending" until the proxy accepts the response with an
+	// "ack" or rejects it with a "nack".
+	Status string
+
+	// ErrorDetail is the error the proxy reported when rejecting the
+	// response.
+	ErrorDetail string `json:",omitempty"`
+
+	Resources []AgentXDSPushResource
+	Removed   []string `json:",omitempty"`
+}
+
+// AgentXDSPushResource is a resource added or updated by an xDS response.
+type AgentXDSPushResource struct {
+	Name    string
+	Version string
+
+	// Diff lists the changes since the previous push of the resource. A
+	// resource that was not pushed before has a single "add" at the root.
+	Diff []AgentXDSDiffOp
+}
+
+// AgentXDSDiffOp is a change to an xDS resource in the style of a JSON patch
+// operation. Path is a JSON pointer into the JSON form of the resource.
+type AgentXDSDiffOp struct {
+	Op   string
+	Path string
+	Old  interface{} `json:",omitempty"`
+	New  interface{} `json:",omitempty"`
+}
+
+// XDSHistory returns up to limit of the most recent xDS responses the agent
+// sent to the proxy with the given service ID, oldest first. A limit of zero
+// returns every response the agent kept.
+func (a *Agent) XDSHistory(proxyID string, limit int, q *QueryOptions) ([]AgentXDSPush, error) {
+	r := a.c.newRequest("GET", "/v1/agent/xds/history/"+proxyID)
+	r.setQueryOptions(q)
+	if limit > 0 {
+		r.params.Set("limit", strconv.Itoa(limit))
+	}
+	_, resp, err := a.c.doRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	defer closeResponseBody(resp)
+	if err := requireOK(resp); err != nil {
+		return nil, err
+	}
+
+	var out []AgentXDSPush
+	if err := decodeBody(resp, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EnableServiceMaintenance toggles service maintenance mode on
 // for the given service ID.
 func (a *Agent) EnableServiceMaintenance(serviceID, reason string) error {
@@ -35,13 +35,20 @@ const (
 	TCPRoute              string = "tcp-route"
 	FileSystemCertificate string = "file-system-certificate"
 	InlineCertificate     string = "inline-certificate"
+	ACMECertificate       string = "acme-certificate"
 	HTTPRoute             string = "http-route"
 	JWTProvider           string = "jwt-provider"
+	GRPCRoute             string = "grpc-route"
+	TLSRoute              string = "tls-route"
 )
 
 const (
 	BuiltinAWSLambdaExtension         string = "builtin/aws/lambda"
+	BuiltinCompressionExtension       string = "builtin/compression"
+	BuiltinCORSExtension              string = "builtin/cors"
 	BuiltinExtAuthzExtension          string = "builtin/ext-authz"
+	BuiltinExtProcExtension           string = "builtin/ext-proc"
+	BuiltinLocalRateLimitExtension    string = "builtin/local-ratelimit"
 	BuiltinLuaExtension               string = "builtin/lua"
 	BuiltinOTELAccessLoggingExtension string = "builtin/otel-access-logging"
 	BuiltinPropertyOverrideExtension  string = "builtin/property-override"
@@ -358,6 +365,20 @@ type InstanceLevelRouteRateLimits struct {
 	RequestsMaxBurst  int `alias:"requests_max_burst"`
 }
 
+// LeafCertConfig overrides the leaf certs issued to instances of a service.
+// Unset fields fall back to the defaults of the CA.
+type LeafCertConfig struct {
+	// PrivateKeyType is the type of key generated for the leaf cert, one of
+	// "ec", "rsa" or "ed25519".
+	PrivateKeyType string `json:",omitempty" alias:"private_key_type"`
+
+	// PrivateKeyBits is the size of the key generated for the leaf cert.
+	PrivateKeyBits int `json:",omitempty" alias:"private_key_bits"`
+
+	// TTL is how long the leaf cert is valid for.
+	TTL time.Duration `json:",omitempty"`
+}
+
 type ServiceConfigEntry struct {
 	Kind                      string
 	Name                      string
@@ -378,6 +399,7 @@ type ServiceConfigEntry struct {
 	BalanceInboundConnections string                  `json:",omitempty" alias:"balance_inbound_connections"`
 	RateLimits                *RateLimits             `json:",omitempty" alias:"rate_limits"`
 	EnvoyExtensions           []EnvoyExtension        `json:",omitempty" alias:"envoy_extensions"`
+	LeafCert                  *LeafCertConfig         `json:",omitempty" alias:"leaf_cert"`
 	Meta                      map[string]string       `json:",omitempty"`
 	CreateIndex               uint64
 	ModifyIndex               uint64
@@ -452,12 +474,18 @@ func makeConfigEntry(kind, name string) (ConfigEntry, error) {
 		return &FileSystemCertificateConfigEntry{Kind: kind, Name: name}, nil
 	case InlineCertificate:
 		return &InlineCertificateConfigEntry{Kind: kind, Name: name}, nil
+	case ACMECertificate:
+		return &ACMECertificateConfigEntry{Kind: kind, Name: name}, nil
 	case HTTPRoute:
 		return &HTTPRouteConfigEntry{Kind: kind, Name: name}, nil
 	case RateLimitIPConfig:
 		return &RateLimitIPConfigEntry{Kind: kind, Name: name}, nil
 	case JWTProvider:
 		return &JWTProviderConfigEntry{Kind: kind, Name: name}, nil
+	case GRPCRoute:
+		return &GRPCRouteConfigEntry{Kind: kind, Name: name}, nil
+	case TLSRoute:
+		return &TLSRouteConfigEntry{Kind: kind, Name: name}, nil
 	default:
 		return nil, fmt.Errorf("invalid config entry kind: %s", kind)
 	}
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package api
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// ACMECertificateConfigEntry manages a certificate for the given hostnames that
+// is issued and renewed by an ACME certificate authority.
+type ACMECertificateConfigEntry struct {
+	// Kind of the config entry. This should be set to api.ACMECertificate.
+	Kind string
+
+	// Name is used to match the config entry with its associated certificate.
+	Name string
+
+	// DirectoryURL is the URL of the ACME server's directory resource.
+	DirectoryURL string `alias:"directory_url"`
+
+	// DirectoryCACertificate is an optional PEM encoded bundle used to verify
+	// the ACME server instead of the system roots.
+	DirectoryCACertificate string `json:",omitempty" alias:"directory_ca_certificate"`
+
+	// Email is the contact address registered with the ACME account.
+	Email string `json:",omitempty"`
+
+	// Hostnames are the DNS names the certificate is issued for.
+	Hostnames []string
+
+	// RenewBefore is how long before expiry the certificate is renewed.
+	// Defaults to 30 days.
+	RenewBefore time.Duration `json:",omitempty" alias:"renew_before"`
+
+	// Certificate is the issued certificate chain encoded in raw PEM format.
+	// This is a read-only field managed by the ACME controller.
+	Certificate string `json:",omitempty"`
+
+	// PrivateKey is the private key for Certificate encoded in raw PEM format.
+	// This is a read-only field managed by the ACME controller.
+	PrivateKey string `json:",omitempty" alias:"private_key"`
+
+	// AccountKey is the private key of the ACME account encoded in raw PEM
+	// format. This is a read-only field managed by the ACME controller.
+	AccountKey string `json:",omitempty" alias:"account_key"`
+
+	// Challenges are the HTTP-01 challenges currently being answered by the
+	// gateways referencing this certificate. This is a read-only field managed
+	// by the ACME controller.
+	Challenges []ACMEChallenge `json:",omitempty"`
+
+	Meta map[string]string `json:",omitempty"`
+
+	// Status is the asynchronous status which the ACME controller propagates
+	// to the user.
+	Status ConfigEntryStatus
+
+	// CreateIndex is the Raft index this entry was created at. This is a
+	// read-only field.
+	CreateIndex uint64
+
+	// ModifyIndex is used for the Check-And-Set operations and can also be fed
+	// back into the WaitIndex of the QueryOptions in order to perform blocking
+	// queries.
+	ModifyIndex uint64
+
+	// Partition is the partition the config entry is associated with.
+	// Partitioning is a Consul Enterprise feature.
+	Partition string `json:",omitempty"`
+
+	// Namespace is the namespace the config entry is associated with.
+	// Namespacing is a Consul Enterprise feature.
+	Namespace string `json:",omitempty"`
+}
+
+// ACMEChallenge is a pending HTTP-01 challenge for one of the hostnames of an
+// ACMECertificateConfigEntry.
+type ACMEChallenge struct {
+	Hostname         string
+	Token            string
+	KeyAuthorization string `alias:"key_authorization"`
+}
+
+func (a *ACMECertificateConfigEntry) GetKind() string            { return ACMECertificate }
+func (a *ACMECertificateConfigEntry) GetName() string            { return a.Name }
+func (a *ACMECertificateConfigEntry) GetPartition() string       { return a.Partition }
+func (a *ACMECertificateConfigEntry) GetNamespace() string       { return a.Namespace }
+func (a *ACMECertificateConfigEntry) GetMeta() map[string]string { return a.Meta }
+func (a *ACMECertificateConfigEntry) GetCreateIndex() uint64     { return a.CreateIndex }
+func (a *ACMECertificateConfigEntry) GetModifyIndex() uint64     { return a.ModifyIndex }
+
+func (a *ACMECertificateConfigEntry) MarshalJSON() ([]byte, error) {
+	type Alias ACMECertificateConfigEntry
+	exported := &struct {
+		RenewBefore string `json:",omitempty"`
+		*Alias
+	}{
+		RenewBefore: a.RenewBefore.String(),
+		Alias:       (*Alias)(a),
+	}
+	if a.RenewBefore == 0 {
+		exported.RenewBefore = ""
+	}
+
+	return json.Marshal(exported)
+}
+
+func (a *ACMECertificateConfigEntry) UnmarshalJSON(data []byte) error {
+	type Alias ACMECertificateConfigEntry
+	aux := &struct {
+		RenewBefore string
+		*Alias
+	}{
+		Alias: (*Alias)(a),
+	}
+	var err error
+	if err = json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	if aux.RenewBefore != "" {
+		if a.RenewBefore, err = time.ParseDuration(aux.RenewBefore); err != nil {
+			return err
+		}
+	}
+	return nil
+}
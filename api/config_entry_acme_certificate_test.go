@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPI_ConfigEntries_ACMECertificate(t *testing.T) {
+	t.Parallel()
+	c, s := makeClient(t)
+	defer s.Stop()
+
+	configEntries := c.ConfigEntries()
+
+	cert1 := &ACMECertificateConfigEntry{
+		Kind:         ACMECertificate,
+		Name:         "cert1",
+		Meta:         map[string]string{"foo": "bar"},
+		DirectoryURL: "https://127.0.0.1:1/directory",
+		Email:        "admin@example.com",
+		Hostnames:    []string{"example.com", "www.example.com"},
+		RenewBefore:  7 * 24 * time.Hour,
+	}
+
+	// set it
+	_, wm, err := configEntries.Set(cert1, nil)
+	require.NoError(t, err)
+	assert.NotNil(t, wm)
+
+	// get it
+	entry, qm, err := configEntries.Get(ACMECertificate, "cert1", nil)
+	require.NoError(t, err)
+	require.NotNil(t, qm)
+	assert.NotEqual(t, 0, qm.RequestTime)
+
+	readCert, ok := entry.(*ACMECertificateConfigEntry)
+	require.True(t, ok)
+	assert.Equal(t, cert1.Kind, readCert.Kind)
+	assert.Equal(t, cert1.Name, readCert.Name)
+	assert.Equal(t, cert1.Meta, readCert.GetMeta())
+	assert.Equal(t, cert1.DirectoryURL, readCert.DirectoryURL)
+	assert.Equal(t, cert1.Email, readCert.Email)
+	assert.Equal(t, cert1.Hostnames, readCert.Hostnames)
+	assert.Equal(t, cert1.RenewBefore, readCert.RenewBefore)
+
+	// list it
+	entries, qm, err := configEntries.List(ACMECertificate, nil)
+	require.NoError(t, err)
+	require.NotNil(t, qm)
+	assert.NotEqual(t, 0, qm.RequestTime)
+
+	require.Len(t, entries, 1)
+	assert.Equal(t, cert1.Kind, entries[0].GetKind())
+	assert.Equal(t, cert1.Name, entries[0].GetName())
+
+	// delete it
+	wm, err = configEntries.Delete(ACMECertificate, cert1.Name, nil)
+	require.NoError(t, err)
+	require.NotNil(t, wm)
+	assert.NotEqual(t, 0, wm.RequestTime)
+
+	// try to get it
+	_, _, err = configEntries.Get(ACMECertificate, cert1.Name, nil)
+	assert.Error(t, err)
+}
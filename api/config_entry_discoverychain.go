@@ -38,6 +38,7 @@ type ServiceRoute struct {
 
 type ServiceRouteMatch struct {
 	HTTP *ServiceRouteHTTPMatch `json:",omitempty"`
+	GRPC *ServiceRouteGRPCMatch `json:",omitempty"`
 }
 
 type ServiceRouteHTTPMatch struct {
@@ -68,32 +69,50 @@ type ServiceRouteHTTPMatchQueryParam struct {
 	Regex   string `json:",omitempty"`
 }
 
+type ServiceRouteGRPCMatch struct {
+	Service  string                        `json:",omitempty"`
+	Method   string                        `json:",omitempty"`
+	Metadata []ServiceRouteHTTPMatchHeader `json:",omitempty"`
+}
+
 type ServiceRouteDestination struct {
-	Service               string               `json:",omitempty"`
-	ServiceSubset         string               `json:",omitempty" alias:"service_subset"`
-	Namespace             string               `json:",omitempty"`
-	Partition             string               `json:",omitempty"`
-	PrefixRewrite         string               `json:",omitempty" alias:"prefix_rewrite"`
-	RequestTimeout        time.Duration        `json:",omitempty" alias:"request_timeout"`
-	IdleTimeout           time.Duration        `json:",omitempty" alias:"idle_timeout"`
-	NumRetries            uint32               `json:",omitempty" alias:"num_retries"`
-	RetryOnConnectFailure bool                 `json:",omitempty" alias:"retry_on_connect_failure"`
-	RetryOnStatusCodes    []uint32             `json:",omitempty" alias:"retry_on_status_codes"`
-	RetryOn               []string             `json:",omitempty" alias:"retry_on"`
-	RequestHeaders        *HTTPHeaderModifiers `json:",omitempty" alias:"request_headers"`
-	ResponseHeaders       *HTTPHeaderModifiers `json:",omitempty" alias:"response_headers"`
+	Service               string                        `json:",omitempty"`
+	ServiceSubset         string                        `json:",omitempty" alias:"service_subset"`
+	Namespace             string                        `json:",omitempty"`
+	Partition             string                        `json:",omitempty"`
+	PrefixRewrite         string                        `json:",omitempty" alias:"prefix_rewrite"`
+	RequestTimeout        time.Duration                 `json:",omitempty" alias:"request_timeout"`
+	IdleTimeout           time.Duration                 `json:",omitempty" alias:"idle_timeout"`
+	NumRetries            uint32                        `json:",omitempty" alias:"num_retries"`
+	RetryOnConnectFailure bool                          `json:",omitempty" alias:"retry_on_connect_failure"`
+	RetryOnStatusCodes    []uint32                      `json:",omitempty" alias:"retry_on_status_codes"`
+	RetryOn               []string                      `json:",omitempty" alias:"retry_on"`
+	RetryBaseInterval     time.Duration                 `json:",omitempty" alias:"retry_base_interval"`
+	RetryMaxInterval      time.Duration                 `json:",omitempty" alias:"retry_max_interval"`
+	PerTryTimeout         time.Duration                 `json:",omitempty" alias:"per_try_timeout"`
+	RetriableHeaders      []ServiceRouteHTTPMatchHeader `json:",omitempty" alias:"retriable_headers"`
+	RequestHeaders        *HTTPHeaderModifiers          `json:",omitempty" alias:"request_headers"`
+	ResponseHeaders       *HTTPHeaderModifiers          `json:",omitempty" alias:"response_headers"`
+	FaultInjection        *ServiceRouteFaultInjection   `json:",omitempty" alias:"fault_injection"`
+	Mirror                *MirrorPolicy                 `json:",omitempty"`
 }
 
 func (e *ServiceRouteDestination) MarshalJSON() ([]byte, error) {
 	type Alias ServiceRouteDestination
 	exported := &struct {
-		RequestTimeout string `json:",omitempty"`
-		IdleTimeout    string `json:",omitempty"`
+		RequestTimeout    string `json:",omitempty"`
+		IdleTimeout       string `json:",omitempty"`
+		RetryBaseInterval string `json:",omitempty"`
+		RetryMaxInterval  string `json:",omitempty"`
+		PerTryTimeout     string `json:",omitempty"`
 		*Alias
 	}{
-		RequestTimeout: e.RequestTimeout.String(),
-		IdleTimeout:    e.IdleTimeout.String(),
-		Alias:          (*Alias)(e),
+		RequestTimeout:    e.RequestTimeout.String(),
+		IdleTimeout:       e.IdleTimeout.String(),
+		RetryBaseInterval: e.RetryBaseInterval.String(),
+		RetryMaxInterval:  e.RetryMaxInterval.String(),
+		PerTryTimeout:     e.PerTryTimeout.String(),
+		Alias:             (*Alias)(e),
 	}
 	if e.RequestTimeout == 0 {
 		exported.RequestTimeout = ""
@@ -101,6 +120,15 @@ func (e *ServiceRouteDestination) MarshalJSON() ([]byte, error) {
 	if e.IdleTimeout == 0 {
 		exported.IdleTimeout = ""
 	}
+	if e.RetryBaseInterval == 0 {
+		exported.RetryBaseInterval = ""
+	}
+	if e.RetryMaxInterval == 0 {
+		exported.RetryMaxInterval = ""
+	}
+	if e.PerTryTimeout == 0 {
+		exported.PerTryTimeout = ""
+	}
 
 	return json.Marshal(exported)
 }
@@ -108,8 +136,11 @@ func (e *ServiceRouteDestination) MarshalJSON() ([]byte, error) {
 func (e *ServiceRouteDestination) UnmarshalJSON(data []byte) error {
 	type Alias ServiceRouteDestination
 	aux := &struct {
-		RequestTimeout string
-		IdleTimeout    string
+		RequestTimeout    string
+		IdleTimeout       string
+		RetryBaseInterval string
+		RetryMaxInterval  string
+		PerTryTimeout     string
 		*Alias
 	}{
 		Alias: (*Alias)(e),
@@ -128,9 +159,79 @@ func (e *ServiceRouteDestination) UnmarshalJSON(data []byte) error {
 			return err
 		}
 	}
+	if aux.RetryBaseInterval != "" {
+		if e.RetryBaseInterval, err = time.ParseDuration(aux.RetryBaseInterval); err != nil {
+			return err
+		}
+	}
+	if aux.RetryMaxInterval != "" {
+		if e.RetryMaxInterval, err = time.ParseDuration(aux.RetryMaxInterval); err != nil {
+			return err
+		}
+	}
+	if aux.PerTryTimeout != "" {
+		if e.PerTryTimeout, err = time.ParseDuration(aux.PerTryTimeout); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ServiceRouteFaultInjection configures faults to inject into the requests
+// matching a route.
+type ServiceRouteFaultInjection struct {
+	Delay   *FaultInjectionDelay          `json:",omitempty"`
+	Abort   *FaultInjectionAbort          `json:",omitempty"`
+	Headers []ServiceRouteHTTPMatchHeader `json:",omitempty"`
+}
+
+type FaultInjectionDelay struct {
+	FixedDelay time.Duration `json:",omitempty" alias:"fixed_delay"`
+	Percentage float32       `json:",omitempty"`
+}
+
+func (d *FaultInjectionDelay) MarshalJSON() ([]byte, error) {
+	type Alias FaultInjectionDelay
+	exported := &struct {
+		FixedDelay string `json:",omitempty"`
+		*Alias
+	}{
+		FixedDelay: d.FixedDelay.String(),
+		Alias:      (*Alias)(d),
+	}
+	if d.FixedDelay == 0 {
+		exported.FixedDelay = ""
+	}
+
+	return json.Marshal(exported)
+}
+
+func (d *FaultInjectionDelay) UnmarshalJSON(data []byte) error {
+	type Alias FaultInjectionDelay
+	aux := &struct {
+		FixedDelay string
+		*Alias
+	}{
+		Alias: (*Alias)(d),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if aux.FixedDelay != "" {
+		if d.FixedDelay, err = time.ParseDuration(aux.FixedDelay); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+type FaultInjectionAbort struct {
+	HTTPStatus uint32  `json:",omitempty" alias:"http_status"`
+	GRPCStatus uint32  `json:",omitempty" alias:"grpc_status"`
+	Percentage float32 `json:",omitempty"`
+}
+
 type ServiceSplitterConfigEntry struct {
 	Kind      string
 	Name      string
@@ -160,6 +261,17 @@ type ServiceSplit struct {
 	Partition       string               `json:",omitempty"`
 	RequestHeaders  *HTTPHeaderModifiers `json:",omitempty" alias:"request_headers"`
 	ResponseHeaders *HTTPHeaderModifiers `json:",omitempty" alias:"response_headers"`
+	Mirror          *MirrorPolicy        `json:",omitempty"`
+}
+
+// MirrorPolicy sends a copy of a percentage of requests to a shadow service.
+// Responses from the shadow service are discarded.
+type MirrorPolicy struct {
+	Service       string
+	ServiceSubset string  `json:",omitempty" alias:"service_subset"`
+	Namespace     string  `json:",omitempty"`
+	Partition     string  `json:",omitempty"`
+	Percentage    float32 `json:",omitempty"`
 }
 
 type ServiceResolverConfigEntry struct {
@@ -179,6 +291,10 @@ type ServiceResolverConfigEntry struct {
 	// local partition will be used to prioritize connectivity.
 	PrioritizeByLocality *ServiceResolverPrioritizeByLocality `json:",omitempty" alias:"prioritize_by_locality"`
 
+	// RetryPolicy is the default retry behavior for requests to this service.
+	// Route destinations that configure retries take precedence over it.
+	RetryPolicy *ServiceResolverRetryPolicy `json:",omitempty" alias:"retry_policy"`
+
 	// LoadBalancer determines the load balancing policy and configuration for services
 	// issuing requests to this upstream service.
 	LoadBalancer *LoadBalancer `json:",omitempty" alias:"load_balancer"`
@@ -286,6 +402,94 @@ type ServiceResolverFailoverPolicy struct {
 	Regions []string `json:",omitempty"`
 }
 
+// ServiceResolverRetryPolicy describes when and how requests to the resolved
+// service are retried. The retry fields have the same meaning as the ones on
+// ServiceRouteDestination.
+type ServiceResolverRetryPolicy struct {
+	NumRetries            uint32                        `json:",omitempty" alias:"num_retries"`
+	RetryOnConnectFailure bool                          `json:",omitempty" alias:"retry_on_connect_failure"`
+	RetryOn               []string                      `json:",omitempty" alias:"retry_on"`
+	RetryOnStatusCodes    []uint32                      `json:",omitempty" alias:"retry_on_status_codes"`
+	RetryBaseInterval     time.Duration                 `json:",omitempty" alias:"retry_base_interval"`
+	RetryMaxInterval      time.Duration                 `json:",omitempty" alias:"retry_max_interval"`
+	PerTryTimeout         time.Duration                 `json:",omitempty" alias:"per_try_timeout"`
+	RetriableHeaders      []ServiceRouteHTTPMatchHeader `json:",omitempty" alias:"retriable_headers"`
+
+	// RetryBudget limits the retries to the resolved service to a share of
+	// its active requests, including retries configured on route destinations.
+	RetryBudget *RetryBudget `json:",omitempty" alias:"retry_budget"`
+}
+
+func (p *ServiceResolverRetryPolicy) MarshalJSON() ([]byte, error) {
+	type Alias ServiceResolverRetryPolicy
+	exported := &struct {
+		RetryBaseInterval string `json:",omitempty"`
+		RetryMaxInterval  string `json:",omitempty"`
+		PerTryTimeout     string `json:",omitempty"`
+		*Alias
+	}{
+		RetryBaseInterval: p.RetryBaseInterval.String(),
+		RetryMaxInterval:  p.RetryMaxInterval.String(),
+		PerTryTimeout:     p.PerTryTimeout.String(),
+		Alias:             (*Alias)(p),
+	}
+	if p.RetryBaseInterval == 0 {
+		exported.RetryBaseInterval = ""
+	}
+	if p.RetryMaxInterval == 0 {
+		exported.RetryMaxInterval = ""
+	}
+	if p.PerTryTimeout == 0 {
+		exported.PerTryTimeout = ""
+	}
+
+	return json.Marshal(exported)
+}
+
+func (p *ServiceResolverRetryPolicy) UnmarshalJSON(data []byte) error {
+	type Alias ServiceResolverRetryPolicy
+	aux := &struct {
+		RetryBaseInterval string
+		RetryMaxInterval  string
+		PerTryTimeout     string
+		*Alias
+	}{
+		Alias: (*Alias)(p),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if aux.RetryBaseInterval != "" {
+		if p.RetryBaseInterval, err = time.ParseDuration(aux.RetryBaseInterval); err != nil {
+			return err
+		}
+	}
+	if aux.RetryMaxInterval != "" {
+		if p.RetryMaxInterval, err = time.ParseDuration(aux.RetryMaxInterval); err != nil {
+			return err
+		}
+	}
+	if aux.PerTryTimeout != "" {
+		if p.PerTryTimeout, err = time.ParseDuration(aux.PerTryTimeout); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RetryBudget caps the number of concurrent retries to a percentage of the
+// active requests.
+type RetryBudget struct {
+	// BudgetPercent is the percentage of active requests that may be retries,
+	// between 0 and 100. If unset the proxy default of 20% is used.
+	BudgetPercent float64 `json:",omitempty" alias:"budget_percent"`
+
+	// MinRetryConcurrency is the number of concurrent retries that are always
+	// allowed, regardless of the budget. If unset the proxy default of 3 is used.
+	MinRetryConcurrency uint32 `json:",omitempty" alias:"min_retry_concurrency"`
+}
+
 type ServiceResolverPrioritizeByLocality struct {
 	// Mode specifies the type of prioritization that will be performed
 	// when selecting nodes in the local partition.
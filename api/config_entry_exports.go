@@ -23,6 +23,10 @@ type ExportedServicesConfigEntry struct {
 	// to expose them to.
 	Services []ExportedService `json:",omitempty"`
 
+	// KeyPrefixes is a list of KV prefixes to be replicated to peers and the
+	// list of peers to replicate them to.
+	KeyPrefixes []ExportedKeyPrefix `json:",omitempty" alias:"key_prefixes"`
+
 	Meta map[string]string `json:",omitempty"`
 
 	// CreateIndex is the Raft index this entry was created at. This is a
@@ -46,6 +50,24 @@ type ExportedService struct {
 
 	// Consumers is a list of downstream consumers of the service to be exported.
 	Consumers []ServiceConsumer `json:",omitempty"`
+
+	// ConfigEntries is a list of kinds of config entries named after the
+	// service to export along with it. Only service-defaults, service-resolver
+	// and service-router entries can be exported, and they are only replicated
+	// to Peer consumers.
+	ConfigEntries []string `json:",omitempty" alias:"config_entries"`
+}
+
+// ExportedKeyPrefix manages the replication of the KV entries under a prefix in
+// the local partition to peers. Replicated entries are read-only in the
+// importing cluster and stored under "_peer/<peer name>/".
+type ExportedKeyPrefix struct {
+	// Prefix is the KV prefix to export.
+	Prefix string
+
+	// Consumers is a list of peers to export the prefix to. Only Peer
+	// consumers are supported.
+	Consumers []ServiceConsumer `json:",omitempty"`
 }
 
 // ServiceConsumer represents a downstream consumer of the service to be exported.
@@ -59,6 +59,16 @@ func TestAPI_ConfigEntries_ExportedServices(t *testing.T) {
 					},
 				},
 			},
+			KeyPrefixes: []ExportedKeyPrefix{
+				{
+					Prefix: "db/config/",
+					Consumers: []ServiceConsumer{
+						{
+							Peer: "alpha",
+						},
+					},
+				},
+			},
 			Meta: map[string]string{
 				"foo": "bar",
 				"gir": "zim",
@@ -60,6 +60,10 @@ type GatewayTLSConfig struct {
 	// SDS allows configuring TLS certificate from an SDS service.
 	SDS *GatewayTLSSDSConfig `json:",omitempty"`
 
+	// ACMECertificate is the name of an acme-certificate config entry whose
+	// certificate is served instead of a Connect certificate or one from SDS.
+	ACMECertificate string `json:",omitempty" alias:"acme_certificate"`
+
 	TLSMinVersion string `json:",omitempty" alias:"tls_min_version"`
 	TLSMaxVersion string `json:",omitempty" alias:"tls_max_version"`
 
@@ -283,7 +287,8 @@ type APIGatewayListener struct {
 	// Port is the port at which this listener should bind.
 	Port int
 	// Protocol is the protocol that a listener should use, it must
-	// either be "http" or "tcp"
+	// be one of "http", "tcp" or "tls". TLS listeners pass encrypted
+	// connections through to the service selected by SNI.
 	Protocol string
 	// TLS is the TLS settings for the listener.
 	TLS APIGatewayTLSConfiguration
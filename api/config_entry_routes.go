@@ -279,3 +279,142 @@ type HTTPService struct {
 	// Namespacing is a Consul Enterprise feature.
 	Namespace string `json:",omitempty"`
 }
+
+// GRPCRouteConfigEntry manages the configuration for a gRPC route
+// with the given name.
+type GRPCRouteConfigEntry struct {
+	// Kind of the config entry. This should be set to api.GRPCRoute.
+	Kind string
+
+	// Name is used to match the config entry with its associated grpc-route.
+	Name string
+
+	// Parents is a list of gateways that this route should be bound to
+	Parents []ResourceReference
+	// Rules are a list of gRPC-based routing rules that this route should
+	// use for constructing a routing table.
+	Rules []GRPCRouteRule
+	// Hostnames are the hostnames for which this GRPCRoute should respond to requests.
+	Hostnames []string
+
+	Meta map[string]string `json:",omitempty"`
+
+	// CreateIndex is the Raft index this entry was created at. This is a
+	// read-only field.
+	CreateIndex uint64
+
+	// ModifyIndex is used for the Check-And-Set operations and can also be fed
+	// back into the WaitIndex of the QueryOptions in order to perform blocking
+	// queries.
+	ModifyIndex uint64
+
+	// Partition is the partition the config entry is associated with.
+	// Partitioning is a Consul Enterprise feature.
+	Partition string `json:",omitempty"`
+
+	// Namespace is the namespace the config entry is associated with.
+	// Namespacing is a Consul Enterprise feature.
+	Namespace string `json:",omitempty"`
+
+	// Status is the asynchronous status which a GRPCRoute propagates to the user.
+	Status ConfigEntryStatus
+}
+
+func (r *GRPCRouteConfigEntry) GetKind() string            { return GRPCRoute }
+func (r *GRPCRouteConfigEntry) GetName() string            { return r.Name }
+func (r *GRPCRouteConfigEntry) GetPartition() string       { return r.Partition }
+func (r *GRPCRouteConfigEntry) GetNamespace() string       { return r.Namespace }
+func (r *GRPCRouteConfigEntry) GetMeta() map[string]string { return r.Meta }
+func (r *GRPCRouteConfigEntry) GetCreateIndex() uint64     { return r.CreateIndex }
+func (r *GRPCRouteConfigEntry) GetModifyIndex() uint64     { return r.ModifyIndex }
+
+// GRPCRouteRule specifies the routing rules used to determine what upstream
+// service a gRPC request is routed to.
+type GRPCRouteRule struct {
+	// Filters is a list of HTTP-based filters used to modify a request prior
+	// to routing it to the upstream service
+	Filters HTTPFilters
+	// ResponseFilters is a list of HTTP-based filters used to modify a response
+	// returned by the upstream service
+	ResponseFilters HTTPResponseFilters
+	// Matches specified the matching criteria used in the routing table. If a
+	// request matches the given GRPCMatch configuration, then traffic is routed
+	// to services specified in the Services field.
+	Matches []GRPCMatch
+	// Services is a list of services to route to if the request matches
+	// the rules specified in the Matches field.
+	Services []HTTPService
+}
+
+// GRPCMatch specifies the criteria that should be
+// used in determining whether or not a request should
+// be routed to a given set of services.
+type GRPCMatch struct {
+	Method  GRPCMethodMatch
+	Headers []HTTPHeaderMatch
+}
+
+// GRPCMethodMatchType specifies how a GRPCMethodMatch is matched
+type GRPCMethodMatchType string
+
+const (
+	GRPCMethodMatchExact             GRPCMethodMatchType = "exact"
+	GRPCMethodMatchRegularExpression GRPCMethodMatchType = "regex"
+)
+
+// GRPCMethodMatch specifies the gRPC service and method a request must be
+// for. Either may be omitted to match any service or method.
+type GRPCMethodMatch struct {
+	Match   GRPCMethodMatchType
+	Service string
+	Method  string
+}
+
+// TLSRouteConfigEntry manages the configuration for a TLS passthrough route
+// with the given name.
+type TLSRouteConfigEntry struct {
+	// Kind of the config entry. This should be set to api.TLSRoute.
+	Kind string
+
+	// Name is used to match the config entry with its associated tls-route.
+	Name string
+
+	// Parents is a list of gateways that this route should be bound to.
+	Parents []ResourceReference
+	// Hostnames are the SNI hostnames for which this TLSRoute should
+	// accept connections.
+	Hostnames []string
+	// Services is a list of TCP-based services that this should route to.
+	// Currently, this must specify at maximum one service.
+	Services []TCPService
+
+	Meta map[string]string `json:",omitempty"`
+
+	// Status is the asynchronous status which a TLSRoute propagates to the user.
+	Status ConfigEntryStatus
+
+	// CreateIndex is the Raft index this entry was created at. This is a
+	// read-only field.
+	CreateIndex uint64
+
+	// ModifyIndex is used for the Check-And-Set operations and can also be fed
+	// back into the WaitIndex of the QueryOptions in order to perform blocking
+	// queries.
+	ModifyIndex uint64
+
+	// Partition is the partition the config entry is associated with.
+	// Partitioning is a Consul Enterprise feature.
+	Partition string `json:",omitempty"`
+
+	// Namespace is the namespace the config entry is associated with.
+	// Namespacing is a Consul Enterprise feature.
+	Namespace string `json:",omitempty"`
+}
+
+func (a *TLSRouteConfigEntry) GetKind() string            { return TLSRoute }
+func (a *TLSRouteConfigEntry) GetName() string            { return a.Name }
+func (a *TLSRouteConfigEntry) GetPartition() string       { return a.Partition }
+func (a *TLSRouteConfigEntry) GetNamespace() string       { return a.Namespace }
+func (a *TLSRouteConfigEntry) GetMeta() map[string]string { return a.Meta }
+func (a *TLSRouteConfigEntry) GetCreateIndex() uint64     { return a.CreateIndex }
+func (a *TLSRouteConfigEntry) GetModifyIndex() uint64     { return a.ModifyIndex }
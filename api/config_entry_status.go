@@ -348,6 +348,75 @@ func ValidateRouteConditionReason(name RouteConditionType, status ConditionStatu
 	return nil
 }
 
+// CertificateConditionType is a type of condition for a certificate that is
+// issued by a controller, such as an acme-certificate.
+type CertificateConditionType string
+
+// CertificateConditionReason is a reason for a certificate condition.
+type CertificateConditionReason string
+
+const (
+	// This condition indicates whether a certificate has been issued for the
+	// config entry.
+	//
+	// Possible reasons for this condition to be True are:
+	//
+	// * "Issued"
+	//
+	// Possible reasons for this condition to be False are:
+	//
+	// * "Pending"
+	// * "IssuanceFailed"
+	CertificateConditionIssued CertificateConditionType = "Issued"
+
+	// This reason is used with the "Issued" condition when the certificate has
+	// been issued and stored on the config entry.
+	CertificateReasonIssued CertificateConditionReason = "Issued"
+
+	// This reason is used with the "Issued" condition while challenges for a
+	// new certificate are being answered.
+	CertificateReasonPending CertificateConditionReason = "Pending"
+
+	// This reason is used with the "Issued" condition when the last attempt to
+	// issue a certificate failed.
+	CertificateReasonIssuanceFailed CertificateConditionReason = "IssuanceFailed"
+)
+
+var validCertificateConditionReasonsMapping = map[CertificateConditionType]map[ConditionStatus][]CertificateConditionReason{
+	CertificateConditionIssued: {
+		ConditionStatusTrue: {
+			CertificateReasonIssued,
+		},
+		ConditionStatusFalse: {
+			CertificateReasonPending,
+			CertificateReasonIssuanceFailed,
+		},
+		ConditionStatusUnknown: {},
+	},
+}
+
+func ValidateCertificateConditionReason(name CertificateConditionType, status ConditionStatus, reason CertificateConditionReason) error {
+	if err := checkConditionStatus(status); err != nil {
+		return err
+	}
+
+	reasons, ok := validCertificateConditionReasonsMapping[name]
+	if !ok {
+		return fmt.Errorf("unrecognized CertificateConditionType %q", name)
+	}
+
+	reasonsForStatus, ok := reasons[status]
+	if !ok {
+		return fmt.Errorf("unrecognized ConditionStatus %q", status)
+	}
+
+	if !slices.Contains(reasonsForStatus, reason) {
+		return fmt.Errorf("certificate condition reason %q not allowed for certificate condition type %q with status %q", reason, name, status)
+	}
+
+	return nil
+}
+
 func checkConditionStatus(status ConditionStatus) error {
 	switch status {
 	case ConditionStatusTrue, ConditionStatusFalse, ConditionStatusUnknown:
@@ -601,6 +601,29 @@ func TestDecodeConfigEntry(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "service-defaults-leaf-cert",
+			body: `
+			{
+				"Kind": "service-defaults",
+				"Name": "web",
+				"LeafCert": {
+					"PrivateKeyType": "rsa",
+					"PrivateKeyBits": 2048,
+					"TTL": "12h"
+				}
+			}
+			`,
+			expect: &ServiceConfigEntry{
+				Kind: "service-defaults",
+				Name: "web",
+				LeafCert: &LeafCertConfig{
+					PrivateKeyType: "rsa",
+					PrivateKeyBits: 2048,
+					TTL:            12 * time.Hour,
+				},
+			},
+		},
 		{
 			name: "service-router: kitchen sink",
 			body: `
@@ -654,7 +677,32 @@ func TestDecodeConfigEntry(t *testing.T) {
 						  "IdleTimeout": "99s",
 						  "NumRetries": 12345,
 						  "RetryOnConnectFailure": true,
-						  "RetryOnStatusCodes": [401, 209]
+						  "RetryOnStatusCodes": [401, 209],
+						  "RetryBaseInterval": "250ms",
+						  "RetryMaxInterval": "2s",
+						  "PerTryTimeout": "3s",
+						  "RetriableHeaders": [
+						    {
+						      "Name": "x-retry",
+						      "Exact": "true"
+						    }
+						  ],
+						  "FaultInjection": {
+						    "Delay": {
+						      "FixedDelay": "2s",
+						      "Percentage": 12.5
+						    },
+						    "Abort": {
+						      "GRPCStatus": 14,
+						      "Percentage": 5
+						    },
+						    "Headers": [
+						      {
+						        "Name": "x-fault",
+						        "Exact": "true"
+						      }
+						    ]
+						  }
 						}
 					},
 					{
@@ -685,6 +733,23 @@ func TestDecodeConfigEntry(t *testing.T) {
 								"PathRegex": "/foo"
 							}
 						}
+					},
+					{
+						"Match": {
+							"GRPC": {
+								"Service": "helloworld.Greeter",
+								"Method": "SayHello",
+								"Metadata": [
+									{
+										"Name": "x-tenant",
+										"Exact": "acme"
+									}
+								]
+							}
+						},
+						"Destination": {
+							"RetryOn": ["unavailable", "cancelled"]
+						}
 					}
 				]
 			}
@@ -740,6 +805,25 @@ func TestDecodeConfigEntry(t *testing.T) {
 							NumRetries:            12345,
 							RetryOnConnectFailure: true,
 							RetryOnStatusCodes:    []uint32{401, 209},
+							RetryBaseInterval:     250 * time.Millisecond,
+							RetryMaxInterval:      2 * time.Second,
+							PerTryTimeout:         3 * time.Second,
+							RetriableHeaders: []ServiceRouteHTTPMatchHeader{
+								{Name: "x-retry", Exact: "true"},
+							},
+							FaultInjection: &ServiceRouteFaultInjection{
+								Delay: &FaultInjectionDelay{
+									FixedDelay: 2 * time.Second,
+									Percentage: 12.5,
+								},
+								Abort: &FaultInjectionAbort{
+									GRPCStatus: 14,
+									Percentage: 5,
+								},
+								Headers: []ServiceRouteHTTPMatchHeader{
+									{Name: "x-fault", Exact: "true"},
+								},
+							},
 						},
 					},
 					{
@@ -771,6 +855,23 @@ func TestDecodeConfigEntry(t *testing.T) {
 							},
 						},
 					},
+					{
+						Match: &ServiceRouteMatch{
+							GRPC: &ServiceRouteGRPCMatch{
+								Service: "helloworld.Greeter",
+								Method:  "SayHello",
+								Metadata: []ServiceRouteHTTPMatchHeader{
+									{
+										Name:  "x-tenant",
+										Exact: "acme",
+									},
+								},
+							},
+						},
+						Destination: &ServiceRouteDestination{
+							RetryOn: []string{"unavailable", "cancelled"},
+						},
+					},
 				},
 			},
 		},
@@ -792,7 +893,12 @@ func TestDecodeConfigEntry(t *testing.T) {
 				  {
 					"Weight": 0.9,
 					"Service": "other",
-					"Namespace": "alt"
+					"Namespace": "alt",
+					"Mirror": {
+						"Service": "shadow",
+						"ServiceSubset": "v2",
+						"Percentage": 50
+					}
 				  }
 				]
 			}
@@ -813,6 +919,11 @@ func TestDecodeConfigEntry(t *testing.T) {
 						Weight:    0.9,
 						Service:   "other",
 						Namespace: "alt",
+						Mirror: &MirrorPolicy{
+							Service:       "shadow",
+							ServiceSubset: "v2",
+							Percentage:    50,
+						},
 					},
 				},
 			},
@@ -906,6 +1017,50 @@ func TestDecodeConfigEntry(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "service-resolver: retry policy",
+			body: `
+			{
+				"Kind": "service-resolver",
+				"Name": "main",
+				"RetryPolicy": {
+					"NumRetries": 3,
+					"RetryOn": ["reset", "5xx"],
+					"RetryBaseInterval": "100ms",
+					"RetryMaxInterval": "1s",
+					"PerTryTimeout": "2s",
+					"RetriableHeaders": [
+						{
+							"Name": "x-retry",
+							"Present": true
+						}
+					],
+					"RetryBudget": {
+						"BudgetPercent": 25.5,
+						"MinRetryConcurrency": 5
+					}
+				}
+			}
+			`,
+			expect: &ServiceResolverConfigEntry{
+				Kind: "service-resolver",
+				Name: "main",
+				RetryPolicy: &ServiceResolverRetryPolicy{
+					NumRetries:        3,
+					RetryOn:           []string{"reset", "5xx"},
+					RetryBaseInterval: 100 * time.Millisecond,
+					RetryMaxInterval:  time.Second,
+					PerTryTimeout:     2 * time.Second,
+					RetriableHeaders: []ServiceRouteHTTPMatchHeader{
+						{Name: "x-retry", Present: true},
+					},
+					RetryBudget: &RetryBudget{
+						BudgetPercent:       25.5,
+						MinRetryConcurrency: 5,
+					},
+				},
+			},
+		},
 		{
 			name: "service-resolver: default",
 			body: `
@@ -1403,6 +1558,126 @@ func TestDecodeConfigEntry(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "grpc-route",
+			body: `
+			{
+				"Kind": "grpc-route",
+				"Name": "greeter",
+				"Parents": [
+					{
+						"Kind": "api-gateway",
+						"Name": "gateway",
+						"SectionName": "grpc"
+					}
+				],
+				"Hostnames": ["greeter.example.com"],
+				"Rules": [
+					{
+						"Matches": [
+							{
+								"Method": {
+									"Match": "exact",
+									"Service": "helloworld.Greeter",
+									"Method": "SayHello"
+								},
+								"Headers": [
+									{
+										"Match": "exact",
+										"Name": "x-env",
+										"Value": "prod"
+									}
+								]
+							}
+						],
+						"Services": [
+							{
+								"Name": "greeter",
+								"Weight": 1
+							}
+						]
+					}
+				]
+			}
+			`,
+			expect: &GRPCRouteConfigEntry{
+				Kind: GRPCRoute,
+				Name: "greeter",
+				Parents: []ResourceReference{
+					{
+						Kind:        APIGateway,
+						Name:        "gateway",
+						SectionName: "grpc",
+					},
+				},
+				Hostnames: []string{"greeter.example.com"},
+				Rules: []GRPCRouteRule{
+					{
+						Matches: []GRPCMatch{
+							{
+								Method: GRPCMethodMatch{
+									Match:   GRPCMethodMatchExact,
+									Service: "helloworld.Greeter",
+									Method:  "SayHello",
+								},
+								Headers: []HTTPHeaderMatch{
+									{
+										Match: HTTPHeaderMatchExact,
+										Name:  "x-env",
+										Value: "prod",
+									},
+								},
+							},
+						},
+						Services: []HTTPService{
+							{
+								Name:   "greeter",
+								Weight: 1,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "tls-route",
+			body: `
+			{
+				"Kind": "tls-route",
+				"Name": "db",
+				"Parents": [
+					{
+						"Kind": "api-gateway",
+						"Name": "gateway",
+						"SectionName": "tls"
+					}
+				],
+				"Hostnames": ["db.example.com", "*.db.example.com"],
+				"Services": [
+					{
+						"Name": "db"
+					}
+				]
+			}
+			`,
+			expect: &TLSRouteConfigEntry{
+				Kind: TLSRoute,
+				Name: "db",
+				Parents: []ResourceReference{
+					{
+						Kind:        APIGateway,
+						Name:        "gateway",
+						SectionName: "tls",
+					},
+				},
+				Hostnames: []string{"db.example.com", "*.db.example.com"},
+				Services: []TCPService{
+					{
+						Name: "db",
+					},
+				},
+			},
+		},
 	} {
 		tc := tc
 
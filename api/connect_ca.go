@@ -80,9 +80,11 @@ func ParseConsulCAConfig(raw map[string]interface{}) (*ConsulCAProviderConfig, e
 
 // CARootList is the structure for the results of listing roots.
 type CARootList struct {
-	ActiveRootID string
-	TrustDomain  string
-	Roots        []*CARoot
+	ActiveRootID    string
+	TrustDomain     string
+	Roots           []*CARoot
+	RevokedCerts    []*CARevokedCert    `json:",omitempty"`
+	RevocationLists []*CARevocationList `json:",omitempty"`
 }
 
 // CARoot represents a root CA certificate that is trusted.
@@ -133,6 +135,96 @@ type LeafCert struct {
 	ModifyIndex uint64
 }
 
+// CAIssuedCert is a leaf certificate in the inventory of the certificates
+// issued by the Connect CA of a datacenter.
+type CAIssuedCert struct {
+	// SerialNumber is the unique serial number for this certificate.
+	// This is encoded in standard hex separated by :.
+	SerialNumber string
+
+	// Service, Agent or Kind is the name of the service, the node or the
+	// kind of gateway the cert was issued for, and the matching URI field
+	// is the SPIFFE ID of the cert. Certs issued to servers only have a
+	// ServerURI.
+	Service    string      `json:",omitempty"`
+	ServiceURI string      `json:",omitempty"`
+	Agent      string      `json:",omitempty"`
+	AgentURI   string      `json:",omitempty"`
+	ServerURI  string      `json:",omitempty"`
+	Kind       ServiceKind `json:",omitempty"`
+	KindURI    string      `json:",omitempty"`
+
+	// Node is the name of the node that requested the cert, if known.
+	Node string `json:",omitempty"`
+
+	// SigningKeyID is the id of the key of the leaf signing cert that issued
+	// the cert. It matches CARoot.SigningKeyID.
+	SigningKeyID string `json:",omitempty"`
+
+	// ValidAfter and ValidBefore are the validity periods for the
+	// certificate.
+	ValidAfter  time.Time
+	ValidBefore time.Time
+
+	Namespace string `json:",omitempty"`
+	Partition string `json:",omitempty"`
+
+	CreateIndex uint64
+	ModifyIndex uint64
+}
+
+// URI returns the SPIFFE ID the cert was issued for.
+func (c *CAIssuedCert) URI() string {
+	for _, uri := range []string{c.ServiceURI, c.AgentURI, c.ServerURI, c.KindURI} {
+		if uri != "" {
+			return uri
+		}
+	}
+	return ""
+}
+
+// CARevokedCert is a leaf certificate, or all the leaf certificates of an
+// identity, that was revoked before it expired.
+type CARevokedCert struct {
+	// SerialNumber is the colon-hex encoded serial number of the revoked
+	// certificate. It is empty when every certificate issued to SpiffeID
+	// before the revocation is revoked.
+	SerialNumber string `json:",omitempty"`
+
+	// SpiffeID is the URI SAN of the revoked certificates.
+	SpiffeID string `json:",omitempty"`
+
+	// Reason is a human readable explanation for the revocation.
+	Reason string `json:",omitempty"`
+
+	// RevokedAt is the time the certificate was revoked, and ExpiresAt the
+	// time after which no certificate it covers can still be valid.
+	RevokedAt time.Time
+	ExpiresAt time.Time
+
+	CreateIndex uint64
+	ModifyIndex uint64
+}
+
+// CARevocationList is a certificate revocation list signed by the leaf
+// signing cert of a datacenter.
+type CARevocationList struct {
+	Datacenter        string
+	SigningKeyID      string `json:",omitempty"`
+	RevocationListPEM string `json:",omitempty"`
+	NumRevoked        int
+	ThisUpdate        time.Time
+	NextUpdate        time.Time
+}
+
+// CARevokeRequest is the request to revoke leaf certificates. Either the
+// SerialNumber of a certificate or the SpiffeID of an identity is required.
+type CARevokeRequest struct {
+	SerialNumber string `json:",omitempty"`
+	SpiffeID     string `json:",omitempty"`
+	Reason       string `json:",omitempty"`
+}
+
 // CARoots queries the list of available roots.
 func (h *Connect) CARoots(q *QueryOptions) (*CARootList, *QueryMeta, error) {
 	r := h.c.newRequest("GET", "/v1/connect/ca/roots")
@@ -157,6 +249,35 @@ func (h *Connect) CARoots(q *QueryOptions) (*CARootList, *QueryMeta, error) {
 	return &out, qm, nil
 }
 
+// CACerts queries the inventory of the unexpired leaf certificates issued in
+// the datacenter, sorted by expiry. When expiringWithin isn't zero, only the
+// certificates that expire within that duration are returned.
+func (h *Connect) CACerts(expiringWithin time.Duration, q *QueryOptions) ([]*CAIssuedCert, *QueryMeta, error) {
+	r := h.c.newRequest("GET", "/v1/connect/ca/certs")
+	r.setQueryOptions(q)
+	if expiringWithin > 0 {
+		r.params.Set("expiring-within", expiringWithin.String())
+	}
+	rtt, resp, err := h.c.doRequest(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer closeResponseBody(resp)
+	if err := requireOK(resp); err != nil {
+		return nil, nil, err
+	}
+
+	qm := &QueryMeta{}
+	parseQueryMeta(resp, qm)
+	qm.RequestTime = rtt
+
+	var out []*CAIssuedCert
+	if err := decodeBody(resp, &out); err != nil {
+		return nil, nil, err
+	}
+	return out, qm, nil
+}
+
 // CAGetConfig returns the current CA configuration.
 func (h *Connect) CAGetConfig(q *QueryOptions) (*CAConfig, *QueryMeta, error) {
 	r := h.c.newRequest("GET", "/v1/connect/ca/configuration")
@@ -199,3 +320,27 @@ func (h *Connect) CASetConfig(conf *CAConfig, q *WriteOptions) (*WriteMeta, erro
 	wm.RequestTime = rtt
 	return wm, nil
 }
+
+// CARevoke revokes the leaf certificates described by req.
+func (h *Connect) CARevoke(req *CARevokeRequest, q *WriteOptions) (*CARevokedCert, *WriteMeta, error) {
+	r := h.c.newRequest("PUT", "/v1/connect/ca/revoke")
+	r.setWriteOptions(q)
+	r.obj = req
+	rtt, resp, err := h.c.doRequest(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer closeResponseBody(resp)
+	if err := requireOK(resp); err != nil {
+		return nil, nil, err
+	}
+
+	wm := &WriteMeta{}
+	wm.RequestTime = rtt
+
+	var out CARevokedCert
+	if err := decodeBody(resp, &out); err != nil {
+		return nil, nil, err
+	}
+	return &out, wm, nil
+}
@@ -59,6 +59,41 @@ func TestAPI_ConnectCARoots_list(t *testing.T) {
 
 }
 
+func TestAPI_ConnectCACerts(t *testing.T) {
+	t.Parallel()
+
+	c, s := makeClient(t)
+	defer s.Stop()
+
+	s.WaitForSerfCheck(t)
+
+	// Have the agent request a leaf cert for a service.
+	var leaf *LeafCert
+	retry.Run(t, func(r *retry.R) {
+		var err error
+		leaf, _, err = c.Agent().ConnectCALeaf("web", nil)
+		r.Check(err)
+	})
+
+	// The server's own certificate is also tracked, so filter to the service.
+	certs, meta, err := c.Connect().CACerts(0, &QueryOptions{Filter: `Service == "web"`})
+	require.NoError(t, err)
+	require.NotZero(t, meta.LastIndex)
+	require.Len(t, certs, 1)
+	require.Equal(t, leaf.SerialNumber, certs[0].SerialNumber)
+	require.Equal(t, leaf.ServiceURI, certs[0].URI())
+	require.NotEmpty(t, certs[0].Node)
+	require.NotEmpty(t, certs[0].SigningKeyID)
+
+	certs, _, err = c.Connect().CACerts(0, &QueryOptions{Filter: `Service == "api"`})
+	require.NoError(t, err)
+	require.Empty(t, certs)
+
+	certs, _, err = c.Connect().CACerts(time.Hour, &QueryOptions{Filter: `Service == "web"`})
+	require.NoError(t, err)
+	require.Empty(t, certs)
+}
+
 func TestAPI_ConnectCAConfig_get_set(t *testing.T) {
 	t.Parallel()
 
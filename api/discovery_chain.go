@@ -169,12 +169,14 @@ type DiscoveryGraphNode struct {
 type DiscoveryRoute struct {
 	Definition *ServiceRoute
 	NextNode   string
+	MirrorNode string `json:",omitempty"`
 }
 
 // compiled form of ServiceSplit
 type DiscoverySplit struct {
-	Weight   float32
-	NextNode string
+	Weight     float32
+	NextNode   string
+	MirrorNode string `json:",omitempty"`
 }
 
 // compiled form of ServiceResolverConfigEntry
@@ -90,6 +90,9 @@ type Peering struct {
 	ModifyIndex uint64
 	// Remote contains metadata for the remote peer.
 	Remote PeeringRemoteInfo
+	// RTT is the most recently measured round trip time to the peer. It is
+	// measured over the peering stream while it is connected.
+	RTT *time.Duration `json:",omitempty"`
 }
 
 type PeeringStreamStatus struct {
@@ -103,6 +106,73 @@ type PeeringStreamStatus struct {
 	LastReceive *time.Time
 	// LastSend represents when any message was last sent, regardless of success or error.
 	LastSend *time.Time
+	// Replication contains detailed information about the replication of resources
+	// over the stream. It is only populated when reading a peering with ReadWithDetail.
+	Replication *PeeringReplicationStatus `json:",omitempty"`
+}
+
+// PeeringReplicationStatus describes the replication of resources over a peering stream.
+type PeeringReplicationStatus struct {
+	// Exported is the status of each resource type replicated to the peer.
+	Exported []PeeringExportedResourceStatus
+	// Imported is the status of each resource type replicated from the peer.
+	Imported []PeeringImportedResourceStatus
+	// ImportedServices is the import status of each service exported by the peer.
+	ImportedServices []PeeringImportedServiceStatus
+	// Errors holds the most recent replication errors, oldest first.
+	Errors []PeeringReplicationError
+}
+
+// PeeringExportedResourceStatus describes the replication of a resource type to the peer.
+type PeeringExportedResourceStatus struct {
+	ResourceURL string
+	// LastSentNonce is the nonce of the last update sent to the peer.
+	LastSentNonce string
+	// LastAckedNonce is the nonce of the last update ACKed by the peer.
+	LastAckedNonce string
+	// PendingUpdates is the number of updates sent to the peer that were not ACKed or NACKed yet.
+	PendingUpdates uint64
+	// LastAck is when the peer last ACKed an update.
+	LastAck *time.Time
+	// LastNack is when the peer last NACKed an update.
+	LastNack *time.Time
+	// LastNackMessage is the error reported with the last NACK.
+	LastNackMessage string `json:",omitempty"`
+}
+
+// PeeringImportedResourceStatus describes the replication of a resource type from the peer.
+type PeeringImportedResourceStatus struct {
+	ResourceURL string
+	// LastNonce is the nonce of the last update received from the peer.
+	LastNonce string
+	// LastApply is when an update was last applied successfully.
+	LastApply *time.Time
+	// LastApplyError is when an update last failed to be applied.
+	LastApplyError *time.Time
+	// LastApplyErrorMessage is the error from the last failed update.
+	LastApplyErrorMessage string `json:",omitempty"`
+}
+
+// PeeringImportedServiceStatus describes the import of a service exported by the peer.
+type PeeringImportedServiceStatus struct {
+	Name string
+	// LastApply is when the service instances were last applied successfully.
+	LastApply *time.Time
+	// LastApplyError is when the service instances last failed to be applied.
+	LastApplyError *time.Time
+	// LastApplyErrorMessage is the error from the last failed update.
+	LastApplyErrorMessage string `json:",omitempty"`
+}
+
+// PeeringReplicationError is an error replicating a resource over a peering stream.
+type PeeringReplicationError struct {
+	Time time.Time
+	// Exported is true for errors reported by the peer when applying resources
+	// replicated to it, and false for errors applying resources replicated from it.
+	Exported    bool
+	ResourceURL string
+	ResourceID  string `json:",omitempty"`
+	Message     string
 }
 
 type PeeringReadResponse struct {
@@ -142,6 +212,16 @@ type PeeringEstablishRequest struct {
 type PeeringEstablishResponse struct {
 }
 
+type PeeringRotateSecretRequest struct {
+	// Name of the peering to rotate the stream secret for.
+	PeerName string
+	// Partition of the peering.
+	Partition string `json:",omitempty"`
+}
+
+type PeeringRotateSecretResponse struct {
+}
+
 type PeeringListRequest struct {
 	// future proofing in case we extend List functionality
 }
@@ -156,6 +236,16 @@ func (c *Client) Peerings() *Peerings {
 }
 
 func (p *Peerings) Read(ctx context.Context, name string, q *QueryOptions) (*Peering, *QueryMeta, error) {
+	return p.read(ctx, name, false, q)
+}
+
+// ReadWithDetail reads a peering along with the detailed status of the
+// resources replicated over its stream in StreamStatus.Replication.
+func (p *Peerings) ReadWithDetail(ctx context.Context, name string, q *QueryOptions) (*Peering, *QueryMeta, error) {
+	return p.read(ctx, name, true, q)
+}
+
+func (p *Peerings) read(ctx context.Context, name string, detail bool, q *QueryOptions) (*Peering, *QueryMeta, error) {
 	if name == "" {
 		return nil, nil, fmt.Errorf("peering name cannot be empty")
 	}
@@ -163,6 +253,9 @@ func (p *Peerings) Read(ctx context.Context, name string, q *QueryOptions) (*Pee
 	req := p.c.newRequest("GET", fmt.Sprintf("/v1/peering/%s", name))
 	req.setQueryOptions(q)
 	req.ctx = ctx
+	if detail {
+		req.params.Set("detail", "")
+	}
 
 	rtt, resp, err := p.c.doRequest(req)
 	if err != nil {
@@ -268,6 +361,35 @@ func (p *Peerings) Establish(ctx context.Context, i PeeringEstablishRequest, wq
 	return &out, wm, nil
 }
 
+// RotateSecret issues a new stream secret for a peering without
+// re-establishing it. It must be called on the cluster that generated the
+// peering token. The peer starts using the new secret when it reconnects, after
+// which the previous secret is no longer accepted.
+func (p *Peerings) RotateSecret(ctx context.Context, i PeeringRotateSecretRequest, wq *WriteOptions) (*PeeringRotateSecretResponse, *WriteMeta, error) {
+	req := p.c.newRequest("POST", "/v1/peering/rotate-secret")
+	req.setWriteOptions(wq)
+	req.ctx = ctx
+	req.obj = i
+
+	rtt, resp, err := p.c.doRequest(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer closeResponseBody(resp)
+	if err := requireOK(resp); err != nil {
+		return nil, nil, err
+	}
+
+	wm := &WriteMeta{RequestTime: rtt}
+
+	var out PeeringRotateSecretResponse
+	if err := decodeBody(resp, &out); err != nil {
+		return nil, nil, err
+	}
+
+	return &out, wm, nil
+}
+
 func (p *Peerings) List(ctx context.Context, q *QueryOptions) ([]*Peering, *QueryMeta, error) {
 	req := p.c.newRequest("GET", "/v1/peerings")
 	req.setQueryOptions(q)
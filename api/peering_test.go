@@ -8,6 +8,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -369,6 +370,48 @@ func TestAPI_Peering_GenerateToken_Read_Establish_Delete(t *testing.T) {
 		})
 	})
 
+	testutil.RunStep(t, "read replication detail", func(t *testing.T) {
+		retry.Run(t, func(r *retry.R) {
+			peering, _, err := c.Peerings().ReadWithDetail(ctx, "peer1", nil)
+			require.NoError(r, err)
+			require.NotNil(r, peering)
+			require.NotNil(r, peering.StreamStatus.Replication)
+
+			var trustBundle *PeeringExportedResourceStatus
+			for i, e := range peering.StreamStatus.Replication.Exported {
+				if strings.HasSuffix(e.ResourceURL, ".PeeringTrustBundle") {
+					trustBundle = &peering.StreamStatus.Replication.Exported[i]
+				}
+			}
+			require.NotNil(r, trustBundle)
+			require.NotEmpty(r, trustBundle.LastAckedNonce)
+			require.NotNil(r, trustBundle.LastAck)
+
+			// Replication detail is only included when requested.
+			peering, _, err = c.Peerings().Read(ctx, "peer1", nil)
+			require.NoError(r, err)
+			require.Nil(r, peering.StreamStatus.Replication)
+		})
+	})
+
+	testutil.RunStep(t, "rotate stream secret", func(t *testing.T) {
+		// Only the cluster that generated the token can rotate the secret.
+		_, _, err := c2.Peerings().RotateSecret(ctx, PeeringRotateSecretRequest{PeerName: "peer1"}, nil)
+		require.Error(t, err)
+
+		_, wm, err := c.Peerings().RotateSecret(ctx, PeeringRotateSecretRequest{PeerName: "peer1"}, nil)
+		require.NoError(t, err)
+		require.NotNil(t, wm)
+
+		// The peering remains active while the dialer reconnects with the rotated secret.
+		retry.Run(t, func(r *retry.R) {
+			peering, _, err := c.Peerings().Read(ctx, "peer1", nil)
+			require.NoError(r, err)
+			require.NotNil(r, peering)
+			require.Equal(r, PeeringStateActive, peering.State)
+		})
+	})
+
 	testutil.RunStep(t, "delete peering at source", func(t *testing.T) {
 		// Delete the token on server 1
 		wm, err := c.Peerings().Delete(ctx, "peer1", nil)
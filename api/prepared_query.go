@@ -7,7 +7,8 @@ package api
 // healthy nodes in the local datacenter.
 type QueryFailoverOptions struct {
 	// NearestN is set to the number of remote datacenters to try, based on
-	// network coordinates.
+	// network coordinates. When combined with Targets, it is also the number
+	// of peers to try first, based on their measured round trip times.
 	NearestN int
 
 	// Datacenters is a fixed list of datacenters to try after NearestN. We
@@ -16,7 +17,7 @@ type QueryFailoverOptions struct {
 	Datacenters []string
 
 	// Targets is a fixed list of datacenters and peers to try. This field cannot
-	// be populated with NearestN or Datacenters.
+	// be populated with Datacenters.
 	Targets []QueryFailoverTarget
 }
 
@@ -298,6 +298,51 @@ func getTemplatedPolicyVariables(variables []string) (*api.ACLTemplatedPolicyVar
 	return out, err
 }
 
+// ParsePolicies parses the rules of the given policies so that they can be
+// merged or linted together.
+func ParsePolicies(policies []api.ACLPolicy) ([]acl.NamedPolicy, error) {
+	parsed := make([]acl.NamedPolicy, 0, len(policies))
+	for _, p := range policies {
+		policy, err := acl.NewPolicyFromSource(p.Rules, nil, nil)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to parse rules of policy %s: %w", p.Name, err)
+		}
+		parsed = append(parsed, acl.NamedPolicy{Name: p.Name, Policy: policy})
+	}
+	return parsed, nil
+}
+
+// GetPolicyLintCatalog returns the names of the services and nodes registered
+// in the catalog, for finding rules that refer to ones that do not exist.
+func GetPolicyLintCatalog(client *api.Client) (*acl.PolicyLintCatalog, error) {
+	services, _, err := client.Catalog().Services(nil)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list services: %w", err)
+	}
+	nodes, _, err := client.Catalog().Nodes(nil)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list nodes: %w", err)
+	}
+
+	catalog := &acl.PolicyLintCatalog{}
+	for name := range services {
+		catalog.Services = append(catalog.Services, name)
+	}
+	for _, node := range nodes {
+		catalog.Nodes = append(catalog.Nodes, node.Node)
+	}
+	return catalog, nil
+}
+
+// FormatPolicyLintFindings formats lint findings for display, one per line.
+func FormatPolicyLintFindings(findings []acl.PolicyLintFinding) string {
+	var b strings.Builder
+	for _, f := range findings {
+		fmt.Fprintf(&b, "%s: %s\n", f.Policy, f.String())
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
 // TestKubernetesJWT_A is a valid service account jwt extracted from a minikube setup.
 //
 //	{
@@ -0,0 +1,209 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package policylint
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/mitchellh/cli"
+
+	consulacl "github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/command/acl"
+	"github.com/hashicorp/consul/command/flags"
+	"github.com/hashicorp/consul/command/helpers"
+)
+
+func New(ui cli.Ui) *cmd {
+	c := &cmd{UI: ui}
+	c.init()
+	return c
+}
+
+type cmd struct {
+	UI    cli.Ui
+	flags *flag.FlagSet
+	http  *flags.HTTPFlags
+	help  string
+
+	policyIDs   []string
+	policyNames []string
+	rules       string
+	catalog     bool
+
+	testStdin io.Reader
+}
+
+func (c *cmd) init() {
+	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+	c.flags.Var((*flags.AppendSliceValue)(&c.policyIDs), "id", "The ID of a policy to lint. "+
+		"It may be specified as a unique ID prefix but will error if the prefix "+
+		"matches multiple policy IDs. May be specified multiple times to lint "+
+		"policies together as they would be when linked to a single token.")
+	c.flags.Var((*flags.AppendSliceValue)(&c.policyNames), "name", "The name of a policy "+
+		"to lint. May be specified multiple times.")
+	c.flags.StringVar(&c.rules, "rules", "", "Policy rules to lint instead of an existing "+
+		"policy. May be prefixed with '@' to indicate that the value is a file path to load "+
+		"the rules from. '-' may also be given to indicate that the rules are available on stdin")
+	c.flags.BoolVar(&c.catalog, "catalog", true, "Report rules for services and nodes "+
+		"that are not registered in the catalog.")
+	c.http = &flags.HTTPFlags{}
+	flags.Merge(c.flags, c.http.ClientFlags())
+	flags.Merge(c.flags, c.http.ServerFlags())
+	flags.Merge(c.flags, c.http.MultiTenancyFlags())
+	c.help = flags.Usage(help, c.flags)
+}
+
+func (c *cmd) Run(args []string) int {
+	if err := c.flags.Parse(args); err != nil {
+		return 1
+	}
+
+	client, err := c.http.APIClient()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error connecting to Consul agent: %s", err))
+		return 1
+	}
+
+	// Each set of policies is linted separately.
+	var sets [][]api.ACLPolicy
+	switch {
+	case c.rules != "":
+		if len(c.policyIDs) > 0 || len(c.policyNames) > 0 {
+			c.UI.Error("Cannot use -rules with -id or -name")
+			return 1
+		}
+		rules, err := helpers.LoadDataSource(c.rules, c.testStdin)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error loading rules: %v", err))
+			return 1
+		}
+		sets = append(sets, []api.ACLPolicy{{Name: "rules", Rules: rules}})
+
+	case len(c.policyIDs) > 0 || len(c.policyNames) > 0:
+		var set []api.ACLPolicy
+		for _, partialID := range c.policyIDs {
+			policyID, err := acl.GetPolicyIDFromPartial(client, partialID)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error determining policy ID: %v", err))
+				return 1
+			}
+			policy, _, err := client.ACL().PolicyRead(policyID, nil)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error reading policy %q: %v", policyID, err))
+				return 1
+			}
+			if policy == nil {
+				c.UI.Error(fmt.Sprintf("Error policy not found: %s", policyID))
+				return 1
+			}
+			set = append(set, *policy)
+		}
+		for _, name := range c.policyNames {
+			policy, err := acl.GetPolicyByName(client, name)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error reading policy %q: %v", name, err))
+				return 1
+			}
+			if policy == nil {
+				c.UI.Error(fmt.Sprintf("Error policy not found: %s", name))
+				return 1
+			}
+			set = append(set, *policy)
+		}
+		sets = append(sets, set)
+
+	default:
+		entries, _, err := client.ACL().PolicyList(nil)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Failed to retrieve the policy list: %v", err))
+			return 1
+		}
+		for _, entry := range entries {
+			if entry.ID == structs.ACLPolicyGlobalManagementID {
+				// grants everything by design
+				continue
+			}
+			policy, _, err := client.ACL().PolicyRead(entry.ID, nil)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error reading policy %q: %v", entry.ID, err))
+				return 1
+			}
+			if policy != nil {
+				sets = append(sets, []api.ACLPolicy{*policy})
+			}
+		}
+	}
+
+	var catalog *consulacl.PolicyLintCatalog
+	if c.catalog {
+		catalog, err = acl.GetPolicyLintCatalog(client)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error reading the catalog: %v", err))
+			return 1
+		}
+	}
+
+	found := false
+	for _, set := range sets {
+		policies, err := acl.ParsePolicies(set)
+		if err != nil {
+			c.UI.Error(err.Error())
+			return 1
+		}
+		findings := consulacl.LintPolicies(policies, catalog)
+		if len(findings) > 0 {
+			found = true
+			c.UI.Info(acl.FormatPolicyLintFindings(findings))
+		}
+	}
+
+	if found {
+		return 2
+	}
+	c.UI.Info("No problems found")
+	return 0
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return flags.Usage(c.help, nil)
+}
+
+const (
+	synopsis = "Lint ACL policies"
+	help     = `
+Usage: consul acl policy lint [options]
+
+    This command analyzes the rules of ACL policies and reports rules
+    that are overridden by or duplicate other rules, rules that have no
+    effect because an enclosing prefix rule grants the same access, prefix
+    rules that grant write access to everything, and rules for services
+    and nodes that are not registered in the catalog.
+
+    When policies are given with -id or -name they are linted together,
+    as they would be when linked to a single token. Otherwise every policy
+    is linted on its own, except for the builtin global-management
+    policy. The command exits with status 2 when problems
+    are found.
+
+    Lint every policy:
+
+        $ consul acl policy lint
+
+    Lint two policies together:
+
+        $ consul acl policy lint -name web-policy -name db-policy
+
+    Lint rules before creating a policy:
+
+        $ consul acl policy lint -rules @rules.hcl
+`
+)
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package policylint
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+func TestPolicyLintCommand_noTabs(t *testing.T) {
+	t.Parallel()
+
+	if strings.ContainsRune(New(cli.NewMockUi()).Help(), '\t') {
+		t.Fatal("help has tabs")
+	}
+}
+
+func TestPolicyLintCommand(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	a := agent.NewTestAgent(t, `
+	primary_datacenter = "dc1"
+	acl {
+		enabled = true
+		tokens {
+			initial_management = "root"
+		}
+	}`)
+
+	defer a.Shutdown()
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1", testrpc.WithToken("root"))
+
+	client := a.Client()
+
+	_, _, err := client.ACL().PolicyCreate(&api.ACLPolicy{
+		Name:  "clean",
+		Rules: `node_prefix "" { policy = "read" }`,
+	}, &api.WriteOptions{Token: "root"})
+	require.NoError(t, err)
+
+	_, _, err = client.ACL().PolicyCreate(&api.ACLPolicy{
+		Name: "messy",
+		Rules: `
+			key_prefix "" { policy = "write" }
+			service "web" { policy = "write" }`,
+	}, &api.WriteOptions{Token: "root"})
+	require.NoError(t, err)
+
+	t.Run("clean policy", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		code := New(ui).Run([]string{
+			"-http-addr=" + a.HTTPAddr(),
+			"-token=root",
+			"-name=clean",
+		})
+		require.Equal(t, 0, code, ui.ErrorWriter.String())
+		require.Contains(t, ui.OutputWriter.String(), "No problems found")
+	})
+
+	t.Run("all policies", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		code := New(ui).Run([]string{
+			"-http-addr=" + a.HTTPAddr(),
+			"-token=root",
+		})
+		require.Equal(t, 2, code, ui.ErrorWriter.String())
+		output := ui.OutputWriter.String()
+		require.Contains(t, output, `messy: key_prefix "": grants write access to every key`)
+		require.Contains(t, output, `messy: service "web": no service with this name is registered in the catalog`)
+		require.NotContains(t, output, "global-management")
+	})
+
+	t.Run("rules without catalog", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		cmd := New(ui)
+		cmd.testStdin = strings.NewReader(`
+			service "web" { policy = "read" }
+			service "web" { policy = "write" }`)
+		code := cmd.Run([]string{
+			"-http-addr=" + a.HTTPAddr(),
+			"-token=root",
+			"-catalog=false",
+			"-rules=-",
+		})
+		require.Equal(t, 2, code, ui.ErrorWriter.String())
+		require.Equal(t, `rules: service "web": is overridden by a "write" rule for the same resource`,
+			strings.TrimSpace(ui.OutputWriter.String()))
+	})
+}
@@ -0,0 +1,409 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package aclsync
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/hcl"
+	"github.com/mitchellh/mapstructure"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/lib/decode"
+)
+
+// definitions are the ACL resources declared in a directory of HCL or JSON
+// files. Blocks of the same type may be spread across files.
+type definitions struct {
+	Policies     []*policyDefinition      `mapstructure:"policy" json:"policy,omitempty"`
+	Roles        []*roleDefinition        `mapstructure:"role" json:"role,omitempty"`
+	AuthMethods  []*authMethodDefinition  `mapstructure:"auth_method" json:"auth_method,omitempty"`
+	BindingRules []*bindingRuleDefinition `mapstructure:"binding_rule" json:"binding_rule,omitempty"`
+}
+
+type policyDefinition struct {
+	Name        string   `mapstructure:"name" json:"name"`
+	Description string   `mapstructure:"description" json:"description,omitempty"`
+	Rules       string   `mapstructure:"rules" json:"rules"`
+	Datacenters []string `mapstructure:"datacenters" json:"datacenters,omitempty"`
+}
+
+type roleDefinition struct {
+	Name              string                       `mapstructure:"name" json:"name"`
+	Description       string                       `mapstructure:"description" json:"description,omitempty"`
+	Policies          []string                     `mapstructure:"policies" json:"policies,omitempty"`
+	ServiceIdentities []*serviceIdentityDefinition `mapstructure:"service_identities" json:"service_identities,omitempty"`
+	NodeIdentities    []*nodeIdentityDefinition    `mapstructure:"node_identities" json:"node_identities,omitempty"`
+	TemplatedPolicies []*templatedPolicyDefinition `mapstructure:"templated_policies" json:"templated_policies,omitempty"`
+}
+
+type serviceIdentityDefinition struct {
+	ServiceName string   `mapstructure:"service_name" json:"service_name"`
+	Datacenters []string `mapstructure:"datacenters" json:"datacenters,omitempty"`
+}
+
+type nodeIdentityDefinition struct {
+	NodeName   string `mapstructure:"node_name" json:"node_name"`
+	Datacenter string `mapstructure:"datacenter" json:"datacenter"`
+}
+
+type templatedPolicyDefinition struct {
+	TemplateName      string                       `mapstructure:"template_name" json:"template_name"`
+	TemplateVariables *templateVariablesDefinition `mapstructure:"template_variables" json:"template_variables,omitempty"`
+	Datacenters       []string                     `mapstructure:"datacenters" json:"datacenters,omitempty"`
+}
+
+type templateVariablesDefinition struct {
+	Name string `mapstructure:"name" json:"name"`
+}
+
+type authMethodDefinition struct {
+	Name          string                 `mapstructure:"name" json:"name"`
+	Type          string                 `mapstructure:"type" json:"type"`
+	DisplayName   string                 `mapstructure:"display_name" json:"display_name,omitempty"`
+	Description   string                 `mapstructure:"description" json:"description,omitempty"`
+	MaxTokenTTL   string                 `mapstructure:"max_token_ttl" json:"max_token_ttl,omitempty"`
+	TokenLocality string                 `mapstructure:"token_locality" json:"token_locality,omitempty"`
+	Config        map[string]interface{} `mapstructure:"config" json:"config,omitempty"`
+}
+
+type bindingRuleDefinition struct {
+	AuthMethod  string                       `mapstructure:"auth_method" json:"auth_method"`
+	Description string                       `mapstructure:"description" json:"description,omitempty"`
+	Selector    string                       `mapstructure:"selector" json:"selector,omitempty"`
+	BindType    string                       `mapstructure:"bind_type" json:"bind_type"`
+	BindName    string                       `mapstructure:"bind_name" json:"bind_name"`
+	BindVars    *templateVariablesDefinition `mapstructure:"bind_vars" json:"bind_vars,omitempty"`
+}
+
+// loadDefinitions reads every .hcl and .json file in dir, in lexical order,
+// and validates the combined definitions.
+func loadDefinitions(dir string) (*definitions, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var defs definitions
+	for _, entry := range entries {
+		ext := filepath.Ext(entry.Name())
+		if entry.IsDir() || (ext != ".hcl" && ext != ".json") {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		file, err := parseDefinitions(data, ext == ".json")
+		if err != nil {
+			return nil, fmt.Errorf("Failed to parse %s: %v", path, err)
+		}
+		defs.Policies = append(defs.Policies, file.Policies...)
+		defs.Roles = append(defs.Roles, file.Roles...)
+		defs.AuthMethods = append(defs.AuthMethods, file.AuthMethods...)
+		defs.BindingRules = append(defs.BindingRules, file.BindingRules...)
+	}
+
+	if err := defs.validate(); err != nil {
+		return nil, err
+	}
+	return &defs, nil
+}
+
+func parseDefinitions(data []byte, isJSON bool) (*definitions, error) {
+	var raw map[string]interface{}
+	if isJSON {
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return nil, err
+		}
+	} else if err := hcl.Decode(&raw, string(data)); err != nil {
+		return nil, err
+	}
+
+	var defs definitions
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook:       decode.HookWeakDecodeFromSlice,
+		ErrorUnused:      true,
+		WeaklyTypedInput: true,
+		Result:           &defs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := decoder.Decode(raw); err != nil {
+		return nil, err
+	}
+	return &defs, nil
+}
+
+func (d *definitions) validate() error {
+	policies := make(map[string]struct{})
+	for _, p := range d.Policies {
+		if p.Name == "" {
+			return fmt.Errorf("Every policy must have a name")
+		}
+		if _, ok := policies[p.Name]; ok {
+			return fmt.Errorf("Policy %q is defined more than once", p.Name)
+		}
+		policies[p.Name] = struct{}{}
+	}
+
+	roles := make(map[string]struct{})
+	for _, r := range d.Roles {
+		if r.Name == "" {
+			return fmt.Errorf("Every role must have a name")
+		}
+		if _, ok := roles[r.Name]; ok {
+			return fmt.Errorf("Role %q is defined more than once", r.Name)
+		}
+		roles[r.Name] = struct{}{}
+	}
+
+	methods := make(map[string]struct{})
+	for _, m := range d.AuthMethods {
+		if m.Name == "" {
+			return fmt.Errorf("Every auth method must have a name")
+		}
+		if _, ok := methods[m.Name]; ok {
+			return fmt.Errorf("Auth method %q is defined more than once", m.Name)
+		}
+		if m.MaxTokenTTL != "" {
+			if _, err := time.ParseDuration(m.MaxTokenTTL); err != nil {
+				return fmt.Errorf("Auth method %q has an invalid max_token_ttl: %v", m.Name, err)
+			}
+		}
+		methods[m.Name] = struct{}{}
+	}
+
+	rules := make(map[string]struct{})
+	for _, b := range d.BindingRules {
+		if b.AuthMethod == "" || b.BindType == "" || b.BindName == "" {
+			return fmt.Errorf("Every binding rule must have an auth_method, bind_type and bind_name")
+		}
+		key := b.key()
+		if _, ok := rules[key]; ok {
+			return fmt.Errorf("Binding rule %s is defined more than once", b.display())
+		}
+		rules[key] = struct{}{}
+	}
+	return nil
+}
+
+// key identifies a binding rule, which has no name. A binding rule whose
+// key changes is replaced rather than updated.
+func (b *bindingRuleDefinition) key() string {
+	return bindingRuleKey(b.AuthMethod, b.BindType, b.BindName, b.Selector)
+}
+
+func (b *bindingRuleDefinition) display() string {
+	return displayBindingRule(b.AuthMethod, b.BindType, b.BindName, b.Selector)
+}
+
+func bindingRuleKey(method, bindType, bindName, selector string) string {
+	return strings.Join([]string{method, bindType, bindName, selector}, "\x00")
+}
+
+func displayBindingRule(method, bindType, bindName, selector string) string {
+	s := fmt.Sprintf("%s/%s:%s", method, bindType, bindName)
+	if selector != "" {
+		s += fmt.Sprintf(" (%s)", selector)
+	}
+	return s
+}
+
+func (p *policyDefinition) toAPI() *api.ACLPolicy {
+	return &api.ACLPolicy{
+		Name:        p.Name,
+		Description: p.Description,
+		Rules:       p.Rules,
+		Datacenters: p.Datacenters,
+	}
+}
+
+func policyFromAPI(p *api.ACLPolicy) *policyDefinition {
+	return &policyDefinition{
+		Name:        p.Name,
+		Description: p.Description,
+		Rules:       p.Rules,
+		Datacenters: p.Datacenters,
+	}
+}
+
+func (r *roleDefinition) toAPI() *api.ACLRole {
+	role := &api.ACLRole{
+		Name:        r.Name,
+		Description: r.Description,
+	}
+	for _, name := range r.Policies {
+		role.Policies = append(role.Policies, &api.ACLRolePolicyLink{Name: name})
+	}
+	for _, s := range r.ServiceIdentities {
+		role.ServiceIdentities = append(role.ServiceIdentities, &api.ACLServiceIdentity{
+			ServiceName: s.ServiceName,
+			Datacenters: s.Datacenters,
+		})
+	}
+	for _, n := range r.NodeIdentities {
+		role.NodeIdentities = append(role.NodeIdentities, &api.ACLNodeIdentity{
+			NodeName:   n.NodeName,
+			Datacenter: n.Datacenter,
+		})
+	}
+	for _, t := range r.TemplatedPolicies {
+		tp := &api.ACLTemplatedPolicy{
+			TemplateName: t.TemplateName,
+			Datacenters:  t.Datacenters,
+		}
+		if t.TemplateVariables != nil {
+			tp.TemplateVariables = &api.ACLTemplatedPolicyVariables{Name: t.TemplateVariables.Name}
+		}
+		role.TemplatedPolicies = append(role.TemplatedPolicies, tp)
+	}
+	return role
+}
+
+func roleFromAPI(r *api.ACLRole) *roleDefinition {
+	role := &roleDefinition{
+		Name:        r.Name,
+		Description: r.Description,
+	}
+	for _, link := range r.Policies {
+		role.Policies = append(role.Policies, link.Name)
+	}
+	for _, s := range r.ServiceIdentities {
+		role.ServiceIdentities = append(role.ServiceIdentities, &serviceIdentityDefinition{
+			ServiceName: s.ServiceName,
+			Datacenters: s.Datacenters,
+		})
+	}
+	for _, n := range r.NodeIdentities {
+		role.NodeIdentities = append(role.NodeIdentities, &nodeIdentityDefinition{
+			NodeName:   n.NodeName,
+			Datacenter: n.Datacenter,
+		})
+	}
+	for _, t := range r.TemplatedPolicies {
+		tp := &templatedPolicyDefinition{
+			TemplateName: t.TemplateName,
+			Datacenters:  t.Datacenters,
+		}
+		if t.TemplateVariables != nil {
+			tp.TemplateVariables = &templateVariablesDefinition{Name: t.TemplateVariables.Name}
+		}
+		role.TemplatedPolicies = append(role.TemplatedPolicies, tp)
+	}
+	return role
+}
+
+func (m *authMethodDefinition) toAPI() *api.ACLAuthMethod {
+	// The TTL was validated when the definitions were loaded.
+	ttl, _ := time.ParseDuration(m.MaxTokenTTL)
+	return &api.ACLAuthMethod{
+		Name:          m.Name,
+		Type:          m.Type,
+		DisplayName:   m.DisplayName,
+		Description:   m.Description,
+		MaxTokenTTL:   ttl,
+		TokenLocality: m.TokenLocality,
+		Config:        m.Config,
+	}
+}
+
+func authMethodFromAPI(m *api.ACLAuthMethod) *authMethodDefinition {
+	method := &authMethodDefinition{
+		Name:          m.Name,
+		Type:          m.Type,
+		DisplayName:   m.DisplayName,
+		Description:   m.Description,
+		TokenLocality: m.TokenLocality,
+		Config:        m.Config,
+	}
+	if m.MaxTokenTTL != 0 {
+		method.MaxTokenTTL = m.MaxTokenTTL.String()
+	}
+	return method
+}
+
+func (b *bindingRuleDefinition) toAPI() *api.ACLBindingRule {
+	rule := &api.ACLBindingRule{
+		AuthMethod:  b.AuthMethod,
+		Description: b.Description,
+		Selector:    b.Selector,
+		BindType:    api.BindingRuleBindType(b.BindType),
+		BindName:    b.BindName,
+	}
+	if b.BindVars != nil {
+		rule.BindVars = &api.ACLTemplatedPolicyVariables{Name: b.BindVars.Name}
+	}
+	return rule
+}
+
+func bindingRuleFromAPI(b *api.ACLBindingRule) *bindingRuleDefinition {
+	rule := &bindingRuleDefinition{
+		AuthMethod:  b.AuthMethod,
+		Description: b.Description,
+		Selector:    b.Selector,
+		BindType:    string(b.BindType),
+		BindName:    b.BindName,
+	}
+	if b.BindVars != nil {
+		rule.BindVars = &templateVariablesDefinition{Name: b.BindVars.Name}
+	}
+	return rule
+}
+
+// writeDefinitions writes one JSON file per resource type to dir. Existing
+// files are never overwritten.
+func writeDefinitions(dir string, defs *definitions) ([]string, error) {
+	sort.Slice(defs.Policies, func(i, j int) bool { return defs.Policies[i].Name < defs.Policies[j].Name })
+	sort.Slice(defs.Roles, func(i, j int) bool { return defs.Roles[i].Name < defs.Roles[j].Name })
+	sort.Slice(defs.AuthMethods, func(i, j int) bool { return defs.AuthMethods[i].Name < defs.AuthMethods[j].Name })
+	sort.Slice(defs.BindingRules, func(i, j int) bool { return defs.BindingRules[i].key() < defs.BindingRules[j].key() })
+
+	files := []struct {
+		name  string
+		empty bool
+		defs  definitions
+	}{
+		{"policies.json", len(defs.Policies) == 0, definitions{Policies: defs.Policies}},
+		{"roles.json", len(defs.Roles) == 0, definitions{Roles: defs.Roles}},
+		{"auth-methods.json", len(defs.AuthMethods) == 0, definitions{AuthMethods: defs.AuthMethods}},
+		{"binding-rules.json", len(defs.BindingRules) == 0, definitions{BindingRules: defs.BindingRules}},
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	var written []string
+	for _, f := range files {
+		if f.empty {
+			continue
+		}
+		data, err := json.MarshalIndent(f.defs, "", "  ")
+		if err != nil {
+			return written, err
+		}
+		path := filepath.Join(dir, f.name)
+		file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+		if err != nil {
+			return written, err
+		}
+		_, err = file.Write(append(data, '\n'))
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return written, err
+		}
+		written = append(written, path)
+	}
+	return written, nil
+}
@@ -0,0 +1,498 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package aclsync
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+)
+
+type action string
+
+const (
+	actionCreate action = "create"
+	actionUpdate action = "update"
+	actionDelete action = "delete"
+)
+
+// change is a single step of a plan.
+type change struct {
+	action   action
+	resource string
+	name     string
+	// fields lists the definition fields that differ for an update.
+	fields []string
+	apply  func(client *api.ACL) error
+}
+
+func (c *change) String() string {
+	var symbol string
+	switch c.action {
+	case actionCreate:
+		symbol = "+"
+	case actionUpdate:
+		symbol = "~"
+	case actionDelete:
+		symbol = "-"
+	}
+	s := fmt.Sprintf("%s %s %s %q", symbol, c.action, c.resource, c.name)
+	if len(c.fields) > 0 {
+		s += fmt.Sprintf(" (%s)", strings.Join(c.fields, ", "))
+	}
+	return s
+}
+
+// buildPlan compares the definitions with the ACL resources in the cluster
+// and returns the changes needed to make the cluster match. Creates and
+// updates are ordered so that resources exist before they are referenced,
+// and deletes are ordered the other way around. Resources that are not
+// defined are only deleted when prune is true.
+func buildPlan(client *api.ACL, defs *definitions, prune bool) ([]*change, error) {
+	var upserts, deletes []*change
+
+	policyChanges, policyDeletes, err := planPolicies(client, defs.Policies, prune)
+	if err != nil {
+		return nil, err
+	}
+	roleChanges, roleDeletes, err := planRoles(client, defs.Roles, prune)
+	if err != nil {
+		return nil, err
+	}
+	methodChanges, methodDeletes, err := planAuthMethods(client, defs.AuthMethods, prune)
+	if err != nil {
+		return nil, err
+	}
+	ruleChanges, ruleDeletes, err := planBindingRules(client, defs.BindingRules, prune)
+	if err != nil {
+		return nil, err
+	}
+
+	upserts = append(upserts, policyChanges...)
+	upserts = append(upserts, roleChanges...)
+	upserts = append(upserts, methodChanges...)
+	upserts = append(upserts, ruleChanges...)
+
+	deletes = append(deletes, ruleDeletes...)
+	deletes = append(deletes, methodDeletes...)
+	deletes = append(deletes, roleDeletes...)
+	deletes = append(deletes, policyDeletes...)
+
+	return append(upserts, deletes...), nil
+}
+
+func planPolicies(client *api.ACL, defs []*policyDefinition, prune bool) ([]*change, []*change, error) {
+	entries, _, err := client.PolicyList(nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Failed to list policies: %v", err)
+	}
+	existing := make(map[string]*api.ACLPolicyListEntry)
+	for _, entry := range entries {
+		existing[entry.Name] = entry
+	}
+
+	var upserts, deletes []*change
+	defined := make(map[string]struct{})
+	for _, def := range sortedPolicies(defs) {
+		def := def
+		defined[def.Name] = struct{}{}
+
+		entry, ok := existing[def.Name]
+		if !ok {
+			upserts = append(upserts, &change{
+				action:   actionCreate,
+				resource: "policy",
+				name:     def.Name,
+				apply: func(client *api.ACL) error {
+					_, _, err := client.PolicyCreate(def.toAPI(), nil)
+					return err
+				},
+			})
+			continue
+		}
+
+		current, _, err := client.PolicyRead(entry.ID, nil)
+		if err != nil {
+			return nil, nil, fmt.Errorf("Failed to read policy %q: %v", def.Name, err)
+		}
+		if fields := diffFields(policyFromAPI(current).normalize(), def.normalize()); len(fields) > 0 {
+			id := entry.ID
+			upserts = append(upserts, &change{
+				action:   actionUpdate,
+				resource: "policy",
+				name:     def.Name,
+				fields:   fields,
+				apply: func(client *api.ACL) error {
+					policy := def.toAPI()
+					policy.ID = id
+					_, _, err := client.PolicyUpdate(policy, nil)
+					return err
+				},
+			})
+		}
+	}
+
+	if prune {
+		for _, entry := range entries {
+			if _, ok := defined[entry.Name]; ok {
+				continue
+			}
+			if entry.ID == structs.ACLPolicyGlobalManagementID || entry.ID == structs.ACLPolicyGlobalReadOnlyID {
+				// builtin policies cannot be deleted
+				continue
+			}
+			id := entry.ID
+			deletes = append(deletes, &change{
+				action:   actionDelete,
+				resource: "policy",
+				name:     entry.Name,
+				apply: func(client *api.ACL) error {
+					_, err := client.PolicyDelete(id, nil)
+					return err
+				},
+			})
+		}
+	}
+	return upserts, sortChanges(deletes), nil
+}
+
+func planRoles(client *api.ACL, defs []*roleDefinition, prune bool) ([]*change, []*change, error) {
+	roles, _, err := client.RoleList(nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Failed to list roles: %v", err)
+	}
+	existing := make(map[string]*api.ACLRole)
+	for _, role := range roles {
+		existing[role.Name] = role
+	}
+
+	var upserts, deletes []*change
+	defined := make(map[string]struct{})
+	for _, def := range sortedRoles(defs) {
+		def := def
+		defined[def.Name] = struct{}{}
+
+		current, ok := existing[def.Name]
+		if !ok {
+			upserts = append(upserts, &change{
+				action:   actionCreate,
+				resource: "role",
+				name:     def.Name,
+				apply: func(client *api.ACL) error {
+					_, _, err := client.RoleCreate(def.toAPI(), nil)
+					return err
+				},
+			})
+			continue
+		}
+
+		if fields := diffFields(roleFromAPI(current).normalize(), def.normalize()); len(fields) > 0 {
+			id := current.ID
+			upserts = append(upserts, &change{
+				action:   actionUpdate,
+				resource: "role",
+				name:     def.Name,
+				fields:   fields,
+				apply: func(client *api.ACL) error {
+					role := def.toAPI()
+					role.ID = id
+					_, _, err := client.RoleUpdate(role, nil)
+					return err
+				},
+			})
+		}
+	}
+
+	if prune {
+		for _, role := range roles {
+			if _, ok := defined[role.Name]; ok {
+				continue
+			}
+			id := role.ID
+			deletes = append(deletes, &change{
+				action:   actionDelete,
+				resource: "role",
+				name:     role.Name,
+				apply: func(client *api.ACL) error {
+					_, err := client.RoleDelete(id, nil)
+					return err
+				},
+			})
+		}
+	}
+	return upserts, sortChanges(deletes), nil
+}
+
+func planAuthMethods(client *api.ACL, defs []*authMethodDefinition, prune bool) ([]*change, []*change, error) {
+	entries, _, err := client.AuthMethodList(nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Failed to list auth methods: %v", err)
+	}
+	existing := make(map[string]struct{})
+	for _, entry := range entries {
+		existing[entry.Name] = struct{}{}
+	}
+
+	var upserts, deletes []*change
+	defined := make(map[string]struct{})
+	for _, def := range sortedAuthMethods(defs) {
+		def := def
+		defined[def.Name] = struct{}{}
+
+		if _, ok := existing[def.Name]; !ok {
+			upserts = append(upserts, &change{
+				action:   actionCreate,
+				resource: "auth-method",
+				name:     def.Name,
+				apply: func(client *api.ACL) error {
+					_, _, err := client.AuthMethodCreate(def.toAPI(), nil)
+					return err
+				},
+			})
+			continue
+		}
+
+		current, _, err := client.AuthMethodRead(def.Name, nil)
+		if err != nil {
+			return nil, nil, fmt.Errorf("Failed to read auth method %q: %v", def.Name, err)
+		}
+		if fields := diffFields(authMethodFromAPI(current).normalize(), def.normalize()); len(fields) > 0 {
+			upserts = append(upserts, &change{
+				action:   actionUpdate,
+				resource: "auth-method",
+				name:     def.Name,
+				fields:   fields,
+				apply: func(client *api.ACL) error {
+					_, _, err := client.AuthMethodUpdate(def.toAPI(), nil)
+					return err
+				},
+			})
+		}
+	}
+
+	if prune {
+		for _, entry := range entries {
+			if _, ok := defined[entry.Name]; ok {
+				continue
+			}
+			name := entry.Name
+			deletes = append(deletes, &change{
+				action:   actionDelete,
+				resource: "auth-method",
+				name:     name,
+				apply: func(client *api.ACL) error {
+					_, err := client.AuthMethodDelete(name, nil)
+					return err
+				},
+			})
+		}
+	}
+	return upserts, sortChanges(deletes), nil
+}
+
+func planBindingRules(client *api.ACL, defs []*bindingRuleDefinition, prune bool) ([]*change, []*change, error) {
+	rules, _, err := client.BindingRuleList("", nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Failed to list binding rules: %v", err)
+	}
+	// Identical rules may exist more than once, only the first is matched
+	// with a definition.
+	existing := make(map[string]*api.ACLBindingRule)
+	for _, rule := range rules {
+		key := bindingRuleKey(rule.AuthMethod, string(rule.BindType), rule.BindName, rule.Selector)
+		if _, ok := existing[key]; !ok {
+			existing[key] = rule
+		}
+	}
+
+	var upserts, deletes []*change
+	matched := make(map[string]struct{})
+	for _, def := range sortedBindingRules(defs) {
+		def := def
+
+		current, ok := existing[def.key()]
+		if !ok {
+			upserts = append(upserts, &change{
+				action:   actionCreate,
+				resource: "binding-rule",
+				name:     def.display(),
+				apply: func(client *api.ACL) error {
+					_, _, err := client.BindingRuleCreate(def.toAPI(), nil)
+					return err
+				},
+			})
+			continue
+		}
+		matched[current.ID] = struct{}{}
+
+		if fields := diffFields(bindingRuleFromAPI(current), def); len(fields) > 0 {
+			id := current.ID
+			upserts = append(upserts, &change{
+				action:   actionUpdate,
+				resource: "binding-rule",
+				name:     def.display(),
+				fields:   fields,
+				apply: func(client *api.ACL) error {
+					rule := def.toAPI()
+					rule.ID = id
+					_, _, err := client.BindingRuleUpdate(rule, nil)
+					return err
+				},
+			})
+		}
+	}
+
+	if prune {
+		for _, rule := range rules {
+			if _, ok := matched[rule.ID]; ok {
+				continue
+			}
+			id := rule.ID
+			deletes = append(deletes, &change{
+				action:   actionDelete,
+				resource: "binding-rule",
+				name:     displayBindingRule(rule.AuthMethod, string(rule.BindType), rule.BindName, rule.Selector),
+				apply: func(client *api.ACL) error {
+					_, err := client.BindingRuleDelete(id, nil)
+					return err
+				},
+			})
+		}
+	}
+	return upserts, sortChanges(deletes), nil
+}
+
+// diffFields returns the names of the top level JSON fields that differ
+// between two definitions.
+func diffFields(current, desired interface{}) []string {
+	a, b := jsonFields(current), jsonFields(desired)
+	var fields []string
+	for name, value := range b {
+		if !bytes.Equal(a[name], value) {
+			fields = append(fields, name)
+		}
+	}
+	for name := range a {
+		if _, ok := b[name]; !ok {
+			fields = append(fields, name)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+func jsonFields(v interface{}) map[string]json.RawMessage {
+	// Definitions only contain values that can be encoded.
+	data, _ := json.Marshal(v)
+	var fields map[string]json.RawMessage
+	_ = json.Unmarshal(data, &fields)
+	return fields
+}
+
+func (p *policyDefinition) normalize() *policyDefinition {
+	out := *p
+	out.Rules = strings.TrimSpace(p.Rules)
+	out.Datacenters = sortedStrings(p.Datacenters)
+	return &out
+}
+
+func (r *roleDefinition) normalize() *roleDefinition {
+	out := *r
+	out.Policies = sortedStrings(r.Policies)
+
+	out.ServiceIdentities = make([]*serviceIdentityDefinition, 0, len(r.ServiceIdentities))
+	for _, s := range r.ServiceIdentities {
+		out.ServiceIdentities = append(out.ServiceIdentities, &serviceIdentityDefinition{
+			ServiceName: s.ServiceName,
+			Datacenters: sortedStrings(s.Datacenters),
+		})
+	}
+	sort.Slice(out.ServiceIdentities, func(i, j int) bool {
+		return out.ServiceIdentities[i].ServiceName < out.ServiceIdentities[j].ServiceName
+	})
+
+	out.NodeIdentities = append([]*nodeIdentityDefinition(nil), r.NodeIdentities...)
+	sort.Slice(out.NodeIdentities, func(i, j int) bool {
+		a, b := out.NodeIdentities[i], out.NodeIdentities[j]
+		if a.NodeName != b.NodeName {
+			return a.NodeName < b.NodeName
+		}
+		return a.Datacenter < b.Datacenter
+	})
+
+	out.TemplatedPolicies = make([]*templatedPolicyDefinition, 0, len(r.TemplatedPolicies))
+	for _, t := range r.TemplatedPolicies {
+		tp := *t
+		tp.Datacenters = sortedStrings(t.Datacenters)
+		out.TemplatedPolicies = append(out.TemplatedPolicies, &tp)
+	}
+	sort.Slice(out.TemplatedPolicies, func(i, j int) bool {
+		return templatedPolicyKey(out.TemplatedPolicies[i]) < templatedPolicyKey(out.TemplatedPolicies[j])
+	})
+	return &out
+}
+
+func templatedPolicyKey(t *templatedPolicyDefinition) string {
+	if t.TemplateVariables == nil {
+		return t.TemplateName
+	}
+	return t.TemplateName + "\x00" + t.TemplateVariables.Name
+}
+
+func (m *authMethodDefinition) normalize() *authMethodDefinition {
+	out := *m
+	if ttl, err := time.ParseDuration(m.MaxTokenTTL); err == nil && ttl != 0 {
+		out.MaxTokenTTL = ttl.String()
+	} else {
+		out.MaxTokenTTL = ""
+	}
+	if out.TokenLocality == "" {
+		out.TokenLocality = "local"
+	}
+	return &out
+}
+
+func sortedStrings(in []string) []string {
+	if len(in) == 0 {
+		return nil
+	}
+	out := append([]string(nil), in...)
+	sort.Strings(out)
+	return out
+}
+
+func sortChanges(changes []*change) []*change {
+	sort.SliceStable(changes, func(i, j int) bool { return changes[i].name < changes[j].name })
+	return changes
+}
+
+func sortedPolicies(defs []*policyDefinition) []*policyDefinition {
+	out := append([]*policyDefinition(nil), defs...)
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+func sortedRoles(defs []*roleDefinition) []*roleDefinition {
+	out := append([]*roleDefinition(nil), defs...)
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+func sortedAuthMethods(defs []*authMethodDefinition) []*authMethodDefinition {
+	out := append([]*authMethodDefinition(nil), defs...)
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+func sortedBindingRules(defs []*bindingRuleDefinition) []*bindingRuleDefinition {
+	out := append([]*bindingRuleDefinition(nil), defs...)
+	sort.Slice(out, func(i, j int) bool { return out[i].key() < out[j].key() })
+	return out
+}
@@ -0,0 +1,228 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package aclsync
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/mitchellh/cli"
+
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/command/flags"
+)
+
+func New(ui cli.Ui) *cmd {
+	c := &cmd{UI: ui}
+	c.init()
+	return c
+}
+
+type cmd struct {
+	UI    cli.Ui
+	flags *flag.FlagSet
+	http  *flags.HTTPFlags
+	help  string
+
+	dir    string
+	prune  bool
+	dryRun bool
+	export bool
+}
+
+func (c *cmd) init() {
+	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+	c.flags.StringVar(&c.dir, "dir", "", "The directory containing the HCL or JSON "+
+		"definitions of policies, roles, auth methods and binding rules. This is required.")
+	c.flags.BoolVar(&c.prune, "prune", false, "Delete policies, roles, auth methods and "+
+		"binding rules that are not defined in the directory. The builtin policies are "+
+		"never deleted.")
+	c.flags.BoolVar(&c.dryRun, "dry-run", false, "Print the plan without applying it. "+
+		"The command exits with status 2 when the cluster does not match the definitions.")
+	c.flags.BoolVar(&c.export, "export", false, "Write the ACL resources in the cluster to "+
+		"JSON definition files in the directory instead of syncing. Existing files are not "+
+		"overwritten.")
+	c.http = &flags.HTTPFlags{}
+	flags.Merge(c.flags, c.http.ClientFlags())
+	flags.Merge(c.flags, c.http.ServerFlags())
+	flags.Merge(c.flags, c.http.MultiTenancyFlags())
+	c.help = flags.Usage(help, c.flags)
+}
+
+func (c *cmd) Run(args []string) int {
+	if err := c.flags.Parse(args); err != nil {
+		return 1
+	}
+
+	if c.dir == "" {
+		c.UI.Error("Must specify the -dir parameter")
+		return 1
+	}
+	if c.export && (c.prune || c.dryRun) {
+		c.UI.Error("Cannot use -export with -prune or -dry-run")
+		return 1
+	}
+
+	client, err := c.http.APIClient()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error connecting to Consul agent: %s", err))
+		return 1
+	}
+
+	if c.export {
+		return c.runExport(client.ACL())
+	}
+
+	defs, err := loadDefinitions(c.dir)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error loading definitions: %v", err))
+		return 1
+	}
+
+	changes, err := buildPlan(client.ACL(), defs, c.prune)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error building plan: %v", err))
+		return 1
+	}
+
+	if len(changes) == 0 {
+		c.UI.Info("No changes. The ACL resources match the definitions.")
+		return 0
+	}
+
+	counts := make(map[action]int)
+	for _, change := range changes {
+		counts[change.action]++
+		c.UI.Info(change.String())
+	}
+	c.UI.Info(fmt.Sprintf("\nPlan: %d to create, %d to update, %d to delete.",
+		counts[actionCreate], counts[actionUpdate], counts[actionDelete]))
+
+	if c.dryRun {
+		return 2
+	}
+
+	for _, change := range changes {
+		if err := change.apply(client.ACL()); err != nil {
+			c.UI.Error(fmt.Sprintf("Failed to %s %s %q: %v", change.action, change.resource, change.name, err))
+			return 1
+		}
+	}
+	c.UI.Info(fmt.Sprintf("Applied %d changes.", len(changes)))
+	return 0
+}
+
+func (c *cmd) runExport(client *api.ACL) int {
+	var defs definitions
+
+	policies, _, err := client.PolicyList(nil)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Failed to list policies: %v", err))
+		return 1
+	}
+	for _, entry := range policies {
+		if entry.ID == structs.ACLPolicyGlobalManagementID || entry.ID == structs.ACLPolicyGlobalReadOnlyID {
+			continue
+		}
+		policy, _, err := client.PolicyRead(entry.ID, nil)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Failed to read policy %q: %v", entry.Name, err))
+			return 1
+		}
+		defs.Policies = append(defs.Policies, policyFromAPI(policy))
+	}
+
+	roles, _, err := client.RoleList(nil)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Failed to list roles: %v", err))
+		return 1
+	}
+	for _, role := range roles {
+		defs.Roles = append(defs.Roles, roleFromAPI(role))
+	}
+
+	methods, _, err := client.AuthMethodList(nil)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Failed to list auth methods: %v", err))
+		return 1
+	}
+	for _, entry := range methods {
+		method, _, err := client.AuthMethodRead(entry.Name, nil)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Failed to read auth method %q: %v", entry.Name, err))
+			return 1
+		}
+		defs.AuthMethods = append(defs.AuthMethods, authMethodFromAPI(method))
+	}
+
+	rules, _, err := client.BindingRuleList("", nil)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Failed to list binding rules: %v", err))
+		return 1
+	}
+	for _, rule := range rules {
+		defs.BindingRules = append(defs.BindingRules, bindingRuleFromAPI(rule))
+	}
+
+	written, err := writeDefinitions(c.dir, &defs)
+	for _, path := range written {
+		c.UI.Info(fmt.Sprintf("Wrote %s", path))
+	}
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error writing definitions: %v", err))
+		return 1
+	}
+	return 0
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return flags.Usage(c.help, nil)
+}
+
+const (
+	synopsis = "Sync ACL resources with definitions in a directory"
+	help     = `
+Usage: consul acl sync -dir=<path> [options]
+
+  This command reads the definitions of ACL policies, roles, auth methods
+  and binding rules from the .hcl and .json files in a directory, compares
+  them with the cluster, prints the changes needed for the cluster to match
+  and then applies them. Resources that are not defined are left alone
+  unless -prune is given.
+
+  Policies, roles and auth methods are matched by name. Binding rules are
+  matched by their auth method, bind type, bind name and selector.
+
+  Show the plan without applying it:
+
+          $ consul acl sync -dir=./acl -dry-run
+
+  Apply the definitions and delete anything not defined:
+
+          $ consul acl sync -dir=./acl -prune
+
+  Export the current ACL resources as definitions:
+
+          $ consul acl sync -dir=./acl -export
+
+  An example definition file:
+
+          policy {
+            name  = "web"
+            rules = <<EOF
+          service "web" { policy = "write" }
+          EOF
+          }
+
+          role {
+            name     = "web"
+            policies = ["web"]
+          }
+`
+)
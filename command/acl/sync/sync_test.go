@@ -0,0 +1,325 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package aclsync
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent"
+	_ "github.com/hashicorp/consul/agent/consul/authmethod/testauth"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+func TestSyncCommand_noTabs(t *testing.T) {
+	t.Parallel()
+
+	if strings.ContainsRune(New(cli.NewMockUi()).Help(), '\t') {
+		t.Fatal("help has tabs")
+	}
+}
+
+func TestParseDefinitions(t *testing.T) {
+	t.Parallel()
+
+	hclDefs := `
+policy {
+  name        = "web"
+  description = "web service"
+  rules       = <<EOF
+service "web" { policy = "write" }
+EOF
+  datacenters = ["dc1"]
+}
+
+role {
+  name     = "web"
+  policies = ["web"]
+  service_identities = [
+    {
+      service_name = "api"
+    }
+  ]
+  node_identities = [
+    {
+      node_name  = "node1"
+      datacenter = "dc1"
+    }
+  ]
+}
+
+auth_method {
+  name          = "k8s"
+  type          = "kubernetes"
+  max_token_ttl = "10m"
+  config {
+    Host = "https://k8s.example.com"
+    Nested {
+      Key = "value"
+    }
+  }
+}
+
+binding_rule {
+  auth_method = "k8s"
+  bind_type   = "service"
+  bind_name   = "${serviceaccount.name}"
+  selector    = "serviceaccount.namespace==default"
+}
+`
+	jsonDefs := `{
+  "policy": [{
+    "name": "web",
+    "description": "web service",
+    "rules": "service \"web\" { policy = \"write\" }\n",
+    "datacenters": ["dc1"]
+  }],
+  "role": {
+    "name": "web",
+    "policies": ["web"],
+    "service_identities": [{"service_name": "api"}],
+    "node_identities": [{"node_name": "node1", "datacenter": "dc1"}]
+  },
+  "auth_method": [{
+    "name": "k8s",
+    "type": "kubernetes",
+    "max_token_ttl": "10m",
+    "config": {"Host": "https://k8s.example.com", "Nested": {"Key": "value"}}
+  }],
+  "binding_rule": [{
+    "auth_method": "k8s",
+    "bind_type": "service",
+    "bind_name": "${serviceaccount.name}",
+    "selector": "serviceaccount.namespace==default"
+  }]
+}`
+
+	expected := &definitions{
+		Policies: []*policyDefinition{{
+			Name:        "web",
+			Description: "web service",
+			Rules:       "service \"web\" { policy = \"write\" }\n",
+			Datacenters: []string{"dc1"},
+		}},
+		Roles: []*roleDefinition{{
+			Name:              "web",
+			Policies:          []string{"web"},
+			ServiceIdentities: []*serviceIdentityDefinition{{ServiceName: "api"}},
+			NodeIdentities:    []*nodeIdentityDefinition{{NodeName: "node1", Datacenter: "dc1"}},
+		}},
+		AuthMethods: []*authMethodDefinition{{
+			Name:        "k8s",
+			Type:        "kubernetes",
+			MaxTokenTTL: "10m",
+			Config: map[string]interface{}{
+				"Host":   "https://k8s.example.com",
+				"Nested": map[string]interface{}{"Key": "value"},
+			},
+		}},
+		BindingRules: []*bindingRuleDefinition{{
+			AuthMethod: "k8s",
+			BindType:   "service",
+			BindName:   "${serviceaccount.name}",
+			Selector:   "serviceaccount.namespace==default",
+		}},
+	}
+
+	defs, err := parseDefinitions([]byte(hclDefs), false)
+	require.NoError(t, err)
+	require.Equal(t, expected, defs)
+
+	defs, err = parseDefinitions([]byte(jsonDefs), true)
+	require.NoError(t, err)
+	require.Equal(t, expected, defs)
+
+	_, err = parseDefinitions([]byte(`policy { name = "web" rulez = "" }`), false)
+	require.ErrorContains(t, err, "rulez")
+}
+
+func TestLoadDefinitions_Invalid(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		files map[string]string
+		err   string
+	}{
+		"duplicate across files": {
+			files: map[string]string{
+				"a.hcl":  `policy { name = "web" }`,
+				"b.json": `{"policy": {"name": "web"}}`,
+			},
+			err: `Policy "web" is defined more than once`,
+		},
+		"missing name": {
+			files: map[string]string{"a.hcl": `role { description = "x" }`},
+			err:   "Every role must have a name",
+		},
+		"bad ttl": {
+			files: map[string]string{"a.hcl": `auth_method { name = "m" type = "testing" max_token_ttl = "soon" }`},
+			err:   `Auth method "m" has an invalid max_token_ttl`,
+		},
+		"incomplete binding rule": {
+			files: map[string]string{"a.hcl": `binding_rule { auth_method = "m" }`},
+			err:   "Every binding rule must have",
+		},
+	}
+
+	for name, tcase := range cases {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			for file, content := range tcase.files {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, file), []byte(content), 0644))
+			}
+			_, err := loadDefinitions(dir)
+			require.ErrorContains(t, err, tcase.err)
+		})
+	}
+}
+
+func TestSyncCommand(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	a := agent.NewTestAgent(t, `
+	primary_datacenter = "dc1"
+	acl {
+		enabled = true
+		tokens {
+			initial_management = "root"
+		}
+	}`)
+
+	defer a.Shutdown()
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1", testrpc.WithToken("root"))
+
+	client := a.Client()
+	writeOpts := &api.WriteOptions{Token: "root"}
+
+	_, _, err := client.ACL().PolicyCreate(&api.ACLPolicy{
+		Name:  "web",
+		Rules: `service "web" { policy = "read" }`,
+	}, writeOpts)
+	require.NoError(t, err)
+
+	_, _, err = client.ACL().PolicyCreate(&api.ACLPolicy{
+		Name:  "stale",
+		Rules: `node_prefix "" { policy = "read" }`,
+	}, writeOpts)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "policies.hcl"), []byte(`
+policy {
+  name  = "web"
+  rules = <<EOF
+service "web" { policy = "write" }
+EOF
+}
+
+policy {
+  name  = "db"
+  rules = <<EOF
+service "db" { policy = "write" }
+EOF
+}
+`), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "roles.hcl"), []byte(`
+role {
+  name     = "app"
+  policies = ["web", "db"]
+  service_identities = [{ service_name = "api" }]
+}
+
+auth_method {
+  name          = "test"
+  type          = "testing"
+  max_token_ttl = "1h"
+}
+
+binding_rule {
+  auth_method = "test"
+  bind_type   = "service"
+  bind_name   = "api"
+}
+`), 0644))
+
+	run := func(t *testing.T, args ...string) (int, string) {
+		ui := cli.NewMockUi()
+		code := New(ui).Run(append([]string{"-http-addr=" + a.HTTPAddr(), "-token=root"}, args...))
+		return code, ui.OutputWriter.String() + ui.ErrorWriter.String()
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		code, output := run(t, "-dir="+dir, "-dry-run", "-prune")
+		require.Equal(t, 2, code, output)
+		require.Contains(t, output, `+ create policy "db"`)
+		require.Contains(t, output, `~ update policy "web" (rules)`)
+		require.Contains(t, output, `+ create role "app"`)
+		require.Contains(t, output, `+ create auth-method "test"`)
+		require.Contains(t, output, `+ create binding-rule "test/service:api"`)
+		require.Contains(t, output, `- delete policy "stale"`)
+		require.NotContains(t, output, "global-management")
+		require.Contains(t, output, "Plan: 4 to create, 1 to update, 1 to delete.")
+
+		policy, _, err := client.ACL().PolicyReadByName("db", &api.QueryOptions{Token: "root"})
+		require.NoError(t, err)
+		require.Nil(t, policy)
+	})
+
+	t.Run("apply without prune", func(t *testing.T) {
+		code, output := run(t, "-dir="+dir)
+		require.Equal(t, 0, code, output)
+		require.Contains(t, output, "Applied 5 changes.")
+
+		role, _, err := client.ACL().RoleReadByName("app", &api.QueryOptions{Token: "root"})
+		require.NoError(t, err)
+		require.NotNil(t, role)
+		require.Len(t, role.Policies, 2)
+
+		policy, _, err := client.ACL().PolicyReadByName("stale", &api.QueryOptions{Token: "root"})
+		require.NoError(t, err)
+		require.NotNil(t, policy)
+	})
+
+	t.Run("no drift", func(t *testing.T) {
+		code, output := run(t, "-dir="+dir, "-dry-run")
+		require.Equal(t, 0, code, output)
+		require.Contains(t, output, "No changes")
+	})
+
+	t.Run("prune", func(t *testing.T) {
+		code, output := run(t, "-dir="+dir, "-prune")
+		require.Equal(t, 0, code, output)
+		require.Contains(t, output, `- delete policy "stale"`)
+
+		policy, _, err := client.ACL().PolicyReadByName("stale", &api.QueryOptions{Token: "root"})
+		require.NoError(t, err)
+		require.Nil(t, policy)
+	})
+
+	t.Run("export", func(t *testing.T) {
+		exportDir := filepath.Join(t.TempDir(), "export")
+		code, output := run(t, "-dir="+exportDir, "-export")
+		require.Equal(t, 0, code, output)
+		require.FileExists(t, filepath.Join(exportDir, "policies.json"))
+		require.FileExists(t, filepath.Join(exportDir, "binding-rules.json"))
+
+		// the exported definitions match the cluster
+		code, output = run(t, "-dir="+exportDir, "-dry-run", "-prune")
+		require.Equal(t, 0, code, output)
+
+		// existing files are not overwritten
+		code, _ = run(t, "-dir="+exportDir, "-export")
+		require.Equal(t, 1, code)
+	})
+}
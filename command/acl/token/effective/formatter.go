@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package tokeneffective
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/consul/acl"
+)
+
+// ruleBlock is a single rule rendered as an HCL block.
+type ruleBlock struct {
+	name       string
+	policy     string
+	intentions string
+	condition  *acl.RuleCondition
+}
+
+// formatRules renders rules in the HCL policy format. Blocks are sorted so
+// that the output is stable.
+func formatRules(rules *acl.PolicyRules) string {
+	var b strings.Builder
+
+	for _, attr := range []struct{ name, value string }{
+		{"acl", rules.ACL},
+		{"keyring", rules.Keyring},
+		{"mesh", rules.Mesh},
+		{"operator", rules.Operator},
+		{"peering", rules.Peering},
+	} {
+		if attr.value != "" {
+			fmt.Fprintf(&b, "%s = %q\n", attr.name, attr.value)
+		}
+	}
+
+	writeBlocks(&b, "agent", agentBlocks(rules.Agents))
+	writeBlocks(&b, "agent_prefix", agentBlocks(rules.AgentPrefixes))
+	writeBlocks(&b, "event", eventBlocks(rules.Events))
+	writeBlocks(&b, "event_prefix", eventBlocks(rules.EventPrefixes))
+	writeBlocks(&b, "key", keyBlocks(rules.Keys))
+	writeBlocks(&b, "key_prefix", keyBlocks(rules.KeyPrefixes))
+	writeBlocks(&b, "node", nodeBlocks(rules.Nodes))
+	writeBlocks(&b, "node_prefix", nodeBlocks(rules.NodePrefixes))
+	writeBlocks(&b, "query", queryBlocks(rules.PreparedQueries))
+	writeBlocks(&b, "query_prefix", queryBlocks(rules.PreparedQueryPrefixes))
+	writeBlocks(&b, "service", serviceBlocks(rules.Services))
+	writeBlocks(&b, "service_prefix", serviceBlocks(rules.ServicePrefixes))
+	writeBlocks(&b, "session", sessionBlocks(rules.Sessions))
+	writeBlocks(&b, "session_prefix", sessionBlocks(rules.SessionPrefixes))
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func writeBlocks(b *strings.Builder, resource string, blocks []ruleBlock) {
+	sort.SliceStable(blocks, func(i, j int) bool {
+		if blocks[i].name != blocks[j].name {
+			return blocks[i].name < blocks[j].name
+		}
+		// unconditional rules first
+		return blocks[i].condition == nil && blocks[j].condition != nil
+	})
+
+	for _, block := range blocks {
+		fmt.Fprintf(b, "%s %q {\n", resource, block.name)
+		if block.policy != "" {
+			fmt.Fprintf(b, "  policy = %q\n", block.policy)
+		}
+		if block.intentions != "" {
+			fmt.Fprintf(b, "  intentions = %q\n", block.intentions)
+		}
+		if cond := block.condition; cond != nil {
+			b.WriteString("  condition {\n")
+			if len(cond.SourceCIDRs) > 0 {
+				fmt.Fprintf(b, "    source_cidrs = [%s]\n", quoteList(cond.SourceCIDRs))
+			}
+			if len(cond.NodeMeta) > 0 {
+				b.WriteString("    node_meta {\n")
+				keys := make([]string, 0, len(cond.NodeMeta))
+				for k := range cond.NodeMeta {
+					keys = append(keys, k)
+				}
+				sort.Strings(keys)
+				for _, k := range keys {
+					fmt.Fprintf(b, "      %q = %q\n", k, cond.NodeMeta[k])
+				}
+				b.WriteString("    }\n")
+			}
+			if w := cond.TimeWindow; w != nil {
+				b.WriteString("    time_window {\n")
+				fmt.Fprintf(b, "      start = %q\n", w.Start)
+				fmt.Fprintf(b, "      end = %q\n", w.End)
+				if len(w.Days) > 0 {
+					fmt.Fprintf(b, "      days = [%s]\n", quoteList(w.Days))
+				}
+				if w.Timezone != "" {
+					fmt.Fprintf(b, "      timezone = %q\n", w.Timezone)
+				}
+				b.WriteString("    }\n")
+			}
+			b.WriteString("  }\n")
+		}
+		b.WriteString("}\n")
+	}
+}
+
+func quoteList(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, v := range values {
+		quoted = append(quoted, fmt.Sprintf("%q", v))
+	}
+	return strings.Join(quoted, ", ")
+}
+
+func agentBlocks(rules []*acl.AgentRule) []ruleBlock {
+	blocks := make([]ruleBlock, 0, len(rules))
+	for _, r := range rules {
+		blocks = append(blocks, ruleBlock{name: r.Node, policy: r.Policy})
+	}
+	return blocks
+}
+
+func eventBlocks(rules []*acl.EventRule) []ruleBlock {
+	blocks := make([]ruleBlock, 0, len(rules))
+	for _, r := range rules {
+		blocks = append(blocks, ruleBlock{name: r.Event, policy: r.Policy})
+	}
+	return blocks
+}
+
+func keyBlocks(rules []*acl.KeyRule) []ruleBlock {
+	blocks := make([]ruleBlock, 0, len(rules))
+	for _, r := range rules {
+		blocks = append(blocks, ruleBlock{name: r.Prefix, policy: r.Policy, condition: r.Condition})
+	}
+	return blocks
+}
+
+func nodeBlocks(rules []*acl.NodeRule) []ruleBlock {
+	blocks := make([]ruleBlock, 0, len(rules))
+	for _, r := range rules {
+		blocks = append(blocks, ruleBlock{name: r.Name, policy: r.Policy, condition: r.Condition})
+	}
+	return blocks
+}
+
+func queryBlocks(rules []*acl.PreparedQueryRule) []ruleBlock {
+	blocks := make([]ruleBlock, 0, len(rules))
+	for _, r := range rules {
+		blocks = append(blocks, ruleBlock{name: r.Prefix, policy: r.Policy})
+	}
+	return blocks
+}
+
+func serviceBlocks(rules []*acl.ServiceRule) []ruleBlock {
+	blocks := make([]ruleBlock, 0, len(rules))
+	for _, r := range rules {
+		blocks = append(blocks, ruleBlock{name: r.Name, policy: r.Policy, intentions: r.Intentions, condition: r.Condition})
+	}
+	return blocks
+}
+
+func sessionBlocks(rules []*acl.SessionRule) []ruleBlock {
+	blocks := make([]ruleBlock, 0, len(rules))
+	for _, r := range rules {
+		blocks = append(blocks, ruleBlock{name: r.Node, policy: r.Policy})
+	}
+	return blocks
+}
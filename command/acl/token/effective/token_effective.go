@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package tokeneffective
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/mitchellh/cli"
+
+	consulacl "github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/command/acl"
+	"github.com/hashicorp/consul/command/flags"
+)
+
+func New(ui cli.Ui) *cmd {
+	c := &cmd{UI: ui}
+	c.init()
+	return c
+}
+
+type cmd struct {
+	UI    cli.Ui
+	flags *flag.FlagSet
+	http  *flags.HTTPFlags
+	help  string
+
+	tokenAccessorID string
+	lint            bool
+	catalog         bool
+}
+
+func (c *cmd) init() {
+	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+	c.flags.StringVar(&c.tokenAccessorID, "accessor-id", "", "The Accessor ID of the token. "+
+		"It may be specified as a unique ID prefix but will error if the prefix "+
+		"matches multiple token Accessor IDs")
+	c.flags.BoolVar(&c.lint, "lint", false, "Also report problems found in the rules "+
+		"linked to the token. See 'consul acl policy lint' for details.")
+	c.flags.BoolVar(&c.catalog, "catalog", true, "When linting, report rules for services "+
+		"and nodes that are not registered in the catalog.")
+	c.http = &flags.HTTPFlags{}
+	flags.Merge(c.flags, c.http.ClientFlags())
+	flags.Merge(c.flags, c.http.ServerFlags())
+	flags.Merge(c.flags, c.http.MultiTenancyFlags())
+	c.help = flags.Usage(help, c.flags)
+}
+
+func (c *cmd) Run(args []string) int {
+	if err := c.flags.Parse(args); err != nil {
+		return 1
+	}
+
+	if c.tokenAccessorID == "" {
+		c.UI.Error("Must specify the -accessor-id parameter")
+		return 1
+	}
+
+	client, err := c.http.APIClient()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error connecting to Consul agent: %s", err))
+		return 1
+	}
+
+	tokenID, err := acl.GetTokenAccessorIDFromPartial(client, c.tokenAccessorID)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error determining token ID: %v", err))
+		return 1
+	}
+
+	tok, _, err := client.ACL().TokenReadExpanded(tokenID, nil)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error reading token %q: %v", tokenID, err))
+		return 1
+	}
+
+	datacenter, err := c.datacenter(client)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error determining datacenter: %v", err))
+		return 1
+	}
+
+	// Only policies that are valid in the datacenter affect the token there.
+	var applicable []api.ACLPolicy
+	for _, policy := range tok.ExpandedPolicies {
+		if appliesToDatacenter(policy.Datacenters, datacenter) {
+			applicable = append(applicable, policy)
+		}
+	}
+
+	policies, err := acl.ParsePolicies(applicable)
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	// Lint before merging as merging modifies some of the parsed rules.
+	var findings []consulacl.PolicyLintFinding
+	if c.lint {
+		var catalog *consulacl.PolicyLintCatalog
+		if c.catalog {
+			catalog, err = acl.GetPolicyLintCatalog(client)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error reading the catalog: %v", err))
+				return 1
+			}
+		}
+		findings = consulacl.LintPolicies(policies, catalog)
+	}
+
+	parsed := make([]*consulacl.Policy, 0, len(policies))
+	for _, p := range policies {
+		parsed = append(parsed, p.Policy)
+	}
+	merged := consulacl.MergePolicies(parsed)
+
+	c.UI.Info(fmt.Sprintf("# Effective rules for token %s in datacenter %s", tok.AccessorID, datacenter))
+	c.UI.Info(fmt.Sprintf("# Default policy: %s", tok.AgentACLDefaultPolicy))
+	if rules := formatRules(&merged.PolicyRules); rules != "" {
+		c.UI.Info(rules)
+	}
+
+	if len(findings) > 0 {
+		c.UI.Info("")
+		c.UI.Info("Problems:")
+		c.UI.Info(acl.FormatPolicyLintFindings(findings))
+	}
+	return 0
+}
+
+func (c *cmd) datacenter(client *api.Client) (string, error) {
+	if dc := c.http.Datacenter(); dc != "" {
+		return dc, nil
+	}
+	self, err := client.Agent().Self()
+	if err != nil {
+		return "", err
+	}
+	dc, _ := self["Config"]["Datacenter"].(string)
+	return dc, nil
+}
+
+func appliesToDatacenter(datacenters []string, datacenter string) bool {
+	if len(datacenters) == 0 {
+		return true
+	}
+	for _, dc := range datacenters {
+		if dc == datacenter {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return flags.Usage(c.help, nil)
+}
+
+const (
+	synopsis = "Show the effective rules of an ACL token"
+	help     = `
+Usage: consul acl token effective [options] -accessor-id TOKENID
+
+  This command merges the rules of every policy, role, service identity,
+  node identity and templated policy linked to a token and prints the
+  resulting rule set. Where several rules apply to the same resource only
+  the one that takes precedence is shown.
+
+  Show the effective rules:
+
+          $ consul acl token effective -accessor-id 4be56c77-82
+
+  Also report problems with the linked rules:
+
+          $ consul acl token effective -accessor-id 4be56c77-82 -lint
+`
+)
@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package tokeneffective
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+func TestTokenEffectiveCommand_noTabs(t *testing.T) {
+	t.Parallel()
+
+	if strings.ContainsRune(New(cli.NewMockUi()).Help(), '\t') {
+		t.Fatal("help has tabs")
+	}
+}
+
+func TestTokenEffectiveCommand(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	a := agent.NewTestAgent(t, `
+	primary_datacenter = "dc1"
+	acl {
+		enabled = true
+		tokens {
+			initial_management = "root"
+		}
+	}`)
+
+	defer a.Shutdown()
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1", testrpc.WithToken("root"))
+
+	client := a.Client()
+	writeOpts := &api.WriteOptions{Token: "root"}
+
+	read, _, err := client.ACL().PolicyCreate(&api.ACLPolicy{
+		Name:  "read",
+		Rules: `service "web" { policy = "read" }`,
+	}, writeOpts)
+	require.NoError(t, err)
+
+	write, _, err := client.ACL().PolicyCreate(&api.ACLPolicy{
+		Name:  "write",
+		Rules: `service "web" { policy = "write" }`,
+	}, writeOpts)
+	require.NoError(t, err)
+
+	_, _, err = client.ACL().PolicyCreate(&api.ACLPolicy{
+		Name:        "other-dc",
+		Rules:       `operator = "write"`,
+		Datacenters: []string{"dc2"},
+	}, writeOpts)
+	require.NoError(t, err)
+
+	token, _, err := client.ACL().TokenCreate(&api.ACLToken{
+		Policies: []*api.ACLTokenPolicyLink{
+			{ID: read.ID},
+			{ID: write.ID},
+			{Name: "other-dc"},
+		},
+		NodeIdentities: []*api.ACLNodeIdentity{
+			{NodeName: "node1", Datacenter: "dc1"},
+		},
+	}, writeOpts)
+	require.NoError(t, err)
+
+	ui := cli.NewMockUi()
+	code := New(ui).Run([]string{
+		"-http-addr=" + a.HTTPAddr(),
+		"-token=root",
+		"-accessor-id=" + token.AccessorID,
+		"-lint",
+		"-catalog=false",
+	})
+	require.Equal(t, 0, code, ui.ErrorWriter.String())
+
+	output := ui.OutputWriter.String()
+	require.Contains(t, output, "in datacenter dc1")
+	require.Contains(t, output, "node \"node1\" {\n  policy = \"write\"\n}")
+	require.Contains(t, output, "service \"web\" {\n  policy = \"write\"\n}")
+	require.NotContains(t, output, "operator")
+	require.Contains(t, output, `read: service "web": is overridden by a "write" rule for the same resource`)
+}
+
+func TestFormatRules(t *testing.T) {
+	policy, err := acl.NewPolicyFromSource(`
+		operator = "read"
+		service_prefix "" { policy = "read" }
+		service "web" {
+			policy = "write"
+			intentions = "read"
+		}
+		key_prefix "app/" {
+			policy = "write"
+			condition {
+				source_cidrs = ["10.0.0.0/8"]
+				node_meta {
+					env = "dev"
+				}
+				time_window {
+					start = "09:00"
+					end = "17:00"
+					days = ["mon"]
+				}
+			}
+		}`, nil, nil)
+	require.NoError(t, err)
+
+	expected := `operator = "read"
+key_prefix "app/" {
+  policy = "write"
+  condition {
+    source_cidrs = ["10.0.0.0/8"]
+    node_meta {
+      "env" = "dev"
+    }
+    time_window {
+      start = "09:00"
+      end = "17:00"
+      days = ["mon"]
+    }
+  }
+}
+service "web" {
+  policy = "write"
+  intentions = "read"
+}
+service_prefix "" {
+  policy = "read"
+}`
+	require.Equal(t, expected, formatRules(&policy.PolicyRules))
+
+	// The output must parse back to the same rules.
+	roundTrip, err := acl.NewPolicyFromSource(expected, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, policy, roundTrip)
+}
@@ -44,5 +44,9 @@ Usage: consul connect ca <subcommand> [options] [args]
 
       $ consul connect ca set-config -config-file ca.json
 
+  List the issued leaf certificates that expire within the next day:
+
+      $ consul connect ca certs -expiring-within 24h
+
   For more examples, ask for subcommand help or view the documentation.
 `
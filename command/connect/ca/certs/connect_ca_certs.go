@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package certs
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/mitchellh/cli"
+	"github.com/ryanuber/columnize"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/command/flags"
+)
+
+const (
+	formatPretty = "pretty"
+	formatJSON   = "json"
+)
+
+func New(ui cli.Ui) *cmd {
+	c := &cmd{UI: ui}
+	c.init()
+	return c
+}
+
+type cmd struct {
+	UI    cli.Ui
+	flags *flag.FlagSet
+	http  *flags.HTTPFlags
+	help  string
+
+	// flags
+	expiringWithin time.Duration
+	filter         string
+	format         string
+}
+
+func (c *cmd) init() {
+	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+	c.flags.DurationVar(&c.expiringWithin, "expiring-within", 0, "Only list the "+
+		"certificates that expire within the given duration, such as 24h.")
+	c.flags.StringVar(&c.filter, "filter", "", "Filter to use with the request")
+	c.flags.StringVar(&c.format, "format", formatPretty,
+		fmt.Sprintf("Output format {%s|%s} (default: %s)", formatPretty, formatJSON, formatPretty))
+
+	c.http = &flags.HTTPFlags{}
+	flags.Merge(c.flags, c.http.ClientFlags())
+	flags.Merge(c.flags, c.http.ServerFlags())
+	c.help = flags.Usage(help, c.flags)
+}
+
+func (c *cmd) Run(args []string) int {
+	if err := c.flags.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return 0
+		}
+		c.UI.Error(fmt.Sprintf("Failed to parse args: %v", err))
+		return 1
+	}
+
+	if c.format != formatPretty && c.format != formatJSON {
+		c.UI.Error(fmt.Sprintf("Invalid format, valid formats are {%s|%s}", formatPretty, formatJSON))
+		return 1
+	}
+	if c.expiringWithin < 0 {
+		c.UI.Error("The -expiring-within flag must be a positive duration")
+		return 1
+	}
+
+	// Set up a client.
+	client, err := c.http.APIClient()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	opts := &api.QueryOptions{
+		AllowStale: c.http.Stale(),
+		Filter:     c.filter,
+	}
+	certs, _, err := client.Connect().CACerts(c.expiringWithin, opts)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error listing issued certificates: %s", err))
+		return 1
+	}
+
+	if c.format == formatJSON {
+		output, err := json.MarshalIndent(certs, "", "    ")
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error marshalling JSON: %s", err))
+			return 1
+		}
+		c.UI.Output(string(output))
+		return 0
+	}
+
+	if len(certs) == 0 {
+		c.UI.Info("No certificates found.")
+		return 0
+	}
+
+	now := time.Now()
+	result := make([]string, 0, len(certs)+1)
+	result = append(result, "Serial Number\x1fSPIFFE ID\x1fNode\x1fExpires\x1fExpires In")
+	for _, cert := range certs {
+		result = append(result, fmt.Sprintf("%s\x1f%s\x1f%s\x1f%s\x1f%s",
+			cert.SerialNumber,
+			cert.URI(),
+			cert.Node,
+			cert.ValidBefore.Format(time.RFC3339),
+			cert.ValidBefore.Sub(now).Round(time.Second),
+		))
+	}
+	c.UI.Output(columnize.Format(result, &columnize.Config{Delim: string([]byte{0x1f})}))
+
+	return 0
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return c.help
+}
+
+const synopsis = "List the leaf certificates issued by the Connect Certificate Authority (CA)"
+const help = `
+Usage: consul connect ca certs [options]
+
+  Lists the unexpired service mesh leaf certificates issued in the datacenter,
+  sorted by expiry. This requires an ACL token with operator:read access.
+
+  List the certificates that expire within the next day:
+
+      $ consul connect ca certs -expiring-within 24h
+
+  List the certificates issued to the web service:
+
+      $ consul connect ca certs -filter 'Service == "web"'
+`
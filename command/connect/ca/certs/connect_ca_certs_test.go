@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package certs
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+func TestConnectCACertsCommand_noTabs(t *testing.T) {
+	t.Parallel()
+	if strings.ContainsRune(New(cli.NewMockUi()).Help(), '\t') {
+		t.Fatal("help has tabs")
+	}
+}
+
+func TestConnectCACertsCommand(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := agent.NewTestAgent(t, ``)
+	defer a.Shutdown()
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+
+	// Have the agent request a leaf cert for a service.
+	leaf, _, err := a.Client().Agent().ConnectCALeaf("web", nil)
+	require.NoError(t, err)
+
+	t.Run("pretty", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		code := New(ui).Run([]string{"-http-addr=" + a.HTTPAddr()})
+		require.Equal(t, 0, code, ui.ErrorWriter.String())
+
+		output := ui.OutputWriter.String()
+		require.Contains(t, output, "Serial Number")
+		require.Contains(t, output, leaf.SerialNumber)
+		require.Contains(t, output, leaf.ServiceURI)
+		require.Contains(t, output, a.Config.NodeName)
+	})
+
+	t.Run("json with filter", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		code := New(ui).Run([]string{
+			"-http-addr=" + a.HTTPAddr(),
+			"-format=json",
+			"-filter=Service == \"web\"",
+		})
+		require.Equal(t, 0, code, ui.ErrorWriter.String())
+
+		var certs []*api.CAIssuedCert
+		require.NoError(t, json.Unmarshal(ui.OutputWriter.Bytes(), &certs))
+		require.Len(t, certs, 1)
+		require.Equal(t, leaf.SerialNumber, certs[0].SerialNumber)
+		require.Equal(t, a.Config.NodeName, certs[0].Node)
+	})
+
+	t.Run("expiring within", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		code := New(ui).Run([]string{"-http-addr=" + a.HTTPAddr(), "-expiring-within=1h"})
+		require.Equal(t, 0, code, ui.ErrorWriter.String())
+		require.Contains(t, ui.OutputWriter.String(), "No certificates found.")
+	})
+
+	t.Run("invalid format", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		code := New(ui).Run([]string{"-http-addr=" + a.HTTPAddr(), "-format=yaml"})
+		require.Equal(t, 1, code)
+		require.Contains(t, ui.ErrorWriter.String(), "Invalid format")
+	})
+}
@@ -3257,6 +3257,67 @@ func TestParseConfigEntry(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "acme-certificate",
+			snake: `
+				kind = "acme-certificate"
+				name = "main"
+				directory_url = "https://acme.example.com/directory"
+				hostnames = ["example.com"]
+				renew_before = "168h"
+				meta {
+					"foo" = "bar"
+					"gir" = "zim"
+				}
+			`,
+			camel: `
+				Kind = "acme-certificate"
+				Name = "main"
+				DirectoryURL = "https://acme.example.com/directory"
+				Hostnames = ["example.com"]
+				RenewBefore = "168h"
+				Meta {
+					"foo" = "bar"
+					"gir" = "zim"
+				}
+			`,
+			snakeJSON: `
+			{
+				"kind": "acme-certificate",
+				"name": "main",
+				"directory_url": "https://acme.example.com/directory",
+				"hostnames": ["example.com"],
+				"renew_before": "168h",
+				"meta": {
+					"foo": "bar",
+					"gir": "zim"
+				}
+			}
+			`,
+			camelJSON: `
+			{
+				"Kind": "acme-certificate",
+				"Name": "main",
+				"DirectoryURL": "https://acme.example.com/directory",
+				"Hostnames": ["example.com"],
+				"RenewBefore": "168h",
+				"Meta": {
+					"foo": "bar",
+					"gir": "zim"
+				}
+			}`,
+			expect: &api.ACMECertificateConfigEntry{
+				Kind:         "acme-certificate",
+				Name:         "main",
+				DirectoryURL: "https://acme.example.com/directory",
+				Hostnames:    []string{"example.com"},
+				RenewBefore:  168 * time.Hour,
+				Meta: map[string]string{
+					"foo": "bar",
+					"gir": "zim",
+				},
+			},
+		},
 		{
 			name: "http-route",
 			snake: `
@@ -64,6 +64,10 @@ Usage: consul peering <subcommand> [options] [args]
 
     $ consul peering read -name west-dc
 
+  Rotate the secret of a peering connection:
+
+    $ consul peering rotate -name west-dc
+
   Lists services exported to a peering connection:
 
     $ consul peering exported-services -name west-dc
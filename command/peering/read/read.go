@@ -33,6 +33,7 @@ type cmd struct {
 
 	name   string
 	format string
+	detail bool
 }
 
 func (c *cmd) init() {
@@ -47,6 +48,9 @@ func (c *cmd) init() {
 		fmt.Sprintf("Output format {%s} (default: %s)", strings.Join(peering.GetSupportedFormats(), "|"), peering.PeeringFormatPretty),
 	)
 
+	c.flags.BoolVar(&c.detail, "detail", false, "Include the detailed status of the resources "+
+		"replicated over the peering stream, such as replication lag and recent errors.")
+
 	c.http = &flags.HTTPFlags{}
 	flags.Merge(c.flags, c.http.ClientFlags())
 	flags.Merge(c.flags, c.http.PartitionFlag())
@@ -76,7 +80,12 @@ func (c *cmd) Run(args []string) int {
 
 	peerings := client.Peerings()
 
-	res, _, err := peerings.Read(context.Background(), c.name, &api.QueryOptions{})
+	var res *api.Peering
+	if c.detail {
+		res, _, err = peerings.ReadWithDetail(context.Background(), c.name, &api.QueryOptions{})
+	} else {
+		res, _, err = peerings.Read(context.Background(), c.name, &api.QueryOptions{})
+	}
 	if err != nil {
 		c.UI.Error(fmt.Sprintf("Error reading peering: %s", err))
 		return 1
@@ -98,6 +107,9 @@ func (c *cmd) Run(args []string) int {
 	}
 
 	c.UI.Output(formatPeering(res))
+	if res.StreamStatus.Replication != nil {
+		c.UI.Output(formatReplication(res.StreamStatus.Replication, time.Now()))
+	}
 
 	return 0
 }
@@ -146,6 +158,77 @@ func formatPeering(peering *api.Peering) string {
 	return buffer.String()
 }
 
+func formatReplication(r *api.PeeringReplicationStatus, now time.Time) string {
+	var buffer bytes.Buffer
+
+	since := func(t *time.Time) string {
+		if t == nil || t.IsZero() {
+			return "never"
+		}
+		return fmt.Sprintf("%s ago", now.Sub(*t).Truncate(time.Second))
+	}
+	resourceType := func(url string) string {
+		return url[strings.LastIndex(url, ".")+1:]
+	}
+
+	if len(r.Exported) > 0 {
+		buffer.WriteString("Exported Resources:\n")
+		for _, e := range r.Exported {
+			buffer.WriteString(fmt.Sprintf("    %s\n", resourceType(e.ResourceURL)))
+			buffer.WriteString(fmt.Sprintf("        Last Sent Nonce:   %s\n", e.LastSentNonce))
+			buffer.WriteString(fmt.Sprintf("        Last Acked Nonce:  %s\n", e.LastAckedNonce))
+			buffer.WriteString(fmt.Sprintf("        Pending Updates:   %d\n", e.PendingUpdates))
+			buffer.WriteString(fmt.Sprintf("        Last Ack:          %s\n", since(e.LastAck)))
+			if e.LastNack != nil {
+				buffer.WriteString(fmt.Sprintf("        Last Nack:         %s: %s\n", since(e.LastNack), e.LastNackMessage))
+			}
+		}
+		buffer.WriteString("\n")
+	}
+
+	if len(r.Imported) > 0 {
+		buffer.WriteString("Imported Resources:\n")
+		for _, i := range r.Imported {
+			buffer.WriteString(fmt.Sprintf("    %s\n", resourceType(i.ResourceURL)))
+			buffer.WriteString(fmt.Sprintf("        Last Nonce:        %s\n", i.LastNonce))
+			buffer.WriteString(fmt.Sprintf("        Last Apply:        %s\n", since(i.LastApply)))
+			if i.LastApplyError != nil {
+				buffer.WriteString(fmt.Sprintf("        Last Apply Error:  %s: %s\n", since(i.LastApplyError), i.LastApplyErrorMessage))
+			}
+		}
+		buffer.WriteString("\n")
+	}
+
+	if len(r.ImportedServices) > 0 {
+		buffer.WriteString("Imported Service Status:\n")
+		for _, svc := range r.ImportedServices {
+			status := fmt.Sprintf("applied %s", since(svc.LastApply))
+			if svc.LastApplyError != nil && (svc.LastApply == nil || svc.LastApplyError.After(*svc.LastApply)) {
+				status = fmt.Sprintf("failing %s: %s", since(svc.LastApplyError), svc.LastApplyErrorMessage)
+			}
+			buffer.WriteString(fmt.Sprintf("    %s: %s\n", svc.Name, status))
+		}
+		buffer.WriteString("\n")
+	}
+
+	if len(r.Errors) > 0 {
+		buffer.WriteString("Recent Replication Errors:\n")
+		for _, e := range r.Errors {
+			direction := "import"
+			if e.Exported {
+				direction = "export"
+			}
+			id := resourceType(e.ResourceURL)
+			if e.ResourceID != "" {
+				id += "/" + e.ResourceID
+			}
+			buffer.WriteString(fmt.Sprintf("    %s  %s %s: %s\n", e.Time.Format(time.RFC3339), direction, id, e.Message))
+		}
+	}
+
+	return buffer.String()
+}
+
 func (c *cmd) Synopsis() string {
 	return synopsis
 }
@@ -166,5 +249,9 @@ Usage: consul peering read [options] -name <peer name>
   Example:
 
     $ consul peering read -name west-dc
+
+  Include the replication lag and recent replication errors of the peering:
+
+    $ consul peering read -name west-dc -detail
 `
 )
@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/mitchellh/cli"
 	"github.com/stretchr/testify/require"
@@ -117,6 +118,26 @@ func TestReadCommand(t *testing.T) {
 		require.Contains(t, output, "Last Receive")
 	})
 
+	t.Run("read with detail", func(t *testing.T) {
+
+		ui := cli.NewMockUi()
+		cmd := New(ui)
+
+		args := []string{
+			"-http-addr=" + acceptor.HTTPAddr(),
+			"-name=foo",
+			"-detail",
+		}
+
+		code := cmd.Run(args)
+		require.Equal(t, 0, code)
+		output := ui.OutputWriter.String()
+
+		// The peering was never established, so there is no replication detail to print.
+		require.Contains(t, output, "Last Receive")
+		require.NotContains(t, output, "Exported Resources")
+	})
+
 	t.Run("read with json", func(t *testing.T) {
 
 		ui := cli.NewMockUi()
@@ -139,3 +160,50 @@ func TestReadCommand(t *testing.T) {
 		require.Equal(t, "production", outputPeering.Meta["env"])
 	})
 }
+
+func TestFormatReplication(t *testing.T) {
+	now := time.Date(2000, time.January, 1, 0, 1, 0, 0, time.UTC)
+	ack := now.Add(-5 * time.Second)
+	apply := now.Add(-30 * time.Second)
+	failed := now.Add(-10 * time.Second)
+
+	output := formatReplication(&api.PeeringReplicationStatus{
+		Exported: []api.PeeringExportedResourceStatus{
+			{
+				ResourceURL:    "type.googleapis.com/hashicorp.consul.internal.peerstream.ExportedService",
+				LastSentNonce:  "00000004",
+				LastAckedNonce: "00000003",
+				PendingUpdates: 1,
+				LastAck:        &ack,
+			},
+		},
+		Imported: []api.PeeringImportedResourceStatus{
+			{
+				ResourceURL: "type.googleapis.com/hashicorp.consul.internal.peering.PeeringTrustBundle",
+				LastNonce:   "00000002",
+				LastApply:   &apply,
+			},
+		},
+		ImportedServices: []api.PeeringImportedServiceStatus{
+			{Name: "api", LastApply: &apply},
+			{Name: "web", LastApply: &apply, LastApplyError: &failed, LastApplyErrorMessage: "boom"},
+		},
+		Errors: []api.PeeringReplicationError{
+			{
+				Time:        failed,
+				ResourceURL: "type.googleapis.com/hashicorp.consul.internal.peerstream.ExportedService",
+				ResourceID:  "web",
+				Message:     "boom",
+			},
+		},
+	}, now)
+
+	require.Contains(t, output, "Exported Resources:\n    ExportedService\n")
+	require.Contains(t, output, "Pending Updates:   1\n")
+	require.Contains(t, output, "Last Ack:          5s ago\n")
+	require.Contains(t, output, "Imported Resources:\n    PeeringTrustBundle\n")
+	require.Contains(t, output, "Last Apply:        30s ago\n")
+	require.Contains(t, output, "    api: applied 30s ago\n")
+	require.Contains(t, output, "    web: failing 10s ago: boom\n")
+	require.Contains(t, output, "2000-01-01T00:00:50Z  import ExportedService/web: boom\n")
+}
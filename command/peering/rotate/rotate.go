@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package rotate
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/mitchellh/cli"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/command/flags"
+)
+
+func New(ui cli.Ui) *cmd {
+	c := &cmd{UI: ui}
+	c.init()
+	return c
+}
+
+type cmd struct {
+	UI    cli.Ui
+	flags *flag.FlagSet
+	http  *flags.HTTPFlags
+	help  string
+
+	name string
+}
+
+func (c *cmd) init() {
+	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+
+	c.flags.StringVar(&c.name, "name", "", "(Required) The local name assigned to the peer cluster.")
+
+	c.http = &flags.HTTPFlags{}
+	flags.Merge(c.flags, c.http.ClientFlags())
+	flags.Merge(c.flags, c.http.PartitionFlag())
+	c.help = flags.Usage(help, c.flags)
+}
+
+func (c *cmd) Run(args []string) int {
+	if err := c.flags.Parse(args); err != nil {
+		return 1
+	}
+
+	if c.name == "" {
+		c.UI.Error("Missing the required -name flag")
+		return 1
+	}
+
+	client, err := c.http.APIClient()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error connecting to Consul agent: %s", err))
+		return 1
+	}
+
+	peerings := client.Peerings()
+
+	req := api.PeeringRotateSecretRequest{
+		PeerName:  c.name,
+		Partition: c.http.Partition(),
+	}
+
+	_, _, err = peerings.RotateSecret(context.Background(), req, &api.WriteOptions{})
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error rotating secret for peering %s: %v", req.PeerName, err))
+		return 1
+	}
+
+	c.UI.Info(fmt.Sprintf("Successfully rotated the stream secret for peering %s", req.PeerName))
+	return 0
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return flags.Usage(c.help, nil)
+}
+
+const (
+	synopsis = "Rotate the secret of a peering connection"
+	help     = `
+Usage: consul peering rotate [options] -name <peer name>
+
+  Rotate the stream secret of a peering connection without re-establishing it.
+  This command must be run on the cluster that generated the peering token.
+  The peer cluster reconnects with the new secret, after which the previous
+  secret is no longer accepted.
+
+  Only the stream secret is rotated. The establishment secret of a peering
+  token is discarded once the peer cluster uses it. To invalidate a token that
+  has not been used yet, generate a new token for the same peer instead.
+
+  Example:
+
+    $ consul peering rotate -name west-dc
+`
+)
@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package rotate
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/sdk/testutil/retry"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+func TestRotateCommand_noTabs(t *testing.T) {
+	t.Parallel()
+
+	if strings.ContainsRune(New(cli.NewMockUi()).Help(), '\t') {
+		t.Fatal("help has tabs")
+	}
+}
+
+func TestRotateCommand(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	acceptor := agent.NewTestAgent(t, ``)
+	t.Cleanup(func() { _ = acceptor.Shutdown() })
+
+	dialer := agent.NewTestAgent(t, `datacenter = "dc2"`)
+	t.Cleanup(func() { _ = dialer.Shutdown() })
+
+	testrpc.WaitForTestAgent(t, acceptor.RPC, "dc1")
+	testrpc.WaitForTestAgent(t, dialer.RPC, "dc2")
+
+	acceptingClient := acceptor.Client()
+	dialingClient := dialer.Client()
+
+	t.Run("name is required", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		cmd := New(ui)
+
+		args := []string{
+			"-http-addr=" + acceptor.HTTPAddr(),
+		}
+
+		code := cmd.Run(args)
+		require.Equal(t, 1, code, "err: %s", ui.ErrorWriter.String())
+		require.Contains(t, ui.ErrorWriter.String(), "Missing the required -name flag")
+	})
+
+	t.Run("peering must be established", func(t *testing.T) {
+		req := api.PeeringGenerateTokenRequest{PeerName: "pending"}
+		_, _, err := acceptingClient.Peerings().GenerateToken(context.Background(), req, &api.WriteOptions{})
+		require.NoError(t, err, "Could not generate peering token at acceptor")
+
+		ui := cli.NewMockUi()
+		cmd := New(ui)
+
+		args := []string{
+			"-http-addr=" + acceptor.HTTPAddr(),
+			"-name=pending",
+		}
+
+		code := cmd.Run(args)
+		require.Equal(t, 1, code)
+		require.Contains(t, ui.ErrorWriter.String(), "has not been established yet")
+	})
+
+	t.Run("rotate secret", func(t *testing.T) {
+		req := api.PeeringGenerateTokenRequest{PeerName: "foo"}
+		res, _, err := acceptingClient.Peerings().GenerateToken(context.Background(), req, &api.WriteOptions{})
+		require.NoError(t, err, "Could not generate peering token at acceptor")
+
+		establishReq := api.PeeringEstablishRequest{
+			PeerName:     "bar",
+			PeeringToken: res.PeeringToken,
+		}
+		_, _, err = dialingClient.Peerings().Establish(context.Background(), establishReq, &api.WriteOptions{})
+		require.NoError(t, err, "Could not establish peering for dialer")
+
+		// The secret can only be rotated from the cluster that generated the token.
+		ui := cli.NewMockUi()
+		cmd := New(ui)
+
+		code := cmd.Run([]string{
+			"-http-addr=" + dialer.HTTPAddr(),
+			"-name=bar",
+		})
+		require.Equal(t, 1, code)
+		require.Contains(t, ui.ErrorWriter.String(), "must be initiated by the cluster that generated the peering token")
+
+		// The secret can be rotated once the dialer has connected with the exchanged secret.
+		retry.Run(t, func(r *retry.R) {
+			ui := cli.NewMockUi()
+			cmd := New(ui)
+
+			code := cmd.Run([]string{
+				"-http-addr=" + acceptor.HTTPAddr(),
+				"-name=foo",
+			})
+			require.Equal(r, 0, code, "err: %s", ui.ErrorWriter.String())
+			require.Contains(r, ui.OutputWriter.String(), "Success")
+		})
+
+		// The dialer reconnects with the new secret and the peering stays active.
+		retry.Run(t, func(r *retry.R) {
+			p, _, err := acceptingClient.Peerings().Read(context.Background(), "foo", &api.QueryOptions{})
+			require.NoError(r, err)
+			require.NotNil(r, p)
+			require.Equal(r, api.PeeringStateActive, p.State)
+		})
+	})
+}
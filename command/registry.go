@@ -27,6 +27,7 @@ import (
 	aclpolicy "github.com/hashicorp/consul/command/acl/policy"
 	aclpcreate "github.com/hashicorp/consul/command/acl/policy/create"
 	aclpdelete "github.com/hashicorp/consul/command/acl/policy/delete"
+	aclplint "github.com/hashicorp/consul/command/acl/policy/lint"
 	aclplist "github.com/hashicorp/consul/command/acl/policy/list"
 	aclpread "github.com/hashicorp/consul/command/acl/policy/read"
 	aclpupdate "github.com/hashicorp/consul/command/acl/policy/update"
@@ -36,6 +37,7 @@ import (
 	aclrlist "github.com/hashicorp/consul/command/acl/role/list"
 	aclrread "github.com/hashicorp/consul/command/acl/role/read"
 	aclrupdate "github.com/hashicorp/consul/command/acl/role/update"
+	aclsync "github.com/hashicorp/consul/command/acl/sync"
 	acltp "github.com/hashicorp/consul/command/acl/templatedpolicy"
 	acltplist "github.com/hashicorp/consul/command/acl/templatedpolicy/list"
 	acltppreview "github.com/hashicorp/consul/command/acl/templatedpolicy/preview"
@@ -44,6 +46,7 @@ import (
 	acltclone "github.com/hashicorp/consul/command/acl/token/clone"
 	acltcreate "github.com/hashicorp/consul/command/acl/token/create"
 	acltdelete "github.com/hashicorp/consul/command/acl/token/delete"
+	aclteffective "github.com/hashicorp/consul/command/acl/token/effective"
 	acltlist "github.com/hashicorp/consul/command/acl/token/list"
 	acltread "github.com/hashicorp/consul/command/acl/token/read"
 	acltupdate "github.com/hashicorp/consul/command/acl/token/update"
@@ -59,6 +62,7 @@ import (
 	configwrite "github.com/hashicorp/consul/command/config/write"
 	"github.com/hashicorp/consul/command/connect"
 	"github.com/hashicorp/consul/command/connect/ca"
+	cacerts "github.com/hashicorp/consul/command/connect/ca/certs"
 	caget "github.com/hashicorp/consul/command/connect/ca/get"
 	caset "github.com/hashicorp/consul/command/connect/ca/set"
 	"github.com/hashicorp/consul/command/connect/envoy"
@@ -112,6 +116,7 @@ import (
 	peergenerate "github.com/hashicorp/consul/command/peering/generate"
 	peerlist "github.com/hashicorp/consul/command/peering/list"
 	peerread "github.com/hashicorp/consul/command/peering/read"
+	peerrotate "github.com/hashicorp/consul/command/peering/rotate"
 	"github.com/hashicorp/consul/command/reload"
 	"github.com/hashicorp/consul/command/resource"
 	resourceapply "github.com/hashicorp/consul/command/resource/apply"
@@ -142,6 +147,7 @@ import (
 	troubleshootports "github.com/hashicorp/consul/command/troubleshoot/ports"
 	troubleshootproxy "github.com/hashicorp/consul/command/troubleshoot/proxy"
 	troubleshootupstreams "github.com/hashicorp/consul/command/troubleshoot/upstreams"
+	troubleshootxds "github.com/hashicorp/consul/command/troubleshoot/xds"
 	"github.com/hashicorp/consul/command/validate"
 	"github.com/hashicorp/consul/command/version"
 	"github.com/hashicorp/consul/command/watch"
@@ -164,7 +170,9 @@ func RegisteredCommands(ui cli.Ui) map[string]mcli.CommandFactory {
 		entry{"acl policy read", func(ui cli.Ui) (cli.Command, error) { return aclpread.New(ui), nil }},
 		entry{"acl policy update", func(ui cli.Ui) (cli.Command, error) { return aclpupdate.New(ui), nil }},
 		entry{"acl policy delete", func(ui cli.Ui) (cli.Command, error) { return aclpdelete.New(ui), nil }},
+		entry{"acl policy lint", func(ui cli.Ui) (cli.Command, error) { return aclplint.New(ui), nil }},
 		entry{"acl set-agent-token", func(ui cli.Ui) (cli.Command, error) { return aclagent.New(ui), nil }},
+		entry{"acl sync", func(ui cli.Ui) (cli.Command, error) { return aclsync.New(ui), nil }},
 		entry{"acl token", func(cli.Ui) (cli.Command, error) { return acltoken.New(), nil }},
 		entry{"acl token create", func(ui cli.Ui) (cli.Command, error) { return acltcreate.New(ui), nil }},
 		entry{"acl token clone", func(ui cli.Ui) (cli.Command, error) { return acltclone.New(ui), nil }},
@@ -172,6 +180,7 @@ func RegisteredCommands(ui cli.Ui) map[string]mcli.CommandFactory {
 		entry{"acl token read", func(ui cli.Ui) (cli.Command, error) { return acltread.New(ui), nil }},
 		entry{"acl token update", func(ui cli.Ui) (cli.Command, error) { return acltupdate.New(ui), nil }},
 		entry{"acl token delete", func(ui cli.Ui) (cli.Command, error) { return acltdelete.New(ui), nil }},
+		entry{"acl token effective", func(ui cli.Ui) (cli.Command, error) { return aclteffective.New(ui), nil }},
 		entry{"acl role", func(cli.Ui) (cli.Command, error) { return aclrole.New(), nil }},
 		entry{"acl role create", func(ui cli.Ui) (cli.Command, error) { return aclrcreate.New(ui), nil }},
 		entry{"acl role list", func(ui cli.Ui) (cli.Command, error) { return aclrlist.New(ui), nil }},
@@ -206,6 +215,7 @@ func RegisteredCommands(ui cli.Ui) map[string]mcli.CommandFactory {
 		entry{"config write", func(ui cli.Ui) (cli.Command, error) { return configwrite.New(ui), nil }},
 		entry{"connect", func(ui cli.Ui) (cli.Command, error) { return connect.New(), nil }},
 		entry{"connect ca", func(ui cli.Ui) (cli.Command, error) { return ca.New(), nil }},
+		entry{"connect ca certs", func(ui cli.Ui) (cli.Command, error) { return cacerts.New(ui), nil }},
 		entry{"connect ca get-config", func(ui cli.Ui) (cli.Command, error) { return caget.New(ui), nil }},
 		entry{"connect ca set-config", func(ui cli.Ui) (cli.Command, error) { return caset.New(ui), nil }},
 		entry{"connect proxy", func(ui cli.Ui) (cli.Command, error) { return proxy.New(ui, MakeShutdownCh()), nil }},
@@ -259,6 +269,7 @@ func RegisteredCommands(ui cli.Ui) map[string]mcli.CommandFactory {
 		entry{"peering establish", func(ui cli.Ui) (cli.Command, error) { return peerestablish.New(ui), nil }},
 		entry{"peering list", func(ui cli.Ui) (cli.Command, error) { return peerlist.New(ui), nil }},
 		entry{"peering read", func(ui cli.Ui) (cli.Command, error) { return peerread.New(ui), nil }},
+		entry{"peering rotate", func(ui cli.Ui) (cli.Command, error) { return peerrotate.New(ui), nil }},
 		entry{"reload", func(ui cli.Ui) (cli.Command, error) { return reload.New(ui), nil }},
 		entry{"resource", func(cli.Ui) (cli.Command, error) { return resource.New(), nil }},
 		entry{"resource read", func(ui cli.Ui) (cli.Command, error) { return resourceread.New(ui), nil }},
@@ -290,6 +301,7 @@ func RegisteredCommands(ui cli.Ui) map[string]mcli.CommandFactory {
 		entry{"troubleshoot proxy", func(ui cli.Ui) (cli.Command, error) { return troubleshootproxy.New(ui), nil }},
 		entry{"troubleshoot upstreams", func(ui cli.Ui) (cli.Command, error) { return troubleshootupstreams.New(ui), nil }},
 		entry{"troubleshoot ports", func(ui cli.Ui) (cli.Command, error) { return troubleshootports.New(ui), nil }},
+		entry{"troubleshoot xds", func(ui cli.Ui) (cli.Command, error) { return troubleshootxds.New(ui), nil }},
 		entry{"validate", func(ui cli.Ui) (cli.Command, error) { return validate.New(ui), nil }},
 		entry{"version", func(ui cli.Ui) (cli.Command, error) { return version.New(ui), nil }},
 		entry{"watch", func(ui cli.Ui) (cli.Command, error) { return watch.New(ui, MakeShutdownCh()), nil }},
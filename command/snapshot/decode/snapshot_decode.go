@@ -27,33 +27,34 @@ import (
 )
 
 var requestTypeZeroValues = map[structs.MessageType]func() any{
-	structs.RegisterRequestType:          func() any { return new(structs.RegisterRequest) },
-	structs.KVSRequestType:               func() any { return new(structs.KVSRequest) },
-	structs.SessionRequestType:           func() any { return new(structs.SessionRequest) },
-	structs.TombstoneRequestType:         func() any { return new(structs.TombstoneRequest) },
-	structs.CoordinateBatchUpdateType:    func() any { return new(structs.Coordinates) },
-	structs.PreparedQueryRequestType:     func() any { return new(structs.PreparedQueryRequest) },
-	structs.AutopilotRequestType:         func() any { return new(structs.AutopilotSetConfigRequest) },
-	structs.IntentionRequestType:         func() any { return new(structs.IntentionRequest) },
-	structs.ConnectCARequestType:         func() any { return new(structs.CARequest) },
-	structs.ConnectCAProviderStateType:   func() any { return new(structs.CAConsulProviderState) },
-	structs.ConnectCAConfigType:          func() any { return new(structs.CAConfiguration) },
-	structs.IndexRequestType:             func() any { return new(state.IndexEntry) },
-	structs.ACLTokenSetRequestType:       func() any { return new(structs.ACLToken) },
-	structs.ACLPolicySetRequestType:      func() any { return new(structs.ACLPolicy) },
-	structs.ConfigEntryRequestType:       func() any { return new(structs.ConfigEntryRequest) },
-	structs.ACLRoleSetRequestType:        func() any { return new(structs.ACLRole) },
-	structs.ACLBindingRuleSetRequestType: func() any { return new(structs.ACLBindingRule) },
-	structs.ACLAuthMethodSetRequestType:  func() any { return new(structs.ACLAuthMethod) },
-	structs.ChunkingStateType:            func() any { return new(raftchunking.State) },
-	structs.FederationStateRequestType:   func() any { return new(structs.FederationStateRequest) },
-	structs.SystemMetadataRequestType:    func() any { return new(structs.SystemMetadataEntry) },
-	structs.ServiceVirtualIPRequestType:  func() any { return new(state.ServiceVirtualIP) },
-	structs.FreeVirtualIPRequestType:     func() any { return new(state.FreeVirtualIP) },
-	structs.PeeringWriteType:             func() any { return new(pbpeering.Peering) },
-	structs.PeeringTrustBundleWriteType:  func() any { return new(pbpeering.PeeringTrustBundle) },
-	structs.PeeringSecretsWriteType:      func() any { return new(pbpeering.PeeringSecrets) },
-	structs.ResourceOperationType:        func() any { return new(pbresource.Resource) },
+	structs.RegisterRequestType:           func() any { return new(structs.RegisterRequest) },
+	structs.KVSRequestType:                func() any { return new(structs.KVSRequest) },
+	structs.SessionRequestType:            func() any { return new(structs.SessionRequest) },
+	structs.TombstoneRequestType:          func() any { return new(structs.TombstoneRequest) },
+	structs.CoordinateBatchUpdateType:     func() any { return new(structs.Coordinates) },
+	structs.PreparedQueryRequestType:      func() any { return new(structs.PreparedQueryRequest) },
+	structs.AutopilotRequestType:          func() any { return new(structs.AutopilotSetConfigRequest) },
+	structs.IntentionRequestType:          func() any { return new(structs.IntentionRequest) },
+	structs.ConnectCARequestType:          func() any { return new(structs.CARequest) },
+	structs.ConnectCAProviderStateType:    func() any { return new(structs.CAConsulProviderState) },
+	structs.ConnectCAConfigType:           func() any { return new(structs.CAConfiguration) },
+	structs.IndexRequestType:              func() any { return new(state.IndexEntry) },
+	structs.ACLTokenSetRequestType:        func() any { return new(structs.ACLToken) },
+	structs.ACLPolicySetRequestType:       func() any { return new(structs.ACLPolicy) },
+	structs.ConfigEntryRequestType:        func() any { return new(structs.ConfigEntryRequest) },
+	structs.ACLRoleSetRequestType:         func() any { return new(structs.ACLRole) },
+	structs.ACLBindingRuleSetRequestType:  func() any { return new(structs.ACLBindingRule) },
+	structs.ACLAuthMethodSetRequestType:   func() any { return new(structs.ACLAuthMethod) },
+	structs.ChunkingStateType:             func() any { return new(raftchunking.State) },
+	structs.FederationStateRequestType:    func() any { return new(structs.FederationStateRequest) },
+	structs.SystemMetadataRequestType:     func() any { return new(structs.SystemMetadataEntry) },
+	structs.ServiceVirtualIPRequestType:   func() any { return new(state.ServiceVirtualIP) },
+	structs.FreeVirtualIPRequestType:      func() any { return new(state.FreeVirtualIP) },
+	structs.PeeringWriteType:              func() any { return new(pbpeering.Peering) },
+	structs.PeeringTrustBundleWriteType:   func() any { return new(pbpeering.PeeringTrustBundle) },
+	structs.PeeringConfigEntriesWriteType: func() any { return new(pbpeering.PeeringConfigEntries) },
+	structs.PeeringSecretsWriteType:       func() any { return new(pbpeering.PeeringSecrets) },
+	structs.ResourceOperationType:         func() any { return new(pbresource.Resource) },
 }
 
 func New(ui cli.Ui) *cmd {
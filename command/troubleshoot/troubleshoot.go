@@ -43,5 +43,9 @@ Usage: consul troubleshoot <subcommand> [options]
 
     $ consul troubleshoot proxy -upstream [options]
 
+  Troubleshoot xDS Responses Sent to a Proxy
+
+    $ consul troubleshoot xds -proxy-id <proxy service ID>
+
   For more examples, ask for subcommand help or view the documentation.
 `
@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package xds
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/command/cli"
+	"github.com/hashicorp/consul/command/flags"
+)
+
+const (
+	formatPretty = "pretty"
+	formatJSON   = "json"
+)
+
+func New(ui cli.Ui) *cmd {
+	c := &cmd{UI: ui}
+	c.init()
+	return c
+}
+
+type cmd struct {
+	UI    cli.Ui
+	flags *flag.FlagSet
+	http  *flags.HTTPFlags
+	help  string
+
+	// flags
+	proxyID string
+	limit   int
+	format  string
+}
+
+func (c *cmd) init() {
+	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+
+	c.flags.StringVar(&c.proxyID, "proxy-id", "", "(Required) The service ID of the proxy.")
+	c.flags.IntVar(&c.limit, "limit", 10, "The number of most recent xDS responses to show. Set to 0 to show all of them.")
+	c.flags.StringVar(&c.format, "format", formatPretty,
+		fmt.Sprintf("Output format {%s|%s} (default: %s)", formatPretty, formatJSON, formatPretty))
+
+	c.http = &flags.HTTPFlags{}
+	flags.Merge(c.flags, c.http.ClientFlags())
+	flags.Merge(c.flags, c.http.MultiTenancyFlags())
+	c.help = flags.Usage(help, c.flags)
+}
+
+func (c *cmd) Run(args []string) int {
+	if err := c.flags.Parse(args); err != nil {
+		c.UI.Error(fmt.Sprintf("Failed to parse args: %v", err))
+		return 1
+	}
+
+	if c.proxyID == "" {
+		c.UI.Error("Missing the required -proxy-id flag")
+		return 1
+	}
+	if c.format != formatPretty && c.format != formatJSON {
+		c.UI.Error(fmt.Sprintf("Invalid format, valid formats are {%s|%s}", formatPretty, formatJSON))
+		return 1
+	}
+	if c.limit < 0 {
+		c.UI.Error("The -limit flag must not be negative")
+		return 1
+	}
+
+	client, err := c.http.APIClient()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error connecting to Consul agent: %s", err))
+		return 1
+	}
+
+	history, err := client.Agent().XDSHistory(c.proxyID, c.limit, nil)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error fetching xDS history: %s", err))
+		return 1
+	}
+
+	if c.format == formatJSON {
+		out, err := json.MarshalIndent(history, "", "  ")
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error marshalling JSON: %s", err))
+			return 1
+		}
+		c.UI.Output(string(out))
+		return 0
+	}
+
+	if len(history) == 0 {
+		c.UI.Info(fmt.Sprintf("No xDS responses were sent to %s", c.proxyID))
+		return 0
+	}
+	c.UI.Output(formatHistory(history))
+	return 0
+}
+
+// formatHistory renders each push with one line per changed path. The
+// content of newly added resources is not shown since it can be large; use
+// the JSON format to see it.
+func formatHistory(history []api.AgentXDSPush) string {
+	var buf bytes.Buffer
+	for i, push := range history {
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+
+		typeName := push.TypeURL[strings.LastIndex(push.TypeURL, ".")+1:]
+		buf.WriteString(fmt.Sprintf("%s  %s  nonce=%s  status=%s\n",
+			push.Time.Format(time.RFC3339), typeName, push.Nonce, push.Status))
+		if push.ErrorDetail != "" {
+			buf.WriteString(fmt.Sprintf("  error: %s\n", push.ErrorDetail))
+		}
+
+		for _, res := range push.Resources {
+			if len(res.Diff) == 1 && res.Diff[0].Op == "add" && res.Diff[0].Path == "" {
+				buf.WriteString(fmt.Sprintf("  + %s (version %s)\n", res.Name, res.Version))
+				continue
+			}
+
+			buf.WriteString(fmt.Sprintf("  ~ %s (version %s)\n", res.Name, res.Version))
+			for _, op := range res.Diff {
+				switch op.Op {
+				case "add":
+					buf.WriteString(fmt.Sprintf("      + %s: %s\n", op.Path, formatValue(op.New)))
+				case "remove":
+					buf.WriteString(fmt.Sprintf("      - %s: %s\n", op.Path, formatValue(op.Old)))
+				default:
+					buf.WriteString(fmt.Sprintf("      ~ %s: %s -> %s\n", op.Path, formatValue(op.Old), formatValue(op.New)))
+				}
+			}
+		}
+
+		for _, name := range push.Removed {
+			buf.WriteString(fmt.Sprintf("  - %s\n", name))
+		}
+	}
+	return buf.String()
+}
+
+func formatValue(v interface{}) string {
+	out, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(out)
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return c.help
+}
+
+const (
+	synopsis = "Show the xDS responses recently sent to a proxy"
+	help     = `
+Usage: consul troubleshoot xds -proxy-id <proxy service ID> [options]
+
+  Shows the most recent xDS responses the agent sent to a proxy, whether the
+  proxy accepted or rejected each of them, and the changes made to every
+  resource since it was previously sent. Private keys are redacted.
+
+  The command must be run against the agent that the proxy receives its
+  configuration from.
+
+    $ consul troubleshoot xds -proxy-id web-sidecar-proxy
+
+  Use the JSON format to include the full content of new resources:
+
+    $ consul troubleshoot xds -proxy-id web-sidecar-proxy -format json
+`
+)
@@ -498,6 +498,37 @@ func InsertHTTPFilter(filters []*envoy_listener_v3.Filter, filter *envoy_http_v3
 	return filtersCopy, nil
 }
 
+// PatchInlineRouteConfiguration calls patch with the route configuration defined inline in the
+// HttpConnectionManager from the list of network filters.
+//
+// It returns the modified list of filters including the updated HttpConnectionManager. The filters
+// are returned unmodified if the HttpConnectionManager fetches its routes with RDS, in which case
+// the route configuration must be patched with PatchRoute instead.
+func PatchInlineRouteConfiguration(filters []*envoy_listener_v3.Filter, patch func(*envoy_route_v3.RouteConfiguration) error) ([]*envoy_listener_v3.Filter, error) {
+	httpConnMgr, idx, err := GetHTTPConnectionManager(filters...)
+	if err != nil {
+		return filters, err
+	}
+
+	routeConfig := httpConnMgr.GetRouteConfig()
+	if routeConfig == nil {
+		return filters, nil
+	}
+	if err := patch(routeConfig); err != nil {
+		return filters, err
+	}
+
+	newHttpConMan, err := MakeFilter("envoy.filters.network.http_connection_manager", httpConnMgr)
+	if err != nil {
+		return filters, errors.New("failed to patch HTTP connection manager route configuration")
+	}
+	filtersCopy := make([]*envoy_listener_v3.Filter, len(filters))
+	copy(filtersCopy, filters)
+	filtersCopy[idx] = newHttpConMan
+
+	return filtersCopy, nil
+}
+
 // InsertNetworkFilter inserts the given network filter into the filter chain in the location
 // determined by the insert options.
 //
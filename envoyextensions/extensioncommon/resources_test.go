@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	envoy_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoy_http_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	"github.com/stretchr/testify/require"
 )
@@ -150,6 +151,59 @@ func TestInsertFilter(t *testing.T) {
 	}
 }
 
+func TestPatchInlineRouteConfiguration(t *testing.T) {
+	setName := func(rc *envoy_route_v3.RouteConfiguration) error {
+		rc.Name = "patched"
+		return nil
+	}
+
+	t.Run("inline", func(t *testing.T) {
+		httpConMgr := &envoy_http_v3.HttpConnectionManager{
+			RouteSpecifier: &envoy_http_v3.HttpConnectionManager_RouteConfig{
+				RouteConfig: &envoy_route_v3.RouteConfiguration{Name: "public_listener"},
+			},
+		}
+		filter, err := MakeFilter("envoy.filters.network.http_connection_manager", httpConMgr)
+		require.NoError(t, err)
+
+		filters := []*envoy_listener_v3.Filter{{Name: "a"}, filter}
+		obsFilters, err := PatchInlineRouteConfiguration(filters, setName)
+		require.NoError(t, err)
+		require.Len(t, obsFilters, 2)
+		require.Equal(t, "a", obsFilters[0].Name)
+
+		obsHttpConMgr, idx, err := GetHTTPConnectionManager(obsFilters...)
+		require.NoError(t, err)
+		require.Equal(t, 1, idx)
+		require.Equal(t, "patched", obsHttpConMgr.GetRouteConfig().Name)
+
+		// The original filter list is not modified.
+		origHttpConMgr, _, err := GetHTTPConnectionManager(filters...)
+		require.NoError(t, err)
+		require.Equal(t, "public_listener", origHttpConMgr.GetRouteConfig().Name)
+	})
+
+	t.Run("rds", func(t *testing.T) {
+		httpConMgr := &envoy_http_v3.HttpConnectionManager{
+			RouteSpecifier: &envoy_http_v3.HttpConnectionManager_Rds{
+				Rds: &envoy_http_v3.Rds{RouteConfigName: "db"},
+			},
+		}
+		filter, err := MakeFilter("envoy.filters.network.http_connection_manager", httpConMgr)
+		require.NoError(t, err)
+
+		filters := []*envoy_listener_v3.Filter{filter}
+		obsFilters, err := PatchInlineRouteConfiguration(filters, setName)
+		require.NoError(t, err)
+		require.Equal(t, filters, obsFilters)
+	})
+
+	t.Run("no connection manager", func(t *testing.T) {
+		_, err := PatchInlineRouteConfiguration(makeFilters(t, "a"), setName)
+		require.Error(t, err)
+	})
+}
+
 func makeHttpConMgr(t *testing.T, filters []*envoy_http_v3.HttpFilter) *envoy_listener_v3.Filter {
 	t.Helper()
 	httpConMgr := &envoy_http_v3.HttpConnectionManager{HttpFilters: filters}
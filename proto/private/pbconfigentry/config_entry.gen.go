@@ -4,6 +4,78 @@ package pbconfigentry
 
 import "github.com/hashicorp/consul/agent/structs"
 
+func ACMECertificateToStructs(s *ACMECertificate, t *structs.ACMECertificateConfigEntry) {
+	if s == nil {
+		return
+	}
+	t.DirectoryURL = s.DirectoryURL
+	t.DirectoryCACertificate = s.DirectoryCACertificate
+	t.Email = s.Email
+	t.Hostnames = s.Hostnames
+	t.RenewBefore = structs.DurationFromProto(s.RenewBefore)
+	t.Certificate = s.Certificate
+	t.PrivateKey = s.PrivateKey
+	t.AccountKey = s.AccountKey
+	{
+		t.Challenges = make([]structs.ACMEChallenge, len(s.Challenges))
+		for i := range s.Challenges {
+			if s.Challenges[i] != nil {
+				ACMEChallengeToStructs(s.Challenges[i], &t.Challenges[i])
+			}
+		}
+	}
+	t.Meta = s.Meta
+	if s.Status != nil {
+		StatusToStructs(s.Status, &t.Status)
+	}
+	t.Hash = s.Hash
+}
+func ACMECertificateFromStructs(t *structs.ACMECertificateConfigEntry, s *ACMECertificate) {
+	if s == nil {
+		return
+	}
+	s.DirectoryURL = t.DirectoryURL
+	s.DirectoryCACertificate = t.DirectoryCACertificate
+	s.Email = t.Email
+	s.Hostnames = t.Hostnames
+	s.RenewBefore = structs.DurationToProto(t.RenewBefore)
+	s.Certificate = t.Certificate
+	s.PrivateKey = t.PrivateKey
+	s.AccountKey = t.AccountKey
+	{
+		s.Challenges = make([]*ACMEChallenge, len(t.Challenges))
+		for i := range t.Challenges {
+			{
+				var x ACMEChallenge
+				ACMEChallengeFromStructs(&t.Challenges[i], &x)
+				s.Challenges[i] = &x
+			}
+		}
+	}
+	s.Meta = t.Meta
+	{
+		var x Status
+		StatusFromStructs(&t.Status, &x)
+		s.Status = &x
+	}
+	s.Hash = t.Hash
+}
+func ACMEChallengeToStructs(s *ACMEChallenge, t *structs.ACMEChallenge) {
+	if s == nil {
+		return
+	}
+	t.Hostname = s.Hostname
+	t.Token = s.Token
+	t.KeyAuthorization = s.KeyAuthorization
+}
+func ACMEChallengeFromStructs(t *structs.ACMEChallenge, s *ACMEChallenge) {
+	if s == nil {
+		return
+	}
+	s.Hostname = t.Hostname
+	s.Token = t.Token
+	s.KeyAuthorization = t.KeyAuthorization
+}
 func APIGatewayToStructs(s *APIGateway, t *structs.APIGatewayConfigEntry) {
 	if s == nil {
 		return
@@ -290,6 +362,14 @@ func ExportedServicesToStructs(s *ExportedServices, t *structs.ExportedServicesC
 			}
 		}
 	}
+	{
+		t.KeyPrefixes = make([]structs.ExportedKeyPrefix, len(s.KeyPrefixes))
+		for i := range s.KeyPrefixes {
+			if s.KeyPrefixes[i] != nil {
+				ExportedServicesKeyPrefixToStructs(s.KeyPrefixes[i], &t.KeyPrefixes[i])
+			}
+		}
+	}
 	t.Meta = s.Meta
 	t.Hash = s.Hash
 }
@@ -307,6 +387,16 @@ func ExportedServicesFromStructs(t *structs.ExportedServicesConfigEntry, s *Expo
 			}
 		}
 	}
+	{
+		s.KeyPrefixes = make([]*ExportedServicesKeyPrefix, len(t.KeyPrefixes))
+		for i := range t.KeyPrefixes {
+			{
+				var x ExportedServicesKeyPrefix
+				ExportedServicesKeyPrefixFromStructs(&t.KeyPrefixes[i], &x)
+				s.KeyPrefixes[i] = &x
+			}
+		}
+	}
 	s.Meta = t.Meta
 	s.Hash = t.Hash
 }
@@ -326,6 +416,36 @@ func ExportedServicesConsumerFromStructs(t *structs.ServiceConsumer, s *Exported
 	s.Peer = t.Peer
 	s.SamenessGroup = t.SamenessGroup
 }
+func ExportedServicesKeyPrefixToStructs(s *ExportedServicesKeyPrefix, t *structs.ExportedKeyPrefix) {
+	if s == nil {
+		return
+	}
+	t.Prefix = s.Prefix
+	{
+		t.Consumers = make([]structs.ServiceConsumer, len(s.Consumers))
+		for i := range s.Consumers {
+			if s.Consumers[i] != nil {
+				ExportedServicesConsumerToStructs(s.Consumers[i], &t.Consumers[i])
+			}
+		}
+	}
+}
+func ExportedServicesKeyPrefixFromStructs(t *structs.ExportedKeyPrefix, s *ExportedServicesKeyPrefix) {
+	if s == nil {
+		return
+	}
+	s.Prefix = t.Prefix
+	{
+		s.Consumers = make([]*ExportedServicesConsumer, len(t.Consumers))
+		for i := range t.Consumers {
+			{
+				var x ExportedServicesConsumer
+				ExportedServicesConsumerFromStructs(&t.Consumers[i], &x)
+				s.Consumers[i] = &x
+			}
+		}
+	}
+}
 func ExportedServicesServiceToStructs(s *ExportedServicesService, t *structs.ExportedService) {
 	if s == nil {
 		return
@@ -340,6 +460,7 @@ func ExportedServicesServiceToStructs(s *ExportedServicesService, t *structs.Exp
 			}
 		}
 	}
+	t.ConfigEntries = s.ConfigEntries
 }
 func ExportedServicesServiceFromStructs(t *structs.ExportedService, s *ExportedServicesService) {
 	if s == nil {
@@ -357,6 +478,7 @@ func ExportedServicesServiceFromStructs(t *structs.ExportedService, s *ExportedS
 			}
 		}
 	}
+	s.ConfigEntries = t.ConfigEntries
 }
 func ExposeConfigToStructs(s *ExposeConfig, t *structs.ExposeConfig) {
 	if s == nil {
@@ -408,6 +530,36 @@ func ExposePathFromStructs(t *structs.ExposePath, s *ExposePath) {
 	s.Protocol = t.Protocol
 	s.ParsedFromCheck = t.ParsedFromCheck
 }
+func FaultInjectionAbortToStructs(s *FaultInjectionAbort, t *structs.FaultInjectionAbort) {
+	if s == nil {
+		return
+	}
+	t.HTTPStatus = s.HTTPStatus
+	t.GRPCStatus = s.GRPCStatus
+	t.Percentage = s.Percentage
+}
+func FaultInjectionAbortFromStructs(t *structs.FaultInjectionAbort, s *FaultInjectionAbort) {
+	if s == nil {
+		return
+	}
+	s.HTTPStatus = t.HTTPStatus
+	s.GRPCStatus = t.GRPCStatus
+	s.Percentage = t.Percentage
+}
+func FaultInjectionDelayToStructs(s *FaultInjectionDelay, t *structs.FaultInjectionDelay) {
+	if s == nil {
+		return
+	}
+	t.FixedDelay = structs.DurationFromProto(s.FixedDelay)
+	t.Percentage = s.Percentage
+}
+func FaultInjectionDelayFromStructs(t *structs.FaultInjectionDelay, s *FaultInjectionDelay) {
+	if s == nil {
+		return
+	}
+	s.FixedDelay = structs.DurationToProto(t.FixedDelay)
+	s.Percentage = t.Percentage
+}
 func FileSystemCertificateToStructs(s *FileSystemCertificate, t *structs.FileSystemCertificateConfigEntry) {
 	if s == nil {
 		return
@@ -426,6 +578,180 @@ func FileSystemCertificateFromStructs(t *structs.FileSystemCertificateConfigEntr
 	s.Meta = t.Meta
 	s.Hash = t.Hash
 }
+func GRPCMatchToStructs(s *GRPCMatch, t *structs.GRPCMatch) {
+	if s == nil {
+		return
+	}
+	if s.Method != nil {
+		GRPCMethodMatchToStructs(s.Method, &t.Method)
+	}
+	{
+		t.Headers = make([]structs.HTTPHeaderMatch, len(s.Headers))
+		for i := range s.Headers {
+			if s.Headers[i] != nil {
+				HTTPHeaderMatchToStructs(s.Headers[i], &t.Headers[i])
+			}
+		}
+	}
+}
+func GRPCMatchFromStructs(t *structs.GRPCMatch, s *GRPCMatch) {
+	if s == nil {
+		return
+	}
+	{
+		var x GRPCMethodMatch
+		GRPCMethodMatchFromStructs(&t.Method, &x)
+		s.Method = &x
+	}
+	{
+		s.Headers = make([]*HTTPHeaderMatch, len(t.Headers))
+		for i := range t.Headers {
+			{
+				var x HTTPHeaderMatch
+				HTTPHeaderMatchFromStructs(&t.Headers[i], &x)
+				s.Headers[i] = &x
+			}
+		}
+	}
+}
+func GRPCMethodMatchToStructs(s *GRPCMethodMatch, t *structs.GRPCMethodMatch) {
+	if s == nil {
+		return
+	}
+	t.Match = grpcMethodMatchToStructs(s.Match)
+	t.Service = s.Service
+	t.Method = s.Method
+}
+func GRPCMethodMatchFromStructs(t *structs.GRPCMethodMatch, s *GRPCMethodMatch) {
+	if s == nil {
+		return
+	}
+	s.Match = grpcMethodMatchFromStructs(t.Match)
+	s.Service = t.Service
+	s.Method = t.Method
+}
+func GRPCRouteToStructs(s *GRPCRoute, t *structs.GRPCRouteConfigEntry) {
+	if s == nil {
+		return
+	}
+	{
+		t.Parents = make([]structs.ResourceReference, len(s.Parents))
+		for i := range s.Parents {
+			if s.Parents[i] != nil {
+				ResourceReferenceToStructs(s.Parents[i], &t.Parents[i])
+			}
+		}
+	}
+	{
+		t.Rules = make([]structs.GRPCRouteRule, len(s.Rules))
+		for i := range s.Rules {
+			if s.Rules[i] != nil {
+				GRPCRouteRuleToStructs(s.Rules[i], &t.Rules[i])
+			}
+		}
+	}
+	t.Hostnames = s.Hostnames
+	t.Meta = s.Meta
+	if s.Status != nil {
+		StatusToStructs(s.Status, &t.Status)
+	}
+	t.Hash = s.Hash
+}
+func GRPCRouteFromStructs(t *structs.GRPCRouteConfigEntry, s *GRPCRoute) {
+	if s == nil {
+		return
+	}
+	{
+		s.Parents = make([]*ResourceReference, len(t.Parents))
+		for i := range t.Parents {
+			{
+				var x ResourceReference
+				ResourceReferenceFromStructs(&t.Parents[i], &x)
+				s.Parents[i] = &x
+			}
+		}
+	}
+	{
+		s.Rules = make([]*GRPCRouteRule, len(t.Rules))
+		for i := range t.Rules {
+			{
+				var x GRPCRouteRule
+				GRPCRouteRuleFromStructs(&t.Rules[i], &x)
+				s.Rules[i] = &x
+			}
+		}
+	}
+	s.Hostnames = t.Hostnames
+	s.Meta = t.Meta
+	{
+		var x Status
+		StatusFromStructs(&t.Status, &x)
+		s.Status = &x
+	}
+	s.Hash = t.Hash
+}
+func GRPCRouteRuleToStructs(s *GRPCRouteRule, t *structs.GRPCRouteRule) {
+	if s == nil {
+		return
+	}
+	if s.Filters != nil {
+		HTTPFiltersToStructs(s.Filters, &t.Filters)
+	}
+	if s.ResponseFilters != nil {
+		HTTPResponseFiltersToStructs(s.ResponseFilters, &t.ResponseFilters)
+	}
+	{
+		t.Matches = make([]structs.GRPCMatch, len(s.Matches))
+		for i := range s.Matches {
+			if s.Matches[i] != nil {
+				GRPCMatchToStructs(s.Matches[i], &t.Matches[i])
+			}
+		}
+	}
+	{
+		t.Services = make([]structs.HTTPService, len(s.Services))
+		for i := range s.Services {
+			if s.Services[i] != nil {
+				HTTPServiceToStructs(s.Services[i], &t.Services[i])
+			}
+		}
+	}
+}
+func GRPCRouteRuleFromStructs(t *structs.GRPCRouteRule, s *GRPCRouteRule) {
+	if s == nil {
+		return
+	}
+	{
+		var x HTTPFilters
+		HTTPFiltersFromStructs(&t.Filters, &x)
+		s.Filters = &x
+	}
+	{
+		var x HTTPResponseFilters
+		HTTPResponseFiltersFromStructs(&t.ResponseFilters, &x)
+		s.ResponseFilters = &x
+	}
+	{
+		s.Matches = make([]*GRPCMatch, len(t.Matches))
+		for i := range t.Matches {
+			{
+				var x GRPCMatch
+				GRPCMatchFromStructs(&t.Matches[i], &x)
+				s.Matches[i] = &x
+			}
+		}
+	}
+	{
+		s.Services = make([]*HTTPService, len(t.Services))
+		for i := range t.Services {
+			{
+				var x HTTPService
+				HTTPServiceFromStructs(&t.Services[i], &x)
+				s.Services[i] = &x
+			}
+		}
+	}
+}
 func GatewayServiceTLSConfigToStructs(s *GatewayServiceTLSConfig, t *structs.GatewayServiceTLSConfig) {
 	if s == nil {
 		return
@@ -459,6 +785,7 @@ func GatewayTLSConfigToStructs(s *GatewayTLSConfig, t *structs.GatewayTLSConfig)
 	t.TLSMinVersion = tlsVersionToStructs(s.TLSMinVersion)
 	t.TLSMaxVersion = tlsVersionToStructs(s.TLSMaxVersion)
 	t.CipherSuites = cipherSuitesToStructs(s.CipherSuites)
+	t.ACMECertificate = s.ACMECertificate
 }
 func GatewayTLSConfigFromStructs(t *structs.GatewayTLSConfig, s *GatewayTLSConfig) {
 	if s == nil {
@@ -473,6 +800,7 @@ func GatewayTLSConfigFromStructs(t *structs.GatewayTLSConfig, s *GatewayTLSConfi
 	s.TLSMinVersion = tlsVersionFromStructs(t.TLSMinVersion)
 	s.TLSMaxVersion = tlsVersionFromStructs(t.TLSMaxVersion)
 	s.CipherSuites = cipherSuitesFromStructs(t.CipherSuites)
+	s.ACMECertificate = t.ACMECertificate
 }
 func GatewayTLSSDSConfigToStructs(s *GatewayTLSSDSConfig, t *structs.GatewayTLSSDSConfig) {
 	if s == nil {
@@ -1630,6 +1958,22 @@ func JWTProviderFromStructs(t *structs.JWTProviderConfigEntry, s *JWTProvider) {
 	s.Meta = t.Meta
 	s.Hash = t.Hash
 }
+func LeafCertConfigToStructs(s *LeafCertConfig, t *structs.LeafCertConfig) {
+	if s == nil {
+		return
+	}
+	t.PrivateKeyType = s.PrivateKeyType
+	t.PrivateKeyBits = int(s.PrivateKeyBits)
+	t.TTL = structs.DurationFromProto(s.TTL)
+}
+func LeafCertConfigFromStructs(t *structs.LeafCertConfig, s *LeafCertConfig) {
+	if s == nil {
+		return
+	}
+	s.PrivateKeyType = t.PrivateKeyType
+	s.PrivateKeyBits = int32(t.PrivateKeyBits)
+	s.TTL = structs.DurationToProto(t.TTL)
+}
 func LeastRequestConfigToStructs(s *LeastRequestConfig, t *structs.LeastRequestConfig) {
 	if s == nil {
 		return
@@ -1862,7 +2206,27 @@ func MeshTLSConfigFromStructs(t *structs.MeshTLSConfig, s *MeshTLSConfig) {
 		s.Outgoing = &x
 	}
 }
-func PassiveHealthCheckToStructs(s *PassiveHealthCheck, t *structs.PassiveHealthCheck) {
+func MirrorPolicyToStructs(s *MirrorPolicy, t *structs.MirrorPolicy) {
+	if s == nil {
+		return
+	}
+	t.Service = s.Service
+	t.ServiceSubset = s.ServiceSubset
+	t.Namespace = s.Namespace
+	t.Partition = s.Partition
+	t.Percentage = s.Percentage
+}
+func MirrorPolicyFromStructs(t *structs.MirrorPolicy, s *MirrorPolicy) {
+	if s == nil {
+		return
+	}
+	s.Service = t.Service
+	s.ServiceSubset = t.ServiceSubset
+	s.Namespace = t.Namespace
+	s.Partition = t.Partition
+	s.Percentage = t.Percentage
+}
+func PassiveHealthCheckToStructs(s *PassiveHealthCheck, t *structs.PassiveHealthCheck) {
 	if s == nil {
 		return
 	}
@@ -1986,6 +2350,20 @@ func ResourceReferenceFromStructs(t *structs.ResourceReference, s *ResourceRefer
 	s.SectionName = t.SectionName
 	s.EnterpriseMeta = enterpriseMetaFromStructs(t.EnterpriseMeta)
 }
+func RetryBudgetToStructs(s *RetryBudget, t *structs.RetryBudget) {
+	if s == nil {
+		return
+	}
+	t.BudgetPercent = s.BudgetPercent
+	t.MinRetryConcurrency = s.MinRetryConcurrency
+}
+func RetryBudgetFromStructs(t *structs.RetryBudget, s *RetryBudget) {
+	if s == nil {
+		return
+	}
+	s.BudgetPercent = t.BudgetPercent
+	s.MinRetryConcurrency = t.MinRetryConcurrency
+}
 func RetryFilterToStructs(s *RetryFilter, t *structs.RetryFilter) {
 	if s == nil {
 		return
@@ -2123,6 +2501,11 @@ func ServiceDefaultsToStructs(s *ServiceDefaults, t *structs.ServiceConfigEntry)
 		t.RateLimits = &x
 	}
 	t.EnvoyExtensions = EnvoyExtensionsToStructs(s.EnvoyExtensions)
+	if s.LeafCert != nil {
+		var x structs.LeafCertConfig
+		LeafCertConfigToStructs(s.LeafCert, &x)
+		t.LeafCert = &x
+	}
 	t.Meta = s.Meta
 	t.Hash = s.Hash
 }
@@ -2169,6 +2552,11 @@ func ServiceDefaultsFromStructs(t *structs.ServiceConfigEntry, s *ServiceDefault
 		s.RateLimits = &x
 	}
 	s.EnvoyExtensions = EnvoyExtensionsFromStructs(t.EnvoyExtensions)
+	if t.LeafCert != nil {
+		var x LeafCertConfig
+		LeafCertConfigFromStructs(t.LeafCert, &x)
+		s.LeafCert = &x
+	}
 	s.Meta = t.Meta
 	s.Hash = t.Hash
 }
@@ -2253,6 +2641,11 @@ func ServiceResolverToStructs(s *ServiceResolver, t *structs.ServiceResolverConf
 	}
 	t.ConnectTimeout = structs.DurationFromProto(s.ConnectTimeout)
 	t.RequestTimeout = structs.DurationFromProto(s.RequestTimeout)
+	if s.RetryPolicy != nil {
+		var x structs.ServiceResolverRetryPolicy
+		ServiceResolverRetryPolicyToStructs(s.RetryPolicy, &x)
+		t.RetryPolicy = &x
+	}
 	if s.LoadBalancer != nil {
 		var x structs.LoadBalancer
 		LoadBalancerToStructs(s.LoadBalancer, &x)
@@ -2302,6 +2695,11 @@ func ServiceResolverFromStructs(t *structs.ServiceResolverConfigEntry, s *Servic
 	}
 	s.ConnectTimeout = structs.DurationToProto(t.ConnectTimeout)
 	s.RequestTimeout = structs.DurationToProto(t.RequestTimeout)
+	if t.RetryPolicy != nil {
+		var x ServiceResolverRetryPolicy
+		ServiceResolverRetryPolicyFromStructs(t.RetryPolicy, &x)
+		s.RetryPolicy = &x
+	}
 	if t.LoadBalancer != nil {
 		var x LoadBalancer
 		LoadBalancerFromStructs(t.LoadBalancer, &x)
@@ -2430,6 +2828,58 @@ func ServiceResolverRedirectFromStructs(t *structs.ServiceResolverRedirect, s *S
 	s.Peer = t.Peer
 	s.SamenessGroup = t.SamenessGroup
 }
+func ServiceResolverRetryPolicyToStructs(s *ServiceResolverRetryPolicy, t *structs.ServiceResolverRetryPolicy) {
+	if s == nil {
+		return
+	}
+	t.NumRetries = s.NumRetries
+	t.RetryOnConnectFailure = s.RetryOnConnectFailure
+	t.RetryOn = s.RetryOn
+	t.RetryOnStatusCodes = s.RetryOnStatusCodes
+	t.RetryBaseInterval = structs.DurationFromProto(s.RetryBaseInterval)
+	t.RetryMaxInterval = structs.DurationFromProto(s.RetryMaxInterval)
+	t.PerTryTimeout = structs.DurationFromProto(s.PerTryTimeout)
+	{
+		t.RetriableHeaders = make([]structs.ServiceRouteHTTPMatchHeader, len(s.RetriableHeaders))
+		for i := range s.RetriableHeaders {
+			if s.RetriableHeaders[i] != nil {
+				ServiceRouteHTTPMatchHeaderToStructs(s.RetriableHeaders[i], &t.RetriableHeaders[i])
+			}
+		}
+	}
+	if s.RetryBudget != nil {
+		var x structs.RetryBudget
+		RetryBudgetToStructs(s.RetryBudget, &x)
+		t.RetryBudget = &x
+	}
+}
+func ServiceResolverRetryPolicyFromStructs(t *structs.ServiceResolverRetryPolicy, s *ServiceResolverRetryPolicy) {
+	if s == nil {
+		return
+	}
+	s.NumRetries = t.NumRetries
+	s.RetryOnConnectFailure = t.RetryOnConnectFailure
+	s.RetryOn = t.RetryOn
+	s.RetryOnStatusCodes = t.RetryOnStatusCodes
+	s.RetryBaseInterval = structs.DurationToProto(t.RetryBaseInterval)
+	s.RetryMaxInterval = structs.DurationToProto(t.RetryMaxInterval)
+	s.PerTryTimeout = structs.DurationToProto(t.PerTryTimeout)
+	{
+		s.RetriableHeaders = make([]*ServiceRouteHTTPMatchHeader, len(t.RetriableHeaders))
+		for i := range t.RetriableHeaders {
+			{
+				var x ServiceRouteHTTPMatchHeader
+				ServiceRouteHTTPMatchHeaderFromStructs(&t.RetriableHeaders[i], &x)
+				s.RetriableHeaders[i] = &x
+			}
+		}
+	}
+	if t.RetryBudget != nil {
+		var x RetryBudget
+		RetryBudgetFromStructs(t.RetryBudget, &x)
+		s.RetryBudget = &x
+	}
+}
 func ServiceResolverSubsetToStructs(s *ServiceResolverSubset, t *structs.ServiceResolverSubset) {
 	if s == nil {
 		return
@@ -2444,6 +2894,372 @@ func ServiceResolverSubsetFromStructs(t *structs.ServiceResolverSubset, s *Servi
 	s.Filter = t.Filter
 	s.OnlyPassing = t.OnlyPassing
 }
+func ServiceRouteToStructs(s *ServiceRoute, t *structs.ServiceRoute) {
+	if s == nil {
+		return
+	}
+	if s.Match != nil {
+		var x structs.ServiceRouteMatch
+		ServiceRouteMatchToStructs(s.Match, &x)
+		t.Match = &x
+	}
+	if s.Destination != nil {
+		var x structs.ServiceRouteDestination
+		ServiceRouteDestinationToStructs(s.Destination, &x)
+		t.Destination = &x
+	}
+}
+func ServiceRouteFromStructs(t *structs.ServiceRoute, s *ServiceRoute) {
+	if s == nil {
+		return
+	}
+	if t.Match != nil {
+		var x ServiceRouteMatch
+		ServiceRouteMatchFromStructs(t.Match, &x)
+		s.Match = &x
+	}
+	if t.Destination != nil {
+		var x ServiceRouteDestination
+		ServiceRouteDestinationFromStructs(t.Destination, &x)
+		s.Destination = &x
+	}
+}
+func ServiceRouteDestinationToStructs(s *ServiceRouteDestination, t *structs.ServiceRouteDestination) {
+	if s == nil {
+		return
+	}
+	t.Service = s.Service
+	t.ServiceSubset = s.ServiceSubset
+	t.Namespace = s.Namespace
+	t.Partition = s.Partition
+	t.PrefixRewrite = s.PrefixRewrite
+	t.RequestTimeout = structs.DurationFromProto(s.RequestTimeout)
+	t.IdleTimeout = structs.DurationFromProto(s.IdleTimeout)
+	t.NumRetries = s.NumRetries
+	t.RetryOnConnectFailure = s.RetryOnConnectFailure
+	t.RetryOn = s.RetryOn
+	t.RetryOnStatusCodes = s.RetryOnStatusCodes
+	t.RetryBaseInterval = structs.DurationFromProto(s.RetryBaseInterval)
+	t.RetryMaxInterval = structs.DurationFromProto(s.RetryMaxInterval)
+	t.PerTryTimeout = structs.DurationFromProto(s.PerTryTimeout)
+	{
+		t.RetriableHeaders = make([]structs.ServiceRouteHTTPMatchHeader, len(s.RetriableHeaders))
+		for i := range s.RetriableHeaders {
+			if s.RetriableHeaders[i] != nil {
+				ServiceRouteHTTPMatchHeaderToStructs(s.RetriableHeaders[i], &t.RetriableHeaders[i])
+			}
+		}
+	}
+	if s.RequestHeaders != nil {
+		var x structs.HTTPHeaderModifiers
+		HTTPHeaderModifiersToStructs(s.RequestHeaders, &x)
+		t.RequestHeaders = &x
+	}
+	if s.ResponseHeaders != nil {
+		var x structs.HTTPHeaderModifiers
+		HTTPHeaderModifiersToStructs(s.ResponseHeaders, &x)
+		t.ResponseHeaders = &x
+	}
+	if s.FaultInjection != nil {
+		var x structs.ServiceRouteFaultInjection
+		ServiceRouteFaultInjectionToStructs(s.FaultInjection, &x)
+		t.FaultInjection = &x
+	}
+	if s.Mirror != nil {
+		var x structs.MirrorPolicy
+		MirrorPolicyToStructs(s.Mirror, &x)
+		t.Mirror = &x
+	}
+}
+func ServiceRouteDestinationFromStructs(t *structs.ServiceRouteDestination, s *ServiceRouteDestination) {
+	if s == nil {
+		return
+	}
+	s.Service = t.Service
+	s.ServiceSubset = t.ServiceSubset
+	s.Namespace = t.Namespace
+	s.Partition = t.Partition
+	s.PrefixRewrite = t.PrefixRewrite
+	s.RequestTimeout = structs.DurationToProto(t.RequestTimeout)
+	s.IdleTimeout = structs.DurationToProto(t.IdleTimeout)
+	s.NumRetries = t.NumRetries
+	s.RetryOnConnectFailure = t.RetryOnConnectFailure
+	s.RetryOn = t.RetryOn
+	s.RetryOnStatusCodes = t.RetryOnStatusCodes
+	s.RetryBaseInterval = structs.DurationToProto(t.RetryBaseInterval)
+	s.RetryMaxInterval = structs.DurationToProto(t.RetryMaxInterval)
+	s.PerTryTimeout = structs.DurationToProto(t.PerTryTimeout)
+	{
+		s.RetriableHeaders = make([]*ServiceRouteHTTPMatchHeader, len(t.RetriableHeaders))
+		for i := range t.RetriableHeaders {
+			{
+				var x ServiceRouteHTTPMatchHeader
+				ServiceRouteHTTPMatchHeaderFromStructs(&t.RetriableHeaders[i], &x)
+				s.RetriableHeaders[i] = &x
+			}
+		}
+	}
+	if t.RequestHeaders != nil {
+		var x HTTPHeaderModifiers
+		HTTPHeaderModifiersFromStructs(t.RequestHeaders, &x)
+		s.RequestHeaders = &x
+	}
+	if t.ResponseHeaders != nil {
+		var x HTTPHeaderModifiers
+		HTTPHeaderModifiersFromStructs(t.ResponseHeaders, &x)
+		s.ResponseHeaders = &x
+	}
+	if t.FaultInjection != nil {
+		var x ServiceRouteFaultInjection
+		ServiceRouteFaultInjectionFromStructs(t.FaultInjection, &x)
+		s.FaultInjection = &x
+	}
+	if t.Mirror != nil {
+		var x MirrorPolicy
+		MirrorPolicyFromStructs(t.Mirror, &x)
+		s.Mirror = &x
+	}
+}
+func ServiceRouteFaultInjectionToStructs(s *ServiceRouteFaultInjection, t *structs.ServiceRouteFaultInjection) {
+	if s == nil {
+		return
+	}
+	if s.Delay != nil {
+		var x structs.FaultInjectionDelay
+		FaultInjectionDelayToStructs(s.Delay, &x)
+		t.Delay = &x
+	}
+	if s.Abort != nil {
+		var x structs.FaultInjectionAbort
+		FaultInjectionAbortToStructs(s.Abort, &x)
+		t.Abort = &x
+	}
+	{
+		t.Headers = make([]structs.ServiceRouteHTTPMatchHeader, len(s.Headers))
+		for i := range s.Headers {
+			if s.Headers[i] != nil {
+				ServiceRouteHTTPMatchHeaderToStructs(s.Headers[i], &t.Headers[i])
+			}
+		}
+	}
+}
+func ServiceRouteFaultInjectionFromStructs(t *structs.ServiceRouteFaultInjection, s *ServiceRouteFaultInjection) {
+	if s == nil {
+		return
+	}
+	if t.Delay != nil {
+		var x FaultInjectionDelay
+		FaultInjectionDelayFromStructs(t.Delay, &x)
+		s.Delay = &x
+	}
+	if t.Abort != nil {
+		var x FaultInjectionAbort
+		FaultInjectionAbortFromStructs(t.Abort, &x)
+		s.Abort = &x
+	}
+	{
+		s.Headers = make([]*ServiceRouteHTTPMatchHeader, len(t.Headers))
+		for i := range t.Headers {
+			{
+				var x ServiceRouteHTTPMatchHeader
+				ServiceRouteHTTPMatchHeaderFromStructs(&t.Headers[i], &x)
+				s.Headers[i] = &x
+			}
+		}
+	}
+}
+func ServiceRouteGRPCMatchToStructs(s *ServiceRouteGRPCMatch, t *structs.ServiceRouteGRPCMatch) {
+	if s == nil {
+		return
+	}
+	t.Service = s.Service
+	t.Method = s.Method
+	{
+		t.Metadata = make([]structs.ServiceRouteHTTPMatchHeader, len(s.Metadata))
+		for i := range s.Metadata {
+			if s.Metadata[i] != nil {
+				ServiceRouteHTTPMatchHeaderToStructs(s.Metadata[i], &t.Metadata[i])
+			}
+		}
+	}
+}
+func ServiceRouteGRPCMatchFromStructs(t *structs.ServiceRouteGRPCMatch, s *ServiceRouteGRPCMatch) {
+	if s == nil {
+		return
+	}
+	s.Service = t.Service
+	s.Method = t.Method
+	{
+		s.Metadata = make([]*ServiceRouteHTTPMatchHeader, len(t.Metadata))
+		for i := range t.Metadata {
+			{
+				var x ServiceRouteHTTPMatchHeader
+				ServiceRouteHTTPMatchHeaderFromStructs(&t.Metadata[i], &x)
+				s.Metadata[i] = &x
+			}
+		}
+	}
+}
+func ServiceRouteHTTPMatchToStructs(s *ServiceRouteHTTPMatch, t *structs.ServiceRouteHTTPMatch) {
+	if s == nil {
+		return
+	}
+	t.PathExact = s.PathExact
+	t.PathPrefix = s.PathPrefix
+	t.PathRegex = s.PathRegex
+	t.CaseInsensitive = s.CaseInsensitive
+	{
+		t.Header = make([]structs.ServiceRouteHTTPMatchHeader, len(s.Header))
+		for i := range s.Header {
+			if s.Header[i] != nil {
+				ServiceRouteHTTPMatchHeaderToStructs(s.Header[i], &t.Header[i])
+			}
+		}
+	}
+	{
+		t.QueryParam = make([]structs.ServiceRouteHTTPMatchQueryParam, len(s.QueryParam))
+		for i := range s.QueryParam {
+			if s.QueryParam[i] != nil {
+				ServiceRouteHTTPMatchQueryParamToStructs(s.QueryParam[i], &t.QueryParam[i])
+			}
+		}
+	}
+	t.Methods = s.Methods
+}
+func ServiceRouteHTTPMatchFromStructs(t *structs.ServiceRouteHTTPMatch, s *ServiceRouteHTTPMatch) {
+	if s == nil {
+		return
+	}
+	s.PathExact = t.PathExact
+	s.PathPrefix = t.PathPrefix
+	s.PathRegex = t.PathRegex
+	s.CaseInsensitive = t.CaseInsensitive
+	{
+		s.Header = make([]*ServiceRouteHTTPMatchHeader, len(t.Header))
+		for i := range t.Header {
+			{
+				var x ServiceRouteHTTPMatchHeader
+				ServiceRouteHTTPMatchHeaderFromStructs(&t.Header[i], &x)
+				s.Header[i] = &x
+			}
+		}
+	}
+	{
+		s.QueryParam = make([]*ServiceRouteHTTPMatchQueryParam, len(t.QueryParam))
+		for i := range t.QueryParam {
+			{
+				var x ServiceRouteHTTPMatchQueryParam
+				ServiceRouteHTTPMatchQueryParamFromStructs(&t.QueryParam[i], &x)
+				s.QueryParam[i] = &x
+			}
+		}
+	}
+	s.Methods = t.Methods
+}
+func ServiceRouteHTTPMatchHeaderToStructs(s *ServiceRouteHTTPMatchHeader, t *structs.ServiceRouteHTTPMatchHeader) {
+	if s == nil {
+		return
+	}
+	t.Name = s.Name
+	t.Present = s.Present
+	t.Exact = s.Exact
+	t.Prefix = s.Prefix
+	t.Suffix = s.Suffix
+	t.Regex = s.Regex
+	t.Invert = s.Invert
+}
+func ServiceRouteHTTPMatchHeaderFromStructs(t *structs.ServiceRouteHTTPMatchHeader, s *ServiceRouteHTTPMatchHeader) {
+	if s == nil {
+		return
+	}
+	s.Name = t.Name
+	s.Present = t.Present
+	s.Exact = t.Exact
+	s.Prefix = t.Prefix
+	s.Suffix = t.Suffix
+	s.Regex = t.Regex
+	s.Invert = t.Invert
+}
+func ServiceRouteHTTPMatchQueryParamToStructs(s *ServiceRouteHTTPMatchQueryParam, t *structs.ServiceRouteHTTPMatchQueryParam) {
+	if s == nil {
+		return
+	}
+	t.Name = s.Name
+	t.Present = s.Present
+	t.Exact = s.Exact
+	t.Regex = s.Regex
+}
+func ServiceRouteHTTPMatchQueryParamFromStructs(t *structs.ServiceRouteHTTPMatchQueryParam, s *ServiceRouteHTTPMatchQueryParam) {
+	if s == nil {
+		return
+	}
+	s.Name = t.Name
+	s.Present = t.Present
+	s.Exact = t.Exact
+	s.Regex = t.Regex
+}
+func ServiceRouteMatchToStructs(s *ServiceRouteMatch, t *structs.ServiceRouteMatch) {
+	if s == nil {
+		return
+	}
+	if s.HTTP != nil {
+		var x structs.ServiceRouteHTTPMatch
+		ServiceRouteHTTPMatchToStructs(s.HTTP, &x)
+		t.HTTP = &x
+	}
+	if s.GRPC != nil {
+		var x structs.ServiceRouteGRPCMatch
+		ServiceRouteGRPCMatchToStructs(s.GRPC, &x)
+		t.GRPC = &x
+	}
+}
+func ServiceRouteMatchFromStructs(t *structs.ServiceRouteMatch, s *ServiceRouteMatch) {
+	if s == nil {
+		return
+	}
+	if t.HTTP != nil {
+		var x ServiceRouteHTTPMatch
+		ServiceRouteHTTPMatchFromStructs(t.HTTP, &x)
+		s.HTTP = &x
+	}
+	if t.GRPC != nil {
+		var x ServiceRouteGRPCMatch
+		ServiceRouteGRPCMatchFromStructs(t.GRPC, &x)
+		s.GRPC = &x
+	}
+}
+func ServiceRouterToStructs(s *ServiceRouter, t *structs.ServiceRouterConfigEntry) {
+	if s == nil {
+		return
+	}
+	{
+		t.Routes = make([]structs.ServiceRoute, len(s.Routes))
+		for i := range s.Routes {
+			if s.Routes[i] != nil {
+				ServiceRouteToStructs(s.Routes[i], &t.Routes[i])
+			}
+		}
+	}
+	t.Meta = s.Meta
+	t.Hash = s.Hash
+}
+func ServiceRouterFromStructs(t *structs.ServiceRouterConfigEntry, s *ServiceRouter) {
+	if s == nil {
+		return
+	}
+	{
+		s.Routes = make([]*ServiceRoute, len(t.Routes))
+		for i := range t.Routes {
+			{
+				var x ServiceRoute
+				ServiceRouteFromStructs(&t.Routes[i], &x)
+				s.Routes[i] = &x
+			}
+		}
+	}
+	s.Meta = t.Meta
+	s.Hash = t.Hash
+}
 func SourceIntentionToStructs(s *SourceIntention, t *structs.SourceIntention) {
 	if s == nil {
 		return
@@ -2598,6 +3414,66 @@ func TCPServiceFromStructs(t *structs.TCPService, s *TCPService) {
 	s.Name = t.Name
 	s.EnterpriseMeta = enterpriseMetaFromStructs(t.EnterpriseMeta)
 }
+func TLSRouteToStructs(s *TLSRoute, t *structs.TLSRouteConfigEntry) {
+	if s == nil {
+		return
+	}
+	{
+		t.Parents = make([]structs.ResourceReference, len(s.Parents))
+		for i := range s.Parents {
+			if s.Parents[i] != nil {
+				ResourceReferenceToStructs(s.Parents[i], &t.Parents[i])
+			}
+		}
+	}
+	t.Hostnames = s.Hostnames
+	{
+		t.Services = make([]structs.TCPService, len(s.Services))
+		for i := range s.Services {
+			if s.Services[i] != nil {
+				TCPServiceToStructs(s.Services[i], &t.Services[i])
+			}
+		}
+	}
+	t.Meta = s.Meta
+	if s.Status != nil {
+		StatusToStructs(s.Status, &t.Status)
+	}
+	t.Hash = s.Hash
+}
+func TLSRouteFromStructs(t *structs.TLSRouteConfigEntry, s *TLSRoute) {
+	if s == nil {
+		return
+	}
+	{
+		s.Parents = make([]*ResourceReference, len(t.Parents))
+		for i := range t.Parents {
+			{
+				var x ResourceReference
+				ResourceReferenceFromStructs(&t.Parents[i], &x)
+				s.Parents[i] = &x
+			}
+		}
+	}
+	s.Hostnames = t.Hostnames
+	{
+		s.Services = make([]*TCPService, len(t.Services))
+		for i := range t.Services {
+			{
+				var x TCPService
+				TCPServiceFromStructs(&t.Services[i], &x)
+				s.Services[i] = &x
+			}
+		}
+	}
+	s.Meta = t.Meta
+	{
+		var x Status
+		StatusFromStructs(&t.Status, &x)
+		s.Status = &x
+	}
+	s.Hash = t.Hash
+}
 func TimeoutFilterToStructs(s *TimeoutFilter, t *structs.TimeoutFilter) {
 	if s == nil {
 		return
@@ -77,6 +77,22 @@ func ConfigEntryToStructs(s *ConfigEntry) structs.ConfigEntry {
 		pbcommon.RaftIndexToStructs(s.RaftIndex, &target.RaftIndex)
 		pbcommon.EnterpriseMetaToStructs(s.EnterpriseMeta, &target.EnterpriseMeta)
 		return &target
+	case Kind_KindGRPCRoute:
+		var target structs.GRPCRouteConfigEntry
+		target.Name = s.Name
+
+		GRPCRouteToStructs(s.GetGRPCRoute(), &target)
+		pbcommon.RaftIndexToStructs(s.RaftIndex, &target.RaftIndex)
+		pbcommon.EnterpriseMetaToStructs(s.EnterpriseMeta, &target.EnterpriseMeta)
+		return &target
+	case Kind_KindTLSRoute:
+		var target structs.TLSRouteConfigEntry
+		target.Name = s.Name
+
+		TLSRouteToStructs(s.GetTLSRoute(), &target)
+		pbcommon.RaftIndexToStructs(s.RaftIndex, &target.RaftIndex)
+		pbcommon.EnterpriseMetaToStructs(s.EnterpriseMeta, &target.EnterpriseMeta)
+		return &target
 	case Kind_KindHTTPRoute:
 		var target structs.HTTPRouteConfigEntry
 		target.Name = s.Name
@@ -101,6 +117,22 @@ func ConfigEntryToStructs(s *ConfigEntry) structs.ConfigEntry {
 		pbcommon.RaftIndexToStructs(s.RaftIndex, &target.RaftIndex)
 		pbcommon.EnterpriseMetaToStructs(s.EnterpriseMeta, &target.EnterpriseMeta)
 		return &target
+	case Kind_KindServiceRouter:
+		var target structs.ServiceRouterConfigEntry
+		target.Name = s.Name
+
+		ServiceRouterToStructs(s.GetServiceRouter(), &target)
+		pbcommon.RaftIndexToStructs(s.RaftIndex, &target.RaftIndex)
+		pbcommon.EnterpriseMetaToStructs(s.EnterpriseMeta, &target.EnterpriseMeta)
+		return &target
+	case Kind_KindACMECertificate:
+		var target structs.ACMECertificateConfigEntry
+		target.Name = s.Name
+
+		ACMECertificateToStructs(s.GetACMECertificate(), &target)
+		pbcommon.RaftIndexToStructs(s.RaftIndex, &target.RaftIndex)
+		pbcommon.EnterpriseMetaToStructs(s.EnterpriseMeta, &target.EnterpriseMeta)
+		return &target
 	case Kind_KindServiceDefaults:
 		var target structs.ServiceConfigEntry
 		target.Name = s.Name
@@ -165,6 +197,14 @@ func ConfigEntryFromStructs(s structs.ConfigEntry) *ConfigEntry {
 		configEntry.Entry = &ConfigEntry_ServiceResolver{
 			ServiceResolver: &serviceResolver,
 		}
+	case *structs.ServiceRouterConfigEntry:
+		var serviceRouter ServiceRouter
+		ServiceRouterFromStructs(v, &serviceRouter)
+
+		configEntry.Kind = Kind_KindServiceRouter
+		configEntry.Entry = &ConfigEntry_ServiceRouter{
+			ServiceRouter: &serviceRouter,
+		}
 	case *structs.IngressGatewayConfigEntry:
 		var ingressGateway IngressGateway
 		IngressGatewayFromStructs(v, &ingressGateway)
@@ -213,6 +253,22 @@ func ConfigEntryFromStructs(s structs.ConfigEntry) *ConfigEntry {
 		configEntry.Entry = &ConfigEntry_TCPRoute{
 			TCPRoute: &route,
 		}
+	case *structs.GRPCRouteConfigEntry:
+		var route GRPCRoute
+		GRPCRouteFromStructs(v, &route)
+
+		configEntry.Kind = Kind_KindGRPCRoute
+		configEntry.Entry = &ConfigEntry_GRPCRoute{
+			GRPCRoute: &route,
+		}
+	case *structs.TLSRouteConfigEntry:
+		var route TLSRoute
+		TLSRouteFromStructs(v, &route)
+
+		configEntry.Kind = Kind_KindTLSRoute
+		configEntry.Entry = &ConfigEntry_TLSRoute{
+			TLSRoute: &route,
+		}
 	case *structs.HTTPRouteConfigEntry:
 		var route HTTPRoute
 		HTTPRouteFromStructs(v, &route)
@@ -237,6 +293,14 @@ func ConfigEntryFromStructs(s structs.ConfigEntry) *ConfigEntry {
 		configEntry.Entry = &ConfigEntry_InlineCertificate{
 			InlineCertificate: &cert,
 		}
+	case *structs.ACMECertificateConfigEntry:
+		var cert ACMECertificate
+		ACMECertificateFromStructs(v, &cert)
+
+		configEntry.Kind = Kind_KindACMECertificate
+		configEntry.Entry = &ConfigEntry_ACMECertificate{
+			ACMECertificate: &cert,
+		}
 	case *structs.SamenessGroupConfigEntry:
 		var sg SamenessGroup
 		SamenessGroupFromStructs(v, &sg)
@@ -510,6 +574,8 @@ func apiGatewayProtocolFromStructs(a structs.APIGatewayListenerProtocol) APIGate
 		return APIGatewayListenerProtocol_ListenerProtocolHTTP
 	case structs.ListenerProtocolTCP:
 		return APIGatewayListenerProtocol_ListenerProtocolTCP
+	case structs.ListenerProtocolTLS:
+		return APIGatewayListenerProtocol_ListenerProtocolTLS
 	default:
 		return APIGatewayListenerProtocol_ListenerProtocolHTTP
 	}
@@ -521,11 +587,35 @@ func apiGatewayProtocolToStructs(a APIGatewayListenerProtocol) structs.APIGatewa
 		return structs.ListenerProtocolHTTP
 	case APIGatewayListenerProtocol_ListenerProtocolTCP:
 		return structs.ListenerProtocolTCP
+	case APIGatewayListenerProtocol_ListenerProtocolTLS:
+		return structs.ListenerProtocolTLS
 	default:
 		return structs.ListenerProtocolHTTP
 	}
 }
 
+func grpcMethodMatchFromStructs(a structs.GRPCMethodMatchType) GRPCMethodMatchType {
+	switch a {
+	case structs.GRPCMethodMatchExact:
+		return GRPCMethodMatchType_GRPCMethodMatchExact
+	case structs.GRPCMethodMatchRegularExpression:
+		return GRPCMethodMatchType_GRPCMethodMatchRegularExpression
+	default:
+		return GRPCMethodMatchType_GRPCMethodMatchExact
+	}
+}
+
+func grpcMethodMatchToStructs(a GRPCMethodMatchType) structs.GRPCMethodMatchType {
+	switch a {
+	case GRPCMethodMatchType_GRPCMethodMatchExact:
+		return structs.GRPCMethodMatchExact
+	case GRPCMethodMatchType_GRPCMethodMatchRegularExpression:
+		return structs.GRPCMethodMatchRegularExpression
+	default:
+		return structs.GRPCMethodMatchExact
+	}
+}
+
 func httpMatchMethodFromStructs(a structs.HTTPMatchMethod) HTTPMatchMethod {
 	switch a {
 	case structs.HTTPMatchMethodAll:
@@ -82,6 +82,7 @@ func PeeringToAPI(s *Peering, t *api.Peering) {
 	if s.Remote != nil {
 		RemoteInfoToAPI(s.Remote, &t.Remote)
 	}
+	t.RTT = DurationPtrFromProto(s.RTT)
 }
 func PeeringFromAPI(t *api.Peering, s *Peering) {
 	if s == nil {
@@ -105,6 +106,7 @@ func PeeringFromAPI(t *api.Peering, s *Peering) {
 		RemoteInfoFromAPI(&t.Remote, &x)
 		s.Remote = &x
 	}
+	s.RTT = DurationPtrToProto(t.RTT)
 }
 func RemoteInfoToAPI(s *RemoteInfo, t *api.PeeringRemoteInfo) {
 	if s == nil {
@@ -122,3 +124,27 @@ func RemoteInfoFromAPI(t *api.PeeringRemoteInfo, s *RemoteInfo) {
 	s.Datacenter = t.Datacenter
 	s.Locality = LocalityFromAPI(t.Locality)
 }
+func RotateSecretRequestToAPI(s *RotateSecretRequest, t *api.PeeringRotateSecretRequest) {
+	if s == nil {
+		return
+	}
+	t.PeerName = s.PeerName
+	t.Partition = s.Partition
+}
+func RotateSecretRequestFromAPI(t *api.PeeringRotateSecretRequest, s *RotateSecretRequest) {
+	if s == nil {
+		return
+	}
+	s.PeerName = t.PeerName
+	s.Partition = t.Partition
+}
+func RotateSecretResponseToAPI(s *RotateSecretResponse, t *api.PeeringRotateSecretResponse) {
+	if s == nil {
+		return
+	}
+}
+func RotateSecretResponseFromAPI(t *api.PeeringRotateSecretResponse, s *RotateSecretResponse) {
+	if s == nil {
+		return
+	}
+}
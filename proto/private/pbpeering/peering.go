@@ -13,6 +13,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/hashicorp/consul/agent/structs"
@@ -63,6 +64,13 @@ func (req *PeeringDeleteRequest) RequestDatacenter() string {
 	return ""
 }
 
+// RequestDatacenter implements structs.RPCInfo
+func (req *RotateSecretRequest) RequestDatacenter() string {
+	// Cross-datacenter requests are not allowed for peering actions because
+	// they rely on WAN-federation.
+	return ""
+}
+
 // RequestDatacenter implements structs.RPCInfo
 func (req *TrustBundleReadRequest) RequestDatacenter() string {
 	// Cross-datacenter requests are not allowed for peering actions because
@@ -163,6 +171,7 @@ func StreamStatusToAPI(status *StreamStatus) api.PeeringStreamStatus {
 		LastHeartbeat:    TimePtrFromProto(status.LastHeartbeat),
 		LastReceive:      TimePtrFromProto(status.LastReceive),
 		LastSend:         TimePtrFromProto(status.LastSend),
+		Replication:      ReplicationStatusToAPI(status.Replication),
 	}
 }
 
@@ -173,9 +182,100 @@ func StreamStatusFromAPI(status api.PeeringStreamStatus) *StreamStatus {
 		LastHeartbeat:    TimePtrToProto(status.LastHeartbeat),
 		LastReceive:      TimePtrToProto(status.LastReceive),
 		LastSend:         TimePtrToProto(status.LastSend),
+		Replication:      ReplicationStatusFromAPI(status.Replication),
 	}
 }
 
+func ReplicationStatusToAPI(status *ReplicationStatus) *api.PeeringReplicationStatus {
+	if status == nil {
+		return nil
+	}
+	out := &api.PeeringReplicationStatus{}
+	for _, r := range status.Exported {
+		out.Exported = append(out.Exported, api.PeeringExportedResourceStatus{
+			ResourceURL:     r.ResourceURL,
+			LastSentNonce:   r.LastSentNonce,
+			LastAckedNonce:  r.LastAckedNonce,
+			PendingUpdates:  r.PendingUpdates,
+			LastAck:         TimePtrFromProto(r.LastAck),
+			LastNack:        TimePtrFromProto(r.LastNack),
+			LastNackMessage: r.LastNackMessage,
+		})
+	}
+	for _, r := range status.Imported {
+		out.Imported = append(out.Imported, api.PeeringImportedResourceStatus{
+			ResourceURL:           r.ResourceURL,
+			LastNonce:             r.LastNonce,
+			LastApply:             TimePtrFromProto(r.LastApply),
+			LastApplyError:        TimePtrFromProto(r.LastApplyError),
+			LastApplyErrorMessage: r.LastApplyErrorMessage,
+		})
+	}
+	for _, svc := range status.ImportedServices {
+		out.ImportedServices = append(out.ImportedServices, api.PeeringImportedServiceStatus{
+			Name:                  svc.Name,
+			LastApply:             TimePtrFromProto(svc.LastApply),
+			LastApplyError:        TimePtrFromProto(svc.LastApplyError),
+			LastApplyErrorMessage: svc.LastApplyErrorMessage,
+		})
+	}
+	for _, e := range status.Errors {
+		out.Errors = append(out.Errors, api.PeeringReplicationError{
+			Time:        structs.TimeFromProto(e.Time),
+			Exported:    e.Exported,
+			ResourceURL: e.ResourceURL,
+			ResourceID:  e.ResourceID,
+			Message:     e.Message,
+		})
+	}
+	return out
+}
+
+func ReplicationStatusFromAPI(status *api.PeeringReplicationStatus) *ReplicationStatus {
+	if status == nil {
+		return nil
+	}
+	out := &ReplicationStatus{}
+	for _, r := range status.Exported {
+		out.Exported = append(out.Exported, &ExportedResourceStatus{
+			ResourceURL:     r.ResourceURL,
+			LastSentNonce:   r.LastSentNonce,
+			LastAckedNonce:  r.LastAckedNonce,
+			PendingUpdates:  r.PendingUpdates,
+			LastAck:         TimePtrToProto(r.LastAck),
+			LastNack:        TimePtrToProto(r.LastNack),
+			LastNackMessage: r.LastNackMessage,
+		})
+	}
+	for _, r := range status.Imported {
+		out.Imported = append(out.Imported, &ImportedResourceStatus{
+			ResourceURL:           r.ResourceURL,
+			LastNonce:             r.LastNonce,
+			LastApply:             TimePtrToProto(r.LastApply),
+			LastApplyError:        TimePtrToProto(r.LastApplyError),
+			LastApplyErrorMessage: r.LastApplyErrorMessage,
+		})
+	}
+	for _, svc := range status.ImportedServices {
+		out.ImportedServices = append(out.ImportedServices, &ImportedServiceStatus{
+			Name:                  svc.Name,
+			LastApply:             TimePtrToProto(svc.LastApply),
+			LastApplyError:        TimePtrToProto(svc.LastApplyError),
+			LastApplyErrorMessage: svc.LastApplyErrorMessage,
+		})
+	}
+	for _, e := range status.Errors {
+		out.Errors = append(out.Errors, &ReplicationError{
+			Time:        structs.TimeToProto(e.Time),
+			Exported:    e.Exported,
+			ResourceURL: e.ResourceURL,
+			ResourceID:  e.ResourceID,
+			Message:     e.Message,
+		})
+	}
+	return out
+}
+
 func (p *Peering) IsActive() bool {
 	if p == nil || p.State == PeeringState_TERMINATED {
 		return false
@@ -210,6 +310,10 @@ func (s *SecretsWriteRequest) Validate() error {
 		if r != nil && r.PromotePending.GetActiveStreamSecret() != "" {
 			return nil
 		}
+	case *SecretsWriteRequest_RotateStream:
+		if r != nil && r.RotateStream.GetPendingStreamSecret() != "" {
+			return nil
+		}
 	default:
 		return fmt.Errorf("unexpected request type %T", s.Request)
 	}
@@ -276,6 +380,13 @@ func (resp *EstablishResponse) ToAPI() *api.PeeringEstablishResponse {
 	return &t
 }
 
+// TODO consider using mog for this
+func (resp *RotateSecretResponse) ToAPI() *api.PeeringRotateSecretResponse {
+	var t api.PeeringRotateSecretResponse
+	RotateSecretResponseToAPI(resp, &t)
+	return &t
+}
+
 func (r *RemoteInfo) IsEmpty() bool {
 	if r == nil {
 		return true
@@ -303,6 +414,16 @@ func NewEstablishRequestFromAPI(req *api.PeeringEstablishRequest) *EstablishRequ
 	return t
 }
 
+// convenience
+func NewRotateSecretRequestFromAPI(req *api.PeeringRotateSecretRequest) *RotateSecretRequest {
+	if req == nil {
+		return nil
+	}
+	t := &RotateSecretRequest{}
+	RotateSecretRequestFromAPI(req, t)
+	return t
+}
+
 func TimePtrFromProto(s *timestamppb.Timestamp) *time.Time {
 	if s == nil {
 		return nil
@@ -318,6 +439,21 @@ func TimePtrToProto(s *time.Time) *timestamppb.Timestamp {
 	return timestamppb.New(*s)
 }
 
+func DurationPtrFromProto(s *durationpb.Duration) *time.Duration {
+	if s == nil {
+		return nil
+	}
+	d := s.AsDuration()
+	return &d
+}
+
+func DurationPtrToProto(s *time.Duration) *durationpb.Duration {
+	if s == nil {
+		return nil
+	}
+	return durationpb.New(*s)
+}
+
 // DeepCopy returns a copy of the PeeringTrustBundle that can be passed around
 // without worrying about the receiver unsafely modifying it. It is used by the
 // generated DeepCopy methods in proxycfg.
@@ -16,3 +16,7 @@ func (p *Peering) PartitionOrDefault() string {
 func (ptb *PeeringTrustBundle) PartitionOrDefault() string {
 	return ""
 }
+
+func (pce *PeeringConfigEntries) PartitionOrDefault() string {
+	return ""
+}
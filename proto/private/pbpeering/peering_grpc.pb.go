@@ -27,6 +27,7 @@ type PeeringServiceClient interface {
 	PeeringRead(ctx context.Context, in *PeeringReadRequest, opts ...grpc.CallOption) (*PeeringReadResponse, error)
 	PeeringList(ctx context.Context, in *PeeringListRequest, opts ...grpc.CallOption) (*PeeringListResponse, error)
 	PeeringDelete(ctx context.Context, in *PeeringDeleteRequest, opts ...grpc.CallOption) (*PeeringDeleteResponse, error)
+	RotateSecret(ctx context.Context, in *RotateSecretRequest, opts ...grpc.CallOption) (*RotateSecretResponse, error)
 	// TODO(peering): As of writing, this method is only used in tests to set up Peerings in the state store.
 	// Consider removing if we can find another way to populate state store in peering_endpoint_test.go
 	PeeringWrite(ctx context.Context, in *PeeringWriteRequest, opts ...grpc.CallOption) (*PeeringWriteResponse, error)
@@ -88,6 +89,15 @@ func (c *peeringServiceClient) PeeringDelete(ctx context.Context, in *PeeringDel
 	return out, nil
 }
 
+func (c *peeringServiceClient) RotateSecret(ctx context.Context, in *RotateSecretRequest, opts ...grpc.CallOption) (*RotateSecretResponse, error) {
+	out := new(RotateSecretResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.consul.internal.peering.PeeringService/RotateSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *peeringServiceClient) PeeringWrite(ctx context.Context, in *PeeringWriteRequest, opts ...grpc.CallOption) (*PeeringWriteResponse, error) {
 	out := new(PeeringWriteResponse)
 	err := c.cc.Invoke(ctx, "/hashicorp.consul.internal.peering.PeeringService/PeeringWrite", in, out, opts...)
@@ -124,6 +134,7 @@ type PeeringServiceServer interface {
 	PeeringRead(context.Context, *PeeringReadRequest) (*PeeringReadResponse, error)
 	PeeringList(context.Context, *PeeringListRequest) (*PeeringListResponse, error)
 	PeeringDelete(context.Context, *PeeringDeleteRequest) (*PeeringDeleteResponse, error)
+	RotateSecret(context.Context, *RotateSecretRequest) (*RotateSecretResponse, error)
 	// TODO(peering): As of writing, this method is only used in tests to set up Peerings in the state store.
 	// Consider removing if we can find another way to populate state store in peering_endpoint_test.go
 	PeeringWrite(context.Context, *PeeringWriteRequest) (*PeeringWriteResponse, error)
@@ -151,6 +162,9 @@ func (UnimplementedPeeringServiceServer) PeeringList(context.Context, *PeeringLi
 func (UnimplementedPeeringServiceServer) PeeringDelete(context.Context, *PeeringDeleteRequest) (*PeeringDeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PeeringDelete not implemented")
 }
+func (UnimplementedPeeringServiceServer) RotateSecret(context.Context, *RotateSecretRequest) (*RotateSecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateSecret not implemented")
+}
 func (UnimplementedPeeringServiceServer) PeeringWrite(context.Context, *PeeringWriteRequest) (*PeeringWriteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PeeringWrite not implemented")
 }
@@ -262,6 +276,24 @@ func _PeeringService_PeeringDelete_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PeeringService_RotateSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeeringServiceServer).RotateSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.consul.internal.peering.PeeringService/RotateSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeeringServiceServer).RotateSecret(ctx, req.(*RotateSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PeeringService_PeeringWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PeeringWriteRequest)
 	if err := dec(in); err != nil {
@@ -343,6 +375,10 @@ var PeeringService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PeeringDelete",
 			Handler:    _PeeringService_PeeringDelete_Handler,
 		},
+		{
+			MethodName: "RotateSecret",
+			Handler:    _PeeringService_RotateSecret_Handler,
+		},
 		{
 			MethodName: "PeeringWrite",
 			Handler:    _PeeringService_PeeringWrite_Handler,
@@ -38,3 +38,20 @@ func ExportedServiceListFromStruct(e *structs.ExportedServiceList) *ExportedServ
 		Services: services,
 	}
 }
+
+// ExportedKVEntriesFromStructs converts the KV entries under the given prefixes
+// to their protobuf equivalent.
+func ExportedKVEntriesFromStructs(prefixes []string, entries structs.DirEntries) *ExportedKVEntries {
+	out := &ExportedKVEntries{
+		Prefixes: prefixes,
+		Entries:  make([]*KVEntry, 0, len(entries)),
+	}
+	for _, e := range entries {
+		out.Entries = append(out.Entries, &KVEntry{
+			Key:   e.Key,
+			Flags: e.Flags,
+			Value: e.Value,
+		})
+	}
+	return out
+}
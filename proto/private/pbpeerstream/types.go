@@ -10,12 +10,25 @@ const (
 	TypeURLExportedServiceList    = apiTypePrefix + "hashicorp.consul.internal.peerstream.ExportedServiceList"
 	TypeURLPeeringTrustBundle     = apiTypePrefix + "hashicorp.consul.internal.peering.PeeringTrustBundle"
 	TypeURLPeeringServerAddresses = apiTypePrefix + "hashicorp.consul.internal.peering.PeeringServerAddresses"
+	TypeURLExportedKVEntries      = apiTypePrefix + "hashicorp.consul.internal.peerstream.ExportedKVEntries"
+	TypeURLPeeringConfigEntries   = apiTypePrefix + "hashicorp.consul.internal.peering.PeeringConfigEntries"
+	TypeURLStreamSecret           = apiTypePrefix + "hashicorp.consul.internal.peerstream.StreamSecret"
 )
 
 func KnownTypeURL(s string) bool {
 	switch s {
-	case TypeURLExportedService, TypeURLExportedServiceList, TypeURLPeeringTrustBundle, TypeURLPeeringServerAddresses:
+	case TypeURLExportedService, TypeURLExportedServiceList, TypeURLPeeringTrustBundle, TypeURLPeeringServerAddresses, TypeURLExportedKVEntries, TypeURLPeeringConfigEntries, TypeURLStreamSecret:
 		return true
 	}
 	return false
 }
+
+// OptionalTypeURLs are the resource types added after peering was released.
+// Peers on older versions close the stream when subscribed to a resource type
+// they don't know, so these are only subscribed to when the remote peer
+// advertises support for them.
+var OptionalTypeURLs = []string{
+	TypeURLExportedKVEntries,
+	TypeURLPeeringConfigEntries,
+	TypeURLStreamSecret,
+}